@@ -0,0 +1,45 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"log"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/cli"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/coderef/cmd/coderefs"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/cmd/bundle"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/lite/cmd/lite"
+)
+
+var (
+	name    = "bucketeer"
+	version = ""
+	build   = ""
+)
+
+func main() {
+	app := cli.NewApp(name, "Bucketeer command line tool", version, build)
+	registerCommands(app)
+	err := app.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func registerCommands(app *cli.App) {
+	bundle.RegisterCommand(app, app)
+	coderefs.RegisterCommand(app, app)
+	lite.RegisterCommand(app, app)
+}
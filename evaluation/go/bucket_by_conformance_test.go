@@ -0,0 +1,123 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ftproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+	userproto "github.com/bucketeer-io/bucketeer/v2/proto/user"
+)
+
+// The conformance fixtures are shared with evaluation/typescript so the two
+// engines stay in lockstep. See evaluation/testdata/bucket_by_conformance.json.
+const bucketByConformanceFixturePath = "../testdata/bucket_by_conformance.json"
+
+type bucketByConformanceAudience struct {
+	Percentage       int32  `json:"percentage"`
+	DefaultVariation string `json:"defaultVariation"`
+	BucketBy         string `json:"bucketBy"`
+}
+
+type bucketByConformanceStrategy struct {
+	ID                        string                       `json:"id"`
+	BucketBy                  string                       `json:"bucketBy"`
+	BucketByFallbackVariation string                       `json:"bucketByFallbackVariation"`
+	Audience                  *bucketByConformanceAudience `json:"audience"`
+}
+
+type bucketByConformanceTestCase struct {
+	Desc              string          `json:"desc"`
+	StrategyID        string          `json:"strategyId"`
+	User              conformanceUser `json:"user"`
+	ExpectedVariation string          `json:"expectedVariation"`
+}
+
+type bucketByConformanceFixture struct {
+	FeatureID    string `json:"featureId"`
+	SamplingSeed string `json:"samplingSeed"`
+	Variations   []struct {
+		Variation string `json:"variation"`
+		Weight    int32  `json:"weight"`
+	} `json:"variations"`
+	Strategies []bucketByConformanceStrategy `json:"strategies"`
+	TestCases  []bucketByConformanceTestCase `json:"testCases"`
+}
+
+func TestBucketByConformance(t *testing.T) {
+	t.Parallel()
+	data, err := os.ReadFile(filepath.Clean(bucketByConformanceFixturePath))
+	require.NoError(t, err)
+	fixture := &bucketByConformanceFixture{}
+	require.NoError(t, json.Unmarshal(data, fixture))
+
+	rolloutVariations := make([]*ftproto.RolloutStrategy_Variation, 0, len(fixture.Variations))
+	for _, v := range fixture.Variations {
+		rolloutVariations = append(rolloutVariations, &ftproto.RolloutStrategy_Variation{
+			Variation: v.Variation,
+			Weight:    v.Weight,
+		})
+	}
+	// The variations are the rollout ones plus the fallback and default variations of the strategies.
+	variations := make([]*ftproto.Variation, 0, len(fixture.Variations))
+	addVariation := func(id string) {
+		for _, v := range variations {
+			if id == "" || v.Id == id {
+				return
+			}
+		}
+		variations = append(variations, &ftproto.Variation{Id: id, Value: id})
+	}
+	for _, v := range fixture.Variations {
+		addVariation(v.Variation)
+	}
+	strategies := make(map[string]*ftproto.Strategy, len(fixture.Strategies))
+	for _, s := range fixture.Strategies {
+		addVariation(s.BucketByFallbackVariation)
+		rollout := &ftproto.RolloutStrategy{
+			Variations:                rolloutVariations,
+			BucketBy:                  s.BucketBy,
+			BucketByFallbackVariation: s.BucketByFallbackVariation,
+		}
+		if s.Audience != nil {
+			rollout.Audience = &ftproto.Audience{
+				Percentage:       s.Audience.Percentage,
+				DefaultVariation: s.Audience.DefaultVariation,
+				BucketBy:         s.Audience.BucketBy,
+			}
+			addVariation(s.Audience.DefaultVariation)
+		}
+		strategies[s.ID] = &ftproto.Strategy{Type: ftproto.Strategy_ROLLOUT, RolloutStrategy: rollout}
+	}
+
+	evaluator := &strategyEvaluator{}
+	for _, tc := range fixture.TestCases {
+		t.Run(tc.Desc, func(t *testing.T) {
+			t.Parallel()
+			strategy, ok := strategies[tc.StrategyID]
+			require.True(t, ok, "unknown strategy: %s", tc.StrategyID)
+			user := &userproto.User{Id: tc.User.ID, Data: tc.User.Data}
+			variation, err := evaluator.Evaluate(strategy, user, variations, fixture.FeatureID, fixture.SamplingSeed)
+			require.NoError(t, err)
+			assert.Equal(t, tc.ExpectedVariation, variation.Id)
+		})
+	}
+}
@@ -15,6 +15,7 @@
 package evaluation
 
 import (
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -24,6 +25,11 @@ import (
 	userproto "github.com/bucketeer-io/bucketeer/v2/proto/user"
 )
 
+// compiledRegexps caches compiled MATCHES_REGEX patterns across evaluations.
+// Patterns come from flag and segment definitions, which anyone allowed to edit
+// them can change at will, so the cache evicts the least recently used patterns.
+var compiledRegexps = newRegexpCache(regexpCacheSize)
+
 type clauseEvaluator struct {
 	segmentEvaluator
 	dependencyEvaluator
@@ -33,7 +39,7 @@ func (c *clauseEvaluator) Evaluate(
 	targetValue string,
 	clause *featureproto.Clause,
 	user *userproto.User,
-	segmentUsers []*featureproto.SegmentUser,
+	segmentUsers SegmentUserIndexes,
 	segments map[string]*featureproto.Segment,
 	flagVariations map[string]string,
 ) (bool, error) {
@@ -72,6 +78,14 @@ func (c *clauseEvaluator) Evaluate(
 		return c.partiallyMatches(targetValue, clause.Values), nil
 	case featureproto.Clause_NOT_EQUALS:
 		return !c.equals(targetValue, clause.Values), nil
+	case featureproto.Clause_MATCHES_REGEX:
+		return c.matchesRegex(targetValue, clause.Values), nil
+	case featureproto.Clause_NOT_IN:
+		return !c.in(targetValue, clause.Values), nil
+	case featureproto.Clause_CONTAINS_ANY:
+		return c.containsAny(targetValue, clause.Values), nil
+	case featureproto.Clause_CONTAINS_ALL:
+		return c.containsAll(targetValue, clause.Values), nil
 	}
 	return false, nil
 }
@@ -129,6 +143,62 @@ func (c *clauseEvaluator) in(targetValue string, values []string) bool {
 	return false
 }
 
+func (c *clauseEvaluator) matchesRegex(targetValue string, values []string) bool {
+	for _, value := range values {
+		re := c.compileRegex(value)
+		if re == nil {
+			// Invalid patterns are rejected when the rule is saved,
+			// so fail closed instead of returning an error.
+			continue
+		}
+		if re.MatchString(targetValue) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *clauseEvaluator) compileRegex(pattern string) *regexp.Regexp {
+	return compiledRegexps.get(pattern)
+}
+
+func (c *clauseEvaluator) containsAny(targetValue string, values []string) bool {
+	elements := c.splitList(targetValue)
+	for _, value := range values {
+		if _, ok := elements[value]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *clauseEvaluator) containsAll(targetValue string, values []string) bool {
+	elements := c.splitList(targetValue)
+	if len(elements) == 0 || len(values) == 0 {
+		return false
+	}
+	for _, value := range values {
+		if _, ok := elements[value]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// splitList parses a comma-separated attribute value into a set,
+// trimming surrounding spaces and ignoring empty elements.
+func (c *clauseEvaluator) splitList(targetValue string) map[string]struct{} {
+	elements := make(map[string]struct{})
+	for _, element := range strings.Split(targetValue, ",") {
+		element = strings.TrimSpace(element)
+		if element == "" {
+			continue
+		}
+		elements[element] = struct{}{}
+	}
+	return elements
+}
+
 func (c *clauseEvaluator) startsWith(targetValue string, values []string) bool {
 	for i := range values {
 		if strings.HasPrefix(targetValue, values[i]) {
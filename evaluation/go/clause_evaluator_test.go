@@ -1431,6 +1431,202 @@ func TestNotEquals(t *testing.T) {
 	}
 }
 
+func TestMatchesRegex(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		targetValue string
+		values      []string
+		expected    bool
+	}{
+		{
+			targetValue: "user@example.com",
+			values:      []string{`@example\.com$`},
+			expected:    true,
+		},
+		{
+			targetValue: "user@example.org",
+			values:      []string{`@example\.com$`},
+			expected:    false,
+		},
+		{
+			targetValue: "user@example.org",
+			values:      []string{`@example\.com$`, `\.org$`},
+			expected:    true, // any of the patterns matches
+		},
+		{
+			targetValue: "v1.2.3",
+			values:      []string{`^v1\.`},
+			expected:    true,
+		},
+		{
+			targetValue: "",
+			values:      []string{`^$`},
+			expected:    true,
+		},
+		{
+			targetValue: "value",
+			values:      []string{`(invalid`},
+			expected:    false, // invalid patterns fail closed
+		},
+		{
+			targetValue: "value",
+			values:      []string{`(invalid`, `^val`},
+			expected:    true,
+		},
+		{
+			targetValue: "ADMIN",
+			values:      []string{`(?i)^admin$`},
+			expected:    false, // RE2-only syntax fails closed like in the JavaScript SDKs
+		},
+		{
+			targetValue: "value",
+			values:      []string{},
+			expected:    false,
+		},
+	}
+
+	clauseEvaluator := &clauseEvaluator{}
+	for i, tc := range testcases {
+		clause := &featureproto.Clause{
+			Operator: featureproto.Clause_MATCHES_REGEX,
+			Values:   tc.values,
+		}
+		des := fmt.Sprintf("index: %d", i)
+		// Evaluate twice so the cached regexp path is exercised.
+		for range 2 {
+			res, err := clauseEvaluator.Evaluate(tc.targetValue, clause, &userproto.User{Id: "userId"}, nil, nil, nil)
+			assert.NoError(t, err, des)
+			assert.Equal(t, tc.expected, res, des)
+		}
+	}
+}
+
+func TestNotIn(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		targetValue string
+		values      []string
+		expected    bool
+	}{
+		{
+			targetValue: "value1",
+			values:      []string{"value1", "value2"},
+			expected:    false,
+		},
+		{
+			targetValue: "value3",
+			values:      []string{"value1", "value2"},
+			expected:    true,
+		},
+		{
+			targetValue: "v1.0.0",
+			values:      []string{"1.0.0", "2.0.0"},
+			expected:    false, // semver comparison like IN
+		},
+		{
+			targetValue: "",
+			values:      []string{"value1"},
+			expected:    true, // missing attribute is not in the list
+		},
+		{
+			targetValue: "value1",
+			values:      []string{},
+			expected:    true,
+		},
+	}
+
+	clauseEvaluator := &clauseEvaluator{}
+	for i, tc := range testcases {
+		clause := &featureproto.Clause{
+			Operator: featureproto.Clause_NOT_IN,
+			Values:   tc.values,
+		}
+		des := fmt.Sprintf("index: %d", i)
+		res, err := clauseEvaluator.Evaluate(tc.targetValue, clause, &userproto.User{Id: "userId"}, nil, nil, nil)
+		assert.NoError(t, err, des)
+		assert.Equal(t, tc.expected, res, des)
+	}
+}
+
+func TestContainsAnyAndContainsAll(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		targetValue string
+		values      []string
+		expectedAny bool
+		expectedAll bool
+	}{
+		{
+			targetValue: "admin,editor",
+			values:      []string{"admin"},
+			expectedAny: true,
+			expectedAll: true,
+		},
+		{
+			targetValue: "admin, editor ,viewer",
+			values:      []string{"editor", "viewer"},
+			expectedAny: true,
+			expectedAll: true,
+		},
+		{
+			targetValue: "admin,editor",
+			values:      []string{"editor", "owner"},
+			expectedAny: true,
+			expectedAll: false,
+		},
+		{
+			targetValue: "admin,editor",
+			values:      []string{"owner"},
+			expectedAny: false,
+			expectedAll: false,
+		},
+		{
+			targetValue: "administrator",
+			values:      []string{"admin"},
+			expectedAny: false, // elements are compared exactly, not by substring
+			expectedAll: false,
+		},
+		{
+			targetValue: "",
+			values:      []string{"admin"},
+			expectedAny: false,
+			expectedAll: false,
+		},
+		{
+			targetValue: ",,",
+			values:      []string{""},
+			expectedAny: false, // empty elements are ignored
+			expectedAll: false,
+		},
+		{
+			targetValue: "admin",
+			values:      []string{},
+			expectedAny: false,
+			expectedAll: false,
+		},
+	}
+
+	clauseEvaluator := &clauseEvaluator{}
+	user := &userproto.User{Id: "userId"}
+	for i, tc := range testcases {
+		des := fmt.Sprintf("index: %d", i)
+		anyClause := &featureproto.Clause{
+			Operator: featureproto.Clause_CONTAINS_ANY,
+			Values:   tc.values,
+		}
+		res, err := clauseEvaluator.Evaluate(tc.targetValue, anyClause, user, nil, nil, nil)
+		assert.NoError(t, err, des)
+		assert.Equal(t, tc.expectedAny, res, des)
+		allClause := &featureproto.Clause{
+			Operator: featureproto.Clause_CONTAINS_ALL,
+			Values:   tc.values,
+		}
+		res, err = clauseEvaluator.Evaluate(tc.targetValue, allClause, user, nil, nil, nil)
+		assert.NoError(t, err, des)
+		assert.Equal(t, tc.expectedAll, res, des)
+	}
+}
+
 // TestSemverVPrefixNormalization tests that semver comparisons work correctly
 // regardless of whether target or values have "v" prefix.
 // This matches the behavior of the npm semver package used in TypeScript.
@@ -56,6 +56,7 @@ func EvaluationID(featureID string, featureVersion int32, userID string) string
 type evaluator struct {
 	ruleEvaluator
 	strategyEvaluator
+	allocationEvaluator
 	// variationCache caches YAML to JSON conversions using variation ID as the key.
 	// Since variation IDs are UUIDs, they are globally unique and safe to use as cache keys.
 	variationCache *sync.Map
@@ -105,7 +106,21 @@ func (e *evaluator) EvaluateFeatures(
 	mapSegments map[string]*ftproto.Segment,
 	targetTag string,
 ) (*ftproto.UserEvaluations, error) {
-	return e.evaluate(fs, user, mapSegmentUsers, mapSegments, false, targetTag)
+	return e.EvaluateFeaturesWithSegmentUserIndexes(
+		fs, user, NewSegmentUserIndexes(mapSegmentUsers), mapSegments, targetTag)
+}
+
+// EvaluateFeaturesWithSegmentUserIndexes is EvaluateFeatures with prebuilt segment user indexes.
+// Callers evaluating many users should build the indexes once per segment version
+// and reuse them, so the SEGMENT clauses don't scan the segment users on every call.
+func (e *evaluator) EvaluateFeaturesWithSegmentUserIndexes(
+	fs []*ftproto.Feature,
+	user *userproto.User,
+	segmentUserIndexes SegmentUserIndexes,
+	mapSegments map[string]*ftproto.Segment,
+	targetTag string,
+) (*ftproto.UserEvaluations, error) {
+	return e.evaluate(fs, user, segmentUserIndexes, mapSegments, false, targetTag)
 }
 
 func (e *evaluator) EvaluateFeaturesByEvaluatedAt(
@@ -117,13 +132,37 @@ func (e *evaluator) EvaluateFeaturesByEvaluatedAt(
 	evaluatedAt int64,
 	userAttributesUpdated bool,
 	targetTag string,
+) (*ftproto.UserEvaluations, error) {
+	return e.EvaluateFeaturesByEvaluatedAtWithSegmentUserIndexes(
+		fs,
+		user,
+		NewSegmentUserIndexes(mapSegmentUsers),
+		mapSegments,
+		prevUEID,
+		evaluatedAt,
+		userAttributesUpdated,
+		targetTag,
+	)
+}
+
+// EvaluateFeaturesByEvaluatedAtWithSegmentUserIndexes is EvaluateFeaturesByEvaluatedAt
+// with prebuilt segment user indexes.
+func (e *evaluator) EvaluateFeaturesByEvaluatedAtWithSegmentUserIndexes(
+	fs []*ftproto.Feature,
+	user *userproto.User,
+	segmentUserIndexes SegmentUserIndexes,
+	mapSegments map[string]*ftproto.Segment,
+	prevUEID string,
+	evaluatedAt int64,
+	userAttributesUpdated bool,
+	targetTag string,
 ) (*ftproto.UserEvaluations, error) {
 	if prevUEID == "" {
-		return e.evaluate(fs, user, mapSegmentUsers, mapSegments, true, targetTag)
+		return e.evaluate(fs, user, segmentUserIndexes, mapSegments, true, targetTag)
 	}
 	now := time.Now()
 	if evaluatedAt < now.Unix()-secondsToReEvaluateAll {
-		return e.evaluate(fs, user, mapSegmentUsers, mapSegments, true, targetTag)
+		return e.evaluate(fs, user, segmentUserIndexes, mapSegments, true, targetTag)
 	}
 	adjustedEvalAt := evaluatedAt - e.secondsForAdjustment
 	updatedFeatures := make([]*ftproto.Feature, 0, len(fs))
@@ -139,19 +178,19 @@ func (e *evaluator) EvaluateFeaturesByEvaluatedAt(
 	// If the UserEvaluationsID has changed, but both User Attributes and Feature Flags have not been updated,
 	// it is considered unusual and a force update should be performed.
 	if len(updatedFeatures) == 0 {
-		return e.evaluate(fs, user, mapSegmentUsers, mapSegments, true, targetTag)
+		return e.evaluate(fs, user, segmentUserIndexes, mapSegments, true, targetTag)
 	}
 	evalTargets, err := e.getEvalFeatures(updatedFeatures, fs)
 	if err != nil {
 		return nil, err
 	}
-	return e.evaluate(evalTargets, user, mapSegmentUsers, mapSegments, false, targetTag)
+	return e.evaluate(evalTargets, user, segmentUserIndexes, mapSegments, false, targetTag)
 }
 
 func (e *evaluator) evaluate(
 	fs []*ftproto.Feature,
 	user *userproto.User,
-	mapSegmentUsers map[string][]*ftproto.SegmentUser,
+	segmentUserIndexes SegmentUserIndexes,
 	mapSegments map[string]*ftproto.Segment,
 	forceUpdate bool,
 	targetTag string,
@@ -166,11 +205,7 @@ func (e *evaluator) evaluate(
 	evaluations := make([]*ftproto.Evaluation, 0, len(fs))
 	archivedIDs := make([]string, 0)
 	for _, feature := range sortedFs {
-		var segmentUsers []*ftproto.SegmentUser
-		for _, id := range e.ListSegmentIDs(feature) {
-			segmentUsers = append(segmentUsers, mapSegmentUsers[id]...)
-		}
-		reason, variation, err := e.assignUser(feature, user, segmentUsers, mapSegments, flagVariations)
+		reason, variation, err := e.assignUser(feature, user, segmentUserIndexes, mapSegments, flagVariations)
 		if err != nil {
 			return nil, err
 		}
@@ -248,7 +283,7 @@ func (e *evaluator) isArchivedBeforeLastThirtyDays(feature *ftproto.Feature) boo
 func (e *evaluator) ListSegmentIDs(feature *ftproto.Feature) []string {
 	mapIDs := make(map[string]struct{})
 	for _, r := range feature.Rules {
-		for _, c := range r.Clauses {
+		for _, c := range ftdomain.RuleClauses(r) {
 			if c.Operator == ftproto.Clause_SEGMENT {
 				for _, v := range c.Values {
 					mapIDs[v] = struct{}{}
@@ -266,7 +301,7 @@ func (e *evaluator) ListSegmentIDs(feature *ftproto.Feature) []string {
 func (e *evaluator) assignUser(
 	feature *ftproto.Feature,
 	user *userproto.User,
-	segmentUsers []*ftproto.SegmentUser,
+	segmentUsers SegmentUserIndexes,
 	segments map[string]*ftproto.Segment,
 	flagVariations map[string]string,
 ) (*ftproto.Reason, *ftproto.Variation, error) {
@@ -295,6 +330,13 @@ func (e *evaluator) assignUser(
 			return &ftproto.Reason{Type: ftproto.Reason_TARGET}, variation, err
 		}
 	}
+	// users outside the running experiment get the baseline variation
+	if allocation := feature.ExperimentAllocation; allocation != nil {
+		if reason, excluded := e.allocationEvaluator.Evaluate(allocation, user.Id); excluded {
+			variation, err := findVariation(allocation.BaselineVariationId, feature.Variations)
+			return &ftproto.Reason{Type: reason}, variation, err
+		}
+	}
 	// evaluate ruleset
 	rule, err := e.ruleEvaluator.Evaluate(feature.Rules, user, segmentUsers, segments, flagVariations)
 	if err != nil {
@@ -303,14 +345,15 @@ func (e *evaluator) assignUser(
 	if rule != nil {
 		variation, err := e.strategyEvaluator.Evaluate(
 			rule.Strategy,
-			user.Id,
+			user,
 			feature.Variations,
 			feature.Id,
 			feature.SamplingSeed,
 		)
 		return &ftproto.Reason{
-			Type:   ftproto.Reason_RULE,
-			RuleId: rule.Id,
+			Type:     ftproto.Reason_RULE,
+			RuleId:   rule.Id,
+			RuleName: rule.Name,
 		}, variation, err
 	}
 	// use default strategy
@@ -319,7 +362,7 @@ func (e *evaluator) assignUser(
 	}
 	variation, err := e.strategyEvaluator.Evaluate(
 		feature.DefaultStrategy,
-		user.Id,
+		user,
 		feature.Variations,
 		feature.Id,
 		feature.SamplingSeed,
@@ -422,6 +422,96 @@ func TestEvaluateFeaturesWithRuleBasedSegment(t *testing.T) {
 	}
 }
 
+// TestEvaluateFeaturesWithClauseGroups covers a named rule whose conditions
+// are expressed with nested clause groups, including a SEGMENT clause inside
+// a group.
+func TestEvaluateFeaturesWithClauseGroups(t *testing.T) {
+	t.Parallel()
+	f := &ftproto.Feature{
+		Id:            "feature-id",
+		Name:          "test feature",
+		Version:       1,
+		Enabled:       true,
+		CreatedAt:     time.Now().Unix(),
+		VariationType: feature.Feature_STRING,
+		Variations: []*ftproto.Variation{
+			{Id: "variation-A", Value: "A", Name: "Variation A"},
+			{Id: "variation-B", Value: "B", Name: "Variation B"},
+		},
+		Rules: []*ftproto.Rule{
+			{
+				Id:   "rule-1",
+				Name: "JP pro or beta",
+				Strategy: &ftproto.Strategy{
+					Type:          ftproto.Strategy_FIXED,
+					FixedStrategy: &ftproto.FixedStrategy{Variation: "variation-B"},
+				},
+				Clauses: []*ftproto.Clause{
+					{Id: "clause-1", Attribute: "country", Operator: ftproto.Clause_EQUALS, Values: []string{"jp"}},
+				},
+				ClauseGroups: []*ftproto.ClauseGroup{
+					{
+						Operator: ftproto.ClauseGroup_OR,
+						Clauses: []*ftproto.Clause{
+							{Id: "clause-2", Attribute: "plan", Operator: ftproto.Clause_EQUALS, Values: []string{"pro"}},
+							{Id: "clause-3", Operator: ftproto.Clause_SEGMENT, Values: []string{"segment-beta"}},
+						},
+					},
+				},
+			},
+		},
+		DefaultStrategy: &ftproto.Strategy{
+			Type:          ftproto.Strategy_FIXED,
+			FixedStrategy: &ftproto.FixedStrategy{Variation: "variation-A"},
+		},
+	}
+	segmentUsers := map[string][]*ftproto.SegmentUser{
+		"segment-beta": {
+			{SegmentId: "segment-beta", UserId: "beta-user", State: ftproto.SegmentUser_INCLUDED},
+		},
+	}
+	patterns := []struct {
+		desc              string
+		user              *userproto.User
+		expectedVariation string
+		expectedReason    *ftproto.Reason
+	}{
+		{
+			desc:              "matches the OR group by attribute",
+			user:              &userproto.User{Id: "user-1", Data: map[string]string{"country": "jp", "plan": "pro"}},
+			expectedVariation: "variation-B",
+			expectedReason:    &ftproto.Reason{Type: ftproto.Reason_RULE, RuleId: "rule-1", RuleName: "JP pro or beta"},
+		},
+		{
+			desc:              "matches the OR group by segment",
+			user:              &userproto.User{Id: "beta-user", Data: map[string]string{"country": "jp"}},
+			expectedVariation: "variation-B",
+			expectedReason:    &ftproto.Reason{Type: ftproto.Reason_RULE, RuleId: "rule-1", RuleName: "JP pro or beta"},
+		},
+		{
+			desc:              "OR group matches but the rule clause does not",
+			user:              &userproto.User{Id: "beta-user", Data: map[string]string{"country": "us"}},
+			expectedVariation: "variation-A",
+			expectedReason:    &ftproto.Reason{Type: ftproto.Reason_DEFAULT},
+		},
+	}
+	evaluator := NewEvaluator()
+	assert.Equal(t, []string{"segment-beta"}, evaluator.ListSegmentIDs(f))
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			evaluation, err := evaluator.EvaluateFeatures(
+				[]*ftproto.Feature{f}, p.user, segmentUsers, nil, "",
+			)
+			assert.NoError(t, err)
+			actual, err := findEvaluation(evaluation.Evaluations, f.Id)
+			assert.NoError(t, err)
+			assert.Equal(t, p.expectedVariation, actual.VariationId)
+			assert.True(t, proto.Equal(p.expectedReason, actual.Reason),
+				"expected %v, actual %v", p.expectedReason, actual.Reason)
+		})
+	}
+}
+
 func TestEvaluateFeaturesByEvaluatedAt_MissingPrerequisite(t *testing.T) {
 	t.Parallel()
 
@@ -3064,3 +3154,80 @@ timeout: 30`,
 		})
 	}
 }
+
+func TestEvaluateFeaturesWithExperimentAllocation(t *testing.T) {
+	t.Parallel()
+	f := &ftproto.Feature{
+		Id:            "feature-id",
+		Name:          "test feature",
+		Version:       1,
+		Enabled:       true,
+		CreatedAt:     time.Now().Unix(),
+		VariationType: feature.Feature_STRING,
+		Variations: []*ftproto.Variation{
+			{Id: "variation-A", Value: "A", Name: "Baseline"},
+			{Id: "variation-B", Value: "B", Name: "Treatment"},
+		},
+		Targets: []*ftproto.Target{
+			{Variation: "variation-B", Users: []string{"user-5"}},
+		},
+		DefaultStrategy: &ftproto.Strategy{
+			Type:          ftproto.Strategy_FIXED,
+			FixedStrategy: &ftproto.FixedStrategy{Variation: "variation-B"},
+		},
+		// Same allocation as exp-search in experiment_allocation_conformance.json.
+		ExperimentAllocation: &ftproto.ExperimentAllocation{
+			ExperimentId:        "exp-search",
+			LayerId:             "layer-search",
+			TrafficStart:        25000,
+			TrafficEnd:          75000,
+			BaselineVariationId: "variation-A",
+			HoldoutWeight:       20000,
+			HoldoutId:           "holdout-2026",
+		},
+	}
+	patterns := []struct {
+		desc              string
+		userID            string
+		expectedVariation string
+		expectedReason    ftproto.Reason_Type
+	}{
+		{
+			desc:              "holdout user gets the baseline",
+			userID:            "user-1",
+			expectedVariation: "variation-A",
+			expectedReason:    ftproto.Reason_EXPERIMENT_HOLDOUT,
+		},
+		{
+			desc:              "user outside the slice gets the baseline",
+			userID:            "user-4",
+			expectedVariation: "variation-A",
+			expectedReason:    ftproto.Reason_EXPERIMENT_EXCLUDED,
+		},
+		{
+			desc:              "user in the slice is evaluated as usual",
+			userID:            "user-2",
+			expectedVariation: "variation-B",
+			expectedReason:    ftproto.Reason_DEFAULT,
+		},
+		{
+			desc:              "individual targeting takes precedence",
+			userID:            "user-5",
+			expectedVariation: "variation-B",
+			expectedReason:    ftproto.Reason_TARGET,
+		},
+	}
+	evaluator := NewEvaluator()
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			evaluation, err := evaluator.EvaluateFeatures(
+				[]*ftproto.Feature{f}, &userproto.User{Id: p.userID}, nil, nil, "",
+			)
+			assert.NoError(t, err)
+			actual, err := findEvaluation(evaluation.Evaluations, f.Id)
+			assert.NoError(t, err)
+			assert.Equal(t, p.expectedVariation, actual.VariationId)
+			assert.Equal(t, p.expectedReason, actual.Reason.Type)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"fmt"
+
+	ftproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+// allocationTotalWeight is the size of the layer hash space. It matches the
+// total weight of the rollout strategy.
+const allocationTotalWeight = 100000
+
+type allocationEvaluator struct {
+}
+
+// Evaluate returns the reason for excluding the user from the experiment the
+// allocation belongs to, or false when the user takes part in it.
+// Holdout users are checked first so they are excluded from every experiment,
+// whatever slice of the layer they hash into.
+func (e *allocationEvaluator) Evaluate(
+	allocation *ftproto.ExperimentAllocation,
+	userID string,
+) (ftproto.Reason_Type, bool) {
+	if allocation.HoldoutWeight > 0 {
+		input := fmt.Sprintf("holdout-%s-%s", allocation.HoldoutId, userID)
+		if e.position(input) < allocation.HoldoutWeight {
+			return ftproto.Reason_EXPERIMENT_HOLDOUT, true
+		}
+	}
+	if allocation.LayerId != "" {
+		position := e.position(fmt.Sprintf("layer-%s-%s", allocation.LayerId, userID))
+		if position < allocation.TrafficStart || position >= allocation.TrafficEnd {
+			return ftproto.Reason_EXPERIMENT_EXCLUDED, true
+		}
+	}
+	return 0, false
+}
+
+// position maps the input to [0, allocationTotalWeight).
+func (e *allocationEvaluator) position(input string) int32 {
+	b := bucketeer{}
+	p := int32(b.bucket(input) * allocationTotalWeight)
+	if p >= allocationTotalWeight {
+		return allocationTotalWeight - 1
+	}
+	return p
+}
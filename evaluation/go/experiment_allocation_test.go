@@ -0,0 +1,105 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ftproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+// The conformance fixtures are shared with evaluation/typescript so the two
+// engines stay in lockstep. See evaluation/testdata/experiment_allocation_conformance.json.
+const allocationConformanceFixturePath = "../testdata/experiment_allocation_conformance.json"
+
+type allocationConformanceTestCase struct {
+	Desc           string `json:"desc"`
+	ExperimentID   string `json:"experimentId"`
+	UserID         string `json:"userId"`
+	ExpectedReason string `json:"expectedReason"`
+}
+
+type allocationConformanceFixture struct {
+	Allocations []struct {
+		ExperimentID  string `json:"experimentId"`
+		LayerID       string `json:"layerId"`
+		TrafficStart  int32  `json:"trafficStart"`
+		TrafficEnd    int32  `json:"trafficEnd"`
+		HoldoutWeight int32  `json:"holdoutWeight"`
+		HoldoutID     string `json:"holdoutId"`
+	} `json:"allocations"`
+	TestCases []allocationConformanceTestCase `json:"testCases"`
+}
+
+func TestExperimentAllocationConformance(t *testing.T) {
+	t.Parallel()
+	data, err := os.ReadFile(filepath.Clean(allocationConformanceFixturePath))
+	require.NoError(t, err)
+	fixture := &allocationConformanceFixture{}
+	require.NoError(t, json.Unmarshal(data, fixture))
+	allocations := make(map[string]*ftproto.ExperimentAllocation, len(fixture.Allocations))
+	for _, a := range fixture.Allocations {
+		allocations[a.ExperimentID] = &ftproto.ExperimentAllocation{
+			ExperimentId:  a.ExperimentID,
+			LayerId:       a.LayerID,
+			TrafficStart:  a.TrafficStart,
+			TrafficEnd:    a.TrafficEnd,
+			HoldoutWeight: a.HoldoutWeight,
+			HoldoutId:     a.HoldoutID,
+		}
+	}
+	evaluator := &allocationEvaluator{}
+	for _, tc := range fixture.TestCases {
+		t.Run(tc.Desc, func(t *testing.T) {
+			t.Parallel()
+			allocation, ok := allocations[tc.ExperimentID]
+			require.True(t, ok, "unknown experiment: %s", tc.ExperimentID)
+			reason, excluded := evaluator.Evaluate(allocation, tc.UserID)
+			if tc.ExpectedReason == "" {
+				assert.False(t, excluded)
+				return
+			}
+			assert.True(t, excluded)
+			assert.Equal(t, tc.ExpectedReason, reason.String())
+		})
+	}
+}
+
+func TestExperimentAllocationSlicesAreExclusive(t *testing.T) {
+	t.Parallel()
+	slices := []*ftproto.ExperimentAllocation{
+		{LayerId: "layer", TrafficStart: 0, TrafficEnd: 30000},
+		{LayerId: "layer", TrafficStart: 30000, TrafficEnd: 60000},
+		{LayerId: "layer", TrafficStart: 60000, TrafficEnd: 100000},
+	}
+	evaluator := &allocationEvaluator{}
+	for i := 0; i < 1000; i++ {
+		userID := fmt.Sprintf("user-%d", i)
+		in := 0
+		for _, s := range slices {
+			if _, excluded := evaluator.Evaluate(s, userID); !excluded {
+				in++
+			}
+		}
+		assert.Equal(t, 1, in, userID)
+	}
+}
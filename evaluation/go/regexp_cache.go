@@ -0,0 +1,82 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"container/list"
+	"regexp"
+	"sync"
+)
+
+// regexpCacheSize bounds the number of compiled MATCHES_REGEX patterns kept in memory.
+const regexpCacheSize = 1024
+
+// regexpCache is a size-bounded LRU cache of compiled patterns.
+// Invalid and non-portable patterns are cached as nil so they are not recompiled on every call.
+type regexpCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List // front is the most recently used
+	entries map[string]*list.Element
+}
+
+type regexpCacheEntry struct {
+	pattern string
+	re      *regexp.Regexp
+}
+
+func newRegexpCache(size int) *regexpCache {
+	return &regexpCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element, size),
+	}
+}
+
+func (c *regexpCache) get(pattern string) *regexp.Regexp {
+	c.mu.Lock()
+	if elem, ok := c.entries[pattern]; ok {
+		c.order.MoveToFront(elem)
+		c.mu.Unlock()
+		return elem.Value.(*regexpCacheEntry).re
+	}
+	c.mu.Unlock()
+
+	// Compile outside the lock so a slow pattern does not block other evaluations.
+	re, err := CompileRegex(pattern)
+	if err != nil {
+		re = nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[pattern]; ok {
+		c.order.MoveToFront(elem)
+		return elem.Value.(*regexpCacheEntry).re
+	}
+	c.entries[pattern] = c.order.PushFront(&regexpCacheEntry{pattern: pattern, re: re})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*regexpCacheEntry).pattern)
+	}
+	return re
+}
+
+func (c *regexpCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegexpCache(t *testing.T) {
+	t.Parallel()
+	cache := newRegexpCache(2)
+
+	re := cache.get("^a")
+	assert.NotNil(t, re)
+	assert.Same(t, re, cache.get("^a"))
+	assert.Nil(t, cache.get("("))
+	assert.Equal(t, 2, cache.len())
+
+	// "(" is the least recently used entry, so it is evicted first.
+	cache.get("^a")
+	cache.get("^b")
+	assert.Equal(t, 2, cache.len())
+	assert.Contains(t, cache.entries, "^a")
+	assert.Contains(t, cache.entries, "^b")
+	assert.NotContains(t, cache.entries, "(")
+
+	for i := range 10 {
+		cache.get(fmt.Sprintf("^%d", i))
+	}
+	assert.Equal(t, 2, cache.len())
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// portableEscapes are the characters that may follow a backslash.
+// They mean the same in RE2 and in ECMAScript regular expressions.
+const portableEscapes = `dDwWsSbBnrtfv\.+*?()|[]{}^$-/`
+
+var ErrNonPortableRegex = errors.New("evaluator: regular expression syntax is not supported by every SDK")
+
+// CompileRegex compiles a MATCHES_REGEX pattern.
+// Patterns are evaluated by RE2 here and by ECMAScript RegExp in the JavaScript SDKs,
+// so only the syntax both engines read the same way is accepted. This rejects
+// inline flags such as (?i), named groups, \A, \z, \Q...\E, \p{...}, \x{...},
+// octal escapes, POSIX classes and classes starting with ']'.
+func CompileRegex(pattern string) (*regexp.Regexp, error) {
+	if err := checkPortableRegex(pattern); err != nil {
+		return nil, err
+	}
+	return regexp.Compile(pattern)
+}
+
+func checkPortableRegex(pattern string) error {
+	inClass := false
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			if i+1 == len(pattern) {
+				return ErrNonPortableRegex
+			}
+			next := pattern[i+1]
+			switch {
+			case strings.IndexByte(portableEscapes, next) >= 0:
+				i++
+			case next == 'x' && i+3 < len(pattern) && isHexDigit(pattern[i+2]) && isHexDigit(pattern[i+3]):
+				i += 3
+			default:
+				return ErrNonPortableRegex
+			}
+		case '[':
+			if inClass {
+				if strings.HasPrefix(pattern[i+1:], ":") {
+					return ErrNonPortableRegex
+				}
+				continue
+			}
+			inClass = true
+			// An empty class never matches in ECMAScript, but RE2 reads the ']' as a literal.
+			rest := strings.TrimPrefix(pattern[i+1:], "^")
+			if strings.HasPrefix(rest, "]") {
+				return ErrNonPortableRegex
+			}
+			i += len(pattern[i+1:]) - len(rest)
+		case ']':
+			inClass = false
+		case '(':
+			if !inClass && strings.HasPrefix(pattern[i+1:], "?") {
+				if !strings.HasPrefix(pattern[i+1:], "?:") {
+					return ErrNonPortableRegex
+				}
+				i += 2
+			}
+		default:
+			// ECMAScript without the u flag reads characters outside the BMP as two code units.
+			if pattern[i] >= utf8.RuneSelf {
+				r, size := utf8.DecodeRuneInString(pattern[i:])
+				if r > 0xFFFF {
+					return ErrNonPortableRegex
+				}
+				i += size - 1
+			}
+		}
+	}
+	return nil
+}
+
+func isHexDigit(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompileRegex(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		pattern     string
+		expectedErr error
+	}{
+		{pattern: `^user-[0-9]+$`},
+		{pattern: `@example\.com$`},
+		{pattern: `^(?:ios|android)\b`},
+		{pattern: `[^\]a-z]+\x41\/`},
+		{pattern: `\d{2,4}\s\w*`},
+		{pattern: `^日本`},
+		{pattern: `(?i)admin`, expectedErr: ErrNonPortableRegex},
+		{pattern: `(?i:admin)`, expectedErr: ErrNonPortableRegex},
+		{pattern: `(?P<id>[0-9]+)`, expectedErr: ErrNonPortableRegex},
+		{pattern: `(?<id>[0-9]+)`, expectedErr: ErrNonPortableRegex},
+		{pattern: `\Aabc\z`, expectedErr: ErrNonPortableRegex},
+		{pattern: `\Qa.b\E`, expectedErr: ErrNonPortableRegex},
+		{pattern: `\pL`, expectedErr: ErrNonPortableRegex},
+		{pattern: `\x{41}`, expectedErr: ErrNonPortableRegex},
+		{pattern: `\101`, expectedErr: ErrNonPortableRegex},
+		{pattern: `[[:alpha:]]`, expectedErr: ErrNonPortableRegex},
+		{pattern: `[]a]`, expectedErr: ErrNonPortableRegex},
+		{pattern: `[^]a]`, expectedErr: ErrNonPortableRegex},
+		{pattern: `😀+`, expectedErr: ErrNonPortableRegex},
+		{pattern: `abc\`, expectedErr: ErrNonPortableRegex},
+	}
+	for _, p := range patterns {
+		t.Run(p.pattern, func(t *testing.T) {
+			t.Parallel()
+			re, err := CompileRegex(p.pattern)
+			assert.Equal(t, p.expectedErr, err)
+			assert.Equal(t, p.expectedErr == nil, re != nil)
+		})
+	}
+	_, err := CompileRegex(`(unclosed`)
+	assert.Error(t, err)
+}
@@ -26,7 +26,7 @@ type ruleEvaluator struct {
 func (e *ruleEvaluator) Evaluate(
 	rules []*featureproto.Rule,
 	user *userproto.User,
-	segmentUsers []*featureproto.SegmentUser,
+	segmentUsers SegmentUserIndexes,
 	segments map[string]*featureproto.Segment,
 	flagVariations map[string]string,
 ) (*featureproto.Rule, error) {
@@ -45,7 +45,7 @@ func (e *ruleEvaluator) Evaluate(
 func (e *ruleEvaluator) evaluateRule(
 	rule *featureproto.Rule,
 	user *userproto.User,
-	segmentUsers []*featureproto.SegmentUser,
+	segmentUsers SegmentUserIndexes,
 	segments map[string]*featureproto.Segment,
 	flagVariations map[string]string,
 ) (bool, error) {
@@ -58,13 +58,74 @@ func (e *ruleEvaluator) evaluateRule(
 			return false, nil
 		}
 	}
+	for _, group := range rule.ClauseGroups {
+		matched, err := e.evaluateGroup(group, user, segmentUsers, segments, flagVariations)
+		if err != nil {
+			return false, err
+		}
+		if !matched {
+			return false, nil
+		}
+	}
 	return true, nil
 }
 
+// evaluateGroup combines the results of the group's clauses and nested
+// groups with its operator.
+func (e *ruleEvaluator) evaluateGroup(
+	group *featureproto.ClauseGroup,
+	user *userproto.User,
+	segmentUsers SegmentUserIndexes,
+	segments map[string]*featureproto.Segment,
+	flagVariations map[string]string,
+) (bool, error) {
+	matchAny := group.Operator == featureproto.ClauseGroup_OR
+	matched, err := e.evaluateGroupChildren(group, matchAny, user, segmentUsers, segments, flagVariations)
+	if err != nil {
+		return false, err
+	}
+	if group.Operator == featureproto.ClauseGroup_NOT {
+		return !matched, nil
+	}
+	return matched, nil
+}
+
+// evaluateGroupChildren reports whether any child matches when matchAny is
+// true, or whether every child matches otherwise. It stops at the first
+// child that decides the result.
+func (e *ruleEvaluator) evaluateGroupChildren(
+	group *featureproto.ClauseGroup,
+	matchAny bool,
+	user *userproto.User,
+	segmentUsers SegmentUserIndexes,
+	segments map[string]*featureproto.Segment,
+	flagVariations map[string]string,
+) (bool, error) {
+	for _, clause := range group.Clauses {
+		matched, err := e.evaluateClause(clause, user, segmentUsers, segments, flagVariations)
+		if err != nil {
+			return false, err
+		}
+		if matched == matchAny {
+			return matchAny, nil
+		}
+	}
+	for _, g := range group.Groups {
+		matched, err := e.evaluateGroup(g, user, segmentUsers, segments, flagVariations)
+		if err != nil {
+			return false, err
+		}
+		if matched == matchAny {
+			return matchAny, nil
+		}
+	}
+	return !matchAny, nil
+}
+
 func (e *ruleEvaluator) evaluateClause(
 	clause *featureproto.Clause,
 	user *userproto.User,
-	segmentUsers []*featureproto.SegmentUser,
+	segmentUsers SegmentUserIndexes,
 	segments map[string]*featureproto.Segment,
 	flagVariations map[string]string,
 ) (bool, error) {
@@ -105,7 +105,7 @@ func TestRuleEvaluator(t *testing.T) {
 	ruleEvaluator := &ruleEvaluator{}
 	for i, tc := range testcases {
 		des := fmt.Sprintf("index: %d", i)
-		actual, _ := ruleEvaluator.Evaluate(f.Rules, tc.user, values, nil, nil)
+		actual, _ := ruleEvaluator.Evaluate(f.Rules, tc.user, newSegmentUserIndexes(values), nil, nil)
 		assert.Equal(t, tc.expected, actual, des)
 	}
 }
@@ -0,0 +1,83 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ftproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+	userproto "github.com/bucketeer-io/bucketeer/v2/proto/user"
+)
+
+// The conformance fixtures are shared with evaluation/typescript so the two
+// engines stay in lockstep. See evaluation/testdata/rule_groups_conformance.json.
+const ruleGroupsConformanceFixturePath = "../testdata/rule_groups_conformance.json"
+
+type conformanceClauseGroup struct {
+	Operator string                   `json:"operator"`
+	Clauses  []conformanceClause      `json:"clauses"`
+	Groups   []conformanceClauseGroup `json:"groups"`
+}
+
+type ruleGroupsConformanceTestCase struct {
+	Desc           string          `json:"desc"`
+	User           conformanceUser `json:"user"`
+	ExpectedRuleID string          `json:"expectedRuleId"`
+}
+
+type ruleGroupsConformanceFixture struct {
+	Rules     []conformanceRule               `json:"rules"`
+	TestCases []ruleGroupsConformanceTestCase `json:"testCases"`
+}
+
+func TestRuleGroupsConformance(t *testing.T) {
+	t.Parallel()
+	data, err := os.ReadFile(filepath.Clean(ruleGroupsConformanceFixturePath))
+	require.NoError(t, err)
+	fixture := &ruleGroupsConformanceFixture{}
+	require.NoError(t, json.Unmarshal(data, fixture))
+	rules := toProtoRules(t, fixture.Rules)
+	evaluator := &ruleEvaluator{}
+	for _, tc := range fixture.TestCases {
+		t.Run(tc.Desc, func(t *testing.T) {
+			t.Parallel()
+			user := &userproto.User{Id: tc.User.ID, Data: tc.User.Data}
+			rule, err := evaluator.Evaluate(rules, user, nil, nil, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedRuleID, rule.GetId())
+		})
+	}
+}
+
+func toProtoClauseGroups(t *testing.T, groups []conformanceClauseGroup) []*ftproto.ClauseGroup {
+	t.Helper()
+	protoGroups := make([]*ftproto.ClauseGroup, 0, len(groups))
+	for _, g := range groups {
+		operator, ok := ftproto.ClauseGroup_Operator_value[g.Operator]
+		require.True(t, ok, "unknown clause group operator: %s", g.Operator)
+		protoGroups = append(protoGroups, &ftproto.ClauseGroup{
+			Operator: ftproto.ClauseGroup_Operator(operator),
+			Clauses:  toProtoClauses(t, g.Clauses),
+			Groups:   toProtoClauseGroups(t, g.Groups),
+		})
+	}
+	return protoGroups
+}
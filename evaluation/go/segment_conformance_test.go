@@ -39,8 +39,10 @@ type conformanceClause struct {
 }
 
 type conformanceRule struct {
-	ID      string              `json:"id"`
-	Clauses []conformanceClause `json:"clauses"`
+	ID           string                   `json:"id"`
+	Name         string                   `json:"name"`
+	Clauses      []conformanceClause      `json:"clauses"`
+	ClauseGroups []conformanceClauseGroup `json:"clauseGroups"`
 }
 
 type conformanceSegment struct {
@@ -92,13 +94,14 @@ func TestSegmentRulesConformance(t *testing.T) {
 			State:     ftproto.SegmentUser_State(state),
 		})
 	}
+	segmentUserIndexes := newSegmentUserIndexes(segmentUsers)
 	evaluator := &segmentEvaluator{}
 	for _, tc := range fixture.TestCases {
 		tc := tc
 		t.Run(tc.Desc, func(t *testing.T) {
 			t.Parallel()
 			user := &userproto.User{Id: tc.User.ID, Data: tc.User.Data}
-			actual, err := evaluator.Evaluate(tc.SegmentIDs, user, segments, segmentUsers)
+			actual, err := evaluator.Evaluate(tc.SegmentIDs, user, segments, segmentUserIndexes)
 			assert.NoError(t, err)
 			assert.Equal(t, tc.Expected, actual)
 		})
@@ -118,21 +121,28 @@ func toProtoRules(t *testing.T, rules []conformanceRule) []*ftproto.Rule {
 	t.Helper()
 	protoRules := make([]*ftproto.Rule, 0, len(rules))
 	for _, r := range rules {
-		clauses := make([]*ftproto.Clause, 0, len(r.Clauses))
-		for _, c := range r.Clauses {
-			operator, ok := ftproto.Clause_Operator_value[c.Operator]
-			require.True(t, ok, "unknown clause operator: %s", c.Operator)
-			clauses = append(clauses, &ftproto.Clause{
-				Id:        c.ID,
-				Attribute: c.Attribute,
-				Operator:  ftproto.Clause_Operator(operator),
-				Values:    c.Values,
-			})
-		}
 		protoRules = append(protoRules, &ftproto.Rule{
-			Id:      r.ID,
-			Clauses: clauses,
+			Id:           r.ID,
+			Name:         r.Name,
+			Clauses:      toProtoClauses(t, r.Clauses),
+			ClauseGroups: toProtoClauseGroups(t, r.ClauseGroups),
 		})
 	}
 	return protoRules
 }
+
+func toProtoClauses(t *testing.T, clauses []conformanceClause) []*ftproto.Clause {
+	t.Helper()
+	protoClauses := make([]*ftproto.Clause, 0, len(clauses))
+	for _, c := range clauses {
+		operator, ok := ftproto.Clause_Operator_value[c.Operator]
+		require.True(t, ok, "unknown clause operator: %s", c.Operator)
+		protoClauses = append(protoClauses, &ftproto.Clause{
+			Id:        c.ID,
+			Attribute: c.Attribute,
+			Operator:  ftproto.Clause_Operator(operator),
+			Values:    c.Values,
+		})
+	}
+	return protoClauses
+}
@@ -27,7 +27,7 @@ func (e *segmentEvaluator) Evaluate(
 	segmentIDs []string,
 	user *userproto.User,
 	segments map[string]*featureproto.Segment,
-	segmentUsers []*featureproto.SegmentUser,
+	segmentUsers SegmentUserIndexes,
 ) (bool, error) {
 	for _, segmentID := range segmentIDs {
 		inSegment, err := e.isUserInSegment(segmentID, user, segments[segmentID], segmentUsers)
@@ -47,10 +47,10 @@ func (e *segmentEvaluator) isUserInSegment(
 	segmentID string,
 	user *userproto.User,
 	segment *featureproto.Segment,
-	segmentUsers []*featureproto.SegmentUser,
+	segmentUsers SegmentUserIndexes,
 ) (bool, error) {
 	// 1. Explicit include list — existing behavior, unchanged.
-	if e.containsSegmentUser(segmentID, user.Id, featureproto.SegmentUser_INCLUDED, segmentUsers) {
+	if segmentUsers.contains(segmentID, user.Id) {
 		return true, nil
 	}
 	// 2. Rules. Segment.Rules is []*featureproto.Rule — the same type as Feature.Rules —
@@ -69,23 +69,3 @@ func (e *segmentEvaluator) isUserInSegment(
 	}
 	return matchedRule != nil, nil
 }
-
-func (e *segmentEvaluator) containsSegmentUser(
-	segmentID, userID string,
-	state featureproto.SegmentUser_State,
-	segmentUsers []*featureproto.SegmentUser,
-) bool {
-	for _, user := range segmentUsers {
-		if user.SegmentId != segmentID {
-			continue
-		}
-		if user.UserId != userID {
-			continue
-		}
-		if user.State != state {
-			continue
-		}
-		return true
-	}
-	return false
-}
@@ -0,0 +1,156 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"github.com/spaolacci/murmur3"
+
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+const (
+	// bloomFilterBitsPerUser and bloomFilterHashes give a false positive rate of about 1%.
+	bloomFilterBitsPerUser = 10
+	bloomFilterHashes      = 7
+)
+
+// SegmentUserIndex is the set of users included in a segment.
+// Build it once per segment version and share it between evaluations,
+// so the membership check of a SEGMENT clause doesn't depend on the segment size.
+// It is read-only once built, so it is safe for concurrent use.
+type SegmentUserIndex struct {
+	users map[string]struct{}
+	bloom *bloomFilter
+}
+
+// SegmentUserIndexes maps the segment IDs to their user indexes.
+type SegmentUserIndexes map[string]*SegmentUserIndex
+
+type segmentUserIndexOptions struct {
+	bloomFilterMinUsers int
+}
+
+type SegmentUserIndexOption func(*segmentUserIndexOptions)
+
+// WithBloomFilter puts a bloom filter in front of the user set of the segments
+// with at least minUsers users. Most of the evaluated users aren't in the segment,
+// and the filter rejects them without touching the large user set.
+// The set still answers when the filter matches, so the result is exact.
+func WithBloomFilter(minUsers int) SegmentUserIndexOption {
+	return func(o *segmentUserIndexOptions) {
+		o.bloomFilterMinUsers = minUsers
+	}
+}
+
+// NewSegmentUserIndex builds the index of the users included in the segment.
+// The users of other segments and the users not in the INCLUDED state are ignored.
+func NewSegmentUserIndex(
+	segmentID string,
+	segmentUsers []*featureproto.SegmentUser,
+	opts ...SegmentUserIndexOption,
+) *SegmentUserIndex {
+	var options segmentUserIndexOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	users := make(map[string]struct{}, len(segmentUsers))
+	for _, u := range segmentUsers {
+		if u.SegmentId != segmentID || u.State != featureproto.SegmentUser_INCLUDED {
+			continue
+		}
+		users[u.UserId] = struct{}{}
+	}
+	index := &SegmentUserIndex{users: users}
+	if options.bloomFilterMinUsers > 0 && len(users) >= options.bloomFilterMinUsers {
+		index.bloom = newBloomFilter(len(users))
+		for id := range users {
+			index.bloom.add(id)
+		}
+	}
+	return index
+}
+
+// NewSegmentUserIndexes builds the indexes of the given segment users, keyed by segment ID.
+func NewSegmentUserIndexes(
+	mapSegmentUsers map[string][]*featureproto.SegmentUser,
+	opts ...SegmentUserIndexOption,
+) SegmentUserIndexes {
+	indexes := make(SegmentUserIndexes, len(mapSegmentUsers))
+	for segmentID, segmentUsers := range mapSegmentUsers {
+		indexes[segmentID] = NewSegmentUserIndex(segmentID, segmentUsers, opts...)
+	}
+	return indexes
+}
+
+// Contains reports whether the user is included in the segment.
+func (i *SegmentUserIndex) Contains(userID string) bool {
+	if i == nil {
+		return false
+	}
+	if i.bloom != nil && !i.bloom.mayContain(userID) {
+		return false
+	}
+	_, ok := i.users[userID]
+	return ok
+}
+
+// Len returns the number of users included in the segment.
+func (i *SegmentUserIndex) Len() int {
+	if i == nil {
+		return 0
+	}
+	return len(i.users)
+}
+
+// contains reports whether the user is included in the segment.
+// A missing segment has no users.
+func (i SegmentUserIndexes) contains(segmentID, userID string) bool {
+	return i[segmentID].Contains(userID)
+}
+
+// bloomFilter derives its hashes from the two halves of a 128-bit murmur3 hash
+// (Kirsch-Mitzenmacher double hashing).
+type bloomFilter struct {
+	bits []uint64
+	size uint64
+}
+
+func newBloomFilter(n int) *bloomFilter {
+	size := uint64(n) * bloomFilterBitsPerUser
+	words := (size + 63) / 64
+	return &bloomFilter{
+		bits: make([]uint64, words),
+		size: words * 64,
+	}
+}
+
+func (f *bloomFilter) add(value string) {
+	h1, h2 := murmur3.Sum128([]byte(value))
+	for i := uint64(0); i < bloomFilterHashes; i++ {
+		bit := (h1 + i*h2) % f.size
+		f.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+func (f *bloomFilter) mayContain(value string) bool {
+	h1, h2 := murmur3.Sum128([]byte(value))
+	for i := uint64(0); i < bloomFilterHashes; i++ {
+		bit := (h1 + i*h2) % f.size
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
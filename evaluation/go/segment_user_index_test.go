@@ -0,0 +1,223 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	ftproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+	userproto "github.com/bucketeer-io/bucketeer/v2/proto/user"
+)
+
+func TestSegmentUserIndexContains(t *testing.T) {
+	t.Parallel()
+	segmentUsers := []*ftproto.SegmentUser{
+		{SegmentId: "segment-id-1", UserId: "user-id-1", State: ftproto.SegmentUser_INCLUDED},
+		{SegmentId: "segment-id-1", UserId: "user-id-2", State: ftproto.SegmentUser_INCLUDED},
+		{SegmentId: "segment-id-1", UserId: "user-id-3", State: ftproto.SegmentUser_EXCLUDED},
+		{SegmentId: "segment-id-2", UserId: "user-id-4", State: ftproto.SegmentUser_INCLUDED},
+	}
+	bloomFilter := []SegmentUserIndexOption{WithBloomFilter(1)}
+	patterns := []struct {
+		desc     string
+		opts     []SegmentUserIndexOption
+		userID   string
+		expected bool
+	}{
+		{desc: "included", userID: "user-id-1", expected: true},
+		{desc: "excluded state", userID: "user-id-3", expected: false},
+		{desc: "other segment", userID: "user-id-4", expected: false},
+		{desc: "unknown user", userID: "user-id-5", expected: false},
+		{desc: "bloom filter: included", opts: bloomFilter, userID: "user-id-2", expected: true},
+		{desc: "bloom filter: unknown user", opts: bloomFilter, userID: "user-id-5", expected: false},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			index := NewSegmentUserIndex("segment-id-1", segmentUsers, p.opts...)
+			assert.Equal(t, 2, index.Len())
+			assert.Equal(t, p.expected, index.Contains(p.userID))
+		})
+	}
+}
+
+func TestSegmentUserIndexesContains(t *testing.T) {
+	t.Parallel()
+	indexes := newSegmentUserIndexes(newSegmentUserIDs())
+	assert.True(t, indexes.contains("segment-id-1", "user-id-3"))
+	assert.False(t, indexes.contains("segment-id-2", "user-id-3"))
+	assert.False(t, indexes.contains("segment-id-3", "user-id-1"))
+	var nilIndexes SegmentUserIndexes
+	assert.False(t, nilIndexes.contains("segment-id-1", "user-id-1"))
+}
+
+func TestBloomFilter(t *testing.T) {
+	t.Parallel()
+	const users = 10000
+	f := newBloomFilter(users)
+	for i := 0; i < users; i++ {
+		f.add(fmt.Sprintf("user-%d", i))
+	}
+	for i := 0; i < users; i++ {
+		assert.True(t, f.mayContain(fmt.Sprintf("user-%d", i)))
+	}
+	falsePositives := 0
+	for i := 0; i < users; i++ {
+		if f.mayContain(fmt.Sprintf("other-user-%d", i)) {
+			falsePositives++
+		}
+	}
+	assert.Less(t, float64(falsePositives)/users, 0.02)
+}
+
+// newSegmentUserIndexes groups the segment users by segment ID and indexes them.
+func newSegmentUserIndexes(segmentUsers []*ftproto.SegmentUser) SegmentUserIndexes {
+	mapSegmentUsers := make(map[string][]*ftproto.SegmentUser)
+	for _, u := range segmentUsers {
+		mapSegmentUsers[u.SegmentId] = append(mapSegmentUsers[u.SegmentId], u)
+	}
+	return NewSegmentUserIndexes(mapSegmentUsers)
+}
+
+const (
+	benchmarkSegments     = 10
+	benchmarkSegmentUsers = 1000000
+)
+
+type segmentBenchmarkFixture struct {
+	features        []*ftproto.Feature
+	mapSegmentUsers map[string][]*ftproto.SegmentUser
+	segments        map[string]*ftproto.Segment
+}
+
+var (
+	segmentBenchmarkOnce sync.Once
+	segmentBenchmark     *segmentBenchmarkFixture
+)
+
+// newSegmentBenchmarkFixture builds one flag per segment, each targeting
+// the segment with a SEGMENT clause, and 10 segments of 1M users.
+// It is shared by the benchmarks because it takes about 2GB of memory.
+func newSegmentBenchmarkFixture(b *testing.B) *segmentBenchmarkFixture {
+	b.Helper()
+	if testing.Short() {
+		b.Skip("skipping the segment benchmarks in short mode")
+	}
+	segmentBenchmarkOnce.Do(func() {
+		fixture := &segmentBenchmarkFixture{
+			mapSegmentUsers: make(map[string][]*ftproto.SegmentUser, benchmarkSegments),
+			segments:        make(map[string]*ftproto.Segment, benchmarkSegments),
+		}
+		for i := 0; i < benchmarkSegments; i++ {
+			segmentID := fmt.Sprintf("segment-%d", i)
+			users := make([]*ftproto.SegmentUser, 0, benchmarkSegmentUsers)
+			for j := 0; j < benchmarkSegmentUsers; j++ {
+				users = append(users, &ftproto.SegmentUser{
+					SegmentId: segmentID,
+					UserId:    fmt.Sprintf("user-%d-%d", i, j),
+					State:     ftproto.SegmentUser_INCLUDED,
+				})
+			}
+			fixture.mapSegmentUsers[segmentID] = users
+			fixture.segments[segmentID] = &ftproto.Segment{Id: segmentID}
+			f := makeFeature(fmt.Sprintf("feature-%d", i))
+			f.Rules = []*ftproto.Rule{{
+				Id: "rule-id",
+				Strategy: &ftproto.Strategy{
+					Type:          ftproto.Strategy_FIXED,
+					FixedStrategy: &ftproto.FixedStrategy{Variation: f.Variations[0].Id},
+				},
+				Clauses: []*ftproto.Clause{{
+					Id:       "clause-id",
+					Operator: ftproto.Clause_SEGMENT,
+					Values:   []string{segmentID},
+				}},
+			}}
+			fixture.features = append(fixture.features, f)
+		}
+		segmentBenchmark = fixture
+	})
+	return segmentBenchmark
+}
+
+// BenchmarkEvaluateFeaturesWithSegmentUserIndexes is the per-request cost
+// when the gateway reuses the indexes built once per segment version.
+func BenchmarkEvaluateFeaturesWithSegmentUserIndexes(b *testing.B) {
+	fixture := newSegmentBenchmarkFixture(b)
+	benchmarks := []struct {
+		desc string
+		opts []SegmentUserIndexOption
+	}{
+		{desc: "hash set"},
+		{desc: "bloom filter", opts: []SegmentUserIndexOption{WithBloomFilter(benchmarkSegmentUsers)}},
+	}
+	for _, bm := range benchmarks {
+		indexes := NewSegmentUserIndexes(fixture.mapSegmentUsers, bm.opts...)
+		evaluator := NewEvaluator()
+		b.Run(bm.desc, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				// Half of the users belong to a segment.
+				user := &userproto.User{Id: fmt.Sprintf("user-%d-%d", i%(2*benchmarkSegments), i)}
+				if _, err := evaluator.EvaluateFeaturesWithSegmentUserIndexes(
+					fixture.features, user, indexes, fixture.segments, "",
+				); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkSegmentUserLinearScan is the per-request cost of the SEGMENT clauses
+// before the indexes: a scan of the users of every segment.
+func BenchmarkSegmentUserLinearScan(b *testing.B) {
+	fixture := newSegmentBenchmarkFixture(b)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		userID := fmt.Sprintf("user-%d-%d", i%(2*benchmarkSegments), i)
+		for segmentID, users := range fixture.mapSegmentUsers {
+			for _, u := range users {
+				if u.SegmentId == segmentID && u.UserId == userID && u.State == ftproto.SegmentUser_INCLUDED {
+					break
+				}
+			}
+		}
+	}
+}
+
+// BenchmarkNewSegmentUserIndex is the cost paid once per segment version.
+func BenchmarkNewSegmentUserIndex(b *testing.B) {
+	fixture := newSegmentBenchmarkFixture(b)
+	users := fixture.mapSegmentUsers["segment-0"]
+	benchmarks := []struct {
+		desc string
+		opts []SegmentUserIndexOption
+	}{
+		{desc: "hash set"},
+		{desc: "bloom filter", opts: []SegmentUserIndexOption{WithBloomFilter(benchmarkSegmentUsers)}},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.desc, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				NewSegmentUserIndex("segment-0", users, bm.opts...)
+			}
+		})
+	}
+}
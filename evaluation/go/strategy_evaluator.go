@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	"github.com/bucketeer-io/bucketeer/v2/proto/feature"
+	userproto "github.com/bucketeer-io/bucketeer/v2/proto/user"
 )
 
 type strategyEvaluator struct {
@@ -25,7 +26,7 @@ type strategyEvaluator struct {
 
 func (e *strategyEvaluator) Evaluate(
 	strategy *feature.Strategy,
-	userID string,
+	user *userproto.User,
 	variations []*feature.Variation,
 	featureID string,
 	samplingSeed string,
@@ -34,7 +35,7 @@ func (e *strategyEvaluator) Evaluate(
 	case feature.Strategy_FIXED:
 		return findVariation(strategy.FixedStrategy.Variation, variations)
 	case feature.Strategy_ROLLOUT:
-		variationID, err := e.rollout(strategy.RolloutStrategy, featureID, userID, samplingSeed)
+		variationID, err := e.rollout(strategy.RolloutStrategy, featureID, user, samplingSeed)
 		if err != nil {
 			return nil, err
 		}
@@ -45,15 +46,25 @@ func (e *strategyEvaluator) Evaluate(
 
 func (e *strategyEvaluator) rollout(
 	strategy *feature.RolloutStrategy,
-	featureID, userID, samplingSeed string,
+	featureID string,
+	user *userproto.User,
+	samplingSeed string,
 ) (string, error) {
 	b := bucketeer{}
 
 	if strategy.GetAudience() != nil {
 		audience := strategy.GetAudience()
 		if audience.GetPercentage() > 0 && audience.GetPercentage() < 100 {
+			bucketBy := audience.GetBucketBy()
+			if bucketBy == "" {
+				bucketBy = strategy.GetBucketBy()
+			}
+			key, ok := bucketKey(user, bucketBy)
+			if !ok && strategy.GetBucketByFallbackVariation() != "" {
+				return strategy.GetBucketByFallbackVariation(), nil
+			}
 			// Use different hash input for traffic control to ensure independence from A/B split
-			trafficInput := fmt.Sprintf("traffic-%s-%s-%s", featureID, userID, samplingSeed)
+			trafficInput := fmt.Sprintf("traffic-%s-%s-%s", featureID, key, samplingSeed)
 			trafficBucket := b.bucket(trafficInput)
 			trafficThreshold := float64(audience.GetPercentage()) / 100.0
 
@@ -67,7 +78,11 @@ func (e *strategyEvaluator) rollout(
 		}
 	}
 
-	bucket := b.bucket(fmt.Sprintf("%s-%s-%s", featureID, userID, samplingSeed))
+	key, ok := bucketKey(user, strategy.GetBucketBy())
+	if !ok && strategy.GetBucketByFallbackVariation() != "" {
+		return strategy.GetBucketByFallbackVariation(), nil
+	}
+	bucket := b.bucket(fmt.Sprintf("%s-%s-%s", featureID, key, samplingSeed))
 	// Iterate through the variant and increment the threshold by the percentage of each variant.
 	// return the first variant where the bucket is smaller than the threshold.
 	rangeEnd := 0.0
@@ -79,3 +94,17 @@ func (e *strategyEvaluator) rollout(
 	}
 	return "", ErrVariationNotFound
 }
+
+// bucketKey returns the value hashed to place the user in a bucket.
+// When bucketBy names a user attribute, its value is used so every user
+// sharing it lands in the same bucket. The second return value is false
+// when the attribute is missing, in which case the user ID is returned.
+func bucketKey(user *userproto.User, bucketBy string) (string, bool) {
+	if bucketBy == "" {
+		return user.GetId(), true
+	}
+	if v, ok := user.GetData()[bucketBy]; ok && v != "" {
+		return v, true
+	}
+	return user.GetId(), false
+}
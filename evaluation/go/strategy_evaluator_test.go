@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/bucketeer-io/bucketeer/v2/proto/feature"
+	userproto "github.com/bucketeer-io/bucketeer/v2/proto/user"
 )
 
 func TestStrategyEvaluator_Evaluate_Fixed(t *testing.T) {
@@ -58,7 +59,7 @@ func TestStrategyEvaluator_Evaluate_Fixed(t *testing.T) {
 	evaluator := &strategyEvaluator{}
 	for _, p := range patterns {
 		t.Run(p.desc, func(t *testing.T) {
-			result, err := evaluator.Evaluate(p.strategy, p.userID, p.variations, p.featureID, p.seed)
+			result, err := evaluator.Evaluate(p.strategy, &userproto.User{Id: p.userID}, p.variations, p.featureID, p.seed)
 			assert.Equal(t, p.expectedErr, err)
 			if err == nil {
 				assert.Equal(t, p.expected, result.Id)
@@ -107,7 +108,7 @@ func TestStrategyEvaluator_Evaluate_Rollout_NoAudience(t *testing.T) {
 	evaluator := &strategyEvaluator{}
 	for _, p := range patterns {
 		t.Run(p.desc, func(t *testing.T) {
-			result, err := evaluator.Evaluate(p.strategy, p.userID, p.variations, p.featureID, p.seed)
+			result, err := evaluator.Evaluate(p.strategy, &userproto.User{Id: p.userID}, p.variations, p.featureID, p.seed)
 			assert.Equal(t, p.expectedErr, err)
 			if err == nil {
 				assert.Contains(t, p.expectedIDs, result.Id)
@@ -146,7 +147,7 @@ func TestStrategyEvaluator_Evaluate_Rollout_WithAudience(t *testing.T) {
 
 	for i := range totalUsers {
 		userID := fmt.Sprintf("user-%d", i)
-		result, err := evaluator.Evaluate(strategy, userID, variations, "feature-1", "seed")
+		result, err := evaluator.Evaluate(strategy, &userproto.User{Id: userID}, variations, "feature-1", "seed")
 		assert.NoError(t, err)
 
 		if result.Id == "variation-default" {
@@ -196,7 +197,7 @@ func TestStrategyEvaluator_Evaluate_Rollout_Audience_NoDefaultVariation(t *testi
 	foundExpectedError := false
 	for i := range 100 {
 		userID := fmt.Sprintf("user-%d", i)
-		_, err := evaluator.Evaluate(strategy, userID, variations, "feature-1", "seed")
+		_, err := evaluator.Evaluate(strategy, &userproto.User{Id: userID}, variations, "feature-1", "seed")
 
 		// We expect some users to get ErrVariationNotFound when they're outside traffic
 		// and no default variation is specified
@@ -261,7 +262,7 @@ func TestStrategyEvaluator_Evaluate_Rollout_FullAudience(t *testing.T) {
 			// With 100% audience, all users should be in experiment
 			for i := range 10 {
 				userID := fmt.Sprintf("user-%d", i)
-				result, err := evaluator.Evaluate(p.strategy, userID, p.variations, p.featureID, p.seed)
+				result, err := evaluator.Evaluate(p.strategy, &userproto.User{Id: userID}, p.variations, p.featureID, p.seed)
 				assert.Equal(t, p.expectedErr, err)
 
 				if err == nil {
@@ -275,3 +276,98 @@ func TestStrategyEvaluator_Evaluate_Rollout_FullAudience(t *testing.T) {
 		})
 	}
 }
+
+func TestStrategyEvaluator_Evaluate_Rollout_BucketBy(t *testing.T) {
+	t.Parallel()
+	variations := []*feature.Variation{
+		{Id: "variation-a", Value: "a"},
+		{Id: "variation-b", Value: "b"},
+		{Id: "variation-fallback", Value: "fallback"},
+	}
+	newStrategy := func(fallback string, audience *feature.Audience) *feature.Strategy {
+		return &feature.Strategy{
+			Type: feature.Strategy_ROLLOUT,
+			RolloutStrategy: &feature.RolloutStrategy{
+				Variations: []*feature.RolloutStrategy_Variation{
+					{Variation: "variation-a", Weight: 50000},
+					{Variation: "variation-b", Weight: 50000},
+				},
+				Audience:                  audience,
+				BucketBy:                  "company",
+				BucketByFallbackVariation: fallback,
+			},
+		}
+	}
+	evaluator := &strategyEvaluator{}
+
+	t.Run("users sharing the attribute get the same variation", func(t *testing.T) {
+		t.Parallel()
+		strategy := newStrategy("", nil)
+		for c := range 20 {
+			company := fmt.Sprintf("company-%d", c)
+			first, err := evaluator.Evaluate(strategy, &userproto.User{
+				Id:   "user-0",
+				Data: map[string]string{"company": company},
+			}, variations, "feature-1", "seed")
+			assert.NoError(t, err)
+			for i := 1; i < 10; i++ {
+				result, err := evaluator.Evaluate(strategy, &userproto.User{
+					Id:   fmt.Sprintf("user-%d", i),
+					Data: map[string]string{"company": company},
+				}, variations, "feature-1", "seed")
+				assert.NoError(t, err)
+				assert.Equal(t, first.Id, result.Id)
+			}
+		}
+	})
+
+	t.Run("missing attribute falls back to the user ID", func(t *testing.T) {
+		t.Parallel()
+		strategy := newStrategy("", nil)
+		user := &userproto.User{Id: "user-1"}
+		result, err := evaluator.Evaluate(strategy, user, variations, "feature-1", "seed")
+		assert.NoError(t, err)
+		withoutBucketBy := newStrategy("", nil)
+		withoutBucketBy.RolloutStrategy.BucketBy = ""
+		expected, err := evaluator.Evaluate(withoutBucketBy, user, variations, "feature-1", "seed")
+		assert.NoError(t, err)
+		assert.Equal(t, expected.Id, result.Id)
+	})
+
+	t.Run("missing attribute serves the fallback variation", func(t *testing.T) {
+		t.Parallel()
+		strategy := newStrategy("variation-fallback", nil)
+		for i := range 10 {
+			result, err := evaluator.Evaluate(strategy, &userproto.User{
+				Id:   fmt.Sprintf("user-%d", i),
+				Data: map[string]string{"other": "value"},
+			}, variations, "feature-1", "seed")
+			assert.NoError(t, err)
+			assert.Equal(t, "variation-fallback", result.Id)
+		}
+	})
+
+	t.Run("audience traffic control buckets by the attribute", func(t *testing.T) {
+		t.Parallel()
+		strategy := newStrategy("", &feature.Audience{
+			Percentage:       50,
+			DefaultVariation: "variation-fallback",
+		})
+		for c := range 20 {
+			company := fmt.Sprintf("company-%d", c)
+			first, err := evaluator.Evaluate(strategy, &userproto.User{
+				Id:   "user-0",
+				Data: map[string]string{"company": company},
+			}, variations, "feature-1", "seed")
+			assert.NoError(t, err)
+			for i := 1; i < 10; i++ {
+				result, err := evaluator.Evaluate(strategy, &userproto.User{
+					Id:   fmt.Sprintf("user-%d", i),
+					Data: map[string]string{"company": company},
+				}, variations, "feature-1", "seed")
+				assert.NoError(t, err)
+				assert.Equal(t, first.Id, result.Id)
+			}
+		}
+	})
+}
@@ -0,0 +1,108 @@
+import test from 'ava';
+import * as fs from 'fs';
+import * as path from 'path';
+import { Audience, RolloutStrategy, Strategy } from '../proto/feature/strategy_pb';
+import { Variation } from '../proto/feature/variation_pb';
+import { StrategyEvaluator } from '../strategyEvaluator';
+import { createUser } from '../modelFactory';
+
+// The conformance fixtures are shared with evaluation/go so the two engines
+// place users in the same buckets. See evaluation/testdata/bucket_by_conformance.json.
+interface ConformanceAudience {
+  percentage: number;
+  defaultVariation: string;
+  bucketBy?: string;
+}
+
+interface ConformanceStrategy {
+  id: string;
+  bucketBy?: string;
+  bucketByFallbackVariation?: string;
+  audience?: ConformanceAudience;
+}
+
+interface ConformanceTestCase {
+  desc: string;
+  strategyId: string;
+  user: { id: string; data?: Record<string, string> };
+  expectedVariation: string;
+}
+
+interface ConformanceFixture {
+  featureId: string;
+  samplingSeed: string;
+  variations: { variation: string; weight: number }[];
+  strategies: ConformanceStrategy[];
+  testCases: ConformanceTestCase[];
+}
+
+function loadFixture(): ConformanceFixture {
+  // Compiled tests run from __test/__tests__, source runs from src/__tests__.
+  const candidates = [
+    path.join(__dirname, '../../../testdata/bucket_by_conformance.json'),
+    path.join(__dirname, '../../testdata/bucket_by_conformance.json'),
+  ];
+  for (const candidate of candidates) {
+    if (fs.existsSync(candidate)) {
+      return JSON.parse(fs.readFileSync(candidate, 'utf-8'));
+    }
+  }
+  throw new Error('bucket_by_conformance.json not found');
+}
+
+const fixture = loadFixture();
+
+// The variations are the rollout ones plus the fallback and default variations of the strategies.
+const variations: Variation[] = [];
+function addVariation(id: string | undefined) {
+  if (!id || variations.some((v) => v.getId() === id)) {
+    return;
+  }
+  const variation = new Variation();
+  variation.setId(id);
+  variation.setValue(id);
+  variations.push(variation);
+}
+fixture.variations.forEach((v) => addVariation(v.variation));
+
+const strategies = new Map<string, Strategy>();
+fixture.strategies.forEach((s) => {
+  const rollout = new RolloutStrategy();
+  rollout.setVariationsList(
+    fixture.variations.map((v) => {
+      const variation = new RolloutStrategy.Variation();
+      variation.setVariation(v.variation);
+      variation.setWeight(v.weight);
+      return variation;
+    }),
+  );
+  rollout.setBucketBy(s.bucketBy || '');
+  rollout.setBucketByFallbackVariation(s.bucketByFallbackVariation || '');
+  addVariation(s.bucketByFallbackVariation);
+  if (s.audience !== undefined) {
+    const audience = new Audience();
+    audience.setPercentage(s.audience.percentage);
+    audience.setDefaultVariation(s.audience.defaultVariation);
+    audience.setBucketBy(s.audience.bucketBy || '');
+    rollout.setAudience(audience);
+    addVariation(s.audience.defaultVariation);
+  }
+  const strategy = new Strategy();
+  strategy.setType(Strategy.Type.ROLLOUT);
+  strategy.setRolloutStrategy(rollout);
+  strategies.set(s.id, strategy);
+});
+
+fixture.testCases.forEach((tc) => {
+  test(`conformance: ${tc.desc}`, (t) => {
+    const strategy = strategies.get(tc.strategyId);
+    if (strategy === undefined) {
+      t.fail(`unknown strategy: ${tc.strategyId}`);
+      return;
+    }
+    const evaluator = new StrategyEvaluator();
+    const user = createUser(tc.user.id, tc.user.data || null);
+    const actual = evaluator.evaluate(strategy, user, variations, fixture.featureId, fixture.samplingSeed);
+    t.is(actual.getId(), tc.expectedVariation);
+  });
+});
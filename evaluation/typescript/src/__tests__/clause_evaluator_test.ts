@@ -1546,3 +1546,97 @@ test('NotEquals', (t) => {
     );
   });
 });
+
+test('MatchesRegex', (t) => {
+  const testCases = [
+    { targetValue: 'user@example.com', values: ['@example\\.com$'], expected: true },
+    { targetValue: 'user@example.org', values: ['@example\\.com$'], expected: false },
+    { targetValue: 'user@example.org', values: ['@example\\.com$', '\\.org$'], expected: true }, // any of the patterns matches
+    { targetValue: 'v1.2.3', values: ['^v1\\.'], expected: true },
+    { targetValue: '', values: ['^$'], expected: true },
+    { targetValue: 'value', values: ['(invalid'], expected: false }, // invalid patterns fail closed
+    { targetValue: 'value', values: ['(invalid', '^val'], expected: true },
+    { targetValue: 'ADMIN', values: ['(?i)^admin$'], expected: false }, // RE2-only syntax fails closed
+    { targetValue: 'Aabc', values: ['\\Aabc'], expected: false }, // \A is a literal A in JavaScript
+    { targetValue: 'a', values: ['[]a]'], expected: false }, // [] never matches in JavaScript
+    { targetValue: 'value', values: [], expected: false },
+  ];
+
+  const clauseEvaluator = new ClauseEvaluator();
+
+  testCases.forEach((tc, i) => {
+    const clause = new Clause();
+    clause.setOperator(Clause.Operator.MATCHES_REGEX);
+    clause.setValuesList(tc.values);
+
+    // Evaluate twice so the cached regexp path is exercised.
+    for (let n = 0; n < 2; n++) {
+      const result = clauseEvaluator.evaluate(tc.targetValue, clause, testUser, [], null, {});
+      t.is(
+        result,
+        tc.expected,
+        `Test case ${i} failed: targetValue ${tc.targetValue} : values ${tc.values}`,
+      );
+    }
+  });
+});
+
+test('NotIn', (t) => {
+  const testCases = [
+    { targetValue: 'value1', values: ['value1', 'value2'], expected: false },
+    { targetValue: 'value3', values: ['value1', 'value2'], expected: true },
+    { targetValue: 'v1.0.0', values: ['1.0.0', '2.0.0'], expected: false }, // semver comparison like IN
+    { targetValue: '', values: ['value1'], expected: true }, // missing attribute is not in the list
+    { targetValue: 'value1', values: [], expected: true },
+  ];
+
+  const clauseEvaluator = new ClauseEvaluator();
+
+  testCases.forEach((tc, i) => {
+    const clause = new Clause();
+    clause.setOperator(Clause.Operator.NOT_IN);
+    clause.setValuesList(tc.values);
+
+    const result = clauseEvaluator.evaluate(tc.targetValue, clause, testUser, [], null, {});
+    t.is(
+      result,
+      tc.expected,
+      `Test case ${i} failed: targetValue ${tc.targetValue} : values ${tc.values}`,
+    );
+  });
+});
+
+test('ContainsAnyAndContainsAll', (t) => {
+  const testCases = [
+    { targetValue: 'admin,editor', values: ['admin'], expectedAny: true, expectedAll: true },
+    { targetValue: 'admin, editor ,viewer', values: ['editor', 'viewer'], expectedAny: true, expectedAll: true },
+    { targetValue: 'admin,editor', values: ['editor', 'owner'], expectedAny: true, expectedAll: false },
+    { targetValue: 'admin,editor', values: ['owner'], expectedAny: false, expectedAll: false },
+    { targetValue: 'administrator', values: ['admin'], expectedAny: false, expectedAll: false }, // elements are compared exactly, not by substring
+    { targetValue: '', values: ['admin'], expectedAny: false, expectedAll: false },
+    { targetValue: ',,', values: [''], expectedAny: false, expectedAll: false }, // empty elements are ignored
+    { targetValue: 'admin', values: [], expectedAny: false, expectedAll: false },
+  ];
+
+  const clauseEvaluator = new ClauseEvaluator();
+
+  testCases.forEach((tc, i) => {
+    const anyClause = new Clause();
+    anyClause.setOperator(Clause.Operator.CONTAINS_ANY);
+    anyClause.setValuesList(tc.values);
+    t.is(
+      clauseEvaluator.evaluate(tc.targetValue, anyClause, testUser, [], null, {}),
+      tc.expectedAny,
+      `Test case ${i} failed (CONTAINS_ANY): targetValue ${tc.targetValue} : values ${tc.values}`,
+    );
+
+    const allClause = new Clause();
+    allClause.setOperator(Clause.Operator.CONTAINS_ALL);
+    allClause.setValuesList(tc.values);
+    t.is(
+      clauseEvaluator.evaluate(tc.targetValue, allClause, testUser, [], null, {}),
+      tc.expectedAll,
+      `Test case ${i} failed (CONTAINS_ALL): targetValue ${tc.targetValue} : values ${tc.values}`,
+    );
+  });
+});
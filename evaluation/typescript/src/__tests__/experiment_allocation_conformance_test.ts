@@ -0,0 +1,74 @@
+import test from 'ava';
+import * as fs from 'fs';
+import * as path from 'path';
+import { ExperimentAllocation } from '../proto/feature/feature_pb';
+import { Reason } from '../proto/feature/reason_pb';
+import { ExperimentAllocationEvaluator } from '../experimentAllocationEvaluator';
+
+// The conformance fixtures are shared with evaluation/go so the two engines
+// give identical assignments. See evaluation/testdata/experiment_allocation_conformance.json.
+interface ConformanceAllocation {
+  experimentId: string;
+  layerId?: string;
+  trafficStart?: number;
+  trafficEnd?: number;
+  holdoutWeight?: number;
+  holdoutId?: string;
+}
+
+interface ConformanceTestCase {
+  desc: string;
+  experimentId: string;
+  userId: string;
+  expectedReason: string;
+}
+
+interface ConformanceFixture {
+  allocations: ConformanceAllocation[];
+  testCases: ConformanceTestCase[];
+}
+
+function loadFixture(): ConformanceFixture {
+  // Compiled tests run from __test/__tests__, source runs from src/__tests__.
+  const candidates = [
+    path.join(__dirname, '../../../testdata/experiment_allocation_conformance.json'),
+    path.join(__dirname, '../../testdata/experiment_allocation_conformance.json'),
+  ];
+  for (const candidate of candidates) {
+    if (fs.existsSync(candidate)) {
+      return JSON.parse(fs.readFileSync(candidate, 'utf-8'));
+    }
+  }
+  throw new Error('experiment_allocation_conformance.json not found');
+}
+
+const fixture = loadFixture();
+
+const allocations = new Map<string, ExperimentAllocation>();
+fixture.allocations.forEach((a) => {
+  const allocation = new ExperimentAllocation();
+  allocation.setExperimentId(a.experimentId);
+  allocation.setLayerId(a.layerId || '');
+  allocation.setTrafficStart(a.trafficStart || 0);
+  allocation.setTrafficEnd(a.trafficEnd || 0);
+  allocation.setHoldoutWeight(a.holdoutWeight || 0);
+  allocation.setHoldoutId(a.holdoutId || '');
+  allocations.set(a.experimentId, allocation);
+});
+
+fixture.testCases.forEach((tc) => {
+  test(`conformance: ${tc.desc}`, (t) => {
+    const allocation = allocations.get(tc.experimentId);
+    if (allocation === undefined) {
+      t.fail(`unknown experiment: ${tc.experimentId}`);
+      return;
+    }
+    const evaluator = new ExperimentAllocationEvaluator();
+    const actual = evaluator.evaluate(allocation, tc.userId);
+    if (tc.expectedReason === '') {
+      t.is(actual, null);
+      return;
+    }
+    t.is(actual, Reason.Type[tc.expectedReason as keyof Reason.TypeMap]);
+  });
+});
@@ -0,0 +1,103 @@
+import test from 'ava';
+import * as fs from 'fs';
+import * as path from 'path';
+import { ClauseGroup, Rule } from '../proto/feature/rule_pb';
+import { Clause } from '../proto/feature/clause_pb';
+import { RuleEvaluator } from '../ruleEvaluator';
+import { createUser } from '../modelFactory';
+
+// The conformance fixtures are shared with evaluation/go so the two engines
+// stay in lockstep. See evaluation/testdata/rule_groups_conformance.json.
+interface ConformanceClause {
+  id: string;
+  attribute: string;
+  operator: string;
+  values: string[];
+}
+
+interface ConformanceClauseGroup {
+  operator: string;
+  clauses?: ConformanceClause[];
+  groups?: ConformanceClauseGroup[];
+}
+
+interface ConformanceRule {
+  id: string;
+  name?: string;
+  clauses?: ConformanceClause[];
+  clauseGroups?: ConformanceClauseGroup[];
+}
+
+interface ConformanceTestCase {
+  desc: string;
+  user: { id: string; data: { [key: string]: string } };
+  expectedRuleId: string;
+}
+
+interface ConformanceFixture {
+  rules: ConformanceRule[];
+  testCases: ConformanceTestCase[];
+}
+
+function loadFixture(): ConformanceFixture {
+  // Compiled tests run from __test/__tests__, source runs from src/__tests__.
+  const candidates = [
+    path.join(__dirname, '../../../testdata/rule_groups_conformance.json'),
+    path.join(__dirname, '../../testdata/rule_groups_conformance.json'),
+  ];
+  for (const candidate of candidates) {
+    if (fs.existsSync(candidate)) {
+      return JSON.parse(fs.readFileSync(candidate, 'utf-8'));
+    }
+  }
+  throw new Error('rule_groups_conformance.json not found');
+}
+
+function toClauses(clauses: ConformanceClause[] = []): Clause[] {
+  return clauses.map((c) => {
+    const operator = Clause.Operator[c.operator as keyof Clause.OperatorMap];
+    if (operator === undefined) {
+      throw new Error(`unknown clause operator: ${c.operator}`);
+    }
+    const clause = new Clause();
+    clause.setId(c.id);
+    clause.setAttribute(c.attribute);
+    clause.setOperator(operator);
+    clause.setValuesList(c.values);
+    return clause;
+  });
+}
+
+function toClauseGroups(groups: ConformanceClauseGroup[] = []): ClauseGroup[] {
+  return groups.map((g) => {
+    const operator = ClauseGroup.Operator[g.operator as keyof ClauseGroup.OperatorMap];
+    if (operator === undefined) {
+      throw new Error(`unknown clause group operator: ${g.operator}`);
+    }
+    const group = new ClauseGroup();
+    group.setOperator(operator);
+    group.setClausesList(toClauses(g.clauses));
+    group.setGroupsList(toClauseGroups(g.groups));
+    return group;
+  });
+}
+
+const fixture = loadFixture();
+
+const rules = fixture.rules.map((r) => {
+  const rule = new Rule();
+  rule.setId(r.id);
+  rule.setName(r.name || '');
+  rule.setClausesList(toClauses(r.clauses));
+  rule.setClauseGroupsList(toClauseGroups(r.clauseGroups));
+  return rule;
+});
+
+fixture.testCases.forEach((tc) => {
+  test(`conformance: ${tc.desc}`, (t) => {
+    const evaluator = new RuleEvaluator();
+    const user = createUser(tc.user.id, tc.user.data);
+    const actual = evaluator.evaluate(rules, user, [], null, null);
+    t.is(actual?.getId() || '', tc.expectedRuleId);
+  });
+});
@@ -2,6 +2,7 @@ import test from 'ava';
 import { StrategyEvaluator } from '../strategyEvaluator';
 import { Strategy, FixedStrategy, RolloutStrategy, Audience } from '../proto/feature/strategy_pb';
 import { Variation } from '../proto/feature/variation_pb';
+import { createUser } from '../modelFactory';
 
 test('StrategyEvaluator evaluate fixed strategy', (t) => {
   const evaluator = new StrategyEvaluator();
@@ -16,7 +17,7 @@ test('StrategyEvaluator evaluate fixed strategy', (t) => {
   fixedStrategy.setVariation('variation-a');
   strategy.setFixedStrategy(fixedStrategy);
 
-  const result = evaluator.evaluate(strategy, 'user-1', variations, 'feature-1', 'seed');
+  const result = evaluator.evaluate(strategy, createUser('user-1', null), variations, 'feature-1', 'seed');
   t.is(result.getId(), 'variation-a');
 });
 
@@ -43,7 +44,7 @@ test('StrategyEvaluator evaluate rollout strategy without audience', (t) => {
   // No audience configuration (undefined means no audience control)
   strategy.setRolloutStrategy(rolloutStrategy);
 
-  const result = evaluator.evaluate(strategy, 'user-1', variations, 'feature-1', 'seed');
+  const result = evaluator.evaluate(strategy, createUser('user-1', null), variations, 'feature-1', 'seed');
   t.true(result.getId() === 'variation-a' || result.getId() === 'variation-b');
 });
 
@@ -84,7 +85,7 @@ test('StrategyEvaluator evaluate rollout strategy with audience', (t) => {
 
   for (let i = 0; i < totalUsers; i++) {
     const userID = `user-${i}`;
-    const result = evaluator.evaluate(strategy, userID, variations, 'feature-1', 'seed');
+    const result = evaluator.evaluate(strategy, createUser(userID, null), variations, 'feature-1', 'seed');
     
     if (result.getId() === 'variation-default') {
       outOfExperimentCount++;
@@ -145,7 +146,7 @@ test('StrategyEvaluator evaluate rollout strategy with audience but no default v
   for (let i = 0; i < 100; i++) {
     const userID = `user-${i}`;
     try {
-      evaluator.evaluate(strategy, userID, variations, 'feature-1', 'seed');
+      evaluator.evaluate(strategy, createUser(userID, null), variations, 'feature-1', 'seed');
     } catch (error) {
       if (error instanceof Error && error.message === 'Variation not found') {
         foundError = true;
@@ -192,7 +193,7 @@ test('StrategyEvaluator evaluate rollout strategy with 100% audience', (t) => {
   // With 100% audience, all users should be in experiment
   for (let i = 0; i < 10; i++) {
     const userID = `user-${i}`;
-    const result = evaluator.evaluate(strategy, userID, variations, 'feature-1', 'seed');
+    const result = evaluator.evaluate(strategy, createUser(userID, null), variations, 'feature-1', 'seed');
     
     // Should never get default variation with 100% audience
     t.not(result.getId(), 'variation-default', `Unexpected default variation for user ${userID} with 100% audience`);
@@ -203,6 +204,70 @@ test('StrategyEvaluator evaluate rollout strategy with 100% audience', (t) => {
   }
 });
 
+test('StrategyEvaluator evaluate rollout strategy bucketed by user attribute', (t) => {
+  const evaluator = new StrategyEvaluator();
+  const variations = [
+    createVariation('variation-a', 'a'),
+    createVariation('variation-b', 'b'),
+    createVariation('variation-fallback', 'fallback'),
+  ];
+  const strategy = createBucketByStrategy('');
+
+  // Users sharing the attribute value always get the same variation
+  for (let c = 0; c < 20; c++) {
+    const company = `company-${c}`;
+    const first = evaluator.evaluate(strategy, createUser('user-0', { company }), variations, 'feature-1', 'seed');
+    for (let i = 1; i < 10; i++) {
+      const result = evaluator.evaluate(strategy, createUser(`user-${i}`, { company }), variations, 'feature-1', 'seed');
+      t.is(result.getId(), first.getId());
+    }
+  }
+
+  // Missing attribute falls back to the user ID
+  const user = createUser('user-1', null);
+  const withoutBucketBy = createBucketByStrategy('');
+  withoutBucketBy.getRolloutStrategy()?.setBucketBy('');
+  t.is(
+    evaluator.evaluate(strategy, user, variations, 'feature-1', 'seed').getId(),
+    evaluator.evaluate(withoutBucketBy, user, variations, 'feature-1', 'seed').getId(),
+  );
+});
+
+test('StrategyEvaluator evaluate rollout strategy serves fallback variation when attribute is missing', (t) => {
+  const evaluator = new StrategyEvaluator();
+  const variations = [
+    createVariation('variation-a', 'a'),
+    createVariation('variation-b', 'b'),
+    createVariation('variation-fallback', 'fallback'),
+  ];
+  const strategy = createBucketByStrategy('variation-fallback');
+
+  for (let i = 0; i < 10; i++) {
+    const result = evaluator.evaluate(strategy, createUser(`user-${i}`, { other: 'value' }), variations, 'feature-1', 'seed');
+    t.is(result.getId(), 'variation-fallback');
+  }
+});
+
+function createBucketByStrategy(fallbackVariation: string): Strategy {
+  const strategy = new Strategy();
+  strategy.setType(Strategy.Type.ROLLOUT);
+  const rolloutStrategy = new RolloutStrategy();
+
+  const variationA = new RolloutStrategy.Variation();
+  variationA.setVariation('variation-a');
+  variationA.setWeight(50000);
+
+  const variationB = new RolloutStrategy.Variation();
+  variationB.setVariation('variation-b');
+  variationB.setWeight(50000);
+
+  rolloutStrategy.setVariationsList([variationA, variationB]);
+  rolloutStrategy.setBucketBy('company');
+  rolloutStrategy.setBucketByFallbackVariation(fallbackVariation);
+  strategy.setRolloutStrategy(rolloutStrategy);
+  return strategy;
+}
+
 function createVariation(id: string, value: string): Variation {
   const variation = new Variation();
   variation.setId(id);
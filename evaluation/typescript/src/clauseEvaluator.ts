@@ -4,6 +4,14 @@ import { User } from './proto/user/user_pb';
 import { SegmentEvaluator } from './segmentEvaluator';
 import { DependencyEvaluator } from './dependencyEvaluator';
 import * as semver from 'semver';
+
+// compiledRegexps caches compiled MATCHES_REGEX patterns across evaluations.
+// Invalid and non-portable patterns are cached as null so they are not recompiled on every call.
+// The cache evicts the least recently used pattern once it holds
+// REGEXP_CACHE_SIZE entries, because anyone allowed to edit flags and segments
+// can add new patterns at will.
+const REGEXP_CACHE_SIZE = 1024;
+const compiledRegexps = new Map<string, RegExp | null>();
 //
 class ClauseEvaluator {
   private segmentEvaluator: SegmentEvaluator;
@@ -66,6 +74,14 @@ class ClauseEvaluator {
           return this.partiallyMatches(targetValue, clause.getValuesList());
         case Clause.Operator.NOT_EQUALS:
           return !this.equals(targetValue, clause.getValuesList());
+        case Clause.Operator.MATCHES_REGEX:
+          return this.matchesRegex(targetValue, clause.getValuesList());
+        case Clause.Operator.NOT_IN:
+          return !this.in(targetValue, clause.getValuesList());
+        case Clause.Operator.CONTAINS_ANY:
+          return this.containsAny(targetValue, clause.getValuesList());
+        case Clause.Operator.CONTAINS_ALL:
+          return this.containsAll(targetValue, clause.getValuesList());
         default:
           return false;
       }
@@ -109,6 +125,28 @@ class ClauseEvaluator {
     return values.includes(targetValue);
   }
 
+  private matchesRegex(targetValue: string, values: string[]): boolean {
+    // Invalid patterns are rejected when the rule is saved,
+    // so fail closed instead of throwing an error.
+    return values.some((value) => {
+      const re = compileRegex(value);
+      return re !== null && re.test(targetValue);
+    });
+  }
+
+  private containsAny(targetValue: string, values: string[]): boolean {
+    const elements = splitList(targetValue);
+    return values.some((value) => elements.has(value));
+  }
+
+  private containsAll(targetValue: string, values: string[]): boolean {
+    const elements = splitList(targetValue);
+    if (elements.size == 0 || values.length == 0) {
+      return false;
+    }
+    return values.every((value) => elements.has(value));
+  }
+
   private startsWith(targetValue: string, values: string[]): boolean {
     return values.some((value) => targetValue.startsWith(value));
   }
@@ -234,6 +272,111 @@ class ClauseEvaluator {
   }
 }
 
+function compileRegex(pattern: string): RegExp | null {
+  const cached = compiledRegexps.get(pattern);
+  if (cached !== undefined) {
+    // Map keeps insertion order, so re-inserting marks the entry as recently used.
+    compiledRegexps.delete(pattern);
+    compiledRegexps.set(pattern, cached);
+    return cached;
+  }
+  let re: RegExp | null = null;
+  if (isPortableRegex(pattern)) {
+    try {
+      re = new RegExp(pattern);
+    } catch {
+      re = null;
+    }
+  }
+  compiledRegexps.set(pattern, re);
+  if (compiledRegexps.size > REGEXP_CACHE_SIZE) {
+    const oldest = compiledRegexps.keys().next().value;
+    if (oldest !== undefined) {
+      compiledRegexps.delete(oldest);
+    }
+  }
+  return re;
+}
+
+// PORTABLE_ESCAPES are the characters that may follow a backslash.
+// They mean the same in RE2 and in ECMAScript regular expressions.
+const PORTABLE_ESCAPES = 'dDwWsSbBnrtfv\\.+*?()|[]{}^$-/';
+
+// isPortableRegex mirrors CompileRegex in evaluation/go. Patterns are evaluated
+// by RE2 in the gateway and by RegExp here, so the syntax that the two engines
+// read differently is rejected: inline flags such as (?i), named groups, \A, \z,
+// \Q...\E, \p{...}, \x{...}, octal escapes, POSIX classes and classes starting with ']'.
+function isPortableRegex(pattern: string): boolean {
+  let inClass = false;
+  for (let i = 0; i < pattern.length; i++) {
+    switch (pattern[i]) {
+      case '\\': {
+        if (i + 1 === pattern.length) {
+          return false;
+        }
+        const next = pattern[i + 1];
+        if (PORTABLE_ESCAPES.includes(next)) {
+          i++;
+        } else if (next === 'x' && /^[0-9a-fA-F]{2}$/.test(pattern.substring(i + 2, i + 4))) {
+          i += 3;
+        } else {
+          return false;
+        }
+        break;
+      }
+      case '[': {
+        if (inClass) {
+          if (pattern[i + 1] === ':') {
+            return false;
+          }
+          break;
+        }
+        inClass = true;
+        // An empty class never matches here, but RE2 reads the ']' as a literal.
+        let j = i + 1;
+        if (pattern[j] === '^') {
+          j++;
+        }
+        if (pattern[j] === ']') {
+          return false;
+        }
+        i = j - 1;
+        break;
+      }
+      case ']':
+        inClass = false;
+        break;
+      case '(':
+        if (!inClass && pattern[i + 1] === '?') {
+          if (pattern[i + 2] !== ':') {
+            return false;
+          }
+          i += 2;
+        }
+        break;
+      default: {
+        // Without the u flag, characters outside the BMP are read as two code units.
+        const code = pattern.charCodeAt(i);
+        if (code >= 0xd800 && code <= 0xdbff) {
+          return false;
+        }
+      }
+    }
+  }
+  return true;
+}
+
+// splitList parses a comma-separated attribute value into a set,
+// trimming surrounding spaces and ignoring empty elements.
+function splitList(targetValue: string): Set<string> {
+  return new Set(
+    targetValue
+      .split(',')
+      .map((element) => element.trim())
+      .filter((element) => element !== ''),
+  );
+}
+
 function isNumericString(input: string): boolean {
   return /^-?\d*\.?\d+$/.test(input);
 }
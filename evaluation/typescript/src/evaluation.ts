@@ -6,8 +6,9 @@ import { Reason } from './proto/feature/reason_pb';
 import { Segment, SegmentUser } from './proto/feature/segment_pb';
 import { Variation } from './proto/feature/variation_pb';
 import { User } from './proto/user/user_pb';
-import { RuleEvaluator } from './ruleEvaluator';
+import { RuleEvaluator, ruleClauses } from './ruleEvaluator';
 import { StrategyEvaluator } from './strategyEvaluator';
+import { ExperimentAllocationEvaluator } from './experimentAllocationEvaluator';
 import { NewUserEvaluations, UserEvaluationsID } from './userEvaluation';
 import { createReason } from './modelFactory';
 import * as yaml from 'js-yaml';
@@ -22,6 +23,7 @@ export function EvaluationID(featureID: string, featureVersion: number, userID:
 class Evaluator {
   private ruleEvaluator: RuleEvaluator;
   private strategyEvaluator: StrategyEvaluator;
+  private allocationEvaluator: ExperimentAllocationEvaluator;
   // variationCache caches YAML to JSON conversions using variation ID as the key.
   // Since variation IDs are UUIDs, they are globally unique and safe to use as cache keys.
   private variationCache: Map<string, string>;
@@ -29,6 +31,7 @@ class Evaluator {
   constructor() {
     this.ruleEvaluator = new RuleEvaluator();
     this.strategyEvaluator = new StrategyEvaluator();
+    this.allocationEvaluator = new ExperimentAllocationEvaluator();
     this.variationCache = new Map<string, string>();
   }
 
@@ -188,7 +191,7 @@ class Evaluator {
   listSegmentIDs(feature: Feature): string[] {
     const mapIDs = new Set<string>();
     for (const rule of feature.getRulesList()) {
-      for (const clause of rule.getClausesList()) {
+      for (const clause of ruleClauses(rule)) {
         if (clause.getOperator() === Clause.Operator.SEGMENT) {
           clause.getValuesList().forEach((value) => mapIDs.add(value));
         }
@@ -237,6 +240,19 @@ class Evaluator {
       }
     }
 
+    // users outside the running experiment get the baseline variation
+    const allocation = feature.getExperimentAllocation();
+    if (allocation !== undefined) {
+      const excludedReason = this.allocationEvaluator.evaluate(allocation, user.getId());
+      if (excludedReason !== null) {
+        const variation = this.findVariation(
+          allocation.getBaselineVariationId(),
+          feature.getVariationsList(),
+        );
+        return [createReason('', excludedReason), variation];
+      }
+    }
+
     // evaluate ruleset
     const rule = this.ruleEvaluator.evaluate(
       feature.getRulesList(),
@@ -252,12 +268,12 @@ class Evaluator {
       }
       const variation = this.strategyEvaluator.evaluate(
         strategy,
-        user.getId(),
+        user,
         feature.getVariationsList(),
         feature.getId(),
         feature.getSamplingSeed(),
       );
-      const reason = createReason(rule.getId(), Reason.Type.RULE);
+      const reason = createReason(rule.getId(), Reason.Type.RULE, rule.getName());
       return [reason, variation];
     }
 
@@ -269,7 +285,7 @@ class Evaluator {
 
     const variation = this.strategyEvaluator.evaluate(
       defaultStrategy,
-      user.getId(),
+      user,
       feature.getVariationsList(),
       feature.getId(),
       feature.getSamplingSeed(),
@@ -351,7 +367,7 @@ function getFeatureIDsDependsOn(feature: Feature): Array<string> {
 
   // Iterate over rules and collect ids from clauses where the operator is FEATURE_FLAG
   feature.getRulesList().forEach((rule) => {
-    rule.getClausesList().forEach((clause) => {
+    ruleClauses(rule).forEach((clause) => {
       if (clause.getOperator() === Clause.Operator.FEATURE_FLAG) {
         ids.push(clause.getAttribute());
       }
@@ -0,0 +1,43 @@
+import { Bucketeer } from './bucketeer';
+import { ExperimentAllocation } from './proto/feature/feature_pb';
+import { Reason } from './proto/feature/reason_pb';
+
+// The size of the layer hash space. It matches the total weight of the rollout strategy.
+const ALLOCATION_TOTAL_WEIGHT = 100000;
+
+class ExperimentAllocationEvaluator {
+  // evaluate returns the reason for excluding the user from the experiment the
+  // allocation belongs to, or null when the user takes part in it.
+  // Holdout users are checked first so they are excluded from every experiment,
+  // whatever slice of the layer they hash into.
+  evaluate(
+    allocation: ExperimentAllocation,
+    userID: string,
+  ): Reason.TypeMap[keyof Reason.TypeMap] | null {
+    if (allocation.getHoldoutWeight() > 0) {
+      const input = `holdout-${allocation.getHoldoutId()}-${userID}`;
+      if (this.position(input) < allocation.getHoldoutWeight()) {
+        return Reason.Type.EXPERIMENT_HOLDOUT;
+      }
+    }
+    if (allocation.getLayerId() !== '') {
+      const position = this.position(`layer-${allocation.getLayerId()}-${userID}`);
+      if (position < allocation.getTrafficStart() || position >= allocation.getTrafficEnd()) {
+        return Reason.Type.EXPERIMENT_EXCLUDED;
+      }
+    }
+    return null;
+  }
+
+  // position maps the input to [0, ALLOCATION_TOTAL_WEIGHT).
+  private position(input: string): number {
+    const bucketeer = new Bucketeer();
+    const p = Math.trunc(bucketeer.bucket(input) * ALLOCATION_TOTAL_WEIGHT);
+    if (p >= ALLOCATION_TOTAL_WEIGHT) {
+      return ALLOCATION_TOTAL_WEIGHT - 1;
+    }
+    return p;
+  }
+}
+
+export { ExperimentAllocationEvaluator };
@@ -1,4 +1,4 @@
-import { Rule } from './proto/feature/rule_pb';
+import { ClauseGroup, Rule } from './proto/feature/rule_pb';
 import { Clause } from './proto/feature/clause_pb';
 import { User } from './proto/user/user_pb';
 import { Segment, SegmentUser } from './proto/feature/segment_pb';
@@ -40,9 +40,54 @@ class RuleEvaluator {
         return false;
       }
     }
+    for (const group of rule.getClauseGroupsList()) {
+      const matched = this.evaluateGroup(group, user, segmentUsers, segments, flagVariations);
+      if (!matched) {
+        return false;
+      }
+    }
     return true;
   }
 
+  // Combines the results of the group's clauses and nested groups with its operator.
+  private evaluateGroup(
+    group: ClauseGroup,
+    user: User,
+    segmentUsers: SegmentUser[],
+    segments: Map<string, Segment> | null,
+    flagVariations: { [key: string]: string } | null,
+  ): boolean {
+    const matchAny = group.getOperator() === ClauseGroup.Operator.OR;
+    const matched = this.evaluateGroupChildren(group, matchAny, user, segmentUsers, segments, flagVariations);
+    if (group.getOperator() === ClauseGroup.Operator.NOT) {
+      return !matched;
+    }
+    return matched;
+  }
+
+  // Reports whether any child matches when matchAny is true, or whether every
+  // child matches otherwise. It stops at the first child that decides the result.
+  private evaluateGroupChildren(
+    group: ClauseGroup,
+    matchAny: boolean,
+    user: User,
+    segmentUsers: SegmentUser[],
+    segments: Map<string, Segment> | null,
+    flagVariations: { [key: string]: string } | null,
+  ): boolean {
+    for (const clause of group.getClausesList()) {
+      if (this.evaluateClause(clause, user, segmentUsers, segments, flagVariations) === matchAny) {
+        return matchAny;
+      }
+    }
+    for (const child of group.getGroupsList()) {
+      if (this.evaluateGroup(child, user, segmentUsers, segments, flagVariations) === matchAny) {
+        return matchAny;
+      }
+    }
+    return !matchAny;
+  }
+
   private evaluateClause(
     clause: Clause,
     user: User,
@@ -68,4 +113,16 @@ class RuleEvaluator {
   }
 }
 
-export { RuleEvaluator };
+// Returns every clause of the rule, including the ones nested in its clause
+// groups, in depth-first order.
+function ruleClauses(rule: Rule): Clause[] {
+  const clauses = [...rule.getClausesList()];
+  const appendGroupClauses = (group: ClauseGroup) => {
+    clauses.push(...group.getClausesList());
+    group.getGroupsList().forEach(appendGroupClauses);
+  };
+  rule.getClauseGroupsList().forEach(appendGroupClauses);
+  return clauses;
+}
+
+export { RuleEvaluator, ruleClauses };
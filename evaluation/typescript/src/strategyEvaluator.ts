@@ -1,11 +1,12 @@
 import { Bucketeer } from './bucketeer';
 import { RolloutStrategy, Strategy } from './proto/feature/strategy_pb';
 import { Variation } from './proto/feature/variation_pb';
+import { User } from './proto/user/user_pb';
 
 class StrategyEvaluator {
   evaluate(
     strategy: Strategy,
-    userID: string,
+    user: User,
     variations: Variation[],
     featureID: string,
     samplingSeed: string,
@@ -16,7 +17,7 @@ class StrategyEvaluator {
       case Strategy.Type.ROLLOUT:
         const rolloutStrategy = strategy.getRolloutStrategy();
         if (rolloutStrategy !== undefined) {
-          const variationID = this.rollout(rolloutStrategy, userID, featureID, samplingSeed);
+          const variationID = this.rollout(rolloutStrategy, user, featureID, samplingSeed);
           return this.findVariation(variationID, variations);
         }
         throw new Error('Missing rollout strategy');
@@ -27,17 +28,22 @@ class StrategyEvaluator {
 
   private rollout(
     strategy: RolloutStrategy,
-    userID: string,
+    user: User,
     featureID: string,
     samplingSeed: string,
   ): string {
     const bucketeer = new Bucketeer();
+    const fallbackVariation = strategy.getBucketByFallbackVariation();
     
     const audience = strategy.getAudience();
     if (audience !== undefined) {
       if (audience.getPercentage() > 0 && audience.getPercentage() < 100) {
+        const [trafficKey, found] = this.bucketKey(user, audience.getBucketBy() || strategy.getBucketBy());
+        if (!found && fallbackVariation !== '') {
+          return fallbackVariation;
+        }
         // Use different hash input for audience control to ensure independence from A/B split
-        const trafficInput = `traffic-${featureID}-${userID}-${samplingSeed}`;
+        const trafficInput = `traffic-${featureID}-${trafficKey}-${samplingSeed}`;
         const trafficBucket = bucketeer.bucket(trafficInput);
         const trafficThreshold = audience.getPercentage() / 100.0;
         
@@ -51,7 +57,11 @@ class StrategyEvaluator {
       }
     }
     
-    const input = `${featureID}-${userID}-${samplingSeed}`;
+    const [key, found] = this.bucketKey(user, strategy.getBucketBy());
+    if (!found && fallbackVariation !== '') {
+      return fallbackVariation;
+    }
+    const input = `${featureID}-${key}-${samplingSeed}`;
     const bucket = bucketeer.bucket(input);
 
     let rangeEnd = 0.0;
@@ -64,6 +74,20 @@ class StrategyEvaluator {
     throw new Error('Variation not found');
   }
 
+  // Returns the value hashed to place the user in a bucket. When bucketBy names
+  // a user attribute its value is used; the flag is false when the attribute is
+  // missing, in which case the user ID is returned.
+  private bucketKey(user: User, bucketBy: string): [string, boolean] {
+    if (bucketBy === '') {
+      return [user.getId(), true];
+    }
+    const value = user.getDataMap().get(bucketBy);
+    if (value !== undefined && value !== '') {
+      return [value, true];
+    }
+    return [user.getId(), false];
+  }
+
   private findVariation(variationID: string, variations: Variation[]): Variation {
     for (const variation of variations) {
       if (variation.getId() === variationID) {
@@ -0,0 +1,41 @@
+-- Create change_request table
+-- Holds feature updates waiting for approval in environments that require
+-- change approval. The payload uses the same format as scheduled_feature_change.
+
+CREATE TABLE IF NOT EXISTS change_request (
+    -- Identity
+    id VARCHAR(255) NOT NULL,
+    feature_id VARCHAR(255) NOT NULL,
+    environment_id VARCHAR(255) NOT NULL,
+
+    -- Content
+    payload JSON NOT NULL,                    -- ScheduledChangePayload as JSON
+    comment TEXT,
+
+    -- Status tracking
+    -- 1=PENDING, 2=APPROVED, 3=REJECTED, 4=APPLIED
+    status TINYINT NOT NULL DEFAULT 1,
+    flag_version_at_creation INT NOT NULL,
+    min_approvers INT NOT NULL DEFAULT 1,
+    approvals JSON,                           -- Array of ChangeRequestApproval as JSON
+    rejected_by VARCHAR(255),
+    rejection_comment TEXT,
+
+    -- Audit
+    created_by VARCHAR(255) NOT NULL,
+    created_at BIGINT NOT NULL,
+    updated_by VARCHAR(255),
+    updated_at BIGINT NOT NULL,
+    applied_at BIGINT,
+
+    -- Keys & Constraints
+    PRIMARY KEY (id),
+    CONSTRAINT fk_change_request_feature
+        FOREIGN KEY (feature_id, environment_id)
+        REFERENCES feature(id, environment_id)
+        ON DELETE RESTRICT,
+
+    -- Indexes for performance
+    INDEX idx_feature_env (feature_id, environment_id),
+    INDEX idx_environment_status (environment_id, status)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
@@ -0,0 +1,8 @@
+-- Add metric analysis settings to the goal table.
+-- The zero values keep the existing behaviour: a conversion goal where higher
+-- is better, winsorized at the server default percentile.
+ALTER TABLE `goal` ADD COLUMN `metric_type` INT NOT NULL DEFAULT 0 AFTER `connection_type`;
+
+ALTER TABLE `goal` ADD COLUMN `improvement_direction` INT NOT NULL DEFAULT 0 AFTER `metric_type`;
+
+ALTER TABLE `goal` ADD COLUMN `value_cap_percentile` INT NOT NULL DEFAULT 0 AFTER `improvement_direction`;
@@ -0,0 +1,45 @@
+-- Create webhook and webhook_delivery tables
+-- Webhooks receive the domain events of an environment as JSON. Every
+-- delivery attempt sequence is logged so that it can be inspected and replayed.
+
+CREATE TABLE IF NOT EXISTS webhook (
+    id VARCHAR(255) NOT NULL,
+    environment_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    source_types JSON NOT NULL,               -- Array of Subscription.SourceType
+    max_retries INT NOT NULL DEFAULT 3,
+    disabled TINYINT(1) NOT NULL DEFAULT 0,
+    created_at BIGINT NOT NULL,
+    updated_at BIGINT NOT NULL,
+
+    PRIMARY KEY (id),
+    INDEX idx_webhook_environment (environment_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS webhook_delivery (
+    id VARCHAR(255) NOT NULL,
+    webhook_id VARCHAR(255) NOT NULL,
+    environment_id VARCHAR(255) NOT NULL,
+    event_id VARCHAR(255) NOT NULL,
+    source_type INT NOT NULL,
+    entity_id VARCHAR(255) NOT NULL,
+    event_type VARCHAR(255) NOT NULL,
+    payload MEDIUMTEXT NOT NULL,              -- The posted domain event as JSON
+    -- 1=SUCCEEDED, 2=FAILED
+    status TINYINT NOT NULL,
+    status_code INT NOT NULL DEFAULT 0,
+    latency_ms BIGINT NOT NULL DEFAULT 0,
+    attempts INT NOT NULL DEFAULT 0,
+    error_message TEXT NOT NULL,
+    replayed_delivery_id VARCHAR(255) NOT NULL DEFAULT '',
+    created_at BIGINT NOT NULL,
+
+    PRIMARY KEY (id),
+    CONSTRAINT fk_webhook_delivery_webhook
+        FOREIGN KEY (webhook_id)
+        REFERENCES webhook(id)
+        ON DELETE CASCADE,
+    INDEX idx_webhook_delivery_env_webhook_created (environment_id, webhook_id, created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
@@ -0,0 +1,16 @@
+-- Create custom_role table
+-- Custom roles grant per-resource permissions to the accounts and teams they are
+-- assigned to. The assignments are stored in the environment roles as custom_role_ids.
+
+CREATE TABLE IF NOT EXISTS custom_role (
+    id VARCHAR(255) NOT NULL,
+    organization_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    permissions JSON NOT NULL,
+    created_at BIGINT NOT NULL,
+    updated_at BIGINT NOT NULL,
+
+    PRIMARY KEY (id),
+    UNIQUE INDEX unique_custom_role_organization_name (organization_id, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
@@ -0,0 +1,29 @@
+-- Add experiment layers and the environment holdout group
+-- An experiment in a layer claims a slice of the layer's traffic, stored in
+-- experiment.layer_slice. While it runs, the feature keeps a copy of the slice and
+-- of the holdout group in feature.experiment_allocation for the evaluators.
+
+CREATE TABLE IF NOT EXISTS experiment_layer (
+    id VARCHAR(255) NOT NULL,
+    environment_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    deleted TINYINT(1) NOT NULL DEFAULT 0,
+    created_at BIGINT NOT NULL,
+    updated_at BIGINT NOT NULL,
+
+    PRIMARY KEY (id, environment_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+CREATE TABLE IF NOT EXISTS experiment_holdout (
+    environment_id VARCHAR(255) NOT NULL,
+    id VARCHAR(255) NOT NULL,                 -- Salt of the holdout hash
+    weight INT NOT NULL,                      -- Out of 100000
+    updated_at BIGINT NOT NULL,
+
+    PRIMARY KEY (environment_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+ALTER TABLE `experiment` ADD COLUMN `layer_slice` JSON NULL AFTER `guardrail_goals`;
+
+ALTER TABLE `feature` ADD COLUMN `experiment_allocation` JSON NULL AFTER `prerequisites`;
@@ -0,0 +1,22 @@
+-- Add the envelope encryption of stored secrets
+-- data_key holds the data keys of each organization, wrapped by the key
+-- encryption key of the configured backend. Encrypted secrets are longer than
+-- plaintext ones, and flag triggers are looked up by the SHA-256 hash of their
+-- token since the token itself is encrypted.
+
+CREATE TABLE IF NOT EXISTS data_key (
+    id VARCHAR(255) NOT NULL,
+    organization_id VARCHAR(255) NOT NULL,
+    encrypted_key BLOB NOT NULL,
+    created_at BIGINT NOT NULL,
+
+    PRIMARY KEY (id),
+    INDEX idx_data_key_organization (organization_id, created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+
+ALTER TABLE `push` MODIFY COLUMN `fcm_service_account` TEXT NOT NULL;
+
+ALTER TABLE `flag_trigger` MODIFY COLUMN `token` TEXT NOT NULL;
+ALTER TABLE `flag_trigger` ADD COLUMN `token_hash` VARCHAR(64) NOT NULL DEFAULT '' AFTER `token`;
+UPDATE `flag_trigger` SET `token_hash` = SHA2(`token`, 256);
+CREATE INDEX `idx_flag_trigger_token_hash` ON `flag_trigger` (`token_hash`);
@@ -0,0 +1,6 @@
+-- Add change approval configuration columns to environment_v2 table.
+-- When require_change_approval is enabled, feature updates are stored as
+-- change requests and applied only after the configured number of approvals.
+ALTER TABLE environment_v2 ADD COLUMN require_change_approval BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE environment_v2 ADD COLUMN change_approval_min_approvers INTEGER NOT NULL DEFAULT 1;
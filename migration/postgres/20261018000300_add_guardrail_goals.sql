@@ -0,0 +1,4 @@
+-- Add guardrail goals to experiments and progressive rollouts.
+ALTER TABLE experiment ADD COLUMN guardrail_goals JSONB NULL;
+
+ALTER TABLE ops_progressive_rollout ADD COLUMN guardrail_goals JSONB NULL;
@@ -0,0 +1,19 @@
+-- Create scim_token table
+-- SCIM tokens authenticate identity providers provisioning the accounts and
+-- teams of an organization. Only the SHA-256 hash of the token is stored.
+
+CREATE TABLE scim_token (
+    id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    organization_id VARCHAR(255) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL,
+    created_at BIGINT NOT NULL,
+    updated_at BIGINT NOT NULL,
+    last_used_at BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX unique_scim_token_hash ON scim_token (token_hash);
+CREATE INDEX idx_scim_token_organization ON scim_token (organization_id);
+
+-- Environment roles granted to the members of a team provisioned via SCIM groups
+ALTER TABLE team ADD COLUMN environment_roles JSONB NULL;
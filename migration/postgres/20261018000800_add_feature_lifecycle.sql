@@ -0,0 +1,5 @@
+-- Add the flag lifecycle
+-- Holds the flag kind, its planned removal date and the lifecycle state derived
+-- by the FeatureLifecycleUpdater batch job.
+
+ALTER TABLE feature ADD COLUMN lifecycle JSONB NULL;
@@ -0,0 +1,21 @@
+-- Add the envelope encryption of stored secrets
+-- data_key holds the data keys of each organization, wrapped by the key
+-- encryption key of the configured backend. Encrypted secrets are longer than
+-- plaintext ones, and flag triggers are looked up by the SHA-256 hash of their
+-- token since the token itself is encrypted.
+
+CREATE TABLE data_key (
+    id VARCHAR(255) NOT NULL,
+    organization_id VARCHAR(255) NOT NULL,
+    encrypted_key BYTEA NOT NULL,
+    created_at BIGINT NOT NULL,
+    PRIMARY KEY (id)
+);
+CREATE INDEX idx_data_key_organization ON data_key (organization_id, created_at);
+
+ALTER TABLE push ALTER COLUMN fcm_service_account TYPE TEXT USING fcm_service_account::TEXT;
+
+ALTER TABLE flag_trigger ALTER COLUMN token TYPE TEXT;
+ALTER TABLE flag_trigger ADD COLUMN token_hash VARCHAR(64) NOT NULL DEFAULT '';
+UPDATE flag_trigger SET token_hash = encode(sha256(token::BYTEA), 'hex');
+CREATE INDEX idx_flag_trigger_token_hash ON flag_trigger (token_hash);
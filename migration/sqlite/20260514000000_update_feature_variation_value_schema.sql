@@ -0,0 +1,2 @@
+ALTER TABLE feature
+  ADD COLUMN variation_value_schema TEXT NULL;
@@ -0,0 +1,26 @@
+-- Create change_request table
+-- Holds feature updates waiting for approval in environments that require
+-- change approval. The payload uses the same format as scheduled_feature_change.
+
+CREATE TABLE change_request (
+    id VARCHAR(255) NOT NULL,
+    feature_id VARCHAR(255) NOT NULL,
+    environment_id VARCHAR(255) NOT NULL,
+    payload TEXT NOT NULL,                   -- ScheduledChangePayload as JSON
+    comment TEXT,
+    status SMALLINT NOT NULL DEFAULT 1,       -- 1=PENDING, 2=APPROVED, 3=REJECTED, 4=APPLIED
+    flag_version_at_creation INTEGER NOT NULL,
+    min_approvers INTEGER NOT NULL DEFAULT 1,
+    approvals TEXT,                          -- Array of ChangeRequestApproval as JSON
+    rejected_by VARCHAR(255),
+    rejection_comment TEXT,
+    created_by VARCHAR(255) NOT NULL,
+    created_at BIGINT NOT NULL,
+    updated_by VARCHAR(255),
+    updated_at BIGINT NOT NULL,
+    applied_at BIGINT,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_change_request_feature FOREIGN KEY (feature_id, environment_id) REFERENCES feature (id, environment_id) ON DELETE RESTRICT
+);
+CREATE INDEX idx_cr_feature_env ON change_request (feature_id, environment_id);
+CREATE INDEX idx_cr_environment_status ON change_request (environment_id, status);
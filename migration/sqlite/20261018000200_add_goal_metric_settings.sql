@@ -0,0 +1,8 @@
+-- Add metric analysis settings to the goal table.
+-- The zero values keep the existing behaviour: a conversion goal where higher
+-- is better, winsorized at the server default percentile.
+ALTER TABLE goal ADD COLUMN metric_type INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE goal ADD COLUMN improvement_direction INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE goal ADD COLUMN value_cap_percentile INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,39 @@
+-- Create webhook and webhook_delivery tables
+-- Webhooks receive the domain events of an environment as JSON. Every
+-- delivery attempt sequence is logged so that it can be inspected and replayed.
+
+CREATE TABLE webhook (
+    id VARCHAR(255) NOT NULL,
+    environment_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    source_types TEXT NOT NULL,              -- Array of Subscription.SourceType
+    max_retries INTEGER NOT NULL DEFAULT 3,
+    disabled BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at BIGINT NOT NULL,
+    updated_at BIGINT NOT NULL,
+    PRIMARY KEY (id)
+);
+CREATE INDEX idx_webhook_environment ON webhook (environment_id);
+
+CREATE TABLE webhook_delivery (
+    id VARCHAR(255) NOT NULL,
+    webhook_id VARCHAR(255) NOT NULL,
+    environment_id VARCHAR(255) NOT NULL,
+    event_id VARCHAR(255) NOT NULL,
+    source_type INTEGER NOT NULL,
+    entity_id VARCHAR(255) NOT NULL,
+    event_type VARCHAR(255) NOT NULL,
+    payload TEXT NOT NULL,                    -- The posted domain event as JSON
+    status SMALLINT NOT NULL,                 -- 1=SUCCEEDED, 2=FAILED
+    status_code INTEGER NOT NULL DEFAULT 0,
+    latency_ms BIGINT NOT NULL DEFAULT 0,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    error_message TEXT NOT NULL,
+    replayed_delivery_id VARCHAR(255) NOT NULL DEFAULT '',
+    created_at BIGINT NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_webhook_delivery_webhook FOREIGN KEY (webhook_id) REFERENCES webhook (id) ON DELETE CASCADE
+);
+CREATE INDEX idx_webhook_delivery_env_webhook_created ON webhook_delivery (environment_id, webhook_id, created_at);
@@ -0,0 +1,15 @@
+-- Create custom_role table
+-- Custom roles grant per-resource permissions to the accounts and teams they are
+-- assigned to. The assignments are stored in the environment roles as custom_role_ids.
+
+CREATE TABLE custom_role (
+    id VARCHAR(255) NOT NULL,
+    organization_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    permissions TEXT NOT NULL,
+    created_at BIGINT NOT NULL,
+    updated_at BIGINT NOT NULL,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX unique_custom_role_organization_name ON custom_role (organization_id, name);
@@ -0,0 +1,27 @@
+-- Add experiment layers and the environment holdout group
+-- An experiment in a layer claims a slice of the layer's traffic, stored in
+-- experiment.layer_slice. While it runs, the feature keeps a copy of the slice and
+-- of the holdout group in feature.experiment_allocation for the evaluators.
+
+CREATE TABLE experiment_layer (
+    id VARCHAR(255) NOT NULL,
+    environment_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    deleted BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at BIGINT NOT NULL,
+    updated_at BIGINT NOT NULL,
+    PRIMARY KEY (id, environment_id)
+);
+
+CREATE TABLE experiment_holdout (
+    environment_id VARCHAR(255) NOT NULL,
+    id VARCHAR(255) NOT NULL,
+    weight INTEGER NOT NULL,
+    updated_at BIGINT NOT NULL,
+    PRIMARY KEY (environment_id)
+);
+
+ALTER TABLE experiment ADD COLUMN layer_slice TEXT NULL;
+
+ALTER TABLE feature ADD COLUMN experiment_allocation TEXT NULL;
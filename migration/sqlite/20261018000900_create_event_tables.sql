@@ -0,0 +1,49 @@
+-- Data warehouse event tables used when the lite mode stores events in SQLite.
+-- Timestamps are stored as UTC "YYYY-MM-DD HH:MM:SS" text so they sort chronologically.
+
+-- Create "evaluation_event" table
+CREATE TABLE evaluation_event (
+    id VARCHAR(255) NOT NULL,
+    environment_id VARCHAR(255) NOT NULL,
+    timestamp TEXT NOT NULL,
+    feature_id VARCHAR(255) NOT NULL,
+    feature_version INTEGER NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    user_data TEXT,
+    variation_id VARCHAR(255) NOT NULL,
+    reason TEXT,
+    tag VARCHAR(255),
+    source_id VARCHAR(255),
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id)
+);
+CREATE INDEX idx_evaluation_environment_id ON evaluation_event (environment_id);
+CREATE INDEX idx_evaluation_timestamp ON evaluation_event (timestamp);
+CREATE INDEX idx_evaluation_feature_id ON evaluation_event (feature_id);
+CREATE INDEX idx_evaluation_user_id ON evaluation_event (user_id);
+CREATE INDEX idx_evaluation_variation_id ON evaluation_event (variation_id);
+
+-- Create "goal_event" table
+CREATE TABLE goal_event (
+    id VARCHAR(255) NOT NULL,
+    environment_id VARCHAR(255) NOT NULL,
+    timestamp TEXT NOT NULL,
+    goal_id VARCHAR(255) NOT NULL,
+    value REAL,
+    user_id VARCHAR(255) NOT NULL,
+    user_data TEXT,
+    tag VARCHAR(255),
+    source_id VARCHAR(255),
+    feature_id VARCHAR(255),
+    feature_version INTEGER,
+    variation_id VARCHAR(255),
+    reason TEXT,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id)
+);
+CREATE INDEX idx_goal_environment_id ON goal_event (environment_id);
+CREATE INDEX idx_goal_timestamp ON goal_event (timestamp);
+CREATE INDEX idx_goal_goal_id ON goal_event (goal_id);
+CREATE INDEX idx_goal_user_id ON goal_event (user_id);
+CREATE INDEX idx_goal_feature_id ON goal_event (feature_id);
+CREATE INDEX idx_goal_variation_id ON goal_event (variation_id);
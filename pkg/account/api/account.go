@@ -62,6 +62,9 @@ func (s *AccountService) CreateAccountV2(
 		)
 		return nil, err
 	}
+	if err := s.validateCustomRoleIDs(ctx, req.OrganizationId, req.EnvironmentRoles); err != nil {
+		return nil, err
+	}
 	account := domain.NewAccountV2(
 		req.Email,
 		req.Name,
@@ -254,6 +257,9 @@ func (s *AccountService) UpdateAccountV2(
 	if err != nil {
 		return nil, err
 	}
+	if err := s.validateCustomRoleIDs(ctx, req.OrganizationId, req.EnvironmentRoles); err != nil {
+		return nil, err
+	}
 	updatedAccountPb, err := s.updateAccountV2NoCommandMysql(
 		ctx,
 		editor,
@@ -269,6 +275,7 @@ func (s *AccountService) UpdateAccountV2(
 		req.TeamChanges,
 		req.OrganizationRole,
 		req.EnvironmentRoles,
+		req.ClearEnvironmentRoles,
 		req.Disabled,
 	)
 	if err != nil {
@@ -363,6 +370,7 @@ func (s *AccountService) EnableAccountV2(
 		nil,
 		nil,
 		nil,
+		false,
 		wrapperspb.Bool(false),
 	)
 	if err != nil {
@@ -422,6 +430,7 @@ func (s *AccountService) DisableAccountV2(
 		nil,
 		nil,
 		nil,
+		false,
 		wrapperspb.Bool(true),
 	)
 	if err != nil {
@@ -481,6 +490,7 @@ func (s *AccountService) updateAccountV2NoCommandMysql(
 	teamChanges []*accountproto.TeamChange,
 	organizationRole *accountproto.UpdateAccountV2Request_OrganizationRoleValue,
 	environmentRoles []*accountproto.AccountV2_EnvironmentRole,
+	clearEnvironmentRoles bool,
 	isDisabled *wrapperspb.BoolValue,
 ) (*accountproto.AccountV2, error) {
 	var updatedAccountPb *accountproto.AccountV2
@@ -501,6 +511,7 @@ func (s *AccountService) updateAccountV2NoCommandMysql(
 			teamChanges,
 			organizationRole,
 			environmentRoles,
+			clearEnvironmentRoles,
 			isDisabled,
 		)
 		if err != nil {
@@ -692,7 +703,22 @@ func (s *AccountService) GetAccountV2ByEnvironmentID(
 	if err != nil {
 		return nil, err
 	}
-	return &accountproto.GetAccountV2ByEnvironmentIDResponse{Account: account.AccountV2}, nil
+	customRoles, err := s.environmentCustomRoles(ctx, account.AccountV2, req.EnvironmentId)
+	if err != nil {
+		s.logger.Error(
+			"Failed to get custom roles by environment id",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentID", req.EnvironmentId),
+				zap.String("email", req.Email),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	return &accountproto.GetAccountV2ByEnvironmentIDResponse{
+		Account:     account.AccountV2,
+		CustomRoles: customRoles,
+	}, nil
 }
 
 func (s *AccountService) getAccountV2ByEnvironmentID(
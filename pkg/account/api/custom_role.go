@@ -0,0 +1,420 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	v2as "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
+	domainauditlog "github.com/bucketeer-io/bucketeer/v2/pkg/auditlog/domain"
+	domainevent "github.com/bucketeer-io/bucketeer/v2/pkg/domainevent/domain"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage"
+	teamstorage "github.com/bucketeer-io/bucketeer/v2/pkg/team/storage"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+)
+
+func (s *AccountService) CreateCustomRole(
+	ctx context.Context,
+	req *proto.CreateCustomRoleRequest,
+) (*proto.CreateCustomRoleResponse, error) {
+	editor, err := s.checkOrganizationRole(
+		ctx,
+		proto.AccountV2_Role_Organization_ADMIN,
+		req.OrganizationId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCreateCustomRoleRequest(req); err != nil {
+		return nil, err
+	}
+	customRole, err := domain.NewCustomRole(req.Name, req.Description, req.OrganizationId, req.Permissions)
+	if err != nil {
+		s.logger.Error(
+			"Failed to create a new custom role",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("organizationId", req.OrganizationId),
+				zap.String("name", req.Name),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	var event *eventproto.Event
+	err = s.dbClient.RunInTransactionV2(ctx, func(contextWithTx context.Context) error {
+		event, err = domainevent.NewAdminEvent(
+			editor,
+			eventproto.Event_CUSTOM_ROLE,
+			customRole.Id,
+			eventproto.Event_CUSTOM_ROLE_CREATED,
+			&eventproto.CustomRoleCreatedEvent{
+				Id:             customRole.Id,
+				Name:           customRole.Name,
+				Description:    customRole.Description,
+				Permissions:    customRole.Permissions,
+				OrganizationId: customRole.OrganizationId,
+			},
+			customRole.CustomRole,
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+		if err := s.accountStorage.CreateCustomRole(contextWithTx, customRole); err != nil {
+			return err
+		}
+		return s.adminAuditLogStorage.CreateAdminAuditLog(
+			contextWithTx,
+			domainauditlog.NewAuditLog(event, storage.AdminEnvironmentID),
+		)
+	})
+	if err != nil {
+		if errors.Is(err, v2as.ErrCustomRoleAlreadyExists) {
+			return nil, statusCustomRoleAlreadyExists.Err()
+		}
+		s.logger.Error(
+			"Failed to create custom role",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("organizationId", req.OrganizationId),
+				zap.String("name", req.Name),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	if err := s.publisher.Publish(ctx, event); err != nil {
+		s.logger.Error(
+			"Failed to publish create custom role event",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("organizationId", req.OrganizationId),
+				zap.String("name", req.Name),
+			)...,
+		)
+		return nil, err
+	}
+	return &proto.CreateCustomRoleResponse{CustomRole: customRole.CustomRole}, nil
+}
+
+func (s *AccountService) UpdateCustomRole(
+	ctx context.Context,
+	req *proto.UpdateCustomRoleRequest,
+) (*proto.UpdateCustomRoleResponse, error) {
+	editor, err := s.checkOrganizationRole(
+		ctx,
+		proto.AccountV2_Role_Organization_ADMIN,
+		req.OrganizationId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateUpdateCustomRoleRequest(req); err != nil {
+		return nil, err
+	}
+	var event *eventproto.Event
+	var updated *domain.CustomRole
+	err = s.dbClient.RunInTransactionV2(ctx, func(contextWithTx context.Context) error {
+		customRole, err := s.accountStorage.GetCustomRole(contextWithTx, req.Id, req.OrganizationId)
+		if err != nil {
+			return err
+		}
+		updated = customRole.Update(req.Name, req.Description, req.Permissions)
+		event, err = domainevent.NewAdminEvent(
+			editor,
+			eventproto.Event_CUSTOM_ROLE,
+			updated.Id,
+			eventproto.Event_CUSTOM_ROLE_UPDATED,
+			&eventproto.CustomRoleUpdatedEvent{
+				Id:             updated.Id,
+				Name:           updated.Name,
+				Description:    updated.Description,
+				Permissions:    updated.Permissions,
+				OrganizationId: updated.OrganizationId,
+			},
+			updated.CustomRole,
+			customRole.CustomRole,
+		)
+		if err != nil {
+			return err
+		}
+		if err := s.accountStorage.UpdateCustomRole(contextWithTx, updated); err != nil {
+			return err
+		}
+		return s.adminAuditLogStorage.CreateAdminAuditLog(
+			contextWithTx,
+			domainauditlog.NewAuditLog(event, storage.AdminEnvironmentID),
+		)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, v2as.ErrCustomRoleNotFound):
+			return nil, statusCustomRoleNotFound.Err()
+		case errors.Is(err, v2as.ErrCustomRoleAlreadyExists):
+			return nil, statusCustomRoleAlreadyExists.Err()
+		}
+		s.logger.Error(
+			"Failed to update custom role",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("organizationId", req.OrganizationId),
+				zap.String("id", req.Id),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	if err := s.publisher.Publish(ctx, event); err != nil {
+		s.logger.Error(
+			"Failed to publish update custom role event",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("organizationId", req.OrganizationId),
+				zap.String("id", req.Id),
+			)...,
+		)
+		return nil, err
+	}
+	return &proto.UpdateCustomRoleResponse{CustomRole: updated.CustomRole}, nil
+}
+
+func (s *AccountService) GetCustomRole(
+	ctx context.Context,
+	req *proto.GetCustomRoleRequest,
+) (*proto.GetCustomRoleResponse, error) {
+	_, err := s.checkOrganizationRole(
+		ctx,
+		proto.AccountV2_Role_Organization_MEMBER,
+		req.OrganizationId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, statusMissingCustomRoleID.Err()
+	}
+	if req.OrganizationId == "" {
+		return nil, statusMissingOrganizationID.Err()
+	}
+	for _, r := range domain.BuiltInCustomRoles(req.OrganizationId) {
+		if r.Id == req.Id {
+			return &proto.GetCustomRoleResponse{CustomRole: r}, nil
+		}
+	}
+	customRole, err := s.accountStorage.GetCustomRole(ctx, req.Id, req.OrganizationId)
+	if err != nil {
+		if errors.Is(err, v2as.ErrCustomRoleNotFound) {
+			return nil, statusCustomRoleNotFound.Err()
+		}
+		s.logger.Error(
+			"Failed to get custom role",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("organizationId", req.OrganizationId),
+				zap.String("id", req.Id),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	return &proto.GetCustomRoleResponse{CustomRole: customRole.CustomRole}, nil
+}
+
+func (s *AccountService) ListCustomRoles(
+	ctx context.Context,
+	req *proto.ListCustomRolesRequest,
+) (*proto.ListCustomRolesResponse, error) {
+	_, err := s.checkOrganizationRole(
+		ctx,
+		proto.AccountV2_Role_Organization_MEMBER,
+		req.OrganizationId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if req.OrganizationId == "" {
+		return nil, statusMissingOrganizationID.Err()
+	}
+	customRoles, err := s.accountStorage.ListCustomRoles(ctx, req.OrganizationId)
+	if err != nil {
+		s.logger.Error(
+			"Failed to list custom roles",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("organizationId", req.OrganizationId),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	return &proto.ListCustomRolesResponse{
+		CustomRoles: append(domain.BuiltInCustomRoles(req.OrganizationId), customRoles...),
+	}, nil
+}
+
+// DeleteCustomRole deletes the role. The accounts and teams it is still assigned to
+// keep its ID in their environment roles, but unknown IDs grant nothing.
+func (s *AccountService) DeleteCustomRole(
+	ctx context.Context,
+	req *proto.DeleteCustomRoleRequest,
+) (*proto.DeleteCustomRoleResponse, error) {
+	editor, err := s.checkOrganizationRole(
+		ctx,
+		proto.AccountV2_Role_Organization_ADMIN,
+		req.OrganizationId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateDeleteCustomRoleRequest(req); err != nil {
+		return nil, err
+	}
+	var event *eventproto.Event
+	err = s.dbClient.RunInTransactionV2(ctx, func(contextWithTx context.Context) error {
+		customRole, err := s.accountStorage.GetCustomRole(contextWithTx, req.Id, req.OrganizationId)
+		if err != nil {
+			return err
+		}
+		event, err = domainevent.NewAdminEvent(
+			editor,
+			eventproto.Event_CUSTOM_ROLE,
+			customRole.Id,
+			eventproto.Event_CUSTOM_ROLE_DELETED,
+			&eventproto.CustomRoleDeletedEvent{
+				Id:             customRole.Id,
+				Name:           customRole.Name,
+				OrganizationId: customRole.OrganizationId,
+			},
+			nil,
+			customRole.CustomRole,
+		)
+		if err != nil {
+			return err
+		}
+		if err := s.accountStorage.DeleteCustomRole(contextWithTx, req.Id, req.OrganizationId); err != nil {
+			return err
+		}
+		return s.adminAuditLogStorage.CreateAdminAuditLog(
+			contextWithTx,
+			domainauditlog.NewAuditLog(event, storage.AdminEnvironmentID),
+		)
+	})
+	if err != nil {
+		if errors.Is(err, v2as.ErrCustomRoleNotFound) {
+			return nil, statusCustomRoleNotFound.Err()
+		}
+		s.logger.Error(
+			"Failed to delete custom role",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("organizationId", req.OrganizationId),
+				zap.String("id", req.Id),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	if err := s.publisher.Publish(ctx, event); err != nil {
+		s.logger.Error(
+			"Failed to publish delete custom role event",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("organizationId", req.OrganizationId),
+				zap.String("id", req.Id),
+			)...,
+		)
+		return nil, err
+	}
+	return &proto.DeleteCustomRoleResponse{}, nil
+}
+
+// environmentCustomRoles returns the custom roles assigned to the account in the environment,
+// either directly or through the teams the account belongs to.
+func (s *AccountService) environmentCustomRoles(
+	ctx context.Context,
+	account *proto.AccountV2,
+	environmentID string,
+) ([]*proto.CustomRole, error) {
+	ids := customRoleIDs(account.EnvironmentRoles, environmentID)
+	for _, name := range account.Teams {
+		team, err := s.teamStorage.GetTeamByName(ctx, name, account.OrganizationId)
+		if err != nil {
+			if errors.Is(err, teamstorage.ErrTeamNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		ids = append(ids, customRoleIDs(team.EnvironmentRoles, environmentID)...)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	customRoles, err := s.accountStorage.ListCustomRoles(ctx, account.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	assigned := make([]*proto.CustomRole, 0, len(ids))
+	for _, r := range customRoles {
+		if slices.Contains(ids, r.Id) {
+			assigned = append(assigned, r)
+		}
+	}
+	return assigned, nil
+}
+
+func customRoleIDs(roles []*proto.AccountV2_EnvironmentRole, environmentID string) []string {
+	for _, r := range roles {
+		if r.EnvironmentId == environmentID {
+			return slices.Clone(r.CustomRoleIds)
+		}
+	}
+	return nil
+}
+
+// validateCustomRoleIDs checks that the custom roles assigned in the environment roles
+// exist in the organization.
+func (s *AccountService) validateCustomRoleIDs(
+	ctx context.Context,
+	organizationID string,
+	environmentRoles []*proto.AccountV2_EnvironmentRole,
+) error {
+	var ids []string
+	for _, r := range environmentRoles {
+		ids = append(ids, r.CustomRoleIds...)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	customRoles, err := s.accountStorage.ListCustomRoles(ctx, organizationID)
+	if err != nil {
+		s.logger.Error(
+			"Failed to list custom roles",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("organizationId", organizationID),
+			)...,
+		)
+		return api.NewGRPCStatus(err).Err()
+	}
+	for _, id := range ids {
+		if !slices.ContainsFunc(customRoles, func(r *proto.CustomRole) bool { return r.Id == id }) {
+			return statusCustomRoleNotFound.Err()
+		}
+	}
+	return nil
+}
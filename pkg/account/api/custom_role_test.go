@@ -0,0 +1,418 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	v2as "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2"
+	accstoragemock "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2/mock"
+	alstoragemock "github.com/bucketeer-io/bucketeer/v2/pkg/auditlog/storage/v2/mock"
+	publishermock "github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher/mock"
+	dbmock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database/mock"
+	teamdomain "github.com/bucketeer-io/bucketeer/v2/pkg/team/domain"
+	teamstorage "github.com/bucketeer-io/bucketeer/v2/pkg/team/storage"
+	teamstoragemock "github.com/bucketeer-io/bucketeer/v2/pkg/team/storage/mock"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	teamproto "github.com/bucketeer-io/bucketeer/v2/proto/team"
+)
+
+var testPermissions = []*accountproto.Permission{
+	{
+		ResourceType: accountproto.Permission_FEATURE,
+		Actions:      []accountproto.Permission_Action{accountproto.Permission_READ},
+	},
+}
+
+func TestCreateCustomRole(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{
+		"accept-language": []string{"ja"},
+	})
+	patterns := []struct {
+		desc        string
+		setup       func(*AccountService)
+		req         *accountproto.CreateCustomRoleRequest
+		expectedErr error
+	}{
+		{
+			desc: "errPermissionDenied",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_MEMBER)
+			},
+			req: &accountproto.CreateCustomRoleRequest{
+				OrganizationId: "org0", Name: "qa", Permissions: testPermissions,
+			},
+			expectedErr: statusPermissionDenied.Err(),
+		},
+		{
+			desc: "errMissingName",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_ADMIN)
+			},
+			req:         &accountproto.CreateCustomRoleRequest{OrganizationId: "org0", Permissions: testPermissions},
+			expectedErr: statusMissingCustomRoleName.Err(),
+		},
+		{
+			desc: "errMissingPermissions",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_ADMIN)
+			},
+			req:         &accountproto.CreateCustomRoleRequest{OrganizationId: "org0", Name: "qa"},
+			expectedErr: statusMissingCustomRolePermissions.Err(),
+		},
+		{
+			desc: "errInvalidPermission",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_ADMIN)
+			},
+			req: &accountproto.CreateCustomRoleRequest{
+				OrganizationId: "org0",
+				Name:           "qa",
+				Permissions:    []*accountproto.Permission{{ResourceType: accountproto.Permission_FEATURE}},
+			},
+			expectedErr: statusInvalidCustomRolePermission.Err(),
+		},
+		{
+			desc: "errAlreadyExists",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_ADMIN)
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).Return(v2as.ErrCustomRoleAlreadyExists)
+			},
+			req: &accountproto.CreateCustomRoleRequest{
+				OrganizationId: "org0", Name: "qa", Permissions: testPermissions,
+			},
+			expectedErr: statusCustomRoleAlreadyExists.Err(),
+		},
+		{
+			desc: "success",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_ADMIN)
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).Do(func(ctx context.Context, fn func(ctx context.Context) error) {
+					require.NoError(t, fn(ctx))
+				}).Return(nil)
+				s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().CreateCustomRole(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.adminAuditLogStorage.(*alstoragemock.MockAdminAuditLogStorage).EXPECT().CreateAdminAuditLog(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.publisher.(*publishermock.MockPublisher).EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			req: &accountproto.CreateCustomRoleRequest{
+				OrganizationId: "org0", Name: "qa", Permissions: testPermissions,
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			ctx = setToken(ctx, false)
+			service := createAccountService(t, mockController, nil)
+			if p.setup != nil {
+				p.setup(service)
+			}
+			resp, err := service.CreateCustomRole(ctx, p.req)
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, p.req.Name, resp.CustomRole.Name)
+				assert.Equal(t, p.req.Permissions, resp.CustomRole.Permissions)
+			}
+		})
+	}
+}
+
+func TestUpdateCustomRole(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{
+		"accept-language": []string{"ja"},
+	})
+	patterns := []struct {
+		desc        string
+		setup       func(*AccountService)
+		req         *accountproto.UpdateCustomRoleRequest
+		expectedErr error
+	}{
+		{
+			desc: "errBuiltInRole",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_ADMIN)
+			},
+			req: &accountproto.UpdateCustomRoleRequest{
+				Id: domain.BuiltInEditorRoleID, OrganizationId: "org0", Name: wrapperspb.String("editor"),
+			},
+			expectedErr: statusBuiltInCustomRole.Err(),
+		},
+		{
+			desc: "errNotFound",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_ADMIN)
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).Return(v2as.ErrCustomRoleNotFound)
+			},
+			req:         &accountproto.UpdateCustomRoleRequest{Id: "id-0", OrganizationId: "org0"},
+			expectedErr: statusCustomRoleNotFound.Err(),
+		},
+		{
+			desc: "success",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_ADMIN)
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).Do(func(ctx context.Context, fn func(ctx context.Context) error) {
+					require.NoError(t, fn(ctx))
+				}).Return(nil)
+				s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().GetCustomRole(
+					gomock.Any(), "id-0", "org0",
+				).Return(&domain.CustomRole{CustomRole: &accountproto.CustomRole{
+					Id: "id-0", OrganizationId: "org0", Name: "qa", Permissions: testPermissions,
+				}}, nil)
+				s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().UpdateCustomRole(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.adminAuditLogStorage.(*alstoragemock.MockAdminAuditLogStorage).EXPECT().CreateAdminAuditLog(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.publisher.(*publishermock.MockPublisher).EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			req: &accountproto.UpdateCustomRoleRequest{
+				Id: "id-0", OrganizationId: "org0", Name: wrapperspb.String("qa-lead"),
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			ctx = setToken(ctx, false)
+			service := createAccountService(t, mockController, nil)
+			if p.setup != nil {
+				p.setup(service)
+			}
+			resp, err := service.UpdateCustomRole(ctx, p.req)
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, "qa-lead", resp.CustomRole.Name)
+				require.Len(t, resp.CustomRole.Permissions, 1)
+				assert.Equal(t, accountproto.Permission_FEATURE, resp.CustomRole.Permissions[0].ResourceType)
+			}
+		})
+	}
+}
+
+func TestGetCustomRole(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{
+		"accept-language": []string{"ja"},
+	})
+	patterns := []struct {
+		desc        string
+		setup       func(*AccountService)
+		req         *accountproto.GetCustomRoleRequest
+		expectedID  string
+		expectedErr error
+	}{
+		{
+			desc: "errNotFound",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_MEMBER)
+				s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().GetCustomRole(
+					gomock.Any(), "id-0", "org0",
+				).Return(nil, v2as.ErrCustomRoleNotFound)
+			},
+			req:         &accountproto.GetCustomRoleRequest{Id: "id-0", OrganizationId: "org0"},
+			expectedErr: statusCustomRoleNotFound.Err(),
+		},
+		{
+			desc: "success: built-in",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_MEMBER)
+			},
+			req:        &accountproto.GetCustomRoleRequest{Id: domain.BuiltInViewerRoleID, OrganizationId: "org0"},
+			expectedID: domain.BuiltInViewerRoleID,
+		},
+		{
+			desc: "success",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_MEMBER)
+				s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().GetCustomRole(
+					gomock.Any(), "id-0", "org0",
+				).Return(&domain.CustomRole{CustomRole: &accountproto.CustomRole{Id: "id-0"}}, nil)
+			},
+			req:        &accountproto.GetCustomRoleRequest{Id: "id-0", OrganizationId: "org0"},
+			expectedID: "id-0",
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			ctx = setToken(ctx, false)
+			service := createAccountService(t, mockController, nil)
+			if p.setup != nil {
+				p.setup(service)
+			}
+			resp, err := service.GetCustomRole(ctx, p.req)
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, p.expectedID, resp.CustomRole.Id)
+			}
+		})
+	}
+}
+
+func TestListCustomRoles(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := setToken(metadata.NewIncomingContext(context.Background(), metadata.MD{
+		"accept-language": []string{"ja"},
+	}), false)
+	service := createAccountService(t, mockController, nil)
+	setOrganizationRole(service, accountproto.AccountV2_Role_Organization_MEMBER)
+	service.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().ListCustomRoles(
+		gomock.Any(), "org0",
+	).Return([]*accountproto.CustomRole{{Id: "id-0", Name: "qa"}}, nil)
+	resp, err := service.ListCustomRoles(ctx, &accountproto.ListCustomRolesRequest{OrganizationId: "org0"})
+	require.NoError(t, err)
+	require.Len(t, resp.CustomRoles, 3)
+	assert.Equal(t, domain.BuiltInViewerRoleID, resp.CustomRoles[0].Id)
+	assert.Equal(t, domain.BuiltInEditorRoleID, resp.CustomRoles[1].Id)
+	assert.Equal(t, "id-0", resp.CustomRoles[2].Id)
+}
+
+func TestDeleteCustomRole(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{
+		"accept-language": []string{"ja"},
+	})
+	patterns := []struct {
+		desc        string
+		setup       func(*AccountService)
+		req         *accountproto.DeleteCustomRoleRequest
+		expectedErr error
+	}{
+		{
+			desc: "errPermissionDenied",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_MEMBER)
+			},
+			req:         &accountproto.DeleteCustomRoleRequest{Id: "id-0", OrganizationId: "org0"},
+			expectedErr: statusPermissionDenied.Err(),
+		},
+		{
+			desc: "errBuiltInRole",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_ADMIN)
+			},
+			req:         &accountproto.DeleteCustomRoleRequest{Id: domain.BuiltInViewerRoleID, OrganizationId: "org0"},
+			expectedErr: statusBuiltInCustomRole.Err(),
+		},
+		{
+			desc: "success",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_ADMIN)
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).Do(func(ctx context.Context, fn func(ctx context.Context) error) {
+					require.NoError(t, fn(ctx))
+				}).Return(nil)
+				s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().GetCustomRole(
+					gomock.Any(), "id-0", "org0",
+				).Return(&domain.CustomRole{CustomRole: &accountproto.CustomRole{Id: "id-0"}}, nil)
+				s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().DeleteCustomRole(
+					gomock.Any(), "id-0", "org0",
+				).Return(nil)
+				s.adminAuditLogStorage.(*alstoragemock.MockAdminAuditLogStorage).EXPECT().CreateAdminAuditLog(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.publisher.(*publishermock.MockPublisher).EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			req:         &accountproto.DeleteCustomRoleRequest{Id: "id-0", OrganizationId: "org0"},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			ctx = setToken(ctx, false)
+			service := createAccountService(t, mockController, nil)
+			if p.setup != nil {
+				p.setup(service)
+			}
+			_, err := service.DeleteCustomRole(ctx, p.req)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestEnvironmentCustomRoles(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	service := createAccountService(t, mockController, nil)
+	service.teamStorage.(*teamstoragemock.MockTeamStorage).EXPECT().GetTeamByName(
+		gomock.Any(), "qa-team", "org0",
+	).Return(&teamdomain.Team{Team: &teamproto.Team{
+		EnvironmentRoles: []*accountproto.AccountV2_EnvironmentRole{
+			{EnvironmentId: "env0", CustomRoleIds: []string{"role-team"}},
+			{EnvironmentId: "env1", CustomRoleIds: []string{"role-other"}},
+		},
+	}}, nil)
+	service.teamStorage.(*teamstoragemock.MockTeamStorage).EXPECT().GetTeamByName(
+		gomock.Any(), "deleted-team", "org0",
+	).Return(nil, teamstorage.ErrTeamNotFound)
+	service.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().ListCustomRoles(
+		gomock.Any(), "org0",
+	).Return([]*accountproto.CustomRole{
+		{Id: "role-account"},
+		{Id: "role-team"},
+		{Id: "role-other"},
+	}, nil)
+	roles, err := service.environmentCustomRoles(context.Background(), &accountproto.AccountV2{
+		OrganizationId: "org0",
+		EnvironmentRoles: []*accountproto.AccountV2_EnvironmentRole{
+			{EnvironmentId: "env0", CustomRoleIds: []string{"role-account", "role-deleted"}},
+		},
+		Teams: []string{"qa-team", "deleted-team"},
+	}, "env0")
+	require.NoError(t, err)
+	require.Len(t, roles, 2)
+	assert.Equal(t, "role-account", roles[0].Id)
+	assert.Equal(t, "role-team", roles[1].Id)
+}
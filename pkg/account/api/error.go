@@ -50,4 +50,15 @@ var (
 	statusSearchFilterIDIsEmpty            = api.NewGRPCStatus(pkgErr.NewErrorInvalidArgEmpty(pkgErr.AccountPackageName, "search filter ID is empty", "SearchFilterId"))
 	statusSearchFilterIDNotFound           = api.NewGRPCStatus(pkgErr.NewErrorNotFound(pkgErr.AccountPackageName, "search filter not found", "MemberSearchFilter"))
 	statusInvalidListAPIKeyRequest         = api.NewGRPCStatus(pkgErr.NewErrorInvalidArgEmpty(pkgErr.AccountPackageName, "invalid list api key request", "ListAPIKeyRequest"))
+	statusClearEnvironmentRolesConflict    = api.NewGRPCStatus(pkgErr.NewErrorInvalidArgNotMatchFormat(pkgErr.AccountPackageName, "environment roles must be empty when clearing them", "MemberEnvironmentRoles"))
+	statusMissingSCIMTokenID               = api.NewGRPCStatus(pkgErr.NewErrorInvalidArgEmpty(pkgErr.AccountPackageName, "scim token id must be specified", "SCIMToken"))
+	statusMissingSCIMTokenName             = api.NewGRPCStatus(pkgErr.NewErrorInvalidArgEmpty(pkgErr.AccountPackageName, "scim token name must be not empty", "SCIMToken"))
+	statusSCIMTokenNotFound                = api.NewGRPCStatus(pkgErr.NewErrorNotFound(pkgErr.AccountPackageName, "scim token not found", "SCIMToken"))
+	statusMissingCustomRoleID              = api.NewGRPCStatus(pkgErr.NewErrorInvalidArgEmpty(pkgErr.AccountPackageName, "custom role id must be specified", "CustomRole"))
+	statusMissingCustomRoleName            = api.NewGRPCStatus(pkgErr.NewErrorInvalidArgEmpty(pkgErr.AccountPackageName, "custom role name must be not empty", "CustomRole"))
+	statusMissingCustomRolePermissions     = api.NewGRPCStatus(pkgErr.NewErrorInvalidArgEmpty(pkgErr.AccountPackageName, "custom role permissions must be not empty", "CustomRolePermissions"))
+	statusInvalidCustomRolePermission      = api.NewGRPCStatus(pkgErr.NewErrorInvalidArgNotMatchFormat(pkgErr.AccountPackageName, "custom role permission must have a resource type and actions", "CustomRolePermissions"))
+	statusBuiltInCustomRole                = api.NewGRPCStatus(pkgErr.NewErrorInvalidArgNotMatchFormat(pkgErr.AccountPackageName, "built-in role cannot be changed", "CustomRole"))
+	statusCustomRoleNotFound               = api.NewGRPCStatus(pkgErr.NewErrorNotFound(pkgErr.AccountPackageName, "custom role not found", "CustomRole"))
+	statusCustomRoleAlreadyExists          = api.NewGRPCStatus(pkgErr.NewErrorAlreadyExists(pkgErr.AccountPackageName, "custom role already exists"))
 )
@@ -0,0 +1,215 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	v2as "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
+	domainauditlog "github.com/bucketeer-io/bucketeer/v2/pkg/auditlog/domain"
+	domainevent "github.com/bucketeer-io/bucketeer/v2/pkg/domainevent/domain"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+)
+
+func (s *AccountService) CreateSCIMToken(
+	ctx context.Context,
+	req *proto.CreateSCIMTokenRequest,
+) (*proto.CreateSCIMTokenResponse, error) {
+	editor, err := s.checkOrganizationRole(
+		ctx,
+		proto.AccountV2_Role_Organization_ADMIN,
+		req.OrganizationId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCreateSCIMTokenRequest(req); err != nil {
+		return nil, err
+	}
+	scimToken, token, err := domain.NewSCIMToken(req.Name, req.OrganizationId)
+	if err != nil {
+		s.logger.Error(
+			"Failed to create a new scim token",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("organizationId", req.OrganizationId),
+				zap.String("name", req.Name),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	var event *eventproto.Event
+	err = s.dbClient.RunInTransactionV2(ctx, func(contextWithTx context.Context) error {
+		event, err = domainevent.NewAdminEvent(
+			editor,
+			eventproto.Event_SCIM_TOKEN,
+			scimToken.Id,
+			eventproto.Event_SCIM_TOKEN_CREATED,
+			&eventproto.SCIMTokenCreatedEvent{
+				Id:             scimToken.Id,
+				Name:           scimToken.Name,
+				OrganizationId: scimToken.OrganizationId,
+			},
+			scimToken.SCIMToken,
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+		if err := s.accountStorage.CreateSCIMToken(contextWithTx, scimToken); err != nil {
+			return err
+		}
+		return s.adminAuditLogStorage.CreateAdminAuditLog(
+			contextWithTx,
+			domainauditlog.NewAuditLog(event, storage.AdminEnvironmentID),
+		)
+	})
+	if err != nil {
+		s.logger.Error(
+			"Failed to create scim token",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("organizationId", req.OrganizationId),
+				zap.String("name", req.Name),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	if err := s.publisher.Publish(ctx, event); err != nil {
+		s.logger.Error(
+			"Failed to publish create scim token event",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("organizationId", req.OrganizationId),
+				zap.String("name", req.Name),
+			)...,
+		)
+		return nil, err
+	}
+	return &proto.CreateSCIMTokenResponse{
+		ScimToken: scimToken.SCIMToken,
+		Token:     token,
+	}, nil
+}
+
+func (s *AccountService) ListSCIMTokens(
+	ctx context.Context,
+	req *proto.ListSCIMTokensRequest,
+) (*proto.ListSCIMTokensResponse, error) {
+	_, err := s.checkOrganizationRole(
+		ctx,
+		proto.AccountV2_Role_Organization_ADMIN,
+		req.OrganizationId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if req.OrganizationId == "" {
+		return nil, statusMissingOrganizationID.Err()
+	}
+	tokens, err := s.accountStorage.ListSCIMTokens(ctx, req.OrganizationId)
+	if err != nil {
+		s.logger.Error(
+			"Failed to list scim tokens",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("organizationId", req.OrganizationId),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	return &proto.ListSCIMTokensResponse{ScimTokens: tokens}, nil
+}
+
+func (s *AccountService) DeleteSCIMToken(
+	ctx context.Context,
+	req *proto.DeleteSCIMTokenRequest,
+) (*proto.DeleteSCIMTokenResponse, error) {
+	editor, err := s.checkOrganizationRole(
+		ctx,
+		proto.AccountV2_Role_Organization_ADMIN,
+		req.OrganizationId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateDeleteSCIMTokenRequest(req); err != nil {
+		return nil, err
+	}
+	var event *eventproto.Event
+	err = s.dbClient.RunInTransactionV2(ctx, func(contextWithTx context.Context) error {
+		scimToken, err := s.accountStorage.GetSCIMToken(contextWithTx, req.Id, req.OrganizationId)
+		if err != nil {
+			return err
+		}
+		event, err = domainevent.NewAdminEvent(
+			editor,
+			eventproto.Event_SCIM_TOKEN,
+			scimToken.Id,
+			eventproto.Event_SCIM_TOKEN_DELETED,
+			&eventproto.SCIMTokenDeletedEvent{
+				Id:             scimToken.Id,
+				Name:           scimToken.Name,
+				OrganizationId: scimToken.OrganizationId,
+			},
+			nil,
+			scimToken.SCIMToken,
+		)
+		if err != nil {
+			return err
+		}
+		if err := s.accountStorage.DeleteSCIMToken(contextWithTx, req.Id, req.OrganizationId); err != nil {
+			return err
+		}
+		return s.adminAuditLogStorage.CreateAdminAuditLog(
+			contextWithTx,
+			domainauditlog.NewAuditLog(event, storage.AdminEnvironmentID),
+		)
+	})
+	if err != nil {
+		if errors.Is(err, v2as.ErrSCIMTokenNotFound) {
+			return nil, statusSCIMTokenNotFound.Err()
+		}
+		s.logger.Error(
+			"Failed to delete scim token",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("organizationId", req.OrganizationId),
+				zap.String("id", req.Id),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	if err := s.publisher.Publish(ctx, event); err != nil {
+		s.logger.Error(
+			"Failed to publish delete scim token event",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("organizationId", req.OrganizationId),
+				zap.String("id", req.Id),
+			)...,
+		)
+		return nil, err
+	}
+	return &proto.DeleteSCIMTokenResponse{}, nil
+}
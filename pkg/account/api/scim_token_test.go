@@ -0,0 +1,244 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	v2as "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2"
+	accstoragemock "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2/mock"
+	alstoragemock "github.com/bucketeer-io/bucketeer/v2/pkg/auditlog/storage/v2/mock"
+	publishermock "github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher/mock"
+	dbmock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database/mock"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+)
+
+func setOrganizationRole(s *AccountService, role accountproto.AccountV2_Role_Organization) {
+	s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().GetAccountV2(
+		gomock.Any(), gomock.Any(), gomock.Any(),
+	).Return(&domain.AccountV2{
+		AccountV2: &accountproto.AccountV2{
+			Email:            "email",
+			OrganizationId:   "org0",
+			OrganizationRole: role,
+		},
+	}, nil)
+}
+
+func TestCreateSCIMToken(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{
+		"accept-language": []string{"ja"},
+	})
+	patterns := []struct {
+		desc        string
+		setup       func(*AccountService)
+		req         *accountproto.CreateSCIMTokenRequest
+		expectedErr error
+	}{
+		{
+			desc: "errPermissionDenied",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_MEMBER)
+			},
+			req:         &accountproto.CreateSCIMTokenRequest{OrganizationId: "org0", Name: "okta"},
+			expectedErr: statusPermissionDenied.Err(),
+		},
+		{
+			desc: "errMissingName",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_ADMIN)
+			},
+			req:         &accountproto.CreateSCIMTokenRequest{OrganizationId: "org0"},
+			expectedErr: statusMissingSCIMTokenName.Err(),
+		},
+		{
+			desc: "success",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_ADMIN)
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).Do(func(ctx context.Context, fn func(ctx context.Context) error) {
+					require.NoError(t, fn(ctx))
+				}).Return(nil)
+				s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().CreateSCIMToken(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.adminAuditLogStorage.(*alstoragemock.MockAdminAuditLogStorage).EXPECT().CreateAdminAuditLog(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.publisher.(*publishermock.MockPublisher).EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			req:         &accountproto.CreateSCIMTokenRequest{OrganizationId: "org0", Name: "okta"},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			ctx = setToken(ctx, false)
+			service := createAccountService(t, mockController, nil)
+			if p.setup != nil {
+				p.setup(service)
+			}
+			resp, err := service.CreateSCIMToken(ctx, p.req)
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, p.req.Name, resp.ScimToken.Name)
+				assert.Equal(t, p.req.OrganizationId, resp.ScimToken.OrganizationId)
+				assert.NotEmpty(t, resp.Token)
+			}
+		})
+	}
+}
+
+func TestListSCIMTokens(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{
+		"accept-language": []string{"ja"},
+	})
+	tokens := []*accountproto.SCIMToken{{Id: "id-0", Name: "okta", OrganizationId: "org0"}}
+	patterns := []struct {
+		desc        string
+		setup       func(*AccountService)
+		req         *accountproto.ListSCIMTokensRequest
+		expected    []*accountproto.SCIMToken
+		expectedErr error
+	}{
+		{
+			desc: "errPermissionDenied",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_MEMBER)
+			},
+			req:         &accountproto.ListSCIMTokensRequest{OrganizationId: "org0"},
+			expectedErr: statusPermissionDenied.Err(),
+		},
+		{
+			desc: "success",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_ADMIN)
+				s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().ListSCIMTokens(
+					gomock.Any(), "org0",
+				).Return(tokens, nil)
+			},
+			req:         &accountproto.ListSCIMTokensRequest{OrganizationId: "org0"},
+			expected:    tokens,
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			ctx = setToken(ctx, false)
+			service := createAccountService(t, mockController, nil)
+			if p.setup != nil {
+				p.setup(service)
+			}
+			resp, err := service.ListSCIMTokens(ctx, p.req)
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, p.expected, resp.ScimTokens)
+			}
+		})
+	}
+}
+
+func TestDeleteSCIMToken(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{
+		"accept-language": []string{"ja"},
+	})
+	patterns := []struct {
+		desc        string
+		setup       func(*AccountService)
+		req         *accountproto.DeleteSCIMTokenRequest
+		expectedErr error
+	}{
+		{
+			desc: "errMissingID",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_ADMIN)
+			},
+			req:         &accountproto.DeleteSCIMTokenRequest{OrganizationId: "org0"},
+			expectedErr: statusMissingSCIMTokenID.Err(),
+		},
+		{
+			desc: "errNotFound",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_ADMIN)
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().GetSCIMToken(
+					gomock.Any(), "id-0", "org0",
+				).Return(nil, v2as.ErrSCIMTokenNotFound)
+			},
+			req:         &accountproto.DeleteSCIMTokenRequest{Id: "id-0", OrganizationId: "org0"},
+			expectedErr: statusSCIMTokenNotFound.Err(),
+		},
+		{
+			desc: "success",
+			setup: func(s *AccountService) {
+				setOrganizationRole(s, accountproto.AccountV2_Role_Organization_ADMIN)
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().GetSCIMToken(
+					gomock.Any(), "id-0", "org0",
+				).Return(&domain.SCIMToken{
+					SCIMToken: &accountproto.SCIMToken{Id: "id-0", Name: "okta", OrganizationId: "org0"},
+				}, nil)
+				s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().DeleteSCIMToken(
+					gomock.Any(), "id-0", "org0",
+				).Return(nil)
+				s.adminAuditLogStorage.(*alstoragemock.MockAdminAuditLogStorage).EXPECT().CreateAdminAuditLog(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.publisher.(*publishermock.MockPublisher).EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			req:         &accountproto.DeleteSCIMTokenRequest{Id: "id-0", OrganizationId: "org0"},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			ctx = setToken(ctx, false)
+			service := createAccountService(t, mockController, nil)
+			if p.setup != nil {
+				p.setup(service)
+			}
+			_, err := service.DeleteSCIMToken(ctx, p.req)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"strings"
 
 	"github.com/bucketeer-io/bucketeer/v2/pkg/account/command"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
 	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
 )
 
@@ -57,7 +58,7 @@ func validateCreateAccountV2Request(
 	if req.OrganizationRole == accountproto.AccountV2_Role_Organization_UNASSIGNED {
 		return statusInvalidOrganizationRole.Err()
 	}
-	if req.OrganizationRole == accountproto.AccountV2_Role_Organization_MEMBER {
+	if req.OrganizationRole == accountproto.AccountV2_Role_Organization_MEMBER && !req.AllowEmptyEnvironmentRoles {
 		if len(req.EnvironmentRoles) == 0 {
 			return statusInvalidEnvironmentRole.Err()
 		}
@@ -108,6 +109,9 @@ func validateUpdateAccountV2Request(
 			return statusInvalidEnvironmentRole.Err()
 		}
 	}
+	if req.ClearEnvironmentRoles && len(req.EnvironmentRoles) > 0 {
+		return statusClearEnvironmentRolesConflict.Err()
+	}
 	return nil
 }
 
@@ -270,3 +274,74 @@ func validateUpdateAPIKeyRequest(req *accountproto.UpdateAPIKeyRequest) error {
 	}
 	return nil
 }
+
+func validateCreateSCIMTokenRequest(req *accountproto.CreateSCIMTokenRequest) error {
+	if req.OrganizationId == "" {
+		return statusMissingOrganizationID.Err()
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return statusMissingSCIMTokenName.Err()
+	}
+	return nil
+}
+
+func validateDeleteSCIMTokenRequest(req *accountproto.DeleteSCIMTokenRequest) error {
+	if req.Id == "" {
+		return statusMissingSCIMTokenID.Err()
+	}
+	if req.OrganizationId == "" {
+		return statusMissingOrganizationID.Err()
+	}
+	return nil
+}
+
+func validateCreateCustomRoleRequest(req *accountproto.CreateCustomRoleRequest) error {
+	if req.OrganizationId == "" {
+		return statusMissingOrganizationID.Err()
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return statusMissingCustomRoleName.Err()
+	}
+	if len(req.Permissions) == 0 {
+		return statusMissingCustomRolePermissions.Err()
+	}
+	return validatePermissions(req.Permissions)
+}
+
+func validateUpdateCustomRoleRequest(req *accountproto.UpdateCustomRoleRequest) error {
+	if req.Id == "" {
+		return statusMissingCustomRoleID.Err()
+	}
+	if req.OrganizationId == "" {
+		return statusMissingOrganizationID.Err()
+	}
+	if domain.IsBuiltInCustomRoleID(req.Id) {
+		return statusBuiltInCustomRole.Err()
+	}
+	if req.Name != nil && strings.TrimSpace(req.Name.Value) == "" {
+		return statusMissingCustomRoleName.Err()
+	}
+	return validatePermissions(req.Permissions)
+}
+
+func validateDeleteCustomRoleRequest(req *accountproto.DeleteCustomRoleRequest) error {
+	if req.Id == "" {
+		return statusMissingCustomRoleID.Err()
+	}
+	if req.OrganizationId == "" {
+		return statusMissingOrganizationID.Err()
+	}
+	if domain.IsBuiltInCustomRoleID(req.Id) {
+		return statusBuiltInCustomRole.Err()
+	}
+	return nil
+}
+
+func validatePermissions(permissions []*accountproto.Permission) error {
+	for _, p := range permissions {
+		if !domain.ValidatePermission(p) {
+			return statusInvalidCustomRolePermission.Err()
+		}
+	}
+	return nil
+}
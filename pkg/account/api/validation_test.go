@@ -85,6 +85,16 @@ func TestValidateCreateAccountV2Request(t *testing.T) {
 			},
 			expectedErr: statusInvalidEnvironmentRole.Err(),
 		},
+		{
+			desc: "success: member role allowed to have no environment roles",
+			req: &accountproto.CreateAccountV2Request{
+				OrganizationId:             "org-id",
+				Email:                      "test@example.com",
+				OrganizationRole:           accountproto.AccountV2_Role_Organization_MEMBER,
+				AllowEmptyEnvironmentRoles: true,
+			},
+			expectedErr: nil,
+		},
 		{
 			desc: "success: admin role without environment roles",
 			req: &accountproto.CreateAccountV2Request{
@@ -94,6 +94,46 @@ func (mr *MockClientMockRecorder) CreateAccountV2(ctx, in any, opts ...any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountV2", reflect.TypeOf((*MockClient)(nil).CreateAccountV2), varargs...)
 }
 
+// CreateCustomRole mocks base method.
+func (m *MockClient) CreateCustomRole(ctx context.Context, in *account.CreateCustomRoleRequest, opts ...grpc.CallOption) (*account.CreateCustomRoleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCustomRole", varargs...)
+	ret0, _ := ret[0].(*account.CreateCustomRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomRole indicates an expected call of CreateCustomRole.
+func (mr *MockClientMockRecorder) CreateCustomRole(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomRole", reflect.TypeOf((*MockClient)(nil).CreateCustomRole), varargs...)
+}
+
+// CreateSCIMToken mocks base method.
+func (m *MockClient) CreateSCIMToken(ctx context.Context, in *account.CreateSCIMTokenRequest, opts ...grpc.CallOption) (*account.CreateSCIMTokenResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSCIMToken", varargs...)
+	ret0, _ := ret[0].(*account.CreateSCIMTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSCIMToken indicates an expected call of CreateSCIMToken.
+func (mr *MockClientMockRecorder) CreateSCIMToken(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSCIMToken", reflect.TypeOf((*MockClient)(nil).CreateSCIMToken), varargs...)
+}
+
 // CreateSearchFilter mocks base method.
 func (m *MockClient) CreateSearchFilter(ctx context.Context, in *account.CreateSearchFilterRequest, opts ...grpc.CallOption) (*account.CreateSearchFilterResponse, error) {
 	m.ctrl.T.Helper()
@@ -134,6 +174,46 @@ func (mr *MockClientMockRecorder) DeleteAccountV2(ctx, in any, opts ...any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountV2", reflect.TypeOf((*MockClient)(nil).DeleteAccountV2), varargs...)
 }
 
+// DeleteCustomRole mocks base method.
+func (m *MockClient) DeleteCustomRole(ctx context.Context, in *account.DeleteCustomRoleRequest, opts ...grpc.CallOption) (*account.DeleteCustomRoleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCustomRole", varargs...)
+	ret0, _ := ret[0].(*account.DeleteCustomRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCustomRole indicates an expected call of DeleteCustomRole.
+func (mr *MockClientMockRecorder) DeleteCustomRole(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomRole", reflect.TypeOf((*MockClient)(nil).DeleteCustomRole), varargs...)
+}
+
+// DeleteSCIMToken mocks base method.
+func (m *MockClient) DeleteSCIMToken(ctx context.Context, in *account.DeleteSCIMTokenRequest, opts ...grpc.CallOption) (*account.DeleteSCIMTokenResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSCIMToken", varargs...)
+	ret0, _ := ret[0].(*account.DeleteSCIMTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSCIMToken indicates an expected call of DeleteSCIMToken.
+func (mr *MockClientMockRecorder) DeleteSCIMToken(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSCIMToken", reflect.TypeOf((*MockClient)(nil).DeleteSCIMToken), varargs...)
+}
+
 // DeleteSearchFilter mocks base method.
 func (m *MockClient) DeleteSearchFilter(ctx context.Context, in *account.DeleteSearchFilterRequest, opts ...grpc.CallOption) (*account.DeleteSearchFilterResponse, error) {
 	m.ctrl.T.Helper()
@@ -254,6 +334,26 @@ func (mr *MockClientMockRecorder) GetAccountV2ByEnvironmentID(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountV2ByEnvironmentID", reflect.TypeOf((*MockClient)(nil).GetAccountV2ByEnvironmentID), varargs...)
 }
 
+// GetCustomRole mocks base method.
+func (m *MockClient) GetCustomRole(ctx context.Context, in *account.GetCustomRoleRequest, opts ...grpc.CallOption) (*account.GetCustomRoleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCustomRole", varargs...)
+	ret0, _ := ret[0].(*account.GetCustomRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomRole indicates an expected call of GetCustomRole.
+func (mr *MockClientMockRecorder) GetCustomRole(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomRole", reflect.TypeOf((*MockClient)(nil).GetCustomRole), varargs...)
+}
+
 // GetEnvironmentAPIKey mocks base method.
 func (m *MockClient) GetEnvironmentAPIKey(ctx context.Context, in *account.GetEnvironmentAPIKeyRequest, opts ...grpc.CallOption) (*account.GetEnvironmentAPIKeyResponse, error) {
 	m.ctrl.T.Helper()
@@ -374,6 +474,46 @@ func (mr *MockClientMockRecorder) ListAccountsV2(ctx, in any, opts ...any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsV2", reflect.TypeOf((*MockClient)(nil).ListAccountsV2), varargs...)
 }
 
+// ListCustomRoles mocks base method.
+func (m *MockClient) ListCustomRoles(ctx context.Context, in *account.ListCustomRolesRequest, opts ...grpc.CallOption) (*account.ListCustomRolesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCustomRoles", varargs...)
+	ret0, _ := ret[0].(*account.ListCustomRolesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomRoles indicates an expected call of ListCustomRoles.
+func (mr *MockClientMockRecorder) ListCustomRoles(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoles", reflect.TypeOf((*MockClient)(nil).ListCustomRoles), varargs...)
+}
+
+// ListSCIMTokens mocks base method.
+func (m *MockClient) ListSCIMTokens(ctx context.Context, in *account.ListSCIMTokensRequest, opts ...grpc.CallOption) (*account.ListSCIMTokensResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSCIMTokens", varargs...)
+	ret0, _ := ret[0].(*account.ListSCIMTokensResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSCIMTokens indicates an expected call of ListSCIMTokens.
+func (mr *MockClientMockRecorder) ListSCIMTokens(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSCIMTokens", reflect.TypeOf((*MockClient)(nil).ListSCIMTokens), varargs...)
+}
+
 // UpdateAPIKey mocks base method.
 func (m *MockClient) UpdateAPIKey(ctx context.Context, in *account.UpdateAPIKeyRequest, opts ...grpc.CallOption) (*account.UpdateAPIKeyResponse, error) {
 	m.ctrl.T.Helper()
@@ -414,6 +554,26 @@ func (mr *MockClientMockRecorder) UpdateAccountV2(ctx, in any, opts ...any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountV2", reflect.TypeOf((*MockClient)(nil).UpdateAccountV2), varargs...)
 }
 
+// UpdateCustomRole mocks base method.
+func (m *MockClient) UpdateCustomRole(ctx context.Context, in *account.UpdateCustomRoleRequest, opts ...grpc.CallOption) (*account.UpdateCustomRoleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateCustomRole", varargs...)
+	ret0, _ := ret[0].(*account.UpdateCustomRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomRole indicates an expected call of UpdateCustomRole.
+func (mr *MockClientMockRecorder) UpdateCustomRole(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomRole", reflect.TypeOf((*MockClient)(nil).UpdateCustomRole), varargs...)
+}
+
 // UpdateSearchFilter mocks base method.
 func (m *MockClient) UpdateSearchFilter(ctx context.Context, in *account.UpdateSearchFilterRequest, opts ...grpc.CallOption) (*account.UpdateSearchFilterResponse, error) {
 	m.ctrl.T.Helper()
@@ -93,6 +93,7 @@ func (a *AccountV2) Update(
 	teamChanges []*proto.TeamChange,
 	organizationRole *proto.UpdateAccountV2Request_OrganizationRoleValue,
 	environmentRoles []*proto.AccountV2_EnvironmentRole,
+	clearEnvironmentRoles bool,
 	isDisabled *wrapperspb.BoolValue,
 ) (*AccountV2, error) {
 	updated := &AccountV2{}
@@ -140,6 +141,9 @@ func (a *AccountV2) Update(
 	if len(environmentRoles) > 0 {
 		updated.EnvironmentRoles = environmentRoles
 	}
+	if clearEnvironmentRoles {
+		updated.EnvironmentRoles = []*proto.AccountV2_EnvironmentRole{}
+	}
 	if updated.OrganizationRole >= proto.AccountV2_Role_Organization_ADMIN {
 		updated.EnvironmentRoles = []*proto.AccountV2_EnvironmentRole{}
 	}
@@ -1156,6 +1156,7 @@ func TestAccountV2_Update(t *testing.T) {
 				EnvironmentId: "default",
 			},
 		},
+		false,
 		nil,
 	)
 	if err != nil {
@@ -16,6 +16,7 @@ package domain
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"time"
 
@@ -41,6 +42,14 @@ func ObfuscateAPIKey(input string) string {
 	return input
 }
 
+// HashAPIKey returns the hex encoded SHA-256 of the API key.
+// It lets a holder verify a presented key without storing the key itself.
+// A plain hash is enough because the keys are random, not user chosen.
+func HashAPIKey(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(sum[:])
+}
+
 var (
 	ErrLastUsedAtNotUpdated = pkgErr.NewErrorFailedPrecondition(
 		pkgErr.AccountPackageName, "last used at not updated")
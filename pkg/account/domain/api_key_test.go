@@ -96,3 +96,11 @@ func TestObfuscateAPIKey(t *testing.T) {
 		})
 	}
 }
+
+func TestHashAPIKey(t *testing.T) {
+	hash := HashAPIKey("api-key")
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, HashAPIKey("api-key"))
+	assert.NotEqual(t, hash, HashAPIKey("api-key2"))
+	assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", HashAPIKey(""))
+}
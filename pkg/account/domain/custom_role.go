@@ -0,0 +1,189 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"slices"
+	"time"
+
+	gproto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/uuid"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+)
+
+const (
+	BuiltInViewerRoleID = "viewer"
+	BuiltInEditorRoleID = "editor"
+)
+
+var (
+	resourceTypes = []proto.Permission_ResourceType{
+		proto.Permission_FEATURE,
+		proto.Permission_SEGMENT,
+		proto.Permission_EXPERIMENT,
+		proto.Permission_GOAL,
+		proto.Permission_AUTOOPS_RULE,
+		proto.Permission_PROGRESSIVE_ROLLOUT,
+	}
+	allActions = []proto.Permission_Action{
+		proto.Permission_READ,
+		proto.Permission_CREATE,
+		proto.Permission_UPDATE,
+		proto.Permission_DELETE,
+		proto.Permission_TOGGLE,
+		proto.Permission_UPDATE_TARGETING,
+	}
+)
+
+type CustomRole struct {
+	*proto.CustomRole
+}
+
+func NewCustomRole(
+	name, description, organizationID string,
+	permissions []*proto.Permission,
+) (*CustomRole, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	return &CustomRole{
+		CustomRole: &proto.CustomRole{
+			Id:             id.String(),
+			OrganizationId: organizationID,
+			Name:           name,
+			Description:    description,
+			Permissions:    permissions,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		},
+	}, nil
+}
+
+// Update returns a copy of the role with the given changes applied.
+// The permissions are replaced as a whole when they are not empty.
+func (r *CustomRole) Update(
+	name, description *wrapperspb.StringValue,
+	permissions []*proto.Permission,
+) *CustomRole {
+	updated := &CustomRole{CustomRole: gproto.Clone(r.CustomRole).(*proto.CustomRole)}
+	if name != nil {
+		updated.Name = name.Value
+	}
+	if description != nil {
+		updated.Description = description.Value
+	}
+	if len(permissions) > 0 {
+		updated.Permissions = permissions
+	}
+	updated.UpdatedAt = time.Now().Unix()
+	return updated
+}
+
+// BuiltInCustomRoles returns the roles equivalent to the VIEWER and EDITOR environment roles.
+func BuiltInCustomRoles(organizationID string) []*proto.CustomRole {
+	return []*proto.CustomRole{
+		builtInCustomRole(BuiltInViewerRoleID, "Viewer", "Read access to all resources.",
+			organizationID, []proto.Permission_Action{proto.Permission_READ}),
+		builtInCustomRole(BuiltInEditorRoleID, "Editor", "Full access to all resources.",
+			organizationID, allActions),
+	}
+}
+
+// BuiltInCustomRole returns the built-in role of the environment role,
+// or nil when the environment role grants nothing.
+func BuiltInCustomRole(role proto.AccountV2_Role_Environment) *proto.CustomRole {
+	roles := BuiltInCustomRoles("")
+	switch role {
+	case proto.AccountV2_Role_Environment_VIEWER:
+		return roles[0]
+	case proto.AccountV2_Role_Environment_EDITOR:
+		return roles[1]
+	}
+	return nil
+}
+
+func IsBuiltInCustomRoleID(id string) bool {
+	return id == BuiltInViewerRoleID || id == BuiltInEditorRoleID
+}
+
+func builtInCustomRole(
+	id, name, description, organizationID string,
+	actions []proto.Permission_Action,
+) *proto.CustomRole {
+	permissions := make([]*proto.Permission, 0, len(resourceTypes))
+	for _, t := range resourceTypes {
+		permissions = append(permissions, &proto.Permission{
+			ResourceType: t,
+			Actions:      actions,
+		})
+	}
+	return &proto.CustomRole{
+		Id:             id,
+		OrganizationId: organizationID,
+		Name:           name,
+		Description:    description,
+		Permissions:    permissions,
+		BuiltIn:        true,
+	}
+}
+
+// Resource identifies the resource an action is performed on.
+// ID and Tags are empty when the action is not bound to a single resource, such as listing.
+type Resource struct {
+	Type proto.Permission_ResourceType
+	ID   string
+	Tags []string
+}
+
+// Allows reports whether any of the permissions grants the action on the resource.
+// A scoped permission grants nothing on an unbound resource, so listing requires an unscoped permission.
+func Allows(
+	permissions []*proto.Permission,
+	resource Resource,
+	action proto.Permission_Action,
+) bool {
+	for _, p := range permissions {
+		if p.ResourceType != resource.Type || !slices.Contains(p.Actions, action) {
+			continue
+		}
+		if len(p.ResourceIds) > 0 && !slices.Contains(p.ResourceIds, resource.ID) {
+			continue
+		}
+		if len(p.Tags) > 0 && !slices.ContainsFunc(resource.Tags, func(tag string) bool {
+			return slices.Contains(p.Tags, tag)
+		}) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// ValidatePermission reports whether the permission has a resource type and valid actions.
+func ValidatePermission(p *proto.Permission) bool {
+	if !slices.Contains(resourceTypes, p.ResourceType) || len(p.Actions) == 0 {
+		return false
+	}
+	for _, a := range p.Actions {
+		if !slices.Contains(allActions, a) {
+			return false
+		}
+	}
+	return true
+}
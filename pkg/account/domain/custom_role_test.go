@@ -0,0 +1,171 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+)
+
+func TestNewCustomRole(t *testing.T) {
+	t.Parallel()
+	permissions := []*proto.Permission{
+		{ResourceType: proto.Permission_FEATURE, Actions: []proto.Permission_Action{proto.Permission_READ}},
+	}
+	r, err := NewCustomRole("qa", "desc", "org-id", permissions)
+	require.NoError(t, err)
+	assert.NotEmpty(t, r.Id)
+	assert.Equal(t, "qa", r.Name)
+	assert.Equal(t, "desc", r.Description)
+	assert.Equal(t, "org-id", r.OrganizationId)
+	assert.Equal(t, permissions, r.Permissions)
+	assert.False(t, r.BuiltIn)
+}
+
+func TestCustomRoleUpdate(t *testing.T) {
+	t.Parallel()
+	r, err := NewCustomRole("qa", "desc", "org-id", []*proto.Permission{
+		{ResourceType: proto.Permission_FEATURE, Actions: []proto.Permission_Action{proto.Permission_READ}},
+	})
+	require.NoError(t, err)
+	permissions := []*proto.Permission{
+		{ResourceType: proto.Permission_EXPERIMENT, Actions: []proto.Permission_Action{proto.Permission_CREATE}},
+	}
+	updated := r.Update(wrapperspb.String("qa-lead"), nil, permissions)
+	assert.Equal(t, "qa-lead", updated.Name)
+	assert.Equal(t, "desc", updated.Description)
+	assert.Equal(t, permissions, updated.Permissions)
+	assert.Equal(t, "qa", r.Name)
+
+	updated = r.Update(nil, wrapperspb.String(""), nil)
+	assert.Equal(t, "qa", updated.Name)
+	assert.Equal(t, "", updated.Description)
+	assert.Len(t, updated.Permissions, 1)
+}
+
+func TestBuiltInCustomRole(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, BuiltInCustomRole(proto.AccountV2_Role_Environment_UNASSIGNED))
+	viewer := BuiltInCustomRole(proto.AccountV2_Role_Environment_VIEWER)
+	assert.Equal(t, BuiltInViewerRoleID, viewer.Id)
+	assert.True(t, viewer.BuiltIn)
+	editor := BuiltInCustomRole(proto.AccountV2_Role_Environment_EDITOR)
+	assert.Equal(t, BuiltInEditorRoleID, editor.Id)
+	for _, typ := range resourceTypes {
+		resource := Resource{Type: typ, ID: "id", Tags: []string{"tag"}}
+		assert.True(t, Allows(viewer.Permissions, resource, proto.Permission_READ))
+		assert.False(t, Allows(viewer.Permissions, resource, proto.Permission_UPDATE))
+		for _, action := range allActions {
+			assert.True(t, Allows(editor.Permissions, resource, action))
+		}
+	}
+	assert.True(t, IsBuiltInCustomRoleID(BuiltInEditorRoleID))
+	assert.False(t, IsBuiltInCustomRoleID("custom"))
+}
+
+func TestAllows(t *testing.T) {
+	t.Parallel()
+	permissions := []*proto.Permission{
+		{
+			ResourceType: proto.Permission_FEATURE,
+			Actions:      []proto.Permission_Action{proto.Permission_READ},
+		},
+		{
+			ResourceType: proto.Permission_FEATURE,
+			Actions:      []proto.Permission_Action{proto.Permission_TOGGLE},
+			Tags:         []string{"web"},
+		},
+		{
+			ResourceType: proto.Permission_SEGMENT,
+			Actions:      []proto.Permission_Action{proto.Permission_UPDATE},
+			ResourceIds:  []string{"segment-1"},
+		},
+	}
+	patterns := []struct {
+		desc     string
+		resource Resource
+		action   proto.Permission_Action
+		expected bool
+	}{
+		{
+			desc:     "unscoped read",
+			resource: Resource{Type: proto.Permission_FEATURE},
+			action:   proto.Permission_READ,
+			expected: true,
+		},
+		{
+			desc:     "action not granted",
+			resource: Resource{Type: proto.Permission_FEATURE, ID: "f", Tags: []string{"web"}},
+			action:   proto.Permission_UPDATE_TARGETING,
+			expected: false,
+		},
+		{
+			desc:     "tag matched",
+			resource: Resource{Type: proto.Permission_FEATURE, ID: "f", Tags: []string{"ios", "web"}},
+			action:   proto.Permission_TOGGLE,
+			expected: true,
+		},
+		{
+			desc:     "tag not matched",
+			resource: Resource{Type: proto.Permission_FEATURE, ID: "f", Tags: []string{"ios"}},
+			action:   proto.Permission_TOGGLE,
+			expected: false,
+		},
+		{
+			desc:     "id matched",
+			resource: Resource{Type: proto.Permission_SEGMENT, ID: "segment-1"},
+			action:   proto.Permission_UPDATE,
+			expected: true,
+		},
+		{
+			desc:     "id not matched",
+			resource: Resource{Type: proto.Permission_SEGMENT, ID: "segment-2"},
+			action:   proto.Permission_UPDATE,
+			expected: false,
+		},
+		{
+			desc:     "resource type not granted",
+			resource: Resource{Type: proto.Permission_EXPERIMENT},
+			action:   proto.Permission_READ,
+			expected: false,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			assert.Equal(t, p.expected, Allows(permissions, p.resource, p.action))
+		})
+	}
+}
+
+func TestValidatePermission(t *testing.T) {
+	t.Parallel()
+	assert.True(t, ValidatePermission(&proto.Permission{
+		ResourceType: proto.Permission_GOAL,
+		Actions:      []proto.Permission_Action{proto.Permission_READ},
+	}))
+	assert.False(t, ValidatePermission(&proto.Permission{
+		Actions: []proto.Permission_Action{proto.Permission_READ},
+	}))
+	assert.False(t, ValidatePermission(&proto.Permission{ResourceType: proto.Permission_GOAL}))
+	assert.False(t, ValidatePermission(&proto.Permission{
+		ResourceType: proto.Permission_GOAL,
+		Actions:      []proto.Permission_Action{proto.Permission_ACTION_UNSPECIFIED},
+	}))
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/uuid"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+)
+
+type SCIMToken struct {
+	*proto.SCIMToken
+	// TokenHash is the SHA-256 hash of the token. The token itself is never stored.
+	TokenHash string
+}
+
+// NewSCIMToken generates a new token and returns it along with the domain object.
+// The token must be shown to the user only once since it can't be restored.
+func NewSCIMToken(name, organizationID string) (*SCIMToken, string, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return nil, "", err
+	}
+	token, err := generateKey()
+	if err != nil {
+		return nil, "", err
+	}
+	now := time.Now().Unix()
+	return &SCIMToken{
+		SCIMToken: &proto.SCIMToken{
+			Id:             id.String(),
+			Name:           name,
+			OrganizationId: organizationID,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		},
+		TokenHash: HashSCIMToken(token),
+	}, token, nil
+}
+
+func HashSCIMToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
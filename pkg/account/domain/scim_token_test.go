@@ -0,0 +1,38 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSCIMToken(t *testing.T) {
+	t.Parallel()
+	scimToken, token, err := NewSCIMToken("okta", "org-id")
+	require.NoError(t, err)
+	assert.NotEmpty(t, scimToken.Id)
+	assert.Equal(t, "okta", scimToken.Name)
+	assert.Equal(t, "org-id", scimToken.OrganizationId)
+	assert.Len(t, token, keyBytes*2)
+	assert.Equal(t, HashSCIMToken(token), scimToken.TokenHash)
+	assert.NotEqual(t, token, scimToken.TokenHash)
+
+	_, other, err := NewSCIMToken("okta", "org-id")
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+}
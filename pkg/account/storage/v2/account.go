@@ -40,6 +40,10 @@ var (
 		pkgErr.AccountPackageName,
 		"api key unexpected affected rows",
 	)
+	ErrSCIMTokenAlreadyExists  = pkgErr.NewErrorAlreadyExists(pkgErr.AccountPackageName, "scim token already exists")
+	ErrSCIMTokenNotFound       = pkgErr.NewErrorNotFound(pkgErr.AccountPackageName, "scim token not found", "scim_token")
+	ErrCustomRoleAlreadyExists = pkgErr.NewErrorAlreadyExists(pkgErr.AccountPackageName, "custom role already exists")
+	ErrCustomRoleNotFound      = pkgErr.NewErrorNotFound(pkgErr.AccountPackageName, "custom role not found", "custom_role")
 )
 
 var (
@@ -65,6 +69,17 @@ type AccountStorage interface {
 	GetEnvironmentAPIKey(ctx context.Context, apiKey string) (*domain.EnvironmentAPIKey, error)
 	ListAllEnvironmentAPIKeys(ctx context.Context) ([]*domain.EnvironmentAPIKey, error)
 	ListAPIKeys(ctx context.Context, params ListAPIKeysParams) ([]*proto.APIKey, int, int64, error)
+	CreateSCIMToken(ctx context.Context, t *domain.SCIMToken) error
+	GetSCIMToken(ctx context.Context, id, organizationID string) (*domain.SCIMToken, error)
+	GetSCIMTokenByHash(ctx context.Context, tokenHash string) (*domain.SCIMToken, error)
+	ListSCIMTokens(ctx context.Context, organizationID string) ([]*proto.SCIMToken, error)
+	UpdateSCIMTokenLastUsedAt(ctx context.Context, id string, lastUsedAt int64) error
+	DeleteSCIMToken(ctx context.Context, id, organizationID string) error
+	CreateCustomRole(ctx context.Context, r *domain.CustomRole) error
+	UpdateCustomRole(ctx context.Context, r *domain.CustomRole) error
+	GetCustomRole(ctx context.Context, id, organizationID string) (*domain.CustomRole, error)
+	ListCustomRoles(ctx context.Context, organizationID string) ([]*proto.CustomRole, error)
+	DeleteCustomRole(ctx context.Context, id, organizationID string) error
 }
 
 type GetAvatarAccountsV2Params struct {
@@ -71,6 +71,34 @@ func (mr *MockAccountStorageMockRecorder) CreateAccountV2(ctx, a any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountV2", reflect.TypeOf((*MockAccountStorage)(nil).CreateAccountV2), ctx, a)
 }
 
+// CreateCustomRole mocks base method.
+func (m *MockAccountStorage) CreateCustomRole(ctx context.Context, r *domain.CustomRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomRole", ctx, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCustomRole indicates an expected call of CreateCustomRole.
+func (mr *MockAccountStorageMockRecorder) CreateCustomRole(ctx, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomRole", reflect.TypeOf((*MockAccountStorage)(nil).CreateCustomRole), ctx, r)
+}
+
+// CreateSCIMToken mocks base method.
+func (m *MockAccountStorage) CreateSCIMToken(ctx context.Context, t *domain.SCIMToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSCIMToken", ctx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSCIMToken indicates an expected call of CreateSCIMToken.
+func (mr *MockAccountStorageMockRecorder) CreateSCIMToken(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSCIMToken", reflect.TypeOf((*MockAccountStorage)(nil).CreateSCIMToken), ctx, t)
+}
+
 // DeleteAccountV2 mocks base method.
 func (m *MockAccountStorage) DeleteAccountV2(ctx context.Context, a *domain.AccountV2) error {
 	m.ctrl.T.Helper()
@@ -85,6 +113,34 @@ func (mr *MockAccountStorageMockRecorder) DeleteAccountV2(ctx, a any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountV2", reflect.TypeOf((*MockAccountStorage)(nil).DeleteAccountV2), ctx, a)
 }
 
+// DeleteCustomRole mocks base method.
+func (m *MockAccountStorage) DeleteCustomRole(ctx context.Context, id, organizationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomRole", ctx, id, organizationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomRole indicates an expected call of DeleteCustomRole.
+func (mr *MockAccountStorageMockRecorder) DeleteCustomRole(ctx, id, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomRole", reflect.TypeOf((*MockAccountStorage)(nil).DeleteCustomRole), ctx, id, organizationID)
+}
+
+// DeleteSCIMToken mocks base method.
+func (m *MockAccountStorage) DeleteSCIMToken(ctx context.Context, id, organizationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSCIMToken", ctx, id, organizationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSCIMToken indicates an expected call of DeleteSCIMToken.
+func (mr *MockAccountStorageMockRecorder) DeleteSCIMToken(ctx, id, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSCIMToken", reflect.TypeOf((*MockAccountStorage)(nil).DeleteSCIMToken), ctx, id, organizationID)
+}
+
 // GetAPIKey mocks base method.
 func (m *MockAccountStorage) GetAPIKey(ctx context.Context, id, environmentID string) (*domain.APIKey, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +231,21 @@ func (mr *MockAccountStorageMockRecorder) GetAvatarAccountsV2(ctx, params any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvatarAccountsV2", reflect.TypeOf((*MockAccountStorage)(nil).GetAvatarAccountsV2), ctx, params)
 }
 
+// GetCustomRole mocks base method.
+func (m *MockAccountStorage) GetCustomRole(ctx context.Context, id, organizationID string) (*domain.CustomRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomRole", ctx, id, organizationID)
+	ret0, _ := ret[0].(*domain.CustomRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomRole indicates an expected call of GetCustomRole.
+func (mr *MockAccountStorageMockRecorder) GetCustomRole(ctx, id, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomRole", reflect.TypeOf((*MockAccountStorage)(nil).GetCustomRole), ctx, id, organizationID)
+}
+
 // GetEnvironmentAPIKey mocks base method.
 func (m *MockAccountStorage) GetEnvironmentAPIKey(ctx context.Context, apiKey string) (*domain.EnvironmentAPIKey, error) {
 	m.ctrl.T.Helper()
@@ -190,6 +261,36 @@ func (mr *MockAccountStorageMockRecorder) GetEnvironmentAPIKey(ctx, apiKey any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironmentAPIKey", reflect.TypeOf((*MockAccountStorage)(nil).GetEnvironmentAPIKey), ctx, apiKey)
 }
 
+// GetSCIMToken mocks base method.
+func (m *MockAccountStorage) GetSCIMToken(ctx context.Context, id, organizationID string) (*domain.SCIMToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSCIMToken", ctx, id, organizationID)
+	ret0, _ := ret[0].(*domain.SCIMToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSCIMToken indicates an expected call of GetSCIMToken.
+func (mr *MockAccountStorageMockRecorder) GetSCIMToken(ctx, id, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSCIMToken", reflect.TypeOf((*MockAccountStorage)(nil).GetSCIMToken), ctx, id, organizationID)
+}
+
+// GetSCIMTokenByHash mocks base method.
+func (m *MockAccountStorage) GetSCIMTokenByHash(ctx context.Context, tokenHash string) (*domain.SCIMToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSCIMTokenByHash", ctx, tokenHash)
+	ret0, _ := ret[0].(*domain.SCIMToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSCIMTokenByHash indicates an expected call of GetSCIMTokenByHash.
+func (mr *MockAccountStorageMockRecorder) GetSCIMTokenByHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSCIMTokenByHash", reflect.TypeOf((*MockAccountStorage)(nil).GetSCIMTokenByHash), ctx, tokenHash)
+}
+
 // GetSystemAdminAccountV2 mocks base method.
 func (m *MockAccountStorage) GetSystemAdminAccountV2(ctx context.Context, email string) (*domain.AccountV2, error) {
 	m.ctrl.T.Helper()
@@ -254,6 +355,36 @@ func (mr *MockAccountStorageMockRecorder) ListAllEnvironmentAPIKeys(ctx any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllEnvironmentAPIKeys", reflect.TypeOf((*MockAccountStorage)(nil).ListAllEnvironmentAPIKeys), ctx)
 }
 
+// ListCustomRoles mocks base method.
+func (m *MockAccountStorage) ListCustomRoles(ctx context.Context, organizationID string) ([]*account.CustomRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomRoles", ctx, organizationID)
+	ret0, _ := ret[0].([]*account.CustomRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomRoles indicates an expected call of ListCustomRoles.
+func (mr *MockAccountStorageMockRecorder) ListCustomRoles(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoles", reflect.TypeOf((*MockAccountStorage)(nil).ListCustomRoles), ctx, organizationID)
+}
+
+// ListSCIMTokens mocks base method.
+func (m *MockAccountStorage) ListSCIMTokens(ctx context.Context, organizationID string) ([]*account.SCIMToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSCIMTokens", ctx, organizationID)
+	ret0, _ := ret[0].([]*account.SCIMToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSCIMTokens indicates an expected call of ListSCIMTokens.
+func (mr *MockAccountStorageMockRecorder) ListSCIMTokens(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSCIMTokens", reflect.TypeOf((*MockAccountStorage)(nil).ListSCIMTokens), ctx, organizationID)
+}
+
 // UpdateAPIKey mocks base method.
 func (m *MockAccountStorage) UpdateAPIKey(ctx context.Context, k *domain.APIKey, environmentID string) error {
 	m.ctrl.T.Helper()
@@ -296,3 +427,31 @@ func (mr *MockAccountStorageMockRecorder) UpdateAccountV2(ctx, a any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountV2", reflect.TypeOf((*MockAccountStorage)(nil).UpdateAccountV2), ctx, a)
 }
+
+// UpdateCustomRole mocks base method.
+func (m *MockAccountStorage) UpdateCustomRole(ctx context.Context, r *domain.CustomRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomRole", ctx, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCustomRole indicates an expected call of UpdateCustomRole.
+func (mr *MockAccountStorageMockRecorder) UpdateCustomRole(ctx, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomRole", reflect.TypeOf((*MockAccountStorage)(nil).UpdateCustomRole), ctx, r)
+}
+
+// UpdateSCIMTokenLastUsedAt mocks base method.
+func (m *MockAccountStorage) UpdateSCIMTokenLastUsedAt(ctx context.Context, id string, lastUsedAt int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSCIMTokenLastUsedAt", ctx, id, lastUsedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSCIMTokenLastUsedAt indicates an expected call of UpdateSCIMTokenLastUsedAt.
+func (mr *MockAccountStorageMockRecorder) UpdateSCIMTokenLastUsedAt(ctx, id, lastUsedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSCIMTokenLastUsedAt", reflect.TypeOf((*MockAccountStorage)(nil).UpdateSCIMTokenLastUsedAt), ctx, id, lastUsedAt)
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	v2as "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2"
+	mysqlstorage "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/mysql"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+)
+
+var (
+	//go:embed sql/custom_role/insert_custom_role.sql
+	insertCustomRoleSQLQuery string
+	//go:embed sql/custom_role/update_custom_role.sql
+	updateCustomRoleSQLQuery string
+	//go:embed sql/custom_role/select_custom_role.sql
+	selectCustomRoleSQLQuery string
+	//go:embed sql/custom_role/select_custom_roles.sql
+	selectCustomRolesSQLQuery string
+	//go:embed sql/custom_role/delete_custom_role.sql
+	deleteCustomRoleSQLQuery string
+)
+
+func (s *accountStorage) CreateCustomRole(ctx context.Context, r *domain.CustomRole) error {
+	_, err := s.qe.ExecContext(
+		ctx,
+		insertCustomRoleSQLQuery,
+		r.Id,
+		r.OrganizationId,
+		r.Name,
+		r.Description,
+		mysqlstorage.JSONObject{Val: r.Permissions},
+		r.CreatedAt,
+		r.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, mysqlstorage.ErrDuplicateEntry) {
+			return v2as.ErrCustomRoleAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *accountStorage) UpdateCustomRole(ctx context.Context, r *domain.CustomRole) error {
+	result, err := s.qe.ExecContext(
+		ctx,
+		updateCustomRoleSQLQuery,
+		r.Name,
+		r.Description,
+		mysqlstorage.JSONObject{Val: r.Permissions},
+		r.UpdatedAt,
+		r.Id,
+		r.OrganizationId,
+	)
+	if err != nil {
+		if errors.Is(err, mysqlstorage.ErrDuplicateEntry) {
+			return v2as.ErrCustomRoleAlreadyExists
+		}
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return v2as.ErrCustomRoleNotFound
+	}
+	return nil
+}
+
+func (s *accountStorage) GetCustomRole(
+	ctx context.Context,
+	id, organizationID string,
+) (*domain.CustomRole, error) {
+	r := &proto.CustomRole{}
+	err := s.qe.QueryRowContext(ctx, selectCustomRoleSQLQuery, id, organizationID).Scan(
+		&r.Id,
+		&r.OrganizationId,
+		&r.Name,
+		&r.Description,
+		&mysqlstorage.JSONObject{Val: &r.Permissions},
+		&r.CreatedAt,
+		&r.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, mysqlstorage.ErrNoRows) {
+			return nil, v2as.ErrCustomRoleNotFound
+		}
+		return nil, err
+	}
+	return &domain.CustomRole{CustomRole: r}, nil
+}
+
+func (s *accountStorage) ListCustomRoles(
+	ctx context.Context,
+	organizationID string,
+) ([]*proto.CustomRole, error) {
+	rows, err := s.qe.QueryContext(ctx, selectCustomRolesSQLQuery, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	roles := make([]*proto.CustomRole, 0)
+	for rows.Next() {
+		r := &proto.CustomRole{}
+		if err := rows.Scan(
+			&r.Id,
+			&r.OrganizationId,
+			&r.Name,
+			&r.Description,
+			&mysqlstorage.JSONObject{Val: &r.Permissions},
+			&r.CreatedAt,
+			&r.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		roles = append(roles, r)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return roles, nil
+}
+
+func (s *accountStorage) DeleteCustomRole(ctx context.Context, id, organizationID string) error {
+	result, err := s.qe.ExecContext(ctx, deleteCustomRoleSQLQuery, id, organizationID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return v2as.ErrCustomRoleNotFound
+	}
+	return nil
+}
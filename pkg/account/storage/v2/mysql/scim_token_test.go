@@ -0,0 +1,232 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	v2as "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/mysql"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/mysql/mock"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+)
+
+func TestCreateSCIMTokenMySQL(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*accountStorage)
+		expectedErr error
+	}{
+		{
+			desc: "error",
+			setup: func(s *accountStorage) {
+				s.qe.(*mock.MockClient).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errors.New("error"))
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "error: duplicate entry",
+			setup: func(s *accountStorage) {
+				s.qe.(*mock.MockClient).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, mysql.ErrDuplicateEntry)
+			},
+			expectedErr: v2as.ErrSCIMTokenAlreadyExists,
+		},
+		{
+			desc: "success",
+			setup: func(s *accountStorage) {
+				s.qe.(*mock.MockClient).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, nil)
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := &accountStorage{qe: mock.NewMockClient(mockController)}
+			p.setup(storage)
+			err := storage.CreateSCIMToken(
+				context.Background(),
+				&domain.SCIMToken{SCIMToken: &proto.SCIMToken{}},
+			)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestGetSCIMTokenByHashMySQL(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*accountStorage)
+		expectedErr error
+	}{
+		{
+			desc: "error: not found",
+			setup: func(s *accountStorage) {
+				row := mock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(mysql.ErrNoRows)
+				s.qe.(*mock.MockClient).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+			},
+			expectedErr: v2as.ErrSCIMTokenNotFound,
+		},
+		{
+			desc: "error: internal",
+			setup: func(s *accountStorage) {
+				row := mock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(errors.New("internal error"))
+				s.qe.(*mock.MockClient).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+			},
+			expectedErr: errors.New("internal error"),
+		},
+		{
+			desc: "success",
+			setup: func(s *accountStorage) {
+				row := mock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(nil)
+				s.qe.(*mock.MockClient).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := &accountStorage{qe: mock.NewMockClient(mockController)}
+			p.setup(storage)
+			token, err := storage.GetSCIMTokenByHash(context.Background(), "hash")
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.NotNil(t, token)
+			}
+		})
+	}
+}
+
+func TestListSCIMTokensMySQL(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*accountStorage)
+		expected    []*proto.SCIMToken
+		expectedErr error
+	}{
+		{
+			desc: "error",
+			setup: func(s *accountStorage) {
+				s.qe.(*mock.MockClient).EXPECT().QueryContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errors.New("error"))
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "success",
+			setup: func(s *accountStorage) {
+				rows := mock.NewMockRows(mockController)
+				rows.EXPECT().Close().Return(nil)
+				rows.EXPECT().Next().Return(false)
+				rows.EXPECT().Err().Return(nil)
+				s.qe.(*mock.MockClient).EXPECT().QueryContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(rows, nil)
+			},
+			expected: []*proto.SCIMToken{},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := &accountStorage{qe: mock.NewMockClient(mockController)}
+			p.setup(storage)
+			tokens, err := storage.ListSCIMTokens(context.Background(), "org-id")
+			assert.Equal(t, p.expectedErr, err)
+			assert.Equal(t, p.expected, tokens)
+		})
+	}
+}
+
+func TestDeleteSCIMTokenMySQL(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*accountStorage)
+		expectedErr error
+	}{
+		{
+			desc: "error",
+			setup: func(s *accountStorage) {
+				s.qe.(*mock.MockClient).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errors.New("error"))
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "error: not found",
+			setup: func(s *accountStorage) {
+				result := mock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(0), nil)
+				s.qe.(*mock.MockClient).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+			},
+			expectedErr: v2as.ErrSCIMTokenNotFound,
+		},
+		{
+			desc: "success",
+			setup: func(s *accountStorage) {
+				result := mock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(1), nil)
+				s.qe.(*mock.MockClient).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := &accountStorage{qe: mock.NewMockClient(mockController)}
+			p.setup(storage)
+			err := storage.DeleteSCIMToken(context.Background(), "id", "org-id")
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,3 @@
+DELETE FROM custom_role
+WHERE id = ?
+  AND organization_id = ?
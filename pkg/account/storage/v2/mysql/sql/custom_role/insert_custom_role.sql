@@ -0,0 +1,9 @@
+INSERT INTO custom_role (
+    id,
+    organization_id,
+    name,
+    description,
+    permissions,
+    created_at,
+    updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?)
@@ -0,0 +1,11 @@
+SELECT
+    id,
+    organization_id,
+    name,
+    description,
+    permissions,
+    created_at,
+    updated_at
+FROM custom_role
+WHERE id = ?
+  AND organization_id = ?
@@ -0,0 +1,7 @@
+UPDATE custom_role SET
+    name = ?,
+    description = ?,
+    permissions = ?,
+    updated_at = ?
+WHERE id = ?
+  AND organization_id = ?
@@ -0,0 +1,3 @@
+DELETE FROM scim_token
+WHERE id = ?
+  AND organization_id = ?
@@ -0,0 +1,10 @@
+SELECT
+    id,
+    name,
+    organization_id,
+    created_at,
+    updated_at,
+    last_used_at
+FROM scim_token
+WHERE organization_id = ?
+ORDER BY created_at DESC
@@ -0,0 +1,4 @@
+UPDATE scim_token
+SET last_used_at = ?
+WHERE id = ?
+  AND last_used_at < ?
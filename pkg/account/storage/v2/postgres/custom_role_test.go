@@ -0,0 +1,285 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	v2as "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres"
+	pgmock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres/mock"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+)
+
+func TestCreateCustomRolePostgres(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*accountStorage)
+		expectedErr error
+	}{
+		{
+			desc: "error",
+			setup: func(s *accountStorage) {
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errInternal)
+			},
+			expectedErr: errInternal,
+		},
+		{
+			desc: "error: duplicate entry",
+			setup: func(s *accountStorage) {
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, postgres.ErrDuplicateEntry)
+			},
+			expectedErr: v2as.ErrCustomRoleAlreadyExists,
+		},
+		{
+			desc: "success",
+			setup: func(s *accountStorage) {
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, nil)
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newAccountStorageWithMock(t, mockController)
+			p.setup(storage)
+			err := storage.CreateCustomRole(
+				context.Background(),
+				&domain.CustomRole{CustomRole: &proto.CustomRole{}},
+			)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestUpdateCustomRolePostgres(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*accountStorage)
+		expectedErr error
+	}{
+		{
+			desc: "error: duplicate entry",
+			setup: func(s *accountStorage) {
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, postgres.ErrDuplicateEntry)
+			},
+			expectedErr: v2as.ErrCustomRoleAlreadyExists,
+		},
+		{
+			desc: "error: not found",
+			setup: func(s *accountStorage) {
+				result := pgmock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(0), nil)
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+			},
+			expectedErr: v2as.ErrCustomRoleNotFound,
+		},
+		{
+			desc: "success",
+			setup: func(s *accountStorage) {
+				result := pgmock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(1), nil)
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newAccountStorageWithMock(t, mockController)
+			p.setup(storage)
+			err := storage.UpdateCustomRole(
+				context.Background(),
+				&domain.CustomRole{CustomRole: &proto.CustomRole{}},
+			)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestGetCustomRolePostgres(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*accountStorage)
+		expectedErr error
+	}{
+		{
+			desc: "error: not found",
+			setup: func(s *accountStorage) {
+				row := pgmock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(postgres.ErrNoRows)
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+			},
+			expectedErr: v2as.ErrCustomRoleNotFound,
+		},
+		{
+			desc: "error: internal",
+			setup: func(s *accountStorage) {
+				row := pgmock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(errInternal)
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+			},
+			expectedErr: errInternal,
+		},
+		{
+			desc: "success",
+			setup: func(s *accountStorage) {
+				row := pgmock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(nil)
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newAccountStorageWithMock(t, mockController)
+			p.setup(storage)
+			role, err := storage.GetCustomRole(context.Background(), "id", "org-id")
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.NotNil(t, role)
+			}
+		})
+	}
+}
+
+func TestListCustomRolesPostgres(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*accountStorage)
+		expected    []*proto.CustomRole
+		expectedErr error
+	}{
+		{
+			desc: "error",
+			setup: func(s *accountStorage) {
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().QueryContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errInternal)
+			},
+			expectedErr: errInternal,
+		},
+		{
+			desc: "success",
+			setup: func(s *accountStorage) {
+				rows := pgmock.NewMockRows(mockController)
+				rows.EXPECT().Close().Return(nil)
+				rows.EXPECT().Next().Return(false)
+				rows.EXPECT().Err().Return(nil)
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().QueryContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(rows, nil)
+			},
+			expected: []*proto.CustomRole{},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newAccountStorageWithMock(t, mockController)
+			p.setup(storage)
+			roles, err := storage.ListCustomRoles(context.Background(), "org-id")
+			assert.Equal(t, p.expectedErr, err)
+			assert.Equal(t, p.expected, roles)
+		})
+	}
+}
+
+func TestDeleteCustomRolePostgres(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*accountStorage)
+		expectedErr error
+	}{
+		{
+			desc: "error",
+			setup: func(s *accountStorage) {
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errInternal)
+			},
+			expectedErr: errInternal,
+		},
+		{
+			desc: "error: not found",
+			setup: func(s *accountStorage) {
+				result := pgmock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(0), nil)
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+			},
+			expectedErr: v2as.ErrCustomRoleNotFound,
+		},
+		{
+			desc: "success",
+			setup: func(s *accountStorage) {
+				result := pgmock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(1), nil)
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newAccountStorageWithMock(t, mockController)
+			p.setup(storage)
+			err := storage.DeleteCustomRole(context.Background(), "id", "org-id")
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	v2as "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2"
+	pgstorage "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+)
+
+var (
+	//go:embed sql/scim_token/insert_scim_token.sql
+	insertSCIMTokenSQLQuery string
+	//go:embed sql/scim_token/select_scim_token.sql
+	selectSCIMTokenSQLQuery string
+	//go:embed sql/scim_token/select_scim_token_by_hash.sql
+	selectSCIMTokenByHashSQLQuery string
+	//go:embed sql/scim_token/select_scim_tokens.sql
+	selectSCIMTokensSQLQuery string
+	//go:embed sql/scim_token/update_scim_token_last_used_at.sql
+	updateSCIMTokenLastUsedAtSQLQuery string
+	//go:embed sql/scim_token/delete_scim_token.sql
+	deleteSCIMTokenSQLQuery string
+)
+
+func (s *accountStorage) CreateSCIMToken(ctx context.Context, t *domain.SCIMToken) error {
+	_, err := s.qe.ExecContext(
+		ctx,
+		insertSCIMTokenSQLQuery,
+		t.Id,
+		t.Name,
+		t.OrganizationId,
+		t.TokenHash,
+		t.CreatedAt,
+		t.UpdatedAt,
+		t.LastUsedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgstorage.ErrDuplicateEntry) {
+			return v2as.ErrSCIMTokenAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *accountStorage) GetSCIMToken(
+	ctx context.Context,
+	id, organizationID string,
+) (*domain.SCIMToken, error) {
+	return s.getSCIMToken(ctx, selectSCIMTokenSQLQuery, id, organizationID)
+}
+
+func (s *accountStorage) GetSCIMTokenByHash(ctx context.Context, tokenHash string) (*domain.SCIMToken, error) {
+	return s.getSCIMToken(ctx, selectSCIMTokenByHashSQLQuery, tokenHash)
+}
+
+func (s *accountStorage) getSCIMToken(
+	ctx context.Context,
+	query string,
+	args ...interface{},
+) (*domain.SCIMToken, error) {
+	t := &domain.SCIMToken{SCIMToken: &proto.SCIMToken{}}
+	err := s.qe.QueryRowContext(ctx, query, args...).Scan(
+		&t.Id,
+		&t.Name,
+		&t.OrganizationId,
+		&t.TokenHash,
+		&t.CreatedAt,
+		&t.UpdatedAt,
+		&t.LastUsedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgstorage.ErrNoRows) {
+			return nil, v2as.ErrSCIMTokenNotFound
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+func (s *accountStorage) ListSCIMTokens(
+	ctx context.Context,
+	organizationID string,
+) ([]*proto.SCIMToken, error) {
+	rows, err := s.qe.QueryContext(ctx, selectSCIMTokensSQLQuery, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	tokens := make([]*proto.SCIMToken, 0)
+	for rows.Next() {
+		t := &proto.SCIMToken{}
+		if err := rows.Scan(
+			&t.Id,
+			&t.Name,
+			&t.OrganizationId,
+			&t.CreatedAt,
+			&t.UpdatedAt,
+			&t.LastUsedAt,
+		); err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, t)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return tokens, nil
+}
+
+func (s *accountStorage) UpdateSCIMTokenLastUsedAt(ctx context.Context, id string, lastUsedAt int64) error {
+	_, err := s.qe.ExecContext(
+		ctx,
+		updateSCIMTokenLastUsedAtSQLQuery,
+		lastUsedAt,
+		id,
+		lastUsedAt,
+	)
+	return err
+}
+
+func (s *accountStorage) DeleteSCIMToken(ctx context.Context, id, organizationID string) error {
+	result, err := s.qe.ExecContext(ctx, deleteSCIMTokenSQLQuery, id, organizationID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return v2as.ErrSCIMTokenNotFound
+	}
+	return nil
+}
@@ -0,0 +1,3 @@
+DELETE FROM custom_role
+WHERE id = $1
+  AND organization_id = $2
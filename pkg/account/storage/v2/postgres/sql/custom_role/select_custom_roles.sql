@@ -0,0 +1,11 @@
+SELECT
+    id,
+    organization_id,
+    name,
+    description,
+    permissions,
+    created_at,
+    updated_at
+FROM custom_role
+WHERE organization_id = $1
+ORDER BY name ASC
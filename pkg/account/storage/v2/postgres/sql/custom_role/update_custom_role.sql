@@ -0,0 +1,7 @@
+UPDATE custom_role SET
+    name = $1,
+    description = $2,
+    permissions = $3,
+    updated_at = $4
+WHERE id = $5
+  AND organization_id = $6
@@ -0,0 +1,3 @@
+DELETE FROM scim_token
+WHERE id = $1
+  AND organization_id = $2
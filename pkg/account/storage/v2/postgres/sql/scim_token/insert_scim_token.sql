@@ -0,0 +1,9 @@
+INSERT INTO scim_token (
+    id,
+    name,
+    organization_id,
+    token_hash,
+    created_at,
+    updated_at,
+    last_used_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
@@ -0,0 +1,11 @@
+SELECT
+    id,
+    name,
+    organization_id,
+    token_hash,
+    created_at,
+    updated_at,
+    last_used_at
+FROM scim_token
+WHERE id = $1
+  AND organization_id = $2
@@ -0,0 +1,10 @@
+SELECT
+    id,
+    name,
+    organization_id,
+    token_hash,
+    created_at,
+    updated_at,
+    last_used_at
+FROM scim_token
+WHERE token_hash = $1
@@ -19,6 +19,7 @@ import (
 	"strings"
 	"unicode/utf8"
 
+	featuredomain "github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
 	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
 )
 
@@ -75,13 +76,20 @@ func buildFeatureContext(f *featureproto.Feature) string {
 		fmt.Fprintf(&sb, "Targeting Rules: %d rule(s)\n", len(f.Rules))
 		for i, rule := range f.Rules {
 			fmt.Fprintf(&sb, "  Rule %d:\n", i+1)
+			if rule.Name != "" {
+				fmt.Fprintf(&sb, "    Name: %q\n", sanitizePromptField(rule.Name))
+			}
 			if rule.Strategy != nil {
 				fmt.Fprintf(&sb, "    Strategy: %s\n", rule.Strategy.Type.String())
 			}
-			fmt.Fprintf(&sb, "    Conditions: %d\n", len(rule.Clauses))
-			for _, clause := range rule.Clauses {
+			clauses := featuredomain.RuleClauses(rule)
+			fmt.Fprintf(&sb, "    Conditions: %d\n", len(clauses))
+			for _, clause := range clauses {
 				fmt.Fprintf(&sb, "      - Operator: %s\n", clause.Operator.String())
 			}
+			for _, group := range rule.ClauseGroups {
+				fmt.Fprintf(&sb, "    Condition Group: %s\n", group.Operator.String())
+			}
 		}
 	}
 
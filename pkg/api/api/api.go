@@ -58,6 +58,7 @@ type gatewayService struct {
 	evaluationPublisher         publisher.Publisher
 	metricsPublisher            publisher.Publisher
 	segmentUsersCache           cachev3.SegmentUsersCache
+	segmentUserIndexCache       cachev3.SegmentUserIndexCache
 	segmentUsersRedisCache      cachev3.SegmentUsersCache
 	featuresCache               cachev3.FeaturesCache
 	featuresRedisCache          cachev3.FeaturesCache
@@ -108,12 +109,17 @@ func NewGatewayService(
 		featuresRedisCache:          cachev3.NewFeaturesCache(redisV3Cache, 0),
 		segmentUsersCache:           cachev3.NewSegmentUsersCache(inMemoryCache, options.segmentUsersMemoryCacheTTL),
 		segmentUsersRedisCache:      cachev3.NewSegmentUsersCache(redisV3Cache, 0),
+		segmentUserIndexCache:       cachev3.NewSegmentUserIndexCache(inMemoryCache, options.segmentUsersMemoryCacheTTL),
 		environmentAPIKeyCache:      cachev3.NewEnvironmentAPIKeyCache(inMemoryCache, options.apiKeyMemoryCacheTTL),
 		environmentAPIKeyRedisCache: cachev3.NewEnvironmentAPIKeyCache(redisV3Cache, 0),
 		streamDispatcher:            dispatcher,
 		opts:                        &options,
 		logger:                      options.logger.Named("api"),
 	}
+	if options.environmentAPIKeyCache != nil {
+		s.environmentAPIKeyCache = options.environmentAPIKeyCache
+		s.environmentAPIKeyRedisCache = options.environmentAPIKeyCache
+	}
 	s.streamEvalHandler = stream.NewEvaluationsHandler(
 		dispatcher,
 		sseHeartbeatInterval,
@@ -165,6 +171,8 @@ func (s *gatewayService) Register(mux *http.ServeMux) {
 	s.regist(mux, evaluationAPI, s.getEvaluation)
 	s.regist(mux, eventAPI, s.registerEvents)
 	s.regist(mux, streamEvaluationsAPI, s.streamEvalHandler.Handle)
+	mux.HandleFunc(ofrepEvaluateFlagsAPI+"/", s.ofrepEvaluateFlag)
+	mux.HandleFunc(ofrepEvaluateFlagsAPI, s.ofrepEvaluateFlags)
 }
 
 func (*gatewayService) regist(mux *http.ServeMux, path string, handler func(http.ResponseWriter, *http.Request)) {
@@ -428,7 +436,7 @@ func (s *gatewayService) evaluateFeaturesForStream(
 			mapIDs[id] = struct{}{}
 		}
 	}
-	segmentUsersMap, segmentsMap, err := s.listSegmentUsers(ctx, user.Id, mapIDs, environmentID)
+	segmentUserIndexes, segmentsMap, err := s.listSegmentUserIndexes(ctx, mapIDs, environmentID)
 	if err != nil {
 		if !isCallerContextErr(err) {
 			s.logger.Error(
@@ -442,8 +450,8 @@ func (s *gatewayService) evaluateFeaturesForStream(
 		return "", nil, err
 	}
 
-	evaluations, err := evaluator.EvaluateFeaturesByEvaluatedAt(
-		features, user, segmentUsersMap, segmentsMap,
+	evaluations, err := evaluator.EvaluateFeaturesByEvaluatedAtWithSegmentUserIndexes(
+		features, user, segmentUserIndexes, segmentsMap,
 		prevUEID, evaluatedAt, false, tag,
 	)
 	if err != nil {
@@ -708,7 +716,7 @@ func (s *gatewayService) evaluateFeatures(
 			mapIDs[id] = struct{}{}
 		}
 	}
-	mapSegmentUsers, mapSegments, err := s.listSegmentUsers(ctx, user.Id, mapIDs, environmentId)
+	segmentUserIndexes, mapSegments, err := s.listSegmentUserIndexes(ctx, mapIDs, environmentId)
 	if err != nil {
 		s.logger.Error(
 			"Failed to list segments",
@@ -719,7 +727,8 @@ func (s *gatewayService) evaluateFeatures(
 		)
 		return nil, err
 	}
-	userEvaluations, err := evaluator.EvaluateFeatures(features, user, mapSegmentUsers, mapSegments, tag)
+	userEvaluations, err := evaluator.EvaluateFeaturesWithSegmentUserIndexes(
+		features, user, segmentUserIndexes, mapSegments, tag)
 	if err != nil {
 		s.logger.Error(
 			"Failed to evaluate",
@@ -732,44 +741,61 @@ func (s *gatewayService) evaluateFeatures(
 	return userEvaluations, nil
 }
 
-func (s *gatewayService) listSegmentUsers(
+func (s *gatewayService) listSegmentUserIndexes(
 	ctx context.Context,
-	userID string,
 	mapSegmentIDs map[string]struct{},
 	environmentId string,
-) (map[string][]*featureproto.SegmentUser, map[string]*featureproto.Segment, error) {
+) (evaluation.SegmentUserIndexes, map[string]*featureproto.Segment, error) {
 	if len(mapSegmentIDs) == 0 {
 		return nil, nil, nil
 	}
-	users := make(map[string][]*featureproto.SegmentUser)
-	segments := make(map[string]*featureproto.Segment)
+	indexes := make(evaluation.SegmentUserIndexes, len(mapSegmentIDs))
+	segments := make(map[string]*featureproto.Segment, len(mapSegmentIDs))
 	for segmentID := range mapSegmentIDs {
-		s, err, _ := s.flightgroup.Do(s.segmentFlightID(environmentId, segmentID), func() (interface{}, error) {
-			return s.getSegmentUsers(ctx, segmentID, environmentId)
+		index, err, _ := s.flightgroup.Do(s.segmentUserIndexFlightID(environmentId, segmentID), func() (interface{}, error) {
+			return s.getSegmentUserIndex(ctx, segmentID, environmentId)
 		})
 		if err != nil {
 			return nil, nil, err
 		}
-		segmentUsers := s.(*featureproto.SegmentUsers)
-		users[segmentID] = segmentUsers.Users
-		segments[segmentID] = &featureproto.Segment{
-			Id:        segmentID,
-			Rules:     segmentUsers.Rules,
-			UpdatedAt: segmentUsers.UpdatedAt,
-		}
+		segmentUserIndex := index.(*cachev3.SegmentUserIndex)
+		indexes[segmentID] = segmentUserIndex.Users
+		segments[segmentID] = segmentUserIndex.Segment
 	}
-	return users, segments, nil
+	return indexes, segments, nil
 }
 
-func (s *gatewayService) segmentFlightID(environmentId, segmentID string) string {
-	return fmt.Sprintf("%s:%s", environmentId, segmentID)
+// getSegmentUserIndex builds the user index when the segment users are loaded,
+// and reuses it until the segment is updated or the in-memory cache expires.
+// The segment users are not kept in memory, only the index built from them.
+func (s *gatewayService) getSegmentUserIndex(
+	ctx context.Context,
+	segmentID, environmentId string,
+) (*cachev3.SegmentUserIndex, error) {
+	index, err := s.segmentUserIndexCache.Get(segmentID, environmentId)
+	if err == nil {
+		restCacheCounter.WithLabelValues(callerGatewayService, typeSegmentUserIndex, cacheLayerInMemory, codeHit).Inc()
+		return index, nil
+	}
+	restCacheCounter.WithLabelValues(callerGatewayService, typeSegmentUserIndex, cacheLayerInMemory, codeMiss).Inc()
+	segmentUsers, err := s.getSegmentUsers(ctx, segmentID, environmentId)
+	if err != nil {
+		return nil, err
+	}
+	index = newSegmentUserIndex(segmentID, segmentUsers)
+	putSegmentUserIndexCache(ctx, index, environmentId, s.segmentUserIndexCache, s.logger)
+	return index, nil
+}
+
+func (s *gatewayService) segmentUserIndexFlightID(environmentId, segmentID string) string {
+	return fmt.Sprintf("%s:%s:index", environmentId, segmentID)
 }
 
 func (s *gatewayService) getSegmentUsers(
 	ctx context.Context,
 	segmentID, environmentId string,
 ) (*featureproto.SegmentUsers, error) {
-	// L1: in-memory cache
+	// L1: the in-memory copy cached by GetSegmentUsers, if any
 	segment, err := s.segmentUsersCache.Get(segmentID, environmentId)
 	if err == nil {
 		restCacheCounter.WithLabelValues(callerGatewayService, typeSegmentUsers, cacheLayerInMemory, codeHit).Inc()
@@ -780,7 +806,6 @@ func (s *gatewayService) getSegmentUsers(
 	segment, err = s.segmentUsersRedisCache.Get(segmentID, environmentId)
 	if err == nil {
 		restCacheCounter.WithLabelValues(callerGatewayService, typeSegmentUsers, cacheLayerExternal, codeHit).Inc()
-		putSegmentUsersCache(ctx, segment, environmentId, s.segmentUsersCache, s.logger)
 		return segment, nil
 	}
 	restCacheCounter.WithLabelValues(callerGatewayService, typeSegmentUsers, cacheLayerExternal, codeMiss).Inc()
@@ -837,7 +862,6 @@ func (s *gatewayService) getSegmentUsers(
 				Users:     res.Users,
 				UpdatedAt: time.Now().Unix(),
 			}
-			putSegmentUsersCache(ctx, segmentUsers, environmentId, s.segmentUsersCache, s.logger)
 			return segmentUsers, nil
 		}
 		s.logger.Error(
@@ -850,14 +874,12 @@ func (s *gatewayService) getSegmentUsers(
 		)
 		return nil, errInternal
 	}
-	segmentUsers := &featureproto.SegmentUsers{
+	return &featureproto.SegmentUsers{
 		SegmentId: segmentID,
 		Users:     res.Users,
 		Rules:     respGet.Segment.Rules,
 		UpdatedAt: respGet.Segment.UpdatedAt,
-	}
-	putSegmentUsersCache(ctx, segmentUsers, environmentId, s.segmentUsersCache, s.logger)
-	return segmentUsers, nil
+	}, nil
 }
 
 func (s *gatewayService) getFeatures(
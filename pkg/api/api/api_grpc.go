@@ -33,6 +33,7 @@ import (
 	evaluation "github.com/bucketeer-io/bucketeer/v2/evaluation/go"
 	accountclient "github.com/bucketeer-io/bucketeer/v2/pkg/account/client"
 	accstorage "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/api/stream"
 	auditlogclient "github.com/bucketeer-io/bucketeer/v2/pkg/auditlog/client"
 	autoopsclient "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/client"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/cache"
@@ -69,6 +70,9 @@ const (
 	// and shorter than the SDK / load-balancer timeout so that runaway work
 	// is eventually released.
 	singleflightFetchTimeout = 10 * time.Second
+	// segmentUserBloomFilterMinUsers is the segment size from which the user index
+	// rejects most of the users with a bloom filter before the hash set lookup.
+	segmentUserBloomFilterMinUsers = 100000
 )
 
 var (
@@ -94,6 +98,9 @@ var (
 	ErrBadRole                 = status.Error(codes.PermissionDenied, "gateway: bad role")
 	ErrInternal                = status.Error(codes.Internal, "gateway: internal")
 	ErrNotFound                = status.Error(codes.NotFound, "gateway: not found")
+	ErrWatchNotAvailable       = status.Error(codes.Unimplemented, "gateway: watch is not available")
+	ErrTooManyWatchStreams     = status.Error(codes.ResourceExhausted, "gateway: stream connection limit reached")
+	ErrShuttingDown            = status.Error(codes.Unavailable, "gateway: server is shutting down")
 
 	// errCallerCanceled is wrapped around the underlying gRPC status error when
 	// singleflightFetch returns because the caller's request context was
@@ -116,9 +123,12 @@ type options struct {
 	oldestEventTimestamp              time.Duration
 	furthestEventTimestamp            time.Duration
 	featureFlagDiffGracePeriod        time.Duration
+	watchHeartbeatInterval            time.Duration
 	metricsWorkers                    int
 	metricsQueueSize                  int
 	inMemoryCache                     *cachev3.InMemoryCache
+	environmentAPIKeyCache            cachev3.EnvironmentAPIKeyCache
+	streamDispatcher                  *stream.Dispatcher
 	metrics                           metrics.Registerer
 	logger                            *zap.Logger
 }
@@ -142,6 +152,7 @@ var defaultOptions = options{
 	// flag values after an unrelated update advances its time cursor past
 	// a still-stale flag's UpdatedAt.
 	featureFlagDiffGracePeriod: 10 * time.Minute,
+	watchHeartbeatInterval:     25 * time.Second,
 	logger:                     zap.NewNop(),
 	metricsWorkers:             4,
 	metricsQueueSize:           4096,
@@ -210,6 +221,32 @@ func WithInMemoryCache(c *cachev3.InMemoryCache) Option {
 	}
 }
 
+// WithEnvironmentAPIKeyCache replaces both layers of the API key cache.
+// The relay proxy uses it to look up keys in a snapshot that holds only their hashes.
+func WithEnvironmentAPIKeyCache(c cachev3.EnvironmentAPIKeyCache) Option {
+	return func(opts *options) {
+		opts.environmentAPIKeyCache = c
+	}
+}
+
+// WithStreamDispatcher enables WatchFeatureFlags. The dispatcher notifies the
+// streams of flag and segment changes and enforces the connection limit.
+func WithStreamDispatcher(d *stream.Dispatcher) Option {
+	return func(opts *options) {
+		opts.streamDispatcher = d
+	}
+}
+
+// WithWatchHeartbeatInterval sets how often WatchFeatureFlags resyncs and
+// sends a heartbeat when nothing changed.
+func WithWatchHeartbeatInterval(d time.Duration) Option {
+	return func(opts *options) {
+		if d > 0 {
+			opts.watchHeartbeatInterval = d
+		}
+	}
+}
+
 func WithMetrics(r metrics.Registerer) Option {
 	return func(opts *options) {
 		opts.metrics = r
@@ -254,6 +291,7 @@ type grpcGatewayService struct {
 	featuresRedisCache          cachev3.FeaturesCache
 	segmentUsersCache           cachev3.SegmentUsersCache
 	segmentUsersRedisCache      cachev3.SegmentUsersCache
+	segmentUserIndexCache       cachev3.SegmentUserIndexCache
 	environmentAPIKeyCache      cachev3.EnvironmentAPIKeyCache
 	environmentAPIKeyRedisCache cachev3.EnvironmentAPIKeyCache
 	apiKeyLastUsedInfoCacher    sync.Map
@@ -320,12 +358,17 @@ func NewGrpcGatewayService(
 		featuresRedisCache:          cachev3.NewFeaturesCache(redisV3Cache, 0),
 		segmentUsersCache:           cachev3.NewSegmentUsersCache(inMemoryCache, options.segmentUsersMemoryCacheTTL),
 		segmentUsersRedisCache:      cachev3.NewSegmentUsersCache(redisV3Cache, 0),
+		segmentUserIndexCache:       cachev3.NewSegmentUserIndexCache(inMemoryCache, options.segmentUsersMemoryCacheTTL),
 		environmentAPIKeyCache:      cachev3.NewEnvironmentAPIKeyCache(inMemoryCache, options.apiKeyMemoryCacheTTL),
 		environmentAPIKeyRedisCache: cachev3.NewEnvironmentAPIKeyCache(redisV3Cache, 0),
 		apiKeyLastUsedInfoCacher:    sync.Map{},
 		opts:                        &options,
 		logger:                      options.logger.Named("api_grpc"),
 	}
+	if options.environmentAPIKeyCache != nil {
+		s.environmentAPIKeyCache = options.environmentAPIKeyCache
+		s.environmentAPIKeyRedisCache = options.environmentAPIKeyCache
+	}
 
 	s.startMetricsWorkers(options.metricsWorkers, options.metricsQueueSize)
 	go s.writeAPIKeyLastUsedAtCacheToDatabase(ctx)
@@ -554,7 +597,7 @@ func (s *grpcGatewayService) GetEvaluations(
 		}, nil
 	}
 
-	segmentUsersMap, segmentsMap, err := s.getSegmentUsersMap(ctx, features, environmentId)
+	segmentUserIndexes, segmentsMap, err := s.getSegmentUserIndexes(ctx, features, environmentId)
 	if err != nil {
 		if isCallerContextErr(err) {
 			evaluationsCounter.WithLabelValues(
@@ -591,10 +634,10 @@ func (s *grpcGatewayService) GetEvaluations(
 				environmentId, envAPIKey.Environment.UrlCode, req.Tag, codeBadRequest, sourceID).Inc()
 			return nil, ErrTagRequired
 		}
-		evaluations, err = evaluator.EvaluateFeatures(
+		evaluations, err = evaluator.EvaluateFeaturesWithSegmentUserIndexes(
 			features,
 			req.User,
-			segmentUsersMap,
+			segmentUserIndexes,
 			segmentsMap,
 			req.Tag,
 		)
@@ -630,10 +673,10 @@ func (s *grpcGatewayService) GetEvaluations(
 		evaluationsCounter.WithLabelValues(
 			environmentId, envAPIKey.Environment.UrlCode, req.Tag, codeOld, sourceID).Inc()
 	} else {
-		evaluations, err = evaluator.EvaluateFeaturesByEvaluatedAt(
+		evaluations, err = evaluator.EvaluateFeaturesByEvaluatedAtWithSegmentUserIndexes(
 			features,
 			req.User,
-			segmentUsersMap,
+			segmentUserIndexes,
 			segmentsMap,
 			req.UserEvaluationsId,
 			req.UserEvaluationCondition.EvaluatedAt,
@@ -772,7 +815,7 @@ func (s *grpcGatewayService) GetEvaluation(
 	if err != nil {
 		return nil, err
 	}
-	segmentUsersMap, segmentsMap, err := s.getSegmentUsersMap(ctx, features, envAPIKey.Environment.Id)
+	segmentUserIndexes, segmentsMap, err := s.getSegmentUserIndexes(ctx, features, envAPIKey.Environment.Id)
 	if err != nil {
 		if isCallerContextErr(err) {
 			return nil, status.FromContextError(ctx.Err()).Err()
@@ -788,7 +831,8 @@ func (s *grpcGatewayService) GetEvaluation(
 		return nil, err
 	}
 	evaluator := evaluation.NewEvaluator()
-	evaluations, err := evaluator.EvaluateFeatures(features, req.User, segmentUsersMap, segmentsMap, req.Tag)
+	evaluations, err := evaluator.EvaluateFeaturesWithSegmentUserIndexes(
+		features, req.User, segmentUserIndexes, segmentsMap, req.Tag)
 	if err != nil {
 		s.logger.Error(
 			"Failed to evaluate features",
@@ -1462,13 +1506,13 @@ func putFeaturesCache(
 	}
 }
 
-// getSegmentUsersMap returns both the segment users and the segment
+// getSegmentUserIndexes returns both the user indexes and the segment
 // definitions (id + rules) for all the segments referenced by the features.
-func (s *grpcGatewayService) getSegmentUsersMap(
+func (s *grpcGatewayService) getSegmentUserIndexes(
 	ctx context.Context,
 	features []*featureproto.Feature,
 	environmentId string,
-) (map[string][]*featureproto.SegmentUser, map[string]*featureproto.Segment, error) {
+) (evaluation.SegmentUserIndexes, map[string]*featureproto.Segment, error) {
 	evaluator := evaluation.NewEvaluator()
 	mapIDs := make(map[string]struct{})
 	for _, f := range features {
@@ -1476,7 +1520,7 @@ func (s *grpcGatewayService) getSegmentUsersMap(
 			mapIDs[id] = struct{}{}
 		}
 	}
-	segmentUsersMap, segmentsMap, err := s.listSegmentUsers(ctx, mapIDs, environmentId)
+	segmentUserIndexes, segmentsMap, err := s.listSegmentUserIndexes(ctx, mapIDs, environmentId)
 	if err != nil {
 		if !isCallerContextErr(err) {
 			s.logger.Error(
@@ -1489,45 +1533,81 @@ func (s *grpcGatewayService) getSegmentUsersMap(
 		}
 		return nil, nil, err
 	}
-	return segmentUsersMap, segmentsMap, nil
+	return segmentUserIndexes, segmentsMap, nil
 }
 
-func (s *grpcGatewayService) listSegmentUsers(
+func (s *grpcGatewayService) listSegmentUserIndexes(
 	ctx context.Context,
 	mapSegmentIDs map[string]struct{},
 	environmentId string,
-) (map[string][]*featureproto.SegmentUser, map[string]*featureproto.Segment, error) {
+) (evaluation.SegmentUserIndexes, map[string]*featureproto.Segment, error) {
 	if len(mapSegmentIDs) == 0 {
 		return nil, nil, nil
 	}
-	users := make(map[string][]*featureproto.SegmentUser)
-	segments := make(map[string]*featureproto.Segment)
+	indexes := make(evaluation.SegmentUserIndexes, len(mapSegmentIDs))
+	segments := make(map[string]*featureproto.Segment, len(mapSegmentIDs))
 	for segmentID := range mapSegmentIDs {
-		su, err := s.singleflightFetch(
+		index, err := s.singleflightFetch(
 			ctx,
-			s.segmentFlightID(environmentId, segmentID),
+			s.segmentUserIndexFlightID(environmentId, segmentID),
 			func(ctx context.Context) (interface{}, error) {
-				return s.getSegmentUsersBySegmentID(ctx, segmentID, environmentId)
+				return s.getSegmentUserIndex(ctx, segmentID, environmentId)
 			},
 		)
 		if err != nil {
 			return nil, nil, err
 		}
-		segmentUsers := su.(*featureproto.SegmentUsers)
-		users[segmentID] = segmentUsers.Users
-		segments[segmentID] = &featureproto.Segment{
-			Id:        segmentID,
-			Rules:     segmentUsers.Rules,
-			UpdatedAt: segmentUsers.UpdatedAt,
+		segmentUserIndex := index.(*cachev3.SegmentUserIndex)
+		indexes[segmentID] = segmentUserIndex.Users
+		segments[segmentID] = segmentUserIndex.Segment
+	}
+	return indexes, segments, nil
+}
+
+// getSegmentUserIndex builds the user index when the segment users are loaded,
+// and reuses it until the segment is updated or the in-memory cache expires.
+// The segment users are not kept in memory, only the index built from them.
+func (s *grpcGatewayService) getSegmentUserIndex(
+	ctx context.Context,
+	segmentID, environmentId string,
+) (*cachev3.SegmentUserIndex, error) {
+	index, err := getSegmentUserIndexFromCache(
+		segmentID,
+		environmentId,
+		s.segmentUserIndexCache,
+		callerGatewayService,
+	)
+	if err == nil {
+		return index, nil
+	}
+	// L1: the in-memory copy cached by GetSegmentUsers, if any
+	segmentUsers, err := getSegmentUsersFromCache(
+		segmentID,
+		environmentId,
+		s.segmentUsersCache,
+		callerGatewayService,
+		cacheLayerInMemory,
+	)
+	if err != nil {
+		segmentUsers, err = s.loadSegmentUsers(ctx, segmentID, environmentId)
+		if err != nil {
+			return nil, err
 		}
 	}
-	return users, segments, nil
+	index = newSegmentUserIndex(segmentID, segmentUsers)
+	putSegmentUserIndexCache(ctx, index, environmentId, s.segmentUserIndexCache, s.logger)
+	return index, nil
 }
 
 func (s *grpcGatewayService) segmentFlightID(environmentId, segmentID string) string {
 	return environmentId + ":" + segmentID
 }
 
+// segmentUserIndexFlightID differs from segmentFlightID because the flights return different types.
+func (s *grpcGatewayService) segmentUserIndexFlightID(environmentId, segmentID string) string {
+	return environmentId + ":" + segmentID + ":index"
+}
+
 func (s *grpcGatewayService) getSegmentUsersBySegmentID(
 	ctx context.Context,
 	segmentID, environmentId string,
@@ -1543,8 +1623,21 @@ func (s *grpcGatewayService) getSegmentUsersBySegmentID(
 	if err == nil {
 		return segmentUsers, nil
 	}
+	segmentUsers, err = s.loadSegmentUsers(ctx, segmentID, environmentId)
+	if err != nil {
+		return nil, err
+	}
+	putSegmentUsersCache(ctx, segmentUsers, environmentId, s.segmentUsersCache, s.logger)
+	return segmentUsers, nil
+}
+
+// loadSegmentUsers loads the segment users from Redis, or from the feature service on a miss.
+func (s *grpcGatewayService) loadSegmentUsers(
+	ctx context.Context,
+	segmentID, environmentId string,
+) (*featureproto.SegmentUsers, error) {
 	// L2: Redis cache (kept warm by batch cacher)
-	segmentUsers, err = getSegmentUsersFromCache(
+	segmentUsers, err := getSegmentUsersFromCache(
 		segmentID,
 		environmentId,
 		s.segmentUsersRedisCache,
@@ -1552,7 +1645,6 @@ func (s *grpcGatewayService) getSegmentUsersBySegmentID(
 		cacheLayerExternal,
 	)
 	if err == nil {
-		putSegmentUsersCache(ctx, segmentUsers, environmentId, s.segmentUsersCache, s.logger)
 		return segmentUsers, nil
 	}
 	// L3: feature service (DB)
@@ -1600,7 +1692,6 @@ func (s *grpcGatewayService) getSegmentUsersBySegmentID(
 				Users:     res.Users,
 				UpdatedAt: time.Now().Unix(),
 			}
-			putSegmentUsersCache(ctx, segmentUsers, environmentId, s.segmentUsersCache, s.logger)
 			return segmentUsers, nil
 		}
 		s.logger.Error(
@@ -1613,14 +1704,12 @@ func (s *grpcGatewayService) getSegmentUsersBySegmentID(
 		)
 		return nil, ErrInternal
 	}
-	segmentUsers = &featureproto.SegmentUsers{
+	return &featureproto.SegmentUsers{
 		SegmentId: segmentID,
 		Users:     res.Users,
 		Rules:     respGet.Segment.Rules,
 		UpdatedAt: respGet.Segment.UpdatedAt,
-	}
-	putSegmentUsersCache(ctx, segmentUsers, environmentId, s.segmentUsersCache, s.logger)
-	return segmentUsers, nil
+	}, nil
 }
 
 func getSegmentUsersFromCache(
@@ -1637,6 +1726,55 @@ func getSegmentUsersFromCache(
 	return nil, err
 }
 
+func getSegmentUserIndexFromCache(
+	segmentID, environmentId string,
+	c cachev3.SegmentUserIndexCache,
+	caller string,
+) (*cachev3.SegmentUserIndex, error) {
+	index, err := c.Get(segmentID, environmentId)
+	if err == nil {
+		cacheCounter.WithLabelValues(caller, typeSegmentUserIndex, cacheLayerInMemory, codeHit).Inc()
+		return index, nil
+	}
+	cacheCounter.WithLabelValues(caller, typeSegmentUserIndex, cacheLayerInMemory, codeMiss).Inc()
+	return nil, err
+}
+
+// newSegmentUserIndex prepares the segment for the evaluation.
+func newSegmentUserIndex(segmentID string, segmentUsers *featureproto.SegmentUsers) *cachev3.SegmentUserIndex {
+	return &cachev3.SegmentUserIndex{
+		Segment: &featureproto.Segment{
+			Id:        segmentID,
+			Rules:     segmentUsers.Rules,
+			UpdatedAt: segmentUsers.UpdatedAt,
+		},
+		Users: evaluation.NewSegmentUserIndex(
+			segmentID,
+			segmentUsers.Users,
+			evaluation.WithBloomFilter(segmentUserBloomFilterMinUsers),
+		),
+	}
+}
+
+func putSegmentUserIndexCache(
+	ctx context.Context,
+	index *cachev3.SegmentUserIndex,
+	environmentId string,
+	segmentUserIndexCache cachev3.SegmentUserIndexCache,
+	logger *zap.Logger,
+) {
+	if err := segmentUserIndexCache.Put(index, environmentId); err != nil {
+		logger.Error(
+			"Failed to cache segment user index",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentID", environmentId),
+				zap.String("segmentId", index.Segment.Id),
+			)...,
+		)
+	}
+}
+
 func putSegmentUsersCache(
 	ctx context.Context,
 	segmentUsers *featureproto.SegmentUsers,
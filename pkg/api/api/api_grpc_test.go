@@ -40,6 +40,7 @@ import (
 	auditlogclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/auditlog/client/mock"
 	autoopsclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/client/mock"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/cache"
+	cachev3 "github.com/bucketeer-io/bucketeer/v2/pkg/cache/v3"
 	cachev3mock "github.com/bucketeer-io/bucketeer/v2/pkg/cache/v3/mock"
 	coderefclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/coderef/client/mock"
 	environmentclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/environment/client/mock"
@@ -1081,6 +1082,108 @@ func TestGrpcGetSegmentUsersContextCanceled(t *testing.T) {
 	}
 }
 
+func TestGrpcGetSegmentUserIndex(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	cached := &cachev3.SegmentUserIndex{
+		Segment: &featureproto.Segment{Id: "seg-0"},
+		Users: evaluation.NewSegmentUserIndex("seg-0", []*featureproto.SegmentUser{
+			{SegmentId: "seg-0", UserId: "user-0", State: featureproto.SegmentUser_INCLUDED},
+		}),
+	}
+	patterns := []struct {
+		desc             string
+		setup            func(*grpcGatewayService, *cachev3mock.MockSegmentUserIndexCache)
+		expectedSegment  *featureproto.Segment
+		expectedIncluded []string
+		expectedExcluded []string
+		expectedErr      error
+	}{
+		{
+			desc: "exists in in-memory cache",
+			setup: func(gs *grpcGatewayService, ic *cachev3mock.MockSegmentUserIndexCache) {
+				ic.EXPECT().Get("seg-0", "ns0").Return(cached, nil)
+			},
+			expectedSegment:  &featureproto.Segment{Id: "seg-0"},
+			expectedIncluded: []string{"user-0"},
+			expectedExcluded: []string{"user-1"},
+			expectedErr:      nil,
+		},
+		{
+			desc: "built from the segment users",
+			setup: func(gs *grpcGatewayService, ic *cachev3mock.MockSegmentUserIndexCache) {
+				ic.EXPECT().Get("seg-0", "ns0").Return(nil, cache.ErrNotFound)
+				gs.segmentUsersCache.(*cachev3mock.MockSegmentUsersCache).EXPECT().Get("seg-0", "ns0").Return(
+					&featureproto.SegmentUsers{
+						SegmentId: "seg-0",
+						Users: []*featureproto.SegmentUser{
+							{SegmentId: "seg-0", UserId: "user-1", State: featureproto.SegmentUser_INCLUDED},
+							{SegmentId: "seg-1", UserId: "user-2", State: featureproto.SegmentUser_INCLUDED},
+						},
+						UpdatedAt: 1,
+					}, nil)
+				ic.EXPECT().Put(gomock.Any(), "ns0").Return(nil)
+			},
+			expectedSegment:  &featureproto.Segment{Id: "seg-0", UpdatedAt: 1},
+			expectedIncluded: []string{"user-1"},
+			expectedExcluded: []string{"user-0", "user-2"},
+			expectedErr:      nil,
+		},
+		{
+			desc: "built even if the cache put fails",
+			setup: func(gs *grpcGatewayService, ic *cachev3mock.MockSegmentUserIndexCache) {
+				ic.EXPECT().Get("seg-0", "ns0").Return(nil, cache.ErrNotFound)
+				gs.segmentUsersCache.(*cachev3mock.MockSegmentUsersCache).EXPECT().Get("seg-0", "ns0").Return(
+					&featureproto.SegmentUsers{
+						SegmentId: "seg-0",
+						Users: []*featureproto.SegmentUser{
+							{SegmentId: "seg-0", UserId: "user-1", State: featureproto.SegmentUser_INCLUDED},
+						},
+					}, nil)
+				ic.EXPECT().Put(gomock.Any(), "ns0").Return(errors.New("test"))
+			},
+			expectedSegment:  &featureproto.Segment{Id: "seg-0"},
+			expectedIncluded: []string{"user-1"},
+			expectedExcluded: []string{"user-0"},
+			expectedErr:      nil,
+		},
+		{
+			desc: "ErrInternal: segment users can't be loaded",
+			setup: func(gs *grpcGatewayService, ic *cachev3mock.MockSegmentUserIndexCache) {
+				ic.EXPECT().Get("seg-0", "ns0").Return(nil, cache.ErrNotFound)
+				gs.segmentUsersCache.(*cachev3mock.MockSegmentUsersCache).EXPECT().Get(gomock.Any(), gomock.Any()).Return(
+					nil, cache.ErrNotFound)
+				gs.featureClient.(*featureclientmock.MockClient).EXPECT().ListSegmentUsers(gomock.Any(), gomock.Any()).Return(
+					nil, errors.New("test"))
+			},
+			expectedErr: ErrInternal,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			gs := newGrpcGatewayServiceWithMock(t, mockController)
+			ic := cachev3mock.NewMockSegmentUserIndexCache(mockController)
+			gs.segmentUserIndexCache = ic
+			p.setup(gs, ic)
+			actual, err := gs.getSegmentUserIndex(context.Background(), "seg-0", "ns0")
+			assert.Equal(t, p.expectedErr, err)
+			if err != nil {
+				assert.Nil(t, actual)
+				return
+			}
+			assert.Equal(t, p.expectedSegment, actual.Segment)
+			for _, id := range p.expectedIncluded {
+				assert.True(t, actual.Users.Contains(id), id)
+			}
+			for _, id := range p.expectedExcluded {
+				assert.False(t, actual.Users.Contains(id), id)
+			}
+		})
+	}
+}
+
 func TestGrpcGetSegmentUsers(t *testing.T) {
 	t.Parallel()
 	mockController := gomock.NewController(t)
@@ -3163,7 +3266,6 @@ func TestGrpcGetEvaluationsEvaluateFeatures(t *testing.T) {
 					}, nil)
 				gs.segmentUsersCache.(*cachev3mock.MockSegmentUsersCache).EXPECT().Get(gomock.Any(), gomock.Any()).Return(
 					nil, errors.New("random error"))
-				gs.segmentUsersCache.(*cachev3mock.MockSegmentUsersCache).EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil)
 				gs.featureClient.(*featureclientmock.MockClient).EXPECT().ListSegmentUsers(gomock.Any(), gomock.Any()).Return(
 					&featureproto.ListSegmentUsersResponse{}, nil)
 				gs.featureClient.(*featureclientmock.MockClient).EXPECT().GetSegment(gomock.Any(), gomock.Any()).Return(
@@ -4474,6 +4576,9 @@ func newGrpcGatewayServiceWithMock(t *testing.T, mockController *gomock.Controll
 	redisFeaturesCache.EXPECT().Get(gomock.Any()).Return(nil, cache.ErrNotFound).AnyTimes()
 	redisSegmentUsersCache := cachev3mock.NewMockSegmentUsersCache(mockController)
 	redisSegmentUsersCache.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, cache.ErrNotFound).AnyTimes()
+	segmentUserIndexCache := cachev3mock.NewMockSegmentUserIndexCache(mockController)
+	segmentUserIndexCache.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, cache.ErrNotFound).AnyTimes()
+	segmentUserIndexCache.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	return &grpcGatewayService{
 		featureClient:               featureclientmock.NewMockClient(mockController),
 		accountClient:               accountclientmock.NewMockClient(mockController),
@@ -4494,6 +4599,7 @@ func newGrpcGatewayServiceWithMock(t *testing.T, mockController *gomock.Controll
 		featuresRedisCache:          redisFeaturesCache,
 		segmentUsersCache:           cachev3mock.NewMockSegmentUsersCache(mockController),
 		segmentUsersRedisCache:      redisSegmentUsersCache,
+		segmentUserIndexCache:       segmentUserIndexCache,
 		environmentAPIKeyCache:      cachev3mock.NewMockEnvironmentAPIKeyCache(mockController),
 		environmentAPIKeyRedisCache: redisAPIKeyCache,
 		apiKeyLastUsedInfoCacher:    sync.Map{},
@@ -1560,7 +1560,6 @@ func TestGetEvaluationsEvaluateFeatures(t *testing.T) {
 					}, nil)
 				gs.segmentUsersCache.(*cachev3mock.MockSegmentUsersCache).EXPECT().Get(gomock.Any(), gomock.Any()).Return(
 					nil, errors.New("random error"))
-				gs.segmentUsersCache.(*cachev3mock.MockSegmentUsersCache).EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil)
 				gs.featureClient.(*featureclientmock.MockClient).EXPECT().ListSegmentUsers(gomock.Any(), gomock.Any()).Return(
 					&featureproto.ListSegmentUsersResponse{}, nil)
 				gs.featureClient.(*featureclientmock.MockClient).EXPECT().GetSegment(gomock.Any(), gomock.Any()).Return(
@@ -2501,7 +2500,6 @@ func TestGetSegmentUsersSegmentNotFound(t *testing.T) {
 	// it. Evaluation must continue with the user list only instead of failing.
 	gs.featureClient.(*featureclientmock.MockClient).EXPECT().GetSegment(gomock.Any(), gomock.Any()).Return(
 		nil, status.Error(codes.NotFound, "segment not found"))
-	gs.segmentUsersCache.(*cachev3mock.MockSegmentUsersCache).EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil)
 
 	actual, err := gs.getSegmentUsers(context.Background(), "seg-0", "ns0")
 	assert.NoError(t, err)
@@ -2525,6 +2523,9 @@ func newGatewayServiceWithMock(t *testing.T, mockController *gomock.Controller)
 	redisFeaturesCache.EXPECT().Get(gomock.Any()).Return(nil, cache.ErrNotFound).AnyTimes()
 	redisSegmentUsersCache := cachev3mock.NewMockSegmentUsersCache(mockController)
 	redisSegmentUsersCache.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, cache.ErrNotFound).AnyTimes()
+	segmentUserIndexCache := cachev3mock.NewMockSegmentUserIndexCache(mockController)
+	segmentUserIndexCache.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, cache.ErrNotFound).AnyTimes()
+	segmentUserIndexCache.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	return &gatewayService{
 		featureClient:               featureclientmock.NewMockClient(mockController),
 		accountClient:               accountclientmock.NewMockClient(mockController),
@@ -2536,6 +2537,7 @@ func newGatewayServiceWithMock(t *testing.T, mockController *gomock.Controller)
 		featuresRedisCache:          redisFeaturesCache,
 		segmentUsersCache:           cachev3mock.NewMockSegmentUsersCache(mockController),
 		segmentUsersRedisCache:      redisSegmentUsersCache,
+		segmentUserIndexCache:       segmentUserIndexCache,
 		environmentAPIKeyCache:      cachev3mock.NewMockEnvironmentAPIKeyCache(mockController),
 		environmentAPIKeyRedisCache: redisAPIKeyCache,
 		opts:                        &defaultOptions,
@@ -2685,7 +2687,6 @@ func TestEvaluateFeaturesForStream(t *testing.T) {
 					&featureproto.ListSegmentUsersResponse{}, nil)
 				gs.featureClient.(*featureclientmock.MockClient).EXPECT().GetSegment(gomock.Any(), gomock.Any()).Return(
 					&featureproto.GetSegmentResponse{Segment: &featureproto.Segment{Id: "segment-id"}}, nil)
-				gs.segmentUsersCache.(*cachev3mock.MockSegmentUsersCache).EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil)
 			},
 
 			expected: &featureproto.UserEvaluations{
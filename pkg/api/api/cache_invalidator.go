@@ -30,6 +30,7 @@ import (
 type cacheInvalidator struct {
 	featuresCache          cachev3.FeaturesCache
 	segmentUsersCache      cachev3.SegmentUsersCache
+	segmentUserIndexCache  cachev3.SegmentUserIndexCache
 	environmentAPIKeyCache cachev3.EnvironmentAPIKeyCache
 	streamDispatcher       *stream.Dispatcher
 	logger                 *zap.Logger
@@ -38,6 +39,7 @@ type cacheInvalidator struct {
 func NewCacheInvalidator(
 	featuresCache cachev3.FeaturesCache,
 	segmentUsersCache cachev3.SegmentUsersCache,
+	segmentUserIndexCache cachev3.SegmentUserIndexCache,
 	environmentAPIKeyCache cachev3.EnvironmentAPIKeyCache,
 	streamDispatcher *stream.Dispatcher,
 	logger *zap.Logger,
@@ -45,6 +47,7 @@ func NewCacheInvalidator(
 	return &cacheInvalidator{
 		featuresCache:          featuresCache,
 		segmentUsersCache:      segmentUsersCache,
+		segmentUserIndexCache:  segmentUserIndexCache,
 		environmentAPIKeyCache: environmentAPIKeyCache,
 		streamDispatcher:       streamDispatcher,
 		logger:                 logger.Named("cache-invalidator"),
@@ -72,6 +75,12 @@ func (ci *cacheInvalidator) handleMessage(msg *puller.Message) {
 		ci.logger.Warn("Failed to unmarshal domain event", zap.Error(err))
 		return
 	}
+	ci.HandleEvent(event)
+}
+
+// HandleEvent evicts the L1 entries affected by the event and notifies the active SSE streams.
+// It is also used by the relay proxy, which learns about changes by polling instead of PubSub.
+func (ci *cacheInvalidator) HandleEvent(event *domaineventproto.Event) {
 	if err := ci.evict(event); err != nil {
 		return
 	}
@@ -111,6 +120,15 @@ func (ci *cacheInvalidator) evict(event *domaineventproto.Event) error {
 			)
 			return err
 		}
+		if err := ci.segmentUserIndexCache.Evict(event.EntityId, event.EnvironmentId); err != nil {
+			ci.logger.Warn("Failed to evict segment user index cache",
+				zap.Error(err),
+				zap.String("environmentId", event.EnvironmentId),
+				zap.String("segmentId", event.EntityId),
+				zap.String("type", event.Type.String()),
+			)
+			return err
+		}
 		cacheInvalidationCounter.WithLabelValues(
 			event.EntityType.String(), event.Type.String(), event.EnvironmentId,
 		).Inc()
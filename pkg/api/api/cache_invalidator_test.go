@@ -171,9 +171,12 @@ func TestCacheInvalidatorHandleMessage(t *testing.T) {
 			inMemoryCache := cachev3.NewInMemoryCache()
 			featuresCache := cachev3.NewFeaturesCache(inMemoryCache, 10*time.Minute)
 			segmentUsersCache := cachev3.NewSegmentUsersCache(inMemoryCache, 10*time.Minute)
+			segmentUserIndexCache := cachev3.NewSegmentUserIndexCache(inMemoryCache, 10*time.Minute)
 			environmentAPIKeyCache := cachev3.NewEnvironmentAPIKeyCache(inMemoryCache, 10*time.Minute)
 
-			invalidator := NewCacheInvalidator(featuresCache, segmentUsersCache, environmentAPIKeyCache, nil, zap.NewNop())
+			invalidator := NewCacheInvalidator(
+				featuresCache, segmentUsersCache, segmentUserIndexCache, environmentAPIKeyCache, nil, zap.NewNop(),
+			)
 
 			if p.setupCache != nil {
 				p.setupCache(featuresCache, segmentUsersCache, environmentAPIKeyCache)
@@ -194,3 +197,39 @@ func TestCacheInvalidatorHandleMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestCacheInvalidatorEvictsSegmentUserIndex(t *testing.T) {
+	t.Parallel()
+	inMemoryCache := cachev3.NewInMemoryCache()
+	segmentUserIndexCache := cachev3.NewSegmentUserIndexCache(inMemoryCache, 10*time.Minute)
+	invalidator := NewCacheInvalidator(
+		cachev3.NewFeaturesCache(inMemoryCache, 10*time.Minute),
+		cachev3.NewSegmentUsersCache(inMemoryCache, 10*time.Minute),
+		segmentUserIndexCache,
+		cachev3.NewEnvironmentAPIKeyCache(inMemoryCache, 10*time.Minute),
+		nil,
+		zap.NewNop(),
+	)
+	require.NoError(t, segmentUserIndexCache.Put(
+		newSegmentUserIndex("segment-id-1", &featureproto.SegmentUsers{SegmentId: "segment-id-1"}),
+		"env-1",
+	))
+	require.NoError(t, segmentUserIndexCache.Put(
+		newSegmentUserIndex("segment-id-2", &featureproto.SegmentUsers{SegmentId: "segment-id-2"}),
+		"env-1",
+	))
+
+	data, err := proto.Marshal(&domaineventproto.Event{
+		EntityType:    domaineventproto.Event_SEGMENT,
+		EntityId:      "segment-id-1",
+		EnvironmentId: "env-1",
+		Type:          domaineventproto.Event_SEGMENT_USER_ADDED,
+	})
+	require.NoError(t, err)
+	invalidator.handleMessage(&puller.Message{Data: data})
+
+	_, err = segmentUserIndexCache.Get("segment-id-1", "env-1")
+	assert.Error(t, err, "the index of the updated segment should be evicted")
+	_, err = segmentUserIndexCache.Get("segment-id-2", "env-1")
+	assert.NoError(t, err, "the index of the other segment should NOT be evicted")
+}
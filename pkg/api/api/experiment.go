@@ -165,6 +165,7 @@ func (s *grpcGatewayService) CreateExperiment(
 		StartAt:         req.StartAt,
 		StopAt:          req.StopAt,
 		BaseVariationId: req.BaseVariationId,
+		GuardrailGoals:  req.GuardrailGoals,
 	})
 	if err != nil {
 		s.logger.Error("Failed to create experiment",
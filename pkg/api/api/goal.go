@@ -154,11 +154,14 @@ func (s *grpcGatewayService) CreateGoal(
 	})
 	ctx = metadata.NewOutgoingContext(ctx, headerMetaData)
 	resp, err := s.experimentClient.CreateGoal(ctx, &experimentproto.CreateGoalRequest{
-		EnvironmentId:  envAPIKey.Environment.Id,
-		Id:             req.Id,
-		Name:           req.Name,
-		Description:    req.Description,
-		ConnectionType: req.ConnectionType,
+		EnvironmentId:        envAPIKey.Environment.Id,
+		Id:                   req.Id,
+		Name:                 req.Name,
+		Description:          req.Description,
+		ConnectionType:       req.ConnectionType,
+		MetricType:           req.MetricType,
+		ImprovementDirection: req.ImprovementDirection,
+		ValueCapPercentile:   req.ValueCapPercentile,
 	})
 	if err != nil {
 		s.logger.Error("Failed to create goal",
@@ -247,11 +250,14 @@ func (s *grpcGatewayService) UpdateGoal(
 	})
 	ctx = metadata.NewOutgoingContext(ctx, headerMetaData)
 	_, err = s.experimentClient.UpdateGoal(ctx, &experimentproto.UpdateGoalRequest{
-		EnvironmentId: envAPIKey.Environment.Id,
-		Id:            req.Id,
-		Name:          req.Name,
-		Description:   req.Description,
-		Archived:      req.Archived,
+		EnvironmentId:        envAPIKey.Environment.Id,
+		Id:                   req.Id,
+		Name:                 req.Name,
+		Description:          req.Description,
+		Archived:             req.Archived,
+		MetricType:           req.MetricType,
+		ImprovementDirection: req.ImprovementDirection,
+		ValueCapPercentile:   req.ValueCapPercentile,
 	})
 	if err != nil {
 		s.logger.Error("Failed to update goal",
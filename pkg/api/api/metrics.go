@@ -26,12 +26,17 @@ import (
 const (
 	callerGatewayService = "GatewayService"
 
-	methodGetEvaluations  = "GetEvaluations"
-	methodGetEvaluation   = "GetEvaluation"
-	methodRegisterEvents  = "RegisterEvents"
-	methodTrack           = "Track"
-	methodGetFeatureFlags = "GetFeatureFlags"
-	methodGetSegmentUsers = "GetSegmentUsers"
+	methodGetEvaluations    = "GetEvaluations"
+	methodGetEvaluation     = "GetEvaluation"
+	methodRegisterEvents    = "RegisterEvents"
+	methodTrack             = "Track"
+	methodGetFeatureFlags   = "GetFeatureFlags"
+	methodGetSegmentUsers   = "GetSegmentUsers"
+	methodWatchFeatureFlags = "WatchFeatureFlags"
+	methodListSDKAPIKeys    = "ListSDKAPIKeys"
+
+	methodOFREPEvaluateFlag  = "OFREPEvaluateFlag"
+	methodOFREPEvaluateFlags = "OFREPEvaluateFlags"
 
 	methodGetGoal    = "Goal"
 	methodListGoals  = "ListGoals"
@@ -125,15 +130,16 @@ const (
 	methodGetProject         = "GetProject"
 	methodListProjects       = "ListProjects"
 
-	typeFeatures      = "Features"
-	typeSegmentUsers  = "SegmentUsers"
-	typeAPIKey        = "APIKey"
-	typeRegisterEvent = "RegisterEvent"
-	typeEvaluation    = "Evaluation"
-	typeGoal          = "Goal"
-	typeMetrics       = "Metrics"
-	typeUnknown       = "Unknown"
-	typeTrack         = "Track"
+	typeFeatures         = "Features"
+	typeSegmentUsers     = "SegmentUsers"
+	typeSegmentUserIndex = "SegmentUserIndex"
+	typeAPIKey           = "APIKey"
+	typeRegisterEvent    = "RegisterEvent"
+	typeEvaluation       = "Evaluation"
+	typeGoal             = "Goal"
+	typeMetrics          = "Metrics"
+	typeUnknown          = "Unknown"
+	typeTrack            = "Track"
 
 	cacheLayerInMemory = "InMemory"
 	cacheLayerExternal = "External"
@@ -236,6 +242,13 @@ var (
 			"project_id", "project_url_code", "environment_id", "environment_url_code",
 			"source_id", "sdk_version", "response_type",
 		})
+	watchFeatureFlagsEventCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "bucketeer",
+			Subsystem: "gateway",
+			Name:      "api_watch_feature_flags_events_total",
+			Help:      "Total number of events sent on the watch feature flags streams",
+		}, []string{"environment_id", "source_id", "event_type"})
 	requestTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: "bucketeer",
@@ -365,6 +378,7 @@ func registerMetrics(r metrics.Registerer) {
 			evaluationsCounter,
 			getFeatureFlagsCounter,
 			getSegmentUsersCounter,
+			watchFeatureFlagsEventCounter,
 			requestTotal,
 			sdkGetEvaluationsLatencyHistogram,
 			sdkGetEvaluationsSizeHistogram,
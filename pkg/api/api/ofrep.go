@@ -0,0 +1,433 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	evaluation "github.com/bucketeer-io/bucketeer/v2/evaluation/go"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/client"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+	userproto "github.com/bucketeer-io/bucketeer/v2/proto/user"
+)
+
+// The OpenFeature Remote Evaluation Protocol (OFREP) endpoints let OpenFeature
+// providers evaluate flags without a Bucketeer SDK.
+// See https://github.com/open-feature/protocol for the specification.
+const (
+	ofrepEvaluateFlagsAPI = "/ofrep/v1/evaluate/flags"
+	ofrepAPIKeyHeader     = "X-API-Key"
+	ofrepTargetingKey     = "targetingKey"
+	ofrepBearerPrefix     = "Bearer "
+)
+
+const (
+	ofrepReasonTargetingMatch = "TARGETING_MATCH"
+	ofrepReasonSplit          = "SPLIT"
+	ofrepReasonDisabled       = "DISABLED"
+	ofrepReasonDefault        = "DEFAULT"
+	ofrepReasonUnknown        = "UNKNOWN"
+	ofrepReasonError          = "ERROR"
+
+	ofrepErrorParse               = "PARSE_ERROR"
+	ofrepErrorTargetingKeyMissing = "TARGETING_KEY_MISSING"
+	ofrepErrorInvalidContext      = "INVALID_CONTEXT"
+	ofrepErrorGeneral             = "GENERAL"
+	ofrepErrorFlagNotFound        = "FLAG_NOT_FOUND"
+)
+
+var (
+	errOFREPTargetingKeyMissing = errors.New("gateway: targeting key is required")
+	errOFREPInvalidTargetingKey = errors.New("gateway: targeting key must be a string")
+	errOFREPInvalidValue        = errors.New("gateway: variation value does not match the variation type")
+)
+
+type ofrepEvaluationRequest struct {
+	Context map[string]interface{} `json:"context"`
+}
+
+// ofrepEvaluation is both the success and the failure response of a flag evaluation.
+// Value is raw JSON so that false, 0 and "" are still encoded.
+type ofrepEvaluation struct {
+	Key          string                 `json:"key,omitempty"`
+	Reason       string                 `json:"reason,omitempty"`
+	Variant      string                 `json:"variant,omitempty"`
+	Value        json.RawMessage        `json:"value,omitempty"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	ErrorCode    string                 `json:"errorCode,omitempty"`
+	ErrorDetails string                 `json:"errorDetails,omitempty"`
+}
+
+type ofrepBulkEvaluationResponse struct {
+	Flags []*ofrepEvaluation `json:"flags"`
+}
+
+// ofrepEvaluateFlag handles POST /ofrep/v1/evaluate/flags/{key}.
+func (s *gatewayService) ofrepEvaluateFlag(w http.ResponseWriter, req *http.Request) {
+	key := strings.TrimPrefix(req.URL.Path, ofrepEvaluateFlagsAPI+"/")
+	envAPIKey, user, ok := s.checkOFREPRequest(w, req, key)
+	if !ok {
+		return
+	}
+	requestTotal.WithLabelValues(
+		envAPIKey.Environment.OrganizationId, envAPIKey.ProjectId, envAPIKey.ProjectUrlCode,
+		envAPIKey.Environment.Id, envAPIKey.Environment.UrlCode, methodOFREPEvaluateFlag,
+		eventproto.SourceId_UNKNOWN.String()).Inc()
+
+	features, ok := s.getOFREPFeatures(w, req, envAPIKey)
+	if !ok {
+		return
+	}
+	feature, err := s.findFeature(features, key)
+	if err != nil {
+		writeOFREPResponse(w, http.StatusNotFound, &ofrepEvaluation{
+			Key:          key,
+			ErrorCode:    ofrepErrorFlagNotFound,
+			ErrorDetails: errFeatureNotFound.Error(),
+		})
+		return
+	}
+	targets, err := s.getTargetFeatures(features, key)
+	if err != nil {
+		writeOFREPError(w, err)
+		return
+	}
+	evaluations, err := s.evaluateFeatures(req.Context(), user, targets, envAPIKey.Environment.Id, "")
+	if err != nil {
+		s.logger.Error(
+			"Failed to evaluate features",
+			log.FieldsFromIncomingContext(req.Context()).AddFields(
+				zap.Error(err),
+				zap.String("environmentID", envAPIKey.Environment.Id),
+				zap.String("userId", user.Id),
+				zap.String("featureId", key),
+			)...,
+		)
+		writeOFREPError(w, errInternal)
+		return
+	}
+	eval, err := s.findEvaluation(evaluations.GetEvaluations(), key)
+	if err != nil {
+		writeOFREPResponse(w, http.StatusNotFound, &ofrepEvaluation{
+			Key:          key,
+			ErrorCode:    ofrepErrorFlagNotFound,
+			ErrorDetails: err.Error(),
+		})
+		return
+	}
+	result := newOFREPEvaluation(feature, eval)
+	if result.ErrorCode != "" {
+		writeOFREPResponse(w, http.StatusBadRequest, result)
+		return
+	}
+	writeOFREPResponse(w, http.StatusOK, result)
+}
+
+// ofrepEvaluateFlags handles POST /ofrep/v1/evaluate/flags.
+// The ETag is the user evaluations ID, so it changes when either the flags
+// or the user attributes change.
+func (s *gatewayService) ofrepEvaluateFlags(w http.ResponseWriter, req *http.Request) {
+	envAPIKey, user, ok := s.checkOFREPRequest(w, req, "")
+	if !ok {
+		return
+	}
+	requestTotal.WithLabelValues(
+		envAPIKey.Environment.OrganizationId, envAPIKey.ProjectId, envAPIKey.ProjectUrlCode,
+		envAPIKey.Environment.Id, envAPIKey.Environment.UrlCode, methodOFREPEvaluateFlags,
+		eventproto.SourceId_UNKNOWN.String()).Inc()
+
+	features, ok := s.getOFREPFeatures(w, req, envAPIKey)
+	if !ok {
+		return
+	}
+	etag := strconv.Quote(evaluation.UserEvaluationsID(user.Id, user.Data, features))
+	w.Header().Set("ETag", etag)
+	if ofrepETagMatches(req.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	resp := &ofrepBulkEvaluationResponse{Flags: []*ofrepEvaluation{}}
+	if len(features) == 0 {
+		writeOFREPResponse(w, http.StatusOK, resp)
+		return
+	}
+	evaluations, err := s.evaluateFeatures(req.Context(), user, features, envAPIKey.Environment.Id, "")
+	if err != nil {
+		s.logger.Error(
+			"Failed to evaluate features",
+			log.FieldsFromIncomingContext(req.Context()).AddFields(
+				zap.Error(err),
+				zap.String("environmentID", envAPIKey.Environment.Id),
+				zap.String("userId", user.Id),
+			)...,
+		)
+		writeOFREPError(w, errInternal)
+		return
+	}
+	byID := make(map[string]*featureproto.Feature, len(features))
+	for _, f := range features {
+		byID[f.Id] = f
+	}
+	for _, eval := range evaluations.GetEvaluations() {
+		resp.Flags = append(resp.Flags, newOFREPEvaluation(byID[eval.FeatureId], eval))
+	}
+	writeOFREPResponse(w, http.StatusOK, resp)
+}
+
+// checkOFREPRequest authenticates the request and maps the evaluation context to a user.
+// It writes the error response and returns false when the request cannot be evaluated.
+func (s *gatewayService) checkOFREPRequest(
+	w http.ResponseWriter,
+	req *http.Request,
+	key string,
+) (*accountproto.EnvironmentAPIKey, *userproto.User, bool) {
+	if req.Method != http.MethodPost {
+		writeOFREPError(w, errInvalidHttpMethod)
+		return nil, nil, false
+	}
+	// OpenFeature providers send the key either as a bearer token or in X-API-Key.
+	if apiKey := ofrepAPIKey(req); apiKey != "" {
+		req.Header.Set(authorizationKey, apiKey)
+	}
+	envAPIKey, err := s.checkRequest(req.Context(), req)
+	if err != nil {
+		s.logger.Error("Failed to check OFREP request",
+			zap.Error(err),
+			zap.String("featureId", key),
+		)
+		writeOFREPError(w, err)
+		return nil, nil, false
+	}
+	var body ofrepEvaluationRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		writeOFREPResponse(w, http.StatusBadRequest, &ofrepEvaluation{
+			Key:          key,
+			ErrorCode:    ofrepErrorParse,
+			ErrorDetails: err.Error(),
+		})
+		return nil, nil, false
+	}
+	user, err := newOFREPUser(body.Context)
+	if err != nil {
+		code := ofrepErrorInvalidContext
+		if errors.Is(err, errOFREPTargetingKeyMissing) {
+			code = ofrepErrorTargetingKeyMissing
+		}
+		writeOFREPResponse(w, http.StatusBadRequest, &ofrepEvaluation{
+			Key:          key,
+			ErrorCode:    code,
+			ErrorDetails: err.Error(),
+		})
+		return nil, nil, false
+	}
+	return envAPIKey, user, true
+}
+
+func (s *gatewayService) getOFREPFeatures(
+	w http.ResponseWriter,
+	req *http.Request,
+	envAPIKey *accountproto.EnvironmentAPIKey,
+) ([]*featureproto.Feature, bool) {
+	f, err, _ := s.flightgroup.Do(
+		envAPIKey.Environment.Id,
+		func() (interface{}, error) {
+			return s.getFeatures(req.Context(), envAPIKey.Environment.Id)
+		},
+	)
+	if err != nil {
+		writeOFREPError(w, err)
+		return nil, false
+	}
+	return s.filterOutArchivedFeatures(f.([]*featureproto.Feature)), true
+}
+
+func ofrepAPIKey(req *http.Request) string {
+	if key := req.Header.Get(ofrepAPIKeyHeader); key != "" {
+		return key
+	}
+	return strings.TrimPrefix(req.Header.Get(authorizationKey), ofrepBearerPrefix)
+}
+
+// newOFREPUser maps the evaluation context to a user.
+// The targeting key is the user ID and the other attributes become the user data.
+// Non-string attributes are stored in their JSON representation.
+func newOFREPUser(evalCtx map[string]interface{}) (*userproto.User, error) {
+	key, ok := evalCtx[ofrepTargetingKey]
+	if !ok || key == nil || key == "" {
+		return nil, errOFREPTargetingKeyMissing
+	}
+	id, ok := key.(string)
+	if !ok {
+		return nil, errOFREPInvalidTargetingKey
+	}
+	data := make(map[string]string, len(evalCtx)-1)
+	for k, v := range evalCtx {
+		if k == ofrepTargetingKey {
+			continue
+		}
+		switch v := v.(type) {
+		case nil:
+			continue
+		case string:
+			data[k] = v
+		case bool:
+			data[k] = strconv.FormatBool(v)
+		case float64:
+			data[k] = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			data[k] = string(encoded)
+		}
+	}
+	return &userproto.User{Id: id, Data: data}, nil
+}
+
+func newOFREPEvaluation(feature *featureproto.Feature, eval *featureproto.Evaluation) *ofrepEvaluation {
+	reason, errorCode := ofrepReason(feature, eval.Reason)
+	if errorCode != "" {
+		return &ofrepEvaluation{
+			Key:          eval.FeatureId,
+			Reason:       reason,
+			ErrorCode:    errorCode,
+			ErrorDetails: eval.Reason.GetType().String(),
+		}
+	}
+	value, err := ofrepValue(feature.VariationType, eval.VariationValue)
+	if err != nil {
+		return &ofrepEvaluation{
+			Key:          eval.FeatureId,
+			Reason:       ofrepReasonError,
+			ErrorCode:    ofrepErrorGeneral,
+			ErrorDetails: err.Error(),
+		}
+	}
+	metadata := map[string]interface{}{
+		"variationName":  eval.VariationName,
+		"featureVersion": eval.FeatureVersion,
+	}
+	if eval.Reason.GetType() == featureproto.Reason_RULE {
+		metadata["ruleId"] = eval.Reason.RuleId
+		if eval.Reason.RuleName != "" {
+			metadata["ruleName"] = eval.Reason.RuleName
+		}
+	}
+	return &ofrepEvaluation{
+		Key:      eval.FeatureId,
+		Reason:   reason,
+		Variant:  eval.VariationId,
+		Value:    value,
+		Metadata: metadata,
+	}
+}
+
+// ofrepReason maps the evaluation reason to an OFREP reason and, for failed
+// evaluations, an OFREP error code.
+func ofrepReason(feature *featureproto.Feature, reason *featureproto.Reason) (string, string) {
+	switch reason.GetType() {
+	case featureproto.Reason_TARGET, featureproto.Reason_RULE, featureproto.Reason_PREREQUISITE:
+		return ofrepReasonTargetingMatch, ""
+	case featureproto.Reason_DEFAULT:
+		if feature.GetDefaultStrategy().GetType() == featureproto.Strategy_ROLLOUT {
+			return ofrepReasonSplit, ""
+		}
+		return ofrepReasonDefault, ""
+	case featureproto.Reason_EXPERIMENT_HOLDOUT, featureproto.Reason_EXPERIMENT_EXCLUDED:
+		// The user was kept out of an experiment by hashing, like a rollout.
+		return ofrepReasonSplit, ""
+	case featureproto.Reason_OFF_VARIATION:
+		return ofrepReasonDisabled, ""
+	case featureproto.Reason_ERROR_FLAG_NOT_FOUND:
+		return ofrepReasonError, ofrepErrorFlagNotFound
+	case featureproto.Reason_ERROR_USER_ID_NOT_SPECIFIED:
+		return ofrepReasonError, ofrepErrorTargetingKeyMissing
+	case featureproto.Reason_ERROR_NO_EVALUATIONS,
+		featureproto.Reason_ERROR_WRONG_TYPE,
+		featureproto.Reason_ERROR_FEATURE_FLAG_ID_NOT_SPECIFIED,
+		featureproto.Reason_ERROR_EXCEPTION,
+		featureproto.Reason_ERROR_CACHE_NOT_FOUND:
+		return ofrepReasonError, ofrepErrorGeneral
+	default:
+		return ofrepReasonUnknown, ""
+	}
+}
+
+// ofrepValue converts the variation value to the JSON type of the feature's variation type.
+func ofrepValue(variationType featureproto.Feature_VariationType, value string) (json.RawMessage, error) {
+	switch variationType {
+	case featureproto.Feature_BOOLEAN:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errOFREPInvalidValue
+		}
+		return json.Marshal(b)
+	case featureproto.Feature_NUMBER:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, errOFREPInvalidValue
+		}
+		return json.Marshal(f)
+	case featureproto.Feature_JSON:
+		if !json.Valid([]byte(value)) {
+			return nil, errOFREPInvalidValue
+		}
+		return json.RawMessage(value), nil
+	default:
+		return json.Marshal(value)
+	}
+}
+
+// ofrepETagMatches reports whether the If-None-Match header contains the ETag.
+func ofrepETagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// writeOFREPError writes a gateway error as an OFREP error response, which
+// only carries the error details.
+func writeOFREPError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	var st interface{ GetStatusCode() int }
+	if errors.As(err, &st) {
+		code = st.GetStatusCode()
+	}
+	writeOFREPResponse(w, code, &ofrepEvaluation{ErrorDetails: err.Error()})
+}
+
+func writeOFREPResponse(w http.ResponseWriter, code int, resp interface{}) {
+	encoded, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(encoded)
+}
@@ -0,0 +1,517 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	evaluation "github.com/bucketeer-io/bucketeer/v2/evaluation/go"
+	cachev3mock "github.com/bucketeer-io/bucketeer/v2/pkg/cache/v3/mock"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	environmentproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+	userproto "github.com/bucketeer-io/bucketeer/v2/proto/user"
+)
+
+func TestNewOFREPUser(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc        string
+		input       map[string]interface{}
+		expected    *userproto.User
+		expectedErr error
+	}{
+		{
+			desc:        "err: missing targeting key",
+			input:       map[string]interface{}{"country": "jp"},
+			expectedErr: errOFREPTargetingKeyMissing,
+		},
+		{
+			desc:        "err: empty targeting key",
+			input:       map[string]interface{}{"targetingKey": ""},
+			expectedErr: errOFREPTargetingKeyMissing,
+		},
+		{
+			desc:        "err: targeting key is not a string",
+			input:       map[string]interface{}{"targetingKey": 1.0},
+			expectedErr: errOFREPInvalidTargetingKey,
+		},
+		{
+			desc: "success",
+			input: map[string]interface{}{
+				"targetingKey": "user-1",
+				"country":      "jp",
+				"beta":         true,
+				"age":          20.0,
+				"score":        1.5,
+				"groups":       []interface{}{"a", "b"},
+				"empty":        nil,
+			},
+			expected: &userproto.User{
+				Id: "user-1",
+				Data: map[string]string{
+					"country": "jp",
+					"beta":    "true",
+					"age":     "20",
+					"score":   "1.5",
+					"groups":  `["a","b"]`,
+				},
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			actual, err := newOFREPUser(p.input)
+			assert.Equal(t, p.expectedErr, err)
+			assert.Equal(t, p.expected, actual)
+		})
+	}
+}
+
+func TestOFREPValue(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc          string
+		variationType featureproto.Feature_VariationType
+		value         string
+		expected      string
+		expectedErr   error
+	}{
+		{
+			desc:          "boolean",
+			variationType: featureproto.Feature_BOOLEAN,
+			value:         "false",
+			expected:      "false",
+		},
+		{
+			desc:          "err: invalid boolean",
+			variationType: featureproto.Feature_BOOLEAN,
+			value:         "yes",
+			expectedErr:   errOFREPInvalidValue,
+		},
+		{
+			desc:          "number",
+			variationType: featureproto.Feature_NUMBER,
+			value:         "1.25",
+			expected:      "1.25",
+		},
+		{
+			desc:          "err: invalid number",
+			variationType: featureproto.Feature_NUMBER,
+			value:         "one",
+			expectedErr:   errOFREPInvalidValue,
+		},
+		{
+			desc:          "json",
+			variationType: featureproto.Feature_JSON,
+			value:         `{"color":"red"}`,
+			expected:      `{"color":"red"}`,
+		},
+		{
+			desc:          "err: invalid json",
+			variationType: featureproto.Feature_JSON,
+			value:         `{"color":`,
+			expectedErr:   errOFREPInvalidValue,
+		},
+		{
+			desc:          "string",
+			variationType: featureproto.Feature_STRING,
+			value:         "blue",
+			expected:      `"blue"`,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			actual, err := ofrepValue(p.variationType, p.value)
+			assert.Equal(t, p.expectedErr, err)
+			if p.expectedErr == nil {
+				assert.Equal(t, p.expected, string(actual))
+			}
+		})
+	}
+}
+
+func TestOFREPReason(t *testing.T) {
+	t.Parallel()
+	fixed := &featureproto.Feature{
+		DefaultStrategy: &featureproto.Strategy{Type: featureproto.Strategy_FIXED},
+	}
+	rollout := &featureproto.Feature{
+		DefaultStrategy: &featureproto.Strategy{Type: featureproto.Strategy_ROLLOUT},
+	}
+	patterns := []struct {
+		desc              string
+		feature           *featureproto.Feature
+		reason            featureproto.Reason_Type
+		expectedReason    string
+		expectedErrorCode string
+	}{
+		{
+			desc:           "target",
+			feature:        fixed,
+			reason:         featureproto.Reason_TARGET,
+			expectedReason: ofrepReasonTargetingMatch,
+		},
+		{
+			desc:           "rule",
+			feature:        fixed,
+			reason:         featureproto.Reason_RULE,
+			expectedReason: ofrepReasonTargetingMatch,
+		},
+		{
+			desc:           "prerequisite",
+			feature:        fixed,
+			reason:         featureproto.Reason_PREREQUISITE,
+			expectedReason: ofrepReasonTargetingMatch,
+		},
+		{
+			desc:           "default with fixed strategy",
+			feature:        fixed,
+			reason:         featureproto.Reason_DEFAULT,
+			expectedReason: ofrepReasonDefault,
+		},
+		{
+			desc:           "default with rollout strategy",
+			feature:        rollout,
+			reason:         featureproto.Reason_DEFAULT,
+			expectedReason: ofrepReasonSplit,
+		},
+		{
+			desc:           "experiment holdout",
+			feature:        fixed,
+			reason:         featureproto.Reason_EXPERIMENT_HOLDOUT,
+			expectedReason: ofrepReasonSplit,
+		},
+		{
+			desc:           "experiment excluded",
+			feature:        fixed,
+			reason:         featureproto.Reason_EXPERIMENT_EXCLUDED,
+			expectedReason: ofrepReasonSplit,
+		},
+		{
+			desc:           "off variation",
+			feature:        fixed,
+			reason:         featureproto.Reason_OFF_VARIATION,
+			expectedReason: ofrepReasonDisabled,
+		},
+		{
+			desc:              "flag not found",
+			feature:           fixed,
+			reason:            featureproto.Reason_ERROR_FLAG_NOT_FOUND,
+			expectedReason:    ofrepReasonError,
+			expectedErrorCode: ofrepErrorFlagNotFound,
+		},
+		{
+			desc:              "user id not specified",
+			feature:           fixed,
+			reason:            featureproto.Reason_ERROR_USER_ID_NOT_SPECIFIED,
+			expectedReason:    ofrepReasonError,
+			expectedErrorCode: ofrepErrorTargetingKeyMissing,
+		},
+		{
+			desc:              "exception",
+			feature:           fixed,
+			reason:            featureproto.Reason_ERROR_EXCEPTION,
+			expectedReason:    ofrepReasonError,
+			expectedErrorCode: ofrepErrorGeneral,
+		},
+		{
+			desc:           "client",
+			feature:        fixed,
+			reason:         featureproto.Reason_CLIENT,
+			expectedReason: ofrepReasonUnknown,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			reason, errorCode := ofrepReason(p.feature, &featureproto.Reason{Type: p.reason})
+			assert.Equal(t, p.expectedReason, reason)
+			assert.Equal(t, p.expectedErrorCode, errorCode)
+		})
+	}
+}
+
+func TestNewOFREPEvaluationRuleMetadata(t *testing.T) {
+	t.Parallel()
+	f := &featureproto.Feature{
+		VariationType:   featureproto.Feature_STRING,
+		DefaultStrategy: &featureproto.Strategy{Type: featureproto.Strategy_FIXED},
+	}
+	eval := &featureproto.Evaluation{
+		FeatureId:      "feature-id",
+		FeatureVersion: 2,
+		VariationId:    "variation-id",
+		VariationName:  "variation-name",
+		VariationValue: "value",
+		Reason: &featureproto.Reason{
+			Type:     featureproto.Reason_RULE,
+			RuleId:   "rule-id",
+			RuleName: "JP pro users",
+		},
+	}
+	actual := newOFREPEvaluation(f, eval)
+	assert.Equal(t, ofrepReasonTargetingMatch, actual.Reason)
+	assert.Equal(t, "rule-id", actual.Metadata["ruleId"])
+	assert.Equal(t, "JP pro users", actual.Metadata["ruleName"])
+
+	eval.Reason = &featureproto.Reason{Type: featureproto.Reason_DEFAULT}
+	actual = newOFREPEvaluation(f, eval)
+	assert.NotContains(t, actual.Metadata, "ruleId")
+	assert.NotContains(t, actual.Metadata, "ruleName")
+}
+
+func TestOFREPETagMatches(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc        string
+		ifNoneMatch string
+		expected    bool
+	}{
+		{desc: "empty", ifNoneMatch: "", expected: false},
+		{desc: "different", ifNoneMatch: `"other"`, expected: false},
+		{desc: "same", ifNoneMatch: `"etag"`, expected: true},
+		{desc: "weak", ifNoneMatch: `W/"etag"`, expected: true},
+		{desc: "list", ifNoneMatch: `"other", "etag"`, expected: true},
+		{desc: "wildcard", ifNoneMatch: "*", expected: true},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			assert.Equal(t, p.expected, ofrepETagMatches(p.ifNoneMatch, `"etag"`))
+		})
+	}
+}
+
+func TestOFREPEvaluateFlag(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc         string
+		setup        func(*gatewayService)
+		method       string
+		path         string
+		apiKey       string
+		body         string
+		expectedCode int
+		expected     *ofrepEvaluation
+	}{
+		{
+			desc:         "err: invalid http method",
+			setup:        func(gs *gatewayService) {},
+			method:       http.MethodGet,
+			path:         ofrepEvaluateFlagsAPI + "/feature-id-1",
+			apiKey:       "Bearer test-key",
+			expectedCode: http.StatusMethodNotAllowed,
+			expected:     &ofrepEvaluation{ErrorDetails: errInvalidHttpMethod.Error()},
+		},
+		{
+			desc:         "err: missing api key",
+			setup:        func(gs *gatewayService) {},
+			method:       http.MethodPost,
+			path:         ofrepEvaluateFlagsAPI + "/feature-id-1",
+			body:         `{"context":{"targetingKey":"user-1"}}`,
+			expectedCode: http.StatusUnauthorized,
+			expected:     &ofrepEvaluation{ErrorDetails: errMissingAPIKey.Error()},
+		},
+		{
+			desc: "err: targeting key missing",
+			setup: func(gs *gatewayService) {
+				expectOFREPAPIKey(gs)
+			},
+			method:       http.MethodPost,
+			path:         ofrepEvaluateFlagsAPI + "/feature-id-1",
+			apiKey:       "Bearer test-key",
+			body:         `{"context":{"country":"jp"}}`,
+			expectedCode: http.StatusBadRequest,
+			expected: &ofrepEvaluation{
+				Key:          "feature-id-1",
+				ErrorCode:    ofrepErrorTargetingKeyMissing,
+				ErrorDetails: errOFREPTargetingKeyMissing.Error(),
+			},
+		},
+		{
+			desc: "err: flag not found",
+			setup: func(gs *gatewayService) {
+				expectOFREPAPIKey(gs)
+				expectOFREPFeatures(gs)
+			},
+			method:       http.MethodPost,
+			path:         ofrepEvaluateFlagsAPI + "/feature-id-3",
+			apiKey:       "Bearer test-key",
+			body:         `{"context":{"targetingKey":"user-1"}}`,
+			expectedCode: http.StatusNotFound,
+			expected: &ofrepEvaluation{
+				Key:          "feature-id-3",
+				ErrorCode:    ofrepErrorFlagNotFound,
+				ErrorDetails: errFeatureNotFound.Error(),
+			},
+		},
+		{
+			desc: "success",
+			setup: func(gs *gatewayService) {
+				expectOFREPAPIKey(gs)
+				expectOFREPFeatures(gs)
+			},
+			method:       http.MethodPost,
+			path:         ofrepEvaluateFlagsAPI + "/feature-id-1",
+			apiKey:       "test-key",
+			body:         `{"context":{"targetingKey":"user-1"}}`,
+			expectedCode: http.StatusOK,
+			expected: &ofrepEvaluation{
+				Key:     "feature-id-1",
+				Reason:  ofrepReasonDefault,
+				Variant: "variation-b",
+				Value:   json.RawMessage("false"),
+				Metadata: map[string]interface{}{
+					"variationName":  "off",
+					"featureVersion": 1.0,
+				},
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			gs := newGatewayServiceWithMock(t, mockController)
+			p.setup(gs)
+			req := httptest.NewRequest(p.method, dummyURL+p.path, bytes.NewBufferString(p.body))
+			if p.apiKey != "" {
+				req.Header.Add(authorizationKey, p.apiKey)
+			}
+			actual := httptest.NewRecorder()
+			gs.ofrepEvaluateFlag(actual, req)
+			assert.Equal(t, p.expectedCode, actual.Code)
+			var resp ofrepEvaluation
+			require.NoError(t, json.Unmarshal(actual.Body.Bytes(), &resp))
+			assert.Equal(t, p.expected, &resp)
+		})
+	}
+}
+
+func TestOFREPEvaluateFlags(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	user := &userproto.User{Id: "user-1", Data: map[string]string{"country": "jp"}}
+	etag := strconv.Quote(evaluation.UserEvaluationsID(user.Id, user.Data, newOFREPFeatures()))
+	patterns := []struct {
+		desc         string
+		ifNoneMatch  string
+		expectedCode int
+		expected     []string
+	}{
+		{
+			desc:         "success",
+			expectedCode: http.StatusOK,
+			expected:     []string{"feature-id-1", "feature-id-2"},
+		},
+		{
+			desc:         "not modified",
+			ifNoneMatch:  etag,
+			expectedCode: http.StatusNotModified,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			gs := newGatewayServiceWithMock(t, mockController)
+			expectOFREPAPIKey(gs)
+			expectOFREPFeatures(gs)
+			req := httptest.NewRequest(
+				http.MethodPost,
+				dummyURL+ofrepEvaluateFlagsAPI,
+				bytes.NewBufferString(`{"context":{"targetingKey":"user-1","country":"jp"}}`),
+			)
+			req.Header.Add(ofrepAPIKeyHeader, "test-key")
+			if p.ifNoneMatch != "" {
+				req.Header.Add("If-None-Match", p.ifNoneMatch)
+			}
+			actual := httptest.NewRecorder()
+			gs.ofrepEvaluateFlags(actual, req)
+			assert.Equal(t, p.expectedCode, actual.Code)
+			assert.Equal(t, etag, actual.Header().Get("ETag"))
+			if p.expected == nil {
+				assert.Empty(t, actual.Body.String())
+				return
+			}
+			var resp ofrepBulkEvaluationResponse
+			require.NoError(t, json.Unmarshal(actual.Body.Bytes(), &resp))
+			keys := make([]string, 0, len(resp.Flags))
+			for _, f := range resp.Flags {
+				keys = append(keys, f.Key)
+			}
+			assert.ElementsMatch(t, p.expected, keys)
+		})
+	}
+}
+
+func expectOFREPAPIKey(gs *gatewayService) {
+	gs.environmentAPIKeyCache.(*cachev3mock.MockEnvironmentAPIKeyCache).EXPECT().Get(gomock.Any()).Return(
+		&accountproto.EnvironmentAPIKey{
+			Environment: &environmentproto.EnvironmentV2{Id: "ns0"},
+			ApiKey: &accountproto.APIKey{
+				Id:       "id-0",
+				Role:     accountproto.APIKey_SDK_CLIENT,
+				Disabled: false,
+			},
+		}, nil)
+}
+
+func expectOFREPFeatures(gs *gatewayService) {
+	gs.featuresCache.(*cachev3mock.MockFeaturesCache).EXPECT().Get(gomock.Any()).Return(
+		&featureproto.Features{Features: newOFREPFeatures()}, nil)
+}
+
+func newOFREPFeatures() []*featureproto.Feature {
+	return []*featureproto.Feature{
+		{
+			Id:            "feature-id-1",
+			Version:       1,
+			VariationType: featureproto.Feature_BOOLEAN,
+			Variations: []*featureproto.Variation{
+				{Id: "variation-a", Name: "on", Value: "true"},
+				{Id: "variation-b", Name: "off", Value: "false"},
+			},
+			DefaultStrategy: &featureproto.Strategy{
+				Type:          featureproto.Strategy_FIXED,
+				FixedStrategy: &featureproto.FixedStrategy{Variation: "variation-b"},
+			},
+			Tags: []string{"test"},
+		},
+		{
+			Id:            "feature-id-2",
+			Version:       1,
+			VariationType: featureproto.Feature_STRING,
+			Variations: []*featureproto.Variation{
+				{Id: "variation-c", Name: "blue", Value: "blue"},
+				{Id: "variation-d", Name: "red", Value: "red"},
+			},
+			DefaultStrategy: &featureproto.Strategy{
+				Type:          featureproto.Strategy_FIXED,
+				FixedStrategy: &featureproto.FixedStrategy{Variation: "variation-c"},
+			},
+			Tags: []string{"test"},
+		},
+	}
+}
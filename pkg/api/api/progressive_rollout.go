@@ -62,6 +62,7 @@ func (s *grpcGatewayService) CreateProgressiveRollout(
 			FeatureId:                                req.FeatureId,
 			ProgressiveRolloutManualScheduleClause:   req.ProgressiveRolloutManualScheduleClause,
 			ProgressiveRolloutTemplateScheduleClause: req.ProgressiveRolloutTemplateScheduleClause,
+			GuardrailGoals:                           req.GuardrailGoals,
 		},
 	)
 	if err != nil {
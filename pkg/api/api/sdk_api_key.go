@@ -0,0 +1,88 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	accountdomain "github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	accstorage "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	gwproto "github.com/bucketeer-io/bucketeer/v2/proto/gateway"
+)
+
+// ListSDKAPIKeys returns the enabled SDK keys of the caller's environment so a
+// relay proxy can authenticate its own SDK clients without reaching the backend.
+// Only the hash of each key is returned, the relay never holds the keys of its clients.
+func (s *grpcGatewayService) ListSDKAPIKeys(
+	ctx context.Context,
+	req *gwproto.ListSDKAPIKeysRequest,
+) (*gwproto.ListSDKAPIKeysResponse, error) {
+	envAPIKey, err := s.checkRequest(ctx, []accountproto.APIKey_Role{accountproto.APIKey_SDK_SERVER})
+	if err != nil {
+		if !isCallerContextErr(err) && !errors.Is(err, ErrInvalidAPIKey) && !errors.Is(err, ErrMissingAPIKey) {
+			s.logger.Error("Failed to check ListSDKAPIKeys request",
+				log.FieldsFromIncomingContext(ctx).AddFields(
+					zap.Error(err),
+				)...,
+			)
+		}
+		return nil, err
+	}
+	requestTotal.WithLabelValues(
+		envAPIKey.Environment.OrganizationId, envAPIKey.ProjectId, envAPIKey.ProjectUrlCode,
+		envAPIKey.Environment.Id, envAPIKey.Environment.UrlCode, methodListSDKAPIKeys, "").Inc()
+
+	disabled := false
+	apiKeys, _, _, err := s.accountStorage.ListAPIKeys(ctx, accstorage.ListAPIKeysParams{
+		EnvironmentIDs: []string{envAPIKey.Environment.Id},
+		Disabled:       &disabled,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list api keys",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentId", envAPIKey.Environment.Id),
+			)...,
+		)
+		return nil, ErrInternal
+	}
+	sdkAPIKeys := make([]*gwproto.ListSDKAPIKeysResponse_SDKAPIKey, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		if apiKey.Role != accountproto.APIKey_SDK_CLIENT && apiKey.Role != accountproto.APIKey_SDK_SERVER {
+			continue
+		}
+		withoutKey := proto.Clone(apiKey).(*accountproto.APIKey)
+		withoutKey.ApiKey = ""
+		sdkAPIKeys = append(sdkAPIKeys, &gwproto.ListSDKAPIKeysResponse_SDKAPIKey{
+			ApiKeySha256: accountdomain.HashAPIKey(apiKey.ApiKey),
+			EnvironmentApiKey: &accountproto.EnvironmentAPIKey{
+				ApiKey:              withoutKey,
+				EnvironmentDisabled: envAPIKey.EnvironmentDisabled,
+				ProjectId:           envAPIKey.ProjectId,
+				Environment:         envAPIKey.Environment,
+				ProjectUrlCode:      envAPIKey.ProjectUrlCode,
+			},
+		})
+	}
+	return &gwproto.ListSDKAPIKeysResponse{
+		SdkApiKeys: sdkAPIKeys,
+	}, nil
+}
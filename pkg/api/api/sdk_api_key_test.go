@@ -0,0 +1,136 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	accountdomain "github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	accstorage "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2"
+	accountstoragemock "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2/mock"
+	cachev3mock "github.com/bucketeer-io/bucketeer/v2/pkg/cache/v3/mock"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	environmentproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
+	gwproto "github.com/bucketeer-io/bucketeer/v2/proto/gateway"
+)
+
+func TestGrpcListSDKAPIKeys(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	env := &environmentproto.EnvironmentV2{Id: "ns0", OrganizationId: "org-0"}
+	callerKey := &accountproto.EnvironmentAPIKey{
+		Environment:    env,
+		ProjectId:      "project-0",
+		ProjectUrlCode: "project-code",
+		ApiKey: &accountproto.APIKey{
+			Id:     "id-0",
+			ApiKey: "server-key",
+			Role:   accountproto.APIKey_SDK_SERVER,
+		},
+	}
+	clientKey := &accountproto.APIKey{Id: "id-1", ApiKey: "client-key", Role: accountproto.APIKey_SDK_CLIENT}
+	serverKey := &accountproto.APIKey{Id: "id-0", ApiKey: "server-key", Role: accountproto.APIKey_SDK_SERVER}
+	adminKey := &accountproto.APIKey{Id: "id-2", ApiKey: "admin-key", Role: accountproto.APIKey_PUBLIC_API_ADMIN}
+
+	patterns := []struct {
+		desc        string
+		setup       func(*grpcGatewayService)
+		expected    *gwproto.ListSDKAPIKeysResponse
+		expectedErr error
+	}{
+		{
+			desc: "err: bad role",
+			setup: func(gs *grpcGatewayService) {
+				gs.environmentAPIKeyCache.(*cachev3mock.MockEnvironmentAPIKeyCache).EXPECT().Get(gomock.Any()).Return(
+					&accountproto.EnvironmentAPIKey{
+						Environment: env,
+						ApiKey:      clientKey,
+					}, nil)
+			},
+			expected:    nil,
+			expectedErr: ErrBadRole,
+		},
+		{
+			desc: "err: internal error while listing api keys",
+			setup: func(gs *grpcGatewayService) {
+				gs.environmentAPIKeyCache.(*cachev3mock.MockEnvironmentAPIKeyCache).EXPECT().Get(gomock.Any()).Return(
+					callerKey, nil)
+				gs.accountStorage.(*accountstoragemock.MockAccountStorage).EXPECT().ListAPIKeys(
+					gomock.Any(), gomock.Any(),
+				).Return(nil, 0, int64(0), errors.New("internal error"))
+			},
+			expected:    nil,
+			expectedErr: ErrInternal,
+		},
+		{
+			desc: "success: only sdk keys are returned",
+			setup: func(gs *grpcGatewayService) {
+				gs.environmentAPIKeyCache.(*cachev3mock.MockEnvironmentAPIKeyCache).EXPECT().Get(gomock.Any()).Return(
+					callerKey, nil)
+				disabled := false
+				gs.accountStorage.(*accountstoragemock.MockAccountStorage).EXPECT().ListAPIKeys(
+					gomock.Any(), accstorage.ListAPIKeysParams{
+						EnvironmentIDs: []string{"ns0"},
+						Disabled:       &disabled,
+					},
+				).Return([]*accountproto.APIKey{clientKey, serverKey, adminKey}, 3, int64(3), nil)
+			},
+			expected: &gwproto.ListSDKAPIKeysResponse{
+				SdkApiKeys: []*gwproto.ListSDKAPIKeysResponse_SDKAPIKey{
+					{
+						ApiKeySha256: accountdomain.HashAPIKey("client-key"),
+						EnvironmentApiKey: &accountproto.EnvironmentAPIKey{
+							ApiKey:         &accountproto.APIKey{Id: "id-1", Role: accountproto.APIKey_SDK_CLIENT},
+							ProjectId:      "project-0",
+							Environment:    env,
+							ProjectUrlCode: "project-code",
+						},
+					},
+					{
+						ApiKeySha256: accountdomain.HashAPIKey("server-key"),
+						EnvironmentApiKey: &accountproto.EnvironmentAPIKey{
+							ApiKey:         &accountproto.APIKey{Id: "id-0", Role: accountproto.APIKey_SDK_SERVER},
+							ProjectId:      "project-0",
+							Environment:    env,
+							ProjectUrlCode: "project-code",
+						},
+					},
+				},
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			gs := newGrpcGatewayServiceWithMock(t, mockController)
+			p.setup(gs)
+			ctx := metadata.NewIncomingContext(context.TODO(), metadata.MD{
+				"authorization": []string{"server-key"},
+			})
+			actual, err := gs.ListSDKAPIKeys(ctx, &gwproto.ListSDKAPIKeysRequest{})
+			assert.Equal(t, p.expectedErr, err)
+			assert.True(t, proto.Equal(p.expected, actual), "expected %v, got %v", p.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/client"
+	gwproto "github.com/bucketeer-io/bucketeer/v2/proto/gateway"
+)
+
+const watchCursorVersion = 1
+
+// watchCursor is the resume position of a WatchFeatureFlags stream.
+// It holds the state a polling server SDK keeps between the GetFeatureFlags
+// and GetSegmentUsers calls, and it is sent to the client as an opaque token.
+type watchCursor struct {
+	Version                 int      `json:"v"`
+	Tag                     string   `json:"t"`
+	FeatureFlagsID          string   `json:"f"`
+	FeatureFlagsRequestedAt int64    `json:"fa"`
+	SegmentUsersRequestedAt int64    `json:"sa"`
+	SegmentIDs              []string `json:"s,omitempty"`
+}
+
+func (c *watchCursor) encode() (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeWatchCursor returns nil when the token is empty, malformed, from another
+// version, or issued for another tag, so the stream starts with a snapshot.
+func decodeWatchCursor(token, tag string) *watchCursor {
+	if token == "" {
+		return nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil
+	}
+	c := &watchCursor{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil
+	}
+	if c.Version != watchCursorVersion || c.Tag != tag {
+		return nil
+	}
+	return c
+}
+
+// WatchFeatureFlags streams the feature flags and segment users of the
+// environment to server SDKs. It first sends a snapshot, or the diff since the
+// request cursor, and then a patch each time the dispatcher reports a change.
+//
+// Each sync calls GetFeatureFlags and GetSegmentUsers with the cursor of the
+// previous event, so the diffs match the polling API and a disabled API key
+// ends the stream. While a send is blocked by flow control, the dispatcher
+// coalesces the notifications into one, which is enough because a sync always
+// catches up from the cursor. The heartbeat tick also syncs to pick up changes
+// that are not dispatched, such as created or archived flags.
+func (s *grpcGatewayService) WatchFeatureFlags(
+	req *gwproto.WatchFeatureFlagsRequest,
+	srv gwproto.Gateway_WatchFeatureFlagsServer,
+) error {
+	dispatcher := s.opts.streamDispatcher
+	if dispatcher == nil {
+		return ErrWatchNotAvailable
+	}
+	ctx := srv.Context()
+	envAPIKey, err := s.checkRequest(ctx, []accountproto.APIKey_Role{accountproto.APIKey_SDK_SERVER})
+	if err != nil {
+		if !isCallerContextErr(err) && !errors.Is(err, ErrInvalidAPIKey) && !errors.Is(err, ErrMissingAPIKey) {
+			s.logger.Error("Failed to check WatchFeatureFlags request",
+				log.FieldsFromIncomingContext(ctx).AddFields(
+					zap.Error(err),
+					zap.String("tag", req.Tag),
+					zap.Any("sourceId", req.SourceId),
+					zap.String("sdkVersion", req.SdkVersion),
+				)...,
+			)
+		}
+		return err
+	}
+	environmentId := envAPIKey.Environment.Id
+	sourceID := req.SourceId.String()
+	requestTotal.WithLabelValues(
+		envAPIKey.Environment.OrganizationId, envAPIKey.ProjectId, envAPIKey.ProjectUrlCode,
+		environmentId, envAPIKey.Environment.UrlCode, methodWatchFeatureFlags, sourceID).Inc()
+
+	if err := s.validateWatchFeatureFlagsRequest(req); err != nil {
+		s.logger.Error("Failed to validate WatchFeatureFlags request",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentId", environmentId),
+				zap.String("apiKey", obfuscateString(envAPIKey.ApiKey.Id, obfuscateAPIKeyLength)),
+				zap.Any("sourceId", req.SourceId),
+				zap.String("sdkVersion", req.SdkVersion),
+			)...,
+		)
+		return err
+	}
+	events, deregister, err := dispatcher.Register(environmentId, req.Tag, sourceID)
+	if err != nil {
+		return ErrTooManyWatchStreams
+	}
+	defer deregister()
+
+	eventType := gwproto.WatchFeatureFlagsEvent_PATCH
+	cursor := decodeWatchCursor(req.Cursor, req.Tag)
+	if cursor == nil {
+		eventType = gwproto.WatchFeatureFlagsEvent_SNAPSHOT
+		cursor = &watchCursor{Version: watchCursorVersion, Tag: req.Tag}
+	}
+	// The first event is always sent so the client gets a cursor to resume from.
+	evt, cursor, _, err := s.syncWatch(ctx, req, cursor)
+	if err != nil {
+		return err
+	}
+	evt.Type = eventType
+	if err := s.sendWatchEvent(srv, environmentId, sourceID, evt); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(s.opts.watchHeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		heartbeat := false
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-dispatcher.Done():
+			return ErrShuttingDown
+		case <-ticker.C:
+			heartbeat = true
+		case <-events:
+		}
+		evt, next, changed, err := s.syncWatch(ctx, req, cursor)
+		if err != nil {
+			return err
+		}
+		cursor = next
+		if !changed {
+			if !heartbeat {
+				continue
+			}
+			evt = &gwproto.WatchFeatureFlagsEvent{
+				Type:   gwproto.WatchFeatureFlagsEvent_HEARTBEAT,
+				Cursor: evt.Cursor,
+			}
+		}
+		if err := s.sendWatchEvent(srv, environmentId, sourceID, evt); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *grpcGatewayService) validateWatchFeatureFlagsRequest(req *gwproto.WatchFeatureFlagsRequest) error {
+	if req.SourceId == eventproto.SourceId_UNKNOWN {
+		return ErrSourceIDRequired
+	}
+	if req.SdkVersion == "" {
+		return ErrSDKVersionRequired
+	}
+	return nil
+}
+
+// syncWatch computes the diff since the cursor and returns it as a PATCH event
+// with the next cursor. changed reports whether the client has anything to apply.
+func (s *grpcGatewayService) syncWatch(
+	ctx context.Context,
+	req *gwproto.WatchFeatureFlagsRequest,
+	cursor *watchCursor,
+) (evt *gwproto.WatchFeatureFlagsEvent, next *watchCursor, changed bool, err error) {
+	ff, err := s.GetFeatureFlags(ctx, &gwproto.GetFeatureFlagsRequest{
+		Tag:            req.Tag,
+		FeatureFlagsId: cursor.FeatureFlagsID,
+		RequestedAt:    cursor.FeatureFlagsRequestedAt,
+		SourceId:       req.SourceId,
+		SdkVersion:     req.SdkVersion,
+	})
+	if err != nil {
+		return nil, nil, false, err
+	}
+	su, err := s.GetSegmentUsers(ctx, &gwproto.GetSegmentUsersRequest{
+		SegmentIds:  cursor.SegmentIDs,
+		RequestedAt: cursor.SegmentUsersRequestedAt,
+		SourceId:    req.SourceId,
+		SdkVersion:  req.SdkVersion,
+	})
+	if err != nil {
+		return nil, nil, false, err
+	}
+	// GetFeatureFlags returns an empty ID without forcing the update when no flag
+	// has the tag anymore, so force it to make the client drop its flags.
+	if ff.FeatureFlagsId == "" && cursor.FeatureFlagsID != "" {
+		ff.ForceUpdate = true
+	}
+	next = &watchCursor{
+		Version:                 watchCursorVersion,
+		Tag:                     req.Tag,
+		FeatureFlagsID:          ff.FeatureFlagsId,
+		FeatureFlagsRequestedAt: ff.RequestedAt,
+		SegmentUsersRequestedAt: su.RequestedAt,
+		SegmentIDs:              nextSegmentIDs(cursor.SegmentIDs, su),
+	}
+	token, err := next.encode()
+	if err != nil {
+		s.logger.Error("Failed to encode the watch cursor",
+			log.FieldsFromIncomingContext(ctx).AddFields(zap.Error(err))...,
+		)
+		return nil, nil, false, ErrInternal
+	}
+	changed = ff.ForceUpdate ||
+		len(ff.Features) > 0 ||
+		len(ff.ArchivedFeatureFlagIds) > 0 ||
+		len(su.SegmentUsers) > 0 ||
+		len(su.DeletedSegmentIds) > 0 ||
+		// GetSegmentUsers forces an empty update whenever no flag uses a segment,
+		// which only matters when the client still has segment users.
+		(su.ForceUpdate && len(cursor.SegmentIDs) > 0)
+	evt = &gwproto.WatchFeatureFlagsEvent{
+		Type:         gwproto.WatchFeatureFlagsEvent_PATCH,
+		Cursor:       token,
+		FeatureFlags: ff,
+		SegmentUsers: su,
+	}
+	return evt, next, changed, nil
+}
+
+// nextSegmentIDs returns the segment IDs the client holds after applying resp.
+func nextSegmentIDs(prev []string, resp *gwproto.GetSegmentUsersResponse) []string {
+	ids := make(map[string]struct{}, len(prev)+len(resp.SegmentUsers))
+	if !resp.ForceUpdate {
+		for _, id := range prev {
+			ids[id] = struct{}{}
+		}
+		for _, id := range resp.DeletedSegmentIds {
+			delete(ids, id)
+		}
+	}
+	for _, su := range resp.SegmentUsers {
+		ids[su.SegmentId] = struct{}{}
+	}
+	result := make([]string, 0, len(ids))
+	for id := range ids {
+		result = append(result, id)
+	}
+	slices.Sort(result)
+	return result
+}
+
+func (s *grpcGatewayService) sendWatchEvent(
+	srv gwproto.Gateway_WatchFeatureFlagsServer,
+	environmentId, sourceID string,
+	evt *gwproto.WatchFeatureFlagsEvent,
+) error {
+	if err := srv.Send(evt); err != nil {
+		return err
+	}
+	watchFeatureFlagsEventCounter.WithLabelValues(environmentId, sourceID, evt.Type.String()).Inc()
+	return nil
+}
@@ -0,0 +1,392 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/api/stream"
+	cachev3mock "github.com/bucketeer-io/bucketeer/v2/pkg/cache/v3/mock"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	environmentproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
+	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/client"
+	domaineventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+	gwproto "github.com/bucketeer-io/bucketeer/v2/proto/gateway"
+)
+
+type fakeWatchFeatureFlagsServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *gwproto.WatchFeatureFlagsEvent
+}
+
+func newFakeWatchFeatureFlagsServer(ctx context.Context, apiKey string) *fakeWatchFeatureFlagsServer {
+	return &fakeWatchFeatureFlagsServer{
+		ctx: metadata.NewIncomingContext(ctx, metadata.MD{
+			"authorization": []string{apiKey},
+		}),
+		events: make(chan *gwproto.WatchFeatureFlagsEvent, 10),
+	}
+}
+
+func (s *fakeWatchFeatureFlagsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchFeatureFlagsServer) Send(evt *gwproto.WatchFeatureFlagsEvent) error {
+	s.events <- evt
+	return nil
+}
+
+func (s *fakeWatchFeatureFlagsServer) next(t *testing.T) *gwproto.WatchFeatureFlagsEvent {
+	t.Helper()
+	select {
+	case evt := <-s.events:
+		return evt
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a watch event")
+		return nil
+	}
+}
+
+func TestWatchCursor(t *testing.T) {
+	t.Parallel()
+	cursor := &watchCursor{
+		Version:                 watchCursorVersion,
+		Tag:                     "tag",
+		FeatureFlagsID:          "ffid",
+		FeatureFlagsRequestedAt: 1,
+		SegmentUsersRequestedAt: 2,
+		SegmentIDs:              []string{"segment-1"},
+	}
+	token, err := cursor.encode()
+	require.NoError(t, err)
+	otherVersion, err := (&watchCursor{Version: watchCursorVersion + 1, Tag: "tag"}).encode()
+	require.NoError(t, err)
+
+	patterns := []struct {
+		desc     string
+		token    string
+		tag      string
+		expected *watchCursor
+	}{
+		{
+			desc:     "empty token",
+			token:    "",
+			tag:      "tag",
+			expected: nil,
+		},
+		{
+			desc:     "malformed token",
+			token:    "not a cursor",
+			tag:      "tag",
+			expected: nil,
+		},
+		{
+			desc:     "other version",
+			token:    otherVersion,
+			tag:      "tag",
+			expected: nil,
+		},
+		{
+			desc:     "other tag",
+			token:    token,
+			tag:      "other-tag",
+			expected: nil,
+		},
+		{
+			desc:     "success",
+			token:    token,
+			tag:      "tag",
+			expected: cursor,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			assert.Equal(t, p.expected, decodeWatchCursor(p.token, p.tag))
+		})
+	}
+}
+
+func TestNextSegmentIDs(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc     string
+		prev     []string
+		resp     *gwproto.GetSegmentUsersResponse
+		expected []string
+	}{
+		{
+			desc: "diff adds updated and removes deleted segments",
+			prev: []string{"segment-1", "segment-2"},
+			resp: &gwproto.GetSegmentUsersResponse{
+				SegmentUsers:      []*featureproto.SegmentUsers{{SegmentId: "segment-3"}, {SegmentId: "segment-1"}},
+				DeletedSegmentIds: []string{"segment-2"},
+			},
+			expected: []string{"segment-1", "segment-3"},
+		},
+		{
+			desc: "force update replaces the segments",
+			prev: []string{"segment-1", "segment-2"},
+			resp: &gwproto.GetSegmentUsersResponse{
+				SegmentUsers: []*featureproto.SegmentUsers{{SegmentId: "segment-3"}},
+				ForceUpdate:  true,
+			},
+			expected: []string{"segment-3"},
+		},
+		{
+			desc:     "force update without segments",
+			prev:     []string{"segment-1"},
+			resp:     &gwproto.GetSegmentUsersResponse{ForceUpdate: true},
+			expected: []string{},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			assert.Equal(t, p.expected, nextSegmentIDs(p.prev, p.resp))
+		})
+	}
+}
+
+func TestGrpcWatchFeatureFlagsErrors(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	apiKey := "api-key-id"
+	envID := "ns0"
+	patterns := []struct {
+		desc        string
+		setup       func(*grpcGatewayService)
+		input       *gwproto.WatchFeatureFlagsRequest
+		expectedErr error
+	}{
+		{
+			desc:        "err: watch not available",
+			setup:       func(gs *grpcGatewayService) {},
+			input:       &gwproto.WatchFeatureFlagsRequest{},
+			expectedErr: ErrWatchNotAvailable,
+		},
+		{
+			desc: "err: bad role",
+			setup: func(gs *grpcGatewayService) {
+				setWatchDispatcher(gs, stream.NewDispatcher(10, nil, zap.NewNop()), time.Minute)
+				expectWatchAPIKey(gs, apiKey, envID, accountproto.APIKey_SDK_CLIENT)
+			},
+			input:       &gwproto.WatchFeatureFlagsRequest{},
+			expectedErr: ErrBadRole,
+		},
+		{
+			desc: "err: source id is required",
+			setup: func(gs *grpcGatewayService) {
+				setWatchDispatcher(gs, stream.NewDispatcher(10, nil, zap.NewNop()), time.Minute)
+				expectWatchAPIKey(gs, apiKey, envID, accountproto.APIKey_SDK_SERVER)
+			},
+			input:       &gwproto.WatchFeatureFlagsRequest{SdkVersion: "v0.0.1"},
+			expectedErr: ErrSourceIDRequired,
+		},
+		{
+			desc: "err: sdk version is required",
+			setup: func(gs *grpcGatewayService) {
+				setWatchDispatcher(gs, stream.NewDispatcher(10, nil, zap.NewNop()), time.Minute)
+				expectWatchAPIKey(gs, apiKey, envID, accountproto.APIKey_SDK_SERVER)
+			},
+			input:       &gwproto.WatchFeatureFlagsRequest{SourceId: eventproto.SourceId_GO_SERVER},
+			expectedErr: ErrSDKVersionRequired,
+		},
+		{
+			desc: "err: too many streams",
+			setup: func(gs *grpcGatewayService) {
+				setWatchDispatcher(gs, stream.NewDispatcher(0, nil, zap.NewNop()), time.Minute)
+				expectWatchAPIKey(gs, apiKey, envID, accountproto.APIKey_SDK_SERVER)
+			},
+			input: &gwproto.WatchFeatureFlagsRequest{
+				SourceId:   eventproto.SourceId_GO_SERVER,
+				SdkVersion: "v0.0.1",
+			},
+			expectedErr: ErrTooManyWatchStreams,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			gs := newGrpcGatewayServiceWithMock(t, mockController)
+			p.setup(gs)
+			srv := newFakeWatchFeatureFlagsServer(context.Background(), apiKey)
+			err := gs.WatchFeatureFlags(p.input, srv)
+			assert.Equal(t, p.expectedErr, err)
+			assert.Empty(t, srv.events)
+		})
+	}
+}
+
+func TestGrpcWatchFeatureFlags(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	apiKey := "api-key-id"
+	envID := "ns0"
+	tag := "tag"
+	now := time.Now()
+	initial := []*featureproto.Feature{
+		{Id: "feature-id-1", Version: 1, Tags: []string{tag}, UpdatedAt: now.Add(-time.Hour).Unix()},
+		{Id: "feature-id-2", Version: 1, Tags: []string{tag}, UpdatedAt: now.Add(-time.Hour).Unix()},
+	}
+	updatedFeature := &featureproto.Feature{
+		Id: "feature-id-2", Version: 2, Tags: []string{tag}, UpdatedAt: now.Unix(),
+	}
+	updated := []*featureproto.Feature{initial[0], updatedFeature}
+	req := &gwproto.WatchFeatureFlagsRequest{
+		Tag:        tag,
+		SourceId:   eventproto.SourceId_GO_SERVER,
+		SdkVersion: "v0.0.1",
+	}
+
+	t.Run("success: snapshot and patch", func(t *testing.T) {
+		gs := newGrpcGatewayServiceWithMock(t, mockController)
+		dispatcher := stream.NewDispatcher(10, nil, zap.NewNop())
+		setWatchDispatcher(gs, dispatcher, time.Minute)
+		expectWatchAPIKey(gs, apiKey, envID, accountproto.APIKey_SDK_SERVER)
+		features := expectWatchFeatures(gs, envID, initial)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		srv := newFakeWatchFeatureFlagsServer(ctx, apiKey)
+		done := make(chan error, 1)
+		go func() { done <- gs.WatchFeatureFlags(req, srv) }()
+
+		snapshot := srv.next(t)
+		assert.Equal(t, gwproto.WatchFeatureFlagsEvent_SNAPSHOT, snapshot.Type)
+		assert.True(t, snapshot.FeatureFlags.ForceUpdate)
+		assert.Equal(t, initial, snapshot.FeatureFlags.Features)
+		assert.NotEmpty(t, snapshot.Cursor)
+
+		features.Store(&updated)
+		dispatcher.HandleEvent(&domaineventproto.Event{
+			EnvironmentId: envID,
+			EntityType:    domaineventproto.Event_FEATURE,
+			EntityId:      updatedFeature.Id,
+			Type:          domaineventproto.Event_FEATURE_UPDATED,
+			EntityData:    `{"tags":["tag"]}`,
+		})
+		patch := srv.next(t)
+		assert.Equal(t, gwproto.WatchFeatureFlagsEvent_PATCH, patch.Type)
+		assert.False(t, patch.FeatureFlags.ForceUpdate)
+		assert.Equal(t, []*featureproto.Feature{updatedFeature}, patch.FeatureFlags.Features)
+		assert.NotEqual(t, snapshot.Cursor, patch.Cursor)
+
+		cancel()
+		assert.NoError(t, <-done)
+	})
+
+	t.Run("success: resume from cursor and heartbeat", func(t *testing.T) {
+		gs := newGrpcGatewayServiceWithMock(t, mockController)
+		dispatcher := stream.NewDispatcher(10, nil, zap.NewNop())
+		setWatchDispatcher(gs, dispatcher, 10*time.Millisecond)
+		expectWatchAPIKey(gs, apiKey, envID, accountproto.APIKey_SDK_SERVER)
+		expectWatchFeatures(gs, envID, updated)
+
+		snapshot, _, _, err := gs.syncWatch(
+			metadata.NewIncomingContext(context.Background(), metadata.MD{"authorization": []string{apiKey}}),
+			req,
+			&watchCursor{Version: watchCursorVersion, Tag: tag},
+		)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		srv := newFakeWatchFeatureFlagsServer(ctx, apiKey)
+		resumeReq := &gwproto.WatchFeatureFlagsRequest{
+			Tag:        req.Tag,
+			SourceId:   req.SourceId,
+			SdkVersion: req.SdkVersion,
+			Cursor:     snapshot.Cursor,
+		}
+		done := make(chan error, 1)
+		go func() { done <- gs.WatchFeatureFlags(resumeReq, srv) }()
+
+		resumed := srv.next(t)
+		assert.Equal(t, gwproto.WatchFeatureFlagsEvent_PATCH, resumed.Type)
+		assert.False(t, resumed.FeatureFlags.ForceUpdate)
+		assert.Empty(t, resumed.FeatureFlags.Features)
+
+		heartbeat := srv.next(t)
+		assert.Equal(t, gwproto.WatchFeatureFlagsEvent_HEARTBEAT, heartbeat.Type)
+		assert.Nil(t, heartbeat.FeatureFlags)
+		assert.NotEmpty(t, heartbeat.Cursor)
+
+		cancel()
+		assert.NoError(t, <-done)
+	})
+
+	t.Run("err: dispatcher shut down", func(t *testing.T) {
+		gs := newGrpcGatewayServiceWithMock(t, mockController)
+		dispatcher := stream.NewDispatcher(10, nil, zap.NewNop())
+		setWatchDispatcher(gs, dispatcher, time.Minute)
+		expectWatchAPIKey(gs, apiKey, envID, accountproto.APIKey_SDK_SERVER)
+		expectWatchFeatures(gs, envID, initial)
+
+		srv := newFakeWatchFeatureFlagsServer(context.Background(), apiKey)
+		done := make(chan error, 1)
+		go func() { done <- gs.WatchFeatureFlags(req, srv) }()
+
+		assert.Equal(t, gwproto.WatchFeatureFlagsEvent_SNAPSHOT, srv.next(t).Type)
+		dispatcher.Shutdown()
+		assert.Equal(t, ErrShuttingDown, <-done)
+	})
+}
+
+func setWatchDispatcher(gs *grpcGatewayService, dispatcher *stream.Dispatcher, heartbeatInterval time.Duration) {
+	opts := *gs.opts
+	opts.streamDispatcher = dispatcher
+	opts.watchHeartbeatInterval = heartbeatInterval
+	gs.opts = &opts
+}
+
+func expectWatchAPIKey(gs *grpcGatewayService, apiKey, envID string, role accountproto.APIKey_Role) {
+	gs.environmentAPIKeyCache.(*cachev3mock.MockEnvironmentAPIKeyCache).EXPECT().Get(apiKey).Return(
+		&accountproto.EnvironmentAPIKey{
+			Environment: &environmentproto.EnvironmentV2{Id: envID},
+			ApiKey: &accountproto.APIKey{
+				Id:   apiKey,
+				Role: role,
+			},
+		}, nil).AnyTimes()
+}
+
+// expectWatchFeatures serves the features from the returned pointer so a test
+// can update them while the stream is open.
+func expectWatchFeatures(
+	gs *grpcGatewayService,
+	envID string,
+	features []*featureproto.Feature,
+) *atomic.Pointer[[]*featureproto.Feature] {
+	current := &atomic.Pointer[[]*featureproto.Feature]{}
+	current.Store(&features)
+	gs.featuresCache.(*cachev3mock.MockFeaturesCache).EXPECT().Get(envID).DoAndReturn(
+		func(string) (*featureproto.Features, error) {
+			return &featureproto.Features{Features: *current.Load()}, nil
+		}).AnyTimes()
+	return current
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -56,6 +57,7 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/rpc/gateway"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/mysql"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/sqlite"
 	subscriptionclient "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/client"
 	tagclient "github.com/bucketeer-io/bucketeer/v2/pkg/tag/client"
 	teamclient "github.com/bucketeer-io/bucketeer/v2/pkg/team/client"
@@ -79,24 +81,27 @@ const (
 
 type server struct {
 	*kingpin.CmdClause
-	port                              *int
-	grpcGatewayPort                   *int
-	project                           *string
-	operationalDatabaseType           *string
-	mysqlUser                         *string
-	mysqlPass                         *string
-	mysqlHost                         *string
-	mysqlPort                         *int
-	mysqlDBName                       *string
-	postgresUser                      *string
-	postgresPass                      *string
-	postgresHost                      *string
-	postgresPort                      *int
-	postgresDBName                    *string
-	postgresSSLMode                   *string
-	postgresSSLRootCert               *string
-	postgresSSLCert                   *string
-	postgresSSLKey                    *string
+	port                    *int
+	grpcGatewayPort         *int
+	restPort                *int
+	project                 *string
+	operationalDatabaseType *string
+	mysqlUser               *string
+	mysqlPass               *string
+	mysqlHost               *string
+	mysqlPort               *int
+	mysqlDBName             *string
+	postgresUser            *string
+	postgresPass            *string
+	postgresHost            *string
+	postgresPort            *int
+	postgresDBName          *string
+	postgresSSLMode         *string
+	postgresSSLRootCert     *string
+	postgresSSLCert         *string
+	postgresSSLKey          *string
+	// SQLite
+	sqlitePath                        *string
 	goalTopic                         *string
 	goalTopicProject                  *string
 	evaluationTopic                   *string
@@ -140,6 +145,8 @@ type server struct {
 	pubSubRedisMinIdle        *int
 	pubSubRedisPartitionCount *int
 	pubSubRedisMode           *string
+	pubSubKafkaBrokers        *string
+	pubSubKafkaPartitionCount *int
 	cacheInvalidationTopic    *string
 	sseHeartbeatInterval      *time.Duration
 	sseMaxConnections         *int
@@ -151,8 +158,9 @@ func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
 		CmdClause:       cmd,
 		port:            cmd.Flag("port", "Port to bind to.").Default("9090").Int(),
 		grpcGatewayPort: cmd.Flag("grpc-gateway-port", "Port to bind to for gRPC-gateway.").Default("9089").Int(),
+		restPort:        cmd.Flag("rest-port", "Port to bind to for the REST server.").Default("8000").Int(),
 		project:         cmd.Flag("project", "GCP Project id to use for PubSub.").Required().String(),
-		operationalDatabaseType: cmd.Flag("storage-type", "Operational database type (mysql, postgres).").
+		operationalDatabaseType: cmd.Flag("storage-type", "Operational database type (mysql, postgres, sqlite).").
 			Default("mysql").String(),
 		mysqlUser:      cmd.Flag("mysql-user", "MySQL user.").Required().String(),
 		mysqlPass:      cmd.Flag("mysql-pass", "MySQL password.").Required().String(),
@@ -180,6 +188,10 @@ func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
 			"postgres-ssl-key",
 			"Path to the PostgreSQL SSL client private key file.",
 		).String(),
+		sqlitePath: cmd.Flag(
+			"sqlite-path",
+			"Path to the SQLite database file used when storage-type=sqlite.",
+		).Default("bucketeer.db").String(),
 		goalTopic: cmd.Flag("goal-topic", "Topic to use for publishing GoalEvent.").Required().String(),
 		goalTopicProject: cmd.Flag(
 			"goal-topic-project",
@@ -305,7 +317,7 @@ func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
 		).Default("10m").Duration(),
 		// PubSub configurations
 		pubSubType: cmd.Flag("pubsub-type",
-			"Type of PubSub to use (google or redis-stream).",
+			"Type of PubSub to use (google, redis-stream, kafka or memory).",
 		).Default("google").String(),
 		pubSubRedisServerName: cmd.Flag("pubsub-redis-server-name",
 			"Name of the Redis server for PubSub.",
@@ -325,6 +337,12 @@ func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
 		pubSubRedisMode: cmd.Flag("pubsub-redis-mode",
 			"PubSub Redis client mode: cluster, standalone, or auto.",
 		).Default("auto").String(),
+		pubSubKafkaBrokers: cmd.Flag("pubsub-kafka-brokers",
+			"Comma-separated addresses of the Kafka brokers for PubSub.",
+		).Default("localhost:9092").String(),
+		pubSubKafkaPartitionCount: cmd.Flag("pubsub-kafka-partition-count",
+			"Number of partitions of the Kafka topics created for PubSub.",
+		).Default("16").Int(),
 		cacheInvalidationTopic: cmd.Flag("cache-invalidation-topic",
 			"PubSub topic on which the subscriber announces L2 cache refreshes. "+
 				"When set, this pod evicts its L1 (in-memory) cache entries on each "+
@@ -333,11 +351,12 @@ func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
 		).Default("cache-invalidation").String(),
 		sseHeartbeatInterval: cmd.Flag("sse-heartbeat-interval",
 			"Interval between SSE heartbeat comments on the stream_evaluations "+
-				"endpoint. Must be shorter than the idle timeout of any reverse "+
+				"endpoint and between heartbeat resyncs on the WatchFeatureFlags "+
+				"gRPC stream. Must be shorter than the idle timeout of any reverse "+
 				"proxy or load balancer in front of the gateway.",
 		).Default("25s").Duration(),
 		sseMaxConnections: cmd.Flag("sse-max-connections",
-			"Maximum number of concurrent SSE connections per pod.",
+			"Maximum number of concurrent SSE and WatchFeatureFlags connections per pod.",
 		).Default("10000").Int(),
 	}
 	r.RegisterCommand(server)
@@ -374,6 +393,9 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		}
 		factoryOpts = append(factoryOpts, factory.WithRedisClient(redisClient))
 		factoryOpts = append(factoryOpts, factory.WithPartitionCount(*s.pubSubRedisPartitionCount))
+	case factory.Kafka:
+		factoryOpts = append(factoryOpts, factory.WithKafkaBrokers(strings.Split(*s.pubSubKafkaBrokers, ",")))
+		factoryOpts = append(factoryOpts, factory.WithPartitionCount(*s.pubSubKafkaPartitionCount))
 	}
 
 	pubsubCtx, pubsubCancel := context.WithCancel(context.Background())
@@ -667,6 +689,8 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		api.WithFeatureFlagDiffGracePeriod(*s.featureFlagDiffGracePeriod),
 		api.WithOldestEventTimestamp(*s.oldestEventTimestamp),
 		api.WithFurthestEventTimestamp(*s.furthestEventTimestamp),
+		api.WithStreamDispatcher(streamDispatcher),
+		api.WithWatchHeartbeatInterval(*s.sseHeartbeatInterval),
 		api.WithMetrics(registerer),
 		api.WithMetricsWorkers(*s.metricsWorkers),
 		api.WithMetricsQueueSize(*s.metricsQueueSize),
@@ -758,6 +782,7 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 	httpServer := rest.NewServer(
 		*s.certPath, *s.keyPath,
 		rest.WithLogger(logger),
+		rest.WithPort(*s.restPort),
 		rest.WithService(gatewayService),
 		rest.WithService(restHealthChecker),
 		rest.WithMetrics(registerer),
@@ -907,6 +932,7 @@ func (s *server) startCacheInvalidator(
 	invalidator := api.NewCacheInvalidator(
 		cachev3.NewFeaturesCache(inMemoryCache, 0),
 		cachev3.NewSegmentUsersCache(inMemoryCache, 0),
+		cachev3.NewSegmentUserIndexCache(inMemoryCache, 0),
 		cachev3.NewEnvironmentAPIKeyCache(inMemoryCache, 0),
 		dispatcher,
 		logger,
@@ -980,6 +1006,14 @@ func (s *server) createMySQLClient(
 ) (mysql.Client, error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
+	if *s.operationalDatabaseType == "sqlite" {
+		return sqlite.NewClient(
+			ctx,
+			*s.sqlitePath,
+			sqlite.WithLogger(logger),
+			sqlite.WithMetrics(registerer),
+		)
+	}
 	return mysql.NewClient(
 		ctx,
 		*s.mysqlUser, *s.mysqlPass, *s.mysqlHost,
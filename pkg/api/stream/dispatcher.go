@@ -27,12 +27,17 @@ import (
 	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
 )
 
-var errTooManyConnections = errors.New("stream: too many connections")
+// ErrTooManyConnections is returned by Register when the connection limit is reached.
+var ErrTooManyConnections = errors.New("stream: too many connections")
+
+// AllTags registers a connection that receives the events of every tag.
+// Server SDKs use it to watch all the flags in the environment.
+const AllTags = ""
 
 // FeaturesFetcher returns all features for the given environment.
 type FeaturesFetcher func(envID string) ([]*featureproto.Feature, error)
 
-// Dispatcher forwards relevant domain events to SSE connections.
+// Dispatcher forwards relevant domain events to SSE and gRPC stream connections.
 type Dispatcher struct {
 	mu sync.Mutex
 	// envID -> tag -> set of conns
@@ -45,15 +50,22 @@ type Dispatcher struct {
 	logger        *zap.Logger
 }
 
-type event struct {
+// Event notifies a connection that the flags or segment users it watches may
+// have changed. Events are coalesced when the connection is slow to consume them.
+type Event struct {
 	environmentID string
 	tags          []string
 	eventType     domaineventproto.Event_Type
 	dispatchedAt  time.Time
 }
 
+// DispatchedAt returns the time the event was fanned out to the connections.
+func (e Event) DispatchedAt() time.Time {
+	return e.dispatchedAt
+}
+
 type conn struct {
-	ch        chan event
+	ch        chan Event
 	tag       string
 	sourceID  string
 	createdAt time.Time
@@ -74,18 +86,23 @@ func (d *Dispatcher) Shutdown() {
 	d.shutdownOnce.Do(func() { close(d.shutdownCh) })
 }
 
-// register adds a connection to the dispatcher. The caller must invoke the returned
+// Done returns a channel that is closed when the dispatcher shuts down.
+func (d *Dispatcher) Done() <-chan struct{} {
+	return d.shutdownCh
+}
+
+// Register adds a connection to the dispatcher. The caller must invoke the returned
 // deregister func on disconnect to free the slot.
-// Returns errTooManyConnections when maxConns is set and already reached.
-func (d *Dispatcher) register(envID, tag, sourceID string) (events <-chan event, deregister func(), err error) {
+// Returns ErrTooManyConnections when maxConns is set and already reached.
+func (d *Dispatcher) Register(envID, tag, sourceID string) (events <-chan Event, deregister func(), err error) {
 	d.mu.Lock()
 	if d.totalConns >= d.maxConns {
 		d.mu.Unlock()
 		sseErrorsCounter.WithLabelValues(envID, tag, sourceID, errorTypeConnectionRefusedByLimit).Inc()
-		return nil, nil, errTooManyConnections
+		return nil, nil, ErrTooManyConnections
 	}
 	c := &conn{
-		ch:        make(chan event, 1),
+		ch:        make(chan Event, 1),
 		tag:       tag,
 		sourceID:  sourceID,
 		createdAt: time.Now(),
@@ -149,7 +166,7 @@ func (d *Dispatcher) HandleEvent(e *domaineventproto.Event) {
 			e.Type != domaineventproto.Event_FEATURE_DISABLED {
 			return
 		}
-		d.dispatch(event{
+		d.dispatch(Event{
 			environmentID: e.EnvironmentId,
 			eventType:     e.Type,
 			tags:          d.affectedTags(e),
@@ -173,7 +190,7 @@ func (d *Dispatcher) HandleEvent(e *domaineventproto.Event) {
 		}
 		// TODO: resolve the affected tags from the segment.
 		// Currently, it fans out env-wide (all tags).
-		d.dispatch(event{
+		d.dispatch(Event{
 			environmentID: e.EnvironmentId,
 			eventType:     e.Type,
 		})
@@ -246,10 +263,10 @@ func (d *Dispatcher) parseTags(data string) []string {
 	return payload.Tags
 }
 
-// dispatch fans an event out to matching tag connections in the environment, or to
-// all of them when tags is empty.
+// dispatch fans an event out to matching tag connections and AllTags connections
+// in the environment, or to all of them when tags is empty.
 // Sends are non-blocking.
-func (d *Dispatcher) dispatch(ev event) {
+func (d *Dispatcher) dispatch(ev Event) {
 	d.mu.Lock()
 	tagConns := d.conns[ev.environmentID]
 	if len(tagConns) == 0 {
@@ -274,12 +291,18 @@ func (d *Dispatcher) dispatch(ev event) {
 		}
 	} else {
 		dispatchTagCount = float64(len(ev.tags))
-		n := 0
+		n := len(tagConns[AllTags])
 		for _, t := range ev.tags {
 			n += len(tagConns[t])
 		}
 		targetConns = make([]*conn, 0, n)
+		for c := range tagConns[AllTags] {
+			targetConns = append(targetConns, c)
+		}
 		for _, t := range ev.tags {
+			if t == AllTags {
+				continue
+			}
 			for c := range tagConns[t] {
 				targetConns = append(targetConns, c)
 			}
@@ -86,7 +86,7 @@ func TestDispatcherRegister(t *testing.T) {
 			t.Parallel()
 			d := NewDispatcher(10000, nil, zap.NewNop())
 			for _, r := range tc.clients {
-				_, cancel, err := d.register(r.envID, r.tag, "source1")
+				_, cancel, err := d.Register(r.envID, r.tag, "source1")
 				require.NoError(t, err)
 				defer cancel()
 			}
@@ -122,10 +122,10 @@ func TestDispatcherRegisterMaxConns(t *testing.T) {
 			d := NewDispatcher(tc.maxConns, nil, zap.NewNop())
 			var errCount int
 			for i := 0; i < tc.register; i++ {
-				_, cancel, err := d.register("env-1", "tag-A", "source1")
+				_, cancel, err := d.Register("env-1", "tag-A", "source1")
 				if err != nil {
 					errCount++
-					assert.ErrorIs(t, err, errTooManyConnections)
+					assert.ErrorIs(t, err, ErrTooManyConnections)
 				} else {
 					defer cancel()
 				}
@@ -139,15 +139,15 @@ func TestDispatcherRegisterSlotFreedByDeregister(t *testing.T) {
 	t.Parallel()
 	maxConns := 1
 	d := NewDispatcher(maxConns, nil, zap.NewNop())
-	_, cancel1, err := d.register("env-1", "tag-A", "source1")
+	_, cancel1, err := d.Register("env-1", "tag-A", "source1")
 	require.NoError(t, err)
 
-	_, _, err = d.register("env-1", "tag-A", "source1")
-	assert.ErrorIs(t, err, errTooManyConnections)
+	_, _, err = d.Register("env-1", "tag-A", "source1")
+	assert.ErrorIs(t, err, ErrTooManyConnections)
 
 	cancel1() // conns: 1->0
 
-	_, cancel3, err := d.register("env-1", "tag-A", "source1")
+	_, cancel3, err := d.Register("env-1", "tag-A", "source1")
 	require.NoError(t, err)
 	defer cancel3()
 }
@@ -208,7 +208,7 @@ func TestDispatcherDeregister(t *testing.T) {
 			for env, tagConns := range tc.conns {
 				for tag := range tagConns {
 					for i := 0; i < tagConns[tag]; i++ {
-						_, cancel, err := d.register(env, tag, "source1")
+						_, cancel, err := d.Register(env, tag, "source1")
 						require.NoError(t, err)
 						defer cancel()
 						cancels[testConnSpec{env, tag}] = cancel
@@ -226,32 +226,44 @@ func TestDispatcherDispatch(t *testing.T) {
 	cases := []struct {
 		name  string
 		conns []testConnSpec
-		event event
+		event Event
 		// expected: parallel to conns; true if the conn at the same index should receive the event
 		wantRecv []bool
 	}{
 		{
 			name:     "matches env+tag",
 			conns:    []testConnSpec{{"env-1", "tag-A"}},
-			event:    event{environmentID: "env-1", tags: []string{"tag-A"}},
+			event:    Event{environmentID: "env-1", tags: []string{"tag-A"}},
 			wantRecv: []bool{true},
 		},
 		{
 			name:     "other env not reached",
 			conns:    []testConnSpec{{"env-1", "tag-A"}, {"env-2", "tag-A"}},
-			event:    event{environmentID: "env-1", tags: []string{"tag-A"}},
+			event:    Event{environmentID: "env-1", tags: []string{"tag-A"}},
 			wantRecv: []bool{true, false},
 		},
 		{
 			name:     "empty tags fan out within env",
 			conns:    []testConnSpec{{"env-1", "tag-A"}, {"env-1", "tag-B"}},
-			event:    event{environmentID: "env-1"},
+			event:    Event{environmentID: "env-1"},
 			wantRecv: []bool{true, true},
 		},
 		{
 			name:     "other tag not reached",
 			conns:    []testConnSpec{{"env-1", "tag-A"}, {"env-1", "tag-B"}},
-			event:    event{environmentID: "env-1", tags: []string{"tag-A"}},
+			event:    Event{environmentID: "env-1", tags: []string{"tag-A"}},
+			wantRecv: []bool{true, false},
+		},
+		{
+			name:     "all tags conn reached by tag event",
+			conns:    []testConnSpec{{"env-1", AllTags}, {"env-1", "tag-B"}},
+			event:    Event{environmentID: "env-1", tags: []string{"tag-A"}},
+			wantRecv: []bool{true, false},
+		},
+		{
+			name:     "all tags conn reached by env-wide event",
+			conns:    []testConnSpec{{"env-1", AllTags}, {"env-2", AllTags}},
+			event:    Event{environmentID: "env-1"},
 			wantRecv: []bool{true, false},
 		},
 	}
@@ -259,9 +271,9 @@ func TestDispatcherDispatch(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			d := NewDispatcher(10000, nil, zap.NewNop())
-			chs := make([]<-chan event, len(tc.conns))
+			chs := make([]<-chan Event, len(tc.conns))
 			for i, c := range tc.conns {
-				ch, cancel, err := d.register(c.envID, c.tag, "source1")
+				ch, cancel, err := d.Register(c.envID, c.tag, "source1")
 				require.NoError(t, err)
 				defer cancel()
 				chs[i] = ch
@@ -424,7 +436,7 @@ func TestDispatcherHandleEvent(t *testing.T) {
 	for _, p := range patterns {
 		t.Run(p.desc, func(t *testing.T) {
 			d := NewDispatcher(10000, nil, zap.NewNop())
-			ch, cancel, err := d.register(envID, p.clientTag, "source1")
+			ch, cancel, err := d.Register(envID, p.clientTag, "source1")
 			require.NoError(t, err)
 			defer cancel()
 
@@ -112,7 +112,7 @@ func (h *EvaluationsHandler) Handle(w http.ResponseWriter, httpReq *http.Request
 
 	// Register before writing headers so we can still return an HTTP error on
 	// connection limit.
-	events, deregister, err := h.dispatcher.register(envID, req.Tag, sourceID)
+	events, deregister, err := h.dispatcher.Register(envID, req.Tag, sourceID)
 	if err != nil {
 		rest.ReturnFailureResponse(w, errServiceUnavailable)
 		return
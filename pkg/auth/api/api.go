@@ -37,6 +37,7 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/auth"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/auth/google"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/auth/oidc"
 	envdomain "github.com/bucketeer-io/bucketeer/v2/pkg/environment/domain"
 	envstotage "github.com/bucketeer-io/bucketeer/v2/pkg/environment/storage/v2"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
@@ -54,6 +55,7 @@ type options struct {
 	emailFilter       *regexp.Regexp
 	logger            *zap.Logger
 	isDemoSiteEnabled bool
+	oidcAuthenticator *oidc.Authenticator
 }
 
 var defaultOptions = options{
@@ -95,6 +97,12 @@ func WithDemoSiteEnabled(isDemoSiteEnabled bool) Option {
 	}
 }
 
+func WithOIDCAuthenticator(authenticator *oidc.Authenticator) Option {
+	return func(opts *options) {
+		opts.oidcAuthenticator = authenticator
+	}
+}
+
 type authService struct {
 	issuer              string
 	audience            string
@@ -185,7 +193,17 @@ func (s *authService) GetAuthenticationURL(
 		)
 		return nil, err
 	}
-	authenticator, err := s.getAuthenticator(req.Type)
+	providerID, err := s.resolveOIDCProvider(req)
+	if err != nil {
+		s.logger.Error("Failed to resolve the oidc provider",
+			zap.Error(err),
+			zap.Any("type", req.Type),
+			zap.String("email", req.Email),
+			zap.String("organization_id", req.OrganizationId),
+		)
+		return nil, err
+	}
+	authenticator, err := s.getAuthenticator(req.Type, providerID, req.State)
 	if err != nil {
 		s.logger.Error("Failed to get the authenticator",
 			zap.Error(err),
@@ -205,7 +223,10 @@ func (s *authService) GetAuthenticationURL(
 		)
 		return nil, api.NewGRPCStatus(err).Err()
 	}
-	return &authproto.GetAuthenticationURLResponse{Url: loginURL}, nil
+	return &authproto.GetAuthenticationURLResponse{
+		Url:        loginURL,
+		ProviderId: providerID,
+	}, nil
 }
 
 func (s *authService) ExchangeToken(
@@ -221,11 +242,12 @@ func (s *authService) ExchangeToken(
 		)
 		return nil, err
 	}
-	authenticator, err := s.getAuthenticator(req.Type)
+	authenticator, err := s.getAuthenticator(req.Type, req.ProviderId, req.State)
 	if err != nil {
 		s.logger.Error("Failed to get the authenticator",
 			zap.Error(err),
 			zap.Any("type", req.Type),
+			zap.String("provider_id", req.ProviderId),
 			zap.String("code", req.Code),
 			zap.String("redirect_url", req.RedirectUrl),
 		)
@@ -428,13 +450,39 @@ func (s *authService) SwitchOrganization(
 	}, nil
 }
 
+// resolveOIDCProvider chooses the OpenID Connect provider by the email domain or the organization.
+// It returns an empty string for the other auth types.
+func (s *authService) resolveOIDCProvider(
+	req *authproto.GetAuthenticationURLRequest,
+) (string, error) {
+	if req.Type != authproto.AuthType_AUTH_TYPE_OIDC || s.opts.oidcAuthenticator == nil {
+		return "", nil
+	}
+	providerID, err := s.opts.oidcAuthenticator.ResolveProvider(req.Email, req.OrganizationId)
+	if err != nil {
+		return "", api.NewGRPCStatus(err).Err()
+	}
+	return providerID, nil
+}
+
 func (s *authService) getAuthenticator(
 	authType authproto.AuthType,
+	providerID, state string,
 ) (auth.Authenticator, error) {
 	var authenticator auth.Authenticator
 	switch authType {
 	case authproto.AuthType_AUTH_TYPE_GOOGLE:
 		authenticator = s.googleAuthenticator
+	case authproto.AuthType_AUTH_TYPE_OIDC:
+		if s.opts.oidcAuthenticator == nil {
+			s.logger.Error("OpenID Connect is not configured")
+			return nil, statusUnknownAuthType.Err()
+		}
+		session, err := s.opts.oidcAuthenticator.Session(providerID, state)
+		if err != nil {
+			return nil, err
+		}
+		authenticator = session
 	case authproto.AuthType_AUTH_TYPE_GITHUB:
 
 	default:
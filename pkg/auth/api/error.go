@@ -30,6 +30,8 @@ var (
 		pkgErr.NewErrorInvalidArgUnknown(pkgErr.AuthPackageName, "unknown auth type", "AuthType"))
 	statusMissingRedirectURL = api.NewGRPCStatus(
 		pkgErr.NewErrorInvalidArgEmpty(pkgErr.AuthPackageName, "redirect url must not be empty", "RedirectUrl"))
+	statusMissingProviderID = api.NewGRPCStatus(
+		pkgErr.NewErrorInvalidArgEmpty(pkgErr.AuthPackageName, "provider id must not be empty", "ProviderId"))
 	statusMissingRefreshToken = api.NewGRPCStatus(
 		pkgErr.NewErrorInvalidArgEmpty(pkgErr.AuthPackageName, "refresh token must not be empty", "RefreshToken"))
 	statusMissingUsername = api.NewGRPCStatus(
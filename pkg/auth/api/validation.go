@@ -45,6 +45,14 @@ func validateExchangeTokenRequest(
 	if req.RedirectUrl == "" {
 		return statusMissingRedirectURL.Err()
 	}
+	if req.Type == authproto.AuthType_AUTH_TYPE_OIDC {
+		if req.State == "" {
+			return statusMissingState.Err()
+		}
+		if req.ProviderId == "" {
+			return statusMissingProviderID.Err()
+		}
+	}
 	return nil
 }
 
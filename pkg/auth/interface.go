@@ -45,6 +45,45 @@ type GoogleConfig struct {
 	RedirectURLs []string `json:"redirectUrls"`
 }
 
+// OIDCConfig configures the generic OpenID Connect providers.
+// CodeVerifierKey is the secret used to derive the PKCE code verifier and the nonce from the state,
+// so it must be the same across all the web server replicas.
+type OIDCConfig struct {
+	CodeVerifierKey string               `json:"codeVerifierKey"`
+	Providers       []OIDCProviderConfig `json:"providers"`
+}
+
+// OIDCProviderConfig configures an OpenID Connect provider such as Okta or Keycloak.
+// The provider is chosen by the organization ID or the email domain used to sign in.
+// A provider with neither of them is used when no other provider matches.
+type OIDCProviderConfig struct {
+	ID              string           `json:"id"`
+	Issuer          string           `json:"issuer"`
+	ClientID        string           `json:"clientId"`
+	ClientSecret    string           `json:"clientSecret"`
+	RedirectURLs    []string         `json:"redirectUrls"`
+	Scopes          []string         `json:"scopes"`
+	EmailDomains    []string         `json:"emailDomains"`
+	OrganizationIDs []string         `json:"organizationIds"`
+	ClaimMapping    OIDCClaimMapping `json:"claimMapping"`
+	// TrustUnverifiedEmail allows signing in when the email is not verified by the provider
+	// or the provider doesn't return the email_verified claim.
+	// Enable it only for providers that own the email addresses of their users.
+	TrustUnverifiedEmail bool `json:"trustUnverifiedEmail"`
+}
+
+// OIDCClaimMapping maps the ID token claims to the user info.
+// Nested claims can be referenced using dots, e.g. "profile.picture".
+// Empty fields fall back to the standard OpenID Connect claims.
+type OIDCClaimMapping struct {
+	Name          string `json:"name"`
+	FirstName     string `json:"firstName"`
+	LastName      string `json:"lastName"`
+	Email         string `json:"email"`
+	EmailVerified string `json:"emailVerified"`
+	Avatar        string `json:"avatar"`
+}
+
 type DemoSignInConfig struct {
 	Enabled                bool   `json:"enabled"`
 	Password               string `json:"password"`
@@ -60,5 +99,6 @@ type OAuthConfig struct {
 	Issuer       string           `json:"issuer"`
 	Audience     string           `json:"audience"`
 	GoogleConfig GoogleConfig     `json:"google"`
+	OIDC         OIDCConfig       `json:"oidc"`
 	DemoSignIn   DemoSignInConfig `json:"demoSignIn"`
 }
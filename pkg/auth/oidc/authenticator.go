@@ -0,0 +1,197 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/auth"
+	pkgErr "github.com/bucketeer-io/bucketeer/v2/pkg/error"
+)
+
+var (
+	ErrUnregisteredRedirectURL = pkgErr.NewErrorInvalidArgEmpty(
+		pkgErr.AuthPackageName,
+		"unregistered redirectURL",
+		"redirectURL",
+	)
+	ErrProviderNotFound = pkgErr.NewErrorNotFound(
+		pkgErr.AuthPackageName,
+		"oidc provider not found",
+		"ProviderId",
+	)
+	ErrDiscoveryFailed = pkgErr.NewErrorUnavailable(
+		pkgErr.AuthPackageName,
+		"failed to load the oidc discovery document",
+	)
+	ErrInvalidIDToken = pkgErr.NewErrorUnauthenticated(
+		pkgErr.AuthPackageName,
+		"invalid id token",
+	)
+	ErrMissingEmail = pkgErr.NewErrorUnauthenticated(
+		pkgErr.AuthPackageName,
+		"email claim is missing",
+	)
+	ErrUnverifiedEmail = pkgErr.NewErrorPermissionDenied(
+		pkgErr.AuthPackageName,
+		"email is not verified",
+	)
+	ErrUnallowedEmailDomain = pkgErr.NewErrorPermissionDenied(
+		pkgErr.AuthPackageName,
+		"email domain is not allowed by the oidc provider",
+	)
+)
+
+type options struct {
+	httpClient *http.Client
+}
+
+var defaultOptions = options{
+	httpClient: &http.Client{Timeout: 10 * time.Second},
+}
+
+type Option func(*options)
+
+func WithHTTPClient(client *http.Client) Option {
+	return func(opts *options) {
+		opts.httpClient = client
+	}
+}
+
+// Authenticator authenticates users through the configured OpenID Connect providers.
+type Authenticator struct {
+	providers   []*provider
+	providerMap map[string]*provider
+	logger      *zap.Logger
+}
+
+func NewAuthenticator(
+	config *auth.OIDCConfig,
+	logger *zap.Logger,
+	opts ...Option,
+) (*Authenticator, error) {
+	options := defaultOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if err := validateConfig(config); err != nil {
+		return nil, err
+	}
+	logger = logger.Named("auth")
+	a := &Authenticator{
+		providers:   make([]*provider, 0, len(config.Providers)),
+		providerMap: make(map[string]*provider, len(config.Providers)),
+		logger:      logger,
+	}
+	for i := range config.Providers {
+		p := newProvider(
+			&config.Providers[i],
+			[]byte(config.CodeVerifierKey),
+			options.httpClient,
+			time.Now,
+			logger,
+		)
+		a.providers = append(a.providers, p)
+		a.providerMap[p.config.ID] = p
+	}
+	return a, nil
+}
+
+func validateConfig(config *auth.OIDCConfig) error {
+	if len(config.Providers) == 0 {
+		return nil
+	}
+	if config.CodeVerifierKey == "" {
+		return errors.New("oidc: codeVerifierKey must not be empty")
+	}
+	ids := make(map[string]struct{}, len(config.Providers))
+	for _, p := range config.Providers {
+		if p.ID == "" {
+			return errors.New("oidc: provider id must not be empty")
+		}
+		if _, ok := ids[p.ID]; ok {
+			return fmt.Errorf("oidc: duplicated provider id %q", p.ID)
+		}
+		ids[p.ID] = struct{}{}
+		if p.Issuer == "" || p.ClientID == "" {
+			return fmt.Errorf("oidc: issuer and clientId must not be empty for provider %q", p.ID)
+		}
+		if len(p.RedirectURLs) == 0 {
+			return fmt.Errorf("oidc: redirectUrls must not be empty for provider %q", p.ID)
+		}
+	}
+	return nil
+}
+
+// ResolveProvider chooses the provider to sign in.
+// The organization takes precedence over the email domain,
+// and a provider with neither of them is used as the default.
+func (a *Authenticator) ResolveProvider(email, organizationID string) (string, error) {
+	if organizationID != "" {
+		for _, p := range a.providers {
+			if p.matchesOrganization(organizationID) {
+				return p.config.ID, nil
+			}
+		}
+	}
+	if domain := emailDomain(email); domain != "" {
+		for _, p := range a.providers {
+			if p.matchesEmailDomain(domain) {
+				return p.config.ID, nil
+			}
+		}
+	}
+	for _, p := range a.providers {
+		if p.isDefault() {
+			return p.config.ID, nil
+		}
+	}
+	return "", ErrProviderNotFound
+}
+
+// Session returns the authenticator of the provider bound to the state of the login request.
+// The state is required to derive the same PKCE code verifier and nonce when exchanging the code.
+func (a *Authenticator) Session(providerID, state string) (auth.Authenticator, error) {
+	p, ok := a.providerMap[providerID]
+	if !ok {
+		return nil, ErrProviderNotFound
+	}
+	return &session{provider: p, state: state}, nil
+}
+
+type session struct {
+	provider *provider
+	state    string
+}
+
+func (s *session) Login(
+	ctx context.Context,
+	state, redirectURL string,
+) (string, error) {
+	return s.provider.login(ctx, state, redirectURL)
+}
+
+func (s *session) Exchange(
+	ctx context.Context,
+	code, redirectURL string,
+) (*auth.UserInfo, error) {
+	return s.provider.exchange(ctx, code, s.state, redirectURL)
+}
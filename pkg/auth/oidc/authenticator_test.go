@@ -0,0 +1,478 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	jose "github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/auth"
+)
+
+const (
+	testClientID    = "bucketeer"
+	testRedirectURL = "https://localhost/auth/callback"
+	testState       = "state"
+	testCode        = "code"
+	testKeyID       = "key-1"
+)
+
+type fakeProvider struct {
+	t          *testing.T
+	server     *httptest.Server
+	key        *rsa.PrivateKey
+	authURL    *url.URL
+	idClaims   map[string]interface{}
+	userInfo   map[string]interface{}
+	jwksCalls  int
+	challenges map[string]string
+}
+
+func newFakeProvider(t *testing.T) *fakeProvider {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	f := &fakeProvider{t: t, key: key, challenges: map[string]string{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc(discoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		f.writeJSON(w, discoveryDocument{
+			Issuer:                f.server.URL,
+			AuthorizationEndpoint: f.server.URL + "/authorize",
+			TokenEndpoint:         f.server.URL + "/token",
+			UserinfoEndpoint:      f.server.URL + "/userinfo",
+			JWKSURI:               f.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		f.jwksCalls++
+		f.writeJSON(w, jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+			Key:       &f.key.PublicKey,
+			KeyID:     testKeyID,
+			Algorithm: string(jose.RS256),
+			Use:       "sig",
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if base64.RawURLEncoding.EncodeToString(sum[:]) != f.challenges[r.PostForm.Get("code")] {
+			w.WriteHeader(http.StatusBadRequest)
+			f.writeJSON(w, map[string]string{"error": "invalid_grant"})
+			return
+		}
+		f.writeJSON(w, map[string]interface{}{
+			"access_token": "access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     f.sign(f.idClaims),
+		})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer access-token", r.Header.Get("Authorization"))
+		f.writeJSON(w, f.userInfo)
+	})
+	f.server = httptest.NewServer(mux)
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+// authorize simulates the user signing in on the provider using the login url.
+func (f *fakeProvider) authorize(loginURL string) {
+	u, err := url.Parse(loginURL)
+	require.NoError(f.t, err)
+	f.authURL = u
+	q := u.Query()
+	require.Equal(f.t, "S256", q.Get("code_challenge_method"))
+	f.challenges[testCode] = q.Get("code_challenge")
+	f.idClaims["nonce"] = q.Get("nonce")
+}
+
+func (f *fakeProvider) sign(claims map[string]interface{}) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: f.key},
+		(&jose.SignerOptions{}).WithHeader("kid", testKeyID).WithType("JWT"),
+	)
+	require.NoError(f.t, err)
+	token, err := jwt.Signed(signer).Claims(claims).Serialize()
+	require.NoError(f.t, err)
+	return token
+}
+
+func (f *fakeProvider) writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(f.t, json.NewEncoder(w).Encode(v))
+}
+
+func (f *fakeProvider) defaultClaims() map[string]interface{} {
+	now := time.Now()
+	return map[string]interface{}{
+		"iss":            f.server.URL,
+		"sub":            "user-1",
+		"aud":            testClientID,
+		"exp":            now.Add(time.Hour).Unix(),
+		"iat":            now.Unix(),
+		"email":          "alice@example.com",
+		"email_verified": true,
+		"name":           "Alice Smith",
+		"given_name":     "Alice",
+		"family_name":    "Smith",
+		"picture":        "https://example.com/alice.png",
+	}
+}
+
+func newTestAuthenticator(t *testing.T, providers ...auth.OIDCProviderConfig) *Authenticator {
+	t.Helper()
+	a, err := NewAuthenticator(&auth.OIDCConfig{
+		CodeVerifierKey: "secret",
+		Providers:       providers,
+	}, zap.NewNop())
+	require.NoError(t, err)
+	return a
+}
+
+func providerConfig(id, issuer string) auth.OIDCProviderConfig {
+	return auth.OIDCProviderConfig{
+		ID:           id,
+		Issuer:       issuer,
+		ClientID:     testClientID,
+		ClientSecret: "client-secret",
+		RedirectURLs: []string{testRedirectURL},
+	}
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc        string
+		config      *auth.OIDCConfig
+		expectedErr bool
+	}{
+		{
+			desc:   "success: no providers",
+			config: &auth.OIDCConfig{},
+		},
+		{
+			desc: "success",
+			config: &auth.OIDCConfig{
+				CodeVerifierKey: "secret",
+				Providers:       []auth.OIDCProviderConfig{providerConfig("okta", "https://okta.example.com")},
+			},
+		},
+		{
+			desc: "err: missing code verifier key",
+			config: &auth.OIDCConfig{
+				Providers: []auth.OIDCProviderConfig{providerConfig("okta", "https://okta.example.com")},
+			},
+			expectedErr: true,
+		},
+		{
+			desc: "err: duplicated provider id",
+			config: &auth.OIDCConfig{
+				CodeVerifierKey: "secret",
+				Providers: []auth.OIDCProviderConfig{
+					providerConfig("okta", "https://okta.example.com"),
+					providerConfig("okta", "https://keycloak.example.com"),
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			desc: "err: missing issuer",
+			config: &auth.OIDCConfig{
+				CodeVerifierKey: "secret",
+				Providers:       []auth.OIDCProviderConfig{providerConfig("okta", "")},
+			},
+			expectedErr: true,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			_, err := NewAuthenticator(p.config, zap.NewNop())
+			assert.Equal(t, p.expectedErr, err != nil)
+		})
+	}
+}
+
+func TestResolveProvider(t *testing.T) {
+	t.Parallel()
+	okta := providerConfig("okta", "https://okta.example.com")
+	okta.EmailDomains = []string{"example.com"}
+	keycloak := providerConfig("keycloak", "https://keycloak.example.com")
+	keycloak.OrganizationIDs = []string{"org-1"}
+	fallback := providerConfig("fallback", "https://idp.example.com")
+	patterns := []struct {
+		desc           string
+		providers      []auth.OIDCProviderConfig
+		email          string
+		organizationID string
+		expected       string
+		expectedErr    error
+	}{
+		{
+			desc:      "by email domain",
+			providers: []auth.OIDCProviderConfig{okta, keycloak},
+			email:     "alice@EXAMPLE.com",
+			expected:  "okta",
+		},
+		{
+			desc:           "organization takes precedence",
+			providers:      []auth.OIDCProviderConfig{okta, keycloak},
+			email:          "alice@example.com",
+			organizationID: "org-1",
+			expected:       "keycloak",
+		},
+		{
+			desc:      "fallback to the default provider",
+			providers: []auth.OIDCProviderConfig{okta, keycloak, fallback},
+			email:     "bob@other.com",
+			expected:  "fallback",
+		},
+		{
+			desc:        "err: not found",
+			providers:   []auth.OIDCProviderConfig{okta, keycloak},
+			email:       "bob@other.com",
+			expectedErr: ErrProviderNotFound,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			a := newTestAuthenticator(t, p.providers...)
+			actual, err := a.ResolveProvider(p.email, p.organizationID)
+			assert.Equal(t, p.expectedErr, err)
+			assert.Equal(t, p.expected, actual)
+		})
+	}
+}
+
+func TestLoginAndExchange(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc        string
+		setup       func(*fakeProvider, *auth.OIDCProviderConfig)
+		state       string
+		expected    *auth.UserInfo
+		expectedErr error
+		// The token endpoint errors are returned as they are by the oauth2 package.
+		expectedAnyErr bool
+	}{
+		{
+			desc:  "success",
+			setup: func(*fakeProvider, *auth.OIDCProviderConfig) {},
+			state: testState,
+			expected: &auth.UserInfo{
+				Name:          "Alice Smith",
+				FirstName:     "Alice",
+				LastName:      "Smith",
+				Avatar:        "https://example.com/alice.png",
+				Email:         "alice@example.com",
+				VerifiedEmail: true,
+			},
+		},
+		{
+			desc: "success: custom claim mapping",
+			setup: func(f *fakeProvider, c *auth.OIDCProviderConfig) {
+				f.idClaims["preferred_username"] = "alice"
+				f.idClaims["profile"] = map[string]interface{}{"avatar": "https://example.com/a.png"}
+				c.ClaimMapping = auth.OIDCClaimMapping{
+					Name:   "preferred_username",
+					Avatar: "profile.avatar",
+				}
+			},
+			state: testState,
+			expected: &auth.UserInfo{
+				Name:          "alice",
+				FirstName:     "Alice",
+				LastName:      "Smith",
+				Avatar:        "https://example.com/a.png",
+				Email:         "alice@example.com",
+				VerifiedEmail: true,
+			},
+		},
+		{
+			desc: "success: claims from the user info endpoint",
+			setup: func(f *fakeProvider, _ *auth.OIDCProviderConfig) {
+				delete(f.idClaims, "email")
+				delete(f.idClaims, "email_verified")
+				f.userInfo = map[string]interface{}{
+					"sub":            "user-1",
+					"email":          "alice@example.com",
+					"email_verified": "true",
+				}
+			},
+			state: testState,
+			expected: &auth.UserInfo{
+				Name:          "Alice Smith",
+				FirstName:     "Alice",
+				LastName:      "Smith",
+				Avatar:        "https://example.com/alice.png",
+				Email:         "alice@example.com",
+				VerifiedEmail: true,
+			},
+		},
+		{
+			desc:           "err: different state fails the pkce verification",
+			setup:          func(*fakeProvider, *auth.OIDCProviderConfig) {},
+			state:          "other-state",
+			expectedAnyErr: true,
+		},
+		{
+			desc: "err: user info subject mismatch",
+			setup: func(f *fakeProvider, _ *auth.OIDCProviderConfig) {
+				delete(f.idClaims, "email")
+				f.userInfo = map[string]interface{}{"sub": "user-2", "email": "mallory@example.com"}
+			},
+			state:       testState,
+			expectedErr: ErrInvalidIDToken,
+		},
+		{
+			desc: "err: expired id token",
+			setup: func(f *fakeProvider, _ *auth.OIDCProviderConfig) {
+				f.idClaims["exp"] = time.Now().Add(-time.Hour).Unix()
+			},
+			state:       testState,
+			expectedErr: ErrInvalidIDToken,
+		},
+		{
+			desc: "err: different audience",
+			setup: func(f *fakeProvider, _ *auth.OIDCProviderConfig) {
+				f.idClaims["aud"] = "other-client"
+			},
+			state:       testState,
+			expectedErr: ErrInvalidIDToken,
+		},
+		{
+			desc: "err: unverified email",
+			setup: func(f *fakeProvider, _ *auth.OIDCProviderConfig) {
+				f.idClaims["email_verified"] = false
+			},
+			state:       testState,
+			expectedErr: ErrUnverifiedEmail,
+		},
+		{
+			desc: "err: missing email_verified claim",
+			setup: func(f *fakeProvider, _ *auth.OIDCProviderConfig) {
+				delete(f.idClaims, "email_verified")
+			},
+			state:       testState,
+			expectedErr: ErrUnverifiedEmail,
+		},
+		{
+			desc: "success: missing email_verified claim with a trusted provider",
+			setup: func(f *fakeProvider, c *auth.OIDCProviderConfig) {
+				delete(f.idClaims, "email_verified")
+				c.TrustUnverifiedEmail = true
+			},
+			state: testState,
+			expected: &auth.UserInfo{
+				Name:          "Alice Smith",
+				FirstName:     "Alice",
+				LastName:      "Smith",
+				Avatar:        "https://example.com/alice.png",
+				Email:         "alice@example.com",
+				VerifiedEmail: false,
+			},
+		},
+		{
+			desc: "err: email domain not allowed",
+			setup: func(_ *fakeProvider, c *auth.OIDCProviderConfig) {
+				c.EmailDomains = []string{"bucketeer.io"}
+			},
+			state:       testState,
+			expectedErr: ErrUnallowedEmailDomain,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			f := newFakeProvider(t)
+			f.idClaims = f.defaultClaims()
+			config := providerConfig("okta", f.server.URL)
+			p.setup(f, &config)
+			a := newTestAuthenticator(t, config)
+			ctx := context.Background()
+
+			login, err := a.Session("okta", testState)
+			require.NoError(t, err)
+			loginURL, err := login.Login(ctx, testState, testRedirectURL)
+			require.NoError(t, err)
+			f.authorize(loginURL)
+			assert.Equal(t, testClientID, f.authURL.Query().Get("client_id"))
+			assert.Equal(t, testState, f.authURL.Query().Get("state"))
+
+			exchange, err := a.Session("okta", p.state)
+			require.NoError(t, err)
+			actual, err := exchange.Exchange(ctx, testCode, testRedirectURL)
+			if p.expectedAnyErr {
+				assert.Error(t, err)
+				assert.Nil(t, actual)
+				return
+			}
+			assert.Equal(t, p.expectedErr, err)
+			assert.Equal(t, p.expected, actual)
+		})
+	}
+}
+
+func TestExchangeUnregisteredRedirectURL(t *testing.T) {
+	t.Parallel()
+	a := newTestAuthenticator(t, providerConfig("okta", "https://okta.example.com"))
+	session, err := a.Session("okta", testState)
+	require.NoError(t, err)
+	_, err = session.Exchange(context.Background(), testCode, "https://evil.example.com/callback")
+	assert.Equal(t, ErrUnregisteredRedirectURL, err)
+	_, err = a.Session("unknown", testState)
+	assert.Equal(t, ErrProviderNotFound, err)
+}
+
+func TestGetKeysRefreshesUnknownKey(t *testing.T) {
+	t.Parallel()
+	f := newFakeProvider(t)
+	config := providerConfig("okta", f.server.URL)
+	now := time.Now()
+	p := newProvider(&config, []byte("secret"), http.DefaultClient, func() time.Time { return now }, zap.NewNop())
+	ctx := context.Background()
+	doc, err := p.getDiscovery(ctx)
+	require.NoError(t, err)
+
+	_, err = p.getKeys(ctx, doc, testKeyID)
+	require.NoError(t, err)
+	_, err = p.getKeys(ctx, doc, testKeyID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, f.jwksCalls)
+
+	// Unknown keys don't trigger a refresh within the interval.
+	_, err = p.getKeys(ctx, doc, "rotated")
+	assert.Error(t, err)
+	assert.Equal(t, 1, f.jwksCalls)
+
+	now = now.Add(jwksRefreshInterval)
+	_, err = p.getKeys(ctx, doc, "rotated")
+	assert.Error(t, err)
+	assert.Equal(t, 2, f.jwksCalls)
+}
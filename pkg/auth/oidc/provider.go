@@ -0,0 +1,511 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	jose "github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/auth"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+	// The maximum clock skew allowed when validating the ID token.
+	idTokenLeeway = time.Minute
+	// The keys are fetched again when the ID token is signed with an unknown key,
+	// but not more often than this interval to avoid hammering the provider.
+	jwksRefreshInterval = time.Minute
+	maxResponseSize     = 1 << 20
+)
+
+var (
+	defaultScopes = []string{"openid", "email", "profile"}
+
+	supportedAlgorithms = []jose.SignatureAlgorithm{
+		jose.RS256, jose.RS384, jose.RS512,
+		jose.ES256, jose.ES384, jose.ES512,
+		jose.PS256, jose.PS384, jose.PS512,
+	}
+
+	defaultClaimMapping = auth.OIDCClaimMapping{
+		Name:          "name",
+		FirstName:     "given_name",
+		LastName:      "family_name",
+		Email:         "email",
+		EmailVerified: "email_verified",
+		Avatar:        "picture",
+	}
+)
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type provider struct {
+	config       *auth.OIDCProviderConfig
+	claimMapping auth.OIDCClaimMapping
+	verifierKey  []byte
+	httpClient   *http.Client
+	now          func() time.Time
+	logger       *zap.Logger
+
+	mu            sync.Mutex
+	discovery     *discoveryDocument
+	jwks          *jose.JSONWebKeySet
+	jwksFetchedAt time.Time
+}
+
+func newProvider(
+	config *auth.OIDCProviderConfig,
+	verifierKey []byte,
+	httpClient *http.Client,
+	now func() time.Time,
+	logger *zap.Logger,
+) *provider {
+	return &provider{
+		config:       config,
+		claimMapping: mergeClaimMapping(config.ClaimMapping),
+		verifierKey:  verifierKey,
+		httpClient:   httpClient,
+		now:          now,
+		logger:       logger.With(zap.String("providerId", config.ID)),
+	}
+}
+
+func (p *provider) login(
+	ctx context.Context,
+	state, redirectURL string,
+) (string, error) {
+	if err := p.validateRedirectURL(redirectURL); err != nil {
+		p.logger.Error("auth/oidc: failed to validate redirect url", zap.Error(err))
+		return "", err
+	}
+	doc, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+	return p.oauth2Config(doc, redirectURL).AuthCodeURL(
+		state,
+		oauth2.S256ChallengeOption(p.codeVerifier(state)),
+		oauth2.SetAuthURLParam("nonce", p.nonce(state)),
+	), nil
+}
+
+func (p *provider) exchange(
+	ctx context.Context,
+	code, state, redirectURL string,
+) (*auth.UserInfo, error) {
+	if err := p.validateRedirectURL(redirectURL); err != nil {
+		p.logger.Error("auth/oidc: failed to validate redirect url", zap.Error(err))
+		return nil, err
+	}
+	doc, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.httpClient)
+	oauth2Config := p.oauth2Config(doc, redirectURL)
+	token, err := oauth2Config.Exchange(ctx, code, oauth2.VerifierOption(p.codeVerifier(state)))
+	if err != nil {
+		p.logger.Error("auth/oidc: failed to exchange token", zap.Error(err))
+		return nil, err
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		p.logger.Error("auth/oidc: id token is missing in the token response")
+		return nil, ErrInvalidIDToken
+	}
+	claims, err := p.verifyIDToken(ctx, doc, rawIDToken, p.nonce(state))
+	if err != nil {
+		p.logger.Error("auth/oidc: failed to verify id token", zap.Error(err))
+		return nil, ErrInvalidIDToken
+	}
+	// Some providers, such as Okta, don't include the profile claims in the ID token
+	// unless configured, so we complement them using the user info endpoint.
+	if _, ok := lookupClaim(claims, p.claimMapping.Email); !ok && doc.UserinfoEndpoint != "" {
+		if err := p.mergeUserInfo(ctx, oauth2Config.Client(ctx, token), doc, claims); err != nil {
+			p.logger.Error("auth/oidc: failed to query user info", zap.Error(err))
+			return nil, err
+		}
+	}
+	userInfo, err := p.userInfo(claims)
+	if err != nil {
+		p.logger.Error("auth/oidc: failed to map the claims", zap.Error(err))
+		return nil, err
+	}
+	return userInfo, nil
+}
+
+func (p *provider) verifyIDToken(
+	ctx context.Context,
+	doc *discoveryDocument,
+	rawIDToken, nonce string,
+) (map[string]interface{}, error) {
+	token, err := jwt.ParseSigned(rawIDToken, supportedAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("malformed jwt: %w", err)
+	}
+	if len(token.Headers) != 1 {
+		return nil, errors.New("id token must have exactly one signature")
+	}
+	keys, err := p.getKeys(ctx, doc, token.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		standard jwt.Claims
+		claims   map[string]interface{}
+		errs     []error
+	)
+	verified := false
+	for i := range keys {
+		if err := token.Claims(keys[i], &standard, &claims); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		verified = true
+		break
+	}
+	if !verified {
+		return nil, fmt.Errorf("invalid jwt: %w", errors.Join(errs...))
+	}
+	expected := jwt.Expected{
+		Issuer:      doc.Issuer,
+		AnyAudience: jwt.Audience{p.config.ClientID},
+		Time:        p.now(),
+	}
+	if err := standard.ValidateWithLeeway(expected, idTokenLeeway); err != nil {
+		return nil, err
+	}
+	if standard.Expiry == nil {
+		return nil, errors.New("id token must have the expiry")
+	}
+	if len(standard.Audience) > 1 {
+		if azp, _ := claims["azp"].(string); azp != p.config.ClientID {
+			return nil, fmt.Errorf("unexpected authorized party %q", azp)
+		}
+	}
+	if got, _ := claims["nonce"].(string); got != nonce {
+		return nil, errors.New("nonce mismatch")
+	}
+	return claims, nil
+}
+
+func (p *provider) mergeUserInfo(
+	ctx context.Context,
+	client *http.Client,
+	doc *discoveryDocument,
+	claims map[string]interface{},
+) error {
+	userInfo := map[string]interface{}{}
+	if err := p.getJSON(ctx, client, doc.UserinfoEndpoint, &userInfo); err != nil {
+		return err
+	}
+	// The sub claim must match the ID token to prevent token substitution attacks.
+	if userInfo["sub"] != claims["sub"] {
+		return ErrInvalidIDToken
+	}
+	for k, v := range userInfo {
+		if _, ok := claims[k]; !ok {
+			claims[k] = v
+		}
+	}
+	return nil
+}
+
+func (p *provider) userInfo(claims map[string]interface{}) (*auth.UserInfo, error) {
+	email := stringClaim(claims, p.claimMapping.Email)
+	if email == "" {
+		return nil, ErrMissingEmail
+	}
+	// A missing claim is treated as unverified, because anyone may register any email
+	// on some providers unless they verify it.
+	verifiedEmail := false
+	if v, ok := lookupClaim(claims, p.claimMapping.EmailVerified); ok {
+		verifiedEmail = boolClaim(v)
+	}
+	if !verifiedEmail && !p.config.TrustUnverifiedEmail {
+		return nil, ErrUnverifiedEmail
+	}
+	if len(p.config.EmailDomains) > 0 && !containsFold(p.config.EmailDomains, emailDomain(email)) {
+		return nil, ErrUnallowedEmailDomain
+	}
+	return &auth.UserInfo{
+		Name:          stringClaim(claims, p.claimMapping.Name),
+		FirstName:     stringClaim(claims, p.claimMapping.FirstName),
+		LastName:      stringClaim(claims, p.claimMapping.LastName),
+		Avatar:        stringClaim(claims, p.claimMapping.Avatar),
+		Email:         email,
+		VerifiedEmail: verifiedEmail,
+	}, nil
+}
+
+func (p *provider) getDiscovery(ctx context.Context) (*discoveryDocument, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+	issuer := strings.TrimSuffix(p.config.Issuer, "/")
+	doc := &discoveryDocument{}
+	if err := p.getJSON(ctx, p.httpClient, issuer+discoveryPath, doc); err != nil {
+		p.logger.Error("auth/oidc: failed to load the discovery document", zap.Error(err))
+		return nil, ErrDiscoveryFailed
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != issuer {
+		p.logger.Error("auth/oidc: issuer mismatch in the discovery document",
+			zap.String("expected", p.config.Issuer),
+			zap.String("actual", doc.Issuer),
+		)
+		return nil, ErrDiscoveryFailed
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		p.logger.Error("auth/oidc: required endpoints are missing in the discovery document")
+		return nil, ErrDiscoveryFailed
+	}
+	p.discovery = doc
+	return doc, nil
+}
+
+func (p *provider) getKeys(
+	ctx context.Context,
+	doc *discoveryDocument,
+	keyID string,
+) ([]jose.JSONWebKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.jwks != nil {
+		if keys := findKeys(p.jwks, keyID); len(keys) > 0 {
+			return keys, nil
+		}
+		if p.now().Sub(p.jwksFetchedAt) < jwksRefreshInterval {
+			return nil, fmt.Errorf("unknown key id %q", keyID)
+		}
+	}
+	jwks := &jose.JSONWebKeySet{}
+	if err := p.getJSON(ctx, p.httpClient, doc.JWKSURI, jwks); err != nil {
+		return nil, fmt.Errorf("failed to fetch the keys: %w", err)
+	}
+	p.jwks = jwks
+	p.jwksFetchedAt = p.now()
+	keys := findKeys(jwks, keyID)
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("unknown key id %q", keyID)
+	}
+	return keys, nil
+}
+
+func (p *provider) getJSON(
+	ctx context.Context,
+	client *http.Client,
+	url string,
+	dst interface{},
+) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, url)
+	}
+	return json.Unmarshal(body, dst)
+}
+
+// codeVerifier derives the PKCE code verifier from the state,
+// so we don't need to store it between the login and the exchange.
+// The state is public, but the verifier can't be computed without the key.
+func (p *provider) codeVerifier(state string) string {
+	return p.derive("code_verifier", state)
+}
+
+func (p *provider) nonce(state string) string {
+	return p.derive("nonce", state)
+}
+
+func (p *provider) derive(purpose, state string) string {
+	mac := hmac.New(sha256.New, p.verifierKey)
+	mac.Write([]byte(purpose))
+	mac.Write([]byte{0})
+	mac.Write([]byte(p.config.ID))
+	mac.Write([]byte{0})
+	mac.Write([]byte(state))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (p *provider) validateRedirectURL(url string) error {
+	for _, r := range p.config.RedirectURLs {
+		if r == url {
+			return nil
+		}
+	}
+	return ErrUnregisteredRedirectURL
+}
+
+func (p *provider) oauth2Config(doc *discoveryDocument, redirectURL string) *oauth2.Config {
+	scopes := p.config.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultScopes
+	}
+	return &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  doc.AuthorizationEndpoint,
+			TokenURL: doc.TokenEndpoint,
+		},
+		Scopes:      scopes,
+		RedirectURL: redirectURL,
+	}
+}
+
+func (p *provider) matchesOrganization(organizationID string) bool {
+	for _, id := range p.config.OrganizationIDs {
+		if id == organizationID {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *provider) matchesEmailDomain(domain string) bool {
+	return containsFold(p.config.EmailDomains, domain)
+}
+
+func (p *provider) isDefault() bool {
+	return len(p.config.EmailDomains) == 0 && len(p.config.OrganizationIDs) == 0
+}
+
+func findKeys(jwks *jose.JSONWebKeySet, keyID string) []jose.JSONWebKey {
+	if keyID != "" {
+		return jwks.Key(keyID)
+	}
+	keys := make([]jose.JSONWebKey, 0, len(jwks.Keys))
+	for _, k := range jwks.Keys {
+		if k.Use == "" || k.Use == "sig" {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+func mergeClaimMapping(mapping auth.OIDCClaimMapping) auth.OIDCClaimMapping {
+	if mapping.Name == "" {
+		mapping.Name = defaultClaimMapping.Name
+	}
+	if mapping.FirstName == "" {
+		mapping.FirstName = defaultClaimMapping.FirstName
+	}
+	if mapping.LastName == "" {
+		mapping.LastName = defaultClaimMapping.LastName
+	}
+	if mapping.Email == "" {
+		mapping.Email = defaultClaimMapping.Email
+	}
+	if mapping.EmailVerified == "" {
+		mapping.EmailVerified = defaultClaimMapping.EmailVerified
+	}
+	if mapping.Avatar == "" {
+		mapping.Avatar = defaultClaimMapping.Avatar
+	}
+	return mapping
+}
+
+func lookupClaim(claims map[string]interface{}, path string) (interface{}, bool) {
+	if v, ok := claims[path]; ok {
+		return v, true
+	}
+	var current interface{} = claims
+	for _, key := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func stringClaim(claims map[string]interface{}, path string) string {
+	v, ok := lookupClaim(claims, path)
+	if !ok {
+		return ""
+	}
+	s, _ := v.(string)
+	return s
+}
+
+// boolClaim accepts string values as well since some providers, such as Amazon Cognito,
+// return the email_verified claim as a string.
+func boolClaim(v interface{}) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case string:
+		return strings.EqualFold(b, "true")
+	}
+	return false
+}
+
+func emailDomain(email string) string {
+	idx := strings.LastIndex(email, "@")
+	if idx < 0 {
+		return ""
+	}
+	return email[idx+1:]
+}
+
+func containsFold(values []string, s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
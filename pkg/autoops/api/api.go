@@ -34,6 +34,7 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/autoops/domain"
 	v2as "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/storage/v2"
 	domainevent "github.com/bucketeer-io/bucketeer/v2/pkg/domainevent/domain"
+	environmentclient "github.com/bucketeer-io/bucketeer/v2/pkg/environment/client"
 	experimentclient "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/client"
 	featureclient "github.com/bucketeer-io/bucketeer/v2/pkg/feature/client"
 	v2fs "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2"
@@ -45,6 +46,7 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database"
 	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
 	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
+	envproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
 	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
 	experimentproto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
 )
@@ -62,18 +64,19 @@ func WithLogger(l *zap.Logger) Option {
 }
 
 type AutoOpsService struct {
-	dbClient         database.Client
-	opsCountStorage  v2os.OpsCountStorage
-	autoOpsStorage   v2as.AutoOpsRuleStorage
-	prStorage        v2as.ProgressiveRolloutStorage
-	featureStorage   v2fs.FeatureStorage
-	featureClient    featureclient.Client
-	experimentClient experimentclient.Client
-	accountClient    accountclient.Client
-	authClient       authclient.Client
-	publisher        publisher.Publisher
-	opts             *options
-	logger           *zap.Logger
+	dbClient          database.Client
+	opsCountStorage   v2os.OpsCountStorage
+	autoOpsStorage    v2as.AutoOpsRuleStorage
+	prStorage         v2as.ProgressiveRolloutStorage
+	featureStorage    v2fs.FeatureStorage
+	featureClient     featureclient.Client
+	experimentClient  experimentclient.Client
+	environmentClient environmentclient.Client
+	accountClient     accountclient.Client
+	authClient        authclient.Client
+	publisher         publisher.Publisher
+	opts              *options
+	logger            *zap.Logger
 }
 
 func NewAutoOpsService(
@@ -84,6 +87,7 @@ func NewAutoOpsService(
 	featureStorage v2fs.FeatureStorage,
 	featureClient featureclient.Client,
 	experimentClient experimentclient.Client,
+	environmentClient environmentclient.Client,
 	accountClient accountclient.Client,
 	authClient authclient.Client,
 	publisher publisher.Publisher,
@@ -96,18 +100,19 @@ func NewAutoOpsService(
 		opt(dopts)
 	}
 	return &AutoOpsService{
-		dbClient:         dbClient,
-		opsCountStorage:  opsCountStorage,
-		featureStorage:   featureStorage,
-		autoOpsStorage:   autoOpsStorage,
-		prStorage:        prStorage,
-		featureClient:    featureClient,
-		experimentClient: experimentClient,
-		accountClient:    accountClient,
-		authClient:       authClient,
-		publisher:        publisher,
-		opts:             dopts,
-		logger:           dopts.logger.Named("api"),
+		dbClient:          dbClient,
+		opsCountStorage:   opsCountStorage,
+		featureStorage:    featureStorage,
+		autoOpsStorage:    autoOpsStorage,
+		prStorage:         prStorage,
+		featureClient:     featureClient,
+		experimentClient:  experimentClient,
+		environmentClient: environmentClient,
+		accountClient:     accountClient,
+		authClient:        authClient,
+		publisher:         publisher,
+		opts:              dopts,
+		logger:            dopts.logger.Named("api"),
 	}
 }
 
@@ -119,9 +124,10 @@ func (s *AutoOpsService) CreateAutoOpsRule(
 	ctx context.Context,
 	req *autoopsproto.CreateAutoOpsRuleRequest,
 ) (*autoopsproto.CreateAutoOpsRuleResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_AUTOOPS_RULE,
+		Action:       accountproto.Permission_CREATE,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -458,9 +464,11 @@ func (s *AutoOpsService) StopAutoOpsRule(
 	ctx context.Context,
 	req *autoopsproto.StopAutoOpsRuleRequest,
 ) (*autoopsproto.StopAutoOpsRuleResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_AUTOOPS_RULE,
+		Action:       accountproto.Permission_UPDATE,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -520,9 +528,11 @@ func (s *AutoOpsService) DeleteAutoOpsRule(
 	ctx context.Context,
 	req *autoopsproto.DeleteAutoOpsRuleRequest,
 ) (*autoopsproto.DeleteAutoOpsRuleResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_AUTOOPS_RULE,
+		Action:       accountproto.Permission_DELETE,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -581,9 +591,11 @@ func (s *AutoOpsService) UpdateAutoOpsRule(
 	ctx context.Context,
 	req *autoopsproto.UpdateAutoOpsRuleRequest,
 ) (*autoopsproto.UpdateAutoOpsRuleResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_AUTOOPS_RULE,
+		Action:       accountproto.Permission_UPDATE,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -808,9 +820,11 @@ func (s *AutoOpsService) GetAutoOpsRule(
 	ctx context.Context,
 	req *autoopsproto.GetAutoOpsRuleRequest,
 ) (*autoopsproto.GetAutoOpsRuleResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_AUTOOPS_RULE,
+		Action:       accountproto.Permission_READ,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -845,9 +859,10 @@ func (s *AutoOpsService) ListAutoOpsRules(
 	ctx context.Context,
 	req *autoopsproto.ListAutoOpsRulesRequest,
 ) (*autoopsproto.ListAutoOpsRulesResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_AUTOOPS_RULE,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -903,9 +918,10 @@ func (s *AutoOpsService) ExecuteAutoOps(
 	ctx context.Context,
 	req *autoopsproto.ExecuteAutoOpsRequest,
 ) (*autoopsproto.ExecuteAutoOpsResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_AUTOOPS_RULE,
+		Action:       accountproto.Permission_UPDATE,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -946,6 +962,13 @@ func (s *AutoOpsService) ExecuteAutoOps(
 		if err != nil {
 			return err
 		}
+		// Enabling a flag exposes new behavior, so protected environments require a change request.
+		// Disabling is exempt from change approval so it can be used as a kill switch.
+		if executeClause.ActionType == autoopsproto.ActionType_ENABLE {
+			if err := s.checkDirectChangeAllowed(ctx, req.EnvironmentId); err != nil {
+				return err
+			}
+		}
 		// Stop the running progressive rollout if the operation type is disable
 		if executeClause.ActionType == autoopsproto.ActionType_DISABLE {
 			if err := s.stopProgressiveRollout(
@@ -1081,7 +1104,7 @@ func (s *AutoOpsService) stopProgressiveRollout(
 	} else {
 		stoppedBy = autoopsproto.ProgressiveRollout_OPS_KILL_SWITCH
 	}
-	if err := executeStopProgressiveRolloutOperation(
+	if _, err := executeStopProgressiveRolloutOperation(
 		ctx,
 		s.prStorage,
 		[]string{autoOpsRule.FeatureId},
@@ -1159,9 +1182,10 @@ func (s *AutoOpsService) ListOpsCounts(
 	ctx context.Context,
 	req *autoopsproto.ListOpsCountsRequest,
 ) (*autoopsproto.ListOpsCountsResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_AUTOOPS_RULE,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1249,28 +1273,41 @@ func (s *AutoOpsService) getGoal(
 	return resp.Goal, nil
 }
 
-func (s *AutoOpsService) checkEnvironmentRole(
+func (s *AutoOpsService) checkPermission(
 	ctx context.Context,
-	requiredRole accountproto.AccountV2_Role_Environment,
 	environmentId string,
+	permissions ...role.Permission,
 ) (*eventproto.Editor, error) {
-	return role.CheckEnvironmentRoleWithLog(
+	return role.CheckPermissionWithLog(
 		ctx,
-		requiredRole,
 		environmentId,
-		func(email string) (*accountproto.AccountV2, error) {
-			resp, err := s.accountClient.GetAccountV2ByEnvironmentID(ctx, &accountproto.GetAccountV2ByEnvironmentIDRequest{
+		func(email string) (*accountproto.GetAccountV2ByEnvironmentIDResponse, error) {
+			return s.accountClient.GetAccountV2ByEnvironmentID(ctx, &accountproto.GetAccountV2ByEnvironmentIDRequest{
 				Email:         email,
 				EnvironmentId: environmentId,
 			})
-			if err != nil {
-				return nil, err
-			}
-			return resp.Account, nil
 		},
 		s.logger,
 		statusUnauthenticated.Err(),
 		statusPermissionDenied.Err(),
 		func(err error) error { return api.NewGRPCStatus(err).Err() },
+		permissions...,
 	)
 }
+
+// checkDirectChangeAllowed rejects operations that would change a flag without the change approval
+// required by a protected environment.
+// Operations that only stop the exposure of a flag (disable operations, stopping progressive rollouts
+// and guardrail halts) don't call it, because blocking them would block incident response.
+func (s *AutoOpsService) checkDirectChangeAllowed(ctx context.Context, environmentId string) error {
+	resp, err := s.environmentClient.GetEnvironmentV2(ctx, &envproto.GetEnvironmentV2Request{
+		Id: environmentId,
+	})
+	if err != nil {
+		return api.NewGRPCStatus(err).Err()
+	}
+	if resp.Environment.RequireChangeApproval {
+		return statusChangeApprovalRequired.Err()
+	}
+	return nil
+}
@@ -32,9 +32,11 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/autoops/domain"
 	v2ao "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/storage/v2"
 	mockAutoOpsStorage "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/storage/v2/mock"
+	envclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/environment/client/mock"
 	bkterr "github.com/bucketeer-io/bucketeer/v2/pkg/error"
 	experimentclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/client/mock"
 	featureclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/feature/client/mock"
+	ftdomain "github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
 	mockFeatureStorage "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2/mock"
 	mockOpsCountStorage "github.com/bucketeer-io/bucketeer/v2/pkg/opsevent/storage/v2/mock"
 	publishermock "github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher/mock"
@@ -44,7 +46,9 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/token"
 	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
 	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
+	envproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
 	experimentproto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
 )
 
 func TestNewAutoOpsService(t *testing.T) {
@@ -70,6 +74,7 @@ func TestNewAutoOpsService(t *testing.T) {
 		featureStorageMock,
 		featureClientMock,
 		experimentClientMock,
+		envclientmock.NewMockClient(mockController),
 		accountClientMock,
 		authClientMock,
 		p,
@@ -1085,6 +1090,104 @@ func TestExecuteAutoOpsRuleMySQL(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			desc: "err: enable requires change approval",
+			setup: func(s *AutoOpsService) {
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				s.autoOpsStorage.(*mockAutoOpsStorage.MockAutoOpsRuleStorage).EXPECT().GetAutoOpsRule(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(&domain.AutoOpsRule{
+					AutoOpsRule: &autoopsproto.AutoOpsRule{
+						Id:            "aid1",
+						FeatureId:     "fid",
+						OpsType:       autoopsproto.OpsType_SCHEDULE,
+						AutoOpsStatus: autoopsproto.AutoOpsStatus_RUNNING,
+						Clauses: []*autoopsproto.Clause{
+							{Id: "testClauseId", ActionType: autoopsproto.ActionType_ENABLE, Clause: &anypb.Any{}},
+						},
+					},
+				}, nil).AnyTimes()
+				s.featureStorage.(*mockFeatureStorage.MockFeatureStorage).EXPECT().GetFeature(
+					gomock.Any(), "fid", "ns0",
+				).Return(&ftdomain.Feature{Feature: &featureproto.Feature{Id: "fid"}}, nil)
+				expectGetEnvironment(s, &envproto.EnvironmentV2{Id: "ns0", RequireChangeApproval: true})
+			},
+			req: &autoopsproto.ExecuteAutoOpsRequest{
+				Id:            "aid1",
+				EnvironmentId: "ns0",
+				ClauseId:      "testClauseId",
+			},
+			expectedErr: statusChangeApprovalRequired.Err(),
+		},
+		{
+			// Disabling is exempt from change approval so it can be used as a kill switch,
+			// so the environment is never looked up.
+			desc: "success: disable is exempt from change approval",
+			setup: func(s *AutoOpsService) {
+				clause, err := anypb.New(&autoopsproto.DatetimeClause{
+					Time:       time.Now().Unix(),
+					ActionType: autoopsproto.ActionType_DISABLE,
+				})
+				assert.NoError(t, err)
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				s.autoOpsStorage.(*mockAutoOpsStorage.MockAutoOpsRuleStorage).EXPECT().GetAutoOpsRule(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(_ context.Context, _, _ string) (*domain.AutoOpsRule, error) {
+					return &domain.AutoOpsRule{
+						AutoOpsRule: &autoopsproto.AutoOpsRule{
+							Id:            "aid1",
+							FeatureId:     "fid",
+							OpsType:       autoopsproto.OpsType_SCHEDULE,
+							AutoOpsStatus: autoopsproto.AutoOpsStatus_RUNNING,
+							Clauses: []*autoopsproto.Clause{
+								{Id: "testClauseId", ActionType: autoopsproto.ActionType_DISABLE, Clause: clause},
+							},
+						},
+					}, nil
+				}).AnyTimes()
+				s.featureStorage.(*mockFeatureStorage.MockFeatureStorage).EXPECT().GetFeature(
+					gomock.Any(), "fid", "ns0",
+				).Return(&ftdomain.Feature{Feature: &featureproto.Feature{
+					Id:           "fid",
+					Enabled:      true,
+					Variations:   []*featureproto.Variation{{Id: "vid1", Value: "true"}, {Id: "vid2", Value: "false"}},
+					OffVariation: "vid2",
+					DefaultStrategy: &featureproto.Strategy{
+						Type:          featureproto.Strategy_FIXED,
+						FixedStrategy: &featureproto.FixedStrategy{Variation: "vid1"},
+					},
+				}}, nil)
+				s.prStorage.(*mockAutoOpsStorage.MockProgressiveRolloutStorage).EXPECT().ListProgressiveRollouts(
+					gomock.Any(), gomock.Any(),
+				).Return([]*autoopsproto.ProgressiveRollout{}, int64(0), 0, nil)
+				s.featureStorage.(*mockFeatureStorage.MockFeatureStorage).EXPECT().UpdateFeature(
+					gomock.Any(), gomock.Any(), "ns0",
+				).DoAndReturn(func(_ context.Context, f *ftdomain.Feature, _ string) error {
+					assert.False(t, f.Enabled)
+					return nil
+				})
+				s.publisher.(*publishermock.MockPublisher).EXPECT().Publish(
+					gomock.Any(), gomock.Any(),
+				).Return(nil).AnyTimes()
+				s.autoOpsStorage.(*mockAutoOpsStorage.MockAutoOpsRuleStorage).EXPECT().UpdateAutoOpsRule(
+					gomock.Any(), gomock.Any(), "ns0",
+				).Return(nil)
+			},
+			req: &autoopsproto.ExecuteAutoOpsRequest{
+				Id:            "aid1",
+				EnvironmentId: "ns0",
+				ClauseId:      "testClauseId",
+			},
+			expectedErr: nil,
+		},
 	}
 	for _, p := range patterns {
 		t.Run(p.desc, func(t *testing.T) {
@@ -1151,6 +1254,13 @@ func TestExistGoal(t *testing.T) {
 	}
 }
 
+func expectGetEnvironment(s *AutoOpsService, env *envproto.EnvironmentV2) {
+	s.environmentClient.(*envclientmock.MockClient).EXPECT().GetEnvironmentV2(
+		gomock.Any(),
+		&envproto.GetEnvironmentV2Request{Id: env.Id},
+	).Return(&envproto.GetEnvironmentV2Response{Environment: env}, nil)
+}
+
 func createAutoOpsService(c *gomock.Controller) *AutoOpsService {
 	featureClientMock := featureclientmock.NewMockClient(c)
 	accountClientMock := accountclientmock.NewMockClient(c)
@@ -1176,16 +1286,17 @@ func createAutoOpsService(c *gomock.Controller) *AutoOpsService {
 	p := publishermock.NewMockPublisher(c)
 	logger := zap.NewNop()
 	return &AutoOpsService{
-		dbClient:         dbmock.NewMockClient(c),
-		featureStorage:   mockFeatureStorage.NewMockFeatureStorage(c),
-		autoOpsStorage:   mockAutoOpsStorage.NewMockAutoOpsRuleStorage(c),
-		prStorage:        mockAutoOpsStorage.NewMockProgressiveRolloutStorage(c),
-		opsCountStorage:  mockOpsCountStorage.NewMockOpsCountStorage(c),
-		featureClient:    featureClientMock,
-		experimentClient: experimentClientMock,
-		accountClient:    accountClientMock,
-		authClient:       authClientMock,
-		publisher:        p,
+		dbClient:          dbmock.NewMockClient(c),
+		featureStorage:    mockFeatureStorage.NewMockFeatureStorage(c),
+		autoOpsStorage:    mockAutoOpsStorage.NewMockAutoOpsRuleStorage(c),
+		prStorage:         mockAutoOpsStorage.NewMockProgressiveRolloutStorage(c),
+		opsCountStorage:   mockOpsCountStorage.NewMockOpsCountStorage(c),
+		featureClient:     featureClientMock,
+		experimentClient:  experimentClientMock,
+		environmentClient: envclientmock.NewMockClient(c),
+		accountClient:     accountClientMock,
+		authClient:        authClientMock,
+		publisher:         p,
 		opts: &options{
 			logger: zap.NewNop(),
 		},
@@ -1214,15 +1325,16 @@ func createServiceWithGetAccountByEnvironmentMock(c *gomock.Controller, ro accou
 	p := publishermock.NewMockPublisher(c)
 	logger := zap.NewNop()
 	return &AutoOpsService{
-		dbClient:         dbmock.NewMockClient(c),
-		autoOpsStorage:   mockAutoOpsStorage.NewMockAutoOpsRuleStorage(c),
-		prStorage:        mockAutoOpsStorage.NewMockProgressiveRolloutStorage(c),
-		opsCountStorage:  mockOpsCountStorage.NewMockOpsCountStorage(c),
-		featureClient:    featureClientMock,
-		experimentClient: experimentClientMock,
-		accountClient:    accountClientMock,
-		authClient:       authClientMock,
-		publisher:        p,
+		dbClient:          dbmock.NewMockClient(c),
+		autoOpsStorage:    mockAutoOpsStorage.NewMockAutoOpsRuleStorage(c),
+		prStorage:         mockAutoOpsStorage.NewMockProgressiveRolloutStorage(c),
+		opsCountStorage:   mockOpsCountStorage.NewMockOpsCountStorage(c),
+		featureClient:     featureClientMock,
+		experimentClient:  experimentClientMock,
+		environmentClient: envclientmock.NewMockClient(c),
+		accountClient:     accountClientMock,
+		authClient:        authClientMock,
+		publisher:         p,
 		opts: &options{
 			logger: zap.NewNop(),
 		},
@@ -288,4 +288,25 @@ var (
 	statusProgressiveRolloutScheduleIDRequired = api.NewGRPCStatus(
 		pkgErr.NewErrorInvalidArgEmpty(
 			pkgErr.AutoopsPackageName, "schedule id must be specified for a progressive rollout", "Schedule"))
+	statusChangeApprovalRequired = api.NewGRPCStatus(
+		pkgErr.NewErrorFailedPrecondition(
+			pkgErr.AutoopsPackageName,
+			"environment requires change approval, the flag must be changed through a change request",
+		))
+	statusGuardrailGoalNotFound = api.NewGRPCStatus(
+		pkgErr.NewErrorNotFound(pkgErr.AutoopsPackageName, "guardrail goal does not exist", "Goal"))
+	statusGuardrailGoalIDRequired = api.NewGRPCStatus(
+		pkgErr.NewErrorInvalidArgEmpty(pkgErr.AutoopsPackageName, "guardrail goal id must be specified", "Goal"))
+	statusGuardrailControlVariationIDRequired = api.NewGRPCStatus(
+		pkgErr.NewErrorInvalidArgEmpty(
+			pkgErr.AutoopsPackageName, "control variation id must be specified for a guardrail", "ControlVariationId"))
+	statusGuardrailVariationIDRequired = api.NewGRPCStatus(
+		pkgErr.NewErrorInvalidArgEmpty(
+			pkgErr.AutoopsPackageName, "variation id must be specified for a guardrail", "VariationId"))
+	statusGuardrailControlVariationNotFound = api.NewGRPCStatus(
+		pkgErr.NewErrorInvalidArgEmpty(
+			pkgErr.AutoopsPackageName,
+			"the control variation id set in the guardrail does not exist in the feature",
+			"control_variation_id",
+		))
 )
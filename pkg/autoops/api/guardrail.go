@@ -0,0 +1,243 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/autoops/domain"
+	domainevent "github.com/bucketeer-io/bucketeer/v2/pkg/domainevent/domain"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
+	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+// ExecuteGuardrailHalt halts the rollout of a flag after one of its guardrail
+// goals regressed. Like a kill switch, it stops the waiting or running
+// progressive rollouts of the flag, but instead of disabling the flag it
+// switches the default strategy and the rule strategies to the control variation
+// so that every user falls back to the known-good behavior.
+// Like a kill switch, it is exempt from the change approval of protected environments
+// because it only stops the exposure of the new variations.
+func (s *AutoOpsService) ExecuteGuardrailHalt(
+	ctx context.Context,
+	req *autoopsproto.ExecuteGuardrailHaltRequest,
+) (*autoopsproto.ExecuteGuardrailHaltResponse, error) {
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_AUTOOPS_RULE,
+		Action:       accountproto.Permission_UPDATE,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := validateExecuteGuardrailHaltRequest(req); err != nil {
+		return nil, err
+	}
+	var alreadyHalted bool
+	var events []publisher.Message
+	err = s.dbClient.RunInTransactionV2(ctx, func(contextWithTx context.Context) error {
+		feature, err := s.featureStorage.GetFeature(contextWithTx, req.FeatureId, req.EnvironmentId)
+		if err != nil {
+			return err
+		}
+		if !s.existVariationID(feature.Feature, req.ControlVariationId) {
+			return statusGuardrailControlVariationNotFound.Err()
+		}
+		stopped, err := executeStopProgressiveRolloutOperation(
+			contextWithTx,
+			s.prStorage,
+			[]string{req.FeatureId},
+			req.EnvironmentId,
+			autoopsproto.ProgressiveRollout_OPS_GUARDRAIL,
+		)
+		if err != nil {
+			return err
+		}
+		controlStrategy := &featureproto.Strategy{
+			Type: featureproto.Strategy_FIXED,
+			FixedStrategy: &featureproto.FixedStrategy{
+				Variation: req.ControlVariationId,
+			},
+		}
+		// The rules are switched to the control variation as well, so that the halt covers every user
+		// like a kill switch does. Otherwise the users matching a rule would keep receiving the regressed variation.
+		ruleChanges := controlRuleChanges(feature.Rules, controlStrategy)
+		servesControl := proto.Equal(feature.DefaultStrategy, controlStrategy) && len(ruleChanges) == 0
+		if servesControl && len(stopped) == 0 {
+			alreadyHalted = true
+			return nil
+		}
+		comment := fmt.Sprintf("Halted by guardrail goal %s", req.GoalId)
+		if !servesControl {
+			updated, err := feature.Update(
+				nil, // name
+				nil, // description
+				nil, // tags
+				nil, // enabled
+				nil, // archived
+				controlStrategy,
+				nil,   // offVariation
+				false, // resetSamplingSeed
+				nil,   // prerequisiteChanges
+				nil,   // targetChanges
+				ruleChanges,
+				nil, // variationChanges
+				nil, // tagChanges
+				nil, // maintainer
+				nil, // ruleOrder
+				nil, // variationValueSchemaUpdate
+			)
+			if err != nil {
+				return err
+			}
+			if err := s.featureStorage.UpdateFeature(contextWithTx, updated, req.EnvironmentId); err != nil {
+				return err
+			}
+			updateFeatureEvent, err := domainevent.NewEvent(
+				editor,
+				eventproto.Event_FEATURE,
+				updated.Id,
+				eventproto.Event_FEATURE_UPDATED,
+				&eventproto.FeatureUpdatedEvent{
+					Id: updated.Id,
+				},
+				req.EnvironmentId,
+				updated.Feature,
+				feature.Feature,
+				domainevent.WithComment(comment),
+				domainevent.WithNewVersion(updated.Version),
+			)
+			if err != nil {
+				return err
+			}
+			events = append(events, updateFeatureEvent)
+		}
+		triggered := &eventproto.GuardrailTriggeredEvent{
+			FeatureId:            req.FeatureId,
+			GoalId:               req.GoalId,
+			ControlVariationId:   req.ControlVariationId,
+			VariationId:          req.VariationId,
+			ProbabilityWorse:     req.ProbabilityWorse,
+			ExperimentId:         req.ExperimentId,
+			ProgressiveRolloutId: req.ProgressiveRolloutId,
+		}
+		for _, r := range stopped {
+			triggered.StoppedProgressiveRolloutIds = append(triggered.StoppedProgressiveRolloutIds, r.Id)
+		}
+		guardrailEvent, err := domainevent.NewEvent(
+			editor,
+			eventproto.Event_AUTOOPS_RULE,
+			req.FeatureId,
+			eventproto.Event_GUARDRAIL_TRIGGERED,
+			triggered,
+			req.EnvironmentId,
+			triggered,
+			nil,
+			domainevent.WithComment(comment),
+		)
+		if err != nil {
+			return err
+		}
+		events = append(events, guardrailEvent)
+		return nil
+	})
+	if err != nil {
+		s.logger.Error(
+			"Failed to execute guardrail halt",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentId", req.EnvironmentId),
+				zap.String("featureId", req.FeatureId),
+				zap.String("goalId", req.GoalId),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	if len(events) > 0 {
+		if errs := s.publisher.PublishMulti(ctx, events); len(errs) > 0 {
+			s.logger.Error(
+				"Failed to publish events",
+				log.FieldsFromIncomingContext(ctx).AddFields(
+					zap.Any("errors", errs),
+					zap.String("environmentId", req.EnvironmentId),
+				)...,
+			)
+			return nil, statusInternal.Err()
+		}
+	}
+	return &autoopsproto.ExecuteGuardrailHaltResponse{AlreadyHalted: alreadyHalted}, nil
+}
+
+// controlRuleChanges returns the changes that make every rule serve the control strategy.
+func controlRuleChanges(rules []*featureproto.Rule, controlStrategy *featureproto.Strategy) []*featureproto.RuleChange {
+	var changes []*featureproto.RuleChange
+	for _, r := range rules {
+		if proto.Equal(r.Strategy, controlStrategy) {
+			continue
+		}
+		rule := proto.Clone(r).(*featureproto.Rule)
+		rule.Strategy = proto.Clone(controlStrategy).(*featureproto.Strategy)
+		changes = append(changes, &featureproto.RuleChange{
+			ChangeType: featureproto.ChangeType_UPDATE,
+			Rule:       rule,
+		})
+	}
+	return changes
+}
+
+func validateExecuteGuardrailHaltRequest(req *autoopsproto.ExecuteGuardrailHaltRequest) error {
+	if req.FeatureId == "" {
+		return statusFeatureIDRequired.Err()
+	}
+	if req.GoalId == "" {
+		return statusGuardrailGoalIDRequired.Err()
+	}
+	if req.ControlVariationId == "" {
+		return statusGuardrailControlVariationIDRequired.Err()
+	}
+	if req.VariationId == "" {
+		return statusGuardrailVariationIDRequired.Err()
+	}
+	return nil
+}
+
+func (s *AutoOpsService) validateGuardrailGoals(
+	ctx context.Context,
+	environmentId string,
+	guardrailGoals []*autoopsproto.GuardrailGoal,
+) error {
+	if err := domain.ValidateGuardrailGoals(guardrailGoals); err != nil {
+		return api.NewGRPCStatus(err).Err()
+	}
+	for _, g := range guardrailGoals {
+		exist, err := s.existGoal(ctx, environmentId, g.GoalId)
+		if err != nil {
+			return api.NewGRPCStatus(err).Err()
+		}
+		if !exist {
+			return statusGuardrailGoalNotFound.Err()
+		}
+	}
+	return nil
+}
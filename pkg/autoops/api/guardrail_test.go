@@ -0,0 +1,312 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/autoops/domain"
+	mockAutoOpsStorage "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/storage/v2/mock"
+	bkterr "github.com/bucketeer-io/bucketeer/v2/pkg/error"
+	experimentclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/client/mock"
+	ftdomain "github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
+	mockFeatureStorage "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2/mock"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher"
+	publishermock "github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher/mock"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage"
+	dbmock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database/mock"
+	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
+	experimentproto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+func TestExecuteGuardrailHaltMySQL(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := createContextWithTokenRoleOwner(t)
+	ctx = metadata.NewIncomingContext(ctx, metadata.MD{
+		"accept-language": []string{"ja"},
+	})
+	newReq := func(modify func(*autoopsproto.ExecuteGuardrailHaltRequest)) *autoopsproto.ExecuteGuardrailHaltRequest {
+		req := &autoopsproto.ExecuteGuardrailHaltRequest{
+			EnvironmentId:      "ns0",
+			FeatureId:          "fid",
+			GoalId:             "gid",
+			ControlVariationId: "vid1",
+			VariationId:        "vid2",
+			ProbabilityWorse:   0.99,
+		}
+		if modify != nil {
+			modify(req)
+		}
+		return req
+	}
+	patterns := []struct {
+		desc        string
+		setup       func(*AutoOpsService)
+		req         *autoopsproto.ExecuteGuardrailHaltRequest
+		expectedErr error
+	}{
+		{
+			desc:        "err: feature id is required",
+			req:         newReq(func(r *autoopsproto.ExecuteGuardrailHaltRequest) { r.FeatureId = "" }),
+			expectedErr: statusFeatureIDRequired.Err(),
+		},
+		{
+			desc:        "err: goal id is required",
+			req:         newReq(func(r *autoopsproto.ExecuteGuardrailHaltRequest) { r.GoalId = "" }),
+			expectedErr: statusGuardrailGoalIDRequired.Err(),
+		},
+		{
+			desc:        "err: control variation id is required",
+			req:         newReq(func(r *autoopsproto.ExecuteGuardrailHaltRequest) { r.ControlVariationId = "" }),
+			expectedErr: statusGuardrailControlVariationIDRequired.Err(),
+		},
+		{
+			desc:        "err: variation id is required",
+			req:         newReq(func(r *autoopsproto.ExecuteGuardrailHaltRequest) { r.VariationId = "" }),
+			expectedErr: statusGuardrailVariationIDRequired.Err(),
+		},
+		{
+			desc: "err: control variation not found",
+			setup: func(s *AutoOpsService) {
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).Return(statusGuardrailControlVariationNotFound.Err())
+			},
+			req:         newReq(nil),
+			expectedErr: statusGuardrailControlVariationNotFound.Err(),
+		},
+		{
+			desc: "err: internal error during transaction",
+			setup: func(s *AutoOpsService) {
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).Return(bkterr.NewErrorInternal(bkterr.AutoopsPackageName, "internal"))
+			},
+			req:         newReq(nil),
+			expectedErr: statusInternal.Err(),
+		},
+		{
+			desc: "success",
+			setup: func(s *AutoOpsService) {
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+			},
+			req:         newReq(nil),
+			expectedErr: nil,
+		},
+		{
+			// The halt is exempt from change approval like a kill switch,
+			// so the environment is never looked up even when it is protected.
+			desc: "success: exempt from change approval",
+			setup: func(s *AutoOpsService) {
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				s.featureStorage.(*mockFeatureStorage.MockFeatureStorage).EXPECT().GetFeature(
+					gomock.Any(), "fid", "ns0",
+				).Return(&ftdomain.Feature{Feature: &featureproto.Feature{
+					Id:         "fid",
+					Variations: []*featureproto.Variation{{Id: "vid1"}, {Id: "vid2"}},
+					DefaultStrategy: &featureproto.Strategy{
+						Type:          featureproto.Strategy_FIXED,
+						FixedStrategy: &featureproto.FixedStrategy{Variation: "vid2"},
+					},
+				}}, nil)
+				s.prStorage.(*mockAutoOpsStorage.MockProgressiveRolloutStorage).EXPECT().ListProgressiveRollouts(
+					gomock.Any(), gomock.Any(),
+				).Return([]*autoopsproto.ProgressiveRollout{}, int64(0), 0, nil)
+				s.featureStorage.(*mockFeatureStorage.MockFeatureStorage).EXPECT().UpdateFeature(
+					gomock.Any(), gomock.Any(), "ns0",
+				).Return(nil)
+				s.publisher.(*publishermock.MockPublisher).EXPECT().PublishMulti(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+			},
+			req:         newReq(nil),
+			expectedErr: nil,
+		},
+		{
+			// The default strategy already serves the control variation,
+			// but the users matching the rule still receive the regressed one.
+			desc: "success: rule strategies are switched to control",
+			setup: func(s *AutoOpsService) {
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				s.featureStorage.(*mockFeatureStorage.MockFeatureStorage).EXPECT().GetFeature(
+					gomock.Any(), "fid", "ns0",
+				).Return(&ftdomain.Feature{Feature: &featureproto.Feature{
+					Id:         "fid",
+					Variations: []*featureproto.Variation{{Id: "vid1"}, {Id: "vid2"}},
+					DefaultStrategy: &featureproto.Strategy{
+						Type:          featureproto.Strategy_FIXED,
+						FixedStrategy: &featureproto.FixedStrategy{Variation: "vid1"},
+					},
+					Rules: []*featureproto.Rule{
+						{
+							Id: "b9b0d1a4-2a1c-4f0e-9f51-7c6d0a3e1c01",
+							Strategy: &featureproto.Strategy{
+								Type:          featureproto.Strategy_FIXED,
+								FixedStrategy: &featureproto.FixedStrategy{Variation: "vid2"},
+							},
+							Clauses: []*featureproto.Clause{
+								{
+									Id:        "c9b0d1a4-2a1c-4f0e-9f51-7c6d0a3e1c01",
+									Attribute: "country",
+									Operator:  featureproto.Clause_EQUALS,
+									Values:    []string{"jp"},
+								},
+							},
+						},
+						{
+							Id: "b9b0d1a4-2a1c-4f0e-9f51-7c6d0a3e1c02",
+							Strategy: &featureproto.Strategy{
+								Type: featureproto.Strategy_ROLLOUT,
+								RolloutStrategy: &featureproto.RolloutStrategy{
+									Variations: []*featureproto.RolloutStrategy_Variation{
+										{Variation: "vid1", Weight: 50000},
+										{Variation: "vid2", Weight: 50000},
+									},
+								},
+							},
+							Clauses: []*featureproto.Clause{
+								{
+									Id:        "c9b0d1a4-2a1c-4f0e-9f51-7c6d0a3e1c02",
+									Attribute: "plan",
+									Operator:  featureproto.Clause_EQUALS,
+									Values:    []string{"beta"},
+								},
+							},
+						},
+					},
+				}}, nil)
+				s.prStorage.(*mockAutoOpsStorage.MockProgressiveRolloutStorage).EXPECT().ListProgressiveRollouts(
+					gomock.Any(), gomock.Any(),
+				).Return([]*autoopsproto.ProgressiveRollout{}, int64(0), 0, nil)
+				s.featureStorage.(*mockFeatureStorage.MockFeatureStorage).EXPECT().UpdateFeature(
+					gomock.Any(), gomock.Any(), "ns0",
+				).DoAndReturn(func(_ context.Context, f *ftdomain.Feature, _ string) error {
+					assert.Equal(t, "vid1", f.DefaultStrategy.FixedStrategy.Variation)
+					require.Len(t, f.Rules, 2)
+					for _, r := range f.Rules {
+						assert.Equal(t, featureproto.Strategy_FIXED, r.Strategy.Type)
+						assert.Equal(t, "vid1", r.Strategy.FixedStrategy.Variation)
+						// Only the strategy changes, the rule still targets the same users.
+						assert.Len(t, r.Clauses, 1)
+					}
+					return nil
+				})
+				s.publisher.(*publishermock.MockPublisher).EXPECT().PublishMulti(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(_ context.Context, events []publisher.Message) map[string]error {
+					// The feature update and the guardrail triggered events.
+					assert.Len(t, events, 2)
+					return nil
+				})
+			},
+			req:         newReq(nil),
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			s := createAutoOpsService(mockController)
+			if p.setup != nil {
+				p.setup(s)
+			}
+			_, err := s.ExecuteGuardrailHalt(ctx, p.req)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestValidateGuardrailGoals(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc           string
+		setup          func(*AutoOpsService)
+		guardrailGoals []*autoopsproto.GuardrailGoal
+		expectedErr    error
+	}{
+		{
+			desc:           "success: no guardrail goals",
+			guardrailGoals: nil,
+			expectedErr:    nil,
+		},
+		{
+			desc: "err: threshold out of range",
+			guardrailGoals: []*autoopsproto.GuardrailGoal{
+				{GoalId: "gid", Threshold: 1.5},
+			},
+			expectedErr: api.NewGRPCStatus(domain.ErrGuardrailThresholdOutOfRange).Err(),
+		},
+		{
+			desc: "err: goal not found",
+			setup: func(s *AutoOpsService) {
+				s.experimentClient.(*experimentclientmock.MockClient).EXPECT().GetGoal(
+					gomock.Any(), gomock.Any(),
+				).Return(nil, storage.ErrKeyNotFound)
+			},
+			guardrailGoals: []*autoopsproto.GuardrailGoal{
+				{GoalId: "gid"},
+			},
+			expectedErr: statusGuardrailGoalNotFound.Err(),
+		},
+		{
+			desc: "success",
+			setup: func(s *AutoOpsService) {
+				s.experimentClient.(*experimentclientmock.MockClient).EXPECT().GetGoal(
+					gomock.Any(), gomock.Any(),
+				).Return(&experimentproto.GetGoalResponse{}, nil)
+			},
+			guardrailGoals: []*autoopsproto.GuardrailGoal{
+				{GoalId: "gid", Threshold: 0.9},
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			s := createAutoOpsService(mockController)
+			if p.setup != nil {
+				p.setup(s)
+			}
+			err := s.validateGuardrailGoals(context.Background(), "ns0", p.guardrailGoals)
+			// Status details carry metadata maps, so compare code and message.
+			assert.Equal(t, status.Code(p.expectedErr), status.Code(err))
+			assert.Equal(t, status.Convert(p.expectedErr).Message(), status.Convert(err).Message())
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	domainevent "github.com/bucketeer-io/bucketeer/v2/pkg/domainevent/domain"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
 	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
 	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
 	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
@@ -46,9 +47,10 @@ func (s *AutoOpsService) CreateProgressiveRollout(
 	ctx context.Context,
 	req *autoopsproto.CreateProgressiveRolloutRequest,
 ) (*autoopsproto.CreateProgressiveRolloutResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_PROGRESSIVE_ROLLOUT,
+		Action:       accountproto.Permission_CREATE,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +62,7 @@ func (s *AutoOpsService) CreateProgressiveRollout(
 		req.FeatureId,
 		req.ProgressiveRolloutManualScheduleClause,
 		req.ProgressiveRolloutTemplateScheduleClause,
+		req.GuardrailGoals,
 	)
 	if err != nil {
 		s.logger.Error(
@@ -78,12 +81,13 @@ func (s *AutoOpsService) CreateProgressiveRollout(
 			progressiveRollout.Id,
 			eventproto.Event_PROGRESSIVE_ROLLOUT_CREATED,
 			&eventproto.ProgressiveRolloutCreatedEvent{
-				Id:        progressiveRollout.Id,
-				FeatureId: progressiveRollout.FeatureId,
-				Clause:    progressiveRollout.Clause,
-				CreatedAt: progressiveRollout.CreatedAt,
-				UpdatedAt: progressiveRollout.UpdatedAt,
-				Type:      progressiveRollout.Type,
+				Id:             progressiveRollout.Id,
+				FeatureId:      progressiveRollout.FeatureId,
+				Clause:         progressiveRollout.Clause,
+				CreatedAt:      progressiveRollout.CreatedAt,
+				UpdatedAt:      progressiveRollout.UpdatedAt,
+				Type:           progressiveRollout.Type,
+				GuardrailGoals: progressiveRollout.GuardrailGoals,
 			},
 			req.EnvironmentId,
 			progressiveRollout.ProgressiveRollout,
@@ -119,9 +123,11 @@ func (s *AutoOpsService) GetProgressiveRollout(
 	ctx context.Context,
 	req *autoopsproto.GetProgressiveRolloutRequest,
 ) (*autoopsproto.GetProgressiveRolloutResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_PROGRESSIVE_ROLLOUT,
+		Action:       accountproto.Permission_READ,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -152,9 +158,11 @@ func (s *AutoOpsService) StopProgressiveRollout(
 	ctx context.Context,
 	req *autoopsproto.StopProgressiveRolloutRequest,
 ) (*autoopsproto.StopProgressiveRolloutResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_PROGRESSIVE_ROLLOUT,
+		Action:       accountproto.Permission_UPDATE,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -228,9 +236,11 @@ func (s *AutoOpsService) DeleteProgressiveRollout(
 	ctx context.Context,
 	req *autoopsproto.DeleteProgressiveRolloutRequest,
 ) (*autoopsproto.DeleteProgressiveRolloutResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_PROGRESSIVE_ROLLOUT,
+		Action:       accountproto.Permission_DELETE,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -294,9 +304,10 @@ func (s *AutoOpsService) ListProgressiveRollouts(
 	ctx context.Context,
 	req *autoopsproto.ListProgressiveRolloutsRequest,
 ) (*autoopsproto.ListProgressiveRolloutsResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_PROGRESSIVE_ROLLOUT,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -318,9 +329,11 @@ func (s *AutoOpsService) ExecuteProgressiveRollout(
 	ctx context.Context,
 	req *autoopsproto.ExecuteProgressiveRolloutRequest,
 ) (*autoopsproto.ExecuteProgressiveRolloutResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_PROGRESSIVE_ROLLOUT,
+		Action:       accountproto.Permission_UPDATE,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -335,6 +348,10 @@ func (s *AutoOpsService) ExecuteProgressiveRollout(
 		)
 		return nil, err
 	}
+	// Each rollout step changes the flag's traffic, so protected environments require a change request.
+	if err := s.checkDirectChangeAllowed(ctx, req.EnvironmentId); err != nil {
+		return nil, err
+	}
 	var events []publisher.Message
 	err = s.dbClient.RunInTransactionV2(ctx, func(contextWithTx context.Context) error {
 		progressiveRollout, err := s.prStorage.GetProgressiveRollout(contextWithTx, req.Id, req.EnvironmentId)
@@ -593,7 +610,7 @@ func (s *AutoOpsService) validateCreateProgressiveRolloutRequest(
 			return err
 		}
 	}
-	return nil
+	return s.validateGuardrailGoals(ctx, req.EnvironmentId, req.GuardrailGoals)
 }
 
 func (s *AutoOpsService) validateGetProgressiveRolloutRequest(
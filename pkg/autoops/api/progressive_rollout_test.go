@@ -35,6 +35,7 @@ import (
 	dbmock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database/mock"
 	"github.com/bucketeer-io/bucketeer/v2/proto/autoops"
 	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
+	envproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
 	experimentproto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
 	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
 )
@@ -1120,9 +1121,22 @@ func TestExecuteProgressiveRolloutMySQL(t *testing.T) {
 			},
 			expectedErr: statusProgressiveRolloutScheduleIDRequired.Err(),
 		},
+		{
+			desc: "err: change approval required",
+			setup: func(s *AutoOpsService) {
+				expectGetEnvironment(s, &envproto.EnvironmentV2{Id: "ns0", RequireChangeApproval: true})
+			},
+			req: &autoopsproto.ExecuteProgressiveRolloutRequest{
+				Id:            "aid1",
+				EnvironmentId: "ns0",
+				ScheduleId:    "sid1",
+			},
+			expectedErr: statusChangeApprovalRequired.Err(),
+		},
 		{
 			desc: "success",
 			setup: func(s *AutoOpsService) {
+				expectGetEnvironment(s, &envproto.EnvironmentV2{Id: "ns0"})
 				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
 					gomock.Any(), gomock.Any(),
 				).Do(func(ctx context.Context, fn func(ctx context.Context) error) {
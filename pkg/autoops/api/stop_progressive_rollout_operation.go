@@ -28,24 +28,26 @@ func executeStopProgressiveRolloutOperation(
 	featureIDs []string,
 	environmentId string,
 	operation autoopsproto.ProgressiveRollout_StoppedBy,
-) error {
+) ([]*prdomain.ProgressiveRollout, error) {
 	list, _, _, err := storage.ListProgressiveRollouts(ctx, v2as.ListProgressiveRolloutsParams{
 		EnvironmentID: environmentId,
 		FeatureIDs:    featureIDs,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
+	var stopped []*prdomain.ProgressiveRollout
 	for _, rollout := range list {
 		r := &prdomain.ProgressiveRollout{ProgressiveRollout: rollout}
 		if r.IsWaiting() || r.IsRunning() {
 			if err := r.Stop(operation); err != nil {
-				return err
+				return nil, err
 			}
 			if err := storage.UpdateProgressiveRollout(ctx, r, environmentId); err != nil {
-				return err
+				return nil, err
 			}
+			stopped = append(stopped, r)
 		}
 	}
-	return nil
+	return stopped, nil
 }
@@ -154,6 +154,26 @@ func (mr *MockClientMockRecorder) ExecuteAutoOps(ctx, in any, opts ...any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteAutoOps", reflect.TypeOf((*MockClient)(nil).ExecuteAutoOps), varargs...)
 }
 
+// ExecuteGuardrailHalt mocks base method.
+func (m *MockClient) ExecuteGuardrailHalt(ctx context.Context, in *autoops.ExecuteGuardrailHaltRequest, opts ...grpc.CallOption) (*autoops.ExecuteGuardrailHaltResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExecuteGuardrailHalt", varargs...)
+	ret0, _ := ret[0].(*autoops.ExecuteGuardrailHaltResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteGuardrailHalt indicates an expected call of ExecuteGuardrailHalt.
+func (mr *MockClientMockRecorder) ExecuteGuardrailHalt(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteGuardrailHalt", reflect.TypeOf((*MockClient)(nil).ExecuteGuardrailHalt), varargs...)
+}
+
 // ExecuteProgressiveRollout mocks base method.
 func (m *MockClient) ExecuteProgressiveRollout(ctx context.Context, in *autoops.ExecuteProgressiveRolloutRequest, opts ...grpc.CallOption) (*autoops.ExecuteProgressiveRolloutResponse, error) {
 	m.ctrl.T.Helper()
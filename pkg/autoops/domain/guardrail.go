@@ -0,0 +1,75 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	err "github.com/bucketeer-io/bucketeer/v2/pkg/error"
+	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
+)
+
+// DefaultGuardrailThreshold is the probability of a variation being worse than
+// the control at which a guardrail halts when no threshold is configured.
+const DefaultGuardrailThreshold = 0.95
+
+var (
+	ErrGuardrailGoalIDRequired = err.NewErrorInvalidArgEmpty(
+		err.AutoopsPackageName,
+		"guardrail goal id is required",
+		"guardrail_goal_id",
+	)
+	ErrGuardrailGoalDuplicated = err.NewErrorInvalidArgDuplicated(
+		err.AutoopsPackageName,
+		"guardrail goal is duplicated",
+		"guardrail_goal_id",
+	)
+	ErrGuardrailThresholdOutOfRange = err.NewErrorInvalidArgUnknown(
+		err.AutoopsPackageName,
+		"guardrail threshold must be between 0 and 1",
+		"guardrail_threshold",
+	)
+)
+
+// ValidateGuardrailGoals checks that every guardrail references a goal once
+// and that its threshold is either unset or a probability in (0, 1).
+func ValidateGuardrailGoals(goals []*autoopsproto.GuardrailGoal) error {
+	seen := make(map[string]struct{}, len(goals))
+	for _, g := range goals {
+		if g.GoalId == "" {
+			return ErrGuardrailGoalIDRequired
+		}
+		if _, ok := seen[g.GoalId]; ok {
+			return ErrGuardrailGoalDuplicated
+		}
+		seen[g.GoalId] = struct{}{}
+		if g.Threshold < 0 || g.Threshold >= 1 {
+			return ErrGuardrailThresholdOutOfRange
+		}
+	}
+	return nil
+}
+
+// GuardrailThreshold returns the effective threshold of the guardrail.
+func GuardrailThreshold(g *autoopsproto.GuardrailGoal) float64 {
+	if g.Threshold <= 0 {
+		return DefaultGuardrailThreshold
+	}
+	return g.Threshold
+}
+
+// GuardrailBreached reports whether the probability of a variation being
+// worse than the control reached the guardrail's threshold.
+func GuardrailBreached(g *autoopsproto.GuardrailGoal, probabilityWorse float64) bool {
+	return probabilityWorse >= GuardrailThreshold(g)
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
+)
+
+func TestValidateGuardrailGoals(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc     string
+		input    []*autoopsproto.GuardrailGoal
+		expected error
+	}{
+		{
+			desc:     "success: empty",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			desc: "success",
+			input: []*autoopsproto.GuardrailGoal{
+				{GoalId: "goal-1"},
+				{GoalId: "goal-2", Threshold: 0.9},
+			},
+			expected: nil,
+		},
+		{
+			desc:     "err: goal id is required",
+			input:    []*autoopsproto.GuardrailGoal{{Threshold: 0.9}},
+			expected: ErrGuardrailGoalIDRequired,
+		},
+		{
+			desc: "err: duplicated goal",
+			input: []*autoopsproto.GuardrailGoal{
+				{GoalId: "goal-1"},
+				{GoalId: "goal-1", Threshold: 0.9},
+			},
+			expected: ErrGuardrailGoalDuplicated,
+		},
+		{
+			desc:     "err: negative threshold",
+			input:    []*autoopsproto.GuardrailGoal{{GoalId: "goal-1", Threshold: -0.1}},
+			expected: ErrGuardrailThresholdOutOfRange,
+		},
+		{
+			desc:     "err: threshold of one",
+			input:    []*autoopsproto.GuardrailGoal{{GoalId: "goal-1", Threshold: 1}},
+			expected: ErrGuardrailThresholdOutOfRange,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			assert.Equal(t, p.expected, ValidateGuardrailGoals(p.input))
+		})
+	}
+}
+
+func TestGuardrailBreached(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc             string
+		guardrail        *autoopsproto.GuardrailGoal
+		probabilityWorse float64
+		expected         bool
+	}{
+		{
+			desc:             "default threshold: below",
+			guardrail:        &autoopsproto.GuardrailGoal{GoalId: "goal-1"},
+			probabilityWorse: 0.94,
+			expected:         false,
+		},
+		{
+			desc:             "default threshold: reached",
+			guardrail:        &autoopsproto.GuardrailGoal{GoalId: "goal-1"},
+			probabilityWorse: 0.95,
+			expected:         true,
+		},
+		{
+			desc:             "custom threshold: reached",
+			guardrail:        &autoopsproto.GuardrailGoal{GoalId: "goal-1", Threshold: 0.8},
+			probabilityWorse: 0.85,
+			expected:         true,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			assert.Equal(t, p.expected, GuardrailBreached(p.guardrail, p.probabilityWorse))
+		})
+	}
+}
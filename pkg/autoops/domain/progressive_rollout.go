@@ -63,6 +63,7 @@ func NewProgressiveRollout(
 	featureID string,
 	manual *autoopsproto.ProgressiveRolloutManualScheduleClause,
 	template *autoopsproto.ProgressiveRolloutTemplateScheduleClause,
+	guardrailGoals []*autoopsproto.GuardrailGoal,
 ) (*ProgressiveRollout, error) {
 	now := time.Now().Unix()
 	id, err := uuid.NewUUID()
@@ -70,13 +71,14 @@ func NewProgressiveRollout(
 		return nil, err
 	}
 	p := &ProgressiveRollout{&autoopsproto.ProgressiveRollout{
-		Id:        id.String(),
-		FeatureId: featureID,
-		Status:    autoopsproto.ProgressiveRollout_WAITING,
-		StoppedBy: autoopsproto.ProgressiveRollout_UNKNOWN,
-		Clause:    nil,
-		CreatedAt: now,
-		UpdatedAt: now,
+		Id:             id.String(),
+		FeatureId:      featureID,
+		Status:         autoopsproto.ProgressiveRollout_WAITING,
+		StoppedBy:      autoopsproto.ProgressiveRollout_UNKNOWN,
+		Clause:         nil,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		GuardrailGoals: guardrailGoals,
 	}}
 	if manual != nil {
 		if err := p.addManualScheduleClause(manual); err != nil {
@@ -189,6 +191,22 @@ func (p *ProgressiveRollout) ExtractSchedules() ([]*autoopsproto.ProgressiveRoll
 	return nil, ErrProgressiveRolloutInvalidType
 }
 
+// LastTriggeredAt returns when the most recent schedule was executed, or zero
+// if none has been executed yet.
+func (p *ProgressiveRollout) LastTriggeredAt() (int64, error) {
+	schedules, err := p.ExtractSchedules()
+	if err != nil {
+		return 0, err
+	}
+	var last int64
+	for _, s := range schedules {
+		if s.TriggeredAt > last {
+			last = s.TriggeredAt
+		}
+	}
+	return last, nil
+}
+
 // inferControlVariationID infers the control variation for backward compatibility.
 // For old progressive rollouts with only variation_id (target), we need to find
 // the other variation (control) from the feature's variations.
@@ -39,6 +39,7 @@ func TestNewProgressiveRollout(t *testing.T) {
 	assert.NotNil(t, aor.Clause)
 	assert.NotZero(t, aor.CreatedAt)
 	assert.NotZero(t, aor.UpdatedAt)
+	assert.Equal(t, "goal-id", aor.GuardrailGoals[0].GoalId)
 }
 
 func createProgressiveRollout(t *testing.T) *ProgressiveRollout {
@@ -82,6 +83,7 @@ func createProgressiveRollout(t *testing.T) *ProgressiveRollout {
 			Increments:  20,
 			VariationId: "vid-1",
 		},
+		[]*autoopsproto.GuardrailGoal{{GoalId: "goal-id", Threshold: 0.9}},
 	)
 	require.NoError(t, err)
 	return aor
@@ -398,6 +400,23 @@ func TestExtractSchedules(t *testing.T) {
 	assert.Equal(t, actual[5].Weight, int32(100))
 }
 
+func TestLastTriggeredAt(t *testing.T) {
+	p := createProgressiveRollout(t)
+	actual, err := p.LastTriggeredAt()
+	assert.NoError(t, err)
+	assert.Zero(t, actual)
+
+	schedules, err := p.ExtractSchedules()
+	require.NoError(t, err)
+	require.NoError(t, p.SetTriggeredAt(schedules[0].ScheduleId))
+	require.NoError(t, p.SetTriggeredAt(schedules[1].ScheduleId))
+	schedules, err = p.ExtractSchedules()
+	require.NoError(t, err)
+	actual, err = p.LastTriggeredAt()
+	assert.NoError(t, err)
+	assert.Equal(t, schedules[1].TriggeredAt, actual)
+}
+
 func TestStop(t *testing.T) {
 	patterns := []struct {
 		desc     string
@@ -424,6 +443,11 @@ func TestStop(t *testing.T) {
 			input:    autoopsproto.ProgressiveRollout_OPS_KILL_SWITCH,
 			expected: nil,
 		},
+		{
+			desc:     "success: by guardrail",
+			input:    autoopsproto.ProgressiveRollout_OPS_GUARDRAIL,
+			expected: nil,
+		},
 	}
 	for _, p := range patterns {
 		t.Run(p.desc, func(t *testing.T) {
@@ -8,7 +8,8 @@ INSERT INTO ops_progressive_rollout (
     stopped_at,
     created_at,
     updated_at,
+    guardrail_goals,
     environment_id
 ) VALUES (
-    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 )
@@ -7,6 +7,7 @@ SELECT
     type,
     stopped_at,
     created_at,
-    updated_at
+    updated_at,
+    guardrail_goals
 FROM
     ops_progressive_rollout
@@ -8,7 +8,8 @@ SET
     type = ?,
     stopped_at = ?,
     created_at = ?,
-    updated_at = ?
+    updated_at = ?,
+    guardrail_goals = ?
 WHERE
     id = ? AND
     environment_id = ?
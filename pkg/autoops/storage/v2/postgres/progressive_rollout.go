@@ -66,6 +66,7 @@ func (s *progressiveRolloutStorage) CreateProgressiveRollout(
 		progressiveRollout.StoppedAt,
 		progressiveRollout.CreatedAt,
 		progressiveRollout.UpdatedAt,
+		pgstorage.JSONObject{Val: progressiveRollout.GuardrailGoals},
 		environmentId,
 	)
 	if err != nil {
@@ -97,6 +98,7 @@ func (s *progressiveRolloutStorage) GetProgressiveRollout(
 		&progressiveRollout.StoppedAt,
 		&progressiveRollout.CreatedAt,
 		&progressiveRollout.UpdatedAt,
+		&pgstorage.JSONObject{Val: &progressiveRollout.GuardrailGoals},
 	)
 	if err != nil {
 		if errors.Is(err, pgstorage.ErrNoRows) {
@@ -157,6 +159,7 @@ func (s *progressiveRolloutStorage) ListProgressiveRollouts(
 			&progressiveRollout.StoppedAt,
 			&progressiveRollout.CreatedAt,
 			&progressiveRollout.UpdatedAt,
+			&pgstorage.JSONObject{Val: &progressiveRollout.GuardrailGoals},
 		)
 		if err != nil {
 			return nil, 0, 0, err
@@ -192,6 +195,7 @@ func (s *progressiveRolloutStorage) UpdateProgressiveRollout(
 		&progressiveRollout.StoppedAt,
 		&progressiveRollout.CreatedAt,
 		&progressiveRollout.UpdatedAt,
+		&pgstorage.JSONObject{Val: &progressiveRollout.GuardrailGoals},
 		&progressiveRollout.Id,
 		environmentId,
 	)
@@ -8,7 +8,8 @@ INSERT INTO ops_progressive_rollout (
     stopped_at,
     created_at,
     updated_at,
+    guardrail_goals,
     environment_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 )
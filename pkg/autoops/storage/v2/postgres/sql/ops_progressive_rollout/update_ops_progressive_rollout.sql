@@ -8,7 +8,8 @@ SET
     type = $5,
     stopped_at = $6,
     created_at = $7,
-    updated_at = $8
+    updated_at = $8,
+    guardrail_goals = $9
 WHERE
-    id = $9 AND
-    environment_id = $10
+    id = $10 AND
+    environment_id = $11
@@ -49,6 +49,8 @@ type batchService struct {
 	featureAutoArchiver         jobs.Job
 	scheduledFlagChangeExecutor jobs.Job
 	monthlySummarizer           jobs.Job
+	featureLifecycleUpdater     jobs.Job
+	secretKeyRotator            jobs.Job
 	logger                      *zap.Logger
 }
 
@@ -60,7 +62,8 @@ func NewBatchService(
 	featureFlagCacher, segmentUserCacher, apiKeyCacher,
 	experimentCacher, autoOpsRulesCacher, tagDeleter,
 	featureAutoArchiver, scheduledFlagChangeExecutor,
-	monthlySummarizer jobs.Job,
+	monthlySummarizer, featureLifecycleUpdater,
+	secretKeyRotator jobs.Job,
 	logger *zap.Logger,
 ) *batchService {
 	return &batchService{
@@ -81,6 +84,8 @@ func NewBatchService(
 		featureAutoArchiver:         featureAutoArchiver,
 		scheduledFlagChangeExecutor: scheduledFlagChangeExecutor,
 		monthlySummarizer:           monthlySummarizer,
+		featureLifecycleUpdater:     featureLifecycleUpdater,
+		secretKeyRotator:            secretKeyRotator,
 		logger:                      logger.Named("batch-service"),
 	}
 }
@@ -123,6 +128,10 @@ func (s *batchService) ExecuteBatchJob(
 		err = s.scheduledFlagChangeExecutor.Run(ctx)
 	case batch.BatchJob_MonthlySummarizer:
 		err = s.monthlySummarizer.Run(ctx)
+	case batch.BatchJob_FeatureLifecycleUpdater:
+		err = s.featureLifecycleUpdater.Run(ctx)
+	case batch.BatchJob_SecretKeyRotator:
+		err = s.secretKeyRotator.Run(ctx)
 	default:
 		s.logger.Error("Unknown job",
 			log.FieldsFromIncomingContext(ctx).AddFields(
@@ -34,11 +34,13 @@ import (
 	cacher "github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/cacher"
 	deleter "github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/deleter"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/experiment"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/lifecycle"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/monthlysummary"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/notification"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/opsevent"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/rediscounter"
 	scheduledflagchange "github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/scheduledflagchange"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/secret"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/cache"
 	redismock "github.com/bucketeer-io/bucketeer/v2/pkg/cache/mock"
 	maucachemock "github.com/bucketeer-io/bucketeer/v2/pkg/cache/v3/mock"
@@ -786,6 +788,9 @@ func newBatchService(t *testing.T,
 		opsevent.NewProgressiveRolloutWatcher(
 			environmentMockClient,
 			autoOpsRulesMockClient,
+			featureMockClient,
+			eventCounterMockClient,
+			experimentMockClient,
 			mockProgressiveRolloutExecutor,
 			nil, // ftCacher - not needed for this test
 			jobs.WithTimeout(5*time.Minute),
@@ -842,6 +847,21 @@ func newBatchService(t *testing.T,
 			nil,
 			jobs.WithLogger(logger),
 		),
+		lifecycle.NewFeatureLifecycleUpdater(
+			environmentMockClient,
+			featureStorageMock,
+			codeRefStorageMock,
+			notificationMockSender,
+			jobs.WithTimeout(10*time.Minute),
+			jobs.WithLogger(logger),
+		),
+		secret.NewSecretKeyRotator(
+			environmentMockClient,
+			nil,
+			nil,
+			nil,
+			jobs.WithLogger(logger),
+		),
 		logger,
 	)
 	return service
@@ -16,6 +16,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
@@ -39,23 +40,29 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/calculator"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/deleter"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/experiment"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/lifecycle"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/monthlysummary"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/notification"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/opsevent"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/rediscounter"
 	scheduledflagchange "github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/scheduledflagchange"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs/secret"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/cache"
 	cachev3 "github.com/bucketeer-io/bucketeer/v2/pkg/cache/v3"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/cli"
 	coderefstorage "github.com/bucketeer-io/bucketeer/v2/pkg/coderef/storage"
 	coderefmysql "github.com/bucketeer-io/bucketeer/v2/pkg/coderef/storage/mysql"
 	coderefpostgres "github.com/bucketeer-io/bucketeer/v2/pkg/coderef/storage/postgres"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/crypto"
+	cryptostorage "github.com/bucketeer-io/bucketeer/v2/pkg/crypto/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/email"
 	environmentclient "github.com/bucketeer-io/bucketeer/v2/pkg/environment/client"
 	v2es "github.com/bucketeer-io/bucketeer/v2/pkg/environment/storage/v2"
 	environmentmysql "github.com/bucketeer-io/bucketeer/v2/pkg/environment/storage/v2/mysql"
 	environmentpostgres "github.com/bucketeer-io/bucketeer/v2/pkg/environment/storage/v2/postgres"
 	ecclient "github.com/bucketeer-io/bucketeer/v2/pkg/eventcounter/client"
 	experimentclient "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/client"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/experimentcalculator/experimentcalc"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/experimentcalculator/stan"
 	v2ecrs "github.com/bucketeer-io/bucketeer/v2/pkg/experimentcalculator/storage/v2"
 	experimentcalcmysql "github.com/bucketeer-io/bucketeer/v2/pkg/experimentcalculator/storage/v2/mysql"
@@ -77,15 +84,20 @@ import (
 	opseventpostgres "github.com/bucketeer-io/bucketeer/v2/pkg/opsevent/storage/v2/postgres"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/prometheus"
 	pushclient "github.com/bucketeer-io/bucketeer/v2/pkg/push/client"
+	v2ps "github.com/bucketeer-io/bucketeer/v2/pkg/push/storage/v2"
 	redisv3 "github.com/bucketeer-io/bucketeer/v2/pkg/redis/v3"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/rpc"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/rpc/client"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/rpc/gateway"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/mysql"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/sqlite"
 	subscriptionclient "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/client"
 	subscriptionsender "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/sender"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/sender/notifier"
+	v2ns "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2"
+	subscriptionmysql "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2/mysql"
+	subscriptionpostgres "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2/postgres"
 	tagstorage "github.com/bucketeer-io/bucketeer/v2/pkg/tag/storage"
 	tagmysql "github.com/bucketeer-io/bucketeer/v2/pkg/tag/storage/mysql"
 	tagpostgres "github.com/bucketeer-io/bucketeer/v2/pkg/tag/storage/postgres"
@@ -120,12 +132,14 @@ type server struct {
 	refreshInterval         *time.Duration
 	experimentLockTTL       *time.Duration
 	webURL                  *string
+	emailConfigPath         *string
 	oauthPublicKeyPath      *string
 	oauthAudience           *string
 	oauthIssuer             *string
 	stanHost                *string
 	stanPort                *string
 	stanModelID             *string
+	binomialSampler         *string
 	operationalDatabaseType *string
 	// MySQL
 	mysqlUser        *string
@@ -144,6 +158,14 @@ type server struct {
 	postgresSSLRootCert *string
 	postgresSSLCert     *string
 	postgresSSLKey      *string
+	// SQLite
+	sqlitePath *string
+	// Secret encryption
+	secretEncryptionBackend      *string
+	secretEncryptionKey          *string
+	secretEncryptionAWSRegion    *string
+	secretEncryptionVaultAddress *string
+	secretEncryptionVaultToken   *string
 	// gRPC service
 	accountService              *string
 	environmentService          *string
@@ -190,6 +212,10 @@ func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
 			"Interval between refreshing target objects.",
 		).Default("1m").Duration(),
 		webURL: cmd.Flag("web-url", "Web console URL.").Required().String(),
+		emailConfigPath: cmd.Flag(
+			"email-config-path",
+			"Path to email config used by the email subscription notifier.",
+		).Default("").String(),
 		oauthPublicKeyPath: cmd.Flag(
 			"oauth-public-key",
 			"Path to public key used to verify oauth token.",
@@ -202,7 +228,12 @@ func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
 		stanHost:    cmd.Flag("stan-host", "httpstan host.").Default("localhost").String(),
 		stanPort:    cmd.Flag("stan-port", "httpstan port.").Default("8080").String(),
 		stanModelID: cmd.Flag("stan-model-id", "httpstan modelId.").Required().String(),
-		operationalDatabaseType: cmd.Flag("storage-type", "Operational database type (mysql, postgres).").
+		binomialSampler: cmd.Flag(
+			"binomial-sampler",
+			"Sampler of the experiment conversion rates (stan, beta-binomial). beta-binomial doesn't need httpstan.",
+		).Default(string(experimentcalc.BinomialSamplerStan)).
+			Enum(string(experimentcalc.BinomialSamplerStan), string(experimentcalc.BinomialSamplerBetaBinomial)),
+		operationalDatabaseType: cmd.Flag("storage-type", "Operational database type (mysql, postgres, sqlite).").
 			Default("mysql").String(),
 		mysqlUser:        cmd.Flag("mysql-user", "MySQL user.").Required().String(),
 		mysqlPass:        cmd.Flag("mysql-pass", "MySQL password.").Required().String(),
@@ -231,6 +262,30 @@ func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
 			"postgres-ssl-key",
 			"Path to the PostgreSQL SSL client private key file.",
 		).String(),
+		sqlitePath: cmd.Flag(
+			"sqlite-path",
+			"Path to the SQLite database file used when storage-type=sqlite.",
+		).Default("bucketeer.db").String(),
+		secretEncryptionBackend: cmd.Flag(
+			"secret-encryption-backend",
+			"Backend wrapping the data keys of the stored secrets (none, local, gcp-kms, aws-kms, vault).",
+		).Default(string(crypto.KeyBackendNone)).String(),
+		secretEncryptionKey: cmd.Flag(
+			"secret-encryption-key",
+			"Key file path for local, key name for gcp-kms and vault, or key ID for aws-kms.",
+		).String(),
+		secretEncryptionAWSRegion: cmd.Flag(
+			"secret-encryption-aws-region",
+			"Region of the AWS KMS key.",
+		).String(),
+		secretEncryptionVaultAddress: cmd.Flag(
+			"secret-encryption-vault-address",
+			"Address of the Vault server.",
+		).String(),
+		secretEncryptionVaultToken: cmd.Flag(
+			"secret-encryption-vault-token",
+			"Token used to authenticate to Vault.",
+		).String(),
 		accountService: cmd.Flag(
 			"account-service",
 			"bucketeer-account-service address.",
@@ -361,6 +416,11 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 	var experimentResultStorage v2ecrs.ExperimentResultStorage
 	var monthlySummaryStorage insightsstorage.MonthlySummaryStorage
 	var scheduledFlagChangeStorage v2fs.ScheduledFlagChangeStorage
+	var pushStorage v2ps.PushStorage
+	var flagTriggerStorage v2fs.FlagTriggerStorage
+	var subscriptionStorage v2ns.SubscriptionStorage
+	var adminSubscriptionStorage v2ns.AdminSubscriptionStorage
+	var dataKeyStorage cryptostorage.DataKeyStorage
 	if *s.operationalDatabaseType == "postgres" {
 		if *s.postgresUser == "" || *s.postgresHost == "" || *s.postgresDBName == "" {
 			return fmt.Errorf("postgres-user, postgres-host, and postgres-db-name are required when storage-type=postgres")
@@ -380,6 +440,11 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		experimentResultStorage = experimentcalcpostgres.NewExperimentResultStorage(postgresClient)
 		monthlySummaryStorage = insightspostgres.NewMonthlySummaryStorage(postgresClient)
 		scheduledFlagChangeStorage = featurepostgres.NewScheduledFlagChangeStorage(postgresClient)
+		pushStorage = v2ps.NewPostgresPushStorage(postgresClient)
+		flagTriggerStorage = featurepostgres.NewFlagTriggerStorage(postgresClient)
+		subscriptionStorage = subscriptionpostgres.NewSubscriptionStorage(postgresClient)
+		adminSubscriptionStorage = subscriptionpostgres.NewAdminSubscriptionStorage(postgresClient)
+		dataKeyStorage = cryptostorage.NewPostgresDataKeyStorage(postgresClient)
 	} else {
 		accountStorage = accountmysql.NewAccountStorage(mysqlClient)
 		featureStorage = featuremysql.NewFeatureStorage(mysqlClient)
@@ -391,6 +456,27 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		experimentResultStorage = experimentcalcmysql.NewExperimentResultStorage(mysqlClient)
 		monthlySummaryStorage = insightsmysql.NewMonthlySummaryStorage(mysqlClient)
 		scheduledFlagChangeStorage = featuremysql.NewScheduledFlagChangeStorage(mysqlClient)
+		pushStorage = v2ps.NewMySQLPushStorage(mysqlClient)
+		flagTriggerStorage = featuremysql.NewFlagTriggerStorage(mysqlClient)
+		subscriptionStorage = subscriptionmysql.NewSubscriptionStorage(mysqlClient)
+		adminSubscriptionStorage = subscriptionmysql.NewAdminSubscriptionStorage(mysqlClient)
+		dataKeyStorage = cryptostorage.NewMySQLDataKeyStorage(mysqlClient)
+	}
+	secretCipher, err := s.createSecretCipher(ctx, dataKeyStorage, envStorage)
+	if err != nil {
+		logger.Error("Failed to create the secret cipher", zap.Error(err))
+		return err
+	}
+	var secretRotators, adminSecretRotators []crypto.SecretRotator
+	if secretCipher != nil {
+		secretRotators = []crypto.SecretRotator{
+			v2ps.NewEncryptedPushStorage(pushStorage, secretCipher),
+			v2fs.NewEncryptedFlagTriggerStorage(flagTriggerStorage, secretCipher),
+			v2ns.NewEncryptedSubscriptionStorage(subscriptionStorage, secretCipher),
+		}
+		adminSecretRotators = []crypto.SecretRotator{
+			v2ns.NewEncryptedAdminSubscriptionStorage(adminSubscriptionStorage, secretCipher),
+		}
 	}
 
 	creds, err := client.NewPerRPCCredentials(*s.serviceTokenPath)
@@ -496,11 +582,31 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		opsexecutor.WithLogger(logger),
 	)
 
+	emailService, err := s.createEmailService(logger)
+	if err != nil {
+		return err
+	}
+
 	slackNotifier := notifier.NewSlackNotifier(*s.webURL)
+	teamsNotifier := notifier.NewTeamsNotifier(
+		*s.webURL,
+		notifier.WithMetrics(registerer),
+		notifier.WithLogger(logger),
+	)
+	webhookNotifier := notifier.NewWebhookNotifier(
+		notifier.WithMetrics(registerer),
+		notifier.WithLogger(logger),
+	)
+	emailNotifier := notifier.NewEmailNotifier(
+		*s.webURL,
+		emailService,
+		notifier.WithMetrics(registerer),
+		notifier.WithLogger(logger),
+	)
 
 	notificationSender := subscriptionsender.NewSender(
 		subscriptionClient,
-		[]notifier.Notifier{slackNotifier},
+		[]notifier.Notifier{slackNotifier, teamsNotifier, webhookNotifier, emailNotifier},
 		subscriptionsender.WithMetrics(registerer),
 		subscriptionsender.WithLogger(logger),
 	)
@@ -633,6 +739,9 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		opsevent.NewProgressiveRolloutWatcher(
 			environmentClient,
 			autoOpsClient,
+			featureClient,
+			eventCounterClient,
+			experimentClient,
 			progressiveRolloutExecutor,
 			ftcacher.NewFeatureFlagCacher(featureStorage, nonPersistentRedisCaches, logger),
 			jobs.WithTimeout(5*time.Minute),
@@ -647,10 +756,12 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		calculator.NewExperimentCalculate(
 			stan.NewStan(*s.stanHost, *s.stanPort, registerer, logger),
 			*s.stanModelID,
+			experimentcalc.BinomialSampler(*s.binomialSampler),
 			environmentClient,
 			experimentClient,
 			eventCounterClient,
 			featureClient,
+			autoOpsClient,
 			experimentResultStorage,
 			calculator.NewExperimentLock(nonPersistentRedisClient, *s.experimentLockTTL),
 			location,
@@ -714,6 +825,22 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 			promClient,
 			jobs.WithLogger(logger),
 		),
+		lifecycle.NewFeatureLifecycleUpdater(
+			environmentClient,
+			featureStorage,
+			codeRefStorage,
+			notificationSender,
+			jobs.WithTimeout(10*time.Minute),
+			jobs.WithLogger(logger),
+		),
+		secret.NewSecretKeyRotator(
+			environmentClient,
+			secretCipher,
+			secretRotators,
+			adminSecretRotators,
+			jobs.WithTimeout(30*time.Minute),
+			jobs.WithLogger(logger),
+		),
 		logger,
 	)
 
@@ -818,6 +945,30 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 	return nil
 }
 
+// createSecretCipher returns nil when the secrets are stored in plaintext.
+func (s *server) createSecretCipher(
+	ctx context.Context,
+	dataKeyStorage cryptostorage.DataKeyStorage,
+	environmentStorage v2es.EnvironmentStorage,
+) (crypto.SecretCipher, error) {
+	return crypto.NewSecretCipher(
+		ctx,
+		crypto.KeyBackend(*s.secretEncryptionBackend),
+		*s.secretEncryptionKey,
+		dataKeyStorage,
+		func(ctx context.Context, environmentID string) (string, error) {
+			env, err := environmentStorage.GetEnvironmentV2(ctx, environmentID)
+			if err != nil {
+				return "", err
+			}
+			return env.OrganizationId, nil
+		},
+		crypto.WithAWSRegion(*s.secretEncryptionAWSRegion),
+		crypto.WithVaultAddress(*s.secretEncryptionVaultAddress),
+		crypto.WithVaultToken(*s.secretEncryptionVaultToken),
+	)
+}
+
 func (s *server) createMySQLClient(
 	ctx context.Context,
 	registerer metrics.Registerer,
@@ -825,6 +976,14 @@ func (s *server) createMySQLClient(
 ) (mysql.Client, error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
+	if *s.operationalDatabaseType == "sqlite" {
+		return sqlite.NewClient(
+			ctx,
+			*s.sqlitePath,
+			sqlite.WithLogger(logger),
+			sqlite.WithMetrics(registerer),
+		)
+	}
 	return mysql.NewClient(
 		ctx,
 		*s.mysqlUser, *s.mysqlPass, *s.mysqlHost,
@@ -859,6 +1018,29 @@ func (s *server) createPostgresClient(
 	)
 }
 
+// createEmailService falls back to the no-op service when the email config isn't given,
+// so email subscriptions are skipped without failing the other notifiers.
+func (s *server) createEmailService(logger *zap.Logger) (email.Service, error) {
+	if *s.emailConfigPath == "" {
+		return email.NewNoOpService(logger), nil
+	}
+	bytes, err := os.ReadFile(*s.emailConfigPath)
+	if err != nil {
+		logger.Error("Failed to read email config file",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	config := email.Config{}
+	if err = json.Unmarshal(bytes, &config); err != nil {
+		logger.Error("Failed to unmarshal email config",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return email.NewService(config, logger)
+}
+
 func (s *server) insertTelepresenceMountRoot(path string) string {
 	volumeRoot := os.Getenv("TELEPRESENCE_ROOT")
 	if volumeRoot == "" {
@@ -26,6 +26,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	aoclient "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/client"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs"
 	environmentclient "github.com/bucketeer-io/bucketeer/v2/pkg/environment/client"
 	ecclient "github.com/bucketeer-io/bucketeer/v2/pkg/eventcounter/client"
@@ -58,10 +59,12 @@ type experimentCalculate struct {
 func NewExperimentCalculate(
 	httpStan *stan.Stan,
 	stanModelID string,
+	binomialSampler experimentcalc.BinomialSampler,
 	environmentClient environmentclient.Client,
 	experimentClient experimentclient.Client,
 	ecClient ecclient.Client,
 	featureClient featureclient.Client,
+	autoOpsClient aoclient.Client,
 	experimentResultStorage v2ecs.ExperimentResultStorage,
 	experimentLock *ExperimentLock,
 	location *time.Location,
@@ -81,10 +84,12 @@ func NewExperimentCalculate(
 		ecClient,
 		experimentClient,
 		featureClient,
+		autoOpsClient,
 		experimentResultStorage,
 		dopts.Metrics,
 		location,
 		dopts.Logger,
+		experimentcalc.WithBinomialSampler(binomialSampler),
 	)
 	return &experimentCalculate{
 		environmentClient: environmentClient,
@@ -0,0 +1,262 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs"
+	coderefstorage "github.com/bucketeer-io/bucketeer/v2/pkg/coderef/storage"
+	environmentclient "github.com/bucketeer-io/bucketeer/v2/pkg/environment/client"
+	featuredomain "github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
+	featurestorage "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/sender"
+	environmentproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+	senderproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription/sender"
+)
+
+const (
+	listRequestSize = 500
+)
+
+// featureLifecycleUpdater is a batch job that derives the lifecycle state of every
+// feature flag and reminds the maintainers of flags past their planned removal date.
+type featureLifecycleUpdater struct {
+	environmentClient environmentclient.Client
+	ftStorage         featurestorage.FeatureStorage
+	codeRefStorage    coderefstorage.CodeReferenceStorage
+	sender            sender.Sender
+	opts              *jobs.Options
+	logger            *zap.Logger
+}
+
+// NewFeatureLifecycleUpdater creates a new feature lifecycle updater batch job.
+func NewFeatureLifecycleUpdater(
+	environmentClient environmentclient.Client,
+	ftStorage featurestorage.FeatureStorage,
+	codeRefStorage coderefstorage.CodeReferenceStorage,
+	sender sender.Sender,
+	opts ...jobs.Option,
+) jobs.Job {
+	dopts := &jobs.Options{
+		Timeout: 10 * time.Minute,
+		Logger:  zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(dopts)
+	}
+	return &featureLifecycleUpdater{
+		environmentClient: environmentClient,
+		ftStorage:         ftStorage,
+		codeRefStorage:    codeRefStorage,
+		sender:            sender,
+		opts:              dopts,
+		logger:            dopts.Logger.Named("feature-lifecycle-updater"),
+	}
+}
+
+// Run executes the feature lifecycle batch job.
+// Environments are processed independently so one failure does not block the others.
+func (u *featureLifecycleUpdater) Run(ctx context.Context) (lastErr error) {
+	startTime := time.Now()
+	defer func() {
+		jobs.RecordJob(jobs.JobFeatureLifecycleUpdater, lastErr, time.Since(startTime))
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, u.opts.Timeout)
+	defer cancel()
+
+	environments, err := u.listEnvironments(ctx)
+	if err != nil {
+		u.logger.Error("Failed to list environments", zap.Error(err))
+		return err
+	}
+	for _, env := range environments {
+		if err := u.processEnvironment(ctx, env); err != nil {
+			u.logger.Error("Failed to process environment",
+				zap.String("environmentId", env.Id),
+				zap.Error(err),
+			)
+			lastErr = err
+			continue
+		}
+	}
+	return lastErr
+}
+
+// processEnvironment updates the lifecycle of the environment's features and
+// sends one reminder for all the flags whose planned removal date passed.
+func (u *featureLifecycleUpdater) processEnvironment(
+	ctx context.Context,
+	env *environmentproto.EnvironmentV2,
+) error {
+	features, err := u.listNonArchivedFeatures(ctx, env.Id)
+	if err != nil {
+		return err
+	}
+	if len(features) == 0 {
+		return nil
+	}
+	featureIDs := make([]string, 0, len(features))
+	for _, f := range features {
+		featureIDs = append(featureIDs, f.Id)
+	}
+	codeRefCounts, err := u.codeRefStorage.GetCodeReferenceCountsByFeatureIDs(ctx, env.Id, featureIDs)
+	if err != nil {
+		return err
+	}
+	// Environments that never uploaded code references must not have every flag
+	// reported as removed from the code.
+	codeRefsTracked := false
+	for _, count := range codeRefCounts {
+		if count > 0 {
+			codeRefsTracked = true
+			break
+		}
+	}
+	var lastErr error
+	var dueFeatures []*featureproto.Feature
+	// The reminder is marked as sent only once the notification is sent,
+	// so the features due for a reminder are saved after sending it.
+	dueChanged := make(map[string]bool)
+	now := time.Now()
+	for _, f := range features {
+		fd := &featuredomain.Feature{Feature: f}
+		state := fd.DeriveLifecycleState(featuredomain.LifecycleSignals{
+			CodeRefCount:    codeRefCounts[f.Id],
+			CodeRefsTracked: codeRefsTracked,
+		}, now)
+		changed := fd.SetLifecycleState(state, now)
+		if fd.IsRemovalReminderDue(now) {
+			dueFeatures = append(dueFeatures, f)
+			dueChanged[f.Id] = changed
+			continue
+		}
+		if !changed {
+			continue
+		}
+		if err := u.updateLifecycle(ctx, f, env.Id); err != nil {
+			lastErr = err
+		}
+	}
+	if len(dueFeatures) == 0 {
+		return lastErr
+	}
+	ne, sendErr := u.createNotificationEvent(env, dueFeatures)
+	if sendErr == nil {
+		sendErr = u.sender.Send(ctx, ne)
+	}
+	for _, f := range dueFeatures {
+		if sendErr == nil {
+			(&featuredomain.Feature{Feature: f}).MarkRemovalReminded(now)
+		} else if !dueChanged[f.Id] {
+			continue
+		}
+		if err := u.updateLifecycle(ctx, f, env.Id); err != nil {
+			lastErr = err
+		}
+	}
+	if sendErr != nil {
+		return sendErr
+	}
+	return lastErr
+}
+
+func (u *featureLifecycleUpdater) updateLifecycle(
+	ctx context.Context,
+	f *featureproto.Feature,
+	environmentID string,
+) error {
+	if err := u.ftStorage.UpdateFeatureLifecycle(ctx, f.Id, f.Lifecycle, environmentID); err != nil {
+		u.logger.Error("Failed to update feature lifecycle",
+			zap.String("featureId", f.Id),
+			zap.String("environmentId", environmentID),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+func (u *featureLifecycleUpdater) createNotificationEvent(
+	environment *environmentproto.EnvironmentV2,
+	features []*featureproto.Feature,
+) (*senderproto.NotificationEvent, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	ne := &senderproto.NotificationEvent{
+		Id:            id.String(),
+		EnvironmentId: environment.Id,
+		SourceType:    subscriptionproto.Subscription_FEATURE_REMOVAL_DUE,
+		Notification: &senderproto.Notification{
+			Type: senderproto.Notification_FeatureRemovalDue,
+			FeatureRemovalDueNotification: &senderproto.FeatureRemovalDueNotification{
+				EnvironmentName:    environment.Name,
+				EnvironmentUrlCode: environment.UrlCode,
+				Features:           features,
+			},
+		},
+		IsAdminEvent: false,
+	}
+	return ne, nil
+}
+
+func (u *featureLifecycleUpdater) listEnvironments(
+	ctx context.Context,
+) ([]*environmentproto.EnvironmentV2, error) {
+	var environments []*environmentproto.EnvironmentV2
+	cursor := ""
+	for {
+		resp, err := u.environmentClient.ListEnvironmentsV2(ctx, &environmentproto.ListEnvironmentsV2Request{
+			PageSize: listRequestSize,
+			Cursor:   cursor,
+			Archived: wrapperspb.Bool(false),
+		})
+		if err != nil {
+			return nil, err
+		}
+		environments = append(environments, resp.Environments...)
+		environmentSize := len(resp.Environments)
+		if environmentSize == 0 || environmentSize < listRequestSize {
+			return environments, nil
+		}
+		cursor = resp.Cursor
+	}
+}
+
+// listNonArchivedFeatures retrieves all non-archived features for the given environment.
+func (u *featureLifecycleUpdater) listNonArchivedFeatures(
+	ctx context.Context,
+	environmentID string,
+) ([]*featureproto.Feature, error) {
+	archived := false
+	features, _, _, err := u.ftStorage.ListFeatures(ctx, featurestorage.ListFeaturesParams{
+		EnvironmentID: environmentID,
+		Archived:      &archived,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return features, nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs"
+	coderefstoragemock "github.com/bucketeer-io/bucketeer/v2/pkg/coderef/storage/mock"
+	environmentclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/environment/client/mock"
+	featurestoragemock "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2/mock"
+	sendermock "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/sender/mock"
+	environmentproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+	senderproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription/sender"
+)
+
+func TestFeatureLifecycleUpdaterRun(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	now := time.Now().Unix()
+	errInternal := errors.New("internal")
+	env := &environmentproto.EnvironmentV2{Id: "ns0", Name: "ns0", UrlCode: "ns0-code"}
+	newRemovalDueFeature := func() *featureproto.Feature {
+		return &featureproto.Feature{
+			Id:           "fid",
+			Name:         "fname",
+			Enabled:      false,
+			OffVariation: "vid",
+			Lifecycle: &featureproto.FeatureLifecycle{
+				Kind:             featureproto.FeatureLifecycle_RELEASE,
+				PlannedRemovalAt: now - 60,
+				State:            featureproto.FeatureLifecycle_ROLLED_OUT,
+				StateUpdatedAt:   now - 60,
+			},
+		}
+	}
+	expectEnvironments := func(u *featureLifecycleUpdater) {
+		u.environmentClient.(*environmentclientmock.MockClient).EXPECT().ListEnvironmentsV2(
+			gomock.Any(), gomock.Any()).Return(
+			&environmentproto.ListEnvironmentsV2Response{
+				Environments: []*environmentproto.EnvironmentV2{env},
+			}, nil)
+	}
+
+	patterns := []struct {
+		desc        string
+		setup       func(*featureLifecycleUpdater)
+		expectedErr error
+	}{
+		{
+			desc: "err: list environments",
+			setup: func(u *featureLifecycleUpdater) {
+				u.environmentClient.(*environmentclientmock.MockClient).EXPECT().ListEnvironmentsV2(
+					gomock.Any(), gomock.Any()).Return(nil, errInternal)
+			},
+			expectedErr: errInternal,
+		},
+		{
+			desc: "success: no features",
+			setup: func(u *featureLifecycleUpdater) {
+				expectEnvironments(u)
+				u.ftStorage.(*featurestoragemock.MockFeatureStorage).EXPECT().ListFeatures(
+					gomock.Any(), gomock.Any()).Return([]*featureproto.Feature{}, 0, int64(0), nil)
+			},
+		},
+		{
+			desc: "success: state unchanged",
+			setup: func(u *featureLifecycleUpdater) {
+				expectEnvironments(u)
+				u.ftStorage.(*featurestoragemock.MockFeatureStorage).EXPECT().ListFeatures(
+					gomock.Any(), gomock.Any()).Return([]*featureproto.Feature{{
+					Id:      "fid",
+					Enabled: true,
+					Lifecycle: &featureproto.FeatureLifecycle{
+						Kind:  featureproto.FeatureLifecycle_PERMISSION,
+						State: featureproto.FeatureLifecycle_ACTIVE,
+					},
+				}}, 1, int64(1), nil)
+				u.codeRefStorage.(*coderefstoragemock.MockCodeReferenceStorage).EXPECT().
+					GetCodeReferenceCountsByFeatureIDs(gomock.Any(), env.Id, []string{"fid"}).
+					Return(map[string]int64{"fid": 2}, nil)
+			},
+		},
+		{
+			desc: "success: removal due",
+			setup: func(u *featureLifecycleUpdater) {
+				expectEnvironments(u)
+				u.ftStorage.(*featurestoragemock.MockFeatureStorage).EXPECT().ListFeatures(
+					gomock.Any(), gomock.Any()).Return(
+					[]*featureproto.Feature{newRemovalDueFeature()}, 1, int64(1), nil)
+				u.codeRefStorage.(*coderefstoragemock.MockCodeReferenceStorage).EXPECT().
+					GetCodeReferenceCountsByFeatureIDs(gomock.Any(), env.Id, []string{"fid"}).
+					Return(map[string]int64{}, nil)
+				u.ftStorage.(*featurestoragemock.MockFeatureStorage).EXPECT().UpdateFeatureLifecycle(
+					gomock.Any(), "fid", gomock.Any(), env.Id,
+				).DoAndReturn(func(
+					_ context.Context, _ string, lifecycle *featureproto.FeatureLifecycle, _ string,
+				) error {
+					assert.Equal(t, featureproto.FeatureLifecycle_READY_TO_REMOVE, lifecycle.State)
+					assert.GreaterOrEqual(t, lifecycle.RemovalRemindedAt, now)
+					return nil
+				})
+				u.sender.(*sendermock.MockSender).EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, ne *senderproto.NotificationEvent) error {
+						assert.Equal(t, subscriptionproto.Subscription_FEATURE_REMOVAL_DUE, ne.SourceType)
+						assert.Equal(t, senderproto.Notification_FeatureRemovalDue, ne.Notification.Type)
+						n := ne.Notification.FeatureRemovalDueNotification
+						assert.Equal(t, env.UrlCode, n.EnvironmentUrlCode)
+						assert.Len(t, n.Features, 1)
+						return nil
+					})
+			},
+		},
+		{
+			desc: "err: update lifecycle",
+			setup: func(u *featureLifecycleUpdater) {
+				expectEnvironments(u)
+				u.ftStorage.(*featurestoragemock.MockFeatureStorage).EXPECT().ListFeatures(
+					gomock.Any(), gomock.Any()).Return(
+					[]*featureproto.Feature{newRemovalDueFeature()}, 1, int64(1), nil)
+				u.codeRefStorage.(*coderefstoragemock.MockCodeReferenceStorage).EXPECT().
+					GetCodeReferenceCountsByFeatureIDs(gomock.Any(), env.Id, []string{"fid"}).
+					Return(map[string]int64{}, nil)
+				u.ftStorage.(*featurestoragemock.MockFeatureStorage).EXPECT().UpdateFeatureLifecycle(
+					gomock.Any(), "fid", gomock.Any(), env.Id,
+				).Return(errInternal)
+				u.sender.(*sendermock.MockSender).EXPECT().Send(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedErr: errInternal,
+		},
+		{
+			desc: "err: send reminder",
+			setup: func(u *featureLifecycleUpdater) {
+				expectEnvironments(u)
+				u.ftStorage.(*featurestoragemock.MockFeatureStorage).EXPECT().ListFeatures(
+					gomock.Any(), gomock.Any()).Return(
+					[]*featureproto.Feature{newRemovalDueFeature()}, 1, int64(1), nil)
+				u.codeRefStorage.(*coderefstoragemock.MockCodeReferenceStorage).EXPECT().
+					GetCodeReferenceCountsByFeatureIDs(gomock.Any(), env.Id, []string{"fid"}).
+					Return(map[string]int64{}, nil)
+				u.sender.(*sendermock.MockSender).EXPECT().Send(gomock.Any(), gomock.Any()).Return(errInternal)
+				// The state change is saved, but the reminder stays due so the next run retries it.
+				u.ftStorage.(*featurestoragemock.MockFeatureStorage).EXPECT().UpdateFeatureLifecycle(
+					gomock.Any(), "fid", gomock.Any(), env.Id,
+				).DoAndReturn(func(
+					_ context.Context, _ string, lifecycle *featureproto.FeatureLifecycle, _ string,
+				) error {
+					assert.Equal(t, featureproto.FeatureLifecycle_READY_TO_REMOVE, lifecycle.State)
+					assert.Zero(t, lifecycle.RemovalRemindedAt)
+					return nil
+				})
+			},
+			expectedErr: errInternal,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			u := newFeatureLifecycleUpdaterWithMock(t, mockController)
+			if p.setup != nil {
+				p.setup(u)
+			}
+			err := u.Run(context.Background())
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func newFeatureLifecycleUpdaterWithMock(t *testing.T, c *gomock.Controller) *featureLifecycleUpdater {
+	t.Helper()
+	return &featureLifecycleUpdater{
+		environmentClient: environmentclientmock.NewMockClient(c),
+		ftStorage:         featurestoragemock.NewMockFeatureStorage(c),
+		codeRefStorage:    coderefstoragemock.NewMockCodeReferenceStorage(c),
+		sender:            sendermock.NewMockSender(c),
+		logger:            zap.NewNop(),
+		opts: &jobs.Options{
+			Timeout: 5 * time.Minute,
+		},
+	}
+}
@@ -51,6 +51,8 @@ const (
 	JobFeatureAutoArchiver         = "feature_auto_archiver"
 	JobScheduledFlagChangeExecutor = "scheduled_flag_change_executor"
 	JobMonthlySummarizer           = "monthly_summarizer"
+	JobFeatureLifecycleUpdater     = "feature_lifecycle_updater"
+	JobSecretKeyRotator            = "secret_key_rotator"
 
 	// Error types
 	ErrorTypeTimeout    = "Timeout"
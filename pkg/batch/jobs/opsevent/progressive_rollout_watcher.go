@@ -25,15 +25,26 @@ import (
 	autoopsdomain "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/domain"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs"
 	envclient "github.com/bucketeer-io/bucketeer/v2/pkg/environment/client"
+	ecclient "github.com/bucketeer-io/bucketeer/v2/pkg/eventcounter/client"
+	experimentclient "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/client"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/experimentcalculator/experimentcalc"
 	ftcacher "github.com/bucketeer-io/bucketeer/v2/pkg/feature/cacher"
+	ftclient "github.com/bucketeer-io/bucketeer/v2/pkg/feature/client"
+	ftdomain "github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/opsevent/batch/executor"
 	aoproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
 	envproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
+	ecproto "github.com/bucketeer-io/bucketeer/v2/proto/eventcounter"
+	experimentproto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+	ftproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
 )
 
 type progressiveRolloutWatcher struct {
 	envClient                  envclient.Client
 	aoClient                   aoclient.Client
+	featureClient              ftclient.Client
+	eventCounterClient         ecclient.Client
+	experimentClient           experimentclient.Client
 	progressiveRolloutExecutor executor.ProgressiveRolloutExecutor
 	ftCacher                   ftcacher.FeatureFlagCacher
 	opts                       *jobs.Options
@@ -43,6 +54,9 @@ type progressiveRolloutWatcher struct {
 func NewProgressiveRolloutWatcher(
 	envClient envclient.Client,
 	aoClient aoclient.Client,
+	featureClient ftclient.Client,
+	eventCounterClient ecclient.Client,
+	experimentClient experimentclient.Client,
 	progressiveRolloutExecutor executor.ProgressiveRolloutExecutor,
 	ftCacher ftcacher.FeatureFlagCacher,
 	opts ...jobs.Option,
@@ -57,6 +71,9 @@ func NewProgressiveRolloutWatcher(
 	return &progressiveRolloutWatcher{
 		envClient:                  envClient,
 		aoClient:                   aoClient,
+		featureClient:              featureClient,
+		eventCounterClient:         eventCounterClient,
+		experimentClient:           experimentClient,
 		progressiveRolloutExecutor: progressiveRolloutExecutor,
 		ftCacher:                   ftCacher,
 		opts:                       dopts,
@@ -93,6 +110,17 @@ func (w *progressiveRolloutWatcher) Run(
 			if pr.IsFinished() || pr.IsStopped() {
 				continue
 			}
+			// A rollout regressing one of its guardrail goals is halted
+			// instead of being advanced to its next schedule.
+			halted, err := w.checkGuardrails(ctx, p, e.Id)
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			if halted {
+				executed = true
+				continue
+			}
 			wasExecuted, err := w.executeProgressiveRollout(ctx, p, e.Id)
 			if err != nil {
 				lastErr = err
@@ -185,3 +213,146 @@ func (w *progressiveRolloutWatcher) executeProgressiveRollout(
 	}
 	return false, nil
 }
+
+// checkGuardrails compares the rollout's target variation with its control on
+// every guardrail goal, counting from the last executed schedule, and halts
+// the rollout when one of them is breached. It returns true if the rollout was
+// halted.
+func (w *progressiveRolloutWatcher) checkGuardrails(
+	ctx context.Context,
+	progressiveRollout *aoproto.ProgressiveRollout,
+	environmentId string,
+) (bool, error) {
+	if len(progressiveRollout.GuardrailGoals) == 0 {
+		return false, nil
+	}
+	pr := &autoopsdomain.ProgressiveRollout{ProgressiveRollout: progressiveRollout}
+	startAt, err := pr.LastTriggeredAt()
+	if err != nil {
+		return false, err
+	}
+	// Nothing is served from the target variation before the first schedule.
+	if startAt == 0 {
+		return false, nil
+	}
+	resp, err := w.featureClient.GetFeature(ctx, &ftproto.GetFeatureRequest{
+		Id:            progressiveRollout.FeatureId,
+		EnvironmentId: environmentId,
+	})
+	if err != nil {
+		w.logger.Error("Failed to get feature", zap.Error(err),
+			zap.String("environmentId", environmentId),
+			zap.String("featureId", progressiveRollout.FeatureId),
+			zap.String("progressiveRolloutId", progressiveRollout.Id),
+		)
+		return false, err
+	}
+	controlVariationID, err := pr.GetControlVariationID(&ftdomain.Feature{Feature: resp.Feature})
+	if err != nil {
+		return false, err
+	}
+	targetVariationID, err := pr.GetTargetVariationID()
+	if err != nil {
+		return false, err
+	}
+	variationIDs := []string{controlVariationID, targetVariationID}
+	endAt := time.Now().Unix()
+	evalResp, err := w.eventCounterClient.GetExperimentEvaluationCount(
+		ctx,
+		&ecproto.GetExperimentEvaluationCountRequest{
+			EnvironmentId:  environmentId,
+			StartAt:        startAt,
+			EndAt:          endAt,
+			FeatureId:      progressiveRollout.FeatureId,
+			FeatureVersion: resp.Feature.Version,
+			VariationIds:   variationIDs,
+		},
+	)
+	if err != nil {
+		w.logger.Error("Failed to get evaluation count", zap.Error(err),
+			zap.String("environmentId", environmentId),
+			zap.String("featureId", progressiveRollout.FeatureId),
+			zap.String("progressiveRolloutId", progressiveRollout.Id),
+		)
+		return false, err
+	}
+	for _, g := range progressiveRollout.GuardrailGoals {
+		goalResp, err := w.experimentClient.GetGoal(ctx, &experimentproto.GetGoalRequest{
+			Id:            g.GoalId,
+			EnvironmentId: environmentId,
+		})
+		if err != nil {
+			w.logger.Error("Failed to get goal", zap.Error(err),
+				zap.String("environmentId", environmentId),
+				zap.String("progressiveRolloutId", progressiveRollout.Id),
+				zap.String("goalId", g.GoalId),
+			)
+			return false, err
+		}
+		goalCountResp, err := w.eventCounterClient.GetExperimentGoalCount(
+			ctx,
+			&ecproto.GetExperimentGoalCountRequest{
+				EnvironmentId:      environmentId,
+				StartAt:            startAt,
+				EndAt:              endAt,
+				GoalId:             g.GoalId,
+				FeatureId:          progressiveRollout.FeatureId,
+				FeatureVersion:     resp.Feature.Version,
+				VariationIds:       variationIDs,
+				ValueCapPercentile: goalResp.Goal.GetValueCapPercentile(),
+			},
+		)
+		if err != nil {
+			w.logger.Error("Failed to get goal count", zap.Error(err),
+				zap.String("environmentId", environmentId),
+				zap.String("progressiveRolloutId", progressiveRollout.Id),
+				zap.String("goalId", g.GoalId),
+			)
+			return false, err
+		}
+		probWorse := experimentcalc.ProbabilityWorse(
+			goalResp.Goal,
+			findVariationCount(evalResp.VariationCounts, controlVariationID),
+			findVariationCount(goalCountResp.VariationCounts, controlVariationID),
+			findVariationCount(evalResp.VariationCounts, targetVariationID),
+			findVariationCount(goalCountResp.VariationCounts, targetVariationID),
+		)
+		if !autoopsdomain.GuardrailBreached(g, probWorse) {
+			continue
+		}
+		w.logger.Info("Halting progressive rollout on guardrail",
+			zap.String("environmentId", environmentId),
+			zap.String("featureId", progressiveRollout.FeatureId),
+			zap.String("progressiveRolloutId", progressiveRollout.Id),
+			zap.String("goalId", g.GoalId),
+			zap.Float64("probabilityWorse", probWorse),
+		)
+		if _, err := w.aoClient.ExecuteGuardrailHalt(ctx, &aoproto.ExecuteGuardrailHaltRequest{
+			EnvironmentId:        environmentId,
+			FeatureId:            progressiveRollout.FeatureId,
+			GoalId:               g.GoalId,
+			ControlVariationId:   controlVariationID,
+			VariationId:          targetVariationID,
+			ProbabilityWorse:     probWorse,
+			ProgressiveRolloutId: progressiveRollout.Id,
+		}); err != nil {
+			w.logger.Error("Failed to halt progressive rollout on guardrail", zap.Error(err),
+				zap.String("environmentId", environmentId),
+				zap.String("progressiveRolloutId", progressiveRollout.Id),
+				zap.String("goalId", g.GoalId),
+			)
+			return false, err
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func findVariationCount(vcs []*ecproto.VariationCount, variationID string) *ecproto.VariationCount {
+	for _, vc := range vcs {
+		if vc.VariationId == variationID {
+			return vc
+		}
+	}
+	return nil
+}
@@ -29,15 +29,21 @@ import (
 	aoclientemock "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/client/mock"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs"
 	envclientemock "github.com/bucketeer-io/bucketeer/v2/pkg/environment/client/mock"
+	ecclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/eventcounter/client/mock"
+	experimentclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/client/mock"
 	ftcachermock "github.com/bucketeer-io/bucketeer/v2/pkg/feature/cacher/mock"
+	ftclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/feature/client/mock"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
 	executormock "github.com/bucketeer-io/bucketeer/v2/pkg/opsevent/batch/executor/mock"
 	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
 	environmentproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
+	ecproto "github.com/bucketeer-io/bucketeer/v2/proto/eventcounter"
+	experimentproto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+	ftproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
 )
 
 func TestNewProgressiveRolloutWatcher(t *testing.T) {
-	w := NewProgressiveRolloutWatcher(nil, nil, nil, nil)
+	w := NewProgressiveRolloutWatcher(nil, nil, nil, nil, nil, nil, nil)
 	assert.IsType(t, &progressiveRolloutWatcher{}, w)
 }
 
@@ -373,6 +379,122 @@ func TestRunProgressiveRolloutWatcher(t *testing.T) {
 	}
 }
 
+func TestCheckGuardrailsProgressiveRolloutWatcher(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	newProgressiveRollout := func(
+		triggeredAt int64,
+		guardrails ...*autoopsproto.GuardrailGoal,
+	) *autoopsproto.ProgressiveRollout {
+		c, err := anypb.New(&autoopsproto.ProgressiveRolloutTemplateScheduleClause{
+			ControlVariationId: "vID1",
+			TargetVariationId:  "vID2",
+			Schedules: []*autoopsproto.ProgressiveRolloutSchedule{
+				{ScheduleId: "sID1", ExecuteAt: triggeredAt, TriggeredAt: triggeredAt},
+				{ScheduleId: "sID2", ExecuteAt: time.Now().Add(time.Hour).Unix()},
+			},
+		})
+		require.NoError(t, err)
+		return &autoopsproto.ProgressiveRollout{
+			Id:             "prID",
+			FeatureId:      "fID",
+			Clause:         c,
+			Type:           autoopsproto.ProgressiveRollout_TEMPLATE_SCHEDULE,
+			GuardrailGoals: guardrails,
+		}
+	}
+	setupCounts := func(w *progressiveRolloutWatcher, targetGoalUsers int64) {
+		w.featureClient.(*ftclientmock.MockClient).EXPECT().GetFeature(gomock.Any(), gomock.Any()).Return(
+			&ftproto.GetFeatureResponse{Feature: &ftproto.Feature{Id: "fID", Version: 3}}, nil,
+		)
+		ec := w.eventCounterClient.(*ecclientmock.MockClient)
+		ec.EXPECT().GetExperimentEvaluationCount(gomock.Any(), gomock.Any()).Return(
+			&ecproto.GetExperimentEvaluationCountResponse{
+				VariationCounts: []*ecproto.VariationCount{
+					{VariationId: "vID1", UserCount: 1000},
+					{VariationId: "vID2", UserCount: 1000},
+				},
+			}, nil,
+		)
+		w.experimentClient.(*experimentclientmock.MockClient).EXPECT().GetGoal(gomock.Any(), gomock.Any()).Return(
+			&experimentproto.GetGoalResponse{Goal: &experimentproto.Goal{Id: "gID"}}, nil,
+		)
+		ec.EXPECT().GetExperimentGoalCount(gomock.Any(), gomock.Any()).Return(
+			&ecproto.GetExperimentGoalCountResponse{
+				VariationCounts: []*ecproto.VariationCount{
+					{VariationId: "vID1", UserCount: 150},
+					{VariationId: "vID2", UserCount: targetGoalUsers},
+				},
+			}, nil,
+		)
+	}
+	guardrail := &autoopsproto.GuardrailGoal{GoalId: "gID"}
+	triggeredAt := time.Now().Add(-time.Hour).Unix()
+
+	patterns := []struct {
+		desc               string
+		setup              func(*progressiveRolloutWatcher)
+		progressiveRollout *autoopsproto.ProgressiveRollout
+		expected           bool
+		expectedErr        error
+	}{
+		{
+			desc:               "no guardrail goals",
+			progressiveRollout: newProgressiveRollout(triggeredAt),
+			expected:           false,
+		},
+		{
+			desc:               "not triggered yet",
+			progressiveRollout: newProgressiveRollout(0, guardrail),
+			expected:           false,
+		},
+		{
+			desc: "not breached",
+			setup: func(w *progressiveRolloutWatcher) {
+				setupCounts(w, 150)
+			},
+			progressiveRollout: newProgressiveRollout(triggeredAt, guardrail),
+			expected:           false,
+		},
+		{
+			desc: "breached",
+			setup: func(w *progressiveRolloutWatcher) {
+				setupCounts(w, 100)
+				w.aoClient.(*aoclientemock.MockClient).EXPECT().ExecuteGuardrailHalt(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(
+						_ context.Context,
+						req *autoopsproto.ExecuteGuardrailHaltRequest,
+						_ ...any,
+					) (*autoopsproto.ExecuteGuardrailHaltResponse, error) {
+						assert.Equal(t, "eID", req.EnvironmentId)
+						assert.Equal(t, "fID", req.FeatureId)
+						assert.Equal(t, "gID", req.GoalId)
+						assert.Equal(t, "vID1", req.ControlVariationId)
+						assert.Equal(t, "vID2", req.VariationId)
+						assert.Equal(t, "prID", req.ProgressiveRolloutId)
+						return &autoopsproto.ExecuteGuardrailHaltResponse{}, nil
+					},
+				)
+			},
+			progressiveRollout: newProgressiveRollout(triggeredAt, guardrail),
+			expected:           true,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			w := newProgressiveRolloutWacherWithMock(t, mockController)
+			if p.setup != nil {
+				p.setup(w)
+			}
+			halted, err := w.checkGuardrails(context.Background(), p.progressiveRollout, "eID")
+			assert.Equal(t, p.expectedErr, err)
+			assert.Equal(t, p.expected, halted)
+		})
+	}
+}
+
 func newProgressiveRolloutWacherWithMock(t *testing.T, mockController *gomock.Controller) *progressiveRolloutWatcher {
 	t.Helper()
 	logger, err := log.NewLogger()
@@ -380,6 +502,9 @@ func newProgressiveRolloutWacherWithMock(t *testing.T, mockController *gomock.Co
 	return &progressiveRolloutWatcher{
 		envClient:                  envclientemock.NewMockClient(mockController),
 		aoClient:                   aoclientemock.NewMockClient(mockController),
+		featureClient:              ftclientmock.NewMockClient(mockController),
+		eventCounterClient:         ecclientmock.NewMockClient(mockController),
+		experimentClient:           experimentclientmock.NewMockClient(mockController),
 		progressiveRolloutExecutor: executormock.NewMockProgressiveRolloutExecutor(mockController),
 		ftCacher:                   ftcachermock.NewMockFeatureFlagCacher(mockController),
 		logger:                     logger,
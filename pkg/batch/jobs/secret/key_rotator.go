@@ -0,0 +1,167 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/crypto"
+	environmentclient "github.com/bucketeer-io/bucketeer/v2/pkg/environment/client"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage"
+	environmentproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
+)
+
+const (
+	listRequestSize = 500
+)
+
+// secretKeyRotator is a batch job that creates a new data key for every organization
+// and re-encrypts the stored secrets that aren't encrypted with it yet.
+type secretKeyRotator struct {
+	environmentClient   environmentclient.Client
+	cipher              crypto.SecretCipher
+	environmentRotators []crypto.SecretRotator
+	adminRotators       []crypto.SecretRotator
+	opts                *jobs.Options
+	logger              *zap.Logger
+}
+
+// NewSecretKeyRotator creates a new secret key rotator batch job.
+// The environment rotators re-encrypt the secrets of the environments,
+// and the admin rotators the ones of the admin environment.
+// The job does nothing when the cipher is nil, since the secrets are stored in plaintext.
+func NewSecretKeyRotator(
+	environmentClient environmentclient.Client,
+	cipher crypto.SecretCipher,
+	environmentRotators []crypto.SecretRotator,
+	adminRotators []crypto.SecretRotator,
+	opts ...jobs.Option,
+) jobs.Job {
+	dopts := &jobs.Options{
+		Timeout: 30 * time.Minute,
+		Logger:  zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(dopts)
+	}
+	return &secretKeyRotator{
+		environmentClient:   environmentClient,
+		cipher:              cipher,
+		environmentRotators: environmentRotators,
+		adminRotators:       adminRotators,
+		opts:                dopts,
+		logger:              dopts.Logger.Named("secret-key-rotator"),
+	}
+}
+
+// Run rotates the data keys, then re-encrypts the secrets of every environment.
+// A failure doesn't stop the job, since the next run re-encrypts whatever was left.
+func (r *secretKeyRotator) Run(ctx context.Context) (lastErr error) {
+	startTime := time.Now()
+	defer func() {
+		jobs.RecordJob(jobs.JobSecretKeyRotator, lastErr, time.Since(startTime))
+	}()
+	if r.cipher == nil {
+		r.logger.Info("Skipped because the secret encryption is disabled")
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.opts.Timeout)
+	defer cancel()
+
+	environments, err := r.listEnvironments(ctx)
+	if err != nil {
+		r.logger.Error("Failed to list environments", zap.Error(err))
+		return err
+	}
+	organizationIDs := []string{storage.AdminEnvironmentID}
+	seen := map[string]struct{}{storage.AdminEnvironmentID: {}}
+	for _, env := range environments {
+		if _, ok := seen[env.OrganizationId]; ok {
+			continue
+		}
+		seen[env.OrganizationId] = struct{}{}
+		organizationIDs = append(organizationIDs, env.OrganizationId)
+	}
+	for _, organizationID := range organizationIDs {
+		if err := r.cipher.RotateDataKey(ctx, organizationID); err != nil {
+			r.logger.Error("Failed to rotate the data key",
+				zap.String("organizationId", organizationID),
+				zap.Error(err),
+			)
+			lastErr = err
+		}
+	}
+	for _, env := range environments {
+		if err := r.rotateSecrets(ctx, env.Id, r.environmentRotators); err != nil {
+			lastErr = err
+		}
+	}
+	if err := r.rotateSecrets(ctx, storage.AdminEnvironmentID, r.adminRotators); err != nil {
+		lastErr = err
+	}
+	return lastErr
+}
+
+func (r *secretKeyRotator) rotateSecrets(
+	ctx context.Context,
+	environmentID string,
+	rotators []crypto.SecretRotator,
+) (lastErr error) {
+	for _, rotator := range rotators {
+		rotated, err := rotator.RotateSecrets(ctx, environmentID)
+		if rotated > 0 {
+			r.logger.Info("Re-encrypted secrets",
+				zap.String("environmentId", environmentID),
+				zap.Int("count", rotated),
+			)
+		}
+		if err != nil {
+			r.logger.Error("Failed to re-encrypt secrets",
+				zap.String("environmentId", environmentID),
+				zap.Error(err),
+			)
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// listEnvironments includes the archived environments, since their secrets are still stored.
+func (r *secretKeyRotator) listEnvironments(
+	ctx context.Context,
+) ([]*environmentproto.EnvironmentV2, error) {
+	var environments []*environmentproto.EnvironmentV2
+	cursor := ""
+	for {
+		resp, err := r.environmentClient.ListEnvironmentsV2(ctx, &environmentproto.ListEnvironmentsV2Request{
+			PageSize: listRequestSize,
+			Cursor:   cursor,
+		})
+		if err != nil {
+			return nil, err
+		}
+		environments = append(environments, resp.Environments...)
+		environmentSize := len(resp.Environments)
+		if environmentSize == 0 || environmentSize < listRequestSize {
+			return environments, nil
+		}
+		cursor = resp.Cursor
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/batch/jobs"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/crypto"
+	cryptomock "github.com/bucketeer-io/bucketeer/v2/pkg/crypto/mock"
+	environmentclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/environment/client/mock"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage"
+	environmentproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
+)
+
+func TestSecretKeyRotatorRun(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	errInternal := errors.New("internal")
+	environments := []*environmentproto.EnvironmentV2{
+		{Id: "ns0", OrganizationId: "org0"},
+		{Id: "ns1", OrganizationId: "org0"},
+		{Id: "ns2", OrganizationId: "org1"},
+	}
+	expectEnvironments := func(r *secretKeyRotator) {
+		r.environmentClient.(*environmentclientmock.MockClient).EXPECT().ListEnvironmentsV2(
+			gomock.Any(), gomock.Any()).Return(
+			&environmentproto.ListEnvironmentsV2Response{Environments: environments}, nil)
+	}
+	expectDataKeys := func(r *secretKeyRotator, err error) {
+		cipher := r.cipher.(*cryptomock.MockSecretCipher)
+		cipher.EXPECT().RotateDataKey(gomock.Any(), storage.AdminEnvironmentID).Return(nil)
+		cipher.EXPECT().RotateDataKey(gomock.Any(), "org0").Return(err)
+		cipher.EXPECT().RotateDataKey(gomock.Any(), "org1").Return(nil)
+	}
+
+	patterns := []struct {
+		desc        string
+		setup       func(*secretKeyRotator)
+		expectedErr error
+	}{
+		{
+			desc: "success: disabled",
+			setup: func(r *secretKeyRotator) {
+				r.cipher = nil
+			},
+		},
+		{
+			desc: "err: list environments",
+			setup: func(r *secretKeyRotator) {
+				r.environmentClient.(*environmentclientmock.MockClient).EXPECT().ListEnvironmentsV2(
+					gomock.Any(), gomock.Any()).Return(nil, errInternal)
+			},
+			expectedErr: errInternal,
+		},
+		{
+			desc: "success",
+			setup: func(r *secretKeyRotator) {
+				expectEnvironments(r)
+				expectDataKeys(r, nil)
+				rotator := r.environmentRotators[0].(*cryptomock.MockSecretRotator)
+				for _, env := range environments {
+					rotator.EXPECT().RotateSecrets(gomock.Any(), env.Id).Return(1, nil)
+				}
+				r.adminRotators[0].(*cryptomock.MockSecretRotator).EXPECT().RotateSecrets(
+					gomock.Any(), storage.AdminEnvironmentID).Return(0, nil)
+			},
+		},
+		{
+			desc: "err: rotate data key",
+			setup: func(r *secretKeyRotator) {
+				expectEnvironments(r)
+				expectDataKeys(r, errInternal)
+				rotator := r.environmentRotators[0].(*cryptomock.MockSecretRotator)
+				for _, env := range environments {
+					rotator.EXPECT().RotateSecrets(gomock.Any(), env.Id).Return(0, nil)
+				}
+				r.adminRotators[0].(*cryptomock.MockSecretRotator).EXPECT().RotateSecrets(
+					gomock.Any(), storage.AdminEnvironmentID).Return(0, nil)
+			},
+			expectedErr: errInternal,
+		},
+		{
+			desc: "err: rotate secrets",
+			setup: func(r *secretKeyRotator) {
+				expectEnvironments(r)
+				expectDataKeys(r, nil)
+				rotator := r.environmentRotators[0].(*cryptomock.MockSecretRotator)
+				rotator.EXPECT().RotateSecrets(gomock.Any(), "ns0").Return(0, errInternal)
+				rotator.EXPECT().RotateSecrets(gomock.Any(), "ns1").Return(1, nil)
+				rotator.EXPECT().RotateSecrets(gomock.Any(), "ns2").Return(1, nil)
+				r.adminRotators[0].(*cryptomock.MockSecretRotator).EXPECT().RotateSecrets(
+					gomock.Any(), storage.AdminEnvironmentID).Return(1, nil)
+			},
+			expectedErr: errInternal,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			r := newSecretKeyRotatorWithMock(t, mockController)
+			if p.setup != nil {
+				p.setup(r)
+			}
+			err := r.Run(context.Background())
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func newSecretKeyRotatorWithMock(t *testing.T, c *gomock.Controller) *secretKeyRotator {
+	t.Helper()
+	return &secretKeyRotator{
+		environmentClient:   environmentclientmock.NewMockClient(c),
+		cipher:              cryptomock.NewMockSecretCipher(c),
+		environmentRotators: []crypto.SecretRotator{cryptomock.NewMockSecretRotator(c)},
+		adminRotators:       []crypto.SecretRotator{cryptomock.NewMockSecretRotator(c)},
+		logger:              zap.NewNop(),
+		opts: &jobs.Options{
+			Timeout: 5 * time.Minute,
+		},
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mock/$GOFILE
+package v3
+
+import (
+	"errors"
+	"time"
+
+	evaluation "github.com/bucketeer-io/bucketeer/v2/evaluation/go"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/cache"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+const (
+	segmentUserIndexKind = "segment_user_index"
+)
+
+// SegmentUserIndex is a segment prepared for the evaluation:
+// its rules and the index of its included users.
+type SegmentUserIndex struct {
+	Segment *featureproto.Segment
+	Users   *evaluation.SegmentUserIndex
+}
+
+// SegmentUserIndexCache keeps the evaluation index of the segments so it is built
+// once when the segment users are loaded, instead of on every evaluation.
+// The index is stored as is, so it must be backed by the in-memory cache.
+// It must be evicted when the segment or its users are updated.
+type SegmentUserIndexCache interface {
+	Get(segmentID, environmentId string) (*SegmentUserIndex, error)
+	Put(index *SegmentUserIndex, environmentId string) error
+	Evict(segmentID, environmentId string) error
+}
+
+type segmentUserIndexCache struct {
+	cache cache.Cache
+	ttl   time.Duration
+}
+
+func NewSegmentUserIndexCache(c *InMemoryCache, ttl time.Duration) SegmentUserIndexCache {
+	return &segmentUserIndexCache{cache: c, ttl: ttl}
+}
+
+func (c *segmentUserIndexCache) Get(segmentID, environmentId string) (*SegmentUserIndex, error) {
+	value, err := c.cache.Get(c.key(segmentID, environmentId))
+	if err != nil {
+		return nil, err
+	}
+	index, ok := value.(*SegmentUserIndex)
+	if !ok {
+		return nil, cache.ErrInvalidType
+	}
+	return index, nil
+}
+
+func (c *segmentUserIndexCache) Put(index *SegmentUserIndex, environmentId string) error {
+	if index == nil || index.Segment == nil {
+		return errors.New("segment user index cannot be nil")
+	}
+	return c.cache.Put(c.key(index.Segment.Id, environmentId), index, c.ttl)
+}
+
+func (c *segmentUserIndexCache) Evict(segmentID, environmentId string) error {
+	return evictKey(c.cache, c.key(segmentID, environmentId))
+}
+
+func (c *segmentUserIndexCache) key(segmentID, environmentId string) string {
+	return cache.MakeKey(segmentUserIndexKind, segmentID, environmentId)
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	evaluation "github.com/bucketeer-io/bucketeer/v2/evaluation/go"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/cache"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+func TestGetSegmentUserIndex(t *testing.T) {
+	t.Parallel()
+	index := createSegmentUserIndex()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*InMemoryCache)
+		expected    *SegmentUserIndex
+		expectedErr error
+	}{
+		{
+			desc:        "error_get_not_found",
+			setup:       func(c *InMemoryCache) {},
+			expected:    nil,
+			expectedErr: cache.ErrNotFound,
+		},
+		{
+			desc: "error_invalid_type",
+			setup: func(c *InMemoryCache) {
+				key := cache.MakeKey(segmentUserIndexKind, segmentID, environmentId)
+				require.NoError(t, c.Put(key, "test", time.Minute))
+			},
+			expected:    nil,
+			expectedErr: cache.ErrInvalidType,
+		},
+		{
+			desc: "success",
+			setup: func(c *InMemoryCache) {
+				key := cache.MakeKey(segmentUserIndexKind, segmentID, environmentId)
+				require.NoError(t, c.Put(key, index, time.Minute))
+			},
+			expected:    index,
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			c := NewInMemoryCache()
+			defer c.Destroy()
+			p.setup(c)
+			sc := NewSegmentUserIndexCache(c, time.Minute)
+			actual, err := sc.Get(segmentID, environmentId)
+			assert.Equal(t, p.expectedErr, err)
+			assert.Same(t, p.expected, actual)
+		})
+	}
+}
+
+func TestPutSegmentUserIndex(t *testing.T) {
+	t.Parallel()
+	index := createSegmentUserIndex()
+
+	patterns := []struct {
+		desc        string
+		input       *SegmentUserIndex
+		expectedErr error
+	}{
+		{
+			desc:        "error_index_nil",
+			input:       nil,
+			expectedErr: errors.New("segment user index cannot be nil"),
+		},
+		{
+			desc:        "error_segment_nil",
+			input:       &SegmentUserIndex{Users: index.Users},
+			expectedErr: errors.New("segment user index cannot be nil"),
+		},
+		{
+			desc:        "success",
+			input:       index,
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			c := NewInMemoryCache()
+			defer c.Destroy()
+			sc := NewSegmentUserIndexCache(c, time.Minute)
+			err := sc.Put(p.input, environmentId)
+			assert.Equal(t, p.expectedErr, err)
+			if err != nil {
+				return
+			}
+			actual, err := sc.Get(segmentID, environmentId)
+			require.NoError(t, err)
+			assert.Same(t, p.input, actual)
+			assert.True(t, actual.Users.Contains("user-id-1"))
+			assert.False(t, actual.Users.Contains("user-id-2"))
+		})
+	}
+}
+
+func TestEvictSegmentUserIndex(t *testing.T) {
+	t.Parallel()
+	c := NewInMemoryCache()
+	defer c.Destroy()
+	sc := NewSegmentUserIndexCache(c, time.Minute)
+	require.NoError(t, sc.Put(createSegmentUserIndex(), environmentId))
+	require.NoError(t, sc.Evict(segmentID, environmentId))
+	_, err := sc.Get(segmentID, environmentId)
+	assert.Equal(t, cache.ErrNotFound, err)
+	// Evicting a missing index is not an error.
+	assert.NoError(t, sc.Evict(segmentID, environmentId))
+}
+
+func createSegmentUserIndex() *SegmentUserIndex {
+	return &SegmentUserIndex{
+		Segment: &featureproto.Segment{Id: segmentID},
+		Users: evaluation.NewSegmentUserIndex(segmentID, []*featureproto.SegmentUser{
+			{
+				SegmentId: segmentID,
+				UserId:    "user-id-1",
+				State:     featureproto.SegmentUser_INCLUDED,
+			},
+		}),
+	}
+}
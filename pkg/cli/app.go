@@ -26,6 +26,7 @@ import (
 
 	"cloud.google.com/go/profiler"
 	octrace "go.opencensus.io/trace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/zap"
 	kingpin "gopkg.in/alecthomas/kingpin.v2"
 
@@ -40,6 +41,11 @@ var (
 	healthCheckSpanName     = "grpc.health.v1.Health.Check"
 	pubsubAckSpanName       = "google.pubsub.v1.Subscriber.Acknowledge"
 	pubsubModifyAckSpanName = "google.pubsub.v1.Subscriber.ModifyAckDeadline"
+
+	// OpenTelemetry gRPC span names use a slash between the service and the method.
+	otelHealthCheckSpanName     = "grpc.health.v1.Health/Check"
+	otelPubsubAckSpanName       = "google.pubsub.v1.Subscriber/Acknowledge"
+	otelPubsubModifyAckSpanName = "google.pubsub.v1.Subscriber/ModifyAckDeadline"
 )
 
 type App struct {
@@ -87,6 +93,14 @@ func (a *App) Run() error {
 		"gcp-trace-enabled",
 		"Enables sending trace data to GCP Trace service.",
 	).Default("true").Bool()
+	otlpEndpoint := a.app.Flag(
+		"otel-exporter-otlp-endpoint",
+		"The host:port of the OTLP/gRPC collector. OpenTelemetry tracing is disabled when empty.",
+	).Default("").String()
+	otlpInsecure := a.app.Flag(
+		"otel-exporter-otlp-insecure",
+		"Disables TLS for the connection to the OTLP collector.",
+	).Default("false").Bool()
 
 	cmd, err := a.app.Parse(os.Args[1:])
 	if err != nil {
@@ -156,6 +170,37 @@ func (a *App) Run() error {
 			),
 		),
 	})
+	if *otlpEndpoint != "" {
+		tp, err := trace.NewTracerProvider(
+			context.Background(),
+			serviceName,
+			a.version,
+			trace.WithOTLPEndpoint(*otlpEndpoint),
+			trace.WithOTLPInsecure(*otlpInsecure),
+			trace.WithProviderLogger(logger),
+			trace.WithProviderSampler(trace.NewOTelSampler(
+				trace.WithOTelDefaultProbability(*traceSamplingProbability),
+				trace.WithOTelFilteringSampler(otelHealthCheckSpanName, sdktrace.NeverSample()),
+				trace.WithOTelFilteringSampler(
+					otelPubsubAckSpanName,
+					sdktrace.TraceIDRatioBased(*tracePubsubAckSamplingProbability),
+				),
+				trace.WithOTelFilteringSampler(
+					otelPubsubModifyAckSpanName,
+					sdktrace.TraceIDRatioBased(*tracePubsubAckSamplingProbability),
+				),
+			)),
+		)
+		if err != nil {
+			logger.Error("Failed to create the OpenTelemetry tracer provider", zap.Error(err))
+			return err
+		}
+		defer func() {
+			if err := tp.Shutdown(context.Background()); err != nil {
+				logger.Error("Failed to shut down the OpenTelemetry tracer provider", zap.Error(err))
+			}
+		}()
+	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -0,0 +1,152 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coderefs
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	errAliasPatternGroups = errors.New("coderefs: alias patterns must have the named groups alias and key")
+	errNotGitCheckout     = errors.New("coderefs: directory is not a git checkout")
+	errRefNotFound        = errors.New("coderefs: git ref not found")
+)
+
+// readGitHead returns the branch checked out at root and its commit hash
+// by reading the git metadata directly, so no git binary is needed.
+// The branch is empty when the HEAD is detached.
+func readGitHead(root string) (branch, commit string, err error) {
+	gitDir, err := resolveGitDir(root)
+	if err != nil {
+		return "", "", err
+	}
+	head, err := os.ReadFile(filepath.Join(gitDir, "HEAD"))
+	if err != nil {
+		return "", "", err
+	}
+	value := strings.TrimSpace(string(head))
+	ref, ok := strings.CutPrefix(value, "ref: ")
+	if !ok {
+		return "", value, nil
+	}
+	branch = strings.TrimPrefix(ref, "refs/heads/")
+	commit, err = resolveRef(gitDir, ref)
+	if err != nil {
+		return "", "", err
+	}
+	return branch, commit, nil
+}
+
+// resolveGitDir returns the git directory of the checkout.
+// In worktrees and submodules `.git` is a file pointing to it.
+func resolveGitDir(root string) (string, error) {
+	dotGit := filepath.Join(root, ".git")
+	info, err := os.Stat(dotGit)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", errNotGitCheckout
+		}
+		return "", err
+	}
+	if info.IsDir() {
+		return dotGit, nil
+	}
+	data, err := os.ReadFile(dotGit)
+	if err != nil {
+		return "", err
+	}
+	dir, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir: ")
+	if !ok {
+		return "", errNotGitCheckout
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(root, dir)
+	}
+	return dir, nil
+}
+
+// resolveRef returns the commit hash of a ref, looking at the loose refs first
+// and then at the packed refs. Worktrees share the refs of the common directory.
+func resolveRef(gitDir, ref string) (string, error) {
+	dirs := []string{gitDir}
+	if common, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		dir := strings.TrimSpace(string(common))
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(gitDir, dir)
+		}
+		dirs = append(dirs, dir)
+	}
+	for _, dir := range dirs {
+		if data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(ref))); err == nil {
+			return strings.TrimSpace(string(data)), nil
+		}
+		commit, err := findPackedRef(filepath.Join(dir, "packed-refs"), ref)
+		if err == nil {
+			return commit, nil
+		}
+		if !errors.Is(err, errRefNotFound) {
+			return "", err
+		}
+	}
+	return "", errRefNotFound
+}
+
+func findPackedRef(p, ref string) (string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", errRefNotFound
+		}
+		return "", err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		commit, name, ok := strings.Cut(s.Text(), " ")
+		if ok && name == ref {
+			return commit, nil
+		}
+	}
+	if err := s.Err(); err != nil {
+		return "", err
+	}
+	return "", errRefNotFound
+}
+
+// listGitFiles returns the files tracked under root, relative to it and with slashes.
+func listGitFiles(root string) ([]string, error) {
+	out, err := exec.Command("git", "-C", root, "ls-files", "-z").Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("coderefs: git ls-files: %s", strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, err
+	}
+	var files []string
+	for _, f := range bytes.Split(out, []byte{0}) {
+		if len(f) > 0 {
+			files = append(files, string(f))
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coderefs
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadGitHead(t *testing.T) {
+	t.Parallel()
+	const commit = "0123456789abcdef0123456789abcdef01234567"
+	patterns := []struct {
+		desc           string
+		files          map[string]string
+		expectedBranch string
+		expectedCommit string
+		expectedErr    error
+	}{
+		{
+			desc:        "err: not a git checkout",
+			files:       map[string]string{"main.go": ""},
+			expectedErr: errNotGitCheckout,
+		},
+		{
+			desc: "loose ref",
+			files: map[string]string{
+				".git/HEAD":                 "ref: refs/heads/feature/x\n",
+				".git/refs/heads/feature/x": commit + "\n",
+			},
+			expectedBranch: "feature/x",
+			expectedCommit: commit,
+		},
+		{
+			desc: "packed ref",
+			files: map[string]string{
+				".git/HEAD":        "ref: refs/heads/main\n",
+				".git/packed-refs": "# pack-refs with: peeled fully-peeled sorted\n" + commit + " refs/heads/main\n",
+			},
+			expectedBranch: "main",
+			expectedCommit: commit,
+		},
+		{
+			desc: "detached head",
+			files: map[string]string{
+				".git/HEAD": commit + "\n",
+			},
+			expectedCommit: commit,
+		},
+		{
+			desc: "worktree",
+			files: map[string]string{
+				".git":                             "gitdir: main/.git/worktrees/wt\n",
+				"main/.git/worktrees/wt/HEAD":      "ref: refs/heads/wt\n",
+				"main/.git/worktrees/wt/commondir": "../..\n",
+				"main/.git/refs/heads/wt":          commit + "\n",
+			},
+			expectedBranch: "wt",
+			expectedCommit: commit,
+		},
+		{
+			desc: "err: missing ref",
+			files: map[string]string{
+				".git/HEAD": "ref: refs/heads/main\n",
+			},
+			expectedErr: errRefNotFound,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			root := t.TempDir()
+			writeFiles(t, root, p.files)
+			branch, commit, err := readGitHead(root)
+			assert.Equal(t, p.expectedErr, err)
+			assert.Equal(t, p.expectedBranch, branch)
+			assert.Equal(t, p.expectedCommit, commit)
+		})
+	}
+}
+
+func TestListGitFiles(t *testing.T) {
+	t.Parallel()
+	_, err := listGitFiles(t.TempDir())
+	assert.Error(t, err)
+
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		".gitignore":   "*.log\n",
+		"a.go":         "package a\n",
+		"sub dir/b.go": "package b\n",
+		"debug.log":    "ignored\n",
+	})
+	gitAdd(t, root)
+	files, err := listGitFiles(root)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{".gitignore", "a.go", "sub dir/b.go"}, files)
+
+	// The paths are relative to a subdirectory of the checkout.
+	files, err = listGitFiles(filepath.Join(root, "sub dir"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b.go"}, files)
+}
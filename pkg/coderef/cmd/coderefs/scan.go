@@ -0,0 +1,318 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coderefs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+	kingpin "gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/cli"
+	featureclient "github.com/bucketeer-io/bucketeer/v2/pkg/feature/client"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/metrics"
+	coderefproto "github.com/bucketeer-io/bucketeer/v2/proto/coderef"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+var errBranchRequired = errors.New("coderefs: the HEAD is detached, the branch must be given")
+
+var repositoryTypes = map[string]coderefproto.CodeReference_RepositoryType{
+	"github":    coderefproto.CodeReference_GITHUB,
+	"gitlab":    coderefproto.CodeReference_GITLAB,
+	"bitbucket": coderefproto.CodeReference_BITBUCKET,
+	"custom":    coderefproto.CodeReference_CUSTOM,
+}
+
+type scanCommand struct {
+	*kingpin.CmdClause
+	*connection
+	dir           *string
+	repoName      *string
+	repoOwner     *string
+	repoType      *string
+	branch        *string
+	commit        *string
+	aliasPatterns *[]string
+	excludes      *[]string
+	contextLines  *int
+	dryRun        *bool
+	reportPath    *string
+}
+
+func registerScanCommand(r cli.CommandRegistry, p cli.ParentCommand) *scanCommand {
+	cmd := p.Command("scan", "Scan a local git checkout and sync the flag references it contains")
+	command := &scanCommand{
+		CmdClause:  cmd,
+		connection: newConnection(cmd),
+		dir:        cmd.Flag("dir", "Path of the git checkout to scan.").Default(".").String(),
+		repoName:   cmd.Flag("repo-name", "The repository name.").Required().String(),
+		repoOwner:  cmd.Flag("repo-owner", "The repository owner.").Required().String(),
+		repoType: cmd.Flag("repo-type", "The repository hosting service.").
+			Default("github").Enum("github", "gitlab", "bitbucket", "custom"),
+		branch: cmd.Flag("branch", "The branch. Defaults to the branch checked out.").String(),
+		commit: cmd.Flag("commit", "The commit hash. Defaults to the commit checked out.").String(),
+		aliasPatterns: cmd.Flag(
+			"alias-pattern",
+			"A regular expression with the named groups alias and key defining identifiers that refer to a flag.",
+		).Strings(),
+		excludes: cmd.Flag("exclude", "A glob of the files or directories to skip.").Strings(),
+		contextLines: cmd.Flag("context-lines", "The number of lines around a reference kept in its snippet.").
+			Default("2").Int(),
+		dryRun:     cmd.Flag("dry-run", "Only show the changes without applying them.").Default("false").Bool(),
+		reportPath: cmd.Flag("report", "Path of the file to write a JSON report to, or - for stdout.").String(),
+	}
+	r.RegisterCommand(command)
+	return command
+}
+
+// report is the machine-readable result of a scan.
+type report struct {
+	Repository  *repository   `json:"repository"`
+	DryRun      bool          `json:"dryRun"`
+	References  int           `json:"references"`
+	Created     int           `json:"created"`
+	Updated     int           `json:"updated"`
+	Deleted     int           `json:"deleted"`
+	Unchanged   int           `json:"unchanged"`
+	Failed      int           `json:"failed"`
+	Changes     []*change     `json:"changes"`
+	UnknownKeys []*unknownKey `json:"unknownKeys"`
+}
+
+type unknownKey struct {
+	Key      string `json:"key"`
+	Location string `json:"location"`
+}
+
+func (c *scanCommand) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.Logger) error {
+	repo, err := c.repository()
+	if err != nil {
+		logger.Error("Failed to read the git checkout", zap.Error(err), zap.String("dir", *c.dir))
+		return err
+	}
+	ftClient, err := c.featureClient(logger)
+	if err != nil {
+		logger.Error("Failed to create feature client", zap.Error(err))
+		return err
+	}
+	defer ftClient.Close()
+	keys, err := listFeatureIDs(ctx, ftClient, *c.environmentID)
+	if err != nil {
+		logger.Error("Failed to list features", zap.Error(err))
+		return err
+	}
+	s, err := newScanner(keys, *c.aliasPatterns, *c.excludes, *c.contextLines)
+	if err != nil {
+		logger.Error("Invalid scan options", zap.Error(err))
+		return err
+	}
+	result, err := s.scan(*c.dir)
+	if err != nil {
+		logger.Error("Failed to scan the git checkout", zap.Error(err), zap.String("dir", *c.dir))
+		return err
+	}
+	crClient, err := c.codeRefClient(logger)
+	if err != nil {
+		logger.Error("Failed to create code reference client", zap.Error(err))
+		return err
+	}
+	defer crClient.Close()
+	sc := &syncer{client: crClient, environmentID: *c.environmentID, repository: repo}
+	rep := newReport(repo, *c.dryRun, result)
+	byFeature := make(map[string][]*reference)
+	for _, r := range result.References {
+		byFeature[r.FeatureID] = append(byFeature[r.FeatureID], r)
+	}
+	for _, key := range keys {
+		remote, err := sc.listCodeReferences(ctx, key)
+		if err != nil {
+			logger.Error("Failed to list code references", zap.Error(err), zap.String("featureId", key))
+			return err
+		}
+		changes, unchanged := planChanges(byFeature[key], remote)
+		rep.add(changes, unchanged)
+	}
+	// A failed change doesn't stop the others, and the report still lists all of them.
+	var applyErr error
+	if !*c.dryRun {
+		applyErr = applyChanges(ctx, sc, rep, logger)
+	}
+	// The summary must not be mixed with a report written to stdout.
+	out := os.Stdout
+	if *c.reportPath == "-" {
+		out = os.Stderr
+	}
+	writeChanges(out, rep)
+	if err := c.writeReport(rep); err != nil {
+		logger.Error("Failed to write the report", zap.Error(err), zap.String("report", *c.reportPath))
+		return errors.Join(applyErr, err)
+	}
+	if applyErr != nil {
+		return applyErr
+	}
+	logger.Info("Code references scanned",
+		zap.Bool("dryRun", *c.dryRun),
+		zap.Int("references", rep.References),
+		zap.Int("changes", len(rep.Changes)),
+	)
+	return nil
+}
+
+// applyChanges applies every change of the report and records the failed ones in it.
+func applyChanges(ctx context.Context, sc *syncer, rep *report, logger *zap.Logger) error {
+	var errs []error
+	for _, ch := range rep.Changes {
+		if err := sc.apply(ctx, ch); err != nil {
+			logger.Error("Failed to apply code reference change",
+				zap.Error(err),
+				zap.String("action", string(ch.Action)),
+				zap.String("featureId", ch.FeatureID),
+				zap.String("filePath", ch.FilePath),
+			)
+			ch.Error = err.Error()
+			rep.Failed++
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *scanCommand) repository() (*repository, error) {
+	branch, commit, err := readGitHead(*c.dir)
+	if err != nil {
+		return nil, err
+	}
+	if *c.branch != "" {
+		branch = *c.branch
+	}
+	if *c.commit != "" {
+		commit = *c.commit
+	}
+	if branch == "" {
+		return nil, errBranchRequired
+	}
+	return &repository{
+		Name:   *c.repoName,
+		Owner:  *c.repoOwner,
+		Type:   repositoryTypes[*c.repoType],
+		Branch: branch,
+		Commit: commit,
+	}, nil
+}
+
+func (c *scanCommand) writeReport(rep *report) error {
+	if *c.reportPath == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(rep, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if *c.reportPath == "-" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(*c.reportPath, data, 0644)
+}
+
+// listFeatureIDs returns the ids of all the flags of the environment, archived ones
+// included, because references to archived flags are the ones to clean up first.
+func listFeatureIDs(ctx context.Context, client featureclient.Client, environmentID string) ([]string, error) {
+	var ids []string
+	cursor := ""
+	for {
+		resp, err := client.ListFeatures(ctx, &featureproto.ListFeaturesRequest{
+			EnvironmentId: environmentID,
+			PageSize:      listRequestSize,
+			Cursor:        cursor,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range resp.Features {
+			ids = append(ids, f.Id)
+		}
+		size := len(resp.Features)
+		if size == 0 || size < listRequestSize {
+			sort.Strings(ids)
+			return ids, nil
+		}
+		cursor = resp.Cursor
+	}
+}
+
+func newReport(repo *repository, dryRun bool, result *scanResult) *report {
+	rep := &report{
+		Repository:  repo,
+		DryRun:      dryRun,
+		References:  len(result.References),
+		Changes:     []*change{},
+		UnknownKeys: []*unknownKey{},
+	}
+	for key, loc := range result.UnknownKeys {
+		rep.UnknownKeys = append(rep.UnknownKeys, &unknownKey{Key: key, Location: loc})
+	}
+	sort.Slice(rep.UnknownKeys, func(i, j int) bool {
+		return rep.UnknownKeys[i].Key < rep.UnknownKeys[j].Key
+	})
+	return rep
+}
+
+func (r *report) add(changes []*change, unchanged int) {
+	r.Unchanged += unchanged
+	for _, c := range changes {
+		switch c.Action {
+		case actionCreate:
+			r.Created++
+		case actionUpdate:
+			r.Updated++
+		case actionDelete:
+			r.Deleted++
+		}
+	}
+	r.Changes = append(r.Changes, changes...)
+}
+
+func writeChanges(w io.Writer, rep *report) {
+	for _, c := range rep.Changes {
+		if c.Error != "" {
+			fmt.Fprintf(w, "%-6s %s %s:%d failed: %s\n", c.Action, c.FeatureID, c.FilePath, c.LineNumber, c.Error)
+			continue
+		}
+		fmt.Fprintf(w, "%-6s %s %s:%d\n", c.Action, c.FeatureID, c.FilePath, c.LineNumber)
+	}
+	for _, k := range rep.UnknownKeys {
+		fmt.Fprintf(w, "unknown flag %s at %s\n", k.Key, k.Location)
+	}
+	counts := []string{
+		fmt.Sprintf("%d created", rep.Created),
+		fmt.Sprintf("%d updated", rep.Updated),
+		fmt.Sprintf("%d deleted", rep.Deleted),
+		fmt.Sprintf("%d unchanged", rep.Unchanged),
+	}
+	if rep.Failed > 0 {
+		counts = append(counts, fmt.Sprintf("%d failed", rep.Failed))
+	}
+	fmt.Fprintf(w, "%d references: %s\n", rep.References, strings.Join(counts, ", "))
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coderefs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	coderefclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/coderef/client/mock"
+	coderefproto "github.com/bucketeer-io/bucketeer/v2/proto/coderef"
+)
+
+func TestApplyChanges(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	client := coderefclientmock.NewMockClient(mockController)
+	s := &syncer{client: client, environmentID: "env", repository: &repository{Branch: "main"}}
+	client.EXPECT().DeleteCodeReference(gomock.Any(), &coderefproto.DeleteCodeReferenceRequest{
+		Id:            "r1",
+		EnvironmentId: "env",
+	}).Return(nil, errors.New("unavailable"))
+	client.EXPECT().DeleteCodeReference(gomock.Any(), &coderefproto.DeleteCodeReferenceRequest{
+		Id:            "r2",
+		EnvironmentId: "env",
+	}).Return(&coderefproto.DeleteCodeReferenceResponse{}, nil)
+
+	rep := &report{}
+	rep.add([]*change{
+		{Action: actionDelete, ID: "r1", FeatureID: "f", FilePath: "a.go", LineNumber: 1},
+		{Action: actionDelete, ID: "r2", FeatureID: "f", FilePath: "b.go", LineNumber: 2},
+	}, 0)
+	// The failed change doesn't stop the next one.
+	err := applyChanges(context.Background(), s, rep, zap.NewNop())
+	assert.EqualError(t, err, "unavailable")
+	assert.Equal(t, 1, rep.Failed)
+	assert.Equal(t, "unavailable", rep.Changes[0].Error)
+	assert.Empty(t, rep.Changes[1].Error)
+
+	var out bytes.Buffer
+	writeChanges(&out, rep)
+	assert.Equal(t, "delete f a.go:1 failed: unavailable\n"+
+		"delete f b.go:2\n"+
+		"0 references: 0 created, 0 updated, 2 deleted, 0 unchanged, 1 failed\n", out.String())
+}
@@ -0,0 +1,329 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coderefs
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Files larger than this are generated or data files rather than source code.
+	maxFileSize = 1 << 20
+	// The number of leading bytes checked for a NUL byte to detect binary files.
+	binaryCheckSize = 8000
+)
+
+var (
+	// defaultExcludedDirs hold third-party code even when it is committed.
+	defaultExcludedDirs = map[string]struct{}{
+		"node_modules": {},
+		"vendor":       {},
+	}
+
+	literalPattern    = regexp.MustCompile("\"([^\"\\\\\\n]*)\"|'([^'\\\\\\n]*)'|`([^`\\n]*)`")
+	identifierPattern = regexp.MustCompile(`[A-Za-z_$][\w$]*`)
+
+	// sdkPatterns capture the flag key passed to the variation methods of the Bucketeer SDKs.
+	// Keys found this way that are not flags of the environment are reported as unknown.
+	goSDKPattern = regexp.MustCompile(
+		`\b(?:Bool|String|Int|Int64|Float64|JSON|Object)Variation(?:Details)?\(\s*[^,()]+,\s*[^,()]+,\s*"([^"]+)"`)
+	tsSDKPattern = regexp.MustCompile(
+		"\\b(?:boolean|string|number|object|json)Variation(?:Details)?\\(\\s*(?:[\\w.]+\\s*,\\s*)?[\"'`]([^\"'`]+)[\"'`]")
+	kotlinSDKPattern = regexp.MustCompile(
+		`\b(?:bool|boolean|string|int|double|json|object)Variation(?:Details)?\(\s*(?:featureId\s*=\s*)?"([^"]+)"`)
+	swiftSDKPattern = regexp.MustCompile(
+		`\b(?:bool|string|int|double|json|object)Variation(?:Details)?\(\s*featureId:\s*"([^"]+)"`)
+	sdkPatterns = map[string]*regexp.Regexp{
+		"go":    goSDKPattern,
+		"ts":    tsSDKPattern,
+		"tsx":   tsSDKPattern,
+		"js":    tsSDKPattern,
+		"jsx":   tsSDKPattern,
+		"mjs":   tsSDKPattern,
+		"cjs":   tsSDKPattern,
+		"kt":    kotlinSDKPattern,
+		"kts":   kotlinSDKPattern,
+		"swift": swiftSDKPattern,
+	}
+)
+
+// reference is a line of the checkout that refers to a flag.
+type reference struct {
+	FeatureID     string   `json:"featureId"`
+	FilePath      string   `json:"filePath"`
+	FileExtension string   `json:"fileExtension"`
+	LineNumber    int32    `json:"lineNumber"`
+	CodeSnippet   string   `json:"-"`
+	ContentHash   string   `json:"contentHash"`
+	Aliases       []string `json:"aliases,omitempty"`
+}
+
+type scanResult struct {
+	References []*reference
+	// UnknownKeys maps the keys passed to SDK calls that are not flags
+	// of the environment to the first place they were found.
+	UnknownKeys map[string]string
+}
+
+type scanner struct {
+	keys          map[string]struct{}
+	aliasPatterns []*regexp.Regexp
+	excludes      []string
+	contextLines  int
+}
+
+// newScanner creates a scanner for the given flag keys.
+// Alias patterns must have the named groups `alias` and `key`. Every match defines
+// an identifier that refers to the flag, e.g. `(?P<alias>\w+)\s*=\s*"(?P<key>[\w-]+)"`.
+func newScanner(
+	keys []string,
+	aliasPatterns []string,
+	excludes []string,
+	contextLines int,
+) (*scanner, error) {
+	s := &scanner{
+		keys:         make(map[string]struct{}, len(keys)),
+		excludes:     excludes,
+		contextLines: contextLines,
+	}
+	for _, k := range keys {
+		s.keys[k] = struct{}{}
+	}
+	for _, p := range aliasPatterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, err
+		}
+		if re.SubexpIndex("alias") < 0 || re.SubexpIndex("key") < 0 {
+			return nil, errAliasPatternGroups
+		}
+		s.aliasPatterns = append(s.aliasPatterns, re)
+	}
+	for _, e := range excludes {
+		if _, err := path.Match(e, ""); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// scan reads the files tracked in the checkout at root and returns the flag references they contain.
+// Ignored and untracked files are skipped, like build outputs and local files.
+// Aliases are collected from the whole checkout first because they are often
+// defined in a different file than the one using them.
+func (s *scanner) scan(root string) (*scanResult, error) {
+	tracked, err := listGitFiles(root)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, rel := range tracked {
+		if s.skipped(rel) {
+			continue
+		}
+		info, err := os.Lstat(filepath.Join(root, filepath.FromSlash(rel)))
+		if err != nil {
+			// The file is deleted in the working tree.
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		// Symlinks and submodules are not scanned.
+		if !info.Mode().IsRegular() {
+			continue
+		}
+		files = append(files, rel)
+	}
+	aliases := make(map[string][]string)
+	if len(s.aliasPatterns) > 0 {
+		for _, f := range files {
+			lines, err := readLines(filepath.Join(root, filepath.FromSlash(f)))
+			if err != nil {
+				return nil, err
+			}
+			s.collectAliases(lines, aliases)
+		}
+	}
+	result := &scanResult{UnknownKeys: make(map[string]string)}
+	for _, f := range files {
+		lines, err := readLines(filepath.Join(root, filepath.FromSlash(f)))
+		if err != nil {
+			return nil, err
+		}
+		result.References = append(result.References, s.scanFile(f, lines, aliases, result.UnknownKeys)...)
+	}
+	return result, nil
+}
+
+// skipped reports whether the file or one of its directories is excluded.
+func (s *scanner) skipped(rel string) bool {
+	if s.excluded(rel) {
+		return true
+	}
+	for dir := path.Dir(rel); dir != "."; dir = path.Dir(dir) {
+		if _, ok := defaultExcludedDirs[path.Base(dir)]; ok || s.excluded(dir) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *scanner) excluded(rel string) bool {
+	for _, e := range s.excludes {
+		if ok, _ := path.Match(e, rel); ok {
+			return true
+		}
+		if ok, _ := path.Match(e, path.Base(rel)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *scanner) collectAliases(lines []string, aliases map[string][]string) {
+	for _, line := range lines {
+		for _, re := range s.aliasPatterns {
+			for _, m := range re.FindAllStringSubmatch(line, -1) {
+				alias, key := m[re.SubexpIndex("alias")], m[re.SubexpIndex("key")]
+				if _, ok := s.keys[key]; !ok || alias == "" || alias == key {
+					continue
+				}
+				if !slices.Contains(aliases[alias], key) {
+					aliases[alias] = append(aliases[alias], key)
+				}
+			}
+		}
+	}
+}
+
+func (s *scanner) scanFile(
+	filePath string,
+	lines []string,
+	aliases map[string][]string,
+	unknownKeys map[string]string,
+) []*reference {
+	ext := strings.TrimPrefix(path.Ext(filePath), ".")
+	sdkPattern := sdkPatterns[ext]
+	var refs []*reference
+	for i, line := range lines {
+		// The aliases found on the line are recorded for each flag.
+		found := make(map[string][]string)
+		var order []string
+		add := func(key, alias string) {
+			if _, ok := found[key]; !ok {
+				order = append(order, key)
+				found[key] = nil
+			}
+			if alias != "" && !slices.Contains(found[key], alias) {
+				found[key] = append(found[key], alias)
+			}
+		}
+		for _, m := range literalPattern.FindAllStringSubmatch(line, -1) {
+			for _, v := range m[1:] {
+				if _, ok := s.keys[v]; ok && v != "" {
+					add(v, "")
+				}
+			}
+		}
+		if sdkPattern != nil {
+			for _, m := range sdkPattern.FindAllStringSubmatch(line, -1) {
+				if _, ok := s.keys[m[1]]; ok {
+					add(m[1], "")
+					continue
+				}
+				if _, ok := unknownKeys[m[1]]; !ok {
+					unknownKeys[m[1]] = location(filePath, i+1)
+				}
+			}
+		}
+		if len(aliases) > 0 {
+			for _, id := range identifierPattern.FindAllString(line, -1) {
+				for _, key := range aliases[id] {
+					add(key, id)
+				}
+			}
+		}
+		if len(order) == 0 {
+			continue
+		}
+		snippet := s.snippet(lines, i)
+		hash := contentHash(snippet)
+		for _, key := range order {
+			a := found[key]
+			sort.Strings(a)
+			refs = append(refs, &reference{
+				FeatureID:     key,
+				FilePath:      filePath,
+				FileExtension: ext,
+				LineNumber:    int32(i + 1),
+				CodeSnippet:   snippet,
+				ContentHash:   hash,
+				Aliases:       a,
+			})
+		}
+	}
+	return refs
+}
+
+// snippet returns the line at index i with the configured number of lines around it.
+func (s *scanner) snippet(lines []string, i int) string {
+	start := max(i-s.contextLines, 0)
+	end := min(i+s.contextLines+1, len(lines))
+	return strings.Join(lines[start:end], "\n")
+}
+
+// readLines returns the lines of a text file.
+// Binary and large files are skipped by returning no lines.
+func readLines(p string) ([]string, error) {
+	info, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > maxFileSize {
+		return nil, nil
+	}
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.IndexByte(data[:min(len(data), binaryCheckSize)], 0) >= 0 {
+		return nil, nil
+	}
+	lines := strings.Split(string(data), "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, "\r")
+	}
+	return lines, nil
+}
+
+func contentHash(snippet string) string {
+	sum := sha256.Sum256([]byte(snippet))
+	return hex.EncodeToString(sum[:])
+}
+
+func location(filePath string, line int) string {
+	return filePath + ":" + strconv.Itoa(line)
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coderefs
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0644))
+	}
+}
+
+// gitAdd makes root a git checkout tracking all its files but the ignored ones.
+func gitAdd(t *testing.T, root string) {
+	t.Helper()
+	for _, args := range [][]string{{"init", "-q"}, {"add", "-A"}} {
+		out, err := exec.Command("git", append([]string{"-C", root}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+}
+
+func TestNewScanner(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc          string
+		aliasPatterns []string
+		excludes      []string
+		expectedErr   bool
+	}{
+		{
+			desc: "success",
+			aliasPatterns: []string{
+				`(?P<alias>\w+)\s*=\s*"(?P<key>[\w-]+)"`,
+			},
+			excludes: []string{"*.md"},
+		},
+		{
+			desc:          "err: invalid alias pattern",
+			aliasPatterns: []string{`(`},
+			expectedErr:   true,
+		},
+		{
+			desc:          "err: alias pattern without groups",
+			aliasPatterns: []string{`\w+`},
+			expectedErr:   true,
+		},
+		{
+			desc:        "err: invalid exclude",
+			excludes:    []string{"["},
+			expectedErr: true,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			_, err := newScanner([]string{"flag"}, p.aliasPatterns, p.excludes, 0)
+			assert.Equal(t, p.expectedErr, err != nil)
+		})
+	}
+}
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"main.go": "package main\n\n" +
+			"func run() {\n" +
+			"\tclient.BoolVariation(ctx, user, \"new-checkout\", false)\n" +
+			"\tclient.StringVariation(ctx, user, \"deleted-flag\", \"\")\n" +
+			"}\n",
+		"web/app.ts":            "const enabled = client.booleanVariation('new-checkout', false);\n",
+		"android/Main.kt":       "val v = client.stringVariation(featureId = \"dark-mode\", defaultValue = \"\")\n",
+		"ios/Main.swift":        "let v = client.boolVariation(featureId: \"dark-mode\", defaultValue: false)\n",
+		"flags.go":              "package main\n\nconst DarkMode = \"dark-mode\"\n",
+		"ui.go":                 "package main\n\nvar theme = pick(DarkMode)\n",
+		"README.md":             "The \"new-checkout\" flag.\n",
+		"node_modules/x/x.js":   "booleanVariation('new-checkout', false)\n",
+		"vendor/x/x.go":         "BoolVariation(ctx, user, \"new-checkout\", false)\n",
+		"testdata/binary.bin":   "\x00\"new-checkout\"",
+		"scripts/gen/script.ts": "booleanVariation('new-checkout', false)\n",
+		".gitignore":            "dist/\n",
+		"dist/bundle.js":        "booleanVariation('new-checkout', false)\n",
+		"deleted.go":            "BoolVariation(ctx, user, \"new-checkout\", false)\n",
+	})
+	gitAdd(t, root)
+	// Untracked and deleted files are not scanned.
+	writeFiles(t, root, map[string]string{"untracked.go": "BoolVariation(ctx, user, \"new-checkout\", false)\n"})
+	require.NoError(t, os.Remove(filepath.Join(root, "deleted.go")))
+	s, err := newScanner(
+		[]string{"new-checkout", "dark-mode"},
+		[]string{`const (?P<alias>\w+) = "(?P<key>[\w-]+)"`},
+		[]string{"*.md", "scripts"},
+		1,
+	)
+	require.NoError(t, err)
+	result, err := s.scan(root)
+	require.NoError(t, err)
+
+	type found struct {
+		featureID string
+		filePath  string
+		line      int32
+		aliases   []string
+	}
+	var actual []found
+	for _, r := range result.References {
+		actual = append(actual, found{r.FeatureID, r.FilePath, r.LineNumber, r.Aliases})
+		assert.Len(t, r.ContentHash, 64)
+		assert.NotEmpty(t, r.CodeSnippet)
+	}
+	assert.ElementsMatch(t, []found{
+		{"dark-mode", "android/Main.kt", 1, nil},
+		{"dark-mode", "flags.go", 3, []string{"DarkMode"}},
+		{"dark-mode", "ios/Main.swift", 1, nil},
+		{"new-checkout", "main.go", 4, nil},
+		{"dark-mode", "ui.go", 3, []string{"DarkMode"}},
+		{"new-checkout", "web/app.ts", 1, nil},
+	}, actual)
+	assert.Equal(t, map[string]string{"deleted-flag": "main.go:5"}, result.UnknownKeys)
+
+	for _, r := range result.References {
+		if r.FilePath == "main.go" {
+			assert.Equal(t, "func run() {\n"+
+				"\tclient.BoolVariation(ctx, user, \"new-checkout\", false)\n"+
+				"\tclient.StringVariation(ctx, user, \"deleted-flag\", \"\")", r.CodeSnippet)
+			assert.Equal(t, "go", r.FileExtension)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coderefs
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	coderefclient "github.com/bucketeer-io/bucketeer/v2/pkg/coderef/client"
+	coderefproto "github.com/bucketeer-io/bucketeer/v2/proto/coderef"
+)
+
+const listRequestSize = 500
+
+type changeAction string
+
+const (
+	actionCreate changeAction = "create"
+	actionUpdate changeAction = "update"
+	actionDelete changeAction = "delete"
+)
+
+// change is an operation needed for the stored code references to match the checkout.
+type change struct {
+	Action     changeAction `json:"action"`
+	ID         string       `json:"id,omitempty"`
+	FeatureID  string       `json:"featureId"`
+	FilePath   string       `json:"filePath"`
+	LineNumber int32        `json:"lineNumber"`
+	Error      string       `json:"error,omitempty"`
+	reference  *reference
+}
+
+// planChanges compares the references found in the checkout with the stored ones.
+// References are matched by their content first so that lines moved by unrelated
+// edits are updated instead of being deleted and created again. The remaining
+// ones are matched by their position, which means the referencing code changed.
+// It returns the changes and the number of references that are already up to date.
+func planChanges(local []*reference, remote []*coderefproto.CodeReference) ([]*change, int) {
+	used := make([]bool, len(remote))
+	byContent := make(map[string][]int)
+	byLine := make(map[string][]int)
+	for i, r := range remote {
+		byContent[contentKey(r.FeatureId, r.FilePath, r.ContentHash)] = append(
+			byContent[contentKey(r.FeatureId, r.FilePath, r.ContentHash)], i)
+		byLine[lineKey(r.FeatureId, r.FilePath, r.LineNumber)] = append(
+			byLine[lineKey(r.FeatureId, r.FilePath, r.LineNumber)], i)
+	}
+	pop := func(index map[string][]int, key string) int {
+		for len(index[key]) > 0 {
+			i := index[key][0]
+			index[key] = index[key][1:]
+			if !used[i] {
+				used[i] = true
+				return i
+			}
+		}
+		return -1
+	}
+	var changes []*change
+	unchanged := 0
+	matched := make([]int, len(local))
+	for i, l := range local {
+		matched[i] = pop(byContent, contentKey(l.FeatureID, l.FilePath, l.ContentHash))
+	}
+	for i, l := range local {
+		if matched[i] < 0 {
+			matched[i] = pop(byLine, lineKey(l.FeatureID, l.FilePath, l.LineNumber))
+		}
+		if matched[i] < 0 {
+			changes = append(changes, newChange(actionCreate, "", l))
+			continue
+		}
+		r := remote[matched[i]]
+		if r.LineNumber == l.LineNumber && r.ContentHash == l.ContentHash && sameAliases(r.Aliases, l.Aliases) {
+			unchanged++
+			continue
+		}
+		changes = append(changes, newChange(actionUpdate, r.Id, l))
+	}
+	for i, r := range remote {
+		if used[i] {
+			continue
+		}
+		changes = append(changes, &change{
+			Action:     actionDelete,
+			ID:         r.Id,
+			FeatureID:  r.FeatureId,
+			FilePath:   r.FilePath,
+			LineNumber: r.LineNumber,
+		})
+	}
+	return changes, unchanged
+}
+
+func newChange(action changeAction, id string, r *reference) *change {
+	return &change{
+		Action:     action,
+		ID:         id,
+		FeatureID:  r.FeatureID,
+		FilePath:   r.FilePath,
+		LineNumber: r.LineNumber,
+		reference:  r,
+	}
+}
+
+func contentKey(featureID, filePath, hash string) string {
+	return featureID + "\x00" + filePath + "\x00" + hash
+}
+
+func lineKey(featureID, filePath string, line int32) string {
+	return featureID + "\x00" + filePath + "\x00" + strconv.Itoa(int(line))
+}
+
+func sameAliases(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+type repository struct {
+	Name   string                                    `json:"name"`
+	Owner  string                                    `json:"owner"`
+	Type   coderefproto.CodeReference_RepositoryType `json:"-"`
+	Branch string                                    `json:"branch"`
+	Commit string                                    `json:"commit"`
+}
+
+// syncer reads and writes the code references of one repository branch.
+type syncer struct {
+	client        coderefclient.Client
+	environmentID string
+	repository    *repository
+}
+
+func (s *syncer) listCodeReferences(
+	ctx context.Context,
+	featureID string,
+) ([]*coderefproto.CodeReference, error) {
+	var refs []*coderefproto.CodeReference
+	cursor := ""
+	for {
+		resp, err := s.client.ListCodeReferences(ctx, &coderefproto.ListCodeReferencesRequest{
+			EnvironmentId:    s.environmentID,
+			FeatureId:        featureID,
+			RepositoryName:   s.repository.Name,
+			RepositoryOwner:  s.repository.Owner,
+			RepositoryType:   s.repository.Type,
+			RepositoryBranch: s.repository.Branch,
+			Cursor:           cursor,
+			PageSize:         listRequestSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, resp.CodeReferences...)
+		size := len(resp.CodeReferences)
+		if size == 0 || size < listRequestSize {
+			return refs, nil
+		}
+		cursor = resp.Cursor
+	}
+}
+
+func (s *syncer) apply(ctx context.Context, c *change) error {
+	switch c.Action {
+	case actionCreate:
+		r := c.reference
+		_, err := s.client.CreateCodeReference(ctx, &coderefproto.CreateCodeReferenceRequest{
+			FeatureId:        r.FeatureID,
+			EnvironmentId:    s.environmentID,
+			FilePath:         r.FilePath,
+			LineNumber:       r.LineNumber,
+			CodeSnippet:      r.CodeSnippet,
+			ContentHash:      r.ContentHash,
+			Aliases:          r.Aliases,
+			RepositoryName:   s.repository.Name,
+			RepositoryOwner:  s.repository.Owner,
+			RepositoryType:   s.repository.Type,
+			RepositoryBranch: s.repository.Branch,
+			CommitHash:       s.repository.Commit,
+			FileExtension:    r.FileExtension,
+		})
+		return err
+	case actionUpdate:
+		r := c.reference
+		_, err := s.client.UpdateCodeReference(ctx, &coderefproto.UpdateCodeReferenceRequest{
+			Id:               c.ID,
+			EnvironmentId:    s.environmentID,
+			FilePath:         r.FilePath,
+			LineNumber:       r.LineNumber,
+			CodeSnippet:      r.CodeSnippet,
+			ContentHash:      r.ContentHash,
+			Aliases:          r.Aliases,
+			RepositoryBranch: s.repository.Branch,
+			CommitHash:       s.repository.Commit,
+			FileExtension:    r.FileExtension,
+		})
+		return err
+	case actionDelete:
+		_, err := s.client.DeleteCodeReference(ctx, &coderefproto.DeleteCodeReferenceRequest{
+			Id:            c.ID,
+			EnvironmentId: s.environmentID,
+		})
+		return err
+	}
+	return nil
+}
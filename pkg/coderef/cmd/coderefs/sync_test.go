@@ -0,0 +1,183 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coderefs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	coderefclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/coderef/client/mock"
+	coderefproto "github.com/bucketeer-io/bucketeer/v2/proto/coderef"
+)
+
+func TestPlanChanges(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc              string
+		local             []*reference
+		remote            []*coderefproto.CodeReference
+		expected          []*change
+		expectedUnchanged int
+	}{
+		{
+			desc: "unchanged",
+			local: []*reference{
+				{FeatureID: "f", FilePath: "a.go", LineNumber: 3, ContentHash: "h1"},
+			},
+			remote: []*coderefproto.CodeReference{
+				{Id: "r1", FeatureId: "f", FilePath: "a.go", LineNumber: 3, ContentHash: "h1"},
+			},
+			expectedUnchanged: 1,
+		},
+		{
+			desc: "moved line is updated",
+			local: []*reference{
+				{FeatureID: "f", FilePath: "a.go", LineNumber: 10, ContentHash: "h1"},
+			},
+			remote: []*coderefproto.CodeReference{
+				{Id: "r1", FeatureId: "f", FilePath: "a.go", LineNumber: 3, ContentHash: "h1"},
+			},
+			expected: []*change{
+				{Action: actionUpdate, ID: "r1", FeatureID: "f", FilePath: "a.go", LineNumber: 10},
+			},
+		},
+		{
+			desc: "edited line is updated",
+			local: []*reference{
+				{FeatureID: "f", FilePath: "a.go", LineNumber: 3, ContentHash: "h2"},
+			},
+			remote: []*coderefproto.CodeReference{
+				{Id: "r1", FeatureId: "f", FilePath: "a.go", LineNumber: 3, ContentHash: "h1"},
+			},
+			expected: []*change{
+				{Action: actionUpdate, ID: "r1", FeatureID: "f", FilePath: "a.go", LineNumber: 3},
+			},
+		},
+		{
+			desc: "new alias is updated",
+			local: []*reference{
+				{FeatureID: "f", FilePath: "a.go", LineNumber: 3, ContentHash: "h1", Aliases: []string{"F"}},
+			},
+			remote: []*coderefproto.CodeReference{
+				{Id: "r1", FeatureId: "f", FilePath: "a.go", LineNumber: 3, ContentHash: "h1"},
+			},
+			expected: []*change{
+				{Action: actionUpdate, ID: "r1", FeatureID: "f", FilePath: "a.go", LineNumber: 3},
+			},
+		},
+		{
+			desc: "created and deleted",
+			local: []*reference{
+				{FeatureID: "f", FilePath: "b.go", LineNumber: 1, ContentHash: "h2"},
+			},
+			remote: []*coderefproto.CodeReference{
+				{Id: "r1", FeatureId: "f", FilePath: "a.go", LineNumber: 3, ContentHash: "h1"},
+			},
+			expected: []*change{
+				{Action: actionCreate, FeatureID: "f", FilePath: "b.go", LineNumber: 1},
+				{Action: actionDelete, ID: "r1", FeatureID: "f", FilePath: "a.go", LineNumber: 3},
+			},
+		},
+		{
+			desc: "content match wins over line match",
+			local: []*reference{
+				{FeatureID: "f", FilePath: "a.go", LineNumber: 3, ContentHash: "h2"},
+				{FeatureID: "f", FilePath: "a.go", LineNumber: 4, ContentHash: "h1"},
+			},
+			remote: []*coderefproto.CodeReference{
+				{Id: "r1", FeatureId: "f", FilePath: "a.go", LineNumber: 3, ContentHash: "h1"},
+			},
+			expected: []*change{
+				{Action: actionCreate, FeatureID: "f", FilePath: "a.go", LineNumber: 3},
+				{Action: actionUpdate, ID: "r1", FeatureID: "f", FilePath: "a.go", LineNumber: 4},
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			changes, unchanged := planChanges(p.local, p.remote)
+			for _, c := range changes {
+				c.reference = nil
+			}
+			assert.Equal(t, p.expected, changes)
+			assert.Equal(t, p.expectedUnchanged, unchanged)
+		})
+	}
+}
+
+func TestSyncerApply(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	client := coderefclientmock.NewMockClient(mockController)
+	s := &syncer{
+		client:        client,
+		environmentID: "env",
+		repository: &repository{
+			Name:   "repo",
+			Owner:  "owner",
+			Type:   coderefproto.CodeReference_GITHUB,
+			Branch: "main",
+			Commit: "c1",
+		},
+	}
+	ref := &reference{
+		FeatureID:     "f",
+		FilePath:      "a.go",
+		FileExtension: "go",
+		LineNumber:    3,
+		CodeSnippet:   "snippet",
+		ContentHash:   "h1",
+	}
+	client.EXPECT().CreateCodeReference(gomock.Any(), &coderefproto.CreateCodeReferenceRequest{
+		FeatureId:        "f",
+		EnvironmentId:    "env",
+		FilePath:         "a.go",
+		LineNumber:       3,
+		CodeSnippet:      "snippet",
+		ContentHash:      "h1",
+		RepositoryName:   "repo",
+		RepositoryOwner:  "owner",
+		RepositoryType:   coderefproto.CodeReference_GITHUB,
+		RepositoryBranch: "main",
+		CommitHash:       "c1",
+		FileExtension:    "go",
+	}).Return(&coderefproto.CreateCodeReferenceResponse{}, nil)
+	client.EXPECT().UpdateCodeReference(gomock.Any(), &coderefproto.UpdateCodeReferenceRequest{
+		Id:               "r1",
+		EnvironmentId:    "env",
+		FilePath:         "a.go",
+		LineNumber:       3,
+		CodeSnippet:      "snippet",
+		ContentHash:      "h1",
+		RepositoryBranch: "main",
+		CommitHash:       "c1",
+		FileExtension:    "go",
+	}).Return(&coderefproto.UpdateCodeReferenceResponse{}, nil)
+	client.EXPECT().DeleteCodeReference(gomock.Any(), &coderefproto.DeleteCodeReferenceRequest{
+		Id:            "r2",
+		EnvironmentId: "env",
+	}).Return(&coderefproto.DeleteCodeReferenceResponse{}, nil)
+
+	ctx := context.Background()
+	require.NoError(t, s.apply(ctx, newChange(actionCreate, "", ref)))
+	require.NoError(t, s.apply(ctx, newChange(actionUpdate, "r1", ref)))
+	require.NoError(t, s.apply(ctx, &change{Action: actionDelete, ID: "r2"}))
+}
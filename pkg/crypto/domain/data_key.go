@@ -0,0 +1,43 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"time"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/uuid"
+)
+
+// DataKey encrypts the secrets of one organization. Only its copy wrapped by
+// the key encryption key of the configured backend is stored.
+type DataKey struct {
+	ID             string
+	OrganizationID string
+	EncryptedKey   []byte
+	CreatedAt      int64
+}
+
+func NewDataKey(organizationID string, encryptedKey []byte) (*DataKey, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	return &DataKey{
+		ID:             id.String(),
+		OrganizationID: organizationID,
+		EncryptedKey:   encryptedKey,
+		CreatedAt:      time.Now().Unix(),
+	}, nil
+}
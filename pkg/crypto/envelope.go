@@ -0,0 +1,296 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mock/$GOFILE
+package crypto
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/crypto/domain"
+	v2 "github.com/bucketeer-io/bucketeer/v2/pkg/crypto/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage"
+)
+
+const (
+	secretPrefix = "enc:v1:"
+	dataKeySize  = 32
+	// activeDataKeyTTL bounds how long a server keeps encrypting with a data key
+	// after the rotation batch job created a new one.
+	activeDataKeyTTL = time.Minute
+)
+
+// SecretCipher encrypts the secrets stored in the database with the data key of
+// the organization they belong to.
+type SecretCipher interface {
+	// Encrypt returns the ciphertext of a secret of the environment. Empty secrets stay empty.
+	Encrypt(ctx context.Context, environmentID, plaintext string) (string, error)
+	// Decrypt returns the plaintext of a value returned by Encrypt.
+	// Values stored before the encryption was enabled are returned as they are.
+	Decrypt(ctx context.Context, value string) (string, error)
+	// NeedsRotation reports whether the value isn't encrypted with the current data key
+	// of the environment's organization.
+	NeedsRotation(ctx context.Context, environmentID, value string) (bool, error)
+	// RotateDataKey creates a new data key for the organization, which encrypts its secrets from now on.
+	RotateDataKey(ctx context.Context, organizationID string) error
+}
+
+// SecretRotator re-encrypts stored secrets with the current data keys.
+type SecretRotator interface {
+	// RotateSecrets re-encrypts the secrets of the environment that aren't encrypted with
+	// the current data key of its organization, and returns how many rows were updated.
+	RotateSecrets(ctx context.Context, environmentID string) (int, error)
+}
+
+// OrganizationResolver returns the ID of the organization an environment belongs to.
+type OrganizationResolver func(ctx context.Context, environmentID string) (string, error)
+
+type dataKey struct {
+	id      string
+	wrapped string
+	aead    cipher.AEAD
+}
+
+type activeDataKey struct {
+	key       *dataKey
+	expiresAt time.Time
+}
+
+// envelopeCipher encrypts the secrets with AES-256-GCM data keys, wrapped by a key encryption key.
+// Every ciphertext carries its wrapped data key, so it stays readable even when the data key
+// was created in a transaction that was rolled back afterwards.
+type envelopeCipher struct {
+	kek                 EncrypterDecrypter
+	storage             v2.DataKeyStorage
+	resolveOrganization OrganizationResolver
+	now                 func() time.Time
+	mu                  sync.Mutex
+	keys                map[string]*dataKey
+	activeKeys          map[string]activeDataKey
+	environmentOrgIDs   map[string]string
+}
+
+func NewEnvelopeCipher(
+	kek EncrypterDecrypter,
+	storage v2.DataKeyStorage,
+	resolveOrganization OrganizationResolver,
+) SecretCipher {
+	return &envelopeCipher{
+		kek:                 kek,
+		storage:             storage,
+		resolveOrganization: resolveOrganization,
+		now:                 time.Now,
+		keys:                make(map[string]*dataKey),
+		activeKeys:          make(map[string]activeDataKey),
+		environmentOrgIDs:   make(map[string]string),
+	}
+}
+
+func (c *envelopeCipher) Encrypt(ctx context.Context, environmentID, plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	key, err := c.environmentDataKey(ctx, environmentID)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := seal(key.aead, []byte(plaintext), []byte(key.id))
+	if err != nil {
+		return "", err
+	}
+	return secretPrefix + key.id + ":" + key.wrapped + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (c *envelopeCipher) Decrypt(ctx context.Context, value string) (string, error) {
+	if !strings.HasPrefix(value, secretPrefix) {
+		return value, nil
+	}
+	id, wrapped, sealed, err := parseSecret(value)
+	if err != nil {
+		return "", err
+	}
+	key, err := c.dataKey(ctx, id, wrapped)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(key.aead, sealed, []byte(id))
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+func (c *envelopeCipher) NeedsRotation(ctx context.Context, environmentID, value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+	if !strings.HasPrefix(value, secretPrefix) {
+		return true, nil
+	}
+	id, _, _, err := parseSecret(value)
+	if err != nil {
+		return false, err
+	}
+	key, err := c.environmentDataKey(ctx, environmentID)
+	if err != nil {
+		return false, err
+	}
+	return id != key.id, nil
+}
+
+func (c *envelopeCipher) RotateDataKey(ctx context.Context, organizationID string) error {
+	_, err := c.createDataKey(ctx, organizationID)
+	return err
+}
+
+func (c *envelopeCipher) environmentDataKey(ctx context.Context, environmentID string) (*dataKey, error) {
+	organizationID, err := c.organizationID(ctx, environmentID)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	active, ok := c.activeKeys[organizationID]
+	c.mu.Unlock()
+	if ok && c.now().Before(active.expiresAt) {
+		return active.key, nil
+	}
+	stored, err := c.storage.GetLatestDataKey(ctx, organizationID)
+	if err != nil {
+		if errors.Is(err, v2.ErrDataKeyNotFound) {
+			return c.createDataKey(ctx, organizationID)
+		}
+		return nil, err
+	}
+	key, err := c.dataKey(ctx, stored.ID, base64.StdEncoding.EncodeToString(stored.EncryptedKey))
+	if err != nil {
+		return nil, err
+	}
+	c.setActiveDataKey(organizationID, key)
+	return key, nil
+}
+
+// organizationID resolves the organization of the environment. The admin environment's
+// secrets are encrypted with a data key of their own.
+func (c *envelopeCipher) organizationID(ctx context.Context, environmentID string) (string, error) {
+	if environmentID == storage.AdminEnvironmentID {
+		return "", nil
+	}
+	c.mu.Lock()
+	organizationID, ok := c.environmentOrgIDs[environmentID]
+	c.mu.Unlock()
+	if ok {
+		return organizationID, nil
+	}
+	organizationID, err := c.resolveOrganization(ctx, environmentID)
+	if err != nil {
+		return "", err
+	}
+	c.mu.Lock()
+	c.environmentOrgIDs[environmentID] = organizationID
+	c.mu.Unlock()
+	return organizationID, nil
+}
+
+func (c *envelopeCipher) createDataKey(ctx context.Context, organizationID string) (*dataKey, error) {
+	raw := make([]byte, dataKeySize)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+	wrapped, err := c.kek.Encrypt(ctx, raw)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := domain.NewDataKey(organizationID, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.storage.CreateDataKey(ctx, stored); err != nil {
+		return nil, err
+	}
+	aead, err := newAESGCM(raw)
+	if err != nil {
+		return nil, err
+	}
+	key := &dataKey{
+		id:      stored.ID,
+		wrapped: base64.StdEncoding.EncodeToString(wrapped),
+		aead:    aead,
+	}
+	c.mu.Lock()
+	c.keys[key.id] = key
+	c.mu.Unlock()
+	c.setActiveDataKey(organizationID, key)
+	return key, nil
+}
+
+// dataKey returns the data key, unwrapping it with the key encryption key the first time it is used.
+func (c *envelopeCipher) dataKey(ctx context.Context, id, wrapped string) (*dataKey, error) {
+	c.mu.Lock()
+	key, ok := c.keys[id]
+	c.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, ErrInvalidCiphertext
+	}
+	raw, err := c.kek.Decrypt(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAESGCM(raw)
+	if err != nil {
+		return nil, err
+	}
+	key = &dataKey{id: id, wrapped: wrapped, aead: aead}
+	c.mu.Lock()
+	c.keys[id] = key
+	c.mu.Unlock()
+	return key, nil
+}
+
+func (c *envelopeCipher) setActiveDataKey(organizationID string, key *dataKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.activeKeys[organizationID] = activeDataKey{
+		key:       key,
+		expiresAt: c.now().Add(activeDataKeyTTL),
+	}
+}
+
+// parseSecret splits an encrypted value into its data key ID, wrapped data key and sealed secret.
+func parseSecret(value string) (string, string, []byte, error) {
+	parts := strings.Split(strings.TrimPrefix(value, secretPrefix), ":")
+	if len(parts) != 3 || parts[0] == "" {
+		return "", "", nil, ErrInvalidCiphertext
+	}
+	sealed, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", "", nil, ErrInvalidCiphertext
+	}
+	return parts[0], parts[1], sealed, nil
+}
+
+// IsEncrypted reports whether the value was returned by a SecretCipher.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, secretPrefix)
+}
@@ -0,0 +1,207 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/crypto/domain"
+	v2 "github.com/bucketeer-io/bucketeer/v2/pkg/crypto/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/crypto/storage/v2/mock"
+)
+
+func TestEnvelopeCipherEncryptDecrypt(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	storage := mock.NewMockDataKeyStorage(mockController)
+	storage.EXPECT().GetLatestDataKey(gomock.Any(), "org-0").Return(nil, v2.ErrDataKeyNotFound)
+	storage.EXPECT().CreateDataKey(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, k *domain.DataKey) error {
+			assert.Equal(t, "org-0", k.OrganizationID)
+			assert.NotEmpty(t, k.EncryptedKey)
+			return nil
+		},
+	)
+	kek := newTestLocalKeyCrypto(t)
+	c := NewEnvelopeCipher(kek, storage, testOrganizationResolver(t))
+
+	ciphertext, err := c.Encrypt(context.Background(), "env-0", "https://hooks.slack.com/services/secret")
+	require.NoError(t, err)
+	assert.True(t, IsEncrypted(ciphertext))
+	assert.NotContains(t, ciphertext, "secret")
+	// The data key and the organization are cached.
+	second, err := c.Encrypt(context.Background(), "env-0", "https://hooks.slack.com/services/secret")
+	require.NoError(t, err)
+	assert.NotEqual(t, ciphertext, second)
+
+	// A server that never saw the data key unwraps it from the ciphertext.
+	other := NewEnvelopeCipher(kek, mock.NewMockDataKeyStorage(mockController), testOrganizationResolver(t))
+	for _, v := range []string{ciphertext, second} {
+		plaintext, err := other.Decrypt(context.Background(), v)
+		require.NoError(t, err)
+		assert.Equal(t, "https://hooks.slack.com/services/secret", plaintext)
+	}
+}
+
+func TestEnvelopeCipherEmptyAndPlaintext(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	c := NewEnvelopeCipher(
+		newTestLocalKeyCrypto(t),
+		mock.NewMockDataKeyStorage(mockController),
+		testOrganizationResolver(t),
+	)
+	ciphertext, err := c.Encrypt(context.Background(), "env-0", "")
+	require.NoError(t, err)
+	assert.Equal(t, "", ciphertext)
+	plaintext, err := c.Decrypt(context.Background(), "stored before the encryption")
+	require.NoError(t, err)
+	assert.Equal(t, "stored before the encryption", plaintext)
+	_, err = c.Decrypt(context.Background(), secretPrefix+"id:broken")
+	assert.Equal(t, ErrInvalidCiphertext, err)
+}
+
+func TestEnvelopeCipherRotation(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	kek := newTestLocalKeyCrypto(t)
+	var stored []*domain.DataKey
+	storage := mock.NewMockDataKeyStorage(mockController)
+	storage.EXPECT().GetLatestDataKey(gomock.Any(), "org-0").DoAndReturn(
+		func(context.Context, string) (*domain.DataKey, error) {
+			if len(stored) == 0 {
+				return nil, v2.ErrDataKeyNotFound
+			}
+			return stored[len(stored)-1], nil
+		},
+	).AnyTimes()
+	storage.EXPECT().CreateDataKey(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, k *domain.DataKey) error {
+			stored = append(stored, k)
+			return nil
+		},
+	).AnyTimes()
+	c := NewEnvelopeCipher(kek, storage, testOrganizationResolver(t))
+
+	old, err := c.Encrypt(context.Background(), "env-0", "secret")
+	require.NoError(t, err)
+	needsRotation, err := c.NeedsRotation(context.Background(), "env-0", old)
+	require.NoError(t, err)
+	assert.False(t, needsRotation)
+	needsRotation, err = c.NeedsRotation(context.Background(), "env-0", "secret")
+	require.NoError(t, err)
+	assert.True(t, needsRotation)
+	needsRotation, err = c.NeedsRotation(context.Background(), "env-0", "")
+	require.NoError(t, err)
+	assert.False(t, needsRotation)
+
+	// Another server picks the new data key up once its cached one expires.
+	other := NewEnvelopeCipher(kek, storage, testOrganizationResolver(t)).(*envelopeCipher)
+	_, err = other.Encrypt(context.Background(), "env-0", "secret")
+	require.NoError(t, err)
+
+	require.NoError(t, c.RotateDataKey(context.Background(), "org-0"))
+	require.Len(t, stored, 2)
+	needsRotation, err = c.NeedsRotation(context.Background(), "env-0", old)
+	require.NoError(t, err)
+	assert.True(t, needsRotation)
+	rotated, err := c.Encrypt(context.Background(), "env-0", "secret")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(rotated, secretPrefix+stored[1].ID+":"))
+
+	needsRotation, err = other.NeedsRotation(context.Background(), "env-0", rotated)
+	require.NoError(t, err)
+	assert.True(t, needsRotation)
+	other.now = func() time.Time { return time.Now().Add(activeDataKeyTTL) }
+	needsRotation, err = other.NeedsRotation(context.Background(), "env-0", rotated)
+	require.NoError(t, err)
+	assert.False(t, needsRotation)
+	plaintext, err := other.Decrypt(context.Background(), old)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", plaintext)
+}
+
+func TestEnvelopeCipherAdminEnvironment(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	storage := mock.NewMockDataKeyStorage(mockController)
+	storage.EXPECT().GetLatestDataKey(gomock.Any(), "").Return(nil, v2.ErrDataKeyNotFound)
+	storage.EXPECT().CreateDataKey(gomock.Any(), gomock.Any()).Return(nil)
+	c := NewEnvelopeCipher(
+		newTestLocalKeyCrypto(t),
+		storage,
+		func(context.Context, string) (string, error) {
+			t.Fatal("the admin environment must not be resolved")
+			return "", nil
+		},
+	)
+	_, err := c.Encrypt(context.Background(), "", "secret")
+	assert.NoError(t, err)
+}
+
+func TestEnvelopeCipherErrors(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	storage := mock.NewMockDataKeyStorage(mockController)
+	c := NewEnvelopeCipher(
+		newTestLocalKeyCrypto(t),
+		storage,
+		func(context.Context, string) (string, error) {
+			return "", errors.New("environment not found")
+		},
+	)
+	_, err := c.Encrypt(context.Background(), "env-0", "secret")
+	assert.EqualError(t, err, "environment not found")
+
+	c = NewEnvelopeCipher(newTestLocalKeyCrypto(t), storage, testOrganizationResolver(t))
+	storage.EXPECT().GetLatestDataKey(gomock.Any(), "org-0").Return(nil, errors.New("error"))
+	_, err = c.Encrypt(context.Background(), "env-0", "secret")
+	assert.EqualError(t, err, "error")
+
+	// A data key wrapped by another key encryption key can't be unwrapped.
+	storage.EXPECT().GetLatestDataKey(gomock.Any(), "org-0").Return(nil, v2.ErrDataKeyNotFound)
+	storage.EXPECT().CreateDataKey(gomock.Any(), gomock.Any()).Return(nil)
+	ciphertext, err := c.Encrypt(context.Background(), "env-0", "secret")
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(path, []byte("fedcba9876543210fedcba9876543210"), 0600))
+	otherKEK, err := NewLocalKeyCrypto(path)
+	require.NoError(t, err)
+	other := NewEnvelopeCipher(otherKEK, storage, testOrganizationResolver(t))
+	_, err = other.Decrypt(context.Background(), ciphertext)
+	assert.Equal(t, ErrInvalidCiphertext, err)
+}
+
+func testOrganizationResolver(t *testing.T) OrganizationResolver {
+	t.Helper()
+	return func(_ context.Context, environmentID string) (string, error) {
+		return strings.Replace(environmentID, "env", "org", 1), nil
+	}
+}
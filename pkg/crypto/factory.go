@@ -0,0 +1,117 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"context"
+	"fmt"
+
+	v2 "github.com/bucketeer-io/bucketeer/v2/pkg/crypto/storage/v2"
+)
+
+// KeyBackend is the service wrapping the data keys of the secrets stored in the database.
+type KeyBackend string
+
+const (
+	// KeyBackendNone stores the secrets in plaintext.
+	KeyBackendNone KeyBackend = "none"
+	// KeyBackendLocal wraps the data keys with a local AES-256 key file.
+	KeyBackendLocal KeyBackend = "local"
+	// KeyBackendGCPKMS wraps the data keys with Google Cloud KMS.
+	KeyBackendGCPKMS KeyBackend = "gcp-kms"
+	// KeyBackendAWSKMS wraps the data keys with AWS KMS.
+	KeyBackendAWSKMS KeyBackend = "aws-kms"
+	// KeyBackendVault wraps the data keys with the HashiCorp Vault transit engine.
+	KeyBackendVault KeyBackend = "vault"
+)
+
+type keyOptions struct {
+	awsRegion    string
+	vaultAddress string
+	vaultToken   string
+}
+
+// KeyOption configures the backend created by NewKeyEncrypter.
+type KeyOption func(*keyOptions)
+
+// WithAWSRegion sets the region of the AWS KMS key.
+func WithAWSRegion(region string) KeyOption {
+	return func(opts *keyOptions) {
+		opts.awsRegion = region
+	}
+}
+
+// WithVaultAddress sets the address of the Vault server.
+func WithVaultAddress(address string) KeyOption {
+	return func(opts *keyOptions) {
+		opts.vaultAddress = address
+	}
+}
+
+// WithVaultToken sets the token used to authenticate to Vault.
+func WithVaultToken(token string) KeyOption {
+	return func(opts *keyOptions) {
+		opts.vaultToken = token
+	}
+}
+
+// NewKeyEncrypter returns the key encryption key of the backend, or nil for KeyBackendNone.
+// The key is the key file path for local, the key name for gcp-kms and vault,
+// and the key ID for aws-kms.
+func NewKeyEncrypter(
+	ctx context.Context,
+	backend KeyBackend,
+	key string,
+	opts ...KeyOption,
+) (EncrypterDecrypter, error) {
+	dopts := &keyOptions{}
+	for _, opt := range opts {
+		opt(dopts)
+	}
+	if backend != KeyBackendNone && key == "" {
+		return nil, fmt.Errorf("crypto: a key is required for the %s backend", backend)
+	}
+	switch backend {
+	case KeyBackendNone:
+		return nil, nil
+	case KeyBackendLocal:
+		return NewLocalKeyCrypto(key)
+	case KeyBackendGCPKMS:
+		return NewCloudKMSCrypto(ctx, key)
+	case KeyBackendAWSKMS:
+		return NewAwsKMSCrypto(ctx, key, dopts.awsRegion)
+	case KeyBackendVault:
+		return NewHashicorpvaultCrypto(ctx, key, dopts.vaultAddress, dopts.vaultToken)
+	default:
+		return nil, fmt.Errorf("crypto: unknown key backend %q", backend)
+	}
+}
+
+// NewSecretCipher returns the SecretCipher wrapping its data keys with the backend,
+// or nil for KeyBackendNone.
+func NewSecretCipher(
+	ctx context.Context,
+	backend KeyBackend,
+	key string,
+	storage v2.DataKeyStorage,
+	resolveOrganization OrganizationResolver,
+	opts ...KeyOption,
+) (SecretCipher, error) {
+	kek, err := NewKeyEncrypter(ctx, backend, key, opts...)
+	if err != nil || kek == nil {
+		return nil, err
+	}
+	return NewEnvelopeCipher(kek, storage, resolveOrganization), nil
+}
@@ -19,7 +19,6 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
-	"log"
 
 	"github.com/hashicorp/vault/api"
 )
@@ -122,9 +121,5 @@ func (c hashicorpvaultCrypto) Decrypt(ctx context.Context, data []byte) ([]byte,
 		return nil, errors.New("after decrypt operation plaintext in data returned from vault is not a string")
 	}
 
-	deplaintext, base64Err := base64.StdEncoding.DecodeString(ptStr)
-	if base64Err != nil {
-		log.Fatal(base64Err)
-	}
-	return deplaintext, nil
+	return base64.StdEncoding.DecodeString(ptStr)
 }
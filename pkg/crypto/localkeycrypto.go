@@ -0,0 +1,101 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+)
+
+const localKeySize = 32
+
+var ErrInvalidCiphertext = errors.New("crypto: invalid ciphertext")
+
+type localKeyCrypto struct {
+	aead cipher.AEAD
+}
+
+// NewLocalKeyCrypto returns an AES-256-GCM EncrypterDecrypter using the key stored in keyPath,
+// for self-hosted deployments without a KMS. The file holds the 32-byte key, raw or base64 encoded.
+func NewLocalKeyCrypto(keyPath string) (EncrypterDecrypter, error) {
+	data, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	key, err := parseLocalKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("crypto: %s: %w", keyPath, err)
+	}
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	return localKeyCrypto{aead: aead}, nil
+}
+
+func parseLocalKey(data []byte) ([]byte, error) {
+	if len(data) == localKeySize {
+		return data, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data)))
+	if err != nil || len(key) != localKeySize {
+		return nil, fmt.Errorf("the key must be %d bytes, raw or base64 encoded", localKeySize)
+	}
+	return key, nil
+}
+
+func (c localKeyCrypto) Encrypt(ctx context.Context, data []byte) ([]byte, error) {
+	return seal(c.aead, data, nil)
+}
+
+func (c localKeyCrypto) Decrypt(ctx context.Context, data []byte) ([]byte, error) {
+	return open(c.aead, data, nil)
+}
+
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts the plaintext with a random nonce, which is prepended to the result.
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(aead cipher.AEAD, data, additionalData []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrInvalidCiphertext
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, ErrInvalidCiphertext
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLocalKeyCrypto(t *testing.T) {
+	t.Parallel()
+	key := []byte("0123456789abcdef0123456789abcdef")
+	patterns := []struct {
+		desc        string
+		content     []byte
+		expectedErr bool
+	}{
+		{
+			desc:    "raw key",
+			content: key,
+		},
+		{
+			desc:    "base64 key",
+			content: []byte(base64.StdEncoding.EncodeToString(key) + "\n"),
+		},
+		{
+			desc:        "short key",
+			content:     []byte("0123456789abcdef"),
+			expectedErr: true,
+		},
+		{
+			desc:        "invalid base64",
+			content:     []byte("not a key"),
+			expectedErr: true,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "key")
+			require.NoError(t, os.WriteFile(path, p.content, 0600))
+			c, err := NewLocalKeyCrypto(path)
+			if p.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			ciphertext, err := c.Encrypt(context.Background(), []byte("secret"))
+			require.NoError(t, err)
+			assert.NotContains(t, string(ciphertext), "secret")
+			plaintext, err := c.Decrypt(context.Background(), ciphertext)
+			require.NoError(t, err)
+			assert.Equal(t, "secret", string(plaintext))
+		})
+	}
+}
+
+func TestLocalKeyCryptoDecryptTampered(t *testing.T) {
+	t.Parallel()
+	c := newTestLocalKeyCrypto(t)
+	ciphertext, err := c.Encrypt(context.Background(), []byte("secret"))
+	require.NoError(t, err)
+	ciphertext[len(ciphertext)-1] ^= 0xff
+	_, err = c.Decrypt(context.Background(), ciphertext)
+	assert.Equal(t, ErrInvalidCiphertext, err)
+	_, err = c.Decrypt(context.Background(), []byte("short"))
+	assert.Equal(t, ErrInvalidCiphertext, err)
+}
+
+func TestNewKeyEncrypter(t *testing.T) {
+	t.Parallel()
+	kek, err := NewKeyEncrypter(context.Background(), KeyBackendNone, "")
+	assert.NoError(t, err)
+	assert.Nil(t, kek)
+	_, err = NewKeyEncrypter(context.Background(), KeyBackendLocal, "")
+	assert.Error(t, err)
+	_, err = NewKeyEncrypter(context.Background(), KeyBackend("unknown"), "key")
+	assert.Error(t, err)
+}
+
+func newTestLocalKeyCrypto(t *testing.T) EncrypterDecrypter {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(path, []byte("0123456789abcdef0123456789abcdef"), 0600))
+	c, err := NewLocalKeyCrypto(path)
+	require.NoError(t, err)
+	return c
+}
@@ -0,0 +1,35 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mock/$GOFILE
+package v2
+
+import (
+	"context"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/crypto/domain"
+	err "github.com/bucketeer-io/bucketeer/v2/pkg/error"
+)
+
+var (
+	ErrDataKeyAlreadyExists = err.NewErrorAlreadyExists(err.CryptoPackageName, "data key already exists")
+	ErrDataKeyNotFound      = err.NewErrorNotFound(err.CryptoPackageName, "data key not found", "data_key")
+)
+
+type DataKeyStorage interface {
+	CreateDataKey(ctx context.Context, k *domain.DataKey) error
+	// GetLatestDataKey returns the key most recently created for the organization,
+	// which is the one encrypting its new secrets.
+	GetLatestDataKey(ctx context.Context, organizationID string) (*domain.DataKey, error)
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/crypto/domain"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres"
+)
+
+var (
+	//go:embed sql/postgres/insert_data_key.sql
+	insertDataKeyPostgres string
+	//go:embed sql/postgres/select_latest_data_key.sql
+	selectLatestDataKeyPostgres string
+)
+
+type postgresDataKeyStorage struct {
+	qe postgres.QueryExecer
+}
+
+// NewPostgresDataKeyStorage returns data key persistence backed by PostgreSQL.
+func NewPostgresDataKeyStorage(qe postgres.QueryExecer) DataKeyStorage {
+	return &postgresDataKeyStorage{qe: qe}
+}
+
+func (s *postgresDataKeyStorage) CreateDataKey(ctx context.Context, k *domain.DataKey) error {
+	_, err := s.qe.ExecContext(
+		ctx,
+		insertDataKeyPostgres,
+		k.ID,
+		k.OrganizationID,
+		k.EncryptedKey,
+		k.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, postgres.ErrDuplicateEntry) {
+			return ErrDataKeyAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *postgresDataKeyStorage) GetLatestDataKey(
+	ctx context.Context,
+	organizationID string,
+) (*domain.DataKey, error) {
+	k := domain.DataKey{}
+	err := s.qe.QueryRowContext(
+		ctx,
+		selectLatestDataKeyPostgres,
+		organizationID,
+	).Scan(
+		&k.ID,
+		&k.OrganizationID,
+		&k.EncryptedKey,
+		&k.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, ErrDataKeyNotFound
+		}
+		return nil, err
+	}
+	return &k, nil
+}
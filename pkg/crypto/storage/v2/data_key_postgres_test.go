@@ -0,0 +1,139 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/crypto/domain"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres"
+	pgmock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres/mock"
+)
+
+func TestPostgresCreateDataKey(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	patterns := []struct {
+		desc        string
+		setup       func(*postgresDataKeyStorage)
+		expectedErr error
+	}{
+		{
+			desc: "ErrDataKeyAlreadyExists",
+			setup: func(s *postgresDataKeyStorage) {
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), "id-0", "org-0", []byte("wrapped"), int64(1),
+				).Return(nil, postgres.ErrDuplicateEntry)
+			},
+			expectedErr: ErrDataKeyAlreadyExists,
+		},
+		{
+			desc: "Error",
+			setup: func(s *postgresDataKeyStorage) {
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), "id-0", "org-0", []byte("wrapped"), int64(1),
+				).Return(nil, errors.New("error"))
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "Success",
+			setup: func(s *postgresDataKeyStorage) {
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), "id-0", "org-0", []byte("wrapped"), int64(1),
+				).Return(nil, nil)
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := &postgresDataKeyStorage{qe: pgmock.NewMockQueryExecer(mockController)}
+			p.setup(storage)
+			err := storage.CreateDataKey(context.Background(), &domain.DataKey{
+				ID:             "id-0",
+				OrganizationID: "org-0",
+				EncryptedKey:   []byte("wrapped"),
+				CreatedAt:      1,
+			})
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestPostgresGetLatestDataKey(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	patterns := []struct {
+		desc        string
+		setup       func(*postgresDataKeyStorage)
+		expectedErr error
+	}{
+		{
+			desc: "ErrDataKeyNotFound",
+			setup: func(s *postgresDataKeyStorage) {
+				row := pgmock.NewMockRow(mockController)
+				row.EXPECT().Scan(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(postgres.ErrNoRows)
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), "org-0",
+				).Return(row)
+			},
+			expectedErr: ErrDataKeyNotFound,
+		},
+		{
+			desc: "Error",
+			setup: func(s *postgresDataKeyStorage) {
+				row := pgmock.NewMockRow(mockController)
+				row.EXPECT().Scan(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(errors.New("error"))
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), "org-0",
+				).Return(row)
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "Success",
+			setup: func(s *postgresDataKeyStorage) {
+				row := pgmock.NewMockRow(mockController)
+				row.EXPECT().Scan(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), "org-0",
+				).Return(row)
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := &postgresDataKeyStorage{qe: pgmock.NewMockQueryExecer(mockController)}
+			p.setup(storage)
+			_, err := storage.GetLatestDataKey(context.Background(), "org-0")
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
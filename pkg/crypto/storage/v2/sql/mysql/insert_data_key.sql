@@ -0,0 +1,6 @@
+INSERT INTO data_key (
+    id,
+    organization_id,
+    encrypted_key,
+    created_at
+) VALUES (?, ?, ?, ?)
\ No newline at end of file
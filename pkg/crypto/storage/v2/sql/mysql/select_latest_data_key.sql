@@ -0,0 +1,13 @@
+SELECT
+    id,
+    organization_id,
+    encrypted_key,
+    created_at
+FROM
+    data_key
+WHERE
+    organization_id = ?
+ORDER BY
+    created_at DESC,
+    id DESC
+LIMIT 1
\ No newline at end of file
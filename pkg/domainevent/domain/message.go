@@ -1303,6 +1303,14 @@ func LocalizedMessage(eventType proto.Event_Type, localizer locale.Localizer) *p
 				localizer.MustLocalizeWithTemplate(locale.ProgressiveRollout),
 			),
 		}
+	case proto.Event_GUARDRAIL_TRIGGERED:
+		return &proto.LocalizedMessage{
+			Locale: localizer.GetLocale(),
+			Message: localizer.MustLocalizeWithTemplate(
+				locale.TriggeredTemplate,
+				localizer.MustLocalizeWithTemplate(locale.Guardrail),
+			),
+		}
 	case proto.Event_ORGANIZATION_CREATED:
 		return &proto.LocalizedMessage{
 			Locale: localizer.GetLocale(),
@@ -1580,6 +1588,110 @@ func LocalizedMessage(eventType proto.Event_Type, localizer locale.Localizer) *p
 				localizer.MustLocalizeWithTemplate(locale.ScheduledFlagChange),
 			),
 		}
+	case proto.Event_CHANGE_REQUEST_CREATED:
+		return &proto.LocalizedMessage{
+			Locale: localizer.GetLocale(),
+			Message: localizer.MustLocalizeWithTemplate(
+				locale.CreatedTemplate,
+				localizer.MustLocalizeWithTemplate(locale.ChangeRequest),
+			),
+		}
+	case proto.Event_CHANGE_REQUEST_APPROVED:
+		return &proto.LocalizedMessage{
+			Locale: localizer.GetLocale(),
+			Message: localizer.MustLocalizeWithTemplate(
+				locale.ApprovedTemplate,
+				localizer.MustLocalizeWithTemplate(locale.ChangeRequest),
+			),
+		}
+	case proto.Event_CHANGE_REQUEST_REJECTED:
+		return &proto.LocalizedMessage{
+			Locale: localizer.GetLocale(),
+			Message: localizer.MustLocalizeWithTemplate(
+				locale.RejectedTemplate,
+				localizer.MustLocalizeWithTemplate(locale.ChangeRequest),
+			),
+		}
+	case proto.Event_CHANGE_REQUEST_APPLIED:
+		return &proto.LocalizedMessage{
+			Locale: localizer.GetLocale(),
+			Message: localizer.MustLocalizeWithTemplate(
+				locale.AppliedTemplate,
+				localizer.MustLocalizeWithTemplate(locale.ChangeRequest),
+			),
+		}
+	case proto.Event_WEBHOOK_CREATED:
+		return &proto.LocalizedMessage{
+			Locale: localizer.GetLocale(),
+			Message: localizer.MustLocalizeWithTemplate(
+				locale.CreatedTemplate,
+				localizer.MustLocalizeWithTemplate(locale.Webhook),
+			),
+		}
+	case proto.Event_WEBHOOK_UPDATED:
+		return &proto.LocalizedMessage{
+			Locale: localizer.GetLocale(),
+			Message: localizer.MustLocalizeWithTemplate(
+				locale.UpdatedTemplate,
+				localizer.MustLocalizeWithTemplate(locale.Webhook),
+			),
+		}
+	case proto.Event_WEBHOOK_DELETED:
+		return &proto.LocalizedMessage{
+			Locale: localizer.GetLocale(),
+			Message: localizer.MustLocalizeWithTemplate(
+				locale.DeletedTemplate,
+				localizer.MustLocalizeWithTemplate(locale.Webhook),
+			),
+		}
+	case proto.Event_WEBHOOK_DELIVERY_REPLAYED:
+		return &proto.LocalizedMessage{
+			Locale: localizer.GetLocale(),
+			Message: localizer.MustLocalizeWithTemplate(
+				locale.TriggeredTemplate,
+				localizer.MustLocalizeWithTemplate(locale.Webhook),
+			),
+		}
+	case proto.Event_SCIM_TOKEN_CREATED:
+		return &proto.LocalizedMessage{
+			Locale: localizer.GetLocale(),
+			Message: localizer.MustLocalizeWithTemplate(
+				locale.CreatedTemplate,
+				localizer.MustLocalizeWithTemplate(locale.SCIMToken),
+			),
+		}
+	case proto.Event_SCIM_TOKEN_DELETED:
+		return &proto.LocalizedMessage{
+			Locale: localizer.GetLocale(),
+			Message: localizer.MustLocalizeWithTemplate(
+				locale.DeletedTemplate,
+				localizer.MustLocalizeWithTemplate(locale.SCIMToken),
+			),
+		}
+	case proto.Event_CUSTOM_ROLE_CREATED:
+		return &proto.LocalizedMessage{
+			Locale: localizer.GetLocale(),
+			Message: localizer.MustLocalizeWithTemplate(
+				locale.CreatedTemplate,
+				localizer.MustLocalizeWithTemplate(locale.CustomRole),
+			),
+		}
+	case proto.Event_CUSTOM_ROLE_UPDATED:
+		return &proto.LocalizedMessage{
+			Locale: localizer.GetLocale(),
+			Message: localizer.MustLocalizeWithTemplate(
+				locale.UpdatedTemplate,
+				localizer.MustLocalizeWithTemplate(locale.CustomRole),
+			),
+		}
+	case proto.Event_CUSTOM_ROLE_DELETED:
+		return &proto.LocalizedMessage{
+			Locale: localizer.GetLocale(),
+			Message: localizer.MustLocalizeWithTemplate(
+				locale.DeletedTemplate,
+				localizer.MustLocalizeWithTemplate(locale.CustomRole),
+			),
+		}
 	}
 
 	return &proto.LocalizedMessage{
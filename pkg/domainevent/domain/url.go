@@ -34,6 +34,9 @@ const (
 	urlTemplateSubscription = "%s/%s/notifications/%s?environmentId=%s"
 	urlTemplateTag          = "%s/%s/tags/%s"
 	urlTemplateTeam         = "%s/%s/teams/%s"
+	urlTemplateWebhook      = "%s/%s/notifications"
+	urlTemplateSCIMToken    = "%s/%s/settings"
+	urlTemplateCustomRole   = "%s/%s/accounts"
 
 	urlTemplateAdminSubscription = "%s/%s/notifications/%s"
 	urlTemplateEnvironment       = "%s/%s/environments/%s"
@@ -87,6 +90,18 @@ func URL(entityType proto.Event_EntityType, url, envURLCode, id string) (string,
 	case proto.Event_SCHEDULED_FLAG_CHANGE:
 		// Scheduled flag changes link to the feature flag page
 		return fmt.Sprintf(urlTemplateFeature, url, envURLCode, id), nil
+	case proto.Event_CHANGE_REQUEST:
+		// Change requests link to the feature flag page
+		return fmt.Sprintf(urlTemplateFeature, url, envURLCode, id), nil
+	case proto.Event_WEBHOOK:
+		// Webhooks are managed from the notification settings page
+		return fmt.Sprintf(urlTemplateWebhook, url, envURLCode), nil
+	case proto.Event_SCIM_TOKEN:
+		// SCIM tokens are managed from the organization settings page
+		return fmt.Sprintf(urlTemplateSCIMToken, url, envURLCode), nil
+	case proto.Event_CUSTOM_ROLE:
+		// Custom roles are assigned from the accounts page
+		return fmt.Sprintf(urlTemplateCustomRole, url, envURLCode), nil
 	}
 	return "", ErrUnknownEntityType
 }
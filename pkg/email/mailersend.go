@@ -45,7 +45,7 @@ func NewMailerSendService(config Config, logger *zap.Logger) Service {
 func (s *MailerSendService) SendWelcomeEmail(ctx context.Context, to string, language string) error {
 	subject, body := s.renderer.RenderWelcomeEmail(language, to)
 
-	err := s.sendEmail(ctx, to, subject, body)
+	err := s.sendEmail(ctx, []string{to}, subject, body)
 	if err != nil {
 		s.logger.Error("Failed to send welcome email",
 			zap.Error(err),
@@ -60,7 +60,40 @@ func (s *MailerSendService) SendWelcomeEmail(ctx context.Context, to string, lan
 	return nil
 }
 
-func (s *MailerSendService) sendEmail(ctx context.Context, to, subject, body string) error {
+func (s *MailerSendService) SendNotificationEmail(ctx context.Context, to []string, subject, body string) error {
+	if err := s.sendEmail(ctx, to, subject, body); err != nil {
+		s.logger.Error("Failed to send notification email",
+			zap.Error(err),
+			zap.Strings("to", to),
+		)
+		return fmt.Errorf("failed to send notification email: %w", err)
+	}
+	return nil
+}
+
+func (s *MailerSendService) sendEmail(ctx context.Context, to []string, subject, body string) error {
+	if len(to) == 0 {
+		return ErrNoRecipients
+	}
+	if len(to) == 1 {
+		if _, err := s.client.Email.Send(ctx, s.newMessage(to[0], subject, body)); err != nil {
+			return fmt.Errorf("failed to send email via MailerSend: %w", err)
+		}
+		return nil
+	}
+	// The recipients of one message see each other, so multiple addresses are sent
+	// as one bulk request with a message per address.
+	messages := make([]*mailersend.Message, 0, len(to))
+	for _, address := range to {
+		messages = append(messages, s.newMessage(address, subject, body))
+	}
+	if _, _, err := s.client.BulkEmail.Send(ctx, messages); err != nil {
+		return fmt.Errorf("failed to send bulk email via MailerSend: %w", err)
+	}
+	return nil
+}
+
+func (s *MailerSendService) newMessage(to, subject, body string) *mailersend.Message {
 	// Create the message using MailerSend's message builder
 	message := s.client.Email.NewMessage()
 
@@ -82,12 +115,5 @@ func (s *MailerSendService) sendEmail(ctx context.Context, to, subject, body str
 	// Set subject and HTML body
 	message.SetSubject(subject)
 	message.SetHTML(body)
-
-	// Send the email
-	_, err := s.client.Email.Send(ctx, message)
-	if err != nil {
-		return fmt.Errorf("failed to send email via MailerSend: %w", err)
-	}
-
-	return nil
+	return message
 }
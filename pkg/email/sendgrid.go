@@ -42,7 +42,7 @@ func NewSendGridService(config Config, logger *zap.Logger) Service {
 func (s *SendGridService) SendWelcomeEmail(ctx context.Context, to string, language string) error {
 	subject, body := s.renderer.RenderWelcomeEmail(language, to)
 
-	err := s.sendEmail(ctx, to, subject, body)
+	err := s.sendEmail(ctx, []string{to}, subject, body)
 	if err != nil {
 		s.logger.Error("Failed to send welcome email",
 			zap.Error(err),
@@ -57,10 +57,22 @@ func (s *SendGridService) SendWelcomeEmail(ctx context.Context, to string, langu
 	return nil
 }
 
-func (s *SendGridService) sendEmail(ctx context.Context, to, subject, body string) error {
-	from := mail.NewEmail(s.config.Sender.Name, s.config.Sender.Email)
-	toEmail := mail.NewEmail("", to)
-	message := mail.NewSingleEmail(from, subject, toEmail, "", body)
+func (s *SendGridService) SendNotificationEmail(ctx context.Context, to []string, subject, body string) error {
+	if err := s.sendEmail(ctx, to, subject, body); err != nil {
+		s.logger.Error("Failed to send notification email",
+			zap.Error(err),
+			zap.Strings("to", to),
+		)
+		return fmt.Errorf("failed to send notification email: %w", err)
+	}
+	return nil
+}
+
+func (s *SendGridService) sendEmail(ctx context.Context, to []string, subject, body string) error {
+	if len(to) == 0 {
+		return ErrNoRecipients
+	}
+	message := s.newMessage(to, subject, body)
 
 	client := sendgrid.NewSendClient(s.config.SendGrid.APIKey)
 	response, err := client.SendWithContext(ctx, message)
@@ -74,3 +86,18 @@ func (s *SendGridService) sendEmail(ctx context.Context, to, subject, body strin
 
 	return nil
 }
+
+// newMessage creates a message with one personalization per address,
+// so every recipient gets their own copy without seeing the other addresses.
+func (s *SendGridService) newMessage(to []string, subject, body string) *mail.SGMailV3 {
+	message := mail.NewV3Mail()
+	message.SetFrom(mail.NewEmail(s.config.Sender.Name, s.config.Sender.Email))
+	message.Subject = subject
+	message.AddContent(mail.NewContent("text/html", body))
+	for _, address := range to {
+		p := mail.NewPersonalization()
+		p.AddTos(mail.NewEmail("", address))
+		message.AddPersonalizations(p)
+	}
+	return message
+}
@@ -16,14 +16,23 @@ package email
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"go.uber.org/zap"
 )
 
+// ErrNoRecipients is returned when an email is sent without any address.
+var ErrNoRecipients = errors.New("email: no recipients")
+
 // Service defines the interface for sending emails
 type Service interface {
 	SendWelcomeEmail(ctx context.Context, to string, language string) error
+	// SendNotificationEmail sends an already rendered HTML email to all the addresses in one request,
+	// so it either reaches every address or fails as a whole.
+	// The recipients don't see each other's addresses.
+	// It is used by callers that own their templates, such as subscription notifications.
+	SendNotificationEmail(ctx context.Context, to []string, subject, body string) error
 }
 
 // NewService creates an email service based on configuration
@@ -63,3 +72,11 @@ func (s *NoOpService) SendWelcomeEmail(ctx context.Context, to string, language
 	)
 	return nil
 }
+
+func (s *NoOpService) SendNotificationEmail(ctx context.Context, to []string, subject, body string) error {
+	s.logger.Info("No-op email service: notification email not sent",
+		zap.Strings("to", to),
+		zap.String("subject", subject),
+	)
+	return nil
+}
@@ -16,6 +16,7 @@ package email
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"go.uber.org/zap"
@@ -110,4 +111,57 @@ func TestNoOpService(t *testing.T) {
 			t.Errorf("unexpected error: %v", err)
 		}
 	})
+	t.Run("SendNotificationEmail", func(t *testing.T) {
+		err := service.SendNotificationEmail(ctx, []string{"test@example.com"}, "subject", "<p>body</p>")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestSendNotificationEmailWithoutRecipients(t *testing.T) {
+	t.Parallel()
+
+	logger := zap.NewNop()
+	services := map[string]Service{
+		"smtp":     NewSMTPService(Config{SMTP: SMTPConfig{Host: "smtp.example.com", Port: 587}}, logger),
+		"sendgrid": NewSendGridService(Config{SendGrid: SendGridConfig{APIKey: "test-key"}}, logger),
+	}
+	for name, service := range services {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := service.SendNotificationEmail(context.Background(), nil, "subject", "<p>body</p>")
+			if !errors.Is(err, ErrNoRecipients) {
+				t.Errorf("expected ErrNoRecipients but got %v", err)
+			}
+		})
+	}
+}
+
+func TestSendGridMessageHidesRecipients(t *testing.T) {
+	t.Parallel()
+
+	service := &SendGridService{config: Config{Sender: SenderConfig{Email: "noreply@example.com"}}}
+	message := service.newMessage([]string{"a@example.com", "b@example.com"}, "subject", "<p>body</p>")
+	if len(message.Personalizations) != 2 {
+		t.Fatalf("expected 2 personalizations but got %d", len(message.Personalizations))
+	}
+	for i, address := range []string{"a@example.com", "b@example.com"} {
+		tos := message.Personalizations[i].To
+		if len(tos) != 1 || tos[0].Address != address {
+			t.Errorf("expected personalization %d to be sent only to %s", i, address)
+		}
+	}
+}
+
+func TestSMTPToHeader(t *testing.T) {
+	t.Parallel()
+
+	if got := smtpToHeader([]string{"a@example.com"}); got != "a@example.com" {
+		t.Errorf("unexpected header for one recipient: %s", got)
+	}
+	if got := smtpToHeader([]string{"a@example.com", "b@example.com"}); got != "undisclosed-recipients:;" {
+		t.Errorf("unexpected header for multiple recipients: %s", got)
+	}
 }
@@ -62,7 +62,7 @@ func NewSESService(emailConfig Config, logger *zap.Logger) (Service, error) {
 func (s *SESService) SendWelcomeEmail(ctx context.Context, to string, language string) error {
 	subject, body := s.renderer.RenderWelcomeEmail(language, to)
 
-	err := s.sendEmail(ctx, to, subject, body)
+	err := s.sendEmail(ctx, []string{to}, subject, body)
 	if err != nil {
 		s.logger.Error("Failed to send welcome email",
 			zap.Error(err),
@@ -77,12 +77,26 @@ func (s *SESService) SendWelcomeEmail(ctx context.Context, to string, language s
 	return nil
 }
 
-func (s *SESService) sendEmail(ctx context.Context, to, subject, body string) error {
+func (s *SESService) SendNotificationEmail(ctx context.Context, to []string, subject, body string) error {
+	if err := s.sendEmail(ctx, to, subject, body); err != nil {
+		s.logger.Error("Failed to send notification email",
+			zap.Error(err),
+			zap.Strings("to", to),
+		)
+		return fmt.Errorf("failed to send notification email: %w", err)
+	}
+	return nil
+}
+
+func (s *SESService) sendEmail(ctx context.Context, to []string, subject, body string) error {
+	if len(to) == 0 {
+		return ErrNoRecipients
+	}
 	input := &sesv2.SendEmailInput{
 		FromEmailAddress: aws.String(s.config.Sender.Email),
-		Destination: &types.Destination{
-			ToAddresses: []string{to},
-		},
+		// A single address is sent as is. Multiple addresses are sent as BCC,
+		// so the recipients don't see each other.
+		Destination: sesDestination(to),
 		Content: &types.EmailContent{
 			Simple: &types.Message{
 				Subject: &types.Content{
@@ -106,3 +120,10 @@ func (s *SESService) sendEmail(ctx context.Context, to, subject, body string) er
 
 	return nil
 }
+
+func sesDestination(to []string) *types.Destination {
+	if len(to) == 1 {
+		return &types.Destination{ToAddresses: to}
+	}
+	return &types.Destination{BccAddresses: to}
+}
@@ -41,7 +41,7 @@ func NewSMTPService(config Config, logger *zap.Logger) Service {
 func (s *SMTPService) SendWelcomeEmail(ctx context.Context, to string, language string) error {
 	subject, body := s.renderer.RenderWelcomeEmail(language, to)
 
-	err := s.sendEmail(ctx, to, subject, body)
+	err := s.sendEmail(ctx, []string{to}, subject, body)
 	if err != nil {
 		s.logger.Error("Failed to send welcome email",
 			zap.Error(err),
@@ -56,7 +56,21 @@ func (s *SMTPService) SendWelcomeEmail(ctx context.Context, to string, language
 	return nil
 }
 
-func (s *SMTPService) sendEmail(ctx context.Context, to, subject, body string) error {
+func (s *SMTPService) SendNotificationEmail(ctx context.Context, to []string, subject, body string) error {
+	if err := s.sendEmail(ctx, to, subject, body); err != nil {
+		s.logger.Error("Failed to send notification email",
+			zap.Error(err),
+			zap.Strings("to", to),
+		)
+		return fmt.Errorf("failed to send notification email: %w", err)
+	}
+	return nil
+}
+
+func (s *SMTPService) sendEmail(ctx context.Context, to []string, subject, body string) error {
+	if len(to) == 0 {
+		return ErrNoRecipients
+	}
 	auth := smtp.PlainAuth("", s.config.SMTP.Username, s.config.SMTP.Password, s.config.SMTP.Host)
 
 	msg := []byte(fmt.Sprintf("To: %s\r\n"+
@@ -65,8 +79,17 @@ func (s *SMTPService) sendEmail(ctx context.Context, to, subject, body string) e
 		"MIME-Version: 1.0\r\n"+
 		"Content-Type: text/html; charset=UTF-8\r\n"+
 		"\r\n"+
-		"%s\r\n", to, s.config.Sender.Email, subject, body))
+		"%s\r\n", smtpToHeader(to), s.config.Sender.Email, subject, body))
 
 	addr := fmt.Sprintf("%s:%d", s.config.SMTP.Host, s.config.SMTP.Port)
-	return smtp.SendMail(addr, auth, s.config.Sender.Email, []string{to}, msg)
+	return smtp.SendMail(addr, auth, s.config.Sender.Email, to, msg)
+}
+
+// smtpToHeader returns the To header. The addresses are only given in the envelope
+// when there are multiple recipients, so they don't see each other.
+func smtpToHeader(to []string) string {
+	if len(to) == 1 {
+		return to[0]
+	}
+	return "undisclosed-recipients:;"
 }
@@ -273,6 +273,8 @@ func (s *EnvironmentService) UpdateEnvironmentV2(
 			req.AutoArchiveEnabled,
 			req.AutoArchiveUnusedDays,
 			req.AutoArchiveCheckCodeRefs,
+			req.RequireChangeApproval,
+			req.ChangeApprovalMinApprovers,
 		)
 		if err != nil {
 			return err
@@ -283,10 +285,12 @@ func (s *EnvironmentService) UpdateEnvironmentV2(
 			environment.Id,
 			eventproto.Event_ENVIRONMENT_V2_UPDATED,
 			&eventproto.EnvironmentV2UpdatedEvent{
-				Id:             updated.Id,
-				Name:           req.Name,
-				Description:    req.Description,
-				RequireComment: req.RequireComment,
+				Id:                         updated.Id,
+				Name:                       req.Name,
+				Description:                req.Description,
+				RequireComment:             req.RequireComment,
+				RequireChangeApproval:      req.RequireChangeApproval,
+				ChangeApprovalMinApprovers: req.ChangeApprovalMinApprovers,
 			},
 			updated,
 			environment,
@@ -323,6 +327,16 @@ func (s *EnvironmentService) UpdateEnvironmentV2(
 			}
 			return nil, dt.Err()
 		}
+		if errors.Is(err, domain.ErrChangeApprovalMinApproversInvalid) {
+			dt, err := statusInvalidChangeApprovalMinApprovers.WithDetails(&errdetails.LocalizedMessage{
+				Locale:  localizer.GetLocale(),
+				Message: localizer.MustLocalizeWithTemplate(locale.InvalidArgumentError, "change_approval_min_approvers"),
+			})
+			if err != nil {
+				return nil, statusInternal.Err()
+			}
+			return nil, dt.Err()
+		}
 		s.logger.Error(
 			"Failed to update environment",
 			log.FieldsFromIncomingContext(ctx).AddFields(zap.Error(err))...,
@@ -359,6 +373,17 @@ func validateUpdateEnvironmentV2Request(
 			return dt.Err()
 		}
 	}
+	// Change approval validation
+	if req.ChangeApprovalMinApprovers != nil && req.ChangeApprovalMinApprovers.Value <= 0 {
+		dt, err := statusInvalidChangeApprovalMinApprovers.WithDetails(&errdetails.LocalizedMessage{
+			Locale:  localizer.GetLocale(),
+			Message: localizer.MustLocalizeWithTemplate(locale.InvalidArgumentError, "change_approval_min_approvers"),
+		})
+		if err != nil {
+			return statusInternal.Err()
+		}
+		return dt.Err()
+	}
 	return nil
 }
 
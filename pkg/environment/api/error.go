@@ -127,4 +127,10 @@ var (
 			"cannot update auto-archive settings when auto_archive_enabled is false",
 			"auto_archive_settings",
 		))
+	statusInvalidChangeApprovalMinApprovers = api.NewGRPCStatus(
+		pkgErr.NewErrorInvalidArgNotMatchFormat(
+			pkgErr.EnvironmentPackageName,
+			"change_approval_min_approvers must be greater than 0",
+			"change_approval_min_approvers",
+		))
 )
@@ -29,6 +29,8 @@ import (
 var (
 	ErrAutoArchiveUnusedDaysRequired = errors.New("auto_archive_unused_days is required when enabling auto-archive")
 	ErrAutoArchiveNotEnabled         = errors.New("cannot update auto-archive settings when auto_archive_enabled is false")
+
+	ErrChangeApprovalMinApproversInvalid = errors.New("change_approval_min_approvers must be greater than zero")
 )
 
 type EnvironmentV2 struct {
@@ -36,8 +38,9 @@ type EnvironmentV2 struct {
 }
 
 const (
-	defaultAutoArchiveUnusedDays    int32 = 60
-	defaultAutoArchiveCheckCodeRefs       = true
+	defaultAutoArchiveUnusedDays      int32 = 60
+	defaultAutoArchiveCheckCodeRefs         = true
+	defaultChangeApprovalMinApprovers int32 = 1
 )
 
 func NewEnvironmentV2(
@@ -56,19 +59,21 @@ func NewEnvironmentV2(
 	}
 	now := time.Now().Unix()
 	return &EnvironmentV2{&proto.EnvironmentV2{
-		Id:                       uid.String(),
-		Name:                     name,
-		UrlCode:                  urlCode,
-		Description:              description,
-		ProjectId:                projectID,
-		OrganizationId:           organizationID,
-		Archived:                 false,
-		RequireComment:           requireComment,
-		CreatedAt:                now,
-		UpdatedAt:                now,
-		AutoArchiveEnabled:       false,
-		AutoArchiveUnusedDays:    defaultAutoArchiveUnusedDays,
-		AutoArchiveCheckCodeRefs: defaultAutoArchiveCheckCodeRefs,
+		Id:                         uid.String(),
+		Name:                       name,
+		UrlCode:                    urlCode,
+		Description:                description,
+		ProjectId:                  projectID,
+		OrganizationId:             organizationID,
+		Archived:                   false,
+		RequireComment:             requireComment,
+		CreatedAt:                  now,
+		UpdatedAt:                  now,
+		AutoArchiveEnabled:         false,
+		AutoArchiveUnusedDays:      defaultAutoArchiveUnusedDays,
+		AutoArchiveCheckCodeRefs:   defaultAutoArchiveCheckCodeRefs,
+		RequireChangeApproval:      false,
+		ChangeApprovalMinApprovers: defaultChangeApprovalMinApprovers,
 	}}, nil
 }
 
@@ -80,6 +85,8 @@ func (e *EnvironmentV2) Update(
 	autoArchiveEnabled *wrapperspb.BoolValue,
 	autoArchiveUnusedDays *wrapperspb.Int32Value,
 	autoArchiveCheckCodeRefs *wrapperspb.BoolValue,
+	requireChangeApproval *wrapperspb.BoolValue,
+	changeApprovalMinApprovers *wrapperspb.Int32Value,
 ) (*EnvironmentV2, error) {
 	// Auto-archive validation
 	// Case 1: When enabling auto-archive, unused_days is required
@@ -98,6 +105,11 @@ func (e *EnvironmentV2) Update(
 		return nil, ErrAutoArchiveNotEnabled
 	}
 
+	// Change approval validation
+	if changeApprovalMinApprovers != nil && changeApprovalMinApprovers.Value <= 0 {
+		return nil, ErrChangeApprovalMinApproversInvalid
+	}
+
 	updated := &EnvironmentV2{}
 	if err := copier.Copy(updated, e); err != nil {
 		return nil, err
@@ -124,6 +136,12 @@ func (e *EnvironmentV2) Update(
 	if autoArchiveCheckCodeRefs != nil {
 		updated.AutoArchiveCheckCodeRefs = autoArchiveCheckCodeRefs.Value
 	}
+	if requireChangeApproval != nil {
+		updated.RequireChangeApproval = requireChangeApproval.Value
+	}
+	if changeApprovalMinApprovers != nil {
+		updated.ChangeApprovalMinApprovers = changeApprovalMinApprovers.Value
+	}
 
 	updated.UpdatedAt = time.Now().Unix()
 	return updated, nil
@@ -44,6 +44,9 @@ func TestNewEnvironmentV2(t *testing.T) {
 	assert.Equal(t, false, env.AutoArchiveEnabled)
 	assert.Equal(t, defaultAutoArchiveUnusedDays, env.AutoArchiveUnusedDays)
 	assert.Equal(t, defaultAutoArchiveCheckCodeRefs, env.AutoArchiveCheckCodeRefs)
+	// Change approval default values
+	assert.Equal(t, false, env.RequireChangeApproval)
+	assert.Equal(t, defaultChangeApprovalMinApprovers, env.ChangeApprovalMinApprovers)
 }
 
 func TestUpdateEnvironmentV2(t *testing.T) {
@@ -67,6 +70,8 @@ func TestUpdateEnvironmentV2(t *testing.T) {
 		wrapperspb.Bool(true),
 		wrapperspb.Int32(30),
 		wrapperspb.Bool(false),
+		wrapperspb.Bool(true),
+		wrapperspb.Int32(2),
 	)
 	assert.NoError(t, err)
 	assert.Equal(t, "new-name", updated.Name)
@@ -77,6 +82,9 @@ func TestUpdateEnvironmentV2(t *testing.T) {
 	assert.Equal(t, true, updated.AutoArchiveEnabled)
 	assert.Equal(t, int32(30), updated.AutoArchiveUnusedDays)
 	assert.Equal(t, false, updated.AutoArchiveCheckCodeRefs)
+	// Change approval settings
+	assert.Equal(t, true, updated.RequireChangeApproval)
+	assert.Equal(t, int32(2), updated.ChangeApprovalMinApprovers)
 }
 
 func TestRenameEnvironmentV2(t *testing.T) {
@@ -267,6 +275,8 @@ func TestUpdateEnvironmentV2_AutoArchiveValidation(t *testing.T) {
 				tt.autoArchiveEnabled,
 				tt.autoArchiveUnusedDays,
 				tt.autoArchiveCheckCodeRefs,
+				nil, // requireChangeApproval
+				nil, // changeApprovalMinApprovers
 			)
 
 			if tt.expectedError != nil {
@@ -277,3 +287,74 @@ func TestUpdateEnvironmentV2_AutoArchiveValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateEnvironmentV2_ChangeApprovalValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                       string
+		requireChangeApproval      *wrapperspb.BoolValue
+		changeApprovalMinApprovers *wrapperspb.Int32Value
+		expectedMinApprovers       int32
+		expectedError              error
+	}{
+		{
+			name:                       "err: min approvers is zero",
+			requireChangeApproval:      wrapperspb.Bool(true),
+			changeApprovalMinApprovers: wrapperspb.Int32(0),
+			expectedError:              ErrChangeApprovalMinApproversInvalid,
+		},
+		{
+			name:                       "err: min approvers is negative",
+			changeApprovalMinApprovers: wrapperspb.Int32(-1),
+			expectedError:              ErrChangeApprovalMinApproversInvalid,
+		},
+		{
+			name:                  "success: enable change approval with the default min approvers",
+			requireChangeApproval: wrapperspb.Bool(true),
+			expectedMinApprovers:  defaultChangeApprovalMinApprovers,
+		},
+		{
+			name:                       "success: enable change approval with min approvers",
+			requireChangeApproval:      wrapperspb.Bool(true),
+			changeApprovalMinApprovers: wrapperspb.Int32(3),
+			expectedMinApprovers:       3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			env, err := NewEnvironmentV2(
+				"name",
+				"code",
+				"desc",
+				"project-id",
+				"organization-id",
+				false,
+				zap.NewNop(),
+			)
+			assert.NoError(t, err)
+
+			updated, err := env.Update(
+				nil, // name
+				nil, // description
+				nil, // requireComment
+				nil, // archived
+				nil, // autoArchiveEnabled
+				nil, // autoArchiveUnusedDays
+				nil, // autoArchiveCheckCodeRefs
+				tt.requireChangeApproval,
+				tt.changeApprovalMinApprovers,
+			)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.requireChangeApproval.GetValue(), updated.RequireChangeApproval)
+			assert.Equal(t, tt.expectedMinApprovers, updated.ChangeApprovalMinApprovers)
+		})
+	}
+}
@@ -11,5 +11,7 @@ INSERT INTO environment_v2 (
     updated_at,
     auto_archive_enabled,
     auto_archive_unused_days,
-    auto_archive_check_code_refs
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
\ No newline at end of file
+    auto_archive_check_code_refs,
+    require_change_approval,
+    change_approval_min_approvers
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
\ No newline at end of file
@@ -11,7 +11,9 @@ SELECT
     updated_at,
     auto_archive_enabled,
     auto_archive_unused_days,
-    auto_archive_check_code_refs
+    auto_archive_check_code_refs,
+    require_change_approval,
+    change_approval_min_approvers
 FROM
     environment_v2
 WHERE
@@ -8,6 +8,8 @@ SET
     updated_at = ?,
     auto_archive_enabled = ?,
     auto_archive_unused_days = ?,
-    auto_archive_check_code_refs = ?
+    auto_archive_check_code_refs = ?,
+    require_change_approval = ?,
+    change_approval_min_approvers = ?
 WHERE
     id = ?
\ No newline at end of file
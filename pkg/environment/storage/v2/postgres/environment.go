@@ -67,6 +67,8 @@ func (s *environmentStorage) CreateEnvironmentV2(ctx context.Context, e *domain.
 		e.AutoArchiveEnabled,
 		e.AutoArchiveUnusedDays,
 		e.AutoArchiveCheckCodeRefs,
+		e.RequireChangeApproval,
+		e.ChangeApprovalMinApprovers,
 	)
 	if err != nil {
 		if errors.Is(err, pgstorage.ErrDuplicateEntry) {
@@ -90,6 +92,8 @@ func (s *environmentStorage) UpdateEnvironmentV2(ctx context.Context, e *domain.
 		e.AutoArchiveEnabled,
 		e.AutoArchiveUnusedDays,
 		e.AutoArchiveCheckCodeRefs,
+		e.RequireChangeApproval,
+		e.ChangeApprovalMinApprovers,
 		e.Id,
 	)
 	if err != nil {
@@ -125,6 +129,8 @@ func (s *environmentStorage) GetEnvironmentV2(ctx context.Context, id string) (*
 		&environment.AutoArchiveEnabled,
 		&environment.AutoArchiveUnusedDays,
 		&environment.AutoArchiveCheckCodeRefs,
+		&environment.RequireChangeApproval,
+		&environment.ChangeApprovalMinApprovers,
 	)
 	if err != nil {
 		if errors.Is(err, pgstorage.ErrNoRows) {
@@ -171,6 +177,8 @@ func (s *environmentStorage) ListEnvironmentsV2(
 			&environment.AutoArchiveEnabled,
 			&environment.AutoArchiveUnusedDays,
 			&environment.AutoArchiveCheckCodeRefs,
+			&environment.RequireChangeApproval,
+			&environment.ChangeApprovalMinApprovers,
 			&environment.FeatureFlagCount,
 		)
 		if err != nil {
@@ -215,6 +223,8 @@ func (s *environmentStorage) ListAutoArchiveEnabledEnvironments(ctx context.Cont
 			&environment.AutoArchiveEnabled,
 			&environment.AutoArchiveUnusedDays,
 			&environment.AutoArchiveCheckCodeRefs,
+			&environment.RequireChangeApproval,
+			&environment.ChangeApprovalMinApprovers,
 		)
 		if err != nil {
 			return nil, err
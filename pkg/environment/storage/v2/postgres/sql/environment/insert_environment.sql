@@ -11,5 +11,7 @@ INSERT INTO environment_v2 (
     updated_at,
     auto_archive_enabled,
     auto_archive_unused_days,
-    auto_archive_check_code_refs
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+    auto_archive_check_code_refs,
+    require_change_approval,
+    change_approval_min_approvers
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
@@ -12,6 +12,8 @@ SELECT
     environment_v2.auto_archive_enabled,
     environment_v2.auto_archive_unused_days,
     environment_v2.auto_archive_check_code_refs,
+    environment_v2.require_change_approval,
+    environment_v2.change_approval_min_approvers,
     COALESCE(COUNT(DISTINCT feature.id), 0) AS feature_count
 FROM
     environment_v2
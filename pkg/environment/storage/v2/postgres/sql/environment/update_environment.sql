@@ -8,6 +8,8 @@ SET
     updated_at = $6,
     auto_archive_enabled = $7,
     auto_archive_unused_days = $8,
-    auto_archive_check_code_refs = $9
+    auto_archive_check_code_refs = $9,
+    require_change_approval = $10,
+    change_approval_min_approvers = $11
 WHERE
-    id = $10
+    id = $12
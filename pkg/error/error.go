@@ -37,6 +37,7 @@ const (
 	ExperimentPackageName   = "experiment"
 	AuthPackageName         = "auth"
 	AIChatPackageName       = "aichat"
+	CryptoPackageName       = "crypto"
 
 	invalidPrefix = "Invalid"
 )
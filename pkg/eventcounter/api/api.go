@@ -826,6 +826,7 @@ func (s *eventCounterService) GetExperimentGoalCount(
 		req.GoalId,
 		req.FeatureId,
 		req.FeatureVersion,
+		req.ValueCapPercentile,
 	)
 	if err != nil {
 		s.logger.Error(
@@ -888,6 +889,8 @@ func (s *eventCounterService) convertGoalCounts(
 		vc.ValueSum = row.GoalValueTotal
 		vc.ValueSumPerUserMean = row.GoalValueMean
 		vc.ValueSumPerUserVariance = row.GoalValueVariance
+		vc.EventCountPerUserVariance = row.GoalEventCountVariance
+		vc.ValueSumEventCountCovariance = row.GoalValueEventCovariance
 		vcsMap[row.VariationID] = vc
 	}
 	vcs := make([]*ecproto.VariationCount, 0, len(vcsMap))
@@ -632,7 +632,9 @@ func TestGetExperimentGoalCount(t *testing.T) {
 			orgRole: toPtr(accountproto.AccountV2_Role_Organization_MEMBER),
 			envRole: toPtr(accountproto.AccountV2_Role_Environment_VIEWER),
 			setup: func(s *eventCounterService) {
-				s.eventStorage.(*dwhmock.MockEventStorage).EXPECT().QueryGoalCount(ctx, ns, correctStartAt, correctEndAt, gID, fID, fVersion).Return(
+				s.eventStorage.(*dwhmock.MockEventStorage).EXPECT().QueryGoalCount(
+					ctx, ns, correctStartAt, correctEndAt, gID, fID, fVersion, int32(0),
+				).Return(
 					[]*dwhdatabase.GoalEventCount{
 						{
 							VariationID:       vID1,
@@ -673,7 +675,9 @@ func TestGetExperimentGoalCount(t *testing.T) {
 		{
 			desc: "success: all variations",
 			setup: func(s *eventCounterService) {
-				s.eventStorage.(*dwhmock.MockEventStorage).EXPECT().QueryGoalCount(ctx, ns, correctStartAt, correctEndAt, gID, fID, fVersion).Return(
+				s.eventStorage.(*dwhmock.MockEventStorage).EXPECT().QueryGoalCount(
+					ctx, ns, correctStartAt, correctEndAt, gID, fID, fVersion, int32(0),
+				).Return(
 					[]*dwhdatabase.GoalEventCount{
 						{
 							VariationID:       vID1,
@@ -115,6 +115,7 @@ func (es *eventStorage) QueryGoalCount(
 	startAt, endAt time.Time,
 	goalID, featureID string,
 	featureVersion int32,
+	valueCapPercentile int32,
 ) ([]*dwhdatabase.GoalEventCount, error) {
 	datasource := fmt.Sprintf("%s.%s", es.dataset, dwhdatabase.DataTypeGoalEvent)
 	query := fmt.Sprintf(goalCountSQL, datasource)
@@ -127,7 +128,7 @@ func (es *eventStorage) QueryGoalCount(
 		{Name: "featureVersion", Value: featureVersion},
 		// Winsorization percentile (integer in [1,100]) for the APPROX_QUANTILES
 		// array offset (100 effectively disables capping).
-		{Name: "valueCapPercentile", Value: dwhdatabase.DefaultValueCapPercentile},
+		{Name: "valueCapPercentile", Value: dwhdatabase.ValueCapPercentile(valueCapPercentile)},
 	}
 	es.logger.Debug("query goal count",
 		zap.String("query", query),
@@ -13,5 +13,8 @@ WHERE
     AND environment_id = @environmentId
     AND feature_id = @featureID
     AND feature_version = @featureVersion
+    -- Users held out or outside the experiment's layer slice are served the baseline
+    -- without being part of the experiment, so they are left out of the results.
+    AND COALESCE(reason, '') NOT IN ('EXPERIMENT_HOLDOUT', 'EXPERIMENT_EXCLUDED')
 GROUP BY
     variation_id
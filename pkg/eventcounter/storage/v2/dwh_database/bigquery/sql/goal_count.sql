@@ -18,6 +18,9 @@ WITH deduped_events AS (
     AND goal_id = @goalID
     AND feature_id = @featureID
     AND feature_version = @featureVersion
+    -- Users held out or outside the experiment's layer slice are served the baseline
+    -- without being part of the experiment, so they are left out of the results.
+    AND COALESCE(reason, '') NOT IN ('EXPERIMENT_HOLDOUT', 'EXPERIMENT_EXCLUDED')
 ),
 grouped_by_user_evaluation AS (
     SELECT
@@ -65,7 +68,9 @@ SELECT
     SUM(event_count) as goalTotal,
     SUM(value_sum) as goalValueTotal,
     AVG(value_sum) as goalValueMean,
-    IFNULL(VAR_SAMP(value_sum), 0) as goalValueVariance
+    IFNULL(VAR_SAMP(value_sum), 0) as goalValueVariance,
+    IFNULL(VAR_SAMP(event_count), 0) as goalEventCountVariance,
+    IFNULL(COVAR_SAMP(value_sum, event_count), 0) as goalValueEventCovariance
 FROM
     capped_by_user
 GROUP BY
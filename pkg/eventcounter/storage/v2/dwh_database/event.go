@@ -32,10 +32,20 @@ const (
 // values are winsorized before the value-metric aggregation, to keep the
 // Normal value model robust to heavy tails (whales). It is bound into the goal
 // query as a parameter rather than hardcoded in the SQL so it has a single
-// source of truth and can later be sourced from config / per-experiment
-// settings. 100 effectively disables capping (cap = max).
+// source of truth; goals can override it via Goal.value_cap_percentile.
+// 100 effectively disables capping (cap = max).
 const DefaultValueCapPercentile = 99
 
+// ValueCapPercentile resolves the winsorization percentile for a goal query,
+// falling back to DefaultValueCapPercentile when the goal leaves it unset (0)
+// or holds a value outside [1,100].
+func ValueCapPercentile(percentile int32) int32 {
+	if percentile <= 0 || percentile > 100 {
+		return DefaultValueCapPercentile
+	}
+	return percentile
+}
+
 var (
 	ErrBQUnexpectedMultipleResults = pkgErr.NewErrorInternal(
 		pkgErr.EventCounterPackageName,
@@ -83,6 +93,7 @@ type EventStorage interface {
 		startAt, endAt time.Time,
 		goalID, featureID string,
 		featureVersion int32,
+		valueCapPercentile int32,
 	) ([]*GoalEventCount, error)
 	QueryUserEvaluation(
 		ctx context.Context,
@@ -105,6 +116,10 @@ type GoalEventCount struct {
 	GoalValueTotal    float64
 	GoalValueMean     float64
 	GoalValueVariance float64
+	// Per-user event count variance and value/event-count covariance, the
+	// extra sufficient statistics needed for ratio (value per event) metrics.
+	GoalEventCountVariance   float64
+	GoalValueEventCovariance float64
 }
 
 type UserEvaluation struct {
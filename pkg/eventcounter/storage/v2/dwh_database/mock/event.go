@@ -58,18 +58,18 @@ func (mr *MockEventStorageMockRecorder) QueryEvaluationCount(ctx, environmentId,
 }
 
 // QueryGoalCount mocks base method.
-func (m *MockEventStorage) QueryGoalCount(ctx context.Context, environmentId string, startAt, endAt time.Time, goalID, featureID string, featureVersion int32) ([]*dwhdatabase.GoalEventCount, error) {
+func (m *MockEventStorage) QueryGoalCount(ctx context.Context, environmentId string, startAt, endAt time.Time, goalID, featureID string, featureVersion, valueCapPercentile int32) ([]*dwhdatabase.GoalEventCount, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "QueryGoalCount", ctx, environmentId, startAt, endAt, goalID, featureID, featureVersion)
+	ret := m.ctrl.Call(m, "QueryGoalCount", ctx, environmentId, startAt, endAt, goalID, featureID, featureVersion, valueCapPercentile)
 	ret0, _ := ret[0].([]*dwhdatabase.GoalEventCount)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // QueryGoalCount indicates an expected call of QueryGoalCount.
-func (mr *MockEventStorageMockRecorder) QueryGoalCount(ctx, environmentId, startAt, endAt, goalID, featureID, featureVersion any) *gomock.Call {
+func (mr *MockEventStorageMockRecorder) QueryGoalCount(ctx, environmentId, startAt, endAt, goalID, featureID, featureVersion, valueCapPercentile any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryGoalCount", reflect.TypeOf((*MockEventStorage)(nil).QueryGoalCount), ctx, environmentId, startAt, endAt, goalID, featureID, featureVersion)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryGoalCount", reflect.TypeOf((*MockEventStorage)(nil).QueryGoalCount), ctx, environmentId, startAt, endAt, goalID, featureID, featureVersion, valueCapPercentile)
 }
 
 // QueryUserEvaluation mocks base method.
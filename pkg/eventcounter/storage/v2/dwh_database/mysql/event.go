@@ -115,6 +115,7 @@ func (es *eventStorage) QueryGoalCount(
 	startAt, endAt time.Time,
 	goalID, featureID string,
 	featureVersion int32,
+	valueCapPercentile int32,
 ) ([]*dwhdatabase.GoalEventCount, error) {
 	rows, err := es.qe.QueryContext(
 		ctx,
@@ -126,7 +127,7 @@ func (es *eventStorage) QueryGoalCount(
 		featureID,
 		featureVersion,
 		// Winsorization percentile (integer in [1,100]) for the NTILE cap.
-		dwhdatabase.DefaultValueCapPercentile,
+		dwhdatabase.ValueCapPercentile(valueCapPercentile),
 	)
 	if err != nil {
 		es.logger.Error(
@@ -155,6 +156,8 @@ func (es *eventStorage) QueryGoalCount(
 			&gc.GoalValueTotal,
 			&gc.GoalValueMean,
 			&gc.GoalValueVariance,
+			&gc.GoalEventCountVariance,
+			&gc.GoalValueEventCovariance,
 		); err != nil {
 			es.logger.Error(
 				"Failed to scan goal event count",
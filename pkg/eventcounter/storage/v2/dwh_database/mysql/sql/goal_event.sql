@@ -12,6 +12,9 @@ WITH grouped_by_user_evaluation AS (
         AND goal_id = ?
         AND feature_id = ?
         AND feature_version = ?
+        -- Users held out or outside the experiment's layer slice are served the baseline
+        -- without being part of the experiment, so they are left out of the results.
+        AND COALESCE(reason, '') NOT IN ('EXPERIMENT_HOLDOUT', 'EXPERIMENT_EXCLUDED')
     GROUP BY
         user_id,
         variation_id
@@ -47,7 +50,15 @@ SELECT
     SUM(event_count) as goalTotal,
     SUM(value_sum) as goalValueTotal,
     AVG(value_sum) as goalValueMean,
-    IFNULL(VAR_SAMP(value_sum), 0) as goalValueVariance
+    IFNULL(VAR_SAMP(value_sum), 0) as goalValueVariance,
+    IFNULL(VAR_SAMP(event_count), 0) as goalEventCountVariance,
+    -- MySQL has no COVAR_SAMP, so the sample covariance is derived from the
+    -- raw sums: (Σxy - ΣxΣy/n) / (n - 1). Each row is one user, so n = COUNT(*).
+    IFNULL(
+        (SUM(value_sum * event_count) - SUM(value_sum) * SUM(event_count) / COUNT(*))
+            / NULLIF(COUNT(*) - 1, 0),
+        0
+    ) as goalValueEventCovariance
 FROM
     capped_by_user
 GROUP BY
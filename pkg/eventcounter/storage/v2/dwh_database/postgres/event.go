@@ -118,6 +118,7 @@ func (es *eventStorage) QueryGoalCount(
 	goalID,
 	featureID string,
 	featureVersion int32,
+	valueCapPercentile int32,
 ) ([]*dwhdatabase.GoalEventCount, error) {
 	rows, err := es.qe.QueryContext(
 		ctx,
@@ -130,7 +131,7 @@ func (es *eventStorage) QueryGoalCount(
 		featureVersion,
 		// $7: winsorization percentile as a fraction in [0,1] for
 		// PERCENTILE_CONT.
-		float64(dwhdatabase.DefaultValueCapPercentile)/100.0,
+		float64(dwhdatabase.ValueCapPercentile(valueCapPercentile))/100.0,
 	)
 	if err != nil {
 		es.logger.Error(
@@ -159,6 +160,8 @@ func (es *eventStorage) QueryGoalCount(
 			&gc.GoalValueTotal,
 			&gc.GoalValueMean,
 			&gc.GoalValueVariance,
+			&gc.GoalEventCountVariance,
+			&gc.GoalValueEventCovariance,
 		); err != nil {
 			es.logger.Error(
 				"Failed to scan goal event count",
@@ -127,7 +127,8 @@ func TestPostgresEventStorageQueryGoalCount(t *testing.T) {
 				rows.EXPECT().Close().Return(nil)
 				rows.EXPECT().Next().Return(true)
 				rows.EXPECT().Scan(
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 				).DoAndReturn(
 					func(dest ...any) error {
 						*(dest[0].(*string)) = "vid1"
@@ -136,12 +137,15 @@ func TestPostgresEventStorageQueryGoalCount(t *testing.T) {
 						*(dest[3].(*float64)) = float64(3.5)
 						*(dest[4].(*float64)) = float64(1.75)
 						*(dest[5].(*float64)) = float64(0.25)
+						*(dest[6].(*float64)) = float64(0.5)
+						*(dest[7].(*float64)) = float64(0.125)
 						return nil
 					},
 				)
 				rows.EXPECT().Next().Return(true)
 				rows.EXPECT().Scan(
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 				).DoAndReturn(
 					func(dest ...any) error {
 						*(dest[0].(*string)) = "vid2"
@@ -150,6 +154,8 @@ func TestPostgresEventStorageQueryGoalCount(t *testing.T) {
 						*(dest[3].(*float64)) = float64(5.5)
 						*(dest[4].(*float64)) = float64(2.75)
 						*(dest[5].(*float64)) = float64(0.5)
+						*(dest[6].(*float64)) = float64(1)
+						*(dest[7].(*float64)) = float64(0.75)
 						return nil
 					},
 				)
@@ -161,20 +167,24 @@ func TestPostgresEventStorageQueryGoalCount(t *testing.T) {
 			},
 			expected: []*dwhdatabase.GoalEventCount{
 				{
-					VariationID:       "vid1",
-					GoalUser:          1,
-					GoalTotal:         2,
-					GoalValueTotal:    3.5,
-					GoalValueMean:     1.75,
-					GoalValueVariance: 0.25,
+					VariationID:              "vid1",
+					GoalUser:                 1,
+					GoalTotal:                2,
+					GoalValueTotal:           3.5,
+					GoalValueMean:            1.75,
+					GoalValueVariance:        0.25,
+					GoalEventCountVariance:   0.5,
+					GoalValueEventCovariance: 0.125,
 				},
 				{
-					VariationID:       "vid2",
-					GoalUser:          3,
-					GoalTotal:         4,
-					GoalValueTotal:    5.5,
-					GoalValueMean:     2.75,
-					GoalValueVariance: 0.5,
+					VariationID:              "vid2",
+					GoalUser:                 3,
+					GoalTotal:                4,
+					GoalValueTotal:           5.5,
+					GoalValueMean:            2.75,
+					GoalValueVariance:        0.5,
+					GoalEventCountVariance:   1,
+					GoalValueEventCovariance: 0.75,
 				},
 			},
 			expectedErr: nil,
@@ -199,7 +209,7 @@ func TestPostgresEventStorageQueryGoalCount(t *testing.T) {
 			if p.setup != nil {
 				p.setup(s)
 			}
-			actual, err := s.QueryGoalCount(ctx, "env", startAt, endAt, "gid", "fid", 1)
+			actual, err := s.QueryGoalCount(ctx, "env", startAt, endAt, "gid", "fid", 1, 0)
 			assert.Equal(t, p.expectedErr, err)
 			assert.Equal(t, p.expected, actual)
 		})
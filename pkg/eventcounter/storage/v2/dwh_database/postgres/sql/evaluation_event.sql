@@ -9,5 +9,8 @@ WHERE
     AND environment_id = $3
     AND feature_id = $4
     AND feature_version = $5
+    -- Users held out or outside the experiment's layer slice are served the baseline
+    -- without being part of the experiment, so they are left out of the results.
+    AND COALESCE(reason, '') NOT IN ('EXPERIMENT_HOLDOUT', 'EXPERIMENT_EXCLUDED')
 GROUP BY
     variation_id 
\ No newline at end of file
@@ -12,6 +12,9 @@ WITH grouped_by_user_evaluation AS (
         AND goal_id = $4
         AND feature_id = $5
         AND feature_version = $6
+        -- Users held out or outside the experiment's layer slice are served the baseline
+        -- without being part of the experiment, so they are left out of the results.
+        AND COALESCE(reason, '') NOT IN ('EXPERIMENT_HOLDOUT', 'EXPERIMENT_EXCLUDED')
     GROUP BY
         user_id,
         variation_id
@@ -44,7 +47,9 @@ SELECT
     SUM(event_count) as goalTotal,
     SUM(value_sum) as goalValueTotal,
     AVG(value_sum) as goalValueMean,
-    COALESCE(VAR_SAMP(value_sum), 0) as goalValueVariance
+    COALESCE(VAR_SAMP(value_sum), 0) as goalValueVariance,
+    COALESCE(VAR_SAMP(event_count::double precision), 0) as goalEventCountVariance,
+    COALESCE(COVAR_SAMP(value_sum, event_count), 0) as goalValueEventCovariance
 FROM
     capped_by_user
 GROUP BY
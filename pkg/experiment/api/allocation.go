@@ -0,0 +1,76 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+
+	pb "google.golang.org/protobuf/proto"
+
+	domainevent "github.com/bucketeer-io/bucketeer/v2/pkg/domainevent/domain"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/experiment/domain"
+	ftdomain "github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
+	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+// syncFeatureAllocation writes the experiment's layer slice and the holdout group to its flag,
+// so the evaluators can exclude users outside of the experiment.
+// The allocation is set while the experiment is running and cleared once it stops
+// or is deleted.
+// A flag holds a single allocation; stopping an experiment leaves the allocation
+// of another experiment on the same flag untouched.
+func (s *experimentService) syncFeatureAllocation(
+	ctx context.Context,
+	editor *eventproto.Editor,
+	experiment *domain.Experiment,
+	holdout *domain.ExperimentHoldout,
+	environmentId string,
+) error {
+	feature, err := s.ftStorage.GetFeature(ctx, experiment.FeatureId, environmentId)
+	if err != nil {
+		return err
+	}
+	var allocation *featureproto.ExperimentAllocation
+	if experiment.Status == proto.Experiment_RUNNING && !experiment.Deleted {
+		allocation = experiment.FeatureAllocation(holdout)
+	} else if feature.ExperimentAllocation.GetExperimentId() != experiment.Id {
+		return nil
+	}
+	if pb.Equal(feature.ExperimentAllocation, allocation) {
+		return nil
+	}
+	updated := &ftdomain.Feature{Feature: pb.Clone(feature.Feature).(*featureproto.Feature)}
+	updated.SetExperimentAllocation(allocation)
+	if err := s.ftStorage.UpdateFeature(ctx, updated, environmentId); err != nil {
+		return err
+	}
+	event, err := domainevent.NewEvent(
+		editor,
+		eventproto.Event_FEATURE,
+		feature.Id,
+		eventproto.Event_FEATURE_UPDATED,
+		&eventproto.FeatureUpdatedEvent{Id: feature.Id},
+		environmentId,
+		updated.Feature,
+		feature.Feature,
+		domainevent.WithComment("Experiment allocation updated"),
+	)
+	if err != nil {
+		return err
+	}
+	return s.publisher.Publish(ctx, event)
+}
@@ -25,6 +25,7 @@ import (
 	autoopsclient "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/client"
 	storage "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/storage/v2"
 	featureclient "github.com/bucketeer-io/bucketeer/v2/pkg/feature/client"
+	ftstorage "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/rpc"
@@ -53,6 +54,9 @@ type experimentService struct {
 	dbClient          database.Client
 	experimentStorage storage.ExperimentStorage
 	goalStorage       storage.GoalStorage
+	layerStorage      storage.ExperimentLayerStorage
+	holdoutStorage    storage.ExperimentHoldoutStorage
+	ftStorage         ftstorage.FeatureStorage
 	publisher         publisher.Publisher
 	opts              *options
 	logger            *zap.Logger
@@ -65,6 +69,9 @@ func NewExperimentService(
 	dbClient database.Client,
 	experimentStorage storage.ExperimentStorage,
 	goalStorage storage.GoalStorage,
+	layerStorage storage.ExperimentLayerStorage,
+	holdoutStorage storage.ExperimentHoldoutStorage,
+	ftStorage ftstorage.FeatureStorage,
 	publisher publisher.Publisher,
 	opts ...Option,
 ) rpc.Service {
@@ -81,6 +88,9 @@ func NewExperimentService(
 		dbClient:          dbClient,
 		experimentStorage: experimentStorage,
 		goalStorage:       goalStorage,
+		layerStorage:      layerStorage,
+		holdoutStorage:    holdoutStorage,
+		ftStorage:         ftStorage,
 		publisher:         publisher,
 		opts:              dopts,
 		logger:            dopts.logger.Named("api"),
@@ -91,28 +101,24 @@ func (s *experimentService) Register(server *grpc.Server) {
 	proto.RegisterExperimentServiceServer(server, s)
 }
 
-func (s *experimentService) checkEnvironmentRole(
+func (s *experimentService) checkPermission(
 	ctx context.Context,
-	requiredRole accountproto.AccountV2_Role_Environment,
 	environmentId string,
+	permissions ...role.Permission,
 ) (*eventproto.Editor, error) {
-	return role.CheckEnvironmentRoleWithLog(
+	return role.CheckPermissionWithLog(
 		ctx,
-		requiredRole,
 		environmentId,
-		func(email string) (*accountproto.AccountV2, error) {
-			resp, err := s.accountClient.GetAccountV2ByEnvironmentID(ctx, &accountproto.GetAccountV2ByEnvironmentIDRequest{
+		func(email string) (*accountproto.GetAccountV2ByEnvironmentIDResponse, error) {
+			return s.accountClient.GetAccountV2ByEnvironmentID(ctx, &accountproto.GetAccountV2ByEnvironmentIDRequest{
 				Email:         email,
 				EnvironmentId: environmentId,
 			})
-			if err != nil {
-				return nil, err
-			}
-			return resp.Account, nil
 		},
 		s.logger,
 		statusUnauthenticated.Err(),
 		statusPermissionDenied.Err(),
 		func(err error) error { return api.NewGRPCStatus(err).Err() },
+		permissions...,
 	)
 }
@@ -28,6 +28,7 @@ import (
 	autoopsclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/client/mock"
 	storagemock "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/storage/v2/mock"
 	featureclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/feature/client/mock"
+	ftstoragemock "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2/mock"
 	publishermock "github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher/mock"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/rpc"
 	dbmock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database/mock"
@@ -46,6 +47,9 @@ func TestNewExperimentService(t *testing.T) {
 	dbClientMock := dbmock.NewMockClient(mockController)
 	experimentStorageMock := storagemock.NewMockExperimentStorage(mockController)
 	goalStorageMock := storagemock.NewMockGoalStorage(mockController)
+	layerStorageMock := storagemock.NewMockExperimentLayerStorage(mockController)
+	holdoutStorageMock := storagemock.NewMockExperimentHoldoutStorage(mockController)
+	ftStorageMock := ftstoragemock.NewMockFeatureStorage(mockController)
 	p := publishermock.NewMockPublisher(mockController)
 	logger := zap.NewNop()
 	s := NewExperimentService(
@@ -55,6 +59,9 @@ func TestNewExperimentService(t *testing.T) {
 		dbClientMock,
 		experimentStorageMock,
 		goalStorageMock,
+		layerStorageMock,
+		holdoutStorageMock,
+		ftStorageMock,
 		p,
 		WithLogger(logger),
 	)
@@ -137,6 +144,9 @@ func createExperimentService(c *gomock.Controller, specifiedEnvironmentId *strin
 		dbClient:          dbClientMock,
 		experimentStorage: storagemock.NewMockExperimentStorage(c),
 		goalStorage:       storagemock.NewMockGoalStorage(c),
+		layerStorage:      storagemock.NewMockExperimentLayerStorage(c),
+		holdoutStorage:    storagemock.NewMockExperimentHoldoutStorage(c),
+		ftStorage:         ftstoragemock.NewMockFeatureStorage(c),
 		publisher:         p,
 		logger:            zap.NewNop().Named("api"),
 	}
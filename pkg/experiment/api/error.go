@@ -17,6 +17,7 @@ package api
 import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
 	pkgErr "github.com/bucketeer-io/bucketeer/v2/pkg/error"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/experiment/domain"
 )
 
 var (
@@ -36,6 +37,18 @@ var (
 		pkgErr.NewErrorInvalidArgNotMatchFormat(pkgErr.ExperimentPackageName, "invalid goal id", "Goal"))
 	statusGoalNameRequired = api.NewGRPCStatus(
 		pkgErr.NewErrorInvalidArgEmpty(pkgErr.ExperimentPackageName, "goal name must be specified", "Goal"))
+	statusUnknownGoalMetricType = api.NewGRPCStatus(
+		pkgErr.NewErrorInvalidArgUnknown(pkgErr.ExperimentPackageName, "unknown goal metric type", "Goal"))
+	statusUnknownGoalImprovementDirection = api.NewGRPCStatus(
+		pkgErr.NewErrorInvalidArgUnknown(pkgErr.ExperimentPackageName, "unknown goal improvement direction", "Goal"))
+	statusGoalValueCapPercentileOutOfRange = api.NewGRPCStatus(
+		pkgErr.NewErrorOutOfRange(
+			pkgErr.ExperimentPackageName,
+			"goal value cap percentile out of range",
+			"Goal",
+			0,
+			maxGoalValueCapPercentile,
+		))
 	statusExperimentPeriodOutOfRange = api.NewGRPCStatus(
 		pkgErr.NewErrorOutOfRange(
 			pkgErr.ExperimentPackageName,
@@ -54,6 +67,23 @@ var (
 		pkgErr.NewErrorNotFound(pkgErr.ExperimentPackageName, "goal not found", "Goal"))
 	statusFeatureNotFound = api.NewGRPCStatus(
 		pkgErr.NewErrorNotFound(pkgErr.ExperimentPackageName, "feature not found", "FeatureFlag"))
+	statusExperimentLayerIDRequired = api.NewGRPCStatus(
+		pkgErr.NewErrorInvalidArgEmpty(pkgErr.ExperimentPackageName, "layer id must be specified", "ExperimentLayer"))
+	statusExperimentLayerNameRequired = api.NewGRPCStatus(
+		pkgErr.NewErrorInvalidArgEmpty(pkgErr.ExperimentPackageName, "layer name must be specified", "ExperimentLayer"))
+	statusExperimentLayerNotFound = api.NewGRPCStatus(
+		pkgErr.NewErrorNotFound(pkgErr.ExperimentPackageName, "experiment layer not found", "ExperimentLayer"))
+	statusExperimentLayerInUse = api.NewGRPCStatus(
+		pkgErr.NewErrorFailedPrecondition(
+			pkgErr.ExperimentPackageName,
+			"experiment layer is used by a waiting or running experiment",
+		))
+	statusLayerSliceOutOfRange = api.NewGRPCStatus(
+		domain.ErrLayerSliceOutOfRange)
+	statusLayerSliceAlreadyClaimed = api.NewGRPCStatus(
+		domain.ErrLayerSliceAlreadyClaimed)
+	statusHoldoutWeightOutOfRange = api.NewGRPCStatus(
+		domain.ErrHoldoutWeightOutOfRange)
 	statusAlreadyExists = api.NewGRPCStatus(
 		pkgErr.NewErrorAlreadyExists(pkgErr.ExperimentPackageName, "already exists"))
 	statusUnauthenticated = api.NewGRPCStatus(
@@ -25,10 +25,12 @@ import (
 	pb "google.golang.org/protobuf/proto"
 
 	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
+	autoopsdomain "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/domain"
 	domainevent "github.com/bucketeer-io/bucketeer/v2/pkg/domainevent/domain"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/experiment/domain"
 	v2es "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/storage/v2"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
 	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
 	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
 	proto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
@@ -44,9 +46,11 @@ func (s *experimentService) GetExperiment(
 	ctx context.Context,
 	req *proto.GetExperimentRequest,
 ) (*proto.GetExperimentResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_EXPERIMENT,
+		Action:       accountproto.Permission_READ,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -76,9 +80,10 @@ func (s *experimentService) ListExperiments(
 	ctx context.Context,
 	req *proto.ListExperimentsRequest,
 ) (*proto.ListExperimentsResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_EXPERIMENT,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -150,9 +155,10 @@ func (s *experimentService) CreateExperiment(
 	ctx context.Context,
 	req *proto.CreateExperimentRequest,
 ) (*proto.CreateExperimentResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_EXPERIMENT,
+		Action:       accountproto.Permission_CREATE,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -188,6 +194,7 @@ func (s *experimentService) CreateExperiment(
 		req.Description,
 		req.BaseVariationId,
 		editor.Email,
+		req.GuardrailGoals,
 	)
 	if err != nil {
 		s.logger.Error(
@@ -202,8 +209,23 @@ func (s *experimentService) CreateExperiment(
 		)
 		return nil, api.NewGRPCStatus(err).Err()
 	}
+	if req.LayerSlice != nil {
+		if err := experiment.SetLayerSlice(req.LayerSlice); err != nil {
+			if errors.Is(err, domain.ErrLayerSliceLayerIDRequired) {
+				return nil, statusExperimentLayerIDRequired.Err()
+			}
+			return nil, statusLayerSliceOutOfRange.Err()
+		}
+	}
 	err = s.dbClient.RunInTransactionV2(ctx, func(ctxWithTx context.Context) error {
-		for _, gid := range req.GoalIds {
+		if err := s.validateLayerSlice(ctxWithTx, experiment, req.EnvironmentId); err != nil {
+			return err
+		}
+		goalIDs := append([]string{}, req.GoalIds...)
+		for _, g := range req.GuardrailGoals {
+			goalIDs = append(goalIDs, g.GoalId)
+		}
+		for _, gid := range goalIDs {
 			goal, err := s.getGoalMySQL(ctxWithTx, gid, req.EnvironmentId)
 			if err != nil {
 				return err
@@ -231,6 +253,7 @@ func (s *experimentService) CreateExperiment(
 				Name:            experiment.Name,
 				Description:     experiment.Description,
 				BaseVariationId: experiment.BaseVariationId,
+				GuardrailGoals:  experiment.GuardrailGoals,
 			},
 			req.EnvironmentId,
 			experiment.Experiment,
@@ -255,6 +278,12 @@ func (s *experimentService) CreateExperiment(
 		if errors.Is(err, v2es.ErrExperimentAlreadyExists) {
 			return nil, statusAlreadyExists.Err()
 		}
+		if errors.Is(err, v2es.ErrExperimentLayerNotFound) {
+			return nil, statusExperimentLayerNotFound.Err()
+		}
+		if errors.Is(err, domain.ErrLayerSliceAlreadyClaimed) {
+			return nil, statusLayerSliceAlreadyClaimed.Err()
+		}
 		s.logger.Error(
 			"Failed to create experiment",
 			log.FieldsFromIncomingContext(ctx).AddFields(
@@ -289,6 +318,9 @@ func validateCreateExperimentRequest(
 	if req.Name == "" {
 		return statusExperimentNameRequired.Err()
 	}
+	if err := autoopsdomain.ValidateGuardrailGoals(req.GuardrailGoals); err != nil {
+		return api.NewGRPCStatus(err).Err()
+	}
 	return nil
 }
 
@@ -304,9 +336,11 @@ func (s *experimentService) UpdateExperiment(
 	ctx context.Context,
 	req *proto.UpdateExperimentRequest,
 ) (*proto.UpdateExperimentResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_EXPERIMENT,
+		Action:       accountproto.Permission_UPDATE,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -375,7 +409,17 @@ func (s *experimentService) UpdateExperiment(
 			return err
 		}
 		experimentPb = updated.Experiment
-		return s.experimentStorage.UpdateExperiment(ctxWithTx, updated, req.EnvironmentId)
+		if err := s.experimentStorage.UpdateExperiment(ctxWithTx, updated, req.EnvironmentId); err != nil {
+			return err
+		}
+		if updated.Status == experiment.Status {
+			return nil
+		}
+		holdout, err := s.getExperimentHoldout(ctxWithTx, req.EnvironmentId)
+		if err != nil {
+			return err
+		}
+		return s.syncFeatureAllocation(ctxWithTx, editor, updated, holdout, req.EnvironmentId)
 	})
 	if err != nil {
 		if errors.Is(err, v2es.ErrExperimentNotFound) || errors.Is(err, v2es.ErrExperimentUnexpectedAffectedRows) {
@@ -423,9 +467,11 @@ func (s *experimentService) DeleteExperiment(
 	ctx context.Context,
 	req *proto.DeleteExperimentRequest,
 ) (*proto.DeleteExperimentResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_EXPERIMENT,
+		Action:       accountproto.Permission_DELETE,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -462,7 +508,14 @@ func (s *experimentService) DeleteExperiment(
 			return err
 		}
 
-		return s.experimentStorage.UpdateExperiment(ctxWithTx, experiment, req.EnvironmentId)
+		if err := s.experimentStorage.UpdateExperiment(ctxWithTx, experiment, req.EnvironmentId); err != nil {
+			return err
+		}
+		if experiment.Status != proto.Experiment_RUNNING {
+			return nil
+		}
+		// Otherwise the flag would keep excluding users for an experiment that no longer exists.
+		return s.syncFeatureAllocation(ctxWithTx, editor, experiment, nil, req.EnvironmentId)
 	})
 	if err != nil {
 		s.logger.Error(
@@ -22,14 +22,18 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/metadata"
+	pb "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/bucketeer-io/bucketeer/v2/pkg/experiment/domain"
 	v2es "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/storage/v2"
 	storagemock "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/storage/v2/mock"
+	ftdomain "github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
+	ftstoragemock "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2/mock"
 	dbmock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database/mock"
 	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
 	experimentproto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
 )
 
 func TestGetExperimentMySQL(t *testing.T) {
@@ -202,6 +206,91 @@ func TestCreateExperimentMySQL(t *testing.T) {
 			},
 			expectedErr: statusExperimentPeriodOutOfRange.Err(),
 		},
+		{
+			desc: "invalid layer slice",
+			input: &experimentproto.CreateExperimentRequest{
+				FeatureId:       "fid",
+				GoalIds:         []string{"goalId"},
+				Name:            "exp0",
+				StartAt:         1,
+				StopAt:          10,
+				EnvironmentId:   "ns0",
+				BaseVariationId: "variation-b-id",
+				LayerSlice: &experimentproto.Experiment_LayerSlice{
+					LayerId:      "layer-id",
+					TrafficStart: 50000,
+					TrafficEnd:   50000,
+				},
+			},
+			expectedErr: statusLayerSliceOutOfRange.Err(),
+		},
+		{
+			desc: "layer not found",
+			setup: func(s *experimentService) {
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				s.layerStorage.(*storagemock.MockExperimentLayerStorage).EXPECT().GetExperimentLayer(
+					gomock.Any(), "layer-id", "ns0",
+				).Return(nil, v2es.ErrExperimentLayerNotFound)
+			},
+			input: &experimentproto.CreateExperimentRequest{
+				FeatureId:       "fid",
+				GoalIds:         []string{"goalId"},
+				Name:            "exp0",
+				StartAt:         1,
+				StopAt:          10,
+				EnvironmentId:   "ns0",
+				BaseVariationId: "variation-b-id",
+				LayerSlice: &experimentproto.Experiment_LayerSlice{
+					LayerId:      "layer-id",
+					TrafficStart: 0,
+					TrafficEnd:   50000,
+				},
+			},
+			expectedErr: statusExperimentLayerNotFound.Err(),
+		},
+		{
+			desc: "layer slice already claimed",
+			setup: func(s *experimentService) {
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				s.layerStorage.(*storagemock.MockExperimentLayerStorage).EXPECT().GetExperimentLayer(
+					gomock.Any(), "layer-id", "ns0",
+				).Return(&domain.ExperimentLayer{ExperimentLayer: &experimentproto.ExperimentLayer{Id: "layer-id"}}, nil)
+				s.experimentStorage.(*storagemock.MockExperimentStorage).EXPECT().ListExperiments(
+					gomock.Any(), gomock.Any(),
+				).Return([]*experimentproto.Experiment{{
+					Id:     "running-id",
+					Status: experimentproto.Experiment_RUNNING,
+					LayerSlice: &experimentproto.Experiment_LayerSlice{
+						LayerId:      "layer-id",
+						TrafficStart: 40000,
+						TrafficEnd:   100000,
+					},
+				}}, 0, int64(1), nil)
+			},
+			input: &experimentproto.CreateExperimentRequest{
+				FeatureId:       "fid",
+				GoalIds:         []string{"goalId"},
+				Name:            "exp0",
+				StartAt:         1,
+				StopAt:          10,
+				EnvironmentId:   "ns0",
+				BaseVariationId: "variation-b-id",
+				LayerSlice: &experimentproto.Experiment_LayerSlice{
+					LayerId:      "layer-id",
+					TrafficStart: 0,
+					TrafficEnd:   50000,
+				},
+			},
+			expectedErr: statusLayerSliceAlreadyClaimed.Err(),
+		},
 		{
 			desc: "success",
 			setup: func(s *experimentService) {
@@ -323,6 +412,113 @@ func TestUpdateExperimentMySQL(t *testing.T) {
 	}
 }
 
+func TestUpdateExperimentStatusSyncsFeatureAllocation(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := createContextWithTokenAndMetadata(metadata.MD{
+		"accept-language": []string{"ja"},
+	})
+	layerSlice := &experimentproto.Experiment_LayerSlice{
+		LayerId:      "layer-id",
+		TrafficStart: 0,
+		TrafficEnd:   50000,
+	}
+
+	patterns := []struct {
+		desc               string
+		status             experimentproto.Experiment_Status
+		current            experimentproto.Experiment_Status
+		featureAllocation  *featureproto.ExperimentAllocation
+		expectedAllocation *featureproto.ExperimentAllocation
+		expectUpdate       bool
+	}{
+		{
+			desc:              "start: set allocation",
+			current:           experimentproto.Experiment_WAITING,
+			status:            experimentproto.Experiment_RUNNING,
+			featureAllocation: nil,
+			expectedAllocation: &featureproto.ExperimentAllocation{
+				ExperimentId:        "id-1",
+				LayerId:             "layer-id",
+				TrafficStart:        0,
+				TrafficEnd:          50000,
+				BaselineVariationId: "variation-a-id",
+				HoldoutId:           "holdout-id",
+				HoldoutWeight:       1000,
+			},
+			expectUpdate: true,
+		},
+		{
+			desc:               "stop: clear allocation",
+			current:            experimentproto.Experiment_RUNNING,
+			status:             experimentproto.Experiment_FORCE_STOPPED,
+			featureAllocation:  &featureproto.ExperimentAllocation{ExperimentId: "id-1"},
+			expectedAllocation: nil,
+			expectUpdate:       true,
+		},
+		{
+			desc:              "stop: keep allocation of another experiment",
+			current:           experimentproto.Experiment_RUNNING,
+			status:            experimentproto.Experiment_FORCE_STOPPED,
+			featureAllocation: &featureproto.ExperimentAllocation{ExperimentId: "id-2"},
+			expectUpdate:      false,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			s := createExperimentService(mockController, nil, nil, nil)
+			s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+				gomock.Any(), gomock.Any(),
+			).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				return fn(ctx)
+			})
+			s.experimentStorage.(*storagemock.MockExperimentStorage).EXPECT().GetExperiment(
+				gomock.Any(), "id-1", "ns0",
+			).Return(&domain.Experiment{Experiment: &experimentproto.Experiment{
+				Id:              "id-1",
+				FeatureId:       "fid",
+				FeatureVersion:  3,
+				BaseVariationId: "variation-a-id",
+				Status:          p.current,
+				LayerSlice:      layerSlice,
+			}}, nil)
+			s.experimentStorage.(*storagemock.MockExperimentStorage).EXPECT().UpdateExperiment(
+				gomock.Any(), gomock.Any(), "ns0",
+			).Return(nil)
+			s.holdoutStorage.(*storagemock.MockExperimentHoldoutStorage).EXPECT().GetExperimentHoldout(
+				gomock.Any(), "ns0",
+			).Return(&domain.ExperimentHoldout{ExperimentHoldout: &experimentproto.ExperimentHoldout{
+				Id:     "holdout-id",
+				Weight: 1000,
+			}}, nil)
+			s.ftStorage.(*ftstoragemock.MockFeatureStorage).EXPECT().GetFeature(
+				gomock.Any(), "fid", "ns0",
+			).Return(&ftdomain.Feature{Feature: &featureproto.Feature{
+				Id:                   "fid",
+				Version:              4,
+				ExperimentAllocation: p.featureAllocation,
+			}}, nil)
+			if p.expectUpdate {
+				s.ftStorage.(*ftstoragemock.MockFeatureStorage).EXPECT().UpdateFeature(
+					gomock.Any(), gomock.Any(), "ns0",
+				).DoAndReturn(func(_ context.Context, f *ftdomain.Feature, _ string) error {
+					assert.True(t, pb.Equal(p.expectedAllocation, f.ExperimentAllocation))
+					assert.Equal(t, int32(4), f.Version)
+					return nil
+				})
+			}
+			_, err := s.UpdateExperiment(ctx, &experimentproto.UpdateExperimentRequest{
+				Id:            "id-1",
+				EnvironmentId: "ns0",
+				Status:        &experimentproto.UpdateExperimentRequest_UpdatedStatus{Status: p.status},
+			})
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestDeleteExperimentMySQL(t *testing.T) {
 	t.Parallel()
 	mockController := gomock.NewController(t)
@@ -381,6 +577,41 @@ func TestDeleteExperimentMySQL(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			name: "success: running experiment clears the feature allocation",
+			setup: func(s *experimentService) {
+				s.experimentStorage.(*storagemock.MockExperimentStorage).EXPECT().GetExperiment(gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.Experiment{
+					Experiment: &experimentproto.Experiment{
+						Id:        "id-1",
+						FeatureId: "fid",
+						Status:    experimentproto.Experiment_RUNNING,
+					},
+				}, nil)
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				s.experimentStorage.(*storagemock.MockExperimentStorage).EXPECT().UpdateExperiment(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				s.ftStorage.(*ftstoragemock.MockFeatureStorage).EXPECT().GetFeature(
+					gomock.Any(), "fid", "ns0",
+				).Return(&ftdomain.Feature{Feature: &featureproto.Feature{
+					Id:                   "fid",
+					ExperimentAllocation: &featureproto.ExperimentAllocation{ExperimentId: "id-1"},
+				}}, nil)
+				s.ftStorage.(*ftstoragemock.MockFeatureStorage).EXPECT().UpdateFeature(
+					gomock.Any(), gomock.Any(), "ns0",
+				).DoAndReturn(func(_ context.Context, f *ftdomain.Feature, _ string) error {
+					assert.Nil(t, f.ExperimentAllocation)
+					return nil
+				})
+			},
+			req: &experimentproto.DeleteExperimentRequest{
+				Id:            "id-1",
+				EnvironmentId: "ns0",
+			},
+			expectedErr: nil,
+		},
 	}
 	for _, p := range patterns {
 		t.Run(p.name, func(t *testing.T) {
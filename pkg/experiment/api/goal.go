@@ -22,12 +22,14 @@ import (
 
 	"go.uber.org/zap"
 	pb "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
 	domainevent "github.com/bucketeer-io/bucketeer/v2/pkg/domainevent/domain"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/experiment/domain"
 	v2es "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/storage/v2"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
 	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
 	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
 	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
@@ -36,10 +38,16 @@ import (
 
 var goalIDRegex = regexp.MustCompile("^[a-zA-Z0-9-]+$")
 
+// maxGoalValueCapPercentile is the highest accepted winsorization percentile.
+// 100 caps at the maximum per-user value, which disables capping.
+const maxGoalValueCapPercentile = 100
+
 func (s *experimentService) GetGoal(ctx context.Context, req *proto.GetGoalRequest) (*proto.GetGoalResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_GOAL,
+		Action:       accountproto.Permission_READ,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -83,9 +91,10 @@ func (s *experimentService) ListGoals(
 	ctx context.Context,
 	req *proto.ListGoalsRequest,
 ) (*proto.ListGoalsResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_GOAL,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -179,16 +188,25 @@ func (s *experimentService) CreateGoal(
 	ctx context.Context,
 	req *proto.CreateGoalRequest,
 ) (*proto.CreateGoalResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_GOAL,
+		Action:       accountproto.Permission_CREATE,
+	})
 	if err != nil {
 		return nil, err
 	}
 	if err = validateCreateGoalRequest(req); err != nil {
 		return nil, err
 	}
-	goal, err := domain.NewGoal(req.Id, req.Name, req.Description, req.ConnectionType)
+	goal, err := domain.NewGoal(
+		req.Id,
+		req.Name,
+		req.Description,
+		req.ConnectionType,
+		req.MetricType,
+		req.ImprovementDirection,
+		req.ValueCapPercentile,
+	)
 	if err != nil {
 		s.logger.Error(
 			"Failed to create a new goal",
@@ -206,13 +224,16 @@ func (s *experimentService) CreateGoal(
 			goal.Id,
 			eventproto.Event_GOAL_CREATED,
 			&eventproto.GoalCreatedEvent{
-				Id:             goal.Id,
-				Name:           goal.Name,
-				Description:    goal.Description,
-				ConnectionType: goal.ConnectionType,
-				Deleted:        goal.Deleted,
-				CreatedAt:      goal.CreatedAt,
-				UpdatedAt:      goal.UpdatedAt,
+				Id:                   goal.Id,
+				Name:                 goal.Name,
+				Description:          goal.Description,
+				ConnectionType:       goal.ConnectionType,
+				MetricType:           goal.MetricType,
+				ImprovementDirection: goal.ImprovementDirection,
+				ValueCapPercentile:   goal.ValueCapPercentile,
+				Deleted:              goal.Deleted,
+				CreatedAt:            goal.CreatedAt,
+				UpdatedAt:            goal.UpdatedAt,
 			},
 			req.EnvironmentId,
 			goal.Goal,
@@ -254,6 +275,31 @@ func validateCreateGoalRequest(req *proto.CreateGoalRequest) error {
 	if req.Name == "" {
 		return statusGoalNameRequired.Err()
 	}
+	return validateGoalMetricSettings(&req.MetricType, &req.ImprovementDirection, wrapperspb.Int32(req.ValueCapPercentile))
+}
+
+// validateGoalMetricSettings checks the optional metric analysis settings.
+// A nil argument means the setting is left unchanged.
+func validateGoalMetricSettings(
+	metricType *proto.Goal_MetricType,
+	improvementDirection *proto.Goal_ImprovementDirection,
+	valueCapPercentile *wrapperspb.Int32Value,
+) error {
+	if metricType != nil {
+		if _, ok := proto.Goal_MetricType_name[int32(*metricType)]; !ok {
+			return statusUnknownGoalMetricType.Err()
+		}
+	}
+	if improvementDirection != nil {
+		if _, ok := proto.Goal_ImprovementDirection_name[int32(*improvementDirection)]; !ok {
+			return statusUnknownGoalImprovementDirection.Err()
+		}
+	}
+	if valueCapPercentile != nil {
+		if valueCapPercentile.Value < 0 || valueCapPercentile.Value > maxGoalValueCapPercentile {
+			return statusGoalValueCapPercentileOutOfRange.Err()
+		}
+	}
 	return nil
 }
 
@@ -261,9 +307,11 @@ func (s *experimentService) UpdateGoal(
 	ctx context.Context,
 	req *proto.UpdateGoalRequest,
 ) (*proto.UpdateGoalResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_GOAL,
+		Action:       accountproto.Permission_UPDATE,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -281,6 +329,9 @@ func (s *experimentService) UpdateGoal(
 			req.Name,
 			req.Description,
 			req.Archived,
+			req.MetricType,
+			req.ImprovementDirection,
+			req.ValueCapPercentile,
 		)
 		if err != nil {
 			return err
@@ -292,9 +343,12 @@ func (s *experimentService) UpdateGoal(
 			event = &eventproto.GoalArchivedEvent{Id: goal.Id}
 		} else {
 			event = &eventproto.GoalUpdatedEvent{
-				Id:          goal.Id,
-				Name:        req.Name,
-				Description: req.Description,
+				Id:                   goal.Id,
+				Name:                 req.Name,
+				Description:          req.Description,
+				MetricType:           req.MetricType,
+				ImprovementDirection: req.ImprovementDirection,
+				ValueCapPercentile:   req.ValueCapPercentile,
 			}
 		}
 		e, err := domainevent.NewEvent(
@@ -335,16 +389,18 @@ func (s *experimentService) validateUpdateGoalRequest(
 	if req.Name != nil && req.Name.Value == "" {
 		return statusGoalNameRequired.Err()
 	}
-	return nil
+	return validateGoalMetricSettings(req.MetricType, req.ImprovementDirection, req.ValueCapPercentile)
 }
 
 func (s *experimentService) DeleteGoal(
 	ctx context.Context,
 	req *proto.DeleteGoalRequest,
 ) (*proto.DeleteGoalResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_GOAL,
+		Action:       accountproto.Permission_DELETE,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +214,39 @@ func TestCreateGoalMySQL(t *testing.T) {
 			},
 			expectedErr: statusGoalNameRequired.Err(),
 		},
+		{
+			desc:  "error: unknown metric type",
+			setup: nil,
+			req: &experimentproto.CreateGoalRequest{
+				EnvironmentId: "ns0",
+				Id:            "Bucketeer-id-2019",
+				Name:          "name-0",
+				MetricType:    experimentproto.Goal_MetricType(99),
+			},
+			expectedErr: statusUnknownGoalMetricType.Err(),
+		},
+		{
+			desc:  "error: unknown improvement direction",
+			setup: nil,
+			req: &experimentproto.CreateGoalRequest{
+				EnvironmentId:        "ns0",
+				Id:                   "Bucketeer-id-2019",
+				Name:                 "name-0",
+				ImprovementDirection: experimentproto.Goal_ImprovementDirection(99),
+			},
+			expectedErr: statusUnknownGoalImprovementDirection.Err(),
+		},
+		{
+			desc:  "error: value cap percentile out of range",
+			setup: nil,
+			req: &experimentproto.CreateGoalRequest{
+				EnvironmentId:      "ns0",
+				Id:                 "Bucketeer-id-2019",
+				Name:               "name-0",
+				ValueCapPercentile: 101,
+			},
+			expectedErr: statusGoalValueCapPercentileOutOfRange.Err(),
+		},
 		{
 			desc: "error: ErrGoalAlreadyExists",
 			setup: func(s *experimentService) {
@@ -247,6 +280,28 @@ func TestCreateGoalMySQL(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			desc: "success: value metric",
+			setup: func(s *experimentService) {
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).Do(func(ctx context.Context, fn func(ctx context.Context) error) {
+					_ = fn(ctx)
+				}).Return(nil)
+				s.goalStorage.(*storagemock.MockGoalStorage).EXPECT().CreateGoal(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil)
+			},
+			req: &experimentproto.CreateGoalRequest{
+				Id:                   "Bucketeer-id-2021",
+				Name:                 "load-time",
+				EnvironmentId:        "ns0",
+				MetricType:           experimentproto.Goal_MEAN,
+				ImprovementDirection: experimentproto.Goal_LOWER_IS_BETTER,
+				ValueCapPercentile:   95,
+			},
+			expectedErr: nil,
+		},
 	}
 	for _, p := range patterns {
 		service := createExperimentService(mockController, nil, nil, nil)
@@ -291,6 +346,26 @@ func TestUpdateGoalMySQL(t *testing.T) {
 			},
 			expectedErr: statusGoalNameRequired.Err(),
 		},
+		{
+			desc:  "error: value cap percentile out of range",
+			setup: nil,
+			req: &experimentproto.UpdateGoalRequest{
+				Id:                 "id-0",
+				ValueCapPercentile: wrapperspb.Int32(-1),
+				EnvironmentId:      "ns0",
+			},
+			expectedErr: statusGoalValueCapPercentileOutOfRange.Err(),
+		},
+		{
+			desc:  "error: unknown metric type",
+			setup: nil,
+			req: &experimentproto.UpdateGoalRequest{
+				Id:            "id-0",
+				MetricType:    experimentproto.Goal_MetricType(99).Enum(),
+				EnvironmentId: "ns0",
+			},
+			expectedErr: statusUnknownGoalMetricType.Err(),
+		},
 		{
 			desc: "error: not found",
 			setup: func(s *experimentService) {
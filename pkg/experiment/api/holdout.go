@@ -0,0 +1,143 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/experiment/domain"
+	v2es "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+)
+
+func (s *experimentService) GetExperimentHoldout(
+	ctx context.Context,
+	req *proto.GetExperimentHoldoutRequest,
+) (*proto.GetExperimentHoldoutResponse, error) {
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_EXPERIMENT,
+		Action:       accountproto.Permission_READ,
+	})
+	if err != nil {
+		return nil, err
+	}
+	holdout, err := s.getExperimentHoldout(ctx, req.EnvironmentId)
+	if err != nil {
+		s.logger.Error(
+			"Failed to get experiment holdout",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	if holdout == nil {
+		// The environment has never configured a holdout group.
+		return &proto.GetExperimentHoldoutResponse{
+			Holdout: &proto.ExperimentHoldout{},
+		}, nil
+	}
+	return &proto.GetExperimentHoldoutResponse{
+		Holdout: holdout.ExperimentHoldout,
+	}, nil
+}
+
+// UpdateExperimentHoldout changes the environment's holdout group and
+// refreshes the allocation of the flags of every running experiment.
+func (s *experimentService) UpdateExperimentHoldout(
+	ctx context.Context,
+	req *proto.UpdateExperimentHoldoutRequest,
+) (*proto.UpdateExperimentHoldoutResponse, error) {
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_EXPERIMENT,
+		Action:       accountproto.Permission_UPDATE,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var holdoutPb *proto.ExperimentHoldout
+	err = s.dbClient.RunInTransactionV2(ctx, func(ctxWithTx context.Context) error {
+		holdout, err := s.getExperimentHoldout(ctxWithTx, req.EnvironmentId)
+		if err != nil {
+			return err
+		}
+		if holdout == nil {
+			holdout, err = domain.NewExperimentHoldout()
+			if err != nil {
+				return err
+			}
+		}
+		if err := holdout.Update(req.Weight, req.Reshuffle.GetValue()); err != nil {
+			return err
+		}
+		if err := s.holdoutStorage.UpsertExperimentHoldout(ctxWithTx, holdout, req.EnvironmentId); err != nil {
+			return err
+		}
+		experiments, _, _, err := s.experimentStorage.ListExperiments(ctxWithTx, v2es.ListExperimentsParams{
+			EnvironmentID: req.EnvironmentId,
+			Statuses:      []proto.Experiment_Status{proto.Experiment_RUNNING},
+		})
+		if err != nil {
+			return err
+		}
+		for _, e := range experiments {
+			experiment := &domain.Experiment{Experiment: e}
+			if err := s.syncFeatureAllocation(ctxWithTx, editor, experiment, holdout, req.EnvironmentId); err != nil {
+				return err
+			}
+		}
+		holdoutPb = holdout.ExperimentHoldout
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrHoldoutWeightOutOfRange) {
+			return nil, statusHoldoutWeightOutOfRange.Err()
+		}
+		s.logger.Error(
+			"Failed to update experiment holdout",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	return &proto.UpdateExperimentHoldoutResponse{
+		Holdout: holdoutPb,
+	}, nil
+}
+
+// getExperimentHoldout returns nil when the environment has no holdout group.
+func (s *experimentService) getExperimentHoldout(
+	ctx context.Context,
+	environmentId string,
+) (*domain.ExperimentHoldout, error) {
+	holdout, err := s.holdoutStorage.GetExperimentHoldout(ctx, environmentId)
+	if err != nil {
+		if errors.Is(err, v2es.ErrExperimentHoldoutNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return holdout, nil
+}
@@ -0,0 +1,180 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/experiment/domain"
+	v2es "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/storage/v2"
+	storagemock "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/storage/v2/mock"
+	ftdomain "github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
+	ftstoragemock "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2/mock"
+	dbmock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database/mock"
+	experimentproto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+func TestGetExperimentHoldoutMySQL(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := createContextWithTokenAndMetadata(metadata.MD{
+		"accept-language": []string{"ja"},
+	})
+
+	patterns := []struct {
+		desc     string
+		setup    func(*experimentService)
+		expected *experimentproto.ExperimentHoldout
+	}{
+		{
+			desc: "not configured",
+			setup: func(s *experimentService) {
+				s.holdoutStorage.(*storagemock.MockExperimentHoldoutStorage).EXPECT().GetExperimentHoldout(
+					gomock.Any(), "ns0",
+				).Return(nil, v2es.ErrExperimentHoldoutNotFound)
+			},
+			expected: &experimentproto.ExperimentHoldout{},
+		},
+		{
+			desc: "configured",
+			setup: func(s *experimentService) {
+				s.holdoutStorage.(*storagemock.MockExperimentHoldoutStorage).EXPECT().GetExperimentHoldout(
+					gomock.Any(), "ns0",
+				).Return(&domain.ExperimentHoldout{ExperimentHoldout: &experimentproto.ExperimentHoldout{
+					Id:     "holdout-id",
+					Weight: 5000,
+				}}, nil)
+			},
+			expected: &experimentproto.ExperimentHoldout{Id: "holdout-id", Weight: 5000},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			service := createExperimentService(mockController, nil, nil, nil)
+			p.setup(service)
+			resp, err := service.GetExperimentHoldout(ctx, &experimentproto.GetExperimentHoldoutRequest{
+				EnvironmentId: "ns0",
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, p.expected, resp.Holdout)
+		})
+	}
+}
+
+func TestUpdateExperimentHoldoutMySQL(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := createContextWithTokenAndMetadata(metadata.MD{
+		"accept-language": []string{"ja"},
+	})
+
+	patterns := []struct {
+		desc        string
+		setup       func(*experimentService)
+		req         *experimentproto.UpdateExperimentHoldoutRequest
+		expectedErr error
+	}{
+		{
+			desc: "err: weight out of range",
+			setup: func(s *experimentService) {
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				s.holdoutStorage.(*storagemock.MockExperimentHoldoutStorage).EXPECT().GetExperimentHoldout(
+					gomock.Any(), "ns0",
+				).Return(nil, v2es.ErrExperimentHoldoutNotFound)
+			},
+			req: &experimentproto.UpdateExperimentHoldoutRequest{
+				EnvironmentId: "ns0",
+				Weight:        domain.TrafficTotalWeight + 1,
+			},
+			expectedErr: statusHoldoutWeightOutOfRange.Err(),
+		},
+		{
+			desc: "success: refresh running experiments",
+			setup: func(s *experimentService) {
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				s.holdoutStorage.(*storagemock.MockExperimentHoldoutStorage).EXPECT().GetExperimentHoldout(
+					gomock.Any(), "ns0",
+				).Return(&domain.ExperimentHoldout{ExperimentHoldout: &experimentproto.ExperimentHoldout{
+					Id:     "holdout-id",
+					Weight: 1000,
+				}}, nil)
+				s.holdoutStorage.(*storagemock.MockExperimentHoldoutStorage).EXPECT().UpsertExperimentHoldout(
+					gomock.Any(), gomock.Any(), "ns0",
+				).Return(nil)
+				s.experimentStorage.(*storagemock.MockExperimentStorage).EXPECT().ListExperiments(
+					gomock.Any(), gomock.Any(),
+				).Return([]*experimentproto.Experiment{{
+					Id:              "exp-id",
+					FeatureId:       "fid",
+					BaseVariationId: "variation-a-id",
+					Status:          experimentproto.Experiment_RUNNING,
+				}}, 0, int64(1), nil)
+				s.ftStorage.(*ftstoragemock.MockFeatureStorage).EXPECT().GetFeature(
+					gomock.Any(), "fid", "ns0",
+				).Return(&ftdomain.Feature{Feature: &featureproto.Feature{
+					Id:      "fid",
+					Version: 2,
+					ExperimentAllocation: &featureproto.ExperimentAllocation{
+						ExperimentId:        "exp-id",
+						BaselineVariationId: "variation-a-id",
+						HoldoutId:           "holdout-id",
+						HoldoutWeight:       1000,
+					},
+				}}, nil)
+				s.ftStorage.(*ftstoragemock.MockFeatureStorage).EXPECT().UpdateFeature(
+					gomock.Any(), gomock.Any(), "ns0",
+				).DoAndReturn(func(_ context.Context, f *ftdomain.Feature, _ string) error {
+					assert.Equal(t, "holdout-id", f.ExperimentAllocation.HoldoutId)
+					assert.Equal(t, int32(3000), f.ExperimentAllocation.HoldoutWeight)
+					assert.Equal(t, int32(2), f.Version)
+					return nil
+				})
+			},
+			req: &experimentproto.UpdateExperimentHoldoutRequest{
+				EnvironmentId: "ns0",
+				Weight:        3000,
+				Reshuffle:     wrapperspb.Bool(false),
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			service := createExperimentService(mockController, nil, nil, nil)
+			p.setup(service)
+			_, err := service.UpdateExperimentHoldout(ctx, p.req)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/experiment/domain"
+	v2es "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+)
+
+func (s *experimentService) CreateExperimentLayer(
+	ctx context.Context,
+	req *proto.CreateExperimentLayerRequest,
+) (*proto.CreateExperimentLayerResponse, error) {
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_EXPERIMENT,
+		Action:       accountproto.Permission_CREATE,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, statusExperimentLayerNameRequired.Err()
+	}
+	layer, err := domain.NewExperimentLayer(req.Name, req.Description)
+	if err != nil {
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	if err := s.layerStorage.CreateExperimentLayer(ctx, layer, req.EnvironmentId); err != nil {
+		if errors.Is(err, v2es.ErrExperimentLayerAlreadyExists) {
+			return nil, statusAlreadyExists.Err()
+		}
+		s.logger.Error(
+			"Failed to create experiment layer",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	return &proto.CreateExperimentLayerResponse{
+		Layer: layer.ExperimentLayer,
+	}, nil
+}
+
+func (s *experimentService) ListExperimentLayers(
+	ctx context.Context,
+	req *proto.ListExperimentLayersRequest,
+) (*proto.ListExperimentLayersResponse, error) {
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_EXPERIMENT,
+		Action:       accountproto.Permission_READ,
+	})
+	if err != nil {
+		return nil, err
+	}
+	layers, err := s.layerStorage.ListExperimentLayers(ctx, req.EnvironmentId)
+	if err != nil {
+		s.logger.Error(
+			"Failed to list experiment layers",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	return &proto.ListExperimentLayersResponse{
+		Layers: layers,
+	}, nil
+}
+
+// DeleteExperimentLayer deletes a layer that no waiting or running experiment claims a slice of.
+func (s *experimentService) DeleteExperimentLayer(
+	ctx context.Context,
+	req *proto.DeleteExperimentLayerRequest,
+) (*proto.DeleteExperimentLayerResponse, error) {
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_EXPERIMENT,
+		Action:       accountproto.Permission_DELETE,
+		ResourceID:   req.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, statusExperimentLayerIDRequired.Err()
+	}
+	err = s.dbClient.RunInTransactionV2(ctx, func(ctxWithTx context.Context) error {
+		layer, err := s.getActiveExperimentLayer(ctxWithTx, req.Id, req.EnvironmentId)
+		if err != nil {
+			return err
+		}
+		active, err := s.listActiveExperiments(ctxWithTx, req.EnvironmentId)
+		if err != nil {
+			return err
+		}
+		for _, e := range active {
+			if e.LayerSlice != nil && e.LayerSlice.LayerId == layer.Id {
+				return statusExperimentLayerInUse.Err()
+			}
+		}
+		if err := layer.SetDeleted(); err != nil {
+			return err
+		}
+		return s.layerStorage.UpdateExperimentLayer(ctxWithTx, layer, req.EnvironmentId)
+	})
+	if err != nil {
+		if errors.Is(err, v2es.ErrExperimentLayerNotFound) ||
+			errors.Is(err, v2es.ErrExperimentLayerUnexpectedAffectedRows) {
+			return nil, statusExperimentLayerNotFound.Err()
+		}
+		if errors.Is(err, statusExperimentLayerInUse.Err()) {
+			return nil, statusExperimentLayerInUse.Err()
+		}
+		s.logger.Error(
+			"Failed to delete experiment layer",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	return &proto.DeleteExperimentLayerResponse{}, nil
+}
+
+func (s *experimentService) getActiveExperimentLayer(
+	ctx context.Context,
+	id, environmentId string,
+) (*domain.ExperimentLayer, error) {
+	layer, err := s.layerStorage.GetExperimentLayer(ctx, id, environmentId)
+	if err != nil {
+		return nil, err
+	}
+	if layer.Deleted {
+		return nil, v2es.ErrExperimentLayerNotFound
+	}
+	return layer, nil
+}
+
+func (s *experimentService) listActiveExperiments(
+	ctx context.Context,
+	environmentId string,
+) ([]*proto.Experiment, error) {
+	experiments, _, _, err := s.experimentStorage.ListExperiments(ctx, v2es.ListExperimentsParams{
+		EnvironmentID: environmentId,
+		Statuses: []proto.Experiment_Status{
+			proto.Experiment_WAITING,
+			proto.Experiment_RUNNING,
+		},
+	})
+	return experiments, err
+}
+
+// validateLayerSlice checks that the experiment's layer exists and that
+// no other active experiment already claims an overlapping slice of it.
+func (s *experimentService) validateLayerSlice(
+	ctx context.Context,
+	experiment *domain.Experiment,
+	environmentId string,
+) error {
+	if experiment.LayerSlice == nil {
+		return nil
+	}
+	if _, err := s.getActiveExperimentLayer(ctx, experiment.LayerSlice.LayerId, environmentId); err != nil {
+		return err
+	}
+	active, err := s.listActiveExperiments(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+	return experiment.ValidateLayerSliceAvailable(active)
+}
@@ -0,0 +1,207 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/experiment/domain"
+	v2es "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/storage/v2"
+	storagemock "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/storage/v2/mock"
+	dbmock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database/mock"
+	experimentproto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+)
+
+func TestCreateExperimentLayerMySQL(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := createContextWithTokenAndMetadata(metadata.MD{
+		"accept-language": []string{"ja"},
+	})
+
+	patterns := []struct {
+		desc        string
+		setup       func(*experimentService)
+		req         *experimentproto.CreateExperimentLayerRequest
+		expectedErr error
+	}{
+		{
+			desc:        "err: name required",
+			req:         &experimentproto.CreateExperimentLayerRequest{EnvironmentId: "ns0"},
+			expectedErr: statusExperimentLayerNameRequired.Err(),
+		},
+		{
+			desc: "success",
+			setup: func(s *experimentService) {
+				s.layerStorage.(*storagemock.MockExperimentLayerStorage).EXPECT().CreateExperimentLayer(
+					gomock.Any(), gomock.Any(), "ns0",
+				).Return(nil)
+			},
+			req: &experimentproto.CreateExperimentLayerRequest{
+				EnvironmentId: "ns0",
+				Name:          "checkout",
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			service := createExperimentService(mockController, nil, nil, nil)
+			if p.setup != nil {
+				p.setup(service)
+			}
+			resp, err := service.CreateExperimentLayer(ctx, p.req)
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, p.req.Name, resp.Layer.Name)
+				assert.NotEmpty(t, resp.Layer.Id)
+			}
+		})
+	}
+}
+
+func TestListExperimentLayersMySQL(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := createContextWithTokenAndMetadata(metadata.MD{
+		"accept-language": []string{"ja"},
+	})
+	service := createExperimentService(mockController, nil, nil, nil)
+	layers := []*experimentproto.ExperimentLayer{{Id: "layer-id", Name: "checkout"}}
+	service.layerStorage.(*storagemock.MockExperimentLayerStorage).EXPECT().ListExperimentLayers(
+		gomock.Any(), "ns0",
+	).Return(layers, nil)
+	resp, err := service.ListExperimentLayers(ctx, &experimentproto.ListExperimentLayersRequest{EnvironmentId: "ns0"})
+	assert.NoError(t, err)
+	assert.Equal(t, layers, resp.Layers)
+}
+
+func TestDeleteExperimentLayerMySQL(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := createContextWithTokenAndMetadata(metadata.MD{
+		"accept-language": []string{"ja"},
+	})
+	layer := func() *domain.ExperimentLayer {
+		return &domain.ExperimentLayer{ExperimentLayer: &experimentproto.ExperimentLayer{Id: "layer-id"}}
+	}
+
+	patterns := []struct {
+		desc        string
+		setup       func(*experimentService)
+		req         *experimentproto.DeleteExperimentLayerRequest
+		expectedErr error
+	}{
+		{
+			desc:        "err: id required",
+			req:         &experimentproto.DeleteExperimentLayerRequest{EnvironmentId: "ns0"},
+			expectedErr: statusExperimentLayerIDRequired.Err(),
+		},
+		{
+			desc: "err: not found",
+			setup: func(s *experimentService) {
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				s.layerStorage.(*storagemock.MockExperimentLayerStorage).EXPECT().GetExperimentLayer(
+					gomock.Any(), "layer-id", "ns0",
+				).Return(nil, v2es.ErrExperimentLayerNotFound)
+			},
+			req:         &experimentproto.DeleteExperimentLayerRequest{Id: "layer-id", EnvironmentId: "ns0"},
+			expectedErr: statusExperimentLayerNotFound.Err(),
+		},
+		{
+			desc: "err: in use",
+			setup: func(s *experimentService) {
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				s.layerStorage.(*storagemock.MockExperimentLayerStorage).EXPECT().GetExperimentLayer(
+					gomock.Any(), "layer-id", "ns0",
+				).Return(layer(), nil)
+				s.experimentStorage.(*storagemock.MockExperimentStorage).EXPECT().ListExperiments(
+					gomock.Any(), gomock.Any(),
+				).Return([]*experimentproto.Experiment{{
+					Id:         "exp-id",
+					Status:     experimentproto.Experiment_WAITING,
+					LayerSlice: &experimentproto.Experiment_LayerSlice{LayerId: "layer-id", TrafficEnd: 100},
+				}}, 0, int64(1), nil)
+			},
+			req:         &experimentproto.DeleteExperimentLayerRequest{Id: "layer-id", EnvironmentId: "ns0"},
+			expectedErr: statusExperimentLayerInUse.Err(),
+		},
+		{
+			desc: "err: internal",
+			setup: func(s *experimentService) {
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).Return(errors.New("error"))
+			},
+			req:         &experimentproto.DeleteExperimentLayerRequest{Id: "layer-id", EnvironmentId: "ns0"},
+			expectedErr: api.NewGRPCStatus(errors.New("error")).Err(),
+		},
+		{
+			desc: "success",
+			setup: func(s *experimentService) {
+				s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				s.layerStorage.(*storagemock.MockExperimentLayerStorage).EXPECT().GetExperimentLayer(
+					gomock.Any(), "layer-id", "ns0",
+				).Return(layer(), nil)
+				s.experimentStorage.(*storagemock.MockExperimentStorage).EXPECT().ListExperiments(
+					gomock.Any(), gomock.Any(),
+				).Return([]*experimentproto.Experiment{}, 0, int64(0), nil)
+				s.layerStorage.(*storagemock.MockExperimentLayerStorage).EXPECT().UpdateExperimentLayer(
+					gomock.Any(), gomock.Any(), "ns0",
+				).DoAndReturn(func(_ context.Context, l *domain.ExperimentLayer, _ string) error {
+					assert.True(t, l.Deleted)
+					return nil
+				})
+			},
+			req:         &experimentproto.DeleteExperimentLayerRequest{Id: "layer-id", EnvironmentId: "ns0"},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			service := createExperimentService(mockController, nil, nil, nil)
+			if p.setup != nil {
+				p.setup(service)
+			}
+			_, err := service.DeleteExperimentLayer(ctx, p.req)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
@@ -74,6 +74,26 @@ func (mr *MockClientMockRecorder) CreateExperiment(ctx, in any, opts ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExperiment", reflect.TypeOf((*MockClient)(nil).CreateExperiment), varargs...)
 }
 
+// CreateExperimentLayer mocks base method.
+func (m *MockClient) CreateExperimentLayer(ctx context.Context, in *experiment.CreateExperimentLayerRequest, opts ...grpc.CallOption) (*experiment.CreateExperimentLayerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateExperimentLayer", varargs...)
+	ret0, _ := ret[0].(*experiment.CreateExperimentLayerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExperimentLayer indicates an expected call of CreateExperimentLayer.
+func (mr *MockClientMockRecorder) CreateExperimentLayer(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExperimentLayer", reflect.TypeOf((*MockClient)(nil).CreateExperimentLayer), varargs...)
+}
+
 // CreateGoal mocks base method.
 func (m *MockClient) CreateGoal(ctx context.Context, in *experiment.CreateGoalRequest, opts ...grpc.CallOption) (*experiment.CreateGoalResponse, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +134,26 @@ func (mr *MockClientMockRecorder) DeleteExperiment(ctx, in any, opts ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExperiment", reflect.TypeOf((*MockClient)(nil).DeleteExperiment), varargs...)
 }
 
+// DeleteExperimentLayer mocks base method.
+func (m *MockClient) DeleteExperimentLayer(ctx context.Context, in *experiment.DeleteExperimentLayerRequest, opts ...grpc.CallOption) (*experiment.DeleteExperimentLayerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteExperimentLayer", varargs...)
+	ret0, _ := ret[0].(*experiment.DeleteExperimentLayerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExperimentLayer indicates an expected call of DeleteExperimentLayer.
+func (mr *MockClientMockRecorder) DeleteExperimentLayer(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExperimentLayer", reflect.TypeOf((*MockClient)(nil).DeleteExperimentLayer), varargs...)
+}
+
 // DeleteGoal mocks base method.
 func (m *MockClient) DeleteGoal(ctx context.Context, in *experiment.DeleteGoalRequest, opts ...grpc.CallOption) (*experiment.DeleteGoalResponse, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +194,26 @@ func (mr *MockClientMockRecorder) GetExperiment(ctx, in any, opts ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExperiment", reflect.TypeOf((*MockClient)(nil).GetExperiment), varargs...)
 }
 
+// GetExperimentHoldout mocks base method.
+func (m *MockClient) GetExperimentHoldout(ctx context.Context, in *experiment.GetExperimentHoldoutRequest, opts ...grpc.CallOption) (*experiment.GetExperimentHoldoutResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetExperimentHoldout", varargs...)
+	ret0, _ := ret[0].(*experiment.GetExperimentHoldoutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExperimentHoldout indicates an expected call of GetExperimentHoldout.
+func (mr *MockClientMockRecorder) GetExperimentHoldout(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExperimentHoldout", reflect.TypeOf((*MockClient)(nil).GetExperimentHoldout), varargs...)
+}
+
 // GetGoal mocks base method.
 func (m *MockClient) GetGoal(ctx context.Context, in *experiment.GetGoalRequest, opts ...grpc.CallOption) (*experiment.GetGoalResponse, error) {
 	m.ctrl.T.Helper()
@@ -174,6 +234,26 @@ func (mr *MockClientMockRecorder) GetGoal(ctx, in any, opts ...any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoal", reflect.TypeOf((*MockClient)(nil).GetGoal), varargs...)
 }
 
+// ListExperimentLayers mocks base method.
+func (m *MockClient) ListExperimentLayers(ctx context.Context, in *experiment.ListExperimentLayersRequest, opts ...grpc.CallOption) (*experiment.ListExperimentLayersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListExperimentLayers", varargs...)
+	ret0, _ := ret[0].(*experiment.ListExperimentLayersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExperimentLayers indicates an expected call of ListExperimentLayers.
+func (mr *MockClientMockRecorder) ListExperimentLayers(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExperimentLayers", reflect.TypeOf((*MockClient)(nil).ListExperimentLayers), varargs...)
+}
+
 // ListExperiments mocks base method.
 func (m *MockClient) ListExperiments(ctx context.Context, in *experiment.ListExperimentsRequest, opts ...grpc.CallOption) (*experiment.ListExperimentsResponse, error) {
 	m.ctrl.T.Helper()
@@ -234,6 +314,26 @@ func (mr *MockClientMockRecorder) UpdateExperiment(ctx, in any, opts ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExperiment", reflect.TypeOf((*MockClient)(nil).UpdateExperiment), varargs...)
 }
 
+// UpdateExperimentHoldout mocks base method.
+func (m *MockClient) UpdateExperimentHoldout(ctx context.Context, in *experiment.UpdateExperimentHoldoutRequest, opts ...grpc.CallOption) (*experiment.UpdateExperimentHoldoutResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateExperimentHoldout", varargs...)
+	ret0, _ := ret[0].(*experiment.UpdateExperimentHoldoutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateExperimentHoldout indicates an expected call of UpdateExperimentHoldout.
+func (mr *MockClientMockRecorder) UpdateExperimentHoldout(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExperimentHoldout", reflect.TypeOf((*MockClient)(nil).UpdateExperimentHoldout), varargs...)
+}
+
 // UpdateGoal mocks base method.
 func (m *MockClient) UpdateGoal(ctx context.Context, in *experiment.UpdateGoalRequest, opts ...grpc.CallOption) (*experiment.UpdateGoalResponse, error) {
 	m.ctrl.T.Helper()
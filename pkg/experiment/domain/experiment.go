@@ -23,6 +23,7 @@ import (
 
 	pkgErr "github.com/bucketeer-io/bucketeer/v2/pkg/error"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/uuid"
+	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
 	experimentproto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
 	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
 )
@@ -68,6 +69,22 @@ var (
 		"base variation not found",
 		"base_variation_id",
 	)
+	ErrLayerSliceLayerIDRequired = pkgErr.NewErrorInvalidArgEmpty(
+		pkgErr.ExperimentPackageName,
+		"layer id must be specified",
+		"layer_slice.layer_id",
+	)
+	ErrLayerSliceOutOfRange = pkgErr.NewErrorOutOfRange(
+		pkgErr.ExperimentPackageName,
+		"layer slice is out of range",
+		"layer_slice",
+		0,
+		TrafficTotalWeight,
+	)
+	ErrLayerSliceAlreadyClaimed = pkgErr.NewErrorFailedPrecondition(
+		pkgErr.ExperimentPackageName,
+		"layer slice overlaps an active experiment in the same layer",
+	)
 )
 
 type Experiment struct {
@@ -84,7 +101,8 @@ func NewExperiment(
 	name string,
 	description string,
 	baseVariationID string,
-	maintainer string) (*Experiment, error) {
+	maintainer string,
+	guardrailGoals []*autoopsproto.GuardrailGoal) (*Experiment, error) {
 
 	id, err := uuid.NewUUID()
 	if err != nil {
@@ -112,6 +130,7 @@ func NewExperiment(
 			BaseVariationId: baseVariationID,
 			Status:          experimentproto.Experiment_WAITING,
 			Maintainer:      maintainer,
+			GuardrailGoals:  guardrailGoals,
 		},
 	}, nil
 }
@@ -333,6 +352,62 @@ func (e *Experiment) SetDeleted() error {
 	return nil
 }
 
+// SetLayerSlice makes the experiment claim the range [trafficStart, trafficEnd) of the layer's hash space.
+func (e *Experiment) SetLayerSlice(slice *experimentproto.Experiment_LayerSlice) error {
+	if slice.LayerId == "" {
+		return ErrLayerSliceLayerIDRequired
+	}
+	if slice.TrafficStart < 0 || slice.TrafficEnd > TrafficTotalWeight || slice.TrafficStart >= slice.TrafficEnd {
+		return ErrLayerSliceOutOfRange
+	}
+	e.LayerSlice = slice
+	e.UpdatedAt = time.Now().Unix()
+	return nil
+}
+
+// ValidateLayerSliceAvailable returns an error if the experiment's slice overlaps
+// the slice of another not finished experiment in the same layer.
+func (e *Experiment) ValidateLayerSliceAvailable(others []*experimentproto.Experiment) error {
+	if e.LayerSlice == nil {
+		return nil
+	}
+	for _, o := range others {
+		if o.Id == e.Id || o.LayerSlice == nil || o.LayerSlice.LayerId != e.LayerSlice.LayerId {
+			continue
+		}
+		if o.Status != experimentproto.Experiment_WAITING && o.Status != experimentproto.Experiment_RUNNING {
+			continue
+		}
+		if e.LayerSlice.TrafficStart < o.LayerSlice.TrafficEnd && o.LayerSlice.TrafficStart < e.LayerSlice.TrafficEnd {
+			return ErrLayerSliceAlreadyClaimed
+		}
+	}
+	return nil
+}
+
+// FeatureAllocation returns the allocation the flag must evaluate while the experiment is running.
+// It returns nil when neither a layer slice nor a holdout group restricts the experiment traffic.
+func (e *Experiment) FeatureAllocation(holdout *ExperimentHoldout) *featureproto.ExperimentAllocation {
+	hasHoldout := holdout != nil && holdout.Weight > 0
+	if e.LayerSlice == nil && !hasHoldout {
+		return nil
+	}
+	allocation := &featureproto.ExperimentAllocation{
+		ExperimentId:        e.Id,
+		BaselineVariationId: e.BaseVariationId,
+	}
+	if e.LayerSlice != nil {
+		allocation.LayerId = e.LayerSlice.LayerId
+		allocation.TrafficStart = e.LayerSlice.TrafficStart
+		allocation.TrafficEnd = e.LayerSlice.TrafficEnd
+	}
+	if hasHoldout {
+		allocation.HoldoutId = holdout.Id
+		allocation.HoldoutWeight = holdout.Weight
+	}
+	return allocation
+}
+
 // SyncGoalIDs syncs goalID and goalIDs.
 // FIXME: This function is needed until admin UI implements multiple goals.
 func SyncGoalIDs(goalID string, goalIDs []string) (string, []string) {
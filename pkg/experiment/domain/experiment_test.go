@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
 	experimentproto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
 	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
 )
@@ -57,6 +58,7 @@ func TestNewExperiment(t *testing.T) {
 	name := "name"
 	description := "description"
 	maintainer := "bucketeer@example.com"
+	guardrailGoals := []*autoopsproto.GuardrailGoal{{GoalId: "id-3", Threshold: 0.9}}
 
 	patterns := []*struct {
 		desc, baseVariationID string
@@ -87,6 +89,7 @@ func TestNewExperiment(t *testing.T) {
 				description,
 				p.baseVariationID,
 				maintainer,
+				guardrailGoals,
 			)
 
 			if err != nil {
@@ -110,6 +113,7 @@ func TestNewExperiment(t *testing.T) {
 			assert.Equal(t, description, e.Description)
 			assert.Equal(t, p.baseVariationID, e.BaseVariationId)
 			assert.Equal(t, maintainer, e.Maintainer)
+			assert.Equal(t, guardrailGoals, e.GuardrailGoals)
 		})
 	}
 }
@@ -738,6 +742,161 @@ func TestUpdateExperiment(t *testing.T) {
 	}
 }
 
+func TestSetLayerSlice(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc        string
+		input       *experimentproto.Experiment_LayerSlice
+		expectedErr error
+	}{
+		{
+			desc:        "err: layer id empty",
+			input:       &experimentproto.Experiment_LayerSlice{TrafficStart: 0, TrafficEnd: 100},
+			expectedErr: ErrLayerSliceLayerIDRequired,
+		},
+		{
+			desc:        "err: negative start",
+			input:       &experimentproto.Experiment_LayerSlice{LayerId: "l", TrafficStart: -1, TrafficEnd: 100},
+			expectedErr: ErrLayerSliceOutOfRange,
+		},
+		{
+			desc:        "err: end exceeds total weight",
+			input:       &experimentproto.Experiment_LayerSlice{LayerId: "l", TrafficStart: 0, TrafficEnd: 100001},
+			expectedErr: ErrLayerSliceOutOfRange,
+		},
+		{
+			desc:        "err: empty slice",
+			input:       &experimentproto.Experiment_LayerSlice{LayerId: "l", TrafficStart: 500, TrafficEnd: 500},
+			expectedErr: ErrLayerSliceOutOfRange,
+		},
+		{
+			desc:        "success",
+			input:       &experimentproto.Experiment_LayerSlice{LayerId: "l", TrafficStart: 0, TrafficEnd: 100000},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			e := newExperiment(t)
+			err := e.SetLayerSlice(p.input)
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, p.input, e.LayerSlice)
+			} else {
+				assert.Nil(t, e.LayerSlice)
+			}
+		})
+	}
+}
+
+func TestValidateLayerSliceAvailable(t *testing.T) {
+	t.Parallel()
+	other := func(
+		id, layerID string,
+		start, end int32,
+		status experimentproto.Experiment_Status,
+	) *experimentproto.Experiment {
+		return &experimentproto.Experiment{
+			Id:     id,
+			Status: status,
+			LayerSlice: &experimentproto.Experiment_LayerSlice{
+				LayerId:      layerID,
+				TrafficStart: start,
+				TrafficEnd:   end,
+			},
+		}
+	}
+	patterns := []struct {
+		desc        string
+		others      []*experimentproto.Experiment
+		expectedErr error
+	}{
+		{
+			desc:        "success: no other experiments",
+			others:      nil,
+			expectedErr: nil,
+		},
+		{
+			desc: "success: adjacent slices",
+			others: []*experimentproto.Experiment{
+				other("e-1", "layer", 0, 25000, experimentproto.Experiment_RUNNING),
+				other("e-2", "layer", 75000, 100000, experimentproto.Experiment_WAITING),
+			},
+			expectedErr: nil,
+		},
+		{
+			desc: "success: overlapping slice in another layer",
+			others: []*experimentproto.Experiment{
+				other("e-1", "another-layer", 0, 100000, experimentproto.Experiment_RUNNING),
+			},
+			expectedErr: nil,
+		},
+		{
+			desc: "success: overlapping slice of a stopped experiment",
+			others: []*experimentproto.Experiment{
+				other("e-1", "layer", 0, 100000, experimentproto.Experiment_STOPPED),
+				other("e-2", "layer", 0, 100000, experimentproto.Experiment_FORCE_STOPPED),
+			},
+			expectedErr: nil,
+		},
+		{
+			desc: "err: overlapping slice",
+			others: []*experimentproto.Experiment{
+				other("e-1", "layer", 0, 25001, experimentproto.Experiment_RUNNING),
+			},
+			expectedErr: ErrLayerSliceAlreadyClaimed,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			e := newExperiment(t)
+			require.NoError(t, e.SetLayerSlice(&experimentproto.Experiment_LayerSlice{
+				LayerId:      "layer",
+				TrafficStart: 25000,
+				TrafficEnd:   75000,
+			}))
+			assert.Equal(t, p.expectedErr, e.ValidateLayerSliceAvailable(p.others))
+		})
+	}
+}
+
+func TestFeatureAllocation(t *testing.T) {
+	t.Parallel()
+	e := newExperiment(t)
+	assert.Nil(t, e.FeatureAllocation(nil))
+	assert.Nil(t, e.FeatureAllocation(&ExperimentHoldout{&experimentproto.ExperimentHoldout{Id: "h", Weight: 0}}))
+
+	holdout := &ExperimentHoldout{&experimentproto.ExperimentHoldout{Id: "h", Weight: 5000}}
+	assert.Equal(t, &featureproto.ExperimentAllocation{
+		ExperimentId:        e.Id,
+		BaselineVariationId: "variation-c-id",
+		HoldoutId:           "h",
+		HoldoutWeight:       5000,
+	}, e.FeatureAllocation(holdout))
+
+	require.NoError(t, e.SetLayerSlice(&experimentproto.Experiment_LayerSlice{
+		LayerId:      "layer",
+		TrafficStart: 0,
+		TrafficEnd:   50000,
+	}))
+	assert.Equal(t, &featureproto.ExperimentAllocation{
+		ExperimentId:        e.Id,
+		LayerId:             "layer",
+		TrafficStart:        0,
+		TrafficEnd:          50000,
+		BaselineVariationId: "variation-c-id",
+	}, e.FeatureAllocation(nil))
+	assert.Equal(t, &featureproto.ExperimentAllocation{
+		ExperimentId:        e.Id,
+		LayerId:             "layer",
+		TrafficStart:        0,
+		TrafficEnd:          50000,
+		BaselineVariationId: "variation-c-id",
+		HoldoutId:           "h",
+		HoldoutWeight:       5000,
+	}, e.FeatureAllocation(holdout))
+}
+
 func newExperiment(t *testing.T) *Experiment {
 	t.Helper()
 	featureID := "id"
@@ -781,6 +940,7 @@ func newExperiment(t *testing.T) *Experiment {
 		description,
 		baseVariationId,
 		maintainer,
+		nil,
 	)
 	assert.NoError(t, err)
 	return e
@@ -30,15 +30,21 @@ type Goal struct {
 func NewGoal(
 	id, name, description string,
 	connectionType proto.Goal_ConnectionType,
+	metricType proto.Goal_MetricType,
+	improvementDirection proto.Goal_ImprovementDirection,
+	valueCapPercentile int32,
 ) (*Goal, error) {
 	now := time.Now().Unix()
 	return &Goal{&proto.Goal{
-		Id:             id,
-		Name:           name,
-		Description:    description,
-		ConnectionType: connectionType,
-		CreatedAt:      now,
-		UpdatedAt:      now,
+		Id:                   id,
+		Name:                 name,
+		Description:          description,
+		ConnectionType:       connectionType,
+		MetricType:           metricType,
+		ImprovementDirection: improvementDirection,
+		ValueCapPercentile:   valueCapPercentile,
+		CreatedAt:            now,
+		UpdatedAt:            now,
 	}}, nil
 }
 
@@ -46,6 +52,9 @@ func (g *Goal) Update(
 	name *wrapperspb.StringValue,
 	description *wrapperspb.StringValue,
 	archived *wrapperspb.BoolValue,
+	metricType *proto.Goal_MetricType,
+	improvementDirection *proto.Goal_ImprovementDirection,
+	valueCapPercentile *wrapperspb.Int32Value,
 ) (*Goal, error) {
 	updated := &Goal{}
 	if err := copier.Copy(updated, g); err != nil {
@@ -61,6 +70,15 @@ func (g *Goal) Update(
 	if archived != nil {
 		updated.Archived = archived.Value
 	}
+	if metricType != nil {
+		updated.MetricType = *metricType
+	}
+	if improvementDirection != nil {
+		updated.ImprovementDirection = *improvementDirection
+	}
+	if valueCapPercentile != nil {
+		updated.ValueCapPercentile = valueCapPercentile.Value
+	}
 	updated.UpdatedAt = time.Now().Unix()
 	return updated, nil
 }
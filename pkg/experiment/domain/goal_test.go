@@ -63,11 +63,14 @@ func TestUpdateGoal(t *testing.T) {
 	g := newGoal(t)
 
 	tests := []struct {
-		desc     string
-		newName  *wrapperspb.StringValue
-		newDesc  *wrapperspb.StringValue
-		archived *wrapperspb.BoolValue
-		deleted  *wrapperspb.BoolValue
+		desc          string
+		newName       *wrapperspb.StringValue
+		newDesc       *wrapperspb.StringValue
+		archived      *wrapperspb.BoolValue
+		deleted       *wrapperspb.BoolValue
+		metricType    *proto.Goal_MetricType
+		direction     *proto.Goal_ImprovementDirection
+		capPercentile *wrapperspb.Int32Value
 	}{
 		{
 			desc:     "update goal",
@@ -83,12 +86,18 @@ func TestUpdateGoal(t *testing.T) {
 			archived: wrapperspb.Bool(true),
 			deleted:  nil,
 		},
+		{
+			desc:          "change metric settings",
+			metricType:    proto.Goal_SUM.Enum(),
+			direction:     proto.Goal_LOWER_IS_BETTER.Enum(),
+			capPercentile: wrapperspb.Int32(95),
+		},
 	}
 	for i := range tests {
 		tt := tests[i]
 		t.Run(tt.desc, func(t *testing.T) {
 			t.Parallel()
-			updated, err := g.Update(tt.newName, tt.newDesc, tt.archived)
+			updated, err := g.Update(tt.newName, tt.newDesc, tt.archived, tt.metricType, tt.direction, tt.capPercentile)
 			require.NoError(t, err)
 			if tt.newName != nil {
 				assert.Equal(t, tt.newName.Value, updated.Name)
@@ -96,13 +105,23 @@ func TestUpdateGoal(t *testing.T) {
 			if tt.newDesc != nil {
 				assert.Equal(t, tt.newDesc.Value, updated.Description)
 			}
+			if tt.metricType != nil {
+				assert.Equal(t, *tt.metricType, updated.MetricType)
+				assert.Equal(t, proto.Goal_CONVERSION, g.MetricType)
+			}
+			if tt.direction != nil {
+				assert.Equal(t, *tt.direction, updated.ImprovementDirection)
+			}
+			if tt.capPercentile != nil {
+				assert.Equal(t, tt.capPercentile.Value, updated.ValueCapPercentile)
+			}
 		})
 	}
 }
 
 func newGoal(t *testing.T) *Goal {
 	t.Helper()
-	g, err := NewGoal("gID", "gName", "gDesc", proto.Goal_OPERATION)
+	g, err := NewGoal("gID", "gName", "gDesc", proto.Goal_OPERATION, proto.Goal_CONVERSION, proto.Goal_HIGHER_IS_BETTER, 0)
 	require.NoError(t, err)
 	return g
 }
@@ -0,0 +1,72 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"time"
+
+	pkgErr "github.com/bucketeer-io/bucketeer/v2/pkg/error"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/uuid"
+	experimentproto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+)
+
+// TrafficTotalWeight is the size of the hash space shared by layer slices and the holdout group.
+const TrafficTotalWeight = 100000
+
+var (
+	ErrHoldoutWeightOutOfRange = pkgErr.NewErrorOutOfRange(
+		pkgErr.ExperimentPackageName,
+		"holdout weight is out of range",
+		"weight",
+		0,
+		TrafficTotalWeight,
+	)
+)
+
+type ExperimentHoldout struct {
+	*experimentproto.ExperimentHoldout
+}
+
+// NewExperimentHoldout returns an empty holdout group.
+// Environments without a stored holdout behave as if they had this one.
+func NewExperimentHoldout() (*ExperimentHoldout, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	return &ExperimentHoldout{&experimentproto.ExperimentHoldout{
+		Id:        id.String(),
+		Weight:    0,
+		UpdatedAt: time.Now().Unix(),
+	}}, nil
+}
+
+// Update changes the share of users held out.
+// When reshuffle is true, a new hash salt is generated so a different set of users is held out.
+func (h *ExperimentHoldout) Update(weight int32, reshuffle bool) error {
+	if weight < 0 || weight > TrafficTotalWeight {
+		return ErrHoldoutWeightOutOfRange
+	}
+	if reshuffle {
+		id, err := uuid.NewUUID()
+		if err != nil {
+			return err
+		}
+		h.Id = id.String()
+	}
+	h.Weight = weight
+	h.UpdatedAt = time.Now().Unix()
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExperimentHoldout(t *testing.T) {
+	t.Parallel()
+	h, err := NewExperimentHoldout()
+	require.NoError(t, err)
+	assert.NotEmpty(t, h.Id)
+	assert.Zero(t, h.Weight)
+}
+
+func TestUpdateExperimentHoldout(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc        string
+		weight      int32
+		reshuffle   bool
+		expectedErr error
+	}{
+		{
+			desc:        "err: negative weight",
+			weight:      -1,
+			expectedErr: ErrHoldoutWeightOutOfRange,
+		},
+		{
+			desc:        "err: weight exceeds total",
+			weight:      TrafficTotalWeight + 1,
+			expectedErr: ErrHoldoutWeightOutOfRange,
+		},
+		{
+			desc:        "success: keep users",
+			weight:      5000,
+			reshuffle:   false,
+			expectedErr: nil,
+		},
+		{
+			desc:        "success: reshuffle users",
+			weight:      5000,
+			reshuffle:   true,
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			h, err := NewExperimentHoldout()
+			require.NoError(t, err)
+			id := h.Id
+			err = h.Update(p.weight, p.reshuffle)
+			assert.Equal(t, p.expectedErr, err)
+			if err != nil {
+				assert.Zero(t, h.Weight)
+				assert.Equal(t, id, h.Id)
+				return
+			}
+			assert.Equal(t, p.weight, h.Weight)
+			if p.reshuffle {
+				assert.NotEqual(t, id, h.Id)
+			} else {
+				assert.Equal(t, id, h.Id)
+			}
+		})
+	}
+}
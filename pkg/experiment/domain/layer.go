@@ -0,0 +1,47 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"time"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/uuid"
+	experimentproto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+)
+
+type ExperimentLayer struct {
+	*experimentproto.ExperimentLayer
+}
+
+func NewExperimentLayer(name, description string) (*ExperimentLayer, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	return &ExperimentLayer{&experimentproto.ExperimentLayer{
+		Id:          id.String(),
+		Name:        name,
+		Description: description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}}, nil
+}
+
+func (l *ExperimentLayer) SetDeleted() error {
+	l.Deleted = true
+	l.UpdatedAt = time.Now().Unix()
+	return nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExperimentLayer(t *testing.T) {
+	t.Parallel()
+	l, err := NewExperimentLayer("checkout", "checkout page experiments")
+	require.NoError(t, err)
+	assert.NotEmpty(t, l.Id)
+	assert.Equal(t, "checkout", l.Name)
+	assert.Equal(t, "checkout page experiments", l.Description)
+	assert.NotZero(t, l.CreatedAt)
+	assert.Equal(t, l.CreatedAt, l.UpdatedAt)
+	assert.False(t, l.Deleted)
+}
+
+func TestSetDeletedExperimentLayer(t *testing.T) {
+	t.Parallel()
+	l, err := NewExperimentLayer("checkout", "")
+	require.NoError(t, err)
+	err = l.SetDeleted()
+	assert.NoError(t, err)
+	assert.True(t, l.Deleted)
+}
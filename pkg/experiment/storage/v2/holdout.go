@@ -0,0 +1,33 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mock/$GOFILE
+package v2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/experiment/domain"
+)
+
+var (
+	ErrExperimentHoldoutNotFound = errors.New("experimentHoldout: not found")
+)
+
+type ExperimentHoldoutStorage interface {
+	GetExperimentHoldout(ctx context.Context, environmentId string) (*domain.ExperimentHoldout, error)
+	// UpsertExperimentHoldout stores the holdout group, creating it if the environment has none.
+	UpsertExperimentHoldout(ctx context.Context, h *domain.ExperimentHoldout, environmentId string) error
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mock/$GOFILE
+package v2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/experiment/domain"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+)
+
+var (
+	ErrExperimentLayerAlreadyExists          = errors.New("experimentLayer: already exists")
+	ErrExperimentLayerNotFound               = errors.New("experimentLayer: not found")
+	ErrExperimentLayerUnexpectedAffectedRows = errors.New("experimentLayer: unexpected affected rows")
+)
+
+type ExperimentLayerStorage interface {
+	CreateExperimentLayer(ctx context.Context, l *domain.ExperimentLayer, environmentId string) error
+	UpdateExperimentLayer(ctx context.Context, l *domain.ExperimentLayer, environmentId string) error
+	GetExperimentLayer(ctx context.Context, id, environmentId string) (*domain.ExperimentLayer, error)
+	// ListExperimentLayers returns the layers of the environment that are not deleted.
+	ListExperimentLayers(ctx context.Context, environmentId string) ([]*proto.ExperimentLayer, error)
+}
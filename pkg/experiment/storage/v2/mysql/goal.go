@@ -66,6 +66,9 @@ func (s *goalStorage) CreateGoal(ctx context.Context, g *domain.Goal, environmen
 		g.Name,
 		g.Description,
 		g.ConnectionType,
+		g.MetricType,
+		g.ImprovementDirection,
+		g.ValueCapPercentile,
 		g.Archived,
 		g.Deleted,
 		g.CreatedAt,
@@ -87,6 +90,9 @@ func (s *goalStorage) UpdateGoal(ctx context.Context, g *domain.Goal, environmen
 		updateGoalSQL,
 		g.Name,
 		g.Description,
+		g.MetricType,
+		g.ImprovementDirection,
+		g.ValueCapPercentile,
 		g.Archived,
 		g.Deleted,
 		g.CreatedAt,
@@ -109,7 +115,7 @@ func (s *goalStorage) UpdateGoal(ctx context.Context, g *domain.Goal, environmen
 
 func (s *goalStorage) GetGoal(ctx context.Context, id, environmentId string) (*domain.Goal, error) {
 	goal := proto.Goal{}
-	var connectionType int32
+	var connectionType, metricType, improvementDirection int32
 	var experiments []experimentRef
 	err := s.qe.QueryRowContext(
 		ctx,
@@ -123,6 +129,9 @@ func (s *goalStorage) GetGoal(ctx context.Context, id, environmentId string) (*d
 		&goal.Name,
 		&goal.Description,
 		&connectionType,
+		&metricType,
+		&improvementDirection,
+		&goal.ValueCapPercentile,
 		&goal.Archived,
 		&goal.Deleted,
 		&goal.CreatedAt,
@@ -137,6 +146,8 @@ func (s *goalStorage) GetGoal(ctx context.Context, id, environmentId string) (*d
 		return nil, err
 	}
 	goal.ConnectionType = proto.Goal_ConnectionType(connectionType)
+	goal.MetricType = proto.Goal_MetricType(metricType)
+	goal.ImprovementDirection = proto.Goal_ImprovementDirection(improvementDirection)
 	for i := range experiments {
 		goal.Experiments = append(goal.Experiments, &proto.Goal_ExperimentReference{
 			Id:          experiments[i].Id,
@@ -210,13 +221,16 @@ func (s *goalStorage) ListGoals(
 
 	for rows.Next() {
 		goal := proto.Goal{}
-		var connectionType int32
+		var connectionType, metricType, improvementDirection int32
 		var experiments []experimentRef
 		err := rows.Scan(
 			&goal.Id,
 			&goal.Name,
 			&goal.Description,
 			&connectionType,
+			&metricType,
+			&improvementDirection,
+			&goal.ValueCapPercentile,
 			&goal.Archived,
 			&goal.Deleted,
 			&goal.CreatedAt,
@@ -228,6 +242,8 @@ func (s *goalStorage) ListGoals(
 			return nil, 0, 0, err
 		}
 		goal.ConnectionType = proto.Goal_ConnectionType(connectionType)
+		goal.MetricType = proto.Goal_MetricType(metricType)
+		goal.ImprovementDirection = proto.Goal_ImprovementDirection(improvementDirection)
 		for i := range experiments {
 			goal.Experiments = append(goal.Experiments, &proto.Goal_ExperimentReference{
 				Id:          experiments[i].Id,
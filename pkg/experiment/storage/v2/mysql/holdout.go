@@ -0,0 +1,80 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/experiment/domain"
+	v2es "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/storage/v2"
+	mysqlstorage "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/mysql"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+)
+
+var (
+	//go:embed sql/experiment_holdout/select_experiment_holdout.sql
+	selectExperimentHoldoutSQL string
+	//go:embed sql/experiment_holdout/upsert_experiment_holdout.sql
+	upsertExperimentHoldoutSQL string
+)
+
+type experimentHoldoutStorage struct {
+	qe mysqlstorage.QueryExecer
+}
+
+func NewExperimentHoldoutStorage(qe mysqlstorage.QueryExecer) v2es.ExperimentHoldoutStorage {
+	return &experimentHoldoutStorage{qe: qe}
+}
+
+func (s *experimentHoldoutStorage) GetExperimentHoldout(
+	ctx context.Context,
+	environmentId string,
+) (*domain.ExperimentHoldout, error) {
+	holdout := proto.ExperimentHoldout{}
+	err := s.qe.QueryRowContext(
+		ctx,
+		selectExperimentHoldoutSQL,
+		environmentId,
+	).Scan(
+		&holdout.Id,
+		&holdout.Weight,
+		&holdout.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, mysqlstorage.ErrNoRows) {
+			return nil, v2es.ErrExperimentHoldoutNotFound
+		}
+		return nil, err
+	}
+	return &domain.ExperimentHoldout{ExperimentHoldout: &holdout}, nil
+}
+
+func (s *experimentHoldoutStorage) UpsertExperimentHoldout(
+	ctx context.Context,
+	h *domain.ExperimentHoldout,
+	environmentId string,
+) error {
+	_, err := s.qe.ExecContext(
+		ctx,
+		upsertExperimentHoldoutSQL,
+		environmentId,
+		h.Id,
+		h.Weight,
+		h.UpdatedAt,
+	)
+	return err
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/experiment/domain"
+	v2es "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/storage/v2"
+	mysqlstorage "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/mysql"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+)
+
+var (
+	//go:embed sql/experiment_layer/insert_experiment_layer.sql
+	insertExperimentLayerSQL string
+	//go:embed sql/experiment_layer/update_experiment_layer.sql
+	updateExperimentLayerSQL string
+	//go:embed sql/experiment_layer/select_experiment_layer.sql
+	selectExperimentLayerSQL string
+	//go:embed sql/experiment_layer/select_experiment_layers.sql
+	selectExperimentLayersSQL string
+)
+
+type experimentLayerStorage struct {
+	qe mysqlstorage.QueryExecer
+}
+
+func NewExperimentLayerStorage(qe mysqlstorage.QueryExecer) v2es.ExperimentLayerStorage {
+	return &experimentLayerStorage{qe: qe}
+}
+
+func (s *experimentLayerStorage) CreateExperimentLayer(
+	ctx context.Context,
+	l *domain.ExperimentLayer,
+	environmentId string,
+) error {
+	_, err := s.qe.ExecContext(
+		ctx,
+		insertExperimentLayerSQL,
+		l.Id,
+		l.Name,
+		l.Description,
+		l.Deleted,
+		l.CreatedAt,
+		l.UpdatedAt,
+		environmentId,
+	)
+	if err != nil {
+		if errors.Is(err, mysqlstorage.ErrDuplicateEntry) {
+			return v2es.ErrExperimentLayerAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *experimentLayerStorage) UpdateExperimentLayer(
+	ctx context.Context,
+	l *domain.ExperimentLayer,
+	environmentId string,
+) error {
+	result, err := s.qe.ExecContext(
+		ctx,
+		updateExperimentLayerSQL,
+		l.Name,
+		l.Description,
+		l.Deleted,
+		l.UpdatedAt,
+		l.Id,
+		environmentId,
+	)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return v2es.ErrExperimentLayerUnexpectedAffectedRows
+	}
+	return nil
+}
+
+func (s *experimentLayerStorage) GetExperimentLayer(
+	ctx context.Context,
+	id, environmentId string,
+) (*domain.ExperimentLayer, error) {
+	layer := proto.ExperimentLayer{}
+	err := s.qe.QueryRowContext(
+		ctx,
+		selectExperimentLayerSQL,
+		id,
+		environmentId,
+	).Scan(
+		&layer.Id,
+		&layer.Name,
+		&layer.Description,
+		&layer.Deleted,
+		&layer.CreatedAt,
+		&layer.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, mysqlstorage.ErrNoRows) {
+			return nil, v2es.ErrExperimentLayerNotFound
+		}
+		return nil, err
+	}
+	return &domain.ExperimentLayer{ExperimentLayer: &layer}, nil
+}
+
+func (s *experimentLayerStorage) ListExperimentLayers(
+	ctx context.Context,
+	environmentId string,
+) ([]*proto.ExperimentLayer, error) {
+	rows, err := s.qe.QueryContext(ctx, selectExperimentLayersSQL, environmentId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	layers := make([]*proto.ExperimentLayer, 0)
+	for rows.Next() {
+		layer := proto.ExperimentLayer{}
+		err := rows.Scan(
+			&layer.Id,
+			&layer.Name,
+			&layer.Description,
+			&layer.Deleted,
+			&layer.CreatedAt,
+			&layer.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, &layer)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return layers, nil
+}
@@ -18,8 +18,11 @@ INSERT INTO experiment (
     base_variation_id,
     status,
     maintainer,
+    guardrail_goals,
+    layer_slice,
     environment_id
 ) VALUES (
     ?, ?, ?, ?, ?, ?, ?, ?, ?, ?,
-    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?,
+    ?, ?
 )
\ No newline at end of file
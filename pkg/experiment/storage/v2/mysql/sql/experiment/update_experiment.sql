@@ -18,7 +18,8 @@ SET
     description = ?,
     base_variation_id = ?,
     maintainer = ?,
-    status = ?
+    status = ?,
+    layer_slice = ?
 WHERE
     id = ? AND
     environment_id = ?
\ No newline at end of file
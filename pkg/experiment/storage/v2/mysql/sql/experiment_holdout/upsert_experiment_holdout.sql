@@ -0,0 +1,11 @@
+INSERT INTO experiment_holdout (
+    environment_id,
+    id,
+    weight,
+    updated_at
+) VALUES (
+    ?, ?, ?, ?
+) ON DUPLICATE KEY UPDATE
+    id = VALUES(id),
+    weight = VALUES(weight),
+    updated_at = VALUES(updated_at)
@@ -0,0 +1,12 @@
+SELECT
+    id,
+    name,
+    description,
+    deleted,
+    created_at,
+    updated_at
+FROM
+    experiment_layer
+WHERE
+    id = ? AND
+    environment_id = ?
@@ -0,0 +1,14 @@
+SELECT
+    id,
+    name,
+    description,
+    deleted,
+    created_at,
+    updated_at
+FROM
+    experiment_layer
+WHERE
+    environment_id = ? AND
+    deleted = false
+ORDER BY
+    name ASC
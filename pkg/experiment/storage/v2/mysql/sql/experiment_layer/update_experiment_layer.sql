@@ -0,0 +1,10 @@
+UPDATE
+    experiment_layer
+SET
+    name = ?,
+    description = ?,
+    deleted = ?,
+    updated_at = ?
+WHERE
+    id = ? AND
+    environment_id = ?
@@ -3,11 +3,14 @@ INSERT INTO goal (
     name,
     description,
     connection_type,
+    metric_type,
+    improvement_direction,
+    value_cap_percentile,
     archived,
     deleted,
     created_at,
     updated_at,
     environment_id
 ) VALUES (
-    ?, ?, ?, ?, ?, ?, ?, ?, ?
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 )
\ No newline at end of file
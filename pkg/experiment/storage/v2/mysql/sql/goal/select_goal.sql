@@ -3,6 +3,9 @@ SELECT
     goal.name,
     goal.description,
     goal.connection_type,
+    goal.metric_type,
+    goal.improvement_direction,
+    goal.value_cap_percentile,
     goal.archived,
     goal.deleted,
     goal.created_at,
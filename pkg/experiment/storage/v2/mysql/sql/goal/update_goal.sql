@@ -3,6 +3,9 @@ UPDATE
 SET
     name = ?,
     description = ?,
+    metric_type = ?,
+    improvement_direction = ?,
+    value_cap_percentile = ?,
     archived = ?,
     deleted = ?,
     created_at = ?,
@@ -76,6 +76,8 @@ func (s *experimentStorage) CreateExperiment(
 		e.BaseVariationId,
 		int32(e.Status),
 		e.Maintainer,
+		pgstorage.JSONObject{Val: e.GuardrailGoals},
+		pgstorage.JSONObject{Val: e.LayerSlice},
 		environmentId,
 	)
 	if err != nil {
@@ -113,6 +115,7 @@ func (s *experimentStorage) UpdateExperiment(
 		e.BaseVariationId,
 		e.Maintainer,
 		int32(e.Status),
+		pgstorage.JSONObject{Val: e.LayerSlice},
 		e.Id,
 		environmentId,
 	)
@@ -160,6 +163,8 @@ func (s *experimentStorage) GetExperiment(
 		&experiment.BaseVariationId,
 		&experiment.Maintainer,
 		&status,
+		&pgstorage.JSONObject{Val: &experiment.GuardrailGoals},
+		&pgstorage.JSONObject{Val: &experiment.LayerSlice},
 		&pgstorage.JSONObject{Val: &experiment.Goals},
 	)
 	if err != nil {
@@ -212,6 +217,8 @@ func (s *experimentStorage) ListExperiments(
 			&experiment.BaseVariationId,
 			&experiment.Maintainer,
 			&status,
+			&pgstorage.JSONObject{Val: &experiment.GuardrailGoals},
+			&pgstorage.JSONObject{Val: &experiment.LayerSlice},
 			&pgstorage.JSONObject{Val: &experiment.Goals},
 		)
 		if err != nil {
@@ -0,0 +1,132 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/experiment/domain"
+	v2es "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres/mock"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+)
+
+func TestNewExperimentHoldoutStorage(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	db := NewExperimentHoldoutStorage(mock.NewMockClient(mockController))
+	assert.IsType(t, &experimentHoldoutStorage{}, db)
+}
+
+func TestGetExperimentHoldout(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*experimentHoldoutStorage)
+		expectedErr error
+	}{
+		{
+			desc: "err: not found",
+			setup: func(s *experimentHoldoutStorage) {
+				row := mock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(postgres.ErrNoRows)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+			},
+			expectedErr: v2es.ErrExperimentHoldoutNotFound,
+		},
+		{
+			desc: "success",
+			setup: func(s *experimentHoldoutStorage) {
+				row := mock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newExperimentHoldoutStorageWithMock(t, mockController)
+			p.setup(storage)
+			_, err := storage.GetExperimentHoldout(context.Background(), "ns0")
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestUpsertExperimentHoldout(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*experimentHoldoutStorage)
+		expectedErr error
+	}{
+		{
+			desc: "err: exec failed",
+			setup: func(s *experimentHoldoutStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errors.New("error"))
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "success",
+			setup: func(s *experimentHoldoutStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, nil)
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newExperimentHoldoutStorageWithMock(t, mockController)
+			p.setup(storage)
+			err := storage.UpsertExperimentHoldout(
+				context.Background(),
+				&domain.ExperimentHoldout{ExperimentHoldout: &proto.ExperimentHoldout{Id: "id-0", Weight: 5000}},
+				"ns0",
+			)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func newExperimentHoldoutStorageWithMock(
+	t *testing.T,
+	mockController *gomock.Controller,
+) *experimentHoldoutStorage {
+	t.Helper()
+	return &experimentHoldoutStorage{mock.NewMockQueryExecer(mockController)}
+}
@@ -0,0 +1,225 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/experiment/domain"
+	v2es "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres/mock"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+)
+
+func TestNewExperimentLayerStorage(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	db := NewExperimentLayerStorage(mock.NewMockClient(mockController))
+	assert.IsType(t, &experimentLayerStorage{}, db)
+}
+
+func TestCreateExperimentLayer(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*experimentLayerStorage)
+		input       *domain.ExperimentLayer
+		expectedErr error
+	}{
+		{
+			desc: "err: duplicate entry",
+			setup: func(s *experimentLayerStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, postgres.ErrDuplicateEntry)
+			},
+			input:       &domain.ExperimentLayer{ExperimentLayer: &proto.ExperimentLayer{Id: "id-0"}},
+			expectedErr: v2es.ErrExperimentLayerAlreadyExists,
+		},
+		{
+			desc: "success",
+			setup: func(s *experimentLayerStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, nil)
+			},
+			input:       &domain.ExperimentLayer{ExperimentLayer: &proto.ExperimentLayer{Id: "id-1"}},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newExperimentLayerStorageWithMock(t, mockController)
+			p.setup(storage)
+			err := storage.CreateExperimentLayer(context.Background(), p.input, "ns0")
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestUpdateExperimentLayer(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*experimentLayerStorage)
+		expectedErr error
+	}{
+		{
+			desc: "err: unexpected affected rows",
+			setup: func(s *experimentLayerStorage) {
+				result := mock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(0), nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+			},
+			expectedErr: v2es.ErrExperimentLayerUnexpectedAffectedRows,
+		},
+		{
+			desc: "success",
+			setup: func(s *experimentLayerStorage) {
+				result := mock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(1), nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newExperimentLayerStorageWithMock(t, mockController)
+			p.setup(storage)
+			err := storage.UpdateExperimentLayer(
+				context.Background(),
+				&domain.ExperimentLayer{ExperimentLayer: &proto.ExperimentLayer{Id: "id-0"}},
+				"ns0",
+			)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestGetExperimentLayer(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*experimentLayerStorage)
+		expectedErr error
+	}{
+		{
+			desc: "err: not found",
+			setup: func(s *experimentLayerStorage) {
+				row := mock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(postgres.ErrNoRows)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+			},
+			expectedErr: v2es.ErrExperimentLayerNotFound,
+		},
+		{
+			desc: "success",
+			setup: func(s *experimentLayerStorage) {
+				row := mock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newExperimentLayerStorageWithMock(t, mockController)
+			p.setup(storage)
+			_, err := storage.GetExperimentLayer(context.Background(), "id-0", "ns0")
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestListExperimentLayers(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*experimentLayerStorage)
+		expected    []*proto.ExperimentLayer
+		expectedErr error
+	}{
+		{
+			desc: "err: query failed",
+			setup: func(s *experimentLayerStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errors.New("error"))
+			},
+			expected:    nil,
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "success",
+			setup: func(s *experimentLayerStorage) {
+				rows := mock.NewMockRows(mockController)
+				rows.EXPECT().Close().Return(nil)
+				rows.EXPECT().Next().Return(false)
+				rows.EXPECT().Err().Return(nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(rows, nil)
+			},
+			expected:    []*proto.ExperimentLayer{},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newExperimentLayerStorageWithMock(t, mockController)
+			p.setup(storage)
+			layers, err := storage.ListExperimentLayers(context.Background(), "ns0")
+			assert.Equal(t, p.expected, layers)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func newExperimentLayerStorageWithMock(
+	t *testing.T,
+	mockController *gomock.Controller,
+) *experimentLayerStorage {
+	t.Helper()
+	return &experimentLayerStorage{mock.NewMockQueryExecer(mockController)}
+}
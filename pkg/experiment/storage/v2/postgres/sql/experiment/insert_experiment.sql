@@ -18,8 +18,11 @@ INSERT INTO experiment (
     base_variation_id,
     status,
     maintainer,
+    guardrail_goals,
+    layer_slice,
     environment_id
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7, $8, $9, $10,
-    $11, $12, $13, $14, $15, $16, $17, $18, $19, $20
+    $11, $12, $13, $14, $15, $16, $17, $18, $19, $20,
+    $21, $22
 )
@@ -18,7 +18,8 @@ SET
     description = $15,
     base_variation_id = $16,
     maintainer = $17,
-    status = $18
+    status = $18,
+    layer_slice = $19
 WHERE
-    id = $19 AND
-    environment_id = $20
+    id = $20 AND
+    environment_id = $21
@@ -0,0 +1,8 @@
+SELECT
+    id,
+    weight,
+    updated_at
+FROM
+    experiment_holdout
+WHERE
+    environment_id = $1
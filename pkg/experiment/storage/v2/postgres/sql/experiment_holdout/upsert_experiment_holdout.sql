@@ -0,0 +1,11 @@
+INSERT INTO experiment_holdout (
+    environment_id,
+    id,
+    weight,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4
+) ON CONFLICT (environment_id) DO UPDATE SET
+    id = EXCLUDED.id,
+    weight = EXCLUDED.weight,
+    updated_at = EXCLUDED.updated_at
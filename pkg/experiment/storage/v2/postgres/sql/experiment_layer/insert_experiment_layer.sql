@@ -0,0 +1,11 @@
+INSERT INTO experiment_layer (
+    id,
+    name,
+    description,
+    deleted,
+    created_at,
+    updated_at,
+    environment_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
@@ -0,0 +1,10 @@
+UPDATE
+    experiment_layer
+SET
+    name = $1,
+    description = $2,
+    deleted = $3,
+    updated_at = $4
+WHERE
+    id = $5 AND
+    environment_id = $6
@@ -3,11 +3,14 @@ INSERT INTO goal (
     name,
     description,
     connection_type,
+    metric_type,
+    improvement_direction,
+    value_cap_percentile,
     archived,
     deleted,
     created_at,
     updated_at,
     environment_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
 )
@@ -3,10 +3,13 @@ UPDATE
 SET
     name = $1,
     description = $2,
-    archived = $3,
-    deleted = $4,
-    created_at = $5,
-    updated_at = $6
+    metric_type = $3,
+    improvement_direction = $4,
+    value_cap_percentile = $5,
+    archived = $6,
+    deleted = $7,
+    created_at = $8,
+    updated_at = $9
 WHERE
-    id = $7 AND
-    environment_id = $8
+    id = $10 AND
+    environment_id = $11
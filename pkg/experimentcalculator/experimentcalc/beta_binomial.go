@@ -0,0 +1,96 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experimentcalc
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// betaBinomialSample draws the posterior of the binomial model without Stan.
+// experiment.stan puts a uniform prior on each conversion rate, so the
+// posterior of variation i is Beta(x[i]+1, n[i]-x[i]+1) and is sampled
+// directly instead of running HMC.
+//
+// The draws are returned in the layout of the Stan fit: one data frame per
+// chain with the 1-based p.i, prob_best.i and prob_upper.i.j columns, so the
+// summaries are computed by the same code whichever sampler is used. Only the
+// prob_upper columns against the baseline are generated because they are the
+// only ones read. baselineIdx is 1-based like the Stan columns.
+// src seeds the sampling; pass nil to use the global RNG.
+func betaBinomialSample(
+	src rand.Source,
+	goalUc, evalUc []int64,
+	baselineIdx, chains, numSamples int,
+) []dataframe.DataFrame {
+	variationNum := len(goalUc)
+	posteriors := make([]distuv.Beta, variationNum)
+	for i := 0; i < variationNum; i++ {
+		posteriors[i] = distuv.Beta{
+			Alpha: float64(goalUc[i] + 1),
+			Beta:  float64(evalUc[i] - goalUc[i] + 1),
+			Src:   src,
+		}
+	}
+	samples := make([]dataframe.DataFrame, 0, chains)
+	for chain := 0; chain < chains; chain++ {
+		p := make([][]float64, variationNum)
+		for i := range p {
+			p[i] = make([]float64, numSamples)
+			for s := 0; s < numSamples; s++ {
+				p[i][s] = posteriors[i].Rand()
+			}
+		}
+		cols := make([]series.Series, 0, 3*variationNum)
+		for i := 0; i < variationNum; i++ {
+			cols = append(cols, series.New(p[i], series.Float, fmt.Sprintf("p.%d", i+1)))
+		}
+		for i := 0; i < variationNum; i++ {
+			cols = append(cols, series.New(probBest(p, i), series.Float, fmt.Sprintf("prob_best.%d", i+1)))
+		}
+		baseline := p[baselineIdx-1]
+		for i := 0; i < variationNum; i++ {
+			upper := make([]float64, numSamples)
+			for s := 0; s < numSamples; s++ {
+				if p[i][s] > baseline[s] {
+					upper[s] = 1
+				}
+			}
+			cols = append(cols, series.New(upper, series.Float, fmt.Sprintf("prob_upper.%d.%d", i+1, baselineIdx)))
+		}
+		samples = append(samples, dataframe.New(cols...))
+	}
+	return samples
+}
+
+// probBest mirrors the prob_best generated quantity of experiment.stan:
+// 1 for the draws where the variation is strictly greater than all the others.
+func probBest(p [][]float64, idx int) []float64 {
+	best := make([]float64, len(p[idx]))
+	for s := range best {
+		best[s] = 1
+		for j := range p {
+			if j != idx && p[j][s] >= p[idx][s] {
+				best[s] = 0
+				break
+			}
+		}
+	}
+	return best
+}
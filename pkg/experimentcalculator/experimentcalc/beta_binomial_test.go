@@ -0,0 +1,248 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experimentcalc
+
+import (
+	"context"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gonum.org/v1/gonum/integrate/quad"
+	"gonum.org/v1/gonum/stat/distuv"
+
+	"github.com/bucketeer-io/bucketeer/v2/proto/eventcounter"
+)
+
+type summaryRange struct {
+	min, max float64
+}
+
+// TestBetaBinomialSampleStanFixtures checks the beta-binomial sampler against
+// the httpstan fit of experiment.stan recorded for the same inputs
+// (see TestExperimentCalculatorBinomialModelSample).
+func TestBetaBinomialSampleStanFixtures(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	calculator := creatExperimentCalculator(mockController)
+
+	type expectedVariation struct {
+		cvrProbMean, cvrProbSd           summaryRange
+		cvrProbBestMean, cvrProbBestSd   summaryRange
+		beatBaselineMean, beatBaselineSd summaryRange
+	}
+	patterns := []struct {
+		desc        string
+		vids        []string
+		goalUc      []int64
+		evalUc      []int64
+		baselineIdx int
+		expected    map[string]expectedVariation
+	}{
+		{
+			desc:        "two variations",
+			vids:        []string{"vid1", "vid2"},
+			goalUc:      []int64{38, 51},
+			evalUc:      []int64{101, 99},
+			baselineIdx: 0,
+			expected: map[string]expectedVariation{
+				"vid1": {
+					cvrProbMean:      summaryRange{0.37, 0.38},
+					cvrProbSd:        summaryRange{0.045, 0.05},
+					cvrProbBestMean:  summaryRange{0.023, 0.026},
+					cvrProbBestSd:    summaryRange{0.15, 0.16},
+					beatBaselineMean: summaryRange{0, 0},
+					beatBaselineSd:   summaryRange{0, 0},
+				},
+				"vid2": {
+					cvrProbMean:      summaryRange{0.49, 0.52},
+					cvrProbSd:        summaryRange{0.045, 0.05},
+					cvrProbBestMean:  summaryRange{0.97, 0.98},
+					cvrProbBestSd:    summaryRange{0.15, 0.16},
+					beatBaselineMean: summaryRange{0.97, 0.98},
+					beatBaselineSd:   summaryRange{0.15, 0.16},
+				},
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			samples := betaBinomialSample(
+				rand.NewPCG(1, 2), p.goalUc, p.evalUc, p.baselineIdx+1, numOfChains, numOfSamples)
+			vrs := calculator.convertFitSamples(context.TODO(), samples, p.vids, p.baselineIdx+1)
+			for vid, expected := range p.expected {
+				vr := vrs[vid]
+				assertInRange(t, expected.cvrProbMean, vr.CvrProb.Mean, vid+" cvr_prob mean")
+				assertInRange(t, expected.cvrProbSd, vr.CvrProb.Sd, vid+" cvr_prob sd")
+				assertInRange(t, summaryRange{0.9, 1.1}, vr.CvrProb.Rhat, vid+" cvr_prob rhat")
+				assert.Len(t, vr.CvrProb.Histogram.Hist, 100)
+				assert.Len(t, vr.CvrProb.Histogram.Bins, 101)
+				assertInRange(t, expected.cvrProbBestMean, vr.CvrProbBest.Mean, vid+" cvr_prob_best mean")
+				assertInRange(t, expected.cvrProbBestSd, vr.CvrProbBest.Sd, vid+" cvr_prob_best sd")
+				assertInRange(t, summaryRange{0.9, 1.1}, vr.CvrProbBest.Rhat, vid+" cvr_prob_best rhat")
+				assertInRange(t, expected.beatBaselineMean, vr.CvrProbBeatBaseline.Mean, vid+" beat baseline mean")
+				assertInRange(t, expected.beatBaselineSd, vr.CvrProbBeatBaseline.Sd, vid+" beat baseline sd")
+				assert.Len(t, vr.CvrSamples, numOfChains*numOfSamples)
+			}
+		})
+	}
+}
+
+// TestBetaBinomialSampleAnalytic compares the Monte Carlo summaries with the
+// exact Beta posteriors: the moments and quantiles in closed form, and the
+// probabilities to be best and to beat the baseline by numerical integration.
+func TestBetaBinomialSampleAnalytic(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	calculator := creatExperimentCalculator(mockController)
+
+	vids := []string{"vid1", "vid2", "vid3"}
+	goalUc := []int64{120, 150, 135}
+	evalUc := []int64{1000, 1000, 1000}
+	baselineIdx := 0
+	samples := betaBinomialSample(rand.NewPCG(3, 4), goalUc, evalUc, baselineIdx+1, numOfChains, numOfSamples)
+	vrs := calculator.convertFitSamples(context.TODO(), samples, vids, baselineIdx+1)
+
+	posteriors := make([]distuv.Beta, len(vids))
+	for i := range vids {
+		posteriors[i] = distuv.Beta{Alpha: float64(goalUc[i] + 1), Beta: float64(evalUc[i] - goalUc[i] + 1)}
+	}
+	var probBestSum float64
+	for i, vid := range vids {
+		vr := vrs[vid]
+		post := posteriors[i]
+		assert.InDelta(t, post.Mean(), vr.CvrProb.Mean, 0.001, vid)
+		assert.InEpsilon(t, post.StdDev(), vr.CvrProb.Sd, 0.02, vid)
+		assert.InDelta(t, post.Quantile(0.5), vr.CvrProb.Median, 0.001, vid)
+		assert.InDelta(t, post.Quantile(0.025), vr.CvrProb.Percentile025, 0.001, vid)
+		assert.InDelta(t, post.Quantile(0.975), vr.CvrProb.Percentile975, 0.001, vid)
+
+		probBest := quad.Fixed(func(x float64) float64 {
+			v := post.Prob(x)
+			for j := range posteriors {
+				if j != i {
+					v *= posteriors[j].CDF(x)
+				}
+			}
+			return v
+		}, 0, 1, 2000, nil, 0)
+		assert.InDelta(t, probBest, vr.CvrProbBest.Mean, 0.005, vid)
+		probBestSum += vr.CvrProbBest.Mean
+
+		if i == baselineIdx {
+			assert.Equal(t, &eventcounter.DistributionSummary{}, vr.CvrProbBeatBaseline)
+			continue
+		}
+		beatBaseline := quad.Fixed(func(x float64) float64 {
+			return post.Prob(x) * posteriors[baselineIdx].CDF(x)
+		}, 0, 1, 2000, nil, 0)
+		assert.InDelta(t, beatBaseline, vr.CvrProbBeatBaseline.Mean, 0.005, vid)
+	}
+	assert.InDelta(t, 1.0, probBestSum, 1e-9)
+}
+
+func TestBetaBinomialSampleColumns(t *testing.T) {
+	t.Parallel()
+	samples := betaBinomialSample(rand.NewPCG(5, 6), []int64{1, 2}, []int64{10, 10}, 2, 3, 10)
+	require.Len(t, samples, 3)
+	for _, sample := range samples {
+		assert.Equal(t, 10, sample.Nrow())
+		assert.Equal(t, []string{
+			"p.1", "p.2",
+			"prob_best.1", "prob_best.2",
+			"prob_upper.1.2", "prob_upper.2.2",
+		}, sample.Names())
+		p1, p2 := sample.Col("p.1").Float(), sample.Col("p.2").Float()
+		upper := sample.Col("prob_upper.1.2").Float()
+		for s := range p1 {
+			assert.GreaterOrEqual(t, p1[s], 0.0)
+			assert.LessOrEqual(t, p1[s], 1.0)
+			if p1[s] > p2[s] {
+				assert.Equal(t, 1.0, upper[s])
+			} else {
+				assert.Equal(t, 0.0, upper[s])
+			}
+		}
+		assert.Equal(t, make([]float64, 10), sample.Col("prob_upper.2.2").Float())
+	}
+}
+
+func TestProbBest(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc     string
+		p        [][]float64
+		idx      int
+		expected []float64
+	}{
+		{
+			desc:     "single variation is always the best",
+			p:        [][]float64{{0.1, 0.2}},
+			idx:      0,
+			expected: []float64{1, 1},
+		},
+		{
+			desc:     "strictly greater than all the others",
+			p:        [][]float64{{0.3, 0.1, 0.5}, {0.2, 0.4, 0.5}, {0.1, 0.2, 0.4}},
+			idx:      0,
+			expected: []float64{1, 0, 0},
+		},
+		{
+			desc:     "ties are not the best",
+			p:        [][]float64{{0.3, 0.1, 0.5}, {0.2, 0.4, 0.5}, {0.1, 0.2, 0.4}},
+			idx:      1,
+			expected: []float64{0, 1, 0},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			assert.Equal(t, p.expected, probBest(p.p, p.idx))
+		})
+	}
+}
+
+func TestExperimentCalculatorBetaBinomialModelSample(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	// The httpstan server isn't running, so any call to it would fail.
+	calculator := creatExperimentCalculator(mockController, WithBinomialSampler(BinomialSamplerBetaBinomial))
+	vrs, err := calculator.binomialModelSample(
+		context.TODO(),
+		[]string{"vid1", "vid2"},
+		[]int64{38, 51},
+		[]int64{101, 99},
+		0,
+		nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, vrs, 2)
+
+	variationResults := []*eventcounter.VariationResult{vrs["vid1"], vrs["vid2"]}
+	calculator.calculateExpectedLoss(variationResults, false)
+	assert.Greater(t, variationResults[0].ExpectedLoss, variationResults[1].ExpectedLoss)
+	assert.InDelta(t, 0.0, variationResults[1].ExpectedLoss, 1.0)
+	assert.Greater(t, vrs["vid2"].CvrProbBeatBaseline.Mean, 0.95)
+}
+
+func assertInRange(t *testing.T, expected summaryRange, actual float64, msg string) {
+	t.Helper()
+	assert.GreaterOrEqual(t, actual, expected.min, msg)
+	assert.LessOrEqual(t, actual, expected.max, msg)
+}
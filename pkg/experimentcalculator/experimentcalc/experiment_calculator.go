@@ -20,6 +20,7 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	aoclient "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/client"
 	envclient "github.com/bucketeer-io/bucketeer/v2/pkg/environment/client"
 	ecclient "github.com/bucketeer-io/bucketeer/v2/pkg/eventcounter/client"
 	experimentclient "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/client"
@@ -49,18 +51,45 @@ var (
 )
 
 const (
-	day         = 24 * 60 * 60
-	numOfChains = 5
+	day          = 24 * 60 * 60
+	numOfChains  = 5
+	numOfSamples = 21000
 )
 
+// BinomialSampler selects how the conversion rate posteriors are sampled.
+type BinomialSampler string
+
+const (
+	// BinomialSamplerStan fits experiment.stan on the httpstan server.
+	BinomialSamplerStan BinomialSampler = "stan"
+	// BinomialSamplerBetaBinomial draws from the analytic Beta posteriors in process.
+	BinomialSamplerBetaBinomial BinomialSampler = "beta-binomial"
+)
+
+type options struct {
+	binomialSampler BinomialSampler
+}
+
+type Option func(*options)
+
+// WithBinomialSampler selects the sampler of the conversion rate posteriors.
+// The Stan sampler is used by default.
+func WithBinomialSampler(s BinomialSampler) Option {
+	return func(o *options) {
+		o.binomialSampler = s
+	}
+}
+
 type ExperimentCalculator struct {
-	httpStan    *stan.Stan
-	stanModelID string
+	httpStan        *stan.Stan
+	stanModelID     string
+	binomialSampler BinomialSampler
 
 	environmentClient       envclient.Client
 	eventCounterClient      ecclient.Client
 	experimentClient        experimentclient.Client
 	featureClient           featureclient.Client
+	autoOpsClient           aoclient.Client
 	experimentResultStorage v2es.ExperimentResultStorage
 	metrics                 metrics.Registerer
 
@@ -75,19 +104,29 @@ func NewExperimentCalculator(
 	eventCounterClient ecclient.Client,
 	experimentClient experimentclient.Client,
 	featureClient featureclient.Client,
+	autoOpsClient aoclient.Client,
 	experimentResultStorage v2es.ExperimentResultStorage,
 	metrics metrics.Registerer,
 	loc *time.Location,
 	logger *zap.Logger,
+	opts ...Option,
 ) *ExperimentCalculator {
+	options := options{
+		binomialSampler: BinomialSamplerStan,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
 	registerMetrics(metrics)
 	return &ExperimentCalculator{
 		httpStan:                httpStan,
 		stanModelID:             stanModelID,
+		binomialSampler:         options.binomialSampler,
 		environmentClient:       environmentClient,
 		eventCounterClient:      eventCounterClient,
 		experimentClient:        experimentClient,
 		featureClient:           featureClient,
+		autoOpsClient:           autoOpsClient,
 		experimentResultStorage: experimentResultStorage,
 		metrics:                 metrics,
 		location:                loc,
@@ -123,6 +162,9 @@ func (e ExperimentCalculator) Run(ctx context.Context, request *domain.Experimen
 		)
 		return err
 	}
+	if err := e.checkGuardrails(ctx, request.EnvironmentId, request.Experiment, experimentResult); err != nil {
+		return err
+	}
 	e.logger.Info("ExperimentCalculator calculated successfully",
 		log.FieldsFromIncomingContext(ctx).AddFields(
 			zap.String("environmentId", request.EnvironmentId),
@@ -148,7 +190,8 @@ func (e ExperimentCalculator) createExperimentResult(
 		variationIDs = append(variationIDs, variation.Id)
 	}
 	endAts := listEndAt(experiment.StartAt, experiment.StopAt, time.Now().In(e.location).Unix())
-	for _, goalID := range experiment.GoalIds {
+	for _, goalID := range resultGoalIDs(experiment) {
+		goal := e.getGoal(ctx, envNamespace, goalID)
 		goalResult := &eventcounter.GoalResult{
 			GoalId:  goalID,
 			Summary: &eventcounter.Summary{},
@@ -178,13 +221,14 @@ func (e ExperimentCalculator) createExperimentResult(
 				return nil, errFailedToGetEvalVariationCount
 			}
 			goalVc, goalErr := e.getGoalCount(ctx, &eventcounter.GetExperimentGoalCountRequest{
-				EnvironmentId:  envNamespace,
-				StartAt:        experiment.StartAt,
-				EndAt:          timestamp,
-				GoalId:         goalID,
-				FeatureId:      experiment.FeatureId,
-				FeatureVersion: experiment.FeatureVersion,
-				VariationIds:   variationIDs,
+				EnvironmentId:      envNamespace,
+				StartAt:            experiment.StartAt,
+				EndAt:              timestamp,
+				GoalId:             goalID,
+				FeatureId:          experiment.FeatureId,
+				FeatureVersion:     experiment.FeatureVersion,
+				VariationIds:       variationIDs,
+				ValueCapPercentile: goal.ValueCapPercentile,
 			})
 			if goalErr != nil {
 				e.logger.Error("ExperimentCalculator failed to get goal count",
@@ -196,7 +240,7 @@ func (e ExperimentCalculator) createExperimentResult(
 				)
 				return nil, errFailedToGetGoalEventCount
 			}
-			gr := e.calcGoalResult(ctx, evalVc, goalVc, experiment)
+			gr := e.calcGoalResult(ctx, evalVc, goalVc, experiment, goal)
 			gr.GoalId = goalID
 			e.appendVariationResult(ctx, timestamp, goalResult, gr.VariationResults)
 		}
@@ -209,7 +253,7 @@ func (e ExperimentCalculator) createExperimentResult(
 		}
 
 		// Calculate expected loss for each variation
-		e.calculateExpectedLoss(goalResult.VariationResults)
+		e.calculateExpectedLoss(goalResult.VariationResults, isCvrLowerBetter(goal))
 
 		// Clear CvrSamples to reduce database storage
 		for _, vr := range goalResult.VariationResults {
@@ -332,6 +376,42 @@ func buildGetFeatureRequestForSRM(
 	return req
 }
 
+// getGoal fetches the goal definition that selects the analysis model. The
+// lookup is best-effort: on failure the goal is analysed with the defaults
+// (a conversion goal where higher is better), which is how every goal was
+// analysed before goals carried a metric type.
+func (e ExperimentCalculator) getGoal(
+	ctx context.Context,
+	environmentID, goalID string,
+) *experiment.Goal {
+	resp, err := e.experimentClient.GetGoal(ctx, &experiment.GetGoalRequest{
+		Id:            goalID,
+		EnvironmentId: environmentID,
+	})
+	if err != nil || resp.Goal == nil {
+		e.logger.Warn("Failed to fetch goal, analysing it as a conversion goal",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.String("environmentId", environmentID),
+				zap.String("goalId", goalID),
+				zap.Error(err),
+			)...,
+		)
+		return &experiment.Goal{Id: goalID}
+	}
+	return resp.Goal
+}
+
+func isLowerBetter(goal *experiment.Goal) bool {
+	return goal.ImprovementDirection == experiment.Goal_LOWER_IS_BETTER
+}
+
+// isCvrLowerBetter reports whether a lower conversion rate is the improvement.
+// For value goals the direction applies to the value, so the conversion rate
+// keeps the default "higher is better" direction.
+func isCvrLowerBetter(goal *experiment.Goal) bool {
+	return isLowerBetter(goal) && goal.MetricType == experiment.Goal_CONVERSION
+}
+
 func (e ExperimentCalculator) getEvaluationCount(
 	ctx context.Context,
 	req *eventcounter.GetExperimentEvaluationCountRequest,
@@ -367,13 +447,16 @@ func (e ExperimentCalculator) calcGoalResult(
 	evalVariationCounts,
 	goalVariationCounts map[string]*eventcounter.VariationCount,
 	experiment *experiment.Experiment,
+	goal *experiment.Goal,
 ) *eventcounter.GoalResult {
 	goalResult := &eventcounter.GoalResult{}
 	length := len(goalVariationCounts)
 	vids := make([]string, 0, length)
 	goalUc, evalUc := make([]int64, 0, length), make([]int64, 0, length)
 	vrs := make(map[string]*eventcounter.VariationResult, length)
+	valueSizes := make([]int64, 0, length)
 	valueMeans, valueVars := make([]float64, 0, length), make([]float64, 0, length)
+	lowerIsBetter := isLowerBetter(goal)
 	baseLineIdx, loopIdx := 0, 0
 
 	for vid, goalVariationCount := range goalVariationCounts {
@@ -396,8 +479,12 @@ func (e ExperimentCalculator) calcGoalResult(
 		}
 		evalUc = append(evalUc, evalVariationCount.UserCount)
 		goalUc = append(goalUc, goalVariationCount.UserCount)
-		valueMeans = append(valueMeans, goalVariationCount.ValueSumPerUserMean)
-		valueVars = append(valueVars, goalVariationCount.ValueSumPerUserVariance)
+		// The value model is chosen by the goal's metric type; see
+		// valueMetricStats for how each type maps to (n, mean, variance).
+		valueN, valueMean, valueVar := valueMetricStats(goal.MetricType, evalVariationCount, goalVariationCount)
+		valueSizes = append(valueSizes, valueN)
+		valueMeans = append(valueMeans, valueMean)
+		valueVars = append(valueVars, valueVar)
 
 		goalResult.VariationResults = append(goalResult.VariationResults, vr)
 		vrs[vid] = vr
@@ -447,20 +534,33 @@ func (e ExperimentCalculator) calcGoalResult(
 			copy(vrs[vid].CvrSamples, vr.CvrSamples)
 		}
 	}
+	if isCvrLowerBetter(goal) {
+		applyLowerIsBetterToCvr(vrs, vids, baseLineIdx)
+	}
+	if baseline := vrs[vids[baseLineIdx]]; len(baseline.CvrSamples) > 0 {
+		for i, vid := range vids {
+			if i != baseLineIdx && len(vrs[vid].CvrSamples) > 0 {
+				vrs[vid].CvrLift = createLift(vrs[vid].CvrSamples, baseline.CvrSamples)
+			}
+		}
+	}
 	// Skip the calculation if values are zero.
 	for i := 0; i < len(vids); i++ {
-		if goalUc[i] == 0 || valueMeans[i] == 0 || valueVars[i] == 0 {
+		if valueSizes[i] == 0 || valueMeans[i] == 0 || valueVars[i] == 0 {
 			calculationExceptionCounter.WithLabelValues(valuesAreZero).Inc()
 			// skip it if the value is zero
 			return goalResult
 		}
 	}
 	// nil src uses the global RNG; tests inject a seeded source for determinism.
-	valueResult := normalInverseGamma(nil, vids, valueMeans, valueVars, goalUc, baseLineIdx, 25000)
+	valueResult := normalInverseGamma(nil, vids, valueMeans, valueVars, valueSizes, baseLineIdx, 25000, lowerIsBetter)
 	for vid, vr := range valueResult {
 		vrs[vid].GoalValueSumPerUserProb = copyDistributionSummary(vr.GoalValueSumPerUserProb)
 		vrs[vid].GoalValueSumPerUserProbBest = copyDistributionSummary(vr.GoalValueSumPerUserProbBest)
 		vrs[vid].GoalValueSumPerUserProbBeatBaseline = copyDistributionSummary(vr.GoalValueSumPerUserProbBeatBaseline)
+		if vr.GoalValueLift != nil {
+			vrs[vid].GoalValueLift = copyDistributionSummary(vr.GoalValueLift)
+		}
 	}
 	calculationCounter.WithLabelValues(calculationSuccess).Inc()
 	return goalResult
@@ -501,6 +601,8 @@ func (e ExperimentCalculator) appendVariationResult(
 			copyDistributionSummary(variationResult.GoalValueSumPerUserProbBest)
 		goalResult.VariationResults[i].GoalValueSumPerUserProbBeatBaseline =
 			copyDistributionSummary(variationResult.GoalValueSumPerUserProbBeatBaseline)
+		goalResult.VariationResults[i].CvrLift = copyDistributionSummary(variationResult.CvrLift)
+		goalResult.VariationResults[i].GoalValueLift = copyDistributionSummary(variationResult.GoalValueLift)
 
 		// Append to EvaluationUserCountTimeseries
 		goalResult.VariationResults[i].EvaluationUserCountTimeseries = appendToTimeseries(
@@ -636,6 +738,37 @@ func (e ExperimentCalculator) binomialModelSample(
 	goalUc, evalUc []int64,
 	baseLineIdx int,
 	experiment *experiment.Experiment,
+) (map[string]*eventcounter.VariationResult, error) {
+	if e.binomialSampler == BinomialSamplerBetaBinomial {
+		return e.betaBinomialModelSample(ctx, vids, goalUc, evalUc, baseLineIdx), nil
+	}
+	return e.stanModelSample(ctx, vids, goalUc, evalUc, baseLineIdx, experiment)
+}
+
+// betaBinomialModelSample samples the binomial model in process.
+// It draws as many samples as the Stan fit so the summaries have the same precision.
+func (e ExperimentCalculator) betaBinomialModelSample(
+	ctx context.Context,
+	vids []string,
+	goalUc, evalUc []int64,
+	baseLineIdx int,
+) map[string]*eventcounter.VariationResult {
+	startTime := time.Now()
+	// The Stan columns are 1-based.
+	baseLineIdx++
+	// nil src uses the global RNG; tests inject a seeded source for determinism.
+	samples := betaBinomialSample(nil, goalUc, evalUc, baseLineIdx, numOfChains, numOfSamples)
+	variationResults := e.convertFitSamples(ctx, samples, vids, baseLineIdx)
+	calculationHistogram.WithLabelValues(betaBinomialSampleMethod).Observe(time.Since(startTime).Seconds())
+	return variationResults
+}
+
+func (e ExperimentCalculator) stanModelSample(
+	ctx context.Context,
+	vids []string,
+	goalUc, evalUc []int64,
+	baseLineIdx int,
+	experiment *experiment.Experiment,
 ) (map[string]*eventcounter.VariationResult, error) {
 	// The index starts from 1 in PyStan.
 	startTime := time.Now()
@@ -654,7 +787,7 @@ func (e ExperimentCalculator) binomialModelSample(
 					"n": evalUc,
 				},
 				Function:   stan.HmcNUTSFunction,
-				NumSamples: 21000,
+				NumSamples: numOfSamples,
 				NumWarmup:  1000,
 				RandomSeed: 1234,
 			}
@@ -839,14 +972,16 @@ func getVariationResult(vcs []*eventcounter.VariationResult, id string) *eventco
 
 func copyVariationCount(from *eventcounter.VariationCount) *eventcounter.VariationCount {
 	return &eventcounter.VariationCount{
-		VariationId:             from.VariationId,
-		UserCount:               from.UserCount,
-		EventCount:              from.EventCount,
-		ValueSum:                from.ValueSum,
-		CreatedAt:               from.CreatedAt,
-		VariationValue:          from.VariationValue,
-		ValueSumPerUserMean:     from.ValueSumPerUserMean,
-		ValueSumPerUserVariance: from.ValueSumPerUserVariance,
+		VariationId:                  from.VariationId,
+		UserCount:                    from.UserCount,
+		EventCount:                   from.EventCount,
+		ValueSum:                     from.ValueSum,
+		CreatedAt:                    from.CreatedAt,
+		VariationValue:               from.VariationValue,
+		ValueSumPerUserMean:          from.ValueSumPerUserMean,
+		ValueSumPerUserVariance:      from.ValueSumPerUserVariance,
+		EventCountPerUserVariance:    from.EventCountPerUserVariance,
+		ValueSumEventCountCovariance: from.ValueSumEventCountCovariance,
 	}
 
 }
@@ -989,8 +1124,12 @@ func pickBestVariations(
 }
 
 // calculateExpectedLoss computes the posterior expected loss (regret) for each variation
-// using a Monte Carlo approach over raw CVR samples (vr.CvrSamples).
-func (e ExperimentCalculator) calculateExpectedLoss(variationResults []*eventcounter.VariationResult) {
+// using a Monte Carlo approach over raw CVR samples (vr.CvrSamples). When lowerIsBetter
+// is set, the best draw is the lowest CVR and the regret is how far a variation is above it.
+func (e ExperimentCalculator) calculateExpectedLoss(
+	variationResults []*eventcounter.VariationResult,
+	lowerIsBetter bool,
+) {
 	if len(variationResults) == 0 {
 		return
 	}
@@ -1024,13 +1163,13 @@ func (e ExperimentCalculator) calculateExpectedLoss(variationResults []*eventcou
 		// find best CVR in this draw
 		best := variationResults[0].CvrSamples[t]
 		for _, vr := range variationResults[1:] {
-			if s := vr.CvrSamples[t]; s > best {
+			if s := vr.CvrSamples[t]; beats(s, best, lowerIsBetter) {
 				best = s
 			}
 		}
-		// accumulate (best - this) for each variation
+		// accumulate |best - this| for each variation
 		for _, vr := range variationResults {
-			regretSum[vr.VariationId] += best - vr.CvrSamples[t]
+			regretSum[vr.VariationId] += math.Abs(best - vr.CvrSamples[t])
 		}
 	}
 
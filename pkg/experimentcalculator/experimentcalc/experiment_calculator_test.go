@@ -22,11 +22,13 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	aoclient "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/client/mock"
 	envclient "github.com/bucketeer-io/bucketeer/v2/pkg/environment/client/mock"
 	ecclient "github.com/bucketeer-io/bucketeer/v2/pkg/eventcounter/client/mock"
 	experimentclient "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/client/mock"
@@ -47,7 +49,7 @@ const (
 	stanModelID = "y3qsnd7m"
 )
 
-func creatExperimentCalculator(mockController *gomock.Controller) *ExperimentCalculator {
+func creatExperimentCalculator(mockController *gomock.Controller, opts ...Option) *ExperimentCalculator {
 	registerer := metricsmock.NewMockRegisterer(mockController)
 	registerer.EXPECT().MustRegister(gomock.Any()).Return().AnyTimes() // Allow any number of calls for metrics registration
 	return NewExperimentCalculator(
@@ -57,10 +59,12 @@ func creatExperimentCalculator(mockController *gomock.Controller) *ExperimentCal
 		ecclient.NewMockClient(mockController),
 		experimentclient.NewMockClient(mockController),
 		featureclient.NewMockClient(mockController),
+		aoclient.NewMockClient(mockController),
 		storagemock.NewMockExperimentResultStorage(mockController),
 		registerer,
 		jpLocation,
 		zap.NewNop(),
+		opts...,
 	)
 }
 
@@ -178,6 +182,7 @@ func TestCalculateExpectedLoss(t *testing.T) {
 	tests := []struct {
 		name           string
 		variations     []*eventcounter.VariationResult
+		lowerIsBetter  bool
 		expectModified bool               // whether we expect the variations to be modified
 		expected       map[string]float64 // expected expectedLoss values by variation ID
 	}{
@@ -199,6 +204,25 @@ func TestCalculateExpectedLoss(t *testing.T) {
 				"var2": ((0.2 - 0.2) + (0.2 - 0.1) + (0.4 - 0.4)) * 100 / 3, // (0 + 0.1 + 0) * 100 / 3 = 3.33
 			},
 		},
+		{
+			name: "lower is better",
+			variations: []*eventcounter.VariationResult{
+				{
+					VariationId: "var1",
+					CvrSamples:  []float64{0.1, 0.2, 0.3},
+				},
+				{
+					VariationId: "var2",
+					CvrSamples:  []float64{0.2, 0.1, 0.4},
+				},
+			},
+			lowerIsBetter:  true,
+			expectModified: true,
+			expected: map[string]float64{
+				"var1": ((0.1 - 0.1) + (0.2 - 0.1) + (0.3 - 0.3)) * 100 / 3, // (0 + 0.1 + 0) * 100 / 3 = 3.33
+				"var2": ((0.2 - 0.1) + (0.1 - 0.1) + (0.4 - 0.3)) * 100 / 3, // (0.1 + 0 + 0.1) * 100 / 3 = 6.67
+			},
+		},
 		{
 			name: "three variations with ties",
 			variations: []*eventcounter.VariationResult{
@@ -296,7 +320,7 @@ func TestCalculateExpectedLoss(t *testing.T) {
 			initialValues := saveInitialValues(tt.variations)
 
 			// Run the calculation
-			calculator.calculateExpectedLoss(tt.variations)
+			calculator.calculateExpectedLoss(tt.variations, tt.lowerIsBetter)
 
 			// Check expected modifications
 			for _, variation := range tt.variations {
@@ -356,7 +380,7 @@ func TestExpectedLossWithBinomialModelSamples(t *testing.T) {
 	assert.True(t, len(variationResults[1].CvrSamples) > 0, "vid2 should have CVR samples")
 
 	// Calculate expected loss
-	experimentCalculator.calculateExpectedLoss(variationResults)
+	experimentCalculator.calculateExpectedLoss(variationResults, false)
 
 	// Verify expected loss calculation
 	// Since vid2 has higher conversion rate (around 0.5) than vid1 (around 0.37),
@@ -499,6 +523,7 @@ func TestComputeExperimentSRM_FeatureFetchFailure_DoesNotLeakError(t *testing.T)
 		ecclient.NewMockClient(mockController),
 		experimentclient.NewMockClient(mockController),
 		mockFeatureClient,
+		aoclient.NewMockClient(mockController),
 		storagemock.NewMockExperimentResultStorage(mockController),
 		registerer,
 		jpLocation,
@@ -725,3 +750,127 @@ func TestCalculateSummary_SafeToStopFlags(t *testing.T) {
 			"CVR BF=12 < 20: safe_to_stop must be false even though prob=0.97")
 	})
 }
+
+func TestGetGoal(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc     string
+		setup    func(*ExperimentCalculator)
+		expected *experimentproto.Goal
+	}{
+		{
+			desc: "success",
+			setup: func(c *ExperimentCalculator) {
+				c.experimentClient.(*experimentclient.MockClient).EXPECT().GetGoal(
+					gomock.Any(),
+					&experimentproto.GetGoalRequest{Id: "goal-1", EnvironmentId: "env-1"},
+				).Return(&experimentproto.GetGoalResponse{
+					Goal: &experimentproto.Goal{
+						Id:                   "goal-1",
+						MetricType:           experimentproto.Goal_SUM,
+						ImprovementDirection: experimentproto.Goal_LOWER_IS_BETTER,
+					},
+				}, nil)
+			},
+			expected: &experimentproto.Goal{
+				Id:                   "goal-1",
+				MetricType:           experimentproto.Goal_SUM,
+				ImprovementDirection: experimentproto.Goal_LOWER_IS_BETTER,
+			},
+		},
+		{
+			desc: "fetch failure falls back to a conversion goal",
+			setup: func(c *ExperimentCalculator) {
+				c.experimentClient.(*experimentclient.MockClient).EXPECT().GetGoal(
+					gomock.Any(), gomock.Any(),
+				).Return(nil, status.Error(codes.Unavailable, "unavailable"))
+			},
+			expected: &experimentproto.Goal{Id: "goal-1"},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			calc := creatExperimentCalculator(mockController)
+			p.setup(calc)
+			goal := calc.getGoal(context.Background(), "env-1", "goal-1")
+			assert.Equal(t, p.expected.Id, goal.Id)
+			assert.Equal(t, p.expected.MetricType, goal.MetricType)
+			assert.Equal(t, p.expected.ImprovementDirection, goal.ImprovementDirection)
+		})
+	}
+}
+
+func TestCalcGoalResultLowerIsBetter(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	calc := creatExperimentCalculator(mockController, WithBinomialSampler(BinomialSamplerBetaBinomial))
+	exp := &experimentproto.Experiment{BaseVariationId: "baseline"}
+	evalCounts := map[string]*eventcounter.VariationCount{
+		"baseline":  {VariationId: "baseline", UserCount: 1000, EventCount: 1000},
+		"treatment": {VariationId: "treatment", UserCount: 1000, EventCount: 1000},
+	}
+	// The treatment converts more users, and their value (e.g. latency) is lower.
+	goalCounts := map[string]*eventcounter.VariationCount{
+		"baseline": {
+			VariationId:             "baseline",
+			UserCount:               100,
+			EventCount:              100,
+			ValueSumPerUserMean:     200,
+			ValueSumPerUserVariance: 400,
+		},
+		"treatment": {
+			VariationId:             "treatment",
+			UserCount:               300,
+			EventCount:              300,
+			ValueSumPerUserMean:     100,
+			ValueSumPerUserVariance: 400,
+		},
+	}
+	patterns := []struct {
+		desc                    string
+		metricType              experimentproto.Goal_MetricType
+		expectedTreatmentCvrWin bool
+	}{
+		{
+			desc:                    "conversion: the lower conversion rate is the improvement",
+			metricType:              experimentproto.Goal_CONVERSION,
+			expectedTreatmentCvrWin: false,
+		},
+		{
+			desc:                    "mean: the direction applies to the value only",
+			metricType:              experimentproto.Goal_MEAN,
+			expectedTreatmentCvrWin: true,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			goal := &experimentproto.Goal{
+				Id:                   "goal-1",
+				MetricType:           p.metricType,
+				ImprovementDirection: experimentproto.Goal_LOWER_IS_BETTER,
+			}
+			gr := calc.calcGoalResult(context.Background(), evalCounts, goalCounts, exp, goal)
+			vrs := make(map[string]*eventcounter.VariationResult, len(gr.VariationResults))
+			for _, vr := range gr.VariationResults {
+				vrs[vr.VariationId] = vr
+			}
+			require.Len(t, vrs, 2)
+			treatment := vrs["treatment"]
+			if p.expectedTreatmentCvrWin {
+				assert.Greater(t, treatment.CvrProbBest.Mean, 0.99)
+				assert.Greater(t, treatment.CvrProbBeatBaseline.Mean, 0.99)
+			} else {
+				assert.Less(t, treatment.CvrProbBest.Mean, 0.01)
+				assert.Less(t, treatment.CvrProbBeatBaseline.Mean, 0.01)
+			}
+			// The lower value wins for both metric types.
+			assert.Greater(t, treatment.GoalValueSumPerUserProbBest.Mean, 0.99)
+			assert.Greater(t, treatment.GoalValueSumPerUserProbBeatBaseline.Mean, 0.99)
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experimentcalc
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/stat"
+
+	"github.com/bucketeer-io/bucketeer/v2/proto/eventcounter"
+	"github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+)
+
+// valueMetricStats reduces the goal and evaluation counts of one variation to
+// the sufficient statistics (n, mean, sample variance) of the goal's value
+// metric, so that every metric type can share the Normal-Inverse-Gamma model:
+//
+//   - CONVERSION, MEAN: value per converting user. This is what the DWH query
+//     aggregates directly, and what conversion goals have always reported.
+//   - SUM: value per evaluated user. Evaluated users who never fired the goal
+//     contribute 0, so the mean and variance over the n_e evaluated users
+//     are rebuilt from the n_g converters' moments:
+//     Σx = n_g·m, Σx² = (n_g-1)·s² + n_g·m², var = (Σx² - n_e·mean²)/(n_e-1).
+//   - RATIO: value per goal event, R = mean(value)/mean(events) over users.
+//     The per-user ratio is not observed, so the delta method gives the
+//     variance of R (Deng, Knoblich & Lu, Applying the Delta Method in Metric
+//     Analytics, KDD 2018): Var(R) ≈ (s_x² - 2R·s_xy + R²·s_y²) / (n·μ_y²).
+//     It is returned as a per-user variance (n·Var(R)) so the NIG update
+//     recovers the same sampling variance.
+//
+// A zero n means the metric is undefined for the variation.
+func valueMetricStats(
+	metricType experiment.Goal_MetricType,
+	evalCount, goalCount *eventcounter.VariationCount,
+) (n int64, mean, variance float64) {
+	goalN := goalCount.UserCount
+	goalMean := goalCount.ValueSumPerUserMean
+	goalVar := goalCount.ValueSumPerUserVariance
+	switch metricType {
+	case experiment.Goal_SUM:
+		evalN := evalCount.UserCount
+		if evalN == 0 {
+			return 0, 0, 0
+		}
+		sumX := float64(goalN) * goalMean
+		sumX2 := float64(goalN) * goalMean * goalMean
+		if goalN > 1 {
+			sumX2 += float64(goalN-1) * goalVar
+		}
+		mean = sumX / float64(evalN)
+		if evalN > 1 {
+			variance = math.Max(0, (sumX2-float64(evalN)*mean*mean)/float64(evalN-1))
+		}
+		return evalN, mean, variance
+	case experiment.Goal_RATIO:
+		if goalN == 0 || goalCount.EventCount == 0 {
+			return 0, 0, 0
+		}
+		eventMean := float64(goalCount.EventCount) / float64(goalN)
+		ratio := goalMean / eventMean
+		linearized := goalVar -
+			2*ratio*goalCount.ValueSumEventCountCovariance +
+			ratio*ratio*goalCount.EventCountPerUserVariance
+		return goalN, ratio, math.Max(0, linearized/(eventMean*eventMean))
+	default:
+		return goalN, goalMean, goalVar
+	}
+}
+
+// beats reports whether sample is an improvement over baseline in the goal's
+// direction. Ties are never an improvement.
+func beats(sample, baseline float64, lowerIsBetter bool) bool {
+	if lowerIsBetter {
+		return sample < baseline
+	}
+	return sample > baseline
+}
+
+// applyLowerIsBetterToCvr recomputes the CVR probability to be best and to
+// beat the baseline from the raw posterior draws when a lower conversion rate
+// is the improvement (e.g. an error or churn goal). The Stan model only emits
+// the "higher is better" indicators, so they cannot be reused. The Rhat of the
+// original indicators is kept as the convergence diagnostic of the same draws.
+func applyLowerIsBetterToCvr(
+	vrs map[string]*eventcounter.VariationResult,
+	vids []string,
+	baselineIdx int,
+) {
+	draws := make([][]float64, 0, len(vids))
+	for _, vid := range vids {
+		vr := vrs[vid]
+		if vr == nil || len(vr.CvrSamples) == 0 {
+			return
+		}
+		if len(draws) > 0 && len(vr.CvrSamples) != len(draws[0]) {
+			return
+		}
+		draws = append(draws, vr.CvrSamples)
+	}
+	numDraws := len(draws[0])
+	best := make([][]float64, len(vids))
+	beat := make([][]float64, len(vids))
+	for i := range vids {
+		best[i] = make([]float64, numDraws)
+		beat[i] = make([]float64, numDraws)
+	}
+	for t := 0; t < numDraws; t++ {
+		bestIdx := 0
+		for i := 1; i < len(vids); i++ {
+			if draws[i][t] < draws[bestIdx][t] {
+				bestIdx = i
+			}
+		}
+		best[bestIdx][t] = 1
+		for i := range vids {
+			if i != baselineIdx && beats(draws[i][t], draws[baselineIdx][t], true) {
+				beat[i][t] = 1
+			}
+		}
+	}
+	for i, vid := range vids {
+		vr := vrs[vid]
+		mean, sd := stat.MeanStdDev(best[i], nil)
+		vr.CvrProbBest = &eventcounter.DistributionSummary{
+			Mean: mean,
+			Sd:   sd,
+			Rhat: vr.GetCvrProbBest().GetRhat(),
+		}
+		if i == baselineIdx {
+			continue
+		}
+		mean, sd = stat.MeanStdDev(beat[i], nil)
+		vr.CvrProbBeatBaseline = &eventcounter.DistributionSummary{
+			Mean: mean,
+			Sd:   sd,
+			Rhat: vr.GetCvrProbBeatBaseline().GetRhat(),
+		}
+	}
+}
+
+// createLift summarizes the posterior of the relative lift over the baseline,
+// (x - baseline) / |baseline|, drawn pairwise from the joint posterior samples.
+// Draws where the baseline is exactly 0 have no defined lift and are skipped.
+// The 2.5 and 97.5 percentiles form the 95% credible interval.
+func createLift(samples, baselineSamples []float64) *eventcounter.DistributionSummary {
+	n := len(samples)
+	if len(baselineSamples) < n {
+		n = len(baselineSamples)
+	}
+	lifts := make([]float64, 0, n)
+	for i := 0; i < n; i++ {
+		if baselineSamples[i] == 0 {
+			continue
+		}
+		lifts = append(lifts, (samples[i]-baselineSamples[i])/math.Abs(baselineSamples[i]))
+	}
+	if len(lifts) == 0 {
+		return &eventcounter.DistributionSummary{}
+	}
+	sort.Float64s(lifts)
+	mean, sd := stat.MeanStdDev(lifts, nil)
+	return &eventcounter.DistributionSummary{
+		Mean:          mean,
+		Sd:            sd,
+		Median:        stat.Quantile(0.5, stat.LinInterp, lifts, nil),
+		Percentile025: stat.Quantile(0.025, stat.LinInterp, lifts, nil),
+		Percentile975: stat.Quantile(0.975, stat.LinInterp, lifts, nil),
+	}
+}
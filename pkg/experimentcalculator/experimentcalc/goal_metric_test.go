@@ -0,0 +1,214 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experimentcalc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bucketeer-io/bucketeer/v2/proto/eventcounter"
+	"github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+)
+
+func TestValueMetricStats(t *testing.T) {
+	t.Parallel()
+	// Two converting users with values 3 and 5 (mean 4, variance 2) out of
+	// four evaluated users.
+	evalCount := &eventcounter.VariationCount{UserCount: 4}
+	goalCount := &eventcounter.VariationCount{
+		UserCount:               2,
+		EventCount:              2,
+		ValueSumPerUserMean:     4,
+		ValueSumPerUserVariance: 2,
+	}
+	patterns := []struct {
+		desc             string
+		metricType       experiment.Goal_MetricType
+		evalCount        *eventcounter.VariationCount
+		goalCount        *eventcounter.VariationCount
+		expectedN        int64
+		expectedMean     float64
+		expectedVariance float64
+	}{
+		{
+			desc:             "conversion: value per converting user",
+			metricType:       experiment.Goal_CONVERSION,
+			evalCount:        evalCount,
+			goalCount:        goalCount,
+			expectedN:        2,
+			expectedMean:     4,
+			expectedVariance: 2,
+		},
+		{
+			desc:             "mean: value per converting user",
+			metricType:       experiment.Goal_MEAN,
+			evalCount:        evalCount,
+			goalCount:        goalCount,
+			expectedN:        2,
+			expectedMean:     4,
+			expectedVariance: 2,
+		},
+		{
+			// Per evaluated user the values are [3, 5, 0, 0].
+			desc:             "sum: value per evaluated user",
+			metricType:       experiment.Goal_SUM,
+			evalCount:        evalCount,
+			goalCount:        goalCount,
+			expectedN:        4,
+			expectedMean:     2,
+			expectedVariance: 6,
+		},
+		{
+			desc:       "sum: no evaluated users",
+			metricType: experiment.Goal_SUM,
+			evalCount:  &eventcounter.VariationCount{},
+			goalCount:  goalCount,
+		},
+		{
+			// Users (value, events): (10, 1) and (20, 1).
+			desc:       "ratio: value per event",
+			metricType: experiment.Goal_RATIO,
+			evalCount:  evalCount,
+			goalCount: &eventcounter.VariationCount{
+				UserCount:               2,
+				EventCount:              2,
+				ValueSumPerUserMean:     15,
+				ValueSumPerUserVariance: 50,
+			},
+			expectedN:        2,
+			expectedMean:     15,
+			expectedVariance: 50,
+		},
+		{
+			// Users (value, events): (10, 1) and (30, 3). Both spend exactly
+			// 10 per event, so the linearized variance vanishes.
+			desc:       "ratio: covariance cancels the variance",
+			metricType: experiment.Goal_RATIO,
+			evalCount:  evalCount,
+			goalCount: &eventcounter.VariationCount{
+				UserCount:                    2,
+				EventCount:                   4,
+				ValueSumPerUserMean:          20,
+				ValueSumPerUserVariance:      200,
+				EventCountPerUserVariance:    2,
+				ValueSumEventCountCovariance: 20,
+			},
+			expectedN:        2,
+			expectedMean:     10,
+			expectedVariance: 0,
+		},
+		{
+			desc:       "ratio: no events",
+			metricType: experiment.Goal_RATIO,
+			evalCount:  evalCount,
+			goalCount:  &eventcounter.VariationCount{},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			n, mean, variance := valueMetricStats(p.metricType, p.evalCount, p.goalCount)
+			assert.Equal(t, p.expectedN, n)
+			assert.InDelta(t, p.expectedMean, mean, 1e-9)
+			assert.InDelta(t, p.expectedVariance, variance, 1e-9)
+		})
+	}
+}
+
+func TestCreateLift(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc           string
+		samples        []float64
+		baseline       []float64
+		expectedMean   float64
+		expectedMedian float64
+	}{
+		{
+			desc:           "relative lift per draw",
+			samples:        []float64{2, 3, 4},
+			baseline:       []float64{1, 1, 2},
+			expectedMean:   4.0 / 3.0,
+			expectedMedian: 1,
+		},
+		{
+			desc:           "zero baseline draws are skipped",
+			samples:        []float64{2, 3, 4},
+			baseline:       []float64{0, 2, 2},
+			expectedMean:   0.75,
+			expectedMedian: 0.5,
+		},
+		{
+			desc:     "no usable draws",
+			samples:  []float64{1},
+			baseline: []float64{0},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			lift := createLift(p.samples, p.baseline)
+			assert.InDelta(t, p.expectedMean, lift.Mean, 1e-9)
+			assert.InDelta(t, p.expectedMedian, lift.Median, 1e-9)
+			assert.LessOrEqual(t, lift.Percentile025, lift.Median)
+			assert.GreaterOrEqual(t, lift.Percentile975, lift.Median)
+		})
+	}
+}
+
+func TestApplyLowerIsBetterToCvr(t *testing.T) {
+	t.Parallel()
+	vids := []string{"baseline", "treatment"}
+	vrs := map[string]*eventcounter.VariationResult{
+		"baseline": {
+			VariationId:         "baseline",
+			CvrSamples:          []float64{0.2, 0.2, 0.2, 0.2},
+			CvrProbBest:         &eventcounter.DistributionSummary{Mean: 0.75, Rhat: 1.01},
+			CvrProbBeatBaseline: &eventcounter.DistributionSummary{},
+		},
+		"treatment": {
+			VariationId:         "treatment",
+			CvrSamples:          []float64{0.1, 0.3, 0.1, 0.1},
+			CvrProbBest:         &eventcounter.DistributionSummary{Mean: 0.25},
+			CvrProbBeatBaseline: &eventcounter.DistributionSummary{Mean: 0.25},
+		},
+	}
+	applyLowerIsBetterToCvr(vrs, vids, 0)
+
+	assert.InDelta(t, 0.25, vrs["baseline"].CvrProbBest.Mean, 1e-9)
+	assert.Equal(t, 1.01, vrs["baseline"].CvrProbBest.Rhat)
+	assert.Equal(t, 0.0, vrs["baseline"].CvrProbBeatBaseline.Mean)
+	assert.InDelta(t, 0.75, vrs["treatment"].CvrProbBest.Mean, 1e-9)
+	assert.InDelta(t, 0.75, vrs["treatment"].CvrProbBeatBaseline.Mean, 1e-9)
+}
+
+func TestApplyLowerIsBetterToCvr_InconsistentSamples(t *testing.T) {
+	t.Parallel()
+	vids := []string{"baseline", "treatment"}
+	vrs := map[string]*eventcounter.VariationResult{
+		"baseline": {
+			CvrSamples:  []float64{0.2, 0.2},
+			CvrProbBest: &eventcounter.DistributionSummary{Mean: 0.5},
+		},
+		"treatment": {
+			CvrSamples:  []float64{0.1},
+			CvrProbBest: &eventcounter.DistributionSummary{Mean: 0.5},
+		},
+	}
+	applyLowerIsBetterToCvr(vrs, vids, 0)
+
+	// Left untouched when the draws cannot be compared pairwise.
+	assert.Equal(t, 0.5, vrs["baseline"].CvrProbBest.Mean)
+	assert.Equal(t, 0.5, vrs["treatment"].CvrProbBest.Mean)
+}
@@ -0,0 +1,249 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experimentcalc
+
+import (
+	"context"
+	"math"
+
+	"go.uber.org/zap"
+
+	autoopsdomain "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/domain"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
+	"github.com/bucketeer-io/bucketeer/v2/proto/eventcounter"
+	"github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+)
+
+// minGuardrailUserCount is the number of evaluated users every compared
+// variation needs before a guardrail may halt, so that the noise of the first
+// few users cannot stop an experiment or a rollout.
+const minGuardrailUserCount = 100
+
+// ProbabilityWorse estimates in closed form the posterior probability that a
+// variation performs worse than the control on the goal, honoring the goal's
+// improvement direction. It is meant for watchers that cannot afford an MCMC
+// run on every check:
+//
+//   - CONVERSION goals compare the conversion rates, using Beta(1+x, 1+n-x)
+//     posteriors over the evaluated users.
+//   - Other metric types compare the goal's value metric (see
+//     valueMetricStats) through the sampling distribution of its mean.
+//
+// Both posteriors are approximated by normal distributions. Zero is returned
+// until both variations have minGuardrailUserCount evaluated users.
+func ProbabilityWorse(
+	goal *experiment.Goal,
+	controlEvalCount, controlGoalCount *eventcounter.VariationCount,
+	evalCount, goalCount *eventcounter.VariationCount,
+) float64 {
+	if controlEvalCount.GetUserCount() < minGuardrailUserCount ||
+		evalCount.GetUserCount() < minGuardrailUserCount {
+		return 0
+	}
+	var controlMean, controlVar, mean, variance float64
+	if goal.GetMetricType() == experiment.Goal_CONVERSION {
+		controlMean, controlVar = betaMeanVariance(controlGoalCount.GetUserCount(), controlEvalCount.GetUserCount())
+		mean, variance = betaMeanVariance(goalCount.GetUserCount(), evalCount.GetUserCount())
+	} else {
+		var ok bool
+		if controlMean, controlVar, ok = meanSamplingVariance(
+			goal.GetMetricType(), controlEvalCount, controlGoalCount,
+		); !ok {
+			return 0
+		}
+		if mean, variance, ok = meanSamplingVariance(goal.GetMetricType(), evalCount, goalCount); !ok {
+			return 0
+		}
+	}
+	sd := math.Sqrt(controlVar + variance)
+	if sd == 0 {
+		return 0
+	}
+	// P(variation < control) under the normal approximation of the difference.
+	probLower := 0.5 * math.Erfc(-(controlMean-mean)/sd/math.Sqrt2)
+	if isLowerBetter(goal) {
+		return 1 - probLower
+	}
+	return probLower
+}
+
+// betaMeanVariance returns the mean and variance of the Beta(1+x, 1+n-x)
+// posterior of a conversion rate.
+func betaMeanVariance(converted, total int64) (float64, float64) {
+	if converted > total {
+		converted = total
+	}
+	a := float64(1 + converted)
+	b := float64(1 + total - converted)
+	return a / (a + b), a * b / ((a + b) * (a + b) * (a + b + 1))
+}
+
+// meanSamplingVariance returns the mean of the goal's value metric and the
+// variance of that mean. It is not defined for fewer than two users.
+func meanSamplingVariance(
+	metricType experiment.Goal_MetricType,
+	evalCount, goalCount *eventcounter.VariationCount,
+) (float64, float64, bool) {
+	if evalCount == nil || goalCount == nil {
+		return 0, 0, false
+	}
+	n, mean, variance := valueMetricStats(metricType, evalCount, goalCount)
+	if n < 2 {
+		return 0, 0, false
+	}
+	return mean, variance / float64(n), true
+}
+
+// posteriorProbabilityWorse reads the probability that the variation is worse
+// than the baseline from the posterior summaries computed for the experiment.
+// The "beat baseline" probabilities already honor the goal's direction.
+func posteriorProbabilityWorse(
+	goal *experiment.Goal,
+	baseline, vr *eventcounter.VariationResult,
+) float64 {
+	if baseline.GetEvaluationCount().GetUserCount() < minGuardrailUserCount ||
+		vr.GetEvaluationCount().GetUserCount() < minGuardrailUserCount {
+		return 0
+	}
+	beat := vr.CvrProbBeatBaseline
+	if goal.GetMetricType() != experiment.Goal_CONVERSION {
+		beat = vr.GoalValueSumPerUserProbBeatBaseline
+	}
+	if beat == nil {
+		return 0
+	}
+	return 1 - beat.Mean
+}
+
+// checkGuardrails halts a running experiment as soon as one of its guardrail
+// goals shows a variation that is likely worse than the baseline. The flag is
+// switched back to the baseline through the auto operation service, so the
+// halt is audited like a kill switch, and the experiment is force stopped.
+func (e ExperimentCalculator) checkGuardrails(
+	ctx context.Context,
+	environmentID string,
+	exp *experiment.Experiment,
+	result *eventcounter.ExperimentResult,
+) error {
+	if exp.Status != experiment.Experiment_RUNNING {
+		return nil
+	}
+	for _, guardrail := range exp.GuardrailGoals {
+		goalResult := getGoalResult(result.GoalResults, guardrail.GoalId)
+		if goalResult == nil {
+			continue
+		}
+		baseline := getVariationResult(goalResult.VariationResults, exp.BaseVariationId)
+		if baseline == nil {
+			continue
+		}
+		goal := e.getGoal(ctx, environmentID, guardrail.GoalId)
+		for _, vr := range goalResult.VariationResults {
+			if vr.VariationId == exp.BaseVariationId {
+				continue
+			}
+			probWorse := posteriorProbabilityWorse(goal, baseline, vr)
+			if !autoopsdomain.GuardrailBreached(guardrail, probWorse) {
+				continue
+			}
+			return e.haltOnGuardrail(ctx, environmentID, exp, guardrail.GoalId, vr.VariationId, probWorse)
+		}
+	}
+	return nil
+}
+
+func (e ExperimentCalculator) haltOnGuardrail(
+	ctx context.Context,
+	environmentID string,
+	exp *experiment.Experiment,
+	goalID, variationID string,
+	probWorse float64,
+) error {
+	if _, err := e.autoOpsClient.ExecuteGuardrailHalt(ctx, &autoopsproto.ExecuteGuardrailHaltRequest{
+		EnvironmentId:      environmentID,
+		FeatureId:          exp.FeatureId,
+		GoalId:             goalID,
+		ControlVariationId: exp.BaseVariationId,
+		VariationId:        variationID,
+		ProbabilityWorse:   probWorse,
+		ExperimentId:       exp.Id,
+	}); err != nil {
+		e.logger.Error("ExperimentCalculator failed to halt the flag on a guardrail",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.String("environmentId", environmentID),
+				zap.String("experimentId", exp.Id),
+				zap.String("goalId", goalID),
+				zap.Error(err),
+			)...,
+		)
+		return err
+	}
+	if _, err := e.experimentClient.UpdateExperiment(ctx, &experiment.UpdateExperimentRequest{
+		Id:            exp.Id,
+		EnvironmentId: environmentID,
+		Status: &experiment.UpdateExperimentRequest_UpdatedStatus{
+			Status: experiment.Experiment_FORCE_STOPPED,
+		},
+	}); err != nil {
+		e.logger.Error("ExperimentCalculator failed to stop the experiment on a guardrail",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.String("environmentId", environmentID),
+				zap.String("experimentId", exp.Id),
+				zap.String("goalId", goalID),
+				zap.Error(err),
+			)...,
+		)
+		return err
+	}
+	e.logger.Info("ExperimentCalculator halted an experiment on a guardrail",
+		log.FieldsFromIncomingContext(ctx).AddFields(
+			zap.String("environmentId", environmentID),
+			zap.String("experimentId", exp.Id),
+			zap.String("goalId", goalID),
+			zap.String("variationId", variationID),
+			zap.Float64("probabilityWorse", probWorse),
+		)...,
+	)
+	return nil
+}
+
+func getGoalResult(grs []*eventcounter.GoalResult, goalID string) *eventcounter.GoalResult {
+	for _, gr := range grs {
+		if gr.GoalId == goalID {
+			return gr
+		}
+	}
+	return nil
+}
+
+// resultGoalIDs lists the goals the experiment result covers: the experiment
+// goals followed by the guardrail goals that are not experiment goals already.
+func resultGoalIDs(exp *experiment.Experiment) []string {
+	ids := append([]string{}, exp.GoalIds...)
+	for _, g := range exp.GuardrailGoals {
+		found := false
+		for _, id := range ids {
+			if id == g.GoalId {
+				found = true
+				break
+			}
+		}
+		if !found {
+			ids = append(ids, g.GoalId)
+		}
+	}
+	return ids
+}
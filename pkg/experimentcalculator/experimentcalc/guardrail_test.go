@@ -0,0 +1,334 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experimentcalc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	aoclient "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/client/mock"
+	experimentclient "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/client/mock"
+	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
+	"github.com/bucketeer-io/bucketeer/v2/proto/eventcounter"
+	"github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+)
+
+func TestProbabilityWorse(t *testing.T) {
+	t.Parallel()
+	users := func(n int64) *eventcounter.VariationCount {
+		return &eventcounter.VariationCount{UserCount: n}
+	}
+	values := func(n int64, mean, variance float64) *eventcounter.VariationCount {
+		return &eventcounter.VariationCount{
+			UserCount:               n,
+			EventCount:              n,
+			ValueSumPerUserMean:     mean,
+			ValueSumPerUserVariance: variance,
+		}
+	}
+	higherIsBetter := &experiment.Goal{MetricType: experiment.Goal_CONVERSION}
+	lowerIsBetter := &experiment.Goal{
+		MetricType:           experiment.Goal_CONVERSION,
+		ImprovementDirection: experiment.Goal_LOWER_IS_BETTER,
+	}
+	meanGoal := &experiment.Goal{MetricType: experiment.Goal_MEAN}
+	patterns := []struct {
+		desc             string
+		goal             *experiment.Goal
+		controlEvalCount *eventcounter.VariationCount
+		controlGoalCount *eventcounter.VariationCount
+		evalCount        *eventcounter.VariationCount
+		goalCount        *eventcounter.VariationCount
+		expectedMin      float64
+		expectedMax      float64
+	}{
+		{
+			desc:             "too few users",
+			goal:             higherIsBetter,
+			controlEvalCount: users(99),
+			controlGoalCount: users(50),
+			evalCount:        users(1000),
+			goalCount:        users(10),
+			expectedMin:      0,
+			expectedMax:      0,
+		},
+		{
+			desc:             "same conversion rate",
+			goal:             higherIsBetter,
+			controlEvalCount: users(1000),
+			controlGoalCount: users(100),
+			evalCount:        users(1000),
+			goalCount:        users(100),
+			expectedMin:      0.49,
+			expectedMax:      0.51,
+		},
+		{
+			desc:             "lower conversion rate is worse",
+			goal:             higherIsBetter,
+			controlEvalCount: users(1000),
+			controlGoalCount: users(150),
+			evalCount:        users(1000),
+			goalCount:        users(100),
+			expectedMin:      0.99,
+			expectedMax:      1,
+		},
+		{
+			desc:             "lower conversion rate is better",
+			goal:             lowerIsBetter,
+			controlEvalCount: users(1000),
+			controlGoalCount: users(150),
+			evalCount:        users(1000),
+			goalCount:        users(100),
+			expectedMin:      0,
+			expectedMax:      0.01,
+		},
+		{
+			desc:             "lower mean value is worse",
+			goal:             meanGoal,
+			controlEvalCount: users(1000),
+			controlGoalCount: values(500, 12, 4),
+			evalCount:        users(1000),
+			goalCount:        values(500, 10, 4),
+			expectedMin:      0.99,
+			expectedMax:      1,
+		},
+		{
+			desc:             "missing value counts",
+			goal:             meanGoal,
+			controlEvalCount: users(1000),
+			controlGoalCount: nil,
+			evalCount:        users(1000),
+			goalCount:        values(500, 10, 4),
+			expectedMin:      0,
+			expectedMax:      0,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			actual := ProbabilityWorse(p.goal, p.controlEvalCount, p.controlGoalCount, p.evalCount, p.goalCount)
+			assert.GreaterOrEqual(t, actual, p.expectedMin)
+			assert.LessOrEqual(t, actual, p.expectedMax)
+		})
+	}
+}
+
+func TestPosteriorProbabilityWorse(t *testing.T) {
+	t.Parallel()
+	result := func(users int64, cvrBeat, valueBeat *eventcounter.DistributionSummary) *eventcounter.VariationResult {
+		return &eventcounter.VariationResult{
+			EvaluationCount:                     &eventcounter.VariationCount{UserCount: users},
+			CvrProbBeatBaseline:                 cvrBeat,
+			GoalValueSumPerUserProbBeatBaseline: valueBeat,
+		}
+	}
+	patterns := []struct {
+		desc     string
+		goal     *experiment.Goal
+		baseline *eventcounter.VariationResult
+		vr       *eventcounter.VariationResult
+		expected float64
+	}{
+		{
+			desc:     "too few users",
+			goal:     &experiment.Goal{MetricType: experiment.Goal_CONVERSION},
+			baseline: result(50, nil, nil),
+			vr:       result(1000, &eventcounter.DistributionSummary{Mean: 0.1}, nil),
+			expected: 0,
+		},
+		{
+			desc:     "conversion goal",
+			goal:     &experiment.Goal{MetricType: experiment.Goal_CONVERSION},
+			baseline: result(1000, nil, nil),
+			vr: result(1000,
+				&eventcounter.DistributionSummary{Mean: 0.25},
+				&eventcounter.DistributionSummary{Mean: 0.75},
+			),
+			expected: 0.75,
+		},
+		{
+			desc:     "value goal",
+			goal:     &experiment.Goal{MetricType: experiment.Goal_SUM},
+			baseline: result(1000, nil, nil),
+			vr: result(1000,
+				&eventcounter.DistributionSummary{Mean: 0.25},
+				&eventcounter.DistributionSummary{Mean: 0.75},
+			),
+			expected: 0.25,
+		},
+		{
+			desc:     "not calculated",
+			goal:     &experiment.Goal{MetricType: experiment.Goal_SUM},
+			baseline: result(1000, nil, nil),
+			vr:       result(1000, nil, nil),
+			expected: 0,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			assert.InDelta(t, p.expected, posteriorProbabilityWorse(p.goal, p.baseline, p.vr), 1e-9)
+		})
+	}
+}
+
+func TestCheckGuardrails(t *testing.T) {
+	t.Parallel()
+	newExperiment := func(status experiment.Experiment_Status) *experiment.Experiment {
+		return &experiment.Experiment{
+			Id:              "eid",
+			FeatureId:       "fid",
+			BaseVariationId: "vid1",
+			Status:          status,
+			GoalIds:         []string{"gid"},
+			GuardrailGoals: []*autoopsproto.GuardrailGoal{
+				{GoalId: "guardrail-gid", Threshold: 0.9},
+			},
+		}
+	}
+	newResult := func(beatBaseline float64) *eventcounter.ExperimentResult {
+		return &eventcounter.ExperimentResult{
+			GoalResults: []*eventcounter.GoalResult{
+				{
+					GoalId: "guardrail-gid",
+					VariationResults: []*eventcounter.VariationResult{
+						{
+							VariationId:     "vid1",
+							EvaluationCount: &eventcounter.VariationCount{UserCount: 1000},
+						},
+						{
+							VariationId:         "vid2",
+							EvaluationCount:     &eventcounter.VariationCount{UserCount: 1000},
+							CvrProbBeatBaseline: &eventcounter.DistributionSummary{Mean: beatBaseline},
+						},
+					},
+				},
+			},
+		}
+	}
+	internalErr := errors.New("internal")
+	patterns := []struct {
+		desc        string
+		setup       func(*experimentclient.MockClient, *aoclient.MockClient)
+		experiment  *experiment.Experiment
+		result      *eventcounter.ExperimentResult
+		expectedErr error
+	}{
+		{
+			desc:        "not running",
+			setup:       nil,
+			experiment:  newExperiment(experiment.Experiment_STOPPED),
+			result:      newResult(0.01),
+			expectedErr: nil,
+		},
+		{
+			desc: "not breached",
+			setup: func(ec *experimentclient.MockClient, _ *aoclient.MockClient) {
+				ec.EXPECT().GetGoal(gomock.Any(), gomock.Any()).Return(&experiment.GetGoalResponse{
+					Goal: &experiment.Goal{Id: "guardrail-gid"},
+				}, nil)
+			},
+			experiment:  newExperiment(experiment.Experiment_RUNNING),
+			result:      newResult(0.5),
+			expectedErr: nil,
+		},
+		{
+			desc: "err: failed to halt",
+			setup: func(ec *experimentclient.MockClient, ac *aoclient.MockClient) {
+				ec.EXPECT().GetGoal(gomock.Any(), gomock.Any()).Return(&experiment.GetGoalResponse{
+					Goal: &experiment.Goal{Id: "guardrail-gid"},
+				}, nil)
+				ac.EXPECT().ExecuteGuardrailHalt(gomock.Any(), gomock.Any()).Return(nil, internalErr)
+			},
+			experiment:  newExperiment(experiment.Experiment_RUNNING),
+			result:      newResult(0.01),
+			expectedErr: internalErr,
+		},
+		{
+			desc: "success: halted and stopped",
+			setup: func(ec *experimentclient.MockClient, ac *aoclient.MockClient) {
+				ec.EXPECT().GetGoal(gomock.Any(), gomock.Any()).Return(&experiment.GetGoalResponse{
+					Goal: &experiment.Goal{Id: "guardrail-gid"},
+				}, nil)
+				ac.EXPECT().ExecuteGuardrailHalt(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(
+						_ context.Context,
+						req *autoopsproto.ExecuteGuardrailHaltRequest,
+						_ ...any,
+					) (*autoopsproto.ExecuteGuardrailHaltResponse, error) {
+						assert.Equal(t, "ns0", req.EnvironmentId)
+						assert.Equal(t, "fid", req.FeatureId)
+						assert.Equal(t, "guardrail-gid", req.GoalId)
+						assert.Equal(t, "vid1", req.ControlVariationId)
+						assert.Equal(t, "vid2", req.VariationId)
+						assert.Equal(t, "eid", req.ExperimentId)
+						assert.InDelta(t, 0.99, req.ProbabilityWorse, 1e-9)
+						return &autoopsproto.ExecuteGuardrailHaltResponse{}, nil
+					},
+				)
+				ec.EXPECT().UpdateExperiment(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(
+						_ context.Context,
+						req *experiment.UpdateExperimentRequest,
+						_ ...any,
+					) (*experiment.UpdateExperimentResponse, error) {
+						assert.Equal(t, "eid", req.Id)
+						assert.Equal(t, experiment.Experiment_FORCE_STOPPED, req.Status.Status)
+						return &experiment.UpdateExperimentResponse{}, nil
+					},
+				)
+			},
+			experiment:  newExperiment(experiment.Experiment_RUNNING),
+			result:      newResult(0.01),
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			mockController := gomock.NewController(t)
+			defer mockController.Finish()
+			ec := experimentclient.NewMockClient(mockController)
+			ac := aoclient.NewMockClient(mockController)
+			if p.setup != nil {
+				p.setup(ec, ac)
+			}
+			calc := ExperimentCalculator{
+				experimentClient: ec,
+				autoOpsClient:    ac,
+				logger:           zap.NewNop(),
+			}
+			err := calc.checkGuardrails(context.Background(), "ns0", p.experiment, p.result)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestResultGoalIDs(t *testing.T) {
+	t.Parallel()
+	exp := &experiment.Experiment{
+		GoalIds: []string{"gid1", "gid2"},
+		GuardrailGoals: []*autoopsproto.GuardrailGoal{
+			{GoalId: "gid2"},
+			{GoalId: "gid3"},
+		},
+	}
+	assert.Equal(t, []string{"gid1", "gid2", "gid3"}, resultGoalIDs(exp))
+}
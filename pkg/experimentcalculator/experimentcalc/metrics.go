@@ -26,6 +26,7 @@ const (
 	calculationSuccess = "Success"
 
 	binomialModelSampleMethod = "binomialModelSample"
+	betaBinomialSampleMethod  = "betaBinomialSample"
 	normalInverseGammaMethod  = "normalInverseGamma"
 
 	valuesAreZero                    = "valuesAreZero"
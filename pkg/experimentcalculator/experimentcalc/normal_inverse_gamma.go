@@ -72,13 +72,16 @@ type distr struct {
 
 // normalInverseGamma computes the value-metric posterior summaries. src seeds
 // the Monte Carlo sampling; pass nil to use the global RNG (production) or a
-// seeded source for deterministic tests.
+// seeded source for deterministic tests. lowerIsBetter flips the comparison
+// used for the probability to be best and to beat the baseline, for goals
+// such as latency where a smaller value is an improvement.
 func normalInverseGamma(
 	src rand.Source,
 	vids []string,
 	means, vars []float64,
 	sizes []int64,
 	baselineIdx, postGenNum int,
+	lowerIsBetter bool,
 ) map[string]*eventcounter.VariationResult {
 	startTime := time.Now()
 	variationNum := len(means)
@@ -103,10 +106,13 @@ func normalInverseGamma(
 		sampleSeries = append(sampleSeries, series.Floats(nums))
 	}
 	samples := dataframe.New(sampleSeries...)
-	best := samples.Rapply(calcBest)
+	best := samples.Rapply(func(s series.Series) series.Series {
+		return calcBest(s, lowerIsBetter)
+	})
 	beatBaseline := samples.Rapply(func(s series.Series) series.Series {
-		return calcBeatBaseline(s, baselineIdx)
+		return calcBeatBaseline(s, baselineIdx, lowerIsBetter)
 	})
+	baselineDraws := samples.Col(fmt.Sprintf("X%d", baselineIdx)).Float()
 	for i := 0; i < variationNum; i++ {
 		col := fmt.Sprintf("X%d", i)
 		vr := &eventcounter.VariationResult{
@@ -114,6 +120,9 @@ func normalInverseGamma(
 			GoalValueSumPerUserProbBest:         createValueSumProbBest(best.Col(col)),
 			GoalValueSumPerUserProbBeatBaseline: createValueSumProbBeatBaseline(beatBaseline.Col(col)),
 		}
+		if i != baselineIdx {
+			vr.GoalValueLift = createLift(samples.Col(col).Float(), baselineDraws)
+		}
 		variationResults[vids[i]] = vr
 	}
 	calculationHistogram.WithLabelValues(normalInverseGammaMethod).Observe(time.Since(startTime).Seconds())
@@ -237,12 +246,15 @@ func generateNormalGamma(src rand.Source, n int, mu float64, lambda float64, alp
 	return x
 }
 
-func calcBest(s series.Series) series.Series {
-	max := s.Max()
+func calcBest(s series.Series, lowerIsBetter bool) series.Series {
+	best := s.Max()
+	if lowerIsBetter {
+		best = s.Min()
+	}
 	samples := s.Float()
 	maxArray := make([]int, len(samples))
 	for i := 0; i < len(samples); i++ {
-		if samples[i] == max {
+		if samples[i] == best {
 			maxArray[i] = 1
 		} else {
 			maxArray[i] = 0
@@ -251,12 +263,12 @@ func calcBest(s series.Series) series.Series {
 	return series.Ints(maxArray)
 }
 
-func calcBeatBaseline(s series.Series, baselineIdx int) series.Series {
+func calcBeatBaseline(s series.Series, baselineIdx int, lowerIsBetter bool) series.Series {
 	baseline := s.Val(baselineIdx).(float64)
 	samples := s.Float()
 	beatArray := make([]int, len(samples))
 	for i := 0; i < len(samples); i++ {
-		if samples[i] > baseline {
+		if beats(samples[i], baseline, lowerIsBetter) {
 			beatArray[i] = 1
 		} else {
 			beatArray[i] = 0
@@ -55,7 +55,7 @@ func TestNormalInverseGamma(t *testing.T) {
 	vars := []float64{stat.Variance(v1, nil), stat.Variance(v2, nil)}
 	sizes := []int64{int64(len(v1)), int64(len(v2))}
 	baselineIdx := 0
-	vrs := normalInverseGamma(rand.NewPCG(9, 10), vids, means, vars, sizes, baselineIdx, 25000)
+	vrs := normalInverseGamma(rand.NewPCG(9, 10), vids, means, vars, sizes, baselineIdx, 25000, false)
 
 	// With the conjugate update using the real sample size, the posterior for the
 	// mean concentrates around the observed per-user mean and the 95% credible
@@ -111,7 +111,7 @@ func TestNormalInverseGammaHeavyTailed(t *testing.T) {
 	vars := []float64{stat.Variance(v1, nil), stat.Variance(v2, nil)}
 	sizes := []int64{int64(len(v1)), int64(len(v2))}
 	baselineIdx := 0
-	vrs := normalInverseGamma(rand.NewPCG(11, 12), vids, means, vars, sizes, baselineIdx, 25000)
+	vrs := normalInverseGamma(rand.NewPCG(11, 12), vids, means, vars, sizes, baselineIdx, 25000, false)
 
 	vid1 := vrs["vid1"]
 	// Posterior median tracks the observed per-user mean.
@@ -166,7 +166,7 @@ func TestNormalInverseGammaSmallNOffScale(t *testing.T) {
 	means := []float64{stat.Mean(v1, nil), stat.Mean(v2, nil)}
 	vars := []float64{stat.Variance(v1, nil), stat.Variance(v2, nil)}
 	sizes := []int64{int64(len(v1)), int64(len(v2))}
-	vrs := normalInverseGamma(rand.NewPCG(17, 18), vids, means, vars, sizes, 0, 25000)
+	vrs := normalInverseGamma(rand.NewPCG(17, 18), vids, means, vars, sizes, 0, 25000, false)
 
 	vid1 := vrs["vid1"]
 	assert.InDelta(t, vid1.GoalValueSumPerUserProb.Median, means[0], 0.10*means[0])
@@ -188,6 +188,33 @@ func TestNormalInverseGammaSmallNOffScale(t *testing.T) {
 	assert.Greater(t, vid2.GoalValueSumPerUserProbBeatBaseline.Mean, 0.5)
 }
 
+// TestNormalInverseGammaLowerIsBetter checks a duration-style goal where the
+// treatment is ~10% faster than the baseline: with lowerIsBetter the faster arm
+// must be the likely best and beat the baseline, and the relative lift's 95%
+// credible interval must sit around -10% and exclude zero.
+func TestNormalInverseGammaLowerIsBetter(t *testing.T) {
+	t.Parallel()
+	vids := []string{"baseline", "treatment"}
+	means := []float64{10.0, 9.0}
+	vars := []float64{4.0, 4.0}
+	sizes := []int64{10000, 10000}
+	vrs := normalInverseGamma(rand.NewPCG(27, 28), vids, means, vars, sizes, 0, 25000, true)
+
+	treatment := vrs["treatment"]
+	assert.Greater(t, treatment.GoalValueSumPerUserProbBest.Mean, 0.99)
+	assert.Greater(t, treatment.GoalValueSumPerUserProbBeatBaseline.Mean, 0.99)
+	assert.Less(t, vrs["baseline"].GoalValueSumPerUserProbBest.Mean, 0.01)
+	assert.Nil(t, vrs["baseline"].GoalValueLift)
+	assert.InDelta(t, -0.1, treatment.GoalValueLift.Median, 0.01)
+	assert.Less(t, treatment.GoalValueLift.Percentile975, 0.0)
+	assert.Less(t, treatment.GoalValueLift.Percentile025, treatment.GoalValueLift.Median)
+
+	// The same data with higher-is-better makes the baseline the winner.
+	vrs = normalInverseGamma(rand.NewPCG(27, 28), vids, means, vars, sizes, 0, 25000, false)
+	assert.Less(t, vrs["treatment"].GoalValueSumPerUserProbBeatBaseline.Mean, 0.01)
+	assert.Greater(t, vrs["baseline"].GoalValueSumPerUserProbBest.Mean, 0.99)
+}
+
 // TestNormalInverseGammaSmallNSubUnit exercises the empirical-Bayes prior on a
 // sub-unit metric (per-user values around 0.3, e.g. value-sum-per-user for a
 // low-value goal) at small n. Previously the hardcoded priors (mean=30,
@@ -210,7 +237,7 @@ func TestNormalInverseGammaSmallNSubUnit(t *testing.T) {
 	means := []float64{stat.Mean(v1, nil), stat.Mean(v2, nil)}
 	vars := []float64{stat.Variance(v1, nil), stat.Variance(v2, nil)}
 	sizes := []int64{int64(len(v1)), int64(len(v2))}
-	vrs := normalInverseGamma(rand.NewPCG(23, 24), vids, means, vars, sizes, 0, 25000)
+	vrs := normalInverseGamma(rand.NewPCG(23, 24), vids, means, vars, sizes, 0, 25000, false)
 
 	for i, vid := range vids {
 		vr := vrs[vid]
@@ -281,6 +308,7 @@ func TestWinsorizationRobustness(t *testing.T) {
 		[]int64{n, n},
 		baselineIdx,
 		numSamples,
+		false,
 	)
 	// The whale-inflated mean difference produces overconfident inference:
 	// 3 users out of 1,000 flip the verdict to "treatment wins decisively".
@@ -302,6 +330,7 @@ func TestWinsorizationRobustness(t *testing.T) {
 		[]int64{n, n},
 		baselineIdx,
 		numSamples,
+		false,
 	)
 	// After capping the result is non-decisive: whales no longer drive the
 	// posterior and the tiny residual lift is within normal noise.
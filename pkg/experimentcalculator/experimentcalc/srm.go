@@ -87,6 +87,15 @@ var (
 	errSRMTooFewExpectedCells = errors.New("fewer than 2 variations with positive expected user counts")
 	errSRMSmallExpectedCell   = errors.New(
 		"smallest expected per-variation count below the chi-square reliability floor")
+
+	// srmBucketByWarning is reported when the rollout buckets by a user
+	// attribute. Users sharing the attribute value are assigned together, so
+	// the per-user counts are clustered rather than independent draws and
+	// the chi-square test overstates its confidence: a MISMATCH may simply
+	// reflect a few large clusters (e.g. one big company) landing in the
+	// same variation.
+	srmBucketByWarning = "rollout buckets by user attribute %q instead of the user ID; " +
+		"observed users are clustered, so the SRM check may report false mismatches"
 )
 
 // computeSRM compares each variation's observed user count (from
@@ -154,6 +163,9 @@ func computeSRM(
 		res.SkipReason = err.Error()
 		return res
 	}
+	if attr := rolloutBucketBy(feature.DefaultStrategy.RolloutStrategy); attr != "" {
+		res.Warning = fmt.Sprintf(srmBucketByWarning, attr)
+	}
 
 	observedByID := make(map[string]int64, len(variationResults))
 	for _, vr := range variationResults {
@@ -308,6 +320,16 @@ func computeSRM(
 	return res
 }
 
+// rolloutBucketBy returns the user attribute the rollout buckets by, either
+// for the variation split or for the audience traffic control, or "" when
+// users are bucketed by their ID.
+func rolloutBucketBy(rs *featureproto.RolloutStrategy) string {
+	if rs.GetBucketBy() != "" {
+		return rs.GetBucketBy()
+	}
+	return rs.GetAudience().GetBucketBy()
+}
+
 // extractExpectedFractions returns the audience-adjusted expected fraction
 // of total observed traffic for each variation in the feature's default
 // rollout strategy. The returned fractions sum to exactly 1.0 (modulo
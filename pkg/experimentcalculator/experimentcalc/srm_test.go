@@ -137,6 +137,32 @@ func TestComputeSRM_SkewedSplit_ReportsMISMATCH(t *testing.T) {
 	assert.Greater(t, got.ChiSquare, 30.0)
 }
 
+func TestComputeSRM_BucketByAttribute_ReportsWarning(t *testing.T) {
+	t.Parallel()
+	pairs := []struct {
+		id     string
+		weight int32
+	}{{"vid1", 50}, {"vid2", 50}}
+	results := []*eventcounter.VariationResult{
+		vr("vid1", 5000), vr("vid2", 4950),
+	}
+
+	byUserID := computeSRM(results, newRolloutFeature(t, pairs...), DefaultSRMThreshold)
+	assert.Empty(t, byUserID.Warning)
+
+	rollout := newRolloutFeature(t, pairs...)
+	rollout.DefaultStrategy.RolloutStrategy.BucketBy = "company"
+	got := computeSRM(results, rollout, DefaultSRMThreshold)
+	// The check still runs; the warning only qualifies the result.
+	assert.Equal(t, eventcounter.SrmResult_OK, got.Status)
+	assert.Contains(t, got.Warning, `"company"`)
+
+	audience := newRolloutFeatureWithAudience(t, 50, "vid1", pairs...)
+	audience.DefaultStrategy.RolloutStrategy.Audience.BucketBy = "tenant"
+	got = computeSRM(results, audience, DefaultSRMThreshold)
+	assert.Contains(t, got.Warning, `"tenant"`)
+}
+
 func TestComputeSRM_NonUniformWeights_ConvergesToExpected(t *testing.T) {
 	t.Parallel()
 	feature := newRolloutFeature(t,
@@ -16,12 +16,15 @@ package api
 
 import (
 	"context"
+	"errors"
+	"sync"
 
 	"go.uber.org/zap"
 	"golang.org/x/sync/singleflight"
 	"google.golang.org/grpc"
 
 	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
+	v2als "github.com/bucketeer-io/bucketeer/v2/pkg/auditlog/storage/v2"
 	v2fs "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2"
 	v2ts "github.com/bucketeer-io/bucketeer/v2/pkg/tag/storage"
 	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
@@ -60,6 +63,8 @@ type FeatureService struct {
 	segmentStorage             v2fs.SegmentStorage
 	segmentUserStorage         v2fs.SegmentUserStorage
 	scheduledFlagChangeStorage v2fs.ScheduledFlagChangeStorage
+	changeRequestStorage       v2fs.ChangeRequestStorage
+	auditLogStorage            v2als.AuditLogStorage
 	tagStorage                 v2ts.TagStorage
 	dbClient                   database.Client
 	accountClient              accountclient.Client
@@ -87,6 +92,8 @@ func NewFeatureService(
 	flagTriggerStorage v2fs.FlagTriggerStorage,
 	fluiStorage v2fs.FeatureLastUsedInfoStorage,
 	scheduledFlagChangeStorage v2fs.ScheduledFlagChangeStorage,
+	changeRequestStorage v2fs.ChangeRequestStorage,
+	auditLogStorage v2als.AuditLogStorage,
 	accountClient accountclient.Client,
 	experimentClient experimentclient.Client,
 	autoOpsClient autoopsclient.Client,
@@ -112,6 +119,8 @@ func NewFeatureService(
 		segmentStorage:             segmentStorage,
 		segmentUserStorage:         segmentUserStorage,
 		scheduledFlagChangeStorage: scheduledFlagChangeStorage,
+		changeRequestStorage:       changeRequestStorage,
+		auditLogStorage:            auditLogStorage,
 		tagStorage:                 tagStorage,
 		dbClient:                   dbClient,
 		accountClient:              accountClient,
@@ -134,32 +143,43 @@ func (s *FeatureService) Register(server *grpc.Server) {
 	featureproto.RegisterFeatureServiceServer(server, s)
 }
 
-func (s *FeatureService) checkEnvironmentRole(
+func (s *FeatureService) checkPermission(
 	ctx context.Context,
-	requiredRole accountproto.AccountV2_Role_Environment,
 	environmentId string,
+	permissions ...role.Permission,
 ) (*eventproto.Editor, error) {
-	return role.CheckEnvironmentRoleWithLog(
+	return role.CheckPermissionWithLog(
 		ctx,
-		requiredRole,
 		environmentId,
-		func(email string) (*accountproto.AccountV2, error) {
-			resp, err := s.accountClient.GetAccountV2ByEnvironmentID(ctx, &accountproto.GetAccountV2ByEnvironmentIDRequest{
+		func(email string) (*accountproto.GetAccountV2ByEnvironmentIDResponse, error) {
+			return s.accountClient.GetAccountV2ByEnvironmentID(ctx, &accountproto.GetAccountV2ByEnvironmentIDRequest{
 				Email:         email,
 				EnvironmentId: environmentId,
 			})
-			if err != nil {
-				return nil, err
-			}
-			return resp.Account, nil
 		},
 		s.logger,
 		statusUnauthenticated.Err(),
 		statusPermissionDenied.Err(),
 		func(err error) error { return api.NewGRPCStatus(err).Err() },
+		permissions...,
 	)
 }
 
+// featureTags returns a loader of the feature tags for the tag-scoped permissions.
+// A missing feature has no tags, so the handler reports it after the permission check.
+func (s *FeatureService) featureTags(ctx context.Context, id, environmentId string) func() ([]string, error) {
+	return sync.OnceValues(func() ([]string, error) {
+		f, err := s.featureStorage.GetFeature(ctx, id, environmentId)
+		if err != nil {
+			if errors.Is(err, v2fs.ErrFeatureNotFound) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return f.Tags, nil
+	})
+}
+
 func (s *FeatureService) reportInternalServerError(
 	ctx context.Context,
 	err error,
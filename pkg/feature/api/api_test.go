@@ -27,6 +27,7 @@ import (
 	tagstoragemock "github.com/bucketeer-io/bucketeer/v2/pkg/tag/storage/mock"
 
 	accountclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/account/client/mock"
+	auditlogstoragemock "github.com/bucketeer-io/bucketeer/v2/pkg/auditlog/storage/v2/mock"
 	aoclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/client/mock"
 	autoopsclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/client/mock"
 	btclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/batch/client/mock"
@@ -123,6 +124,8 @@ func createFeatureService(c *gomock.Controller) *FeatureService {
 		mock.NewMockSegmentStorage(c),
 		mock.NewMockSegmentUserStorage(c),
 		mock.NewMockScheduledFlagChangeStorage(c),
+		mock.NewMockChangeRequestStorage(c),
+		auditlogstoragemock.NewMockAuditLogStorage(c),
 		tagstoragemock.NewMockTagStorage(c),
 		databasemock.NewMockClient(c),
 		a,
@@ -169,6 +172,8 @@ func createFeatureServiceNew(c *gomock.Controller) *FeatureService {
 		segmentStorage:             mock.NewMockSegmentStorage(c),
 		segmentUserStorage:         mock.NewMockSegmentUserStorage(c),
 		scheduledFlagChangeStorage: mock.NewMockScheduledFlagChangeStorage(c),
+		changeRequestStorage:       mock.NewMockChangeRequestStorage(c),
+		auditLogStorage:            auditlogstoragemock.NewMockAuditLogStorage(c),
 		dbClient:                   databasemock.NewMockClient(c),
 		tagStorage:                 tagstoragemock.NewMockTagStorage(c),
 		accountClient:              a,
@@ -208,6 +213,8 @@ func createFeatureServiceWithGetAccountByEnvironmentMock(c *gomock.Controller, r
 		flagTriggerStorage:         mock.NewMockFlagTriggerStorage(c),
 		featureStorage:             mock.NewMockFeatureStorage(c),
 		scheduledFlagChangeStorage: mock.NewMockScheduledFlagChangeStorage(c),
+		changeRequestStorage:       mock.NewMockChangeRequestStorage(c),
+		auditLogStorage:            auditlogstoragemock.NewMockAuditLogStorage(c),
 		segmentUsersCache:          cachev3mock.NewMockSegmentUsersCache(c),
 		segmentStorage:             mock.NewMockSegmentStorage(c),
 		segmentUserStorage:         mock.NewMockSegmentUserStorage(c),
@@ -215,6 +222,7 @@ func createFeatureServiceWithGetAccountByEnvironmentMock(c *gomock.Controller, r
 		accountClient:              a,
 		autoOpsClient:              aoclientmock.NewMockClient(c),
 		experimentClient:           experimentclientmock.NewMockClient(c),
+		environmentClient:          envclientmock.NewMockClient(c),
 		featuresCache:              cachev3mock.NewMockFeaturesCache(c),
 		segmentUsersPublisher:      segmentUsersPublisher,
 		userAttributesCache:        cachev3mock.NewMockUserAttributesCache(c),
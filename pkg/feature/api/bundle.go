@@ -0,0 +1,702 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
+	pkgErr "github.com/bucketeer-io/bucketeer/v2/pkg/error"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/bundle"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
+	v2fs "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database"
+	tagstorage "github.com/bucketeer-io/bucketeer/v2/pkg/tag/storage"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
+	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+	tagproto "github.com/bucketeer-io/bucketeer/v2/proto/tag"
+)
+
+func (s *FeatureService) ExportFeatureBundle(
+	ctx context.Context,
+	req *featureproto.ExportFeatureBundleRequest,
+) (*featureproto.ExportFeatureBundleResponse, error) {
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_READ,
+	})
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.getBundleState(ctx, req.EnvironmentId)
+	if err != nil {
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	b, err := bundle.Export(state)
+	if err != nil {
+		s.logger.Error(
+			"Failed to export feature bundle",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		return nil, statusInternal.Err()
+	}
+	data, err := bundle.Marshal(b, req.Format)
+	if err != nil {
+		return nil, newInvalidBundleError(err)
+	}
+	return &featureproto.ExportFeatureBundleResponse{Bundle: data}, nil
+}
+
+func (s *FeatureService) ImportFeatureBundle(
+	ctx context.Context,
+	req *featureproto.ImportFeatureBundleRequest,
+) (*featureproto.ImportFeatureBundleResponse, error) {
+	editor, err := s.checkPermission(
+		ctx,
+		req.EnvironmentId,
+		role.Permission{
+			ResourceType: accountproto.Permission_FEATURE,
+			Action:       accountproto.Permission_CREATE,
+		},
+		role.Permission{
+			ResourceType: accountproto.Permission_FEATURE,
+			Action:       accountproto.Permission_UPDATE,
+		},
+		role.Permission{
+			ResourceType: accountproto.Permission_FEATURE,
+			Action:       accountproto.Permission_UPDATE_TARGETING,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateImportFeatureBundleRequest(req); err != nil {
+		return nil, err
+	}
+	b, err := bundle.Unmarshal(req.Bundle)
+	if err != nil {
+		return nil, newInvalidBundleError(err)
+	}
+	// Updates in a protected environment only open change requests, so the
+	// bundle could not be applied. Dry runs are refused too, so their diff
+	// never promises changes that the import would not make.
+	if err := s.checkDirectChangeAllowed(ctx, req.EnvironmentId); err != nil {
+		return nil, err
+	}
+	state, err := s.getBundleState(ctx, req.EnvironmentId)
+	if err != nil {
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	token, err := bundle.DiffToken(state)
+	if err != nil {
+		s.logBundleStateError(ctx, "Failed to compute the diff token", err, req.EnvironmentId)
+		return nil, statusInternal.Err()
+	}
+	if !req.DryRun && req.DiffToken != token {
+		return nil, statusBundleDiffTokenMismatch.Err()
+	}
+	imp := &bundleImport{
+		s:        s,
+		editor:   editor,
+		req:      req,
+		state:    state,
+		resolver: bundle.NewResolver(state.Segments, state.Features),
+		created:  make(map[string]struct{}),
+	}
+	steps := []func(context.Context, *bundle.Bundle) error{
+		imp.importTags,
+		imp.importSegments,
+		imp.createFeatures,
+		imp.updateFeatures,
+		imp.archiveFeatures,
+		imp.importAutoOps,
+		imp.deleteSegments,
+		imp.deleteTags,
+	}
+	for _, step := range steps {
+		if err := step(ctx, b); err != nil {
+			s.logger.Error(
+				"Failed to import feature bundle",
+				log.FieldsFromIncomingContext(ctx).AddFields(
+					zap.Error(err),
+					zap.String("environmentId", req.EnvironmentId),
+					zap.Bool("dryRun", req.DryRun),
+					zap.Int("appliedChanges", imp.applied),
+				)...,
+			)
+			if imp.applied == 0 {
+				return nil, err
+			}
+			return s.partialImportResponse(ctx, imp, err), nil
+		}
+	}
+	return &featureproto.ImportFeatureBundleResponse{
+		Changes:   imp.changes,
+		DiffToken: token,
+		Applied:   !req.DryRun,
+	}, nil
+}
+
+// partialImportResponse reports the changes written before err stopped the
+// import. The writes are not rolled back since each one runs in its own
+// transaction, and auto operations live in another service. The diff token
+// is computed from the resulting state, so the caller can import the same
+// bundle again to apply the rest. It is empty when the state cannot be read,
+// which makes the caller run a new dry run first.
+func (s *FeatureService) partialImportResponse(
+	ctx context.Context,
+	imp *bundleImport,
+	err error,
+) *featureproto.ImportFeatureBundleResponse {
+	resp := &featureproto.ImportFeatureBundleResponse{
+		Changes:      imp.changes[:imp.applied],
+		Applied:      true,
+		Partial:      true,
+		ErrorMessage: status.Convert(err).Message(),
+	}
+	state, err := s.getBundleState(ctx, imp.req.EnvironmentId)
+	if err != nil {
+		return resp
+	}
+	if token, err := bundle.DiffToken(state); err == nil {
+		resp.DiffToken = token
+	}
+	return resp
+}
+
+// getBundleState loads every resource of the environment that a bundle covers.
+func (s *FeatureService) getBundleState(ctx context.Context, environmentID string) (*bundle.State, error) {
+	deleted := false
+	features, _, _, err := s.featureStorage.ListFeatures(ctx, v2fs.ListFeaturesParams{
+		PageSize:      database.QueryNoLimit,
+		EnvironmentID: environmentID,
+		Deleted:       &deleted,
+	})
+	if err != nil {
+		s.logBundleStateError(ctx, "Failed to list features", err, environmentID)
+		return nil, err
+	}
+	segments, _, _, _, err := s.segmentStorage.ListSegments(ctx, v2fs.ListSegmentsParams{
+		PageSize:      database.QueryNoLimit,
+		EnvironmentID: environmentID,
+	})
+	if err != nil {
+		s.logBundleStateError(ctx, "Failed to list segments", err, environmentID)
+		return nil, err
+	}
+	tags, _, _, err := s.tagStorage.ListTags(ctx, tagstorage.ListTagsParams{
+		EnvironmentID: environmentID,
+		EntityType:    tagproto.Tag_FEATURE_FLAG,
+		PageSize:      database.QueryNoLimit,
+	})
+	if err != nil {
+		s.logBundleStateError(ctx, "Failed to list tags", err, environmentID)
+		return nil, err
+	}
+	tagNames := make([]string, 0, len(tags))
+	for _, t := range tags {
+		tagNames = append(tagNames, t.Name)
+	}
+	autoOpsRules, err := s.listAllAutoOpsRules(ctx, environmentID)
+	if err != nil {
+		s.logBundleStateError(ctx, "Failed to list auto ops rules", err, environmentID)
+		return nil, err
+	}
+	rollouts, err := s.listAllProgressiveRollouts(ctx, environmentID)
+	if err != nil {
+		s.logBundleStateError(ctx, "Failed to list progressive rollouts", err, environmentID)
+		return nil, err
+	}
+	return &bundle.State{
+		Features:            features,
+		Segments:            segments,
+		Tags:                tagNames,
+		AutoOpsRules:        autoOpsRules,
+		ProgressiveRollouts: rollouts,
+	}, nil
+}
+
+func (s *FeatureService) logBundleStateError(ctx context.Context, msg string, err error, environmentID string) {
+	s.logger.Error(
+		msg,
+		log.FieldsFromIncomingContext(ctx).AddFields(
+			zap.Error(err),
+			zap.String("environmentId", environmentID),
+		)...,
+	)
+}
+
+func (s *FeatureService) listAllAutoOpsRules(
+	ctx context.Context,
+	environmentID string,
+) ([]*autoopsproto.AutoOpsRule, error) {
+	rules := []*autoopsproto.AutoOpsRule{}
+	cursor := ""
+	for {
+		resp, err := s.autoOpsClient.ListAutoOpsRules(ctx, &autoopsproto.ListAutoOpsRulesRequest{
+			PageSize:      listRequestSize,
+			Cursor:        cursor,
+			EnvironmentId: environmentID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, resp.AutoOpsRules...)
+		size := len(resp.AutoOpsRules)
+		if size == 0 || size < listRequestSize {
+			return rules, nil
+		}
+		cursor = resp.Cursor
+	}
+}
+
+func (s *FeatureService) listAllProgressiveRollouts(
+	ctx context.Context,
+	environmentID string,
+) ([]*autoopsproto.ProgressiveRollout, error) {
+	rollouts := []*autoopsproto.ProgressiveRollout{}
+	cursor := ""
+	for {
+		resp, err := s.autoOpsClient.ListProgressiveRollouts(ctx, &autoopsproto.ListProgressiveRolloutsRequest{
+			PageSize:      listRequestSize,
+			Cursor:        cursor,
+			EnvironmentId: environmentID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rollouts = append(rollouts, resp.ProgressiveRollouts...)
+		size := len(resp.ProgressiveRollouts)
+		if size == 0 || size < listRequestSize {
+			return rollouts, nil
+		}
+		cursor = resp.Cursor
+	}
+}
+
+func newInvalidBundleError(err error) error {
+	return api.NewGRPCStatus(
+		pkgErr.NewErrorInvalidArgNotMatchFormat(pkgErr.FeaturePackageName, err.Error(), "Bundle"),
+	).Err()
+}
+
+// bundleImport makes an environment match a bundle. Resources missing from
+// the bundle are removed: features are archived, segments and tags deleted. Every write goes through
+// the regular service methods so versions, audit logs and domain events are
+// the same as for changes made in the console. On a dry run nothing is
+// written: created resources are simulated in memory so later steps can
+// still resolve references to them.
+// A change is recorded before it is written, and applied counts the recorded
+// changes whose writes succeeded.
+type bundleImport struct {
+	s        *FeatureService
+	editor   *eventproto.Editor
+	req      *featureproto.ImportFeatureBundleRequest
+	state    *bundle.State
+	resolver *bundle.Resolver
+	created  map[string]struct{}
+	changes  []*featureproto.FeatureBundleChange
+	applied  int
+}
+
+func (i *bundleImport) record(
+	resourceType featureproto.FeatureBundleChange_ResourceType,
+	action featureproto.FeatureBundleChange_ChangeAction,
+	id string,
+	fields []string,
+) {
+	i.changes = append(i.changes, &featureproto.FeatureBundleChange{
+		ResourceType: resourceType,
+		Action:       action,
+		ResourceId:   id,
+		Fields:       fields,
+	})
+}
+
+func (i *bundleImport) markApplied() {
+	i.applied = len(i.changes)
+}
+
+func (i *bundleImport) importTags(ctx context.Context, b *bundle.Bundle) error {
+	existing := make(map[string]struct{}, len(i.state.Tags))
+	for _, t := range i.state.Tags {
+		existing[t] = struct{}{}
+	}
+	var missing []string
+	for _, t := range b.Tags {
+		if _, ok := existing[t]; ok {
+			continue
+		}
+		missing = append(missing, t)
+		i.record(featureproto.FeatureBundleChange_TAG, featureproto.FeatureBundleChange_CREATE, t, nil)
+	}
+	if i.req.DryRun || len(missing) == 0 {
+		return nil
+	}
+	if err := i.s.upsertTags(ctx, missing, i.req.EnvironmentId); err != nil {
+		return api.NewGRPCStatus(err).Err()
+	}
+	i.markApplied()
+	return nil
+}
+
+func (i *bundleImport) importSegments(ctx context.Context, b *bundle.Bundle) error {
+	byName := make(map[string]*featureproto.Segment, len(i.state.Segments))
+	for _, seg := range i.state.Segments {
+		byName[seg.Name] = seg
+	}
+	created := make(map[string]struct{})
+	for _, seg := range b.Segments {
+		if _, ok := byName[seg.Name]; ok {
+			continue
+		}
+		created[seg.Name] = struct{}{}
+		i.record(featureproto.FeatureBundleChange_SEGMENT, featureproto.FeatureBundleChange_CREATE, seg.Name, nil)
+		if i.req.DryRun {
+			placeholder := &featureproto.Segment{Id: seg.Name, Name: seg.Name}
+			byName[seg.Name] = placeholder
+			i.resolver.SetSegment(placeholder)
+			continue
+		}
+		resp, err := i.s.CreateSegment(ctx, &featureproto.CreateSegmentRequest{
+			Name:          seg.Name,
+			Description:   seg.Description,
+			EnvironmentId: i.req.EnvironmentId,
+		})
+		if err != nil {
+			return err
+		}
+		i.markApplied()
+		byName[seg.Name] = resp.Segment
+		i.resolver.SetSegment(resp.Segment)
+	}
+	// Rules are set once every segment exists because they may reference each other.
+	for _, seg := range b.Segments {
+		update, fields, err := i.resolver.SegmentUpdate(byName[seg.Name], seg)
+		if err != nil {
+			return newInvalidBundleError(err)
+		}
+		if update == nil {
+			continue
+		}
+		if _, ok := created[seg.Name]; !ok {
+			i.record(featureproto.FeatureBundleChange_SEGMENT, featureproto.FeatureBundleChange_UPDATE, seg.Name, fields)
+		}
+		if i.req.DryRun {
+			continue
+		}
+		update.EnvironmentId = i.req.EnvironmentId
+		if _, err := i.s.UpdateSegment(ctx, update); err != nil {
+			return err
+		}
+		i.markApplied()
+	}
+	return nil
+}
+
+// createFeatures creates the missing features with their variations only.
+// Rules, targets and prerequisites are set by updateFeatures once every
+// feature exists, since they may reference features created later.
+func (i *bundleImport) createFeatures(ctx context.Context, b *bundle.Bundle) error {
+	for _, f := range b.Features {
+		if i.resolver.Feature(f.ID) != nil {
+			continue
+		}
+		req, err := bundle.NewCreateFeatureRequest(f)
+		if err != nil {
+			return newInvalidBundleError(err)
+		}
+		req.EnvironmentId = i.req.EnvironmentId
+		if err := validateCreateFeatureRequest(req); err != nil {
+			return err
+		}
+		i.created[f.ID] = struct{}{}
+		i.record(featureproto.FeatureBundleChange_FEATURE, featureproto.FeatureBundleChange_CREATE, f.ID, nil)
+		if i.req.DryRun {
+			feature, err := domain.NewFeature(
+				req.Id,
+				req.Name,
+				req.Description,
+				req.VariationType,
+				nil,
+				req.Variations,
+				req.Tags,
+				int(req.DefaultOnVariationIndex.Value),
+				int(req.DefaultOffVariationIndex.Value),
+				"",
+			)
+			if err != nil {
+				return api.NewGRPCStatus(err).Err()
+			}
+			if req.Kind != featureproto.FeatureLifecycle_KIND_UNSPECIFIED || req.PlannedRemovalAt != 0 {
+				if _, err := feature.UpdateLifecycle(req.Kind, wrapperspb.Int64(req.PlannedRemovalAt)); err != nil {
+					return api.NewGRPCStatus(err).Err()
+				}
+			}
+			i.resolver.SetFeature(feature.Feature)
+			continue
+		}
+		resp, err := i.s.CreateFeature(ctx, req)
+		if err != nil {
+			return err
+		}
+		i.markApplied()
+		i.resolver.SetFeature(resp.Feature)
+	}
+	return nil
+}
+
+func (i *bundleImport) updateFeatures(ctx context.Context, b *bundle.Bundle) error {
+	for _, f := range b.Features {
+		current := i.resolver.Feature(f.ID)
+		req, fields, err := i.resolver.FeatureUpdate(current, f)
+		if err != nil {
+			return newInvalidBundleError(err)
+		}
+		if req == nil {
+			continue
+		}
+		if _, ok := i.created[f.ID]; !ok {
+			i.record(featureproto.FeatureBundleChange_FEATURE, featureproto.FeatureBundleChange_UPDATE, f.ID, fields)
+		}
+		if i.req.DryRun {
+			// Apply the update in memory to validate it and to let the auto
+			// operations resolve variations it creates.
+			updated, err := (&domain.Feature{Feature: current}).Update(
+				req.Name,
+				req.Description,
+				req.Tags,
+				req.Enabled,
+				req.Archived,
+				req.DefaultStrategy,
+				req.OffVariation,
+				req.ResetSamplingSeed,
+				req.PrerequisiteChanges,
+				req.TargetChanges,
+				req.RuleChanges,
+				req.VariationChanges,
+				req.TagChanges,
+				req.Maintainer,
+				req.OrderedRuleIds,
+				nil,
+			)
+			if err != nil {
+				return i.s.convUpdateFeatureError(err)
+			}
+			if _, err := updated.UpdateLifecycle(req.Kind, req.PlannedRemovalAt); err != nil {
+				return api.NewGRPCStatus(err).Err()
+			}
+			i.resolver.SetFeature(updated.Feature)
+			continue
+		}
+		req.EnvironmentId = i.req.EnvironmentId
+		req.Comment = i.req.Comment
+		resp, err := i.s.UpdateFeature(ctx, req)
+		if err != nil {
+			return err
+		}
+		i.markApplied()
+		i.resolver.SetFeature(resp.Feature)
+	}
+	return nil
+}
+
+// archiveFeatures archives the features that are not in the bundle.
+func (i *bundleImport) archiveFeatures(ctx context.Context, b *bundle.Bundle) error {
+	var candidates []*featureproto.Feature
+	for _, f := range i.state.Features {
+		if f.Archived || b.Feature(f.Id) != nil {
+			continue
+		}
+		candidates = append(candidates, i.resolver.Feature(f.Id))
+	}
+	for _, f := range orderFeaturesForArchive(candidates) {
+		i.record(featureproto.FeatureBundleChange_FEATURE, featureproto.FeatureBundleChange_ARCHIVE, f.Id, nil)
+		if i.req.DryRun {
+			continue
+		}
+		if _, err := i.s.UpdateFeature(ctx, &featureproto.UpdateFeatureRequest{
+			Id:            f.Id,
+			EnvironmentId: i.req.EnvironmentId,
+			Comment:       i.req.Comment,
+			Archived:      wrapperspb.Bool(true),
+		}); err != nil {
+			return err
+		}
+		i.markApplied()
+	}
+	return nil
+}
+
+// orderFeaturesForArchive puts features before the features they depend on,
+// because archiving a feature that another active feature depends on is refused.
+// Features in a dependency cycle keep their order and fail when archived.
+func orderFeaturesForArchive(features []*featureproto.Feature) []*featureproto.Feature {
+	ordered := make([]*featureproto.Feature, 0, len(features))
+	remaining := features
+	for len(remaining) > 0 {
+		var next, rest []*featureproto.Feature
+		for _, f := range remaining {
+			if domain.HasFeaturesDependsOnTargets([]*featureproto.Feature{f}, remaining) {
+				rest = append(rest, f)
+				continue
+			}
+			next = append(next, f)
+		}
+		if len(next) == 0 {
+			next, rest = rest, nil
+		}
+		ordered = append(ordered, next...)
+		remaining = rest
+	}
+	return ordered
+}
+
+func (i *bundleImport) importAutoOps(ctx context.Context, b *bundle.Bundle) error {
+	for _, f := range b.Features {
+		feature := i.resolver.Feature(f.ID)
+		ruleCreates, ruleDeletes, err := i.resolver.AutoOpsRuleChanges(feature, i.state.AutoOpsRules, f.AutoOpsRules)
+		if err != nil {
+			return newInvalidBundleError(err)
+		}
+		rolloutCreates, rolloutDeletes, err := i.resolver.ProgressiveRolloutChanges(
+			feature,
+			i.state.ProgressiveRollouts,
+			f.ProgressiveRollouts,
+		)
+		if err != nil {
+			return newInvalidBundleError(err)
+		}
+		for _, id := range ruleDeletes {
+			i.record(featureproto.FeatureBundleChange_AUTO_OPS_RULE, featureproto.FeatureBundleChange_DELETE, f.ID, nil)
+			if i.req.DryRun {
+				continue
+			}
+			if _, err := i.s.autoOpsClient.DeleteAutoOpsRule(ctx, &autoopsproto.DeleteAutoOpsRuleRequest{
+				Id:            id,
+				EnvironmentId: i.req.EnvironmentId,
+			}); err != nil {
+				return err
+			}
+			i.markApplied()
+		}
+		for _, id := range rolloutDeletes {
+			i.record(featureproto.FeatureBundleChange_PROGRESSIVE_ROLLOUT, featureproto.FeatureBundleChange_DELETE, f.ID, nil)
+			if i.req.DryRun {
+				continue
+			}
+			if _, err := i.s.autoOpsClient.DeleteProgressiveRollout(ctx, &autoopsproto.DeleteProgressiveRolloutRequest{
+				Id:            id,
+				EnvironmentId: i.req.EnvironmentId,
+			}); err != nil {
+				return err
+			}
+			i.markApplied()
+		}
+		for _, req := range ruleCreates {
+			i.record(featureproto.FeatureBundleChange_AUTO_OPS_RULE, featureproto.FeatureBundleChange_CREATE, f.ID, nil)
+			if i.req.DryRun {
+				continue
+			}
+			req.EnvironmentId = i.req.EnvironmentId
+			if _, err := i.s.autoOpsClient.CreateAutoOpsRule(ctx, req); err != nil {
+				return err
+			}
+			i.markApplied()
+		}
+		for _, req := range rolloutCreates {
+			i.record(featureproto.FeatureBundleChange_PROGRESSIVE_ROLLOUT, featureproto.FeatureBundleChange_CREATE, f.ID, nil)
+			if i.req.DryRun {
+				continue
+			}
+			req.EnvironmentId = i.req.EnvironmentId
+			if _, err := i.s.autoOpsClient.CreateProgressiveRollout(ctx, req); err != nil {
+				return err
+			}
+			i.markApplied()
+		}
+	}
+	return nil
+}
+
+// deleteSegments deletes the segments that are not in the bundle. It runs once
+// the features are updated, so they no longer use the segments.
+func (i *bundleImport) deleteSegments(ctx context.Context, b *bundle.Bundle) error {
+	names := make(map[string]struct{}, len(b.Segments))
+	for _, seg := range b.Segments {
+		names[seg.Name] = struct{}{}
+	}
+	for _, seg := range i.state.Segments {
+		if _, ok := names[seg.Name]; ok || seg.Deleted {
+			continue
+		}
+		i.record(featureproto.FeatureBundleChange_SEGMENT, featureproto.FeatureBundleChange_DELETE, seg.Name, nil)
+		if i.req.DryRun {
+			continue
+		}
+		if _, err := i.s.DeleteSegment(ctx, &featureproto.DeleteSegmentRequest{
+			Id:            seg.Id,
+			EnvironmentId: i.req.EnvironmentId,
+		}); err != nil {
+			return err
+		}
+		i.markApplied()
+	}
+	return nil
+}
+
+// deleteTags deletes the tags that are not in the bundle. Tags still used by
+// a feature, such as an archived feature left out of the bundle, are kept.
+func (i *bundleImport) deleteTags(ctx context.Context, b *bundle.Bundle) error {
+	used := make(map[string]struct{}, len(b.Tags))
+	for _, t := range b.Tags {
+		used[t] = struct{}{}
+	}
+	for _, f := range i.state.Features {
+		for _, t := range i.resolver.Feature(f.Id).Tags {
+			used[t] = struct{}{}
+		}
+	}
+	for _, f := range b.Features {
+		for _, t := range i.resolver.Feature(f.ID).Tags {
+			used[t] = struct{}{}
+		}
+	}
+	for _, t := range i.state.Tags {
+		if _, ok := used[t]; ok {
+			continue
+		}
+		i.record(featureproto.FeatureBundleChange_TAG, featureproto.FeatureBundleChange_DELETE, t, nil)
+		if i.req.DryRun {
+			continue
+		}
+		if err := i.s.deleteTag(ctx, i.editor, t, i.req.EnvironmentId); err != nil {
+			return api.NewGRPCStatus(err).Err()
+		}
+		i.markApplied()
+	}
+	return nil
+}
@@ -0,0 +1,338 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/status"
+
+	aoclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/client/mock"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/bundle"
+	v2fs "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2/mock"
+	databasemock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database/mock"
+	tagstoragemock "github.com/bucketeer-io/bucketeer/v2/pkg/tag/storage/mock"
+	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
+	envproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+	tagproto "github.com/bucketeer-io/bucketeer/v2/proto/tag"
+)
+
+func newTestBundleFeature() *featureproto.Feature {
+	return &featureproto.Feature{
+		Id:      "feature-a",
+		Name:    "Feature A",
+		Version: 2,
+		Variations: []*featureproto.Variation{
+			{Id: "variation-a", Value: "a"},
+			{Id: "variation-b", Value: "b"},
+		},
+		OffVariation: "variation-b",
+		DefaultStrategy: &featureproto.Strategy{
+			Type:          featureproto.Strategy_FIXED,
+			FixedStrategy: &featureproto.FixedStrategy{Variation: "variation-a"},
+		},
+		Targets: []*featureproto.Target{
+			{Variation: "variation-a"},
+			{Variation: "variation-b"},
+		},
+	}
+}
+
+func expectGetBundleState(
+	s *FeatureService,
+	features []*featureproto.Feature,
+	segments []*featureproto.Segment,
+	tags ...string,
+) {
+	s.featureStorage.(*mock.MockFeatureStorage).EXPECT().ListFeatures(
+		gomock.Any(), gomock.Any(),
+	).Return(features, 0, int64(0), nil)
+	s.segmentStorage.(*mock.MockSegmentStorage).EXPECT().ListSegments(
+		gomock.Any(), gomock.Any(),
+	).Return(append([]*featureproto.Segment{}, segments...), 0, int64(0), nil, nil)
+	tagProtos := []*tagproto.Tag{}
+	for _, t := range tags {
+		tagProtos = append(tagProtos, &tagproto.Tag{Name: t})
+	}
+	s.tagStorage.(*tagstoragemock.MockTagStorage).EXPECT().ListTags(
+		gomock.Any(), gomock.Any(),
+	).Return(tagProtos, 0, int64(0), nil)
+	s.autoOpsClient.(*aoclientmock.MockClient).EXPECT().ListAutoOpsRules(
+		gomock.Any(), gomock.Any(),
+	).Return(&autoopsproto.ListAutoOpsRulesResponse{}, nil)
+	s.autoOpsClient.(*aoclientmock.MockClient).EXPECT().ListProgressiveRollouts(
+		gomock.Any(), gomock.Any(),
+	).Return(&autoopsproto.ListProgressiveRolloutsResponse{}, nil)
+}
+
+func TestExportFeatureBundle(t *testing.T) {
+	t.Parallel()
+	service := createFeatureServiceNew(gomock.NewController(t))
+	expectGetBundleState(service, []*featureproto.Feature{newTestBundleFeature()}, nil)
+	resp, err := service.ExportFeatureBundle(createContextWithToken(), &featureproto.ExportFeatureBundleRequest{
+		EnvironmentId: "namespace",
+		Format:        featureproto.FeatureBundleFormat_FEATURE_BUNDLE_FORMAT_JSON,
+	})
+	require.NoError(t, err)
+	b, err := bundle.Unmarshal(resp.Bundle)
+	require.NoError(t, err)
+	require.Len(t, b.Features, 1)
+	assert.Equal(t, "feature-a", b.Features[0].ID)
+	assert.Equal(t, "b", b.Features[0].OffVariation)
+}
+
+func TestImportFeatureBundle(t *testing.T) {
+	t.Parallel()
+
+	desired, err := bundle.Export(&bundle.State{Features: []*featureproto.Feature{newTestBundleFeature()}})
+	require.NoError(t, err)
+	desired.Tags = []string{"web"}
+	desired.Features[0].Name = "Renamed"
+	desired.Features = append(desired.Features, &bundle.Feature{
+		ID:            "feature-b",
+		Name:          "Feature B",
+		VariationType: "STRING",
+		Variations:    []*bundle.Variation{{Value: "x", Name: "X"}, {Value: "y", Name: "Y"}},
+		Tags:          []string{"web"},
+		OffVariation:  "y",
+		DefaultStrategy: &bundle.Strategy{
+			Type:      "FIXED",
+			Variation: "x",
+		},
+	})
+	data, err := bundle.Marshal(desired, featureproto.FeatureBundleFormat_FEATURE_BUNDLE_FORMAT_YAML)
+	require.NoError(t, err)
+	_, errInvalid := bundle.Unmarshal([]byte("version: 2\n"))
+	require.ErrorIs(t, errInvalid, bundle.ErrUnsupportedVersion)
+	token, err := bundle.DiffToken(&bundle.State{Features: []*featureproto.Feature{newTestBundleFeature()}})
+	require.NoError(t, err)
+	partialToken, err := bundle.DiffToken(&bundle.State{
+		Features: []*featureproto.Feature{newTestBundleFeature()},
+		Tags:     []string{"web"},
+	})
+	require.NoError(t, err)
+	// Archived features left out of the bundle keep their tags.
+	archived := newTestBundleFeature()
+	archived.Id = "feature-archived"
+	archived.Archived = true
+	archived.Tags = []string{"archived"}
+	removalToken, err := bundle.DiffToken(&bundle.State{
+		Features: []*featureproto.Feature{newTestBundleFeature(), archived},
+		Segments: []*featureproto.Segment{{Id: "segment-id", Name: "old"}},
+		Tags:     []string{"web", "archived", "legacy"},
+	})
+	require.NoError(t, err)
+
+	patterns := []struct {
+		desc        string
+		setup       func(*FeatureService)
+		req         *featureproto.ImportFeatureBundleRequest
+		expected    *featureproto.ImportFeatureBundleResponse
+		expectedErr error
+	}{
+		{
+			desc: "err: missing bundle",
+			req: &featureproto.ImportFeatureBundleRequest{
+				EnvironmentId: "namespace",
+				DryRun:        true,
+			},
+			expectedErr: statusMissingBundle.Err(),
+		},
+		{
+			desc: "err: missing diff token",
+			req: &featureproto.ImportFeatureBundleRequest{
+				EnvironmentId: "namespace",
+				Bundle:        data,
+			},
+			expectedErr: statusMissingDiffToken.Err(),
+		},
+		{
+			desc: "err: invalid bundle",
+			req: &featureproto.ImportFeatureBundleRequest{
+				EnvironmentId: "namespace",
+				Bundle:        []byte("version: 2\n"),
+				DryRun:        true,
+			},
+			expectedErr: newInvalidBundleError(errInvalid),
+		},
+		{
+			desc: "err: environment requires change approval",
+			setup: func(s *FeatureService) {
+				expectGetEnvironment(s, &envproto.EnvironmentV2{Id: "namespace", RequireChangeApproval: true})
+			},
+			req: &featureproto.ImportFeatureBundleRequest{
+				EnvironmentId: "namespace",
+				Bundle:        data,
+				DryRun:        true,
+			},
+			expectedErr: statusChangeApprovalRequired.Err(),
+		},
+		{
+			desc: "err: features were modified after the dry run",
+			setup: func(s *FeatureService) {
+				expectGetEnvironment(s, &envproto.EnvironmentV2{Id: "namespace"})
+				expectGetBundleState(s, []*featureproto.Feature{newTestBundleFeature()}, nil)
+			},
+			req: &featureproto.ImportFeatureBundleRequest{
+				EnvironmentId: "namespace",
+				Bundle:        data,
+				DiffToken:     "stale",
+			},
+			expectedErr: statusBundleDiffTokenMismatch.Err(),
+		},
+		{
+			desc: "success: dry run",
+			setup: func(s *FeatureService) {
+				expectGetEnvironment(s, &envproto.EnvironmentV2{Id: "namespace"})
+				expectGetBundleState(s, []*featureproto.Feature{newTestBundleFeature()}, nil)
+			},
+			req: &featureproto.ImportFeatureBundleRequest{
+				EnvironmentId: "namespace",
+				Bundle:        data,
+				DryRun:        true,
+			},
+			expected: &featureproto.ImportFeatureBundleResponse{
+				Changes: []*featureproto.FeatureBundleChange{
+					{
+						ResourceType: featureproto.FeatureBundleChange_TAG,
+						Action:       featureproto.FeatureBundleChange_CREATE,
+						ResourceId:   "web",
+					},
+					{
+						ResourceType: featureproto.FeatureBundleChange_FEATURE,
+						Action:       featureproto.FeatureBundleChange_CREATE,
+						ResourceId:   "feature-b",
+					},
+					{
+						ResourceType: featureproto.FeatureBundleChange_FEATURE,
+						Action:       featureproto.FeatureBundleChange_UPDATE,
+						ResourceId:   "feature-a",
+						Fields:       []string{"name"},
+					},
+				},
+				DiffToken: token,
+			},
+		},
+		{
+			desc: "success: dry run removes the segments and tags missing from the bundle",
+			setup: func(s *FeatureService) {
+				expectGetEnvironment(s, &envproto.EnvironmentV2{Id: "namespace"})
+				expectGetBundleState(
+					s,
+					[]*featureproto.Feature{newTestBundleFeature(), archived},
+					[]*featureproto.Segment{{Id: "segment-id", Name: "old"}},
+					"web", "archived", "legacy",
+				)
+			},
+			req: &featureproto.ImportFeatureBundleRequest{
+				EnvironmentId: "namespace",
+				Bundle:        data,
+				DryRun:        true,
+			},
+			expected: &featureproto.ImportFeatureBundleResponse{
+				Changes: []*featureproto.FeatureBundleChange{
+					{
+						ResourceType: featureproto.FeatureBundleChange_FEATURE,
+						Action:       featureproto.FeatureBundleChange_CREATE,
+						ResourceId:   "feature-b",
+					},
+					{
+						ResourceType: featureproto.FeatureBundleChange_FEATURE,
+						Action:       featureproto.FeatureBundleChange_UPDATE,
+						ResourceId:   "feature-a",
+						Fields:       []string{"name"},
+					},
+					{
+						ResourceType: featureproto.FeatureBundleChange_SEGMENT,
+						Action:       featureproto.FeatureBundleChange_DELETE,
+						ResourceId:   "old",
+					},
+					{
+						ResourceType: featureproto.FeatureBundleChange_TAG,
+						Action:       featureproto.FeatureBundleChange_DELETE,
+						ResourceId:   "legacy",
+					},
+				},
+				DiffToken: removalToken,
+			},
+		},
+		{
+			desc: "success: partial import when a write fails",
+			setup: func(s *FeatureService) {
+				expectGetEnvironment(s, &envproto.EnvironmentV2{Id: "namespace"})
+				expectGetBundleState(s, []*featureproto.Feature{newTestBundleFeature()}, nil)
+				s.tagStorage.(*tagstoragemock.MockTagStorage).EXPECT().UpsertTag(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.dbClient.(*databasemock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).Return(v2fs.ErrFeatureAlreadyExists)
+				expectGetBundleState(s, []*featureproto.Feature{newTestBundleFeature()}, nil, "web")
+			},
+			req: &featureproto.ImportFeatureBundleRequest{
+				EnvironmentId: "namespace",
+				Bundle:        data,
+				DiffToken:     token,
+			},
+			expected: &featureproto.ImportFeatureBundleResponse{
+				Changes: []*featureproto.FeatureBundleChange{
+					{
+						ResourceType: featureproto.FeatureBundleChange_TAG,
+						Action:       featureproto.FeatureBundleChange_CREATE,
+						ResourceId:   "web",
+					},
+				},
+				DiffToken:    partialToken,
+				Applied:      true,
+				Partial:      true,
+				ErrorMessage: status.Convert(statusAlreadyExists.Err()).Message(),
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			service := createFeatureServiceNew(gomock.NewController(t))
+			if p.setup != nil {
+				p.setup(service)
+			}
+			resp, err := service.ImportFeatureBundle(createContextWithToken(), p.req)
+			// The error details hold a map, so their encoding is not stable across calls.
+			assert.Equal(t, status.Code(p.expectedErr), status.Code(err))
+			assert.Equal(t, status.Convert(p.expectedErr).Message(), status.Convert(err).Message())
+			assert.Equal(t, p.expected, resp)
+		})
+	}
+}
+
+func TestOrderFeaturesForArchive(t *testing.T) {
+	t.Parallel()
+	a := &featureproto.Feature{Id: "a"}
+	b := &featureproto.Feature{
+		Id:            "b",
+		Prerequisites: []*featureproto.Prerequisite{{FeatureId: "a"}},
+	}
+	c := &featureproto.Feature{
+		Id:            "c",
+		Prerequisites: []*featureproto.Prerequisite{{FeatureId: "b"}},
+	}
+	actual := orderFeaturesForArchive([]*featureproto.Feature{a, b, c})
+	assert.Equal(t, []*featureproto.Feature{c, b, a}, actual)
+}
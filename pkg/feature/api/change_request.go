@@ -0,0 +1,756 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	domainevent "github.com/bucketeer-io/bucketeer/v2/pkg/domainevent/domain"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
+	v2fs "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	envproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
+	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+	ftproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+func (s *FeatureService) CreateChangeRequest(
+	ctx context.Context,
+	req *ftproto.CreateChangeRequestRequest,
+) (*ftproto.CreateChangeRequestResponse, error) {
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_UPDATE,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateCreateChangeRequestRequest(req); err != nil {
+		return nil, err
+	}
+
+	env, err := s.validateEnvironmentSettings(ctx, req.EnvironmentId, req.Comment)
+	if err != nil {
+		return nil, err
+	}
+	if !env.RequireChangeApproval {
+		return nil, statusChangeApprovalNotRequired.Err()
+	}
+
+	cr, _, err := s.createChangeRequest(ctx, editor, env, req.FeatureId, req.Payload, req.Comment)
+	if err != nil {
+		return nil, err
+	}
+	return &ftproto.CreateChangeRequestResponse{
+		ChangeRequest: cr.ChangeRequest,
+	}, nil
+}
+
+// createChangeRequestFromUpdate stores an UpdateFeature request as a change request
+// instead of applying it. It is used in environments that require change approval.
+func (s *FeatureService) createChangeRequestFromUpdate(
+	ctx context.Context,
+	editor *eventproto.Editor,
+	env *envproto.EnvironmentV2,
+	req *ftproto.UpdateFeatureRequest,
+) (*ftproto.UpdateFeatureResponse, error) {
+	payload, err := convertUpdateRequestToPayload(req)
+	if err != nil {
+		return nil, err
+	}
+	cr, feature, err := s.createChangeRequest(ctx, editor, env, req.Id, payload, req.Comment)
+	if err != nil {
+		return nil, err
+	}
+	return &ftproto.UpdateFeatureResponse{
+		Feature:       feature.Feature,
+		ChangeRequest: cr.ChangeRequest,
+	}, nil
+}
+
+func (s *FeatureService) createChangeRequest(
+	ctx context.Context,
+	editor *eventproto.Editor,
+	env *envproto.EnvironmentV2,
+	featureID string,
+	payload *ftproto.ScheduledChangePayload,
+	comment string,
+) (*domain.ChangeRequest, *domain.Feature, error) {
+	var cr *domain.ChangeRequest
+	var feature *domain.Feature
+	err := s.dbClient.RunInTransactionV2(ctx, func(ctxWithTx context.Context) error {
+		var err error
+		feature, err = s.featureStorage.GetFeature(ctxWithTx, featureID, env.Id)
+		if err != nil {
+			if errors.Is(err, v2fs.ErrFeatureNotFound) {
+				return statusFeatureNotFound.Err()
+			}
+			s.logger.Error(
+				"Failed to get feature for change request",
+				log.FieldsFromIncomingContext(ctx).AddFields(
+					zap.Error(err),
+					zap.String("featureId", featureID),
+					zap.String("environmentId", env.Id),
+				)...,
+			)
+			return statusInternal.Err()
+		}
+
+		if err := s.validateScheduledChangePayload(ctxWithTx, payload, feature.Feature, env.Id); err != nil {
+			return err
+		}
+
+		cr, err = domain.NewChangeRequest(
+			featureID,
+			env.Id,
+			payload,
+			comment,
+			feature.Version,
+			env.ChangeApprovalMinApprovers,
+			editor.Email,
+		)
+		if err != nil {
+			s.logger.Error(
+				"Failed to create change request domain object",
+				log.FieldsFromIncomingContext(ctx).AddFields(
+					zap.Error(err),
+					zap.String("featureId", featureID),
+					zap.String("environmentId", env.Id),
+				)...,
+			)
+			return statusInternal.Err()
+		}
+
+		if err := s.changeRequestStorage.CreateChangeRequest(ctxWithTx, cr); err != nil {
+			if errors.Is(err, v2fs.ErrChangeRequestAlreadyExists) {
+				return statusAlreadyExists.Err()
+			}
+			s.logger.Error(
+				"Failed to store change request",
+				log.FieldsFromIncomingContext(ctx).AddFields(
+					zap.Error(err),
+					zap.String("featureId", featureID),
+					zap.String("environmentId", env.Id),
+				)...,
+			)
+			return statusInternal.Err()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.publishChangeRequestCreatedEvent(ctx, editor, cr, feature, env.Id); err != nil {
+		s.logger.Error(
+			"Failed to publish change request created event",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("id", cr.Id),
+				zap.String("featureId", featureID),
+				zap.String("environmentId", env.Id),
+			)...,
+		)
+		// Don't fail the request if event publishing fails
+	}
+	return cr, feature, nil
+}
+
+func (s *FeatureService) GetChangeRequest(
+	ctx context.Context,
+	req *ftproto.GetChangeRequestRequest,
+) (*ftproto.GetChangeRequestResponse, error) {
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_READ,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == "" {
+		return nil, statusMissingChangeRequestID.Err()
+	}
+
+	cr, err := s.changeRequestStorage.GetChangeRequest(ctx, req.Id, req.EnvironmentId)
+	if err != nil {
+		if errors.Is(err, v2fs.ErrChangeRequestNotFound) {
+			return nil, statusChangeRequestNotFound.Err()
+		}
+		s.logger.Error(
+			"Failed to get change request",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("id", req.Id),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		return nil, statusInternal.Err()
+	}
+
+	return &ftproto.GetChangeRequestResponse{
+		ChangeRequest: cr.ChangeRequest,
+	}, nil
+}
+
+func (s *FeatureService) ListChangeRequests(
+	ctx context.Context,
+	req *ftproto.ListChangeRequestsRequest,
+) (*ftproto.ListChangeRequestsResponse, error) {
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_READ,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Pagination
+	limit := int(req.PageSize)
+	if limit <= 0 || limit > maxPageSizePerRequest {
+		limit = maxPageSizePerRequest
+	}
+
+	offset := 0
+	if req.Cursor != "" {
+		var err error
+		offset, err = strconv.Atoi(req.Cursor)
+		if err != nil {
+			return nil, statusInvalidCursor.Err()
+		}
+		if offset < 0 {
+			return nil, statusInvalidCursor.Err()
+		}
+	}
+
+	params := v2fs.ListChangeRequestsParams{
+		EnvironmentID:  req.EnvironmentId,
+		FeatureID:      req.FeatureId,
+		Statuses:       req.Statuses,
+		OrderBy:        req.OrderBy,
+		OrderDirection: req.OrderDirection,
+		PageSize:       limit,
+		Offset:         offset,
+	}
+
+	crs, nextOffset, totalCount, err := s.changeRequestStorage.ListChangeRequests(ctx, params)
+	if err != nil {
+		s.logger.Error(
+			"Failed to list change requests",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		return nil, statusInternal.Err()
+	}
+
+	return &ftproto.ListChangeRequestsResponse{
+		ChangeRequests: crs,
+		Cursor:         strconv.Itoa(nextOffset),
+		TotalCount:     totalCount,
+	}, nil
+}
+
+func (s *FeatureService) ApproveChangeRequest(
+	ctx context.Context,
+	req *ftproto.ApproveChangeRequestRequest,
+) (*ftproto.ApproveChangeRequestResponse, error) {
+	if req.Id == "" {
+		return nil, statusMissingChangeRequestID.Err()
+	}
+	editor, err := s.checkChangeRequestPermission(ctx, req.Id, req.EnvironmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var cr *domain.ChangeRequest
+	var featureName string
+	err = s.dbClient.RunInTransactionV2(ctx, func(ctxWithTx context.Context) error {
+		var err error
+		cr, err = s.getChangeRequestWithinTransaction(ctx, ctxWithTx, req.Id, req.EnvironmentId)
+		if err != nil {
+			return err
+		}
+		if err := cr.Approve(editor.Email, req.Comment); err != nil {
+			return convChangeRequestDomainError(err)
+		}
+		featureName = s.getFeatureName(ctxWithTx, cr.FeatureId, req.EnvironmentId)
+		return s.updateChangeRequest(ctx, ctxWithTx, cr)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.publishChangeRequestApprovedEvent(
+		ctx, editor, cr, featureName, req.Comment, req.EnvironmentId,
+	); err != nil {
+		s.logger.Error(
+			"Failed to publish change request approved event",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("id", cr.Id),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		// Don't fail the request if event publishing fails
+	}
+
+	return &ftproto.ApproveChangeRequestResponse{
+		ChangeRequest: cr.ChangeRequest,
+	}, nil
+}
+
+func (s *FeatureService) RejectChangeRequest(
+	ctx context.Context,
+	req *ftproto.RejectChangeRequestRequest,
+) (*ftproto.RejectChangeRequestResponse, error) {
+	if req.Id == "" {
+		return nil, statusMissingChangeRequestID.Err()
+	}
+	editor, err := s.checkChangeRequestPermission(ctx, req.Id, req.EnvironmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var cr *domain.ChangeRequest
+	var featureName string
+	err = s.dbClient.RunInTransactionV2(ctx, func(ctxWithTx context.Context) error {
+		var err error
+		cr, err = s.getChangeRequestWithinTransaction(ctx, ctxWithTx, req.Id, req.EnvironmentId)
+		if err != nil {
+			return err
+		}
+		if err := cr.Reject(editor.Email, req.Comment); err != nil {
+			return convChangeRequestDomainError(err)
+		}
+		featureName = s.getFeatureName(ctxWithTx, cr.FeatureId, req.EnvironmentId)
+		return s.updateChangeRequest(ctx, ctxWithTx, cr)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.publishChangeRequestRejectedEvent(
+		ctx, editor, cr, featureName, req.EnvironmentId,
+	); err != nil {
+		s.logger.Error(
+			"Failed to publish change request rejected event",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("id", cr.Id),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		// Don't fail the request if event publishing fails
+	}
+
+	return &ftproto.RejectChangeRequestResponse{
+		ChangeRequest: cr.ChangeRequest,
+	}, nil
+}
+
+func (s *FeatureService) ApplyChangeRequest(
+	ctx context.Context,
+	req *ftproto.ApplyChangeRequestRequest,
+) (*ftproto.ApplyChangeRequestResponse, error) {
+	if req.Id == "" {
+		return nil, statusMissingChangeRequestID.Err()
+	}
+	editor, err := s.checkChangeRequestPermission(ctx, req.Id, req.EnvironmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var cr *domain.ChangeRequest
+	var feature *domain.Feature
+	var event *eventproto.Event
+	var updatedpb *ftproto.Feature
+	err = s.dbClient.RunInTransactionV2(ctx, func(ctxWithTx context.Context) error {
+		var err error
+		cr, err = s.getChangeRequestWithinTransaction(ctx, ctxWithTx, req.Id, req.EnvironmentId)
+		if err != nil {
+			return err
+		}
+		if !cr.IsApproved() {
+			return statusChangeRequestNotApproved.Err()
+		}
+
+		feature, err = s.featureStorage.GetFeature(ctxWithTx, cr.FeatureId, req.EnvironmentId)
+		if err != nil {
+			if errors.Is(err, v2fs.ErrFeatureNotFound) {
+				return statusFeatureNotFound.Err()
+			}
+			s.logger.Error(
+				"Failed to get feature for change request",
+				log.FieldsFromIncomingContext(ctx).AddFields(
+					zap.Error(err),
+					zap.String("id", req.Id),
+					zap.String("featureId", cr.FeatureId),
+					zap.String("environmentId", req.EnvironmentId),
+				)...,
+			)
+			return statusInternal.Err()
+		}
+		// The reviewers approved the change against a specific flag version.
+		// If the flag has been modified since then, the request must be recreated.
+		if feature.Version != cr.FlagVersionAtCreation {
+			return statusChangeRequestVersionMismatch.Err()
+		}
+
+		if err := s.validateScheduledChangePayload(ctxWithTx, cr.Payload, feature.Feature, req.EnvironmentId); err != nil {
+			return err
+		}
+
+		// Apply the changes using updateFeatureWithinTransaction (to avoid nested transactions)
+		updateReq := convertPayloadToUpdateRequest(cr.Payload, cr.FeatureId, req.EnvironmentId)
+		updateReq.Comment = "Applied from change request: " + cr.Comment
+
+		event, updatedpb, err = s.updateFeatureWithinTransaction(ctxWithTx, editor, updateReq)
+		if err != nil {
+			return err
+		}
+
+		if err := cr.MarkApplied(editor.Email); err != nil {
+			return convChangeRequestDomainError(err)
+		}
+		return s.updateChangeRequest(ctx, ctxWithTx, cr)
+	})
+	if err != nil {
+		return nil, s.convUpdateFeatureError(err)
+	}
+
+	// Publish domain events and update cache (post-transaction operations)
+	if errs := s.publishDomainEvents(ctx, []*eventproto.Event{event}); len(errs) > 0 {
+		s.logger.Error(
+			"Failed to publish events after applying change request",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Any("errors", errs),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		// Don't fail the request, the change has already been applied
+	}
+	if err := s.publishChangeRequestAppliedEvent(ctx, editor, cr, feature, req.EnvironmentId); err != nil {
+		s.logger.Error(
+			"Failed to publish change request applied event",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("id", cr.Id),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+	}
+
+	// Same as UpdateFeature, conflict detection runs outside the transaction
+	conflictCount := s.detectScheduledFlagChangeConflicts(ctx, updatedpb, cr.FeatureId, req.EnvironmentId)
+
+	s.updateFeatureFlagCache(ctx)
+
+	return &ftproto.ApplyChangeRequestResponse{
+		ChangeRequest:             cr.ChangeRequest,
+		Feature:                   updatedpb,
+		ScheduleConflictsDetected: conflictCount > 0,
+		ConflictCount:             conflictCount,
+	}, nil
+}
+
+// Helper functions
+
+// checkChangeRequestPermission checks that the caller could make the requested
+// change through UpdateFeature, so reviewing or applying a change request
+// requires the same actions on the same flag as making the change directly.
+func (s *FeatureService) checkChangeRequestPermission(
+	ctx context.Context,
+	id, environmentID string,
+) (*eventproto.Editor, error) {
+	cr, err := s.getChangeRequestWithinTransaction(ctx, ctx, id, environmentID)
+	if err != nil {
+		return nil, err
+	}
+	updateReq := convertPayloadToUpdateRequest(cr.Payload, cr.FeatureId, environmentID)
+	return s.checkPermission(
+		ctx,
+		environmentID,
+		updateFeaturePermissions(updateReq, s.featureTags(ctx, cr.FeatureId, environmentID))...,
+	)
+}
+
+func validateCreateChangeRequestRequest(req *ftproto.CreateChangeRequestRequest) error {
+	if req.FeatureId == "" {
+		return statusMissingFeatureID.Err()
+	}
+	if req.Payload == nil {
+		return statusMissingPayload.Err()
+	}
+	sfcDomain := &domain.ScheduledFlagChange{
+		ScheduledFlagChange: &ftproto.ScheduledFlagChange{Payload: req.Payload},
+	}
+	if sfcDomain.IsEmptyPayload() {
+		return statusEmptyPayload.Err()
+	}
+	return validateRuleChanges(req.Payload.RuleChanges)
+}
+
+// convertUpdateRequestToPayload is the inverse of convertPayloadToUpdateRequest.
+func convertUpdateRequestToPayload(req *ftproto.UpdateFeatureRequest) (*ftproto.ScheduledChangePayload, error) {
+	payload := &ftproto.ScheduledChangePayload{
+		VariationChanges:          req.VariationChanges,
+		RuleChanges:               req.RuleChanges,
+		PrerequisiteChanges:       req.PrerequisiteChanges,
+		TargetChanges:             req.TargetChanges,
+		TagChanges:                req.TagChanges,
+		DefaultStrategy:           req.DefaultStrategy,
+		OffVariation:              req.OffVariation,
+		Enabled:                   req.Enabled,
+		Name:                      req.Name,
+		Description:               req.Description,
+		Archived:                  req.Archived,
+		ResetSamplingSeed:         req.ResetSamplingSeed,
+		Maintainer:                req.Maintainer,
+		OrderedRuleIds:            req.OrderedRuleIds,
+		Tags:                      req.Tags,
+		VariationValueSchema:      req.VariationValueSchema,
+		ClearVariationValueSchema: req.ClearVariationValueSchema,
+		Kind:                      req.Kind,
+		PlannedRemovalAt:          req.PlannedRemovalAt,
+	}
+	sfcDomain := &domain.ScheduledFlagChange{
+		ScheduledFlagChange: &ftproto.ScheduledFlagChange{Payload: payload},
+	}
+	if sfcDomain.IsEmptyPayload() {
+		return nil, statusEmptyPayload.Err()
+	}
+	return payload, nil
+}
+
+func (s *FeatureService) getChangeRequestWithinTransaction(
+	ctx, ctxWithTx context.Context,
+	id, environmentID string,
+) (*domain.ChangeRequest, error) {
+	cr, err := s.changeRequestStorage.GetChangeRequest(ctxWithTx, id, environmentID)
+	if err != nil {
+		if errors.Is(err, v2fs.ErrChangeRequestNotFound) {
+			return nil, statusChangeRequestNotFound.Err()
+		}
+		s.logger.Error(
+			"Failed to get change request",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("id", id),
+				zap.String("environmentId", environmentID),
+			)...,
+		)
+		return nil, statusInternal.Err()
+	}
+	return cr, nil
+}
+
+func (s *FeatureService) updateChangeRequest(
+	ctx, ctxWithTx context.Context,
+	cr *domain.ChangeRequest,
+) error {
+	if err := s.changeRequestStorage.UpdateChangeRequest(ctxWithTx, cr); err != nil {
+		s.logger.Error(
+			"Failed to update change request",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("id", cr.Id),
+				zap.String("environmentId", cr.EnvironmentId),
+			)...,
+		)
+		return statusInternal.Err()
+	}
+	return nil
+}
+
+// getFeatureName returns the feature name used in the audit events.
+// The name is informational only, so lookup failures fall back to an empty name.
+func (s *FeatureService) getFeatureName(ctx context.Context, featureID, environmentID string) string {
+	feature, err := s.featureStorage.GetFeature(ctx, featureID, environmentID)
+	if err != nil || feature == nil {
+		return ""
+	}
+	return feature.Name
+}
+
+func convChangeRequestDomainError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrChangeRequestNotPending):
+		return statusChangeRequestNotPending.Err()
+	case errors.Is(err, domain.ErrChangeRequestNotApproved):
+		return statusChangeRequestNotApproved.Err()
+	case errors.Is(err, domain.ErrChangeRequestSelfApproval):
+		return statusChangeRequestSelfApproval.Err()
+	case errors.Is(err, domain.ErrChangeRequestAlreadyApproved):
+		return statusChangeRequestAlreadyApproved.Err()
+	default:
+		return statusInternal.Err()
+	}
+}
+
+// publishChangeRequestCreatedEvent publishes a domain event for audit logging
+func (s *FeatureService) publishChangeRequestCreatedEvent(
+	ctx context.Context,
+	editor *eventproto.Editor,
+	cr *domain.ChangeRequest,
+	feature *domain.Feature,
+	environmentID string,
+) error {
+	payloadJSON, err := protojson.Marshal(cr.Payload)
+	if err != nil {
+		return err
+	}
+	summaryOptions := s.buildChangeSummaryOptions(ctx, environmentID, cr.Payload, feature.Feature)
+	changeSummaries := changeSummariesToStrings(cr.ChangeSummaries(feature.Feature, summaryOptions))
+
+	event, err := domainevent.NewEvent(
+		editor,
+		eventproto.Event_CHANGE_REQUEST,
+		cr.Id,
+		eventproto.Event_CHANGE_REQUEST_CREATED,
+		&eventproto.ChangeRequestCreatedEvent{
+			Id:              cr.Id,
+			FeatureId:       cr.FeatureId,
+			FeatureName:     feature.Name,
+			ChangeSummaries: changeSummaries,
+			PayloadJson:     string(payloadJSON),
+			Comment:         cr.Comment,
+			MinApprovers:    cr.MinApprovers,
+			RequestedBy:     cr.CreatedBy,
+		},
+		environmentID,
+		cr.ChangeRequest,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	return s.domainPublisher.Publish(ctx, event)
+}
+
+// publishChangeRequestApprovedEvent publishes a domain event for audit logging
+func (s *FeatureService) publishChangeRequestApprovedEvent(
+	ctx context.Context,
+	editor *eventproto.Editor,
+	cr *domain.ChangeRequest,
+	featureName string,
+	comment string,
+	environmentID string,
+) error {
+	event, err := domainevent.NewEvent(
+		editor,
+		eventproto.Event_CHANGE_REQUEST,
+		cr.Id,
+		eventproto.Event_CHANGE_REQUEST_APPROVED,
+		&eventproto.ChangeRequestApprovedEvent{
+			Id:            cr.Id,
+			FeatureId:     cr.FeatureId,
+			FeatureName:   featureName,
+			ApprovedBy:    editor.Email,
+			Comment:       comment,
+			ApprovalCount: int32(len(cr.Approvals)),
+			MinApprovers:  cr.MinApprovers,
+			RequestedBy:   cr.CreatedBy,
+		},
+		environmentID,
+		cr.ChangeRequest,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	return s.domainPublisher.Publish(ctx, event)
+}
+
+// publishChangeRequestRejectedEvent publishes a domain event for audit logging
+func (s *FeatureService) publishChangeRequestRejectedEvent(
+	ctx context.Context,
+	editor *eventproto.Editor,
+	cr *domain.ChangeRequest,
+	featureName string,
+	environmentID string,
+) error {
+	event, err := domainevent.NewEvent(
+		editor,
+		eventproto.Event_CHANGE_REQUEST,
+		cr.Id,
+		eventproto.Event_CHANGE_REQUEST_REJECTED,
+		&eventproto.ChangeRequestRejectedEvent{
+			Id:          cr.Id,
+			FeatureId:   cr.FeatureId,
+			FeatureName: featureName,
+			RejectedBy:  cr.RejectedBy,
+			Comment:     cr.RejectionComment,
+			RequestedBy: cr.CreatedBy,
+		},
+		environmentID,
+		cr.ChangeRequest,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	return s.domainPublisher.Publish(ctx, event)
+}
+
+// publishChangeRequestAppliedEvent publishes a domain event for audit logging.
+// The change summaries are generated from the flag state before the change was applied.
+func (s *FeatureService) publishChangeRequestAppliedEvent(
+	ctx context.Context,
+	editor *eventproto.Editor,
+	cr *domain.ChangeRequest,
+	feature *domain.Feature,
+	environmentID string,
+) error {
+	payloadJSON, err := protojson.Marshal(cr.Payload)
+	if err != nil {
+		return err
+	}
+	summaryOptions := s.buildChangeSummaryOptions(ctx, environmentID, cr.Payload, feature.Feature)
+	changeSummaries := changeSummariesToStrings(cr.ChangeSummaries(feature.Feature, summaryOptions))
+
+	event, err := domainevent.NewEvent(
+		editor,
+		eventproto.Event_CHANGE_REQUEST,
+		cr.Id,
+		eventproto.Event_CHANGE_REQUEST_APPLIED,
+		&eventproto.ChangeRequestAppliedEvent{
+			Id:              cr.Id,
+			FeatureId:       cr.FeatureId,
+			FeatureName:     feature.Name,
+			ChangeSummaries: changeSummaries,
+			PayloadJson:     string(payloadJSON),
+			ApprovedBy:      cr.ApproverEmails(),
+			AppliedBy:       editor.Email,
+			RequestedBy:     cr.CreatedBy,
+		},
+		environmentID,
+		cr.ChangeRequest,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	return s.domainPublisher.Publish(ctx, event)
+}
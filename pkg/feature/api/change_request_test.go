@@ -0,0 +1,721 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	accountclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/account/client/mock"
+	btclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/batch/client/mock"
+	envclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/environment/client/mock"
+	exprclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/experiment/client/mock"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
+	v2fs "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2/mock"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher"
+	publishermock "github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher/mock"
+	databasemock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database/mock"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	commonproto "github.com/bucketeer-io/bucketeer/v2/proto/common"
+	envproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
+	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+	exprproto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+func newTestChangeRequestFeature() *domain.Feature {
+	return &domain.Feature{
+		Feature: &featureproto.Feature{
+			Id:      "feature-id",
+			Name:    "Test Feature",
+			Version: 3,
+			Variations: []*featureproto.Variation{
+				{Id: "var-1", Name: "Variation 1", Value: "true"},
+				{Id: "var-2", Name: "Variation 2", Value: "false"},
+			},
+			OffVariation: "var-2",
+			DefaultStrategy: &featureproto.Strategy{
+				Type:          featureproto.Strategy_FIXED,
+				FixedStrategy: &featureproto.FixedStrategy{Variation: "var-1"},
+			},
+		},
+	}
+}
+
+func newTestEnumSchema() *featureproto.VariationValueSchema {
+	return &featureproto.VariationValueSchema{
+		Type: featureproto.VariationValueSchema_ENUM,
+		Validator: &featureproto.VariationValueSchema_EnumValidator_{
+			EnumValidator: &featureproto.VariationValueSchema_EnumValidator{Values: []string{"true", "false"}},
+		},
+	}
+}
+
+func newTestChangeRequestForAPI(status featureproto.ChangeRequestStatus) *domain.ChangeRequest {
+	return &domain.ChangeRequest{
+		ChangeRequest: &featureproto.ChangeRequest{
+			Id:                    "cr-id",
+			FeatureId:             "feature-id",
+			EnvironmentId:         "namespace",
+			Payload:               &featureproto.ScheduledChangePayload{Enabled: wrapperspb.Bool(true)},
+			Comment:               "Enable flag",
+			Status:                status,
+			FlagVersionAtCreation: 3,
+			MinApprovers:          1,
+			CreatedBy:             "author@example.com",
+		},
+	}
+}
+
+func expectGetEnvironment(s *FeatureService, env *envproto.EnvironmentV2) {
+	s.environmentClient.(*envclientmock.MockClient).EXPECT().GetEnvironmentV2(
+		gomock.Any(),
+		&envproto.GetEnvironmentV2Request{Id: env.Id},
+	).Return(&envproto.GetEnvironmentV2Response{Environment: env}, nil)
+}
+
+func expectRunInTransaction(s *FeatureService) {
+	s.dbClient.(*databasemock.MockClient).EXPECT().RunInTransactionV2(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(context.Context) error) error {
+			return f(ctx)
+		},
+	)
+}
+
+func TestCreateChangeRequest(t *testing.T) {
+	t.Parallel()
+
+	protectedEnv := &envproto.EnvironmentV2{
+		Id:                         "namespace",
+		RequireChangeApproval:      true,
+		ChangeApprovalMinApprovers: 2,
+	}
+	patterns := []struct {
+		desc        string
+		setup       func(*testing.T, *FeatureService)
+		req         *featureproto.CreateChangeRequestRequest
+		expectedErr error
+	}{
+		{
+			desc: "err: missing feature id",
+			req: &featureproto.CreateChangeRequestRequest{
+				EnvironmentId: "namespace",
+				Payload:       &featureproto.ScheduledChangePayload{Enabled: wrapperspb.Bool(true)},
+			},
+			expectedErr: statusMissingFeatureID.Err(),
+		},
+		{
+			desc: "err: missing payload",
+			req: &featureproto.CreateChangeRequestRequest{
+				EnvironmentId: "namespace",
+				FeatureId:     "feature-id",
+			},
+			expectedErr: statusMissingPayload.Err(),
+		},
+		{
+			desc: "err: empty payload",
+			req: &featureproto.CreateChangeRequestRequest{
+				EnvironmentId: "namespace",
+				FeatureId:     "feature-id",
+				Payload:       &featureproto.ScheduledChangePayload{},
+			},
+			expectedErr: statusEmptyPayload.Err(),
+		},
+		{
+			desc: "err: environment does not require change approval",
+			setup: func(t *testing.T, s *FeatureService) {
+				expectGetEnvironment(s, &envproto.EnvironmentV2{Id: "namespace"})
+			},
+			req: &featureproto.CreateChangeRequestRequest{
+				EnvironmentId: "namespace",
+				FeatureId:     "feature-id",
+				Payload:       &featureproto.ScheduledChangePayload{Enabled: wrapperspb.Bool(true)},
+			},
+			expectedErr: statusChangeApprovalNotRequired.Err(),
+		},
+		{
+			desc: "err: feature not found",
+			setup: func(t *testing.T, s *FeatureService) {
+				expectGetEnvironment(s, protectedEnv)
+				expectRunInTransaction(s)
+				s.featureStorage.(*mock.MockFeatureStorage).EXPECT().GetFeature(
+					gomock.Any(), "feature-id", "namespace",
+				).Return(nil, v2fs.ErrFeatureNotFound)
+			},
+			req: &featureproto.CreateChangeRequestRequest{
+				EnvironmentId: "namespace",
+				FeatureId:     "feature-id",
+				Payload:       &featureproto.ScheduledChangePayload{Enabled: wrapperspb.Bool(true)},
+			},
+			expectedErr: statusFeatureNotFound.Err(),
+		},
+		{
+			desc: "success",
+			setup: func(t *testing.T, s *FeatureService) {
+				expectGetEnvironment(s, protectedEnv)
+				expectRunInTransaction(s)
+				s.featureStorage.(*mock.MockFeatureStorage).EXPECT().GetFeature(
+					gomock.Any(), "feature-id", "namespace",
+				).Return(newTestChangeRequestFeature(), nil)
+				s.changeRequestStorage.(*mock.MockChangeRequestStorage).EXPECT().CreateChangeRequest(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.domainPublisher.(*publishermock.MockPublisher).EXPECT().Publish(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(_ context.Context, msg publisher.Message) error {
+					created := &eventproto.ChangeRequestCreatedEvent{}
+					require.NoError(t, msg.(*eventproto.Event).Data.UnmarshalTo(created))
+					// The payload is encoded with protojson, so wrapper values are plain JSON values.
+					assert.JSONEq(t, `{"enabled":true}`, created.PayloadJson)
+					return nil
+				})
+			},
+			req: &featureproto.CreateChangeRequestRequest{
+				EnvironmentId: "namespace",
+				FeatureId:     "feature-id",
+				Payload:       &featureproto.ScheduledChangePayload{Enabled: wrapperspb.Bool(true)},
+				Comment:       "Enable flag",
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			service := createFeatureServiceNew(gomock.NewController(t))
+			if p.setup != nil {
+				p.setup(t, service)
+			}
+			resp, err := service.CreateChangeRequest(createContextWithToken(), p.req)
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, "feature-id", resp.ChangeRequest.FeatureId)
+				assert.Equal(t, featureproto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING, resp.ChangeRequest.Status)
+				assert.Equal(t, int32(3), resp.ChangeRequest.FlagVersionAtCreation)
+				assert.Equal(t, int32(2), resp.ChangeRequest.MinApprovers)
+				assert.Equal(t, "email", resp.ChangeRequest.CreatedBy)
+			}
+		})
+	}
+}
+
+func TestUpdateFeatureRequiresChangeApproval(t *testing.T) {
+	t.Parallel()
+
+	plannedRemovalAt := time.Now().Add(30 * 24 * time.Hour).Unix()
+	expectCreate := func(s *FeatureService) {
+		expectRunInTransaction(s)
+		s.featureStorage.(*mock.MockFeatureStorage).EXPECT().GetFeature(
+			gomock.Any(), "feature-id", "namespace",
+		).Return(newTestChangeRequestFeature(), nil)
+		s.changeRequestStorage.(*mock.MockChangeRequestStorage).EXPECT().CreateChangeRequest(
+			gomock.Any(), gomock.Any(),
+		).Return(nil)
+		s.domainPublisher.(*publishermock.MockPublisher).EXPECT().Publish(
+			gomock.Any(), gomock.Any(),
+		).Return(nil)
+	}
+	patterns := []struct {
+		desc            string
+		setup           func(*FeatureService)
+		req             *featureproto.UpdateFeatureRequest
+		expectedPayload *featureproto.ScheduledChangePayload
+		expectedErr     error
+	}{
+		{
+			desc:  "success: change request is created instead of updating the feature",
+			setup: expectCreate,
+			req: &featureproto.UpdateFeatureRequest{
+				EnvironmentId: "namespace",
+				Id:            "feature-id",
+				Comment:       "comment",
+				Enabled:       wrapperspb.Bool(true),
+			},
+			expectedPayload: &featureproto.ScheduledChangePayload{Enabled: wrapperspb.Bool(true)},
+		},
+		{
+			desc:  "success: tags, variation value schema and lifecycle are kept in the change request",
+			setup: expectCreate,
+			req: &featureproto.UpdateFeatureRequest{
+				EnvironmentId:        "namespace",
+				Id:                   "feature-id",
+				Comment:              "comment",
+				Tags:                 &commonproto.StringListValue{Values: []string{"web", "ios"}},
+				VariationValueSchema: newTestEnumSchema(),
+				Kind:                 featureproto.FeatureLifecycle_RELEASE,
+				PlannedRemovalAt:     wrapperspb.Int64(plannedRemovalAt),
+			},
+			expectedPayload: &featureproto.ScheduledChangePayload{
+				Tags:                 &commonproto.StringListValue{Values: []string{"web", "ios"}},
+				VariationValueSchema: newTestEnumSchema(),
+				Kind:                 featureproto.FeatureLifecycle_RELEASE,
+				PlannedRemovalAt:     wrapperspb.Int64(plannedRemovalAt),
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			service := createFeatureServiceNew(gomock.NewController(t))
+			service.experimentClient.(*exprclientmock.MockClient).EXPECT().ListExperiments(
+				gomock.Any(), gomock.Any(),
+			).Return(&exprproto.ListExperimentsResponse{}, nil)
+			expectGetEnvironment(service, &envproto.EnvironmentV2{
+				Id:                         "namespace",
+				RequireChangeApproval:      true,
+				ChangeApprovalMinApprovers: 1,
+			})
+			if p.setup != nil {
+				p.setup(service)
+			}
+			resp, err := service.UpdateFeature(createContextWithToken(), p.req)
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				require.NotNil(t, resp.ChangeRequest)
+				assert.True(t, proto.Equal(p.expectedPayload, resp.ChangeRequest.Payload))
+				// The feature is returned unchanged
+				assert.Equal(t, int32(3), resp.Feature.Version)
+				assert.False(t, resp.Feature.Enabled)
+			}
+		})
+	}
+}
+
+func TestConvertUpdateRequestToPayloadRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	req := &featureproto.UpdateFeatureRequest{
+		EnvironmentId:             "namespace",
+		Id:                        "feature-id",
+		Enabled:                   wrapperspb.Bool(true),
+		Tags:                      &commonproto.StringListValue{Values: []string{"web"}},
+		VariationValueSchema:      newTestEnumSchema(),
+		ClearVariationValueSchema: wrapperspb.Bool(false),
+		Kind:                      featureproto.FeatureLifecycle_EXPERIMENT,
+		PlannedRemovalAt:          wrapperspb.Int64(time.Now().Add(time.Hour).Unix()),
+	}
+	payload, err := convertUpdateRequestToPayload(req)
+	require.NoError(t, err)
+	// Applying the change request must make the same update as the original request.
+	actual := convertPayloadToUpdateRequest(payload, req.Id, req.EnvironmentId)
+	assert.True(t, proto.Equal(req, actual))
+}
+
+func TestGetChangeRequest(t *testing.T) {
+	t.Parallel()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*FeatureService)
+		req         *featureproto.GetChangeRequestRequest
+		expectedErr error
+	}{
+		{
+			desc:        "err: missing id",
+			req:         &featureproto.GetChangeRequestRequest{EnvironmentId: "namespace"},
+			expectedErr: statusMissingChangeRequestID.Err(),
+		},
+		{
+			desc: "err: not found",
+			setup: func(s *FeatureService) {
+				s.changeRequestStorage.(*mock.MockChangeRequestStorage).EXPECT().GetChangeRequest(
+					gomock.Any(), "cr-id", "namespace",
+				).Return(nil, v2fs.ErrChangeRequestNotFound)
+			},
+			req:         &featureproto.GetChangeRequestRequest{EnvironmentId: "namespace", Id: "cr-id"},
+			expectedErr: statusChangeRequestNotFound.Err(),
+		},
+		{
+			desc: "success",
+			setup: func(s *FeatureService) {
+				s.changeRequestStorage.(*mock.MockChangeRequestStorage).EXPECT().GetChangeRequest(
+					gomock.Any(), "cr-id", "namespace",
+				).Return(newTestChangeRequestForAPI(featureproto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING), nil)
+			},
+			req: &featureproto.GetChangeRequestRequest{EnvironmentId: "namespace", Id: "cr-id"},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			service := createFeatureServiceNew(gomock.NewController(t))
+			if p.setup != nil {
+				p.setup(service)
+			}
+			resp, err := service.GetChangeRequest(createContextWithToken(), p.req)
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, "cr-id", resp.ChangeRequest.Id)
+			}
+		})
+	}
+}
+
+func TestListChangeRequests(t *testing.T) {
+	t.Parallel()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*FeatureService)
+		req         *featureproto.ListChangeRequestsRequest
+		expectedErr error
+	}{
+		{
+			desc: "err: invalid cursor",
+			req: &featureproto.ListChangeRequestsRequest{
+				EnvironmentId: "namespace",
+				Cursor:        "invalid",
+			},
+			expectedErr: statusInvalidCursor.Err(),
+		},
+		{
+			desc: "success",
+			setup: func(s *FeatureService) {
+				s.changeRequestStorage.(*mock.MockChangeRequestStorage).EXPECT().ListChangeRequests(
+					gomock.Any(),
+					v2fs.ListChangeRequestsParams{
+						EnvironmentID: "namespace",
+						FeatureID:     "feature-id",
+						Statuses: []featureproto.ChangeRequestStatus{
+							featureproto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING,
+						},
+						PageSize: 10,
+						Offset:   5,
+					},
+				).Return(
+					[]*featureproto.ChangeRequest{
+						newTestChangeRequestForAPI(featureproto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING).ChangeRequest,
+					},
+					6, int64(6), nil,
+				)
+			},
+			req: &featureproto.ListChangeRequestsRequest{
+				EnvironmentId: "namespace",
+				FeatureId:     "feature-id",
+				Statuses: []featureproto.ChangeRequestStatus{
+					featureproto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING,
+				},
+				PageSize: 10,
+				Cursor:   "5",
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			service := createFeatureServiceNew(gomock.NewController(t))
+			if p.setup != nil {
+				p.setup(service)
+			}
+			resp, err := service.ListChangeRequests(createContextWithToken(), p.req)
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.Len(t, resp.ChangeRequests, 1)
+				assert.Equal(t, "6", resp.Cursor)
+				assert.Equal(t, int64(6), resp.TotalCount)
+			}
+		})
+	}
+}
+
+func TestApproveChangeRequest(t *testing.T) {
+	t.Parallel()
+
+	patterns := []struct {
+		desc           string
+		changeRequest  *domain.ChangeRequest
+		getErr         error
+		expectedStatus featureproto.ChangeRequestStatus
+		expectedErr    error
+	}{
+		{
+			desc:        "err: not found",
+			getErr:      v2fs.ErrChangeRequestNotFound,
+			expectedErr: statusChangeRequestNotFound.Err(),
+		},
+		{
+			desc:          "err: not pending",
+			changeRequest: newTestChangeRequestForAPI(featureproto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_REJECTED),
+			expectedErr:   statusChangeRequestNotPending.Err(),
+		},
+		{
+			desc: "err: self approval",
+			changeRequest: func() *domain.ChangeRequest {
+				cr := newTestChangeRequestForAPI(featureproto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING)
+				cr.CreatedBy = "email"
+				return cr
+			}(),
+			expectedErr: statusChangeRequestSelfApproval.Err(),
+		},
+		{
+			desc:           "success",
+			changeRequest:  newTestChangeRequestForAPI(featureproto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING),
+			expectedStatus: featureproto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_APPROVED,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			service := createFeatureServiceNew(gomock.NewController(t))
+			if p.getErr == nil {
+				expectRunInTransaction(service)
+			}
+			// The change request is read for the permission check and again in the transaction.
+			service.changeRequestStorage.(*mock.MockChangeRequestStorage).EXPECT().GetChangeRequest(
+				gomock.Any(), "cr-id", "namespace",
+			).Return(p.changeRequest, p.getErr).MinTimes(1)
+			if p.expectedErr == nil {
+				service.featureStorage.(*mock.MockFeatureStorage).EXPECT().GetFeature(
+					gomock.Any(), "feature-id", "namespace",
+				).Return(newTestChangeRequestFeature(), nil)
+				service.changeRequestStorage.(*mock.MockChangeRequestStorage).EXPECT().UpdateChangeRequest(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				service.domainPublisher.(*publishermock.MockPublisher).EXPECT().Publish(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+			}
+			resp, err := service.ApproveChangeRequest(createContextWithToken(), &featureproto.ApproveChangeRequestRequest{
+				EnvironmentId: "namespace",
+				Id:            "cr-id",
+				Comment:       "lgtm",
+			})
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, p.expectedStatus, resp.ChangeRequest.Status)
+				require.Len(t, resp.ChangeRequest.Approvals, 1)
+				assert.Equal(t, "email", resp.ChangeRequest.Approvals[0].ApprovedBy)
+			}
+		})
+	}
+}
+
+func TestRejectChangeRequest(t *testing.T) {
+	t.Parallel()
+
+	service := createFeatureServiceNew(gomock.NewController(t))
+	expectRunInTransaction(service)
+	service.changeRequestStorage.(*mock.MockChangeRequestStorage).EXPECT().GetChangeRequest(
+		gomock.Any(), "cr-id", "namespace",
+	).Return(newTestChangeRequestForAPI(featureproto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_APPROVED), nil).Times(2)
+	service.featureStorage.(*mock.MockFeatureStorage).EXPECT().GetFeature(
+		gomock.Any(), "feature-id", "namespace",
+	).Return(newTestChangeRequestFeature(), nil)
+	service.changeRequestStorage.(*mock.MockChangeRequestStorage).EXPECT().UpdateChangeRequest(
+		gomock.Any(), gomock.Any(),
+	).Return(nil)
+	service.domainPublisher.(*publishermock.MockPublisher).EXPECT().Publish(
+		gomock.Any(), gomock.Any(),
+	).Return(nil)
+
+	resp, err := service.RejectChangeRequest(createContextWithToken(), &featureproto.RejectChangeRequestRequest{
+		EnvironmentId: "namespace",
+		Id:            "cr-id",
+		Comment:       "not now",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, featureproto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_REJECTED, resp.ChangeRequest.Status)
+	assert.Equal(t, "email", resp.ChangeRequest.RejectedBy)
+	assert.Equal(t, "not now", resp.ChangeRequest.RejectionComment)
+}
+
+func TestApplyChangeRequest(t *testing.T) {
+	t.Parallel()
+
+	patterns := []struct {
+		desc          string
+		changeRequest *domain.ChangeRequest
+		setup         func(*FeatureService)
+		expectedErr   error
+	}{
+		{
+			desc:          "err: not approved",
+			changeRequest: newTestChangeRequestForAPI(featureproto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING),
+			expectedErr:   statusChangeRequestNotApproved.Err(),
+		},
+		{
+			desc:          "err: feature was modified after the request was created",
+			changeRequest: newTestChangeRequestForAPI(featureproto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_APPROVED),
+			setup: func(s *FeatureService) {
+				feature := newTestChangeRequestFeature()
+				feature.Version = 4
+				s.featureStorage.(*mock.MockFeatureStorage).EXPECT().GetFeature(
+					gomock.Any(), "feature-id", "namespace",
+				).Return(feature, nil)
+			},
+			expectedErr: statusChangeRequestVersionMismatch.Err(),
+		},
+		{
+			desc:          "success",
+			changeRequest: newTestChangeRequestForAPI(featureproto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_APPROVED),
+			setup: func(s *FeatureService) {
+				feature := newTestChangeRequestFeature()
+				fs := s.featureStorage.(*mock.MockFeatureStorage)
+				fs.EXPECT().GetFeature(gomock.Any(), "feature-id", "namespace").Return(feature, nil)
+				fs.EXPECT().ListFeatures(gomock.Any(), gomock.Any()).Return(
+					[]*featureproto.Feature{feature.Feature}, 0, int64(1), nil,
+				)
+				fs.EXPECT().UpdateFeature(gomock.Any(), gomock.Any(), "namespace").Return(nil)
+				s.changeRequestStorage.(*mock.MockChangeRequestStorage).EXPECT().UpdateChangeRequest(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				publisher := s.domainPublisher.(*publishermock.MockPublisher)
+				publisher.EXPECT().PublishMulti(gomock.Any(), gomock.Any()).Return(nil)
+				publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil)
+				s.scheduledFlagChangeStorage.(*mock.MockScheduledFlagChangeStorage).EXPECT().ListScheduledFlagChanges(
+					gomock.Any(), gomock.Any(),
+				).Return([]*featureproto.ScheduledFlagChange{}, 0, int64(0), nil).AnyTimes()
+				s.batchClient.(*btclientmock.MockClient).EXPECT().ExecuteBatchJob(
+					gomock.Any(), gomock.Any(),
+				).Return(nil, nil)
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			service := createFeatureServiceNew(gomock.NewController(t))
+			expectRunInTransaction(service)
+			service.changeRequestStorage.(*mock.MockChangeRequestStorage).EXPECT().GetChangeRequest(
+				gomock.Any(), "cr-id", "namespace",
+			).Return(p.changeRequest, nil).Times(2)
+			if p.setup != nil {
+				p.setup(service)
+			}
+			resp, err := service.ApplyChangeRequest(createContextWithToken(), &featureproto.ApplyChangeRequestRequest{
+				EnvironmentId: "namespace",
+				Id:            "cr-id",
+			})
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, featureproto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_APPLIED, resp.ChangeRequest.Status)
+				assert.Equal(t, "email", resp.ChangeRequest.UpdatedBy)
+				assert.True(t, resp.Feature.Enabled)
+				assert.Equal(t, int32(4), resp.Feature.Version)
+			}
+		})
+	}
+}
+
+func TestChangeRequestScopedPermissions(t *testing.T) {
+	t.Parallel()
+
+	targetingPayload := &featureproto.ScheduledChangePayload{OffVariation: wrapperspb.String("var-1")}
+	patterns := []struct {
+		desc        string
+		permission  *accountproto.Permission
+		payload     *featureproto.ScheduledChangePayload
+		expectedErr error
+	}{
+		{
+			desc: "err: metadata-only role reviews a targeting change",
+			permission: &accountproto.Permission{
+				ResourceType: accountproto.Permission_FEATURE,
+				Actions:      []accountproto.Permission_Action{accountproto.Permission_UPDATE},
+			},
+			payload:     targetingPayload,
+			expectedErr: statusPermissionDenied.Err(),
+		},
+		{
+			desc: "err: role scoped to another tag",
+			permission: &accountproto.Permission{
+				ResourceType: accountproto.Permission_FEATURE,
+				Actions:      []accountproto.Permission_Action{accountproto.Permission_UPDATE_TARGETING},
+				Tags:         []string{"ios"},
+			},
+			payload:     targetingPayload,
+			expectedErr: statusPermissionDenied.Err(),
+		},
+		{
+			desc: "err: role scoped to another flag",
+			permission: &accountproto.Permission{
+				ResourceType: accountproto.Permission_FEATURE,
+				Actions:      []accountproto.Permission_Action{accountproto.Permission_TOGGLE},
+				ResourceIds:  []string{"other-feature-id"},
+			},
+			payload:     &featureproto.ScheduledChangePayload{Enabled: wrapperspb.Bool(true)},
+			expectedErr: statusPermissionDenied.Err(),
+		},
+		{
+			desc: "success: role scoped to the flag tag",
+			permission: &accountproto.Permission{
+				ResourceType: accountproto.Permission_FEATURE,
+				Actions:      []accountproto.Permission_Action{accountproto.Permission_UPDATE_TARGETING},
+				Tags:         []string{"web"},
+			},
+			payload: targetingPayload,
+		},
+		{
+			desc: "success: role scoped to the flag",
+			permission: &accountproto.Permission{
+				ResourceType: accountproto.Permission_FEATURE,
+				Actions:      []accountproto.Permission_Action{accountproto.Permission_TOGGLE},
+				ResourceIds:  []string{"feature-id"},
+			},
+			payload: &featureproto.ScheduledChangePayload{Enabled: wrapperspb.Bool(true)},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			mockController := gomock.NewController(t)
+			service := createFeatureServiceNew(mockController)
+			accountClient := accountclientmock.NewMockClient(mockController)
+			accountClient.EXPECT().GetAccountV2ByEnvironmentID(gomock.Any(), gomock.Any()).Return(
+				&accountproto.GetAccountV2ByEnvironmentIDResponse{
+					Account: &accountproto.AccountV2{
+						Email:            "email",
+						OrganizationRole: accountproto.AccountV2_Role_Organization_MEMBER,
+					},
+					CustomRoles: []*accountproto.CustomRole{
+						{Id: "scoped", Permissions: []*accountproto.Permission{p.permission}},
+					},
+				}, nil,
+			)
+			service.accountClient = accountClient
+			cr := newTestChangeRequestForAPI(featureproto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING)
+			cr.Payload = p.payload
+			service.changeRequestStorage.(*mock.MockChangeRequestStorage).EXPECT().GetChangeRequest(
+				gomock.Any(), "cr-id", "namespace",
+			).Return(cr, nil).MinTimes(1)
+			feature := newTestChangeRequestFeature()
+			feature.Tags = []string{"web"}
+			service.featureStorage.(*mock.MockFeatureStorage).EXPECT().GetFeature(
+				gomock.Any(), "feature-id", "namespace",
+			).Return(feature, nil).AnyTimes()
+			if p.expectedErr == nil {
+				expectRunInTransaction(service)
+				service.changeRequestStorage.(*mock.MockChangeRequestStorage).EXPECT().UpdateChangeRequest(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				service.domainPublisher.(*publishermock.MockPublisher).EXPECT().Publish(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+			}
+			_, err := service.ApproveChangeRequest(createContextWithToken(), &featureproto.ApproveChangeRequestRequest{
+				EnvironmentId: "namespace",
+				Id:            "cr-id",
+			})
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
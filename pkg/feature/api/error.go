@@ -197,6 +197,12 @@ var (
 			"segment rule clause values must not be empty",
 			"SegmentRuleClauseValues",
 		))
+	statusInvalidClauseRegex = api.NewGRPCStatus(
+		pkgErr.NewErrorInvalidArgNotMatchFormat(
+			pkgErr.FeaturePackageName,
+			"clause values must be valid regular expressions supported by every SDK",
+			"ClauseValues",
+		))
 	statusUnauthenticated = api.NewGRPCStatus(
 		pkgErr.NewErrorUnauthenticated(pkgErr.FeaturePackageName, "unauthenticated"))
 	statusPermissionDenied = api.NewGRPCStatus(
@@ -316,4 +322,57 @@ var (
 			"ordered_rule_ids must contain exactly one entry per rule with no duplicates",
 			"OrderedRuleIds",
 		))
+	// change request
+	statusMissingChangeRequestID = api.NewGRPCStatus(
+		pkgErr.NewErrorInvalidArgEmpty(pkgErr.FeaturePackageName, "missing change request id", "ChangeRequestId"))
+	statusChangeRequestNotFound = api.NewGRPCStatus(
+		pkgErr.NewErrorNotFound(pkgErr.FeaturePackageName, "change request not found", "ChangeRequest"))
+	statusChangeRequestNotPending = api.NewGRPCStatus(
+		pkgErr.NewErrorFailedPrecondition(pkgErr.FeaturePackageName, "change request is not pending"))
+	statusChangeRequestNotApproved = api.NewGRPCStatus(
+		pkgErr.NewErrorFailedPrecondition(pkgErr.FeaturePackageName, "change request is not approved"))
+	statusChangeRequestSelfApproval = api.NewGRPCStatus(
+		pkgErr.NewErrorPermissionDenied(pkgErr.FeaturePackageName, "change request cannot be approved by its author"))
+	statusChangeRequestAlreadyApproved = api.NewGRPCStatus(
+		pkgErr.NewErrorAlreadyExists(pkgErr.FeaturePackageName, "change request already approved by this reviewer"))
+	statusChangeRequestVersionMismatch = api.NewGRPCStatus(
+		pkgErr.NewErrorFailedPrecondition(
+			pkgErr.FeaturePackageName,
+			"feature was modified after the change request was created",
+		))
+	statusChangeApprovalNotRequired = api.NewGRPCStatus(
+		pkgErr.NewErrorFailedPrecondition(
+			pkgErr.FeaturePackageName,
+			"environment does not require change approval",
+		))
+	statusChangeApprovalRequired = api.NewGRPCStatus(
+		pkgErr.NewErrorFailedPrecondition(
+			pkgErr.FeaturePackageName,
+			"environment requires change approval, the change must be made through a change request",
+		))
+	// feature bundle
+	statusMissingBundle = api.NewGRPCStatus(
+		pkgErr.NewErrorInvalidArgEmpty(pkgErr.FeaturePackageName, "missing bundle", "Bundle"))
+	statusMissingDiffToken = api.NewGRPCStatus(
+		pkgErr.NewErrorInvalidArgEmpty(pkgErr.FeaturePackageName, "missing diff token", "DiffToken"))
+	statusBundleDiffTokenMismatch = api.NewGRPCStatus(
+		pkgErr.NewErrorFailedPrecondition(
+			pkgErr.FeaturePackageName,
+			"features were modified after the diff was computed",
+		))
+	// feature restore
+	statusMissingRestorePoint = api.NewGRPCStatus(
+		pkgErr.NewErrorInvalidArgEmpty(pkgErr.FeaturePackageName, "missing version or timestamp", "Version"))
+	statusFeatureSnapshotNotFound = api.NewGRPCStatus(
+		pkgErr.NewErrorNotFound(pkgErr.FeaturePackageName, "feature snapshot not found", "FeatureSnapshot"))
+	statusRestoreSegmentNotFound = api.NewGRPCStatus(
+		pkgErr.NewErrorFailedPrecondition(
+			pkgErr.FeaturePackageName,
+			"a segment used by the restored feature no longer exists",
+		))
+	statusRestoreReferenceNotFound = api.NewGRPCStatus(
+		pkgErr.NewErrorFailedPrecondition(
+			pkgErr.FeaturePackageName,
+			"a feature or variation used by the restored feature no longer exists",
+		))
 )
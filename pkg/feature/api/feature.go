@@ -37,6 +37,7 @@ import (
 	v2fs "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/storage"
 	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
 	btproto "github.com/bucketeer-io/bucketeer/v2/proto/batch"
@@ -57,9 +58,11 @@ func (s *FeatureService) GetFeature(
 	ctx context.Context,
 	req *featureproto.GetFeatureRequest,
 ) (*featureproto.GetFeatureResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_READ,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -142,9 +145,10 @@ func (s *FeatureService) GetFeatures(
 	ctx context.Context,
 	req *featureproto.GetFeaturesRequest,
 ) (*featureproto.GetFeaturesResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -212,9 +216,10 @@ func (s *FeatureService) ListFeatures(
 	ctx context.Context,
 	req *featureproto.ListFeaturesRequest,
 ) (*featureproto.ListFeaturesResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -444,9 +449,10 @@ func (s *FeatureService) ListEnabledFeatures(
 	ctx context.Context,
 	req *featureproto.ListEnabledFeaturesRequest,
 ) (*featureproto.ListEnabledFeaturesResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -485,9 +491,12 @@ func (s *FeatureService) CreateFeature(
 	ctx context.Context,
 	req *featureproto.CreateFeatureRequest,
 ) (*featureproto.CreateFeatureResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_CREATE,
+		ResourceID:   req.Id,
+		Tags:         func() ([]string, error) { return req.Tags, nil },
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -517,6 +526,11 @@ func (s *FeatureService) CreateFeature(
 		)
 		return nil, err
 	}
+	if req.Kind != featureproto.FeatureLifecycle_KIND_UNSPECIFIED || req.PlannedRemovalAt != 0 {
+		if _, err := feature.UpdateLifecycle(req.Kind, wrapperspb.Int64(req.PlannedRemovalAt)); err != nil {
+			return nil, api.NewGRPCStatus(err).Err()
+		}
+	}
 	var event *eventproto.Event
 	err = s.dbClient.RunInTransactionV2(ctx, func(ctxWithTx context.Context) error {
 		event, err = domainevent.NewEvent(
@@ -582,21 +596,32 @@ func (s *FeatureService) UpdateFeature(
 	ctx context.Context,
 	req *featureproto.UpdateFeatureRequest,
 ) (*featureproto.UpdateFeatureResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(
+		ctx,
+		req.EnvironmentId,
+		updateFeaturePermissions(req, s.featureTags(ctx, req.Id, req.EnvironmentId))...,
+	)
 	if err != nil {
 		return nil, err
 	}
 	if req.Id == "" {
 		return nil, statusMissingID.Err()
 	}
+	if err := validateRuleChanges(req.RuleChanges); err != nil {
+		return nil, err
+	}
 	if err := s.validateFeatureStatus(ctx, req.Id, req.EnvironmentId); err != nil {
 		return nil, err
 	}
-	if err := s.validateEnvironmentSettings(ctx, req.EnvironmentId, req.Comment); err != nil {
+	env, err := s.validateEnvironmentSettings(ctx, req.EnvironmentId, req.Comment)
+	if err != nil {
 		return nil, err
 	}
+	if env.RequireChangeApproval {
+		// Protected environments never apply updates directly.
+		// The update is stored as a change request and applied once it has been approved.
+		return s.createChangeRequestFromUpdate(ctx, editor, env, req)
+	}
 	var event *eventproto.Event
 	var updatedpb *featureproto.Feature
 	err = s.dbClient.RunInTransactionV2(ctx, func(ctxWithTx context.Context) error {
@@ -630,22 +655,40 @@ func (s *FeatureService) UpdateFeature(
 	// pre-commit snapshot and miss concurrent modifications.
 	// As a final safety net, the executor validates all references before
 	// executing any schedule.
+	conflictCount := s.detectScheduledFlagChangeConflicts(ctx, updatedpb, req.Id, req.EnvironmentId)
+
+	s.updateFeatureFlagCache(ctx)
+
+	return &featureproto.UpdateFeatureResponse{
+		Feature:                   updatedpb,
+		ScheduleConflictsDetected: conflictCount > 0,
+		ConflictCount:             conflictCount,
+	}, nil
+}
+
+// detectScheduledFlagChangeConflicts runs the same-flag and cross-flag conflict detection
+// for pending scheduled changes after a flag update and returns the number of conflicts found.
+// Detection is best-effort, so errors are logged and never fail the update.
+func (s *FeatureService) detectScheduledFlagChangeConflicts(
+	ctx context.Context,
+	updated *featureproto.Feature,
+	featureID, environmentID string,
+) int32 {
 	conflictCount := int32(0)
 	conflictDetector := scheduled.NewConflictDetectorWithFeatureStorage(
 		s.scheduledFlagChangeStorage, s.featureStorage, s.logger,
 	)
 
 	// Same-flag conflict detection (includes auto-recovery)
-	if count, err := conflictDetector.DetectConflictsOnFlagChange(ctx, updatedpb, req.EnvironmentId); err != nil {
+	if count, err := conflictDetector.DetectConflictsOnFlagChange(ctx, updated, environmentID); err != nil {
 		s.logger.Error(
 			"Failed to detect conflicts on flag change",
 			log.FieldsFromIncomingContext(ctx).AddFields(
 				zap.Error(err),
-				zap.String("featureId", req.Id),
-				zap.String("environmentId", req.EnvironmentId),
+				zap.String("featureId", featureID),
+				zap.String("environmentId", environmentID),
 			)...,
 		)
-		// Don't fail the update, conflict detection is best-effort
 	} else {
 		conflictCount = int32(count)
 	}
@@ -653,27 +696,19 @@ func (s *FeatureService) UpdateFeature(
 	// Cross-flag conflict detection (prerequisite references)
 	// When a flag is updated, schedules on OTHER flags that reference this flag
 	// via prerequisites may become invalid (e.g., referenced variation deleted).
-	if crossCount, err := conflictDetector.DetectCrossFlagConflicts(ctx, req.Id, req.EnvironmentId); err != nil {
+	if crossCount, err := conflictDetector.DetectCrossFlagConflicts(ctx, featureID, environmentID); err != nil {
 		s.logger.Error(
 			"Failed to detect cross-flag conflicts",
 			log.FieldsFromIncomingContext(ctx).AddFields(
 				zap.Error(err),
-				zap.String("featureId", req.Id),
-				zap.String("environmentId", req.EnvironmentId),
+				zap.String("featureId", featureID),
+				zap.String("environmentId", environmentID),
 			)...,
 		)
-		// Don't fail the update, cross-flag conflict detection is best-effort
 	} else {
 		conflictCount += int32(crossCount)
 	}
-
-	s.updateFeatureFlagCache(ctx)
-
-	return &featureproto.UpdateFeatureResponse{
-		Feature:                   updatedpb,
-		ScheduleConflictsDetected: conflictCount > 0,
-		ConflictCount:             conflictCount,
-	}, nil
+	return conflictCount
 }
 
 // updateFeatureWithinTransaction performs the update logic within an existing transaction context.
@@ -804,6 +839,14 @@ func (s *FeatureService) updateFeatureWithinTransaction(
 	if err != nil {
 		return nil, nil, err
 	}
+	lifecycleChanged, err := updated.UpdateLifecycle(req.Kind, req.PlannedRemovalAt)
+	if err != nil {
+		return nil, nil, err
+	}
+	if lifecycleChanged && updated.Version == feature.Version {
+		// Update only increments the version for the changes it applies itself
+		updated.Version++
+	}
 	if err := s.upsertTags(ctx, updated.Tags, req.EnvironmentId); err != nil {
 		return nil, nil, err
 	}
@@ -894,13 +937,16 @@ func (s *FeatureService) DeleteFeature(
 	if err := validateDeleteFeatureRequest(req); err != nil {
 		return nil, err
 	}
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_DELETE,
+		ResourceID:   req.Id,
+		Tags:         s.featureTags(ctx, req.Id, req.EnvironmentId),
+	})
 	if err != nil {
 		return nil, err
 	}
-	if err := s.validateEnvironmentSettings(ctx, req.EnvironmentId, req.Comment); err != nil {
+	if _, err := s.validateEnvironmentSettings(ctx, req.EnvironmentId, req.Comment); err != nil {
 		return nil, err
 	}
 	var eventPb *eventproto.Event
@@ -1292,9 +1338,10 @@ func (s *FeatureService) EvaluateFeatures(
 	ctx context.Context,
 	req *featureproto.EvaluateFeaturesRequest,
 ) (*featureproto.EvaluateFeaturesResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1374,9 +1421,10 @@ func (s *FeatureService) DebugEvaluateFeatures(
 	ctx context.Context,
 	req *featureproto.DebugEvaluateFeaturesRequest,
 ) (*featureproto.DebugEvaluateFeaturesResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1518,10 +1566,10 @@ func (s *FeatureService) CloneFeature(
 	ctx context.Context,
 	req *featureproto.CloneFeatureRequest,
 ) (*featureproto.CloneFeatureResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.TargetEnvironmentId,
-	)
+	editor, err := s.checkPermission(ctx, req.TargetEnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_CREATE,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1641,10 +1689,10 @@ func (s *FeatureService) BulkCloneFeature(
 	// We collect permission errors and abort before any cloning if any env fails.
 	editors := make(map[string]*eventproto.Editor, len(req.TargetEnvironmentIds))
 	for _, targetEnvID := range req.TargetEnvironmentIds {
-		editor, err := s.checkEnvironmentRole(
-			ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-			targetEnvID,
-		)
+		editor, err := s.checkPermission(ctx, targetEnvID, role.Permission{
+			ResourceType: accountproto.Permission_FEATURE,
+			Action:       accountproto.Permission_CREATE,
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -1790,3 +1838,49 @@ func (s *FeatureService) updateFeatureFlagCache(ctx context.Context) {
 		s.logger.Error("Failed to update feature flag cache", zap.Error(err))
 	}
 }
+
+// updateFeaturePermissions returns the permissions required by the changes in the request.
+// Enabling or disabling the flag requires TOGGLE, changing how users are served requires
+// UPDATE_TARGETING, and any other change requires UPDATE.
+func updateFeaturePermissions(
+	req *featureproto.UpdateFeatureRequest,
+	tags func() ([]string, error),
+) []role.Permission {
+	var actions []accountproto.Permission_Action
+	if req.Enabled != nil {
+		actions = append(actions, accountproto.Permission_TOGGLE)
+	}
+	if req.DefaultStrategy != nil ||
+		req.OffVariation != nil ||
+		req.ResetSamplingSeed ||
+		len(req.VariationChanges) > 0 ||
+		len(req.RuleChanges) > 0 ||
+		len(req.PrerequisiteChanges) > 0 ||
+		len(req.TargetChanges) > 0 ||
+		len(req.OrderedRuleIds) > 0 {
+		actions = append(actions, accountproto.Permission_UPDATE_TARGETING)
+	}
+	if req.Name != nil ||
+		req.Description != nil ||
+		req.Tags != nil ||
+		req.Archived != nil ||
+		req.Maintainer != nil ||
+		req.VariationValueSchema != nil ||
+		req.ClearVariationValueSchema != nil ||
+		req.Kind != featureproto.FeatureLifecycle_KIND_UNSPECIFIED ||
+		req.PlannedRemovalAt != nil ||
+		len(req.TagChanges) > 0 ||
+		len(actions) == 0 {
+		actions = append(actions, accountproto.Permission_UPDATE)
+	}
+	permissions := make([]role.Permission, 0, len(actions))
+	for _, action := range actions {
+		permissions = append(permissions, role.Permission{
+			ResourceType: accountproto.Permission_FEATURE,
+			Action:       action,
+			ResourceID:   req.Id,
+			Tags:         tags,
+		})
+	}
+	return permissions
+}
@@ -2816,8 +2816,11 @@ func TestValidateEnvironmentSettings(t *testing.T) {
 			if p.setup != nil {
 				p.setup(service)
 			}
-			err := service.validateEnvironmentSettings(ctx, p.env, p.comment)
+			env, err := service.validateEnvironmentSettings(ctx, p.env, p.comment)
 			assert.Equal(t, p.expected, err)
+			if err == nil {
+				assert.NotNil(t, env)
+			}
 		})
 	}
 }
@@ -3226,3 +3229,69 @@ func TestUpdateFeature(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateFeaturePermissions(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc     string
+		req      *featureproto.UpdateFeatureRequest
+		expected []accountproto.Permission_Action
+	}{
+		{
+			desc:     "no changes",
+			req:      &featureproto.UpdateFeatureRequest{Id: "id"},
+			expected: []accountproto.Permission_Action{accountproto.Permission_UPDATE},
+		},
+		{
+			desc:     "toggle only",
+			req:      &featureproto.UpdateFeatureRequest{Id: "id", Enabled: wrapperspb.Bool(true)},
+			expected: []accountproto.Permission_Action{accountproto.Permission_TOGGLE},
+		},
+		{
+			desc: "targeting only",
+			req: &featureproto.UpdateFeatureRequest{
+				Id:            "id",
+				TargetChanges: []*featureproto.TargetChange{{}},
+				OffVariation:  wrapperspb.String("variation-id"),
+			},
+			expected: []accountproto.Permission_Action{accountproto.Permission_UPDATE_TARGETING},
+		},
+		{
+			desc: "toggle and lifecycle",
+			req: &featureproto.UpdateFeatureRequest{
+				Id:               "id",
+				Enabled:          wrapperspb.Bool(false),
+				Kind:             featureproto.FeatureLifecycle_OPERATIONAL,
+				PlannedRemovalAt: wrapperspb.Int64(0),
+			},
+			expected: []accountproto.Permission_Action{
+				accountproto.Permission_TOGGLE,
+				accountproto.Permission_UPDATE,
+			},
+		},
+		{
+			desc: "toggle and rename",
+			req: &featureproto.UpdateFeatureRequest{
+				Id:      "id",
+				Enabled: wrapperspb.Bool(false),
+				Name:    wrapperspb.String("name"),
+			},
+			expected: []accountproto.Permission_Action{
+				accountproto.Permission_TOGGLE,
+				accountproto.Permission_UPDATE,
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			permissions := updateFeaturePermissions(p.req, nil)
+			actions := make([]accountproto.Permission_Action, 0, len(permissions))
+			for _, permission := range permissions {
+				assert.Equal(t, accountproto.Permission_FEATURE, permission.ResourceType)
+				assert.Equal(t, p.req.Id, permission.ResourceID)
+				actions = append(actions, permission.Action)
+			}
+			assert.Equal(t, p.expected, actions)
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
 	v2fs "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
 	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
 	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
 	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
@@ -49,11 +50,12 @@ func (s *FeatureService) CreateFlagTrigger(
 	ctx context.Context,
 	request *featureproto.CreateFlagTriggerRequest,
 ) (*featureproto.CreateFlagTriggerResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx,
-		accountproto.AccountV2_Role_Environment_EDITOR,
-		request.EnvironmentId,
-	)
+	editor, err := s.checkPermission(ctx, request.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_UPDATE,
+		ResourceID:   request.FeatureId,
+		Tags:         s.featureTags(ctx, request.FeatureId, request.EnvironmentId),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -98,13 +100,12 @@ func (s *FeatureService) CreateFlagTrigger(
 				Type:          flagTrigger.Type,
 				Action:        flagTrigger.Action,
 				Description:   flagTrigger.Description,
-				Token:         flagTrigger.Token,
 				CreatedAt:     flagTrigger.CreatedAt,
 				UpdatedAt:     flagTrigger.UpdatedAt,
 				EnvironmentId: flagTrigger.EnvironmentId,
 			},
 			request.EnvironmentId,
-			flagTrigger,
+			flagTrigger.WithoutToken(),
 			nil,
 		)
 		if err != nil {
@@ -145,11 +146,10 @@ func (s *FeatureService) UpdateFlagTrigger(
 	ctx context.Context,
 	request *featureproto.UpdateFlagTriggerRequest,
 ) (*featureproto.UpdateFlagTriggerResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx,
-		accountproto.AccountV2_Role_Environment_EDITOR,
-		request.EnvironmentId,
-	)
+	editor, err := s.checkPermission(ctx, request.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_UPDATE,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -188,8 +188,8 @@ func (s *FeatureService) UpdateFlagTrigger(
 				Disabled:    request.Disabled,
 			},
 			request.EnvironmentId,
-			updated,
-			flagTrigger,
+			updated.WithoutToken(),
+			flagTrigger.WithoutToken(),
 		)
 		if err != nil {
 			return err
@@ -229,11 +229,10 @@ func (s *FeatureService) DeleteFlagTrigger(
 	ctx context.Context,
 	request *featureproto.DeleteFlagTriggerRequest,
 ) (*featureproto.DeleteFlagTriggerResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx,
-		accountproto.AccountV2_Role_Environment_EDITOR,
-		request.EnvironmentId,
-	)
+	editor, err := s.checkPermission(ctx, request.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_UPDATE,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -265,8 +264,8 @@ func (s *FeatureService) DeleteFlagTrigger(
 				EnvironmentId: flagTrigger.EnvironmentId,
 			},
 			request.EnvironmentId,
-			nil,         // Current state: entity no longer exists
-			flagTrigger, // Previous state: what was deleted
+			nil,                        // Current state: entity no longer exists
+			flagTrigger.WithoutToken(), // Previous state: what was deleted
 		)
 		if err != nil {
 			return err
@@ -302,9 +301,10 @@ func (s *FeatureService) GetFlagTrigger(
 	ctx context.Context,
 	request *featureproto.GetFlagTriggerRequest,
 ) (*featureproto.GetFlagTriggerResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		request.EnvironmentId)
+	_, err := s.checkPermission(ctx, request.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -341,9 +341,10 @@ func (s *FeatureService) ListFlagTriggers(
 	ctx context.Context,
 	request *featureproto.ListFlagTriggersRequest,
 ) (*featureproto.ListFlagTriggersResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		request.EnvironmentId)
+	_, err := s.checkPermission(ctx, request.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -444,13 +445,18 @@ func (s *FeatureService) FlagTriggerWebhook(
 	if trigger.GetAction() == featureproto.FlagTrigger_Action_ON {
 		// check if feature is already enabled
 		if !feature.GetEnabled() {
+			// Turning a flag on exposes new behavior, so protected environments require a change request.
+			if err := s.checkDirectChangeAllowed(ctx, trigger.GetEnvironmentId()); err != nil {
+				return nil, err
+			}
 			err := s.updateEnableFeature(ctx, trigger.GetFeatureId(), trigger.GetEnvironmentId(), true)
 			if err != nil {
 				return nil, statusTriggerEnableFailed.Err()
 			}
 		}
 	} else if trigger.GetAction() == featureproto.FlagTrigger_Action_OFF {
-		// check if feature is already disabled
+		// check if feature is already disabled.
+		// Turning a flag off is exempt from change approval so it can be used as a kill switch.
 		if feature.GetEnabled() {
 			err := s.updateEnableFeature(ctx, trigger.GetFeatureId(), trigger.GetEnvironmentId(), false)
 			if err != nil {
@@ -499,8 +505,8 @@ func (s *FeatureService) updateTriggerUsageInfo(
 				EnvironmentId:   flagTrigger.EnvironmentId,
 			},
 			flagTrigger.EnvironmentId,
-			flagTrigger,
-			prev,
+			flagTrigger.WithoutToken(),
+			prev.WithoutToken(),
 		)
 		if err != nil {
 			return err
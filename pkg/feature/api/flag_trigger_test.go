@@ -35,6 +35,7 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2/mock"
 	publishermock "github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher/mock"
 	databasemock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database/mock"
+	envproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
 	proto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
 )
 
@@ -511,6 +512,79 @@ func TestFlagTriggerWebhook(t *testing.T) {
 			input:       &proto.FlagTriggerWebhookRequest{Token: "token"},
 			expectedErr: nil,
 		},
+		{
+			desc: "Error Change Approval Required",
+			setup: func(s *FeatureService) {
+				s.flagTriggerStorage.(*mock.MockFlagTriggerStorage).EXPECT().GetFlagTriggerByToken(
+					gomock.Any(), gomock.Any(),
+				).Return(&domain.FlagTrigger{
+					FlagTrigger: baseFlagTrigger,
+				}, nil)
+				s.featureStorage.(*mock.MockFeatureStorage).EXPECT().GetFeature(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(&domain.Feature{
+					Feature: &proto.Feature{
+						Id:      "id",
+						Name:    "test feature",
+						Version: 1,
+						Enabled: false,
+					},
+				}, nil)
+				expectGetEnvironment(s, &envproto.EnvironmentV2{Id: "namespace", RequireChangeApproval: true})
+			},
+			input:       &proto.FlagTriggerWebhookRequest{Token: "token"},
+			expectedErr: statusChangeApprovalRequired.Err(),
+		},
+		{
+			// Turning a flag off is exempt from change approval, so the environment is never checked.
+			desc: "Success Disable In Protected Environment",
+			setup: func(s *FeatureService) {
+				offTrigger := &proto.FlagTrigger{
+					Id:            "2",
+					FeatureId:     "featureId",
+					EnvironmentId: "namespace",
+					Type:          proto.FlagTrigger_Type_WEBHOOK,
+					Action:        proto.FlagTrigger_Action_OFF,
+					Token:         "test-token",
+				}
+				s.flagTriggerStorage.(*mock.MockFlagTriggerStorage).EXPECT().GetFlagTriggerByToken(
+					gomock.Any(), gomock.Any(),
+				).Return(&domain.FlagTrigger{
+					FlagTrigger: offTrigger,
+				}, nil)
+				s.featureStorage.(*mock.MockFeatureStorage).EXPECT().GetFeature(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(_ context.Context, _, _ string) (*domain.Feature, error) {
+					return &domain.Feature{
+						Feature: &proto.Feature{
+							Id:      "id",
+							Name:    "test feature",
+							Version: 1,
+							Enabled: true,
+						},
+					}, nil
+				}).Times(2)
+				s.dbClient.(*databasemock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				}).Times(2)
+				s.featureStorage.(*mock.MockFeatureStorage).EXPECT().UpdateFeature(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(_ context.Context, f *domain.Feature, _ string) error {
+					assert.False(t, f.Enabled)
+					return nil
+				})
+				s.domainPublisher.(*publishermock.MockPublisher).EXPECT().Publish(
+					gomock.Any(), gomock.Any(),
+				).Return(nil).Times(2)
+				s.flagTriggerStorage.(*mock.MockFlagTriggerStorage).EXPECT().UpdateFlagTrigger(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+			},
+			input:       &proto.FlagTriggerWebhookRequest{Token: "token"},
+			expectedErr: nil,
+		},
 	}
 
 	for _, p := range patterns {
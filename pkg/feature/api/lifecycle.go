@@ -0,0 +1,119 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
+	v2fs "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+func (s *FeatureService) GetFlagDebtReport(
+	ctx context.Context,
+	req *featureproto.GetFlagDebtReportRequest,
+) (*featureproto.GetFlagDebtReportResponse, error) {
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_READ,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if req.EnvironmentId == "" {
+		return nil, statusMissingEnvironmentID.Err()
+	}
+	archived := false
+	deleted := false
+	features, _, _, err := s.featureStorage.ListFeatures(ctx, v2fs.ListFeaturesParams{
+		PageSize:      database.QueryNoLimit,
+		EnvironmentID: req.EnvironmentId,
+		Archived:      &archived,
+		Deleted:       &deleted,
+	})
+	if err != nil {
+		return nil, s.reportInternalServerError(ctx, err, req.EnvironmentId)
+	}
+	var keys func(*featureproto.Feature) []string
+	switch req.GroupBy {
+	case featureproto.GetFlagDebtReportRequest_TEAM:
+		teams, err := s.maintainerTeams(ctx, features, req.EnvironmentId)
+		if err != nil {
+			return nil, s.reportInternalServerError(ctx, err, req.EnvironmentId)
+		}
+		keys = func(f *featureproto.Feature) []string { return teams[f.Maintainer] }
+	case featureproto.GetFlagDebtReportRequest_MAINTAINER:
+		keys = func(f *featureproto.Feature) []string {
+			if f.Maintainer == "" {
+				return nil
+			}
+			return []string{f.Maintainer}
+		}
+	default:
+		keys = func(f *featureproto.Feature) []string { return f.Tags }
+	}
+	return &featureproto.GetFlagDebtReportResponse{
+		Entries: domain.FlagDebtReport(features, keys, time.Now()),
+	}, nil
+}
+
+// maintainerTeams returns the teams of each flag maintainer.
+// Maintainers who no longer have an account in the environment belong to no team.
+func (s *FeatureService) maintainerTeams(
+	ctx context.Context,
+	features []*featureproto.Feature,
+	environmentID string,
+) (map[string][]string, error) {
+	teams := make(map[string][]string)
+	for _, f := range features {
+		if f.Maintainer == "" {
+			continue
+		}
+		if _, ok := teams[f.Maintainer]; ok {
+			continue
+		}
+		resp, err := s.accountClient.GetAccountV2ByEnvironmentID(ctx, &accountproto.GetAccountV2ByEnvironmentIDRequest{
+			Email:         f.Maintainer,
+			EnvironmentId: environmentID,
+		})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				teams[f.Maintainer] = nil
+				continue
+			}
+			s.logger.Error(
+				"Failed to get the maintainer account",
+				log.FieldsFromIncomingContext(ctx).AddFields(
+					zap.Error(err),
+					zap.String("environmentId", environmentID),
+					zap.String("maintainer", f.Maintainer),
+				)...,
+			)
+			return nil, err
+		}
+		teams[f.Maintainer] = resp.Account.GetTeams()
+	}
+	return teams, nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	accountclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/account/client/mock"
+	btclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/batch/client/mock"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2/mock"
+	publishermock "github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher/mock"
+	databasemock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database/mock"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+func TestCreateFeatureLifecycle(t *testing.T) {
+	t.Parallel()
+	plannedRemovalAt := time.Now().Add(24 * time.Hour).Unix()
+	patterns := []struct {
+		desc         string
+		setup        func(*FeatureService)
+		kind         featureproto.FeatureLifecycle_Kind
+		removalAt    int64
+		expectedCode codes.Code
+		expected     *featureproto.FeatureLifecycle
+	}{
+		{
+			desc:         "err: temporary kind without planned removal date",
+			kind:         featureproto.FeatureLifecycle_EXPERIMENT,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			desc: "success",
+			setup: func(s *FeatureService) {
+				s.dbClient.(*databasemock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.domainPublisher.(*publishermock.MockPublisher).EXPECT().Publish(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.batchClient.(*btclientmock.MockClient).EXPECT().ExecuteBatchJob(gomock.Any(), gomock.Any())
+			},
+			kind:         featureproto.FeatureLifecycle_RELEASE,
+			removalAt:    plannedRemovalAt,
+			expectedCode: codes.OK,
+			expected: &featureproto.FeatureLifecycle{
+				Kind:             featureproto.FeatureLifecycle_RELEASE,
+				PlannedRemovalAt: plannedRemovalAt,
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			service := createFeatureServiceNew(gomock.NewController(t))
+			if p.setup != nil {
+				p.setup(service)
+			}
+			resp, err := service.CreateFeature(createContextWithToken(), &featureproto.CreateFeatureRequest{
+				Id:                       "feature-id",
+				Name:                     "name",
+				EnvironmentId:            "namespace",
+				Variations:               createFeatureVariations(),
+				Tags:                     createFeatureTags(),
+				DefaultOnVariationIndex:  wrapperspb.Int32(0),
+				DefaultOffVariationIndex: wrapperspb.Int32(1),
+				Kind:                     p.kind,
+				PlannedRemovalAt:         p.removalAt,
+			})
+			assert.Equal(t, p.expectedCode, status.Code(err))
+			if p.expected != nil {
+				assert.Equal(t, p.expected.Kind, resp.Feature.Lifecycle.Kind)
+				assert.Equal(t, p.expected.PlannedRemovalAt, resp.Feature.Lifecycle.PlannedRemovalAt)
+			}
+		})
+	}
+}
+
+func TestGetFlagDebtReport(t *testing.T) {
+	t.Parallel()
+	overdue := &featureproto.FeatureLifecycle{
+		Kind:             featureproto.FeatureLifecycle_RELEASE,
+		PlannedRemovalAt: time.Now().Add(-time.Hour).Unix(),
+		State:            featureproto.FeatureLifecycle_READY_TO_REMOVE,
+	}
+	features := []*featureproto.Feature{
+		{Id: "f1", Tags: []string{"web"}, Maintainer: "alice@example.com", Lifecycle: overdue},
+		{Id: "f2", Tags: []string{"web", "ios"}, Maintainer: "bob@example.com"},
+		{Id: "f3", Maintainer: "gone@example.com"},
+	}
+	patterns := []struct {
+		desc        string
+		req         *featureproto.GetFlagDebtReportRequest
+		expectedErr error
+		expected    map[string]int32
+	}{
+		{
+			desc:        "err: missing environment id",
+			req:         &featureproto.GetFlagDebtReportRequest{},
+			expectedErr: statusMissingEnvironmentID.Err(),
+		},
+		{
+			desc:     "success: by tag",
+			req:      &featureproto.GetFlagDebtReportRequest{EnvironmentId: "namespace"},
+			expected: map[string]int32{"web": 2, "ios": 1, "": 1},
+		},
+		{
+			desc: "success: by team",
+			req: &featureproto.GetFlagDebtReportRequest{
+				EnvironmentId: "namespace",
+				GroupBy:       featureproto.GetFlagDebtReportRequest_TEAM,
+			},
+			expected: map[string]int32{"payments": 2, "mobile": 1, "": 1},
+		},
+		{
+			desc: "success: by maintainer",
+			req: &featureproto.GetFlagDebtReportRequest{
+				EnvironmentId: "namespace",
+				GroupBy:       featureproto.GetFlagDebtReportRequest_MAINTAINER,
+			},
+			expected: map[string]int32{"alice@example.com": 1, "bob@example.com": 1, "gone@example.com": 1},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			mockController := gomock.NewController(t)
+			service := createFeatureServiceNew(mockController)
+			ac := accountclientmock.NewMockClient(mockController)
+			ac.EXPECT().GetAccountV2ByEnvironmentID(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(
+					_ context.Context,
+					req *accountproto.GetAccountV2ByEnvironmentIDRequest,
+					_ ...any,
+				) (*accountproto.GetAccountV2ByEnvironmentIDResponse, error) {
+					teams := map[string][]string{
+						"alice@example.com": {"payments"},
+						"bob@example.com":   {"payments", "mobile"},
+					}
+					if req.Email == "gone@example.com" {
+						return nil, status.Error(codes.NotFound, "not found")
+					}
+					return &accountproto.GetAccountV2ByEnvironmentIDResponse{
+						Account: &accountproto.AccountV2{
+							Email:            req.Email,
+							OrganizationRole: accountproto.AccountV2_Role_Organization_ADMIN,
+							Teams:            teams[req.Email],
+						},
+					}, nil
+				},
+			).AnyTimes()
+			service.accountClient = ac
+			if p.expectedErr == nil {
+				service.featureStorage.(*mock.MockFeatureStorage).EXPECT().ListFeatures(
+					gomock.Any(), gomock.Any(),
+				).Return(features, 0, int64(len(features)), nil)
+			}
+			resp, err := service.GetFlagDebtReport(createContextWithToken(), p.req)
+			assert.Equal(t, p.expectedErr, err)
+			if p.expectedErr != nil {
+				return
+			}
+			totals := make(map[string]int32, len(resp.Entries))
+			for _, e := range resp.Entries {
+				totals[e.Key] = e.TotalCount
+			}
+			assert.Equal(t, p.expected, totals)
+			require.NotEmpty(t, resp.Entries)
+			assert.Equal(t, []string{"f1"}, resp.Entries[0].OverdueFeatureIds)
+		})
+	}
+}
@@ -0,0 +1,475 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
+	v2als "github.com/bucketeer-io/bucketeer/v2/pkg/auditlog/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
+	v2fs "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	auditlogproto "github.com/bucketeer-io/bucketeer/v2/proto/auditlog"
+	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+// featureSnapshot is the part of a feature's audit log entity data that a restore rebuilds.
+// The entity data is the JSON encoding of the whole feature, but only these fields are decoded
+// so that fields added later cannot break the restore of old versions.
+type featureSnapshot struct {
+	Version         int32                        `json:"version"`
+	Variations      []*featureproto.Variation    `json:"variations"`
+	Targets         []*featureproto.Target       `json:"targets"`
+	Rules           []*featureproto.Rule         `json:"rules"`
+	DefaultStrategy *featureproto.Strategy       `json:"default_strategy"`
+	OffVariation    string                       `json:"off_variation"`
+	Prerequisites   []*featureproto.Prerequisite `json:"prerequisites"`
+}
+
+func (s *FeatureService) RestoreFeature(
+	ctx context.Context,
+	req *featureproto.RestoreFeatureRequest,
+) (*featureproto.RestoreFeatureResponse, error) {
+	tags := s.featureTags(ctx, req.Id, req.EnvironmentId)
+	_, err := s.checkPermission(
+		ctx,
+		req.EnvironmentId,
+		role.Permission{
+			ResourceType: accountproto.Permission_FEATURE,
+			Action:       accountproto.Permission_UPDATE,
+			ResourceID:   req.Id,
+			Tags:         tags,
+		},
+		role.Permission{
+			ResourceType: accountproto.Permission_FEATURE,
+			Action:       accountproto.Permission_UPDATE_TARGETING,
+			ResourceID:   req.Id,
+			Tags:         tags,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRestoreFeatureRequest(req); err != nil {
+		return nil, err
+	}
+	snapshot, err := s.findFeatureSnapshot(ctx, req)
+	if err != nil {
+		s.logger.Error(
+			"Failed to find feature snapshot",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("id", req.Id),
+				zap.String("environmentId", req.EnvironmentId),
+				zap.Int32("version", req.Version),
+				zap.Int64("timestamp", req.Timestamp),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	if snapshot == nil {
+		return nil, statusFeatureSnapshotNotFound.Err()
+	}
+	deleted := false
+	features, _, _, err := s.featureStorage.ListFeatures(ctx, v2fs.ListFeaturesParams{
+		PageSize:      database.QueryNoLimit,
+		EnvironmentID: req.EnvironmentId,
+		Deleted:       &deleted,
+	})
+	if err != nil {
+		s.logger.Error(
+			"Failed to list features",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	current, err := findFeature(features, req.Id)
+	if err != nil {
+		return nil, statusFeatureNotFound.Err()
+	}
+	if err := s.validateSnapshotReferences(ctx, snapshot, features, req.EnvironmentId); err != nil {
+		return nil, err
+	}
+	update, fields := newRestoreFeatureRequest(current, snapshot)
+	resp := &featureproto.RestoreFeatureResponse{
+		Feature:         current,
+		RestoredVersion: snapshot.Version,
+		ChangedFields:   fields,
+	}
+	if update == nil {
+		return resp, nil
+	}
+	if req.DryRun {
+		preview, err := (&domain.Feature{Feature: current}).Update(
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			update.DefaultStrategy,
+			update.OffVariation,
+			false,
+			update.PrerequisiteChanges,
+			update.TargetChanges,
+			update.RuleChanges,
+			update.VariationChanges,
+			nil,
+			nil,
+			update.OrderedRuleIds,
+			nil,
+		)
+		if err != nil {
+			return nil, s.convUpdateFeatureError(err)
+		}
+		resp.Feature = preview.Feature
+		return resp, nil
+	}
+	update.Id = req.Id
+	update.EnvironmentId = req.EnvironmentId
+	update.Comment = restoreComment(snapshot.Version, req.Comment)
+	updated, err := s.UpdateFeature(ctx, update)
+	if err != nil {
+		return nil, err
+	}
+	resp.Feature = updated.Feature
+	resp.ChangeRequest = updated.ChangeRequest
+	return resp, nil
+}
+
+// findFeatureSnapshot returns the feature as it was at the requested version,
+// or at the latest version saved at or before the requested timestamp.
+// It returns nil when the history has no such version.
+func (s *FeatureService) findFeatureSnapshot(
+	ctx context.Context,
+	req *featureproto.RestoreFeatureRequest,
+) (*featureSnapshot, error) {
+	entityType := int32(eventproto.Event_FEATURE)
+	params := v2als.ListAuditLogsParams{
+		EnvironmentID:  req.EnvironmentId,
+		EntityType:     &entityType,
+		EntityID:       req.Id,
+		OrderBy:        auditlogproto.ListAuditLogsRequest_TIMESTAMP,
+		OrderDirection: auditlogproto.ListAuditLogsRequest_DESC,
+		PageSize:       listRequestSize,
+	}
+	if req.Version <= 0 {
+		params.To = req.Timestamp
+	}
+	var found *featureSnapshot
+	var foundAt int64
+	for {
+		logs, nextOffset, _, err := s.auditLogStorage.ListAuditLogs(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range logs {
+			if l.EntityData == "" {
+				continue
+			}
+			// Several updates can share the same timestamp, so the highest
+			// version among the latest logs is the one in effect at that time.
+			if found != nil && l.Timestamp < foundAt {
+				return found, nil
+			}
+			snapshot := &featureSnapshot{}
+			if err := json.Unmarshal([]byte(l.EntityData), snapshot); err != nil {
+				return nil, err
+			}
+			if req.Version > 0 {
+				if snapshot.Version == req.Version {
+					return snapshot, nil
+				}
+				continue
+			}
+			if found == nil || snapshot.Version > found.Version {
+				found = snapshot
+				foundAt = l.Timestamp
+			}
+		}
+		if len(logs) < listRequestSize {
+			return found, nil
+		}
+		params.Cursor = strconv.Itoa(nextOffset)
+	}
+}
+
+// validateSnapshotReferences checks that the segments, features and variations
+// the snapshot depends on still exist, since they may have been deleted since.
+func (s *FeatureService) validateSnapshotReferences(
+	ctx context.Context,
+	snapshot *featureSnapshot,
+	features []*featureproto.Feature,
+	environmentID string,
+) error {
+	variationExists := func(featureID, variationID string) bool {
+		f, err := findFeature(features, featureID)
+		if err != nil || f.Archived {
+			return false
+		}
+		return slices.ContainsFunc(f.Variations, func(v *featureproto.Variation) bool {
+			return v.Id == variationID
+		})
+	}
+	for _, p := range snapshot.Prerequisites {
+		if !variationExists(p.FeatureId, p.VariationId) {
+			return statusRestoreReferenceNotFound.Err()
+		}
+	}
+	for _, r := range snapshot.Rules {
+		for _, c := range domain.RuleClauses(r) {
+			switch c.Operator {
+			case featureproto.Clause_FEATURE_FLAG:
+				for _, v := range c.Values {
+					if !variationExists(c.Attribute, v) {
+						return statusRestoreReferenceNotFound.Err()
+					}
+				}
+			case featureproto.Clause_SEGMENT:
+				for _, id := range c.Values {
+					segment, _, err := s.segmentStorage.GetSegment(ctx, id, environmentID)
+					if err != nil {
+						if errors.Is(err, v2fs.ErrSegmentNotFound) {
+							return statusRestoreSegmentNotFound.Err()
+						}
+						s.logger.Error(
+							"Failed to get segment",
+							log.FieldsFromIncomingContext(ctx).AddFields(
+								zap.Error(err),
+								zap.String("segmentId", id),
+								zap.String("environmentId", environmentID),
+							)...,
+						)
+						return api.NewGRPCStatus(err).Err()
+					}
+					if segment.Deleted {
+						return statusRestoreSegmentNotFound.Err()
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// newRestoreFeatureRequest returns the update that makes the feature match the snapshot
+// and the names of the changed fields, or nil when they already match.
+// Variations, rules and targets are matched by ID so that variations deleted since
+// the snapshot are recreated with their original IDs.
+func newRestoreFeatureRequest(
+	current *featureproto.Feature,
+	snapshot *featureSnapshot,
+) (*featureproto.UpdateFeatureRequest, []string) {
+	req := &featureproto.UpdateFeatureRequest{}
+	var fields []string
+	if changes := restoreVariationChanges(current.Variations, snapshot.Variations); len(changes) > 0 {
+		req.VariationChanges = changes
+		fields = append(fields, "variations")
+	}
+	if current.OffVariation != snapshot.OffVariation {
+		req.OffVariation = wrapperspb.String(snapshot.OffVariation)
+		fields = append(fields, "off_variation")
+	}
+	if !proto.Equal(current.DefaultStrategy, snapshot.DefaultStrategy) {
+		req.DefaultStrategy = snapshot.DefaultStrategy
+		fields = append(fields, "default_strategy")
+	}
+	ruleChanges, ruleOrder := restoreRuleChanges(current.Rules, snapshot.Rules)
+	if len(ruleChanges) > 0 || len(ruleOrder) > 0 {
+		req.RuleChanges = ruleChanges
+		req.OrderedRuleIds = ruleOrder
+		fields = append(fields, "rules")
+	}
+	if changes := restoreTargetChanges(current.Targets, snapshot.Targets); len(changes) > 0 {
+		req.TargetChanges = changes
+		fields = append(fields, "targets")
+	}
+	if changes := restorePrerequisiteChanges(current.Prerequisites, snapshot.Prerequisites); len(changes) > 0 {
+		req.PrerequisiteChanges = changes
+		fields = append(fields, "prerequisites")
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return req, fields
+}
+
+func restoreVariationChanges(current, snapshot []*featureproto.Variation) []*featureproto.VariationChange {
+	existing := make(map[string]*featureproto.Variation, len(current))
+	for _, v := range current {
+		existing[v.Id] = v
+	}
+	wanted := make(map[string]struct{}, len(snapshot))
+	var changes []*featureproto.VariationChange
+	for _, v := range snapshot {
+		wanted[v.Id] = struct{}{}
+		cur, ok := existing[v.Id]
+		if ok && proto.Equal(cur, v) {
+			continue
+		}
+		changeType := featureproto.ChangeType_CREATE
+		if ok {
+			changeType = featureproto.ChangeType_UPDATE
+		}
+		changes = append(changes, &featureproto.VariationChange{ChangeType: changeType, Variation: v})
+	}
+	for _, v := range current {
+		if _, ok := wanted[v.Id]; !ok {
+			changes = append(changes, &featureproto.VariationChange{
+				ChangeType: featureproto.ChangeType_DELETE,
+				Variation:  &featureproto.Variation{Id: v.Id},
+			})
+		}
+	}
+	return changes
+}
+
+// restoreRuleChanges returns the rule changes and, when the resulting order
+// differs from the snapshot, the rule IDs in the snapshot order.
+func restoreRuleChanges(current, snapshot []*featureproto.Rule) ([]*featureproto.RuleChange, []string) {
+	existing := make(map[string]*featureproto.Rule, len(current))
+	for _, r := range current {
+		existing[r.Id] = r
+	}
+	wanted := make(map[string]struct{}, len(snapshot))
+	var changes []*featureproto.RuleChange
+	var created []string
+	for _, r := range snapshot {
+		wanted[r.Id] = struct{}{}
+		cur, ok := existing[r.Id]
+		if !ok {
+			changes = append(changes, &featureproto.RuleChange{ChangeType: featureproto.ChangeType_CREATE, Rule: r})
+			created = append(created, r.Id)
+			continue
+		}
+		if !proto.Equal(cur, r) {
+			changes = append(changes, &featureproto.RuleChange{ChangeType: featureproto.ChangeType_UPDATE, Rule: r})
+		}
+	}
+	// New rules are appended after the remaining ones.
+	order := make([]string, 0, len(snapshot))
+	for _, r := range current {
+		if _, ok := wanted[r.Id]; !ok {
+			changes = append(changes, &featureproto.RuleChange{
+				ChangeType: featureproto.ChangeType_DELETE,
+				Rule:       &featureproto.Rule{Id: r.Id},
+			})
+			continue
+		}
+		order = append(order, r.Id)
+	}
+	order = append(order, created...)
+	snapshotOrder := make([]string, 0, len(snapshot))
+	for _, r := range snapshot {
+		snapshotOrder = append(snapshotOrder, r.Id)
+	}
+	if slices.Equal(order, snapshotOrder) {
+		return changes, nil
+	}
+	return changes, snapshotOrder
+}
+
+func restoreTargetChanges(current, snapshot []*featureproto.Target) []*featureproto.TargetChange {
+	existing := make(map[string][]string, len(current))
+	for _, t := range current {
+		existing[t.Variation] = t.Users
+	}
+	wanted := make(map[string][]string, len(snapshot))
+	var changes []*featureproto.TargetChange
+	for _, t := range snapshot {
+		wanted[t.Variation] = t.Users
+		if added := missingUsers(t.Users, existing[t.Variation]); len(added) > 0 {
+			changes = append(changes, &featureproto.TargetChange{
+				ChangeType: featureproto.ChangeType_CREATE,
+				Target:     &featureproto.Target{Variation: t.Variation, Users: added},
+			})
+		}
+	}
+	for _, t := range current {
+		if removed := missingUsers(t.Users, wanted[t.Variation]); len(removed) > 0 {
+			changes = append(changes, &featureproto.TargetChange{
+				ChangeType: featureproto.ChangeType_DELETE,
+				Target:     &featureproto.Target{Variation: t.Variation, Users: removed},
+			})
+		}
+	}
+	return changes
+}
+
+// missingUsers returns the users that are in a but not in b.
+func missingUsers(a, b []string) []string {
+	var missing []string
+	for _, u := range a {
+		if !slices.Contains(b, u) {
+			missing = append(missing, u)
+		}
+	}
+	return missing
+}
+
+func restorePrerequisiteChanges(
+	current, snapshot []*featureproto.Prerequisite,
+) []*featureproto.PrerequisiteChange {
+	existing := make(map[string]string, len(current))
+	for _, p := range current {
+		existing[p.FeatureId] = p.VariationId
+	}
+	wanted := make(map[string]struct{}, len(snapshot))
+	var changes []*featureproto.PrerequisiteChange
+	for _, p := range snapshot {
+		wanted[p.FeatureId] = struct{}{}
+		variationID, ok := existing[p.FeatureId]
+		if ok && variationID == p.VariationId {
+			continue
+		}
+		changeType := featureproto.ChangeType_CREATE
+		if ok {
+			changeType = featureproto.ChangeType_UPDATE
+		}
+		changes = append(changes, &featureproto.PrerequisiteChange{ChangeType: changeType, Prerequisite: p})
+	}
+	for _, p := range current {
+		if _, ok := wanted[p.FeatureId]; !ok {
+			changes = append(changes, &featureproto.PrerequisiteChange{
+				ChangeType:   featureproto.ChangeType_DELETE,
+				Prerequisite: &featureproto.Prerequisite{FeatureId: p.FeatureId},
+			})
+		}
+	}
+	return changes
+}
+
+func restoreComment(version int32, comment string) string {
+	if comment == "" {
+		return fmt.Sprintf("Restored from v%d", version)
+	}
+	return fmt.Sprintf("Restored from v%d: %s", version, comment)
+}
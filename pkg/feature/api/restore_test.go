@@ -0,0 +1,288 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+
+	v2als "github.com/bucketeer-io/bucketeer/v2/pkg/auditlog/storage/v2"
+	auditlogstoragemock "github.com/bucketeer-io/bucketeer/v2/pkg/auditlog/storage/v2/mock"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
+	v2fs "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2/mock"
+	auditlogproto "github.com/bucketeer-io/bucketeer/v2/proto/auditlog"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+const (
+	restoreRuleID   = "9f2e7c1a-63d4-4b8e-a0c5-2d1f8e7b6a43"
+	restoreClauseID = "4c8a5a36-1b4e-4c59-9d38-0a3f6a0c5e1d"
+	// Variations are recreated with their ID, which has to be a UUID.
+	restoreVariationID = "b7d3f0e2-5a61-4c9e-8f24-71c0d9a3e5b8"
+)
+
+func newTestRestoreFeature() *featureproto.Feature {
+	return &featureproto.Feature{
+		Id:      "feature-id",
+		Name:    "feature",
+		Version: 3,
+		Variations: []*featureproto.Variation{
+			{Id: "variation-1", Name: "on", Value: "true"},
+			{Id: "variation-2", Name: "off", Value: "false"},
+		},
+		OffVariation: "variation-2",
+		DefaultStrategy: &featureproto.Strategy{
+			Type:          featureproto.Strategy_FIXED,
+			FixedStrategy: &featureproto.FixedStrategy{Variation: "variation-1"},
+		},
+		Targets: []*featureproto.Target{
+			{Variation: "variation-1", Users: []string{"user-1"}},
+			{Variation: "variation-2"},
+		},
+	}
+}
+
+// newTestRestoreSnapshot returns version 2 of the feature, which had a third
+// variation and a segment rule that were removed by version 3.
+func newTestRestoreSnapshot() *featureproto.Feature {
+	f := newTestRestoreFeature()
+	f.Version = 2
+	f.Variations = append(f.Variations, &featureproto.Variation{Id: restoreVariationID, Name: "maybe", Value: "maybe"})
+	f.OffVariation = restoreVariationID
+	f.Targets = []*featureproto.Target{
+		{Variation: "variation-1", Users: []string{"user-2"}},
+		{Variation: "variation-2"},
+		{Variation: restoreVariationID},
+	}
+	f.Rules = []*featureproto.Rule{
+		{
+			Id: restoreRuleID,
+			Clauses: []*featureproto.Clause{
+				{Id: restoreClauseID, Operator: featureproto.Clause_SEGMENT, Values: []string{"segment-1"}},
+			},
+			Strategy: &featureproto.Strategy{
+				Type:          featureproto.Strategy_FIXED,
+				FixedStrategy: &featureproto.FixedStrategy{Variation: "variation-2"},
+			},
+		},
+	}
+	return f
+}
+
+func newTestFeatureAuditLog(t *testing.T, timestamp int64, f *featureproto.Feature) *auditlogproto.AuditLog {
+	t.Helper()
+	data, err := json.MarshalIndent(f, "", "  ")
+	require.NoError(t, err)
+	return &auditlogproto.AuditLog{
+		Id:         f.Id,
+		Timestamp:  timestamp,
+		EntityId:   f.Id,
+		EntityData: string(data),
+	}
+}
+
+func TestRestoreFeature(t *testing.T) {
+	t.Parallel()
+
+	expectListAuditLogs := func(s *FeatureService, logs ...*auditlogproto.AuditLog) {
+		s.auditLogStorage.(*auditlogstoragemock.MockAuditLogStorage).EXPECT().ListAuditLogs(
+			gomock.Any(), gomock.Any(),
+		).Return(logs, len(logs), int64(len(logs)), nil)
+	}
+	expectListFeatures := func(s *FeatureService, features ...*featureproto.Feature) {
+		s.featureStorage.(*mock.MockFeatureStorage).EXPECT().ListFeatures(
+			gomock.Any(), gomock.Any(),
+		).Return(features, 0, int64(0), nil)
+	}
+	expectGetSegment := func(s *FeatureService, err error) {
+		var segment *domain.Segment
+		if err == nil {
+			segment = &domain.Segment{Segment: &featureproto.Segment{Id: "segment-1"}}
+		}
+		s.segmentStorage.(*mock.MockSegmentStorage).EXPECT().GetSegment(
+			gomock.Any(), "segment-1", "namespace",
+		).Return(segment, nil, err)
+	}
+
+	patterns := []struct {
+		desc        string
+		setup       func(*testing.T, *FeatureService)
+		req         *featureproto.RestoreFeatureRequest
+		expected    *featureproto.RestoreFeatureResponse
+		expectedErr error
+	}{
+		{
+			desc: "err: missing version and timestamp",
+			req: &featureproto.RestoreFeatureRequest{
+				EnvironmentId: "namespace",
+				Id:            "feature-id",
+			},
+			expectedErr: statusMissingRestorePoint.Err(),
+		},
+		{
+			desc: "err: version not in the history",
+			setup: func(t *testing.T, s *FeatureService) {
+				expectListAuditLogs(s, newTestFeatureAuditLog(t, 300, newTestRestoreFeature()))
+			},
+			req: &featureproto.RestoreFeatureRequest{
+				EnvironmentId: "namespace",
+				Id:            "feature-id",
+				Version:       2,
+			},
+			expectedErr: statusFeatureSnapshotNotFound.Err(),
+		},
+		{
+			desc: "err: segment was deleted",
+			setup: func(t *testing.T, s *FeatureService) {
+				expectListAuditLogs(s,
+					newTestFeatureAuditLog(t, 300, newTestRestoreFeature()),
+					newTestFeatureAuditLog(t, 200, newTestRestoreSnapshot()),
+				)
+				expectListFeatures(s, newTestRestoreFeature())
+				expectGetSegment(s, v2fs.ErrSegmentNotFound)
+			},
+			req: &featureproto.RestoreFeatureRequest{
+				EnvironmentId: "namespace",
+				Id:            "feature-id",
+				Version:       2,
+			},
+			expectedErr: statusRestoreSegmentNotFound.Err(),
+		},
+		{
+			desc: "err: prerequisite feature was deleted",
+			setup: func(t *testing.T, s *FeatureService) {
+				snapshot := newTestRestoreFeature()
+				snapshot.Version = 2
+				snapshot.Prerequisites = []*featureproto.Prerequisite{
+					{FeatureId: "deleted-feature", VariationId: "variation"},
+				}
+				expectListAuditLogs(s, newTestFeatureAuditLog(t, 200, snapshot))
+				expectListFeatures(s, newTestRestoreFeature())
+			},
+			req: &featureproto.RestoreFeatureRequest{
+				EnvironmentId: "namespace",
+				Id:            "feature-id",
+				Version:       2,
+			},
+			expectedErr: statusRestoreReferenceNotFound.Err(),
+		},
+		{
+			desc: "success: nothing to restore",
+			setup: func(t *testing.T, s *FeatureService) {
+				expectListAuditLogs(s, newTestFeatureAuditLog(t, 300, newTestRestoreFeature()))
+				expectListFeatures(s, newTestRestoreFeature())
+			},
+			req: &featureproto.RestoreFeatureRequest{
+				EnvironmentId: "namespace",
+				Id:            "feature-id",
+				Version:       3,
+			},
+			expected: &featureproto.RestoreFeatureResponse{
+				Feature:         newTestRestoreFeature(),
+				RestoredVersion: 3,
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			service := createFeatureServiceNew(gomock.NewController(t))
+			if p.setup != nil {
+				p.setup(t, service)
+			}
+			resp, err := service.RestoreFeature(createContextWithToken(), p.req)
+			assert.Equal(t, p.expectedErr, err)
+			assert.True(t, proto.Equal(p.expected, resp))
+		})
+	}
+}
+
+func TestRestoreFeatureDryRun(t *testing.T) {
+	t.Parallel()
+	service := createFeatureServiceNew(gomock.NewController(t))
+	service.auditLogStorage.(*auditlogstoragemock.MockAuditLogStorage).EXPECT().ListAuditLogs(
+		gomock.Any(), gomock.Any(),
+	).Return([]*auditlogproto.AuditLog{
+		newTestFeatureAuditLog(t, 300, newTestRestoreFeature()),
+		newTestFeatureAuditLog(t, 200, newTestRestoreSnapshot()),
+	}, 2, int64(2), nil)
+	service.featureStorage.(*mock.MockFeatureStorage).EXPECT().ListFeatures(
+		gomock.Any(), gomock.Any(),
+	).Return([]*featureproto.Feature{newTestRestoreFeature()}, 0, int64(0), nil)
+	service.segmentStorage.(*mock.MockSegmentStorage).EXPECT().GetSegment(
+		gomock.Any(), "segment-1", "namespace",
+	).Return(&domain.Segment{Segment: &featureproto.Segment{Id: "segment-1"}}, nil, nil)
+
+	resp, err := service.RestoreFeature(createContextWithToken(), &featureproto.RestoreFeatureRequest{
+		EnvironmentId: "namespace",
+		Id:            "feature-id",
+		Version:       2,
+		DryRun:        true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.RestoredVersion)
+	assert.Equal(t, []string{"variations", "off_variation", "rules", "targets"}, resp.ChangedFields)
+	assert.Nil(t, resp.ChangeRequest)
+
+	snapshot := newTestRestoreSnapshot()
+	// The preview is the new version the restore would create.
+	assert.Equal(t, int32(4), resp.Feature.Version)
+	assert.True(t, proto.Equal(
+		&featureproto.Feature{Variations: snapshot.Variations, Rules: snapshot.Rules},
+		&featureproto.Feature{Variations: resp.Feature.Variations, Rules: resp.Feature.Rules},
+	))
+	assert.Equal(t, snapshot.OffVariation, resp.Feature.OffVariation)
+	assert.Equal(t, []string{"user-2"}, resp.Feature.Targets[0].Users)
+}
+
+func TestFindFeatureSnapshotByTimestamp(t *testing.T) {
+	t.Parallel()
+	service := createFeatureServiceNew(gomock.NewController(t))
+	v1 := newTestRestoreFeature()
+	v1.Version = 1
+	v2 := newTestRestoreFeature()
+	v2.Version = 2
+	v3 := newTestRestoreFeature()
+	service.auditLogStorage.(*auditlogstoragemock.MockAuditLogStorage).EXPECT().ListAuditLogs(
+		gomock.Any(), gomock.Any(),
+	).DoAndReturn(func(_ any, params interface{}) ([]*auditlogproto.AuditLog, int, int64, error) {
+		assert.Equal(t, int64(250), params.(v2als.ListAuditLogsParams).To)
+		// Logs sharing a timestamp are not ordered by version.
+		return []*auditlogproto.AuditLog{
+			newTestFeatureAuditLog(t, 200, v2),
+			newTestFeatureAuditLog(t, 200, v3),
+			newTestFeatureAuditLog(t, 100, v1),
+		}, 3, int64(3), nil
+	})
+	snapshot, err := service.findFeatureSnapshot(createContextWithToken(), &featureproto.RestoreFeatureRequest{
+		EnvironmentId: "namespace",
+		Id:            "feature-id",
+		Timestamp:     250,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), snapshot.Version)
+}
+
+func TestRestoreComment(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "Restored from v2", restoreComment(2, ""))
+	assert.Equal(t, "Restored from v2: bad rollout", restoreComment(2, "bad rollout"))
+}
@@ -24,6 +24,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
 	domainevent "github.com/bucketeer-io/bucketeer/v2/pkg/domainevent/domain"
@@ -31,6 +32,7 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/scheduled"
 	v2fs "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database"
 	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
 	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
@@ -41,9 +43,12 @@ func (s *FeatureService) CreateScheduledFlagChange(
 	ctx context.Context,
 	req *ftproto.CreateScheduledFlagChangeRequest,
 ) (*ftproto.CreateScheduledFlagChangeResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_UPDATE,
+		ResourceID:   req.FeatureId,
+		Tags:         s.featureTags(ctx, req.FeatureId, req.EnvironmentId),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -218,9 +223,10 @@ func (s *FeatureService) GetScheduledFlagChange(
 	ctx context.Context,
 	req *ftproto.GetScheduledFlagChangeRequest,
 ) (*ftproto.GetScheduledFlagChangeResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -271,9 +277,10 @@ func (s *FeatureService) UpdateScheduledFlagChange(
 	ctx context.Context,
 	req *ftproto.UpdateScheduledFlagChangeRequest,
 ) (*ftproto.UpdateScheduledFlagChangeResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_UPDATE,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -468,9 +475,10 @@ func (s *FeatureService) DeleteScheduledFlagChange(
 	ctx context.Context,
 	req *ftproto.DeleteScheduledFlagChangeRequest,
 ) (*ftproto.DeleteScheduledFlagChangeResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_UPDATE,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -555,9 +563,10 @@ func (s *FeatureService) ListScheduledFlagChanges(
 	ctx context.Context,
 	req *ftproto.ListScheduledFlagChangesRequest,
 ) (*ftproto.ListScheduledFlagChangesResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -635,9 +644,10 @@ func (s *FeatureService) ExecuteScheduledFlagChange(
 	ctx context.Context,
 	req *ftproto.ExecuteScheduledFlagChangeRequest,
 ) (*ftproto.ExecuteScheduledFlagChangeResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_UPDATE,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -679,6 +689,15 @@ func (s *FeatureService) ExecuteScheduledFlagChange(
 			return statusScheduledFlagChangeNotPending.Err()
 		}
 
+		// Scheduled changes are applied without review,
+		// so they can't be executed in an environment that requires change approval.
+		if err := s.checkDirectChangeAllowed(ctx, req.EnvironmentId); err != nil {
+			if isPermanentScheduledChangeError(err) {
+				failureReason = err.Error()
+			}
+			return err
+		}
+
 		// Get the feature
 		feature, err := s.featureStorage.GetFeature(ctxWithTx, sfc.FeatureId, req.EnvironmentId)
 		if err != nil {
@@ -819,9 +838,10 @@ func (s *FeatureService) GetScheduledFlagChangeSummary(
 	ctx context.Context,
 	req *ftproto.GetScheduledFlagChangeSummaryRequest,
 ) (*ftproto.GetScheduledFlagChangeSummaryResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -961,6 +981,9 @@ func (s *FeatureService) validateScheduledChangePayload(
 			}
 		}
 	}
+	if err := validateRuleChanges(payload.RuleChanges); err != nil {
+		return err
+	}
 
 	// Validate ordered_rule_ids: if provided, must exactly match the post-change rule set.
 	// Build the expected rule IDs by applying CREATE/DELETE changes to the current rules.
@@ -1054,7 +1077,16 @@ func (s *FeatureService) validateScheduledChangePayload(
 		}
 	}
 
-	if len(payload.VariationChanges) > 0 {
+	// The variation value schema must hold for the variations after the changes.
+	schemaChanged := payload.VariationValueSchema != nil || payload.ClearVariationValueSchema != nil
+	if len(payload.VariationChanges) > 0 || schemaChanged {
+		var schemaUpdate *domain.VariationValueSchemaUpdate
+		if schemaChanged {
+			schemaUpdate = &domain.VariationValueSchemaUpdate{
+				Schema: payload.VariationValueSchema,
+				Clear:  payload.ClearVariationValueSchema,
+			}
+		}
 		if _, err := (&domain.Feature{Feature: feature}).Update(
 			nil,
 			nil,
@@ -1071,12 +1103,19 @@ func (s *FeatureService) validateScheduledChangePayload(
 			nil,
 			nil,
 			nil,
-			nil,
+			schemaUpdate,
 		); err != nil {
 			return err
 		}
 	}
 
+	if payload.Kind != ftproto.FeatureLifecycle_KIND_UNSPECIFIED || payload.PlannedRemovalAt != nil {
+		clone := &domain.Feature{Feature: proto.Clone(feature).(*ftproto.Feature)}
+		if _, err := clone.UpdateLifecycle(payload.Kind, payload.PlannedRemovalAt); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1134,22 +1173,27 @@ func convertPayloadToUpdateRequest(
 	featureID, environmentID string,
 ) *ftproto.UpdateFeatureRequest {
 	req := &ftproto.UpdateFeatureRequest{
-		EnvironmentId:       environmentID,
-		Id:                  featureID,
-		VariationChanges:    payload.VariationChanges,
-		RuleChanges:         payload.RuleChanges,
-		PrerequisiteChanges: payload.PrerequisiteChanges,
-		TargetChanges:       payload.TargetChanges,
-		TagChanges:          payload.TagChanges,
-		DefaultStrategy:     payload.DefaultStrategy,
-		OffVariation:        payload.OffVariation,
-		Enabled:             payload.Enabled,
-		Name:                payload.Name,
-		Description:         payload.Description,
-		Archived:            payload.Archived,
-		ResetSamplingSeed:   payload.ResetSamplingSeed,
-		Maintainer:          payload.Maintainer,
-		OrderedRuleIds:      payload.OrderedRuleIds,
+		EnvironmentId:             environmentID,
+		Id:                        featureID,
+		VariationChanges:          payload.VariationChanges,
+		RuleChanges:               payload.RuleChanges,
+		PrerequisiteChanges:       payload.PrerequisiteChanges,
+		TargetChanges:             payload.TargetChanges,
+		TagChanges:                payload.TagChanges,
+		DefaultStrategy:           payload.DefaultStrategy,
+		OffVariation:              payload.OffVariation,
+		Enabled:                   payload.Enabled,
+		Name:                      payload.Name,
+		Description:               payload.Description,
+		Archived:                  payload.Archived,
+		ResetSamplingSeed:         payload.ResetSamplingSeed,
+		Maintainer:                payload.Maintainer,
+		OrderedRuleIds:            payload.OrderedRuleIds,
+		Tags:                      payload.Tags,
+		VariationValueSchema:      payload.VariationValueSchema,
+		ClearVariationValueSchema: payload.ClearVariationValueSchema,
+		Kind:                      payload.Kind,
+		PlannedRemovalAt:          payload.PlannedRemovalAt,
 	}
 	return req
 }
@@ -1460,7 +1504,7 @@ func extractSummaryReferenceIDs(
 
 	for _, rc := range payload.RuleChanges {
 		if rc.Rule != nil {
-			for _, clause := range rc.Rule.Clauses {
+			for _, clause := range domain.RuleClauses(rc.Rule) {
 				addClauseReferences(clause)
 			}
 		}
@@ -1474,7 +1518,7 @@ func extractSummaryReferenceIDs(
 			if rule.Id != rc.Rule.Id {
 				continue
 			}
-			for _, clause := range rule.Clauses {
+			for _, clause := range domain.RuleClauses(rule) {
 				addClauseReferences(clause)
 			}
 			break
@@ -30,6 +30,7 @@ import (
 	publishermock "github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher/mock"
 	databasemock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database/mock"
 	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	envproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
 	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
 )
 
@@ -473,6 +474,7 @@ func TestExecuteScheduledFlagChange_ValidationFailureMarksFailedOutsideTransacti
 	)
 	scheduledStorage.EXPECT().GetScheduledFlagChange(gomock.Any(), "sfc-id", "ns0").
 		Return(&domain.ScheduledFlagChange{ScheduledFlagChange: sfc}, nil)
+	expectGetEnvironment(service, &envproto.EnvironmentV2{Id: "ns0"})
 	featureStorage.EXPECT().GetFeature(gomock.Any(), "feature-id", "ns0").Return(feature, nil)
 
 	// The FAILED status must be persisted after (outside) the failed transaction,
@@ -553,6 +555,7 @@ func TestExecuteScheduledFlagChange_TransientErrorKeepsSchedulePending(t *testin
 	)
 	scheduledStorage.EXPECT().GetScheduledFlagChange(gomock.Any(), "sfc-id", "ns0").
 		Return(&domain.ScheduledFlagChange{ScheduledFlagChange: sfc}, nil)
+	expectGetEnvironment(service, &envproto.EnvironmentV2{Id: "ns0"})
 	featureStorage.EXPECT().GetFeature(gomock.Any(), "feature-id", "ns0").Return(feature, nil)
 	// The prerequisite lookup fails with a transient storage error (not "not found").
 	featureStorage.EXPECT().GetFeature(gomock.Any(), "prereq-feature-id", "ns0").
@@ -569,6 +572,57 @@ func TestExecuteScheduledFlagChange_TransientErrorKeepsSchedulePending(t *testin
 	assert.Equal(t, statusInternal.Err(), err)
 }
 
+func TestExecuteScheduledFlagChange_ChangeApprovalRequiredMarksFailed(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := createFeatureServiceWithGetAccountByEnvironmentMock(
+		ctrl,
+		accountproto.AccountV2_Role_Organization_MEMBER,
+		accountproto.AccountV2_Role_Environment_EDITOR,
+	)
+
+	dbClient := service.dbClient.(*databasemock.MockClient)
+	scheduledStorage := service.scheduledFlagChangeStorage.(*mock.MockScheduledFlagChangeStorage)
+
+	sfc := &featureproto.ScheduledFlagChange{
+		Id:            "sfc-id",
+		FeatureId:     "feature-id",
+		EnvironmentId: "ns0",
+		ScheduledAt:   time.Now().Add(-time.Minute).Unix(),
+		Status:        featureproto.ScheduledFlagChangeStatus_SCHEDULED_FLAG_CHANGE_STATUS_PENDING,
+		Payload:       &featureproto.ScheduledChangePayload{Enabled: wrapperspb.Bool(true)},
+	}
+
+	dbClient.EXPECT().RunInTransactionV2(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(context.Context) error) error {
+			return f(ctx)
+		},
+	)
+	scheduledStorage.EXPECT().GetScheduledFlagChange(gomock.Any(), "sfc-id", "ns0").
+		Return(&domain.ScheduledFlagChange{ScheduledFlagChange: sfc}, nil)
+	expectGetEnvironment(service, &envproto.EnvironmentV2{Id: "ns0", RequireChangeApproval: true})
+	// The feature must not be updated. The schedule is marked FAILED
+	// because it will never be executable while the environment is protected.
+	scheduledStorage.EXPECT().UpdateScheduledFlagChange(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, updated *domain.ScheduledFlagChange) error {
+			assert.Equal(
+				t,
+				featureproto.ScheduledFlagChangeStatus_SCHEDULED_FLAG_CHANGE_STATUS_FAILED,
+				updated.Status,
+			)
+			assert.NotEmpty(t, updated.FailureReason)
+			return nil
+		})
+
+	_, err := service.ExecuteScheduledFlagChange(createContextWithToken(), &featureproto.ExecuteScheduledFlagChangeRequest{
+		EnvironmentId: "ns0",
+		Id:            "sfc-id",
+	})
+	assert.Equal(t, statusChangeApprovalRequired.Err(), err)
+}
+
 func TestGetScheduledFlagChangeSummary_Success(t *testing.T) {
 	t.Parallel()
 	ctrl := gomock.NewController(t)
@@ -27,6 +27,7 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
 	v2fs "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/uuid"
 	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
@@ -43,9 +44,10 @@ func (s *FeatureService) CreateSegment(
 	ctx context.Context,
 	req *featureproto.CreateSegmentRequest,
 ) (*featureproto.CreateSegmentResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_SEGMENT,
+		Action:       accountproto.Permission_CREATE,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -156,9 +158,11 @@ func (s *FeatureService) DeleteSegment(
 	ctx context.Context,
 	req *featureproto.DeleteSegmentRequest,
 ) (*featureproto.DeleteSegmentResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_SEGMENT,
+		Action:       accountproto.Permission_DELETE,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -269,7 +273,7 @@ func (s *FeatureService) checkSegmentInUse(
 func (s *FeatureService) containsInRules(segmentID string, features []*featureproto.Feature) bool {
 	for _, f := range features {
 		for _, r := range f.Rules {
-			for _, c := range r.Clauses {
+			for _, c := range domain.RuleClauses(r) {
 				if c.Operator == featureproto.Clause_SEGMENT {
 					for _, id := range c.Values {
 						if segmentID == id {
@@ -287,9 +291,11 @@ func (s *FeatureService) UpdateSegment(
 	ctx context.Context,
 	req *featureproto.UpdateSegmentRequest,
 ) (*featureproto.UpdateSegmentResponse, error) {
-	editor, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
-		req.EnvironmentId)
+	editor, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_SEGMENT,
+		Action:       accountproto.Permission_UPDATE,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		s.logger.Error(
 			"Permission denied",
@@ -454,8 +460,8 @@ func (s *FeatureService) refreshSegmentUsersCache(
 	}
 }
 
-// generateSegmentRuleIDs assigns a uuid to every rule and clause
-// that doesn't have an id yet. Ids provided by the caller are kept
+// generateSegmentRuleIDs assigns a uuid to every rule and clause,
+// including the clauses nested in clause groups, that doesn't have an id yet. Ids provided by the caller are kept
 // and validated later by validateSegmentRules.
 func generateSegmentRuleIDs(rules []*featureproto.Rule) error {
 	for _, rule := range rules {
@@ -469,7 +475,7 @@ func generateSegmentRuleIDs(rules []*featureproto.Rule) error {
 			}
 			rule.Id = id.String()
 		}
-		for _, clause := range rule.Clauses {
+		for _, clause := range domain.RuleClauses(rule) {
 			if clause == nil {
 				continue
 			}
@@ -489,9 +495,11 @@ func (s *FeatureService) GetSegment(
 	ctx context.Context,
 	req *featureproto.GetSegmentRequest,
 ) (*featureproto.GetSegmentResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_SEGMENT,
+		Action:       accountproto.Permission_READ,
+		ResourceID:   req.Id,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -546,9 +554,10 @@ func (s *FeatureService) ListSegments(
 	ctx context.Context,
 	req *featureproto.ListSegmentsRequest,
 ) (*featureproto.ListSegmentsResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_SEGMENT,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		return nil, err
 	}
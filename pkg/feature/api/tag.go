@@ -21,10 +21,13 @@ import (
 
 	"go.uber.org/zap"
 
+	domainevent "github.com/bucketeer-io/bucketeer/v2/pkg/domainevent/domain"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/tag/domain"
 	tagstorage "github.com/bucketeer-io/bucketeer/v2/pkg/tag/storage"
 	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
 	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
 	tagproto "github.com/bucketeer-io/bucketeer/v2/proto/tag"
 )
@@ -33,9 +36,10 @@ func (s *FeatureService) ListTags(
 	ctx context.Context,
 	req *featureproto.ListTagsRequest,
 ) (*featureproto.ListTagsResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -146,3 +150,52 @@ func (s *FeatureService) upsertTags(
 	}
 	return nil
 }
+
+// deleteTag deletes the feature flag tag with the given name and publishes the
+// same event as the tag service. The caller checks that no feature uses it.
+func (s *FeatureService) deleteTag(
+	ctx context.Context,
+	editor *eventproto.Editor,
+	name, environmentId string,
+) error {
+	tag, err := s.tagStorage.GetTagByName(ctx, name, environmentId, tagproto.Tag_FEATURE_FLAG)
+	if err != nil {
+		s.logger.Error(
+			"Failed to get tag",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environment_id", environmentId),
+				zap.String("tagName", name),
+			)...,
+		)
+		return err
+	}
+	if err := s.tagStorage.DeleteTag(ctx, tag.Id); err != nil {
+		s.logger.Error(
+			"Failed to delete tag",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environment_id", environmentId),
+				zap.String("tagID", tag.Id),
+			)...,
+		)
+		return err
+	}
+	event, err := domainevent.NewEvent(
+		editor,
+		eventproto.Event_TAG,
+		tag.Id,
+		eventproto.Event_TAG_DELETED,
+		&eventproto.TagDeletedEvent{
+			Id:            tag.Id,
+			EnvironmentId: environmentId,
+		},
+		environmentId,
+		nil,     // Current state: entity no longer exists
+		tag.Tag, // Previous state: what was deleted
+	)
+	if err != nil {
+		return err
+	}
+	return s.domainPublisher.Publish(ctx, event)
+}
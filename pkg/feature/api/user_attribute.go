@@ -22,6 +22,7 @@ import (
 
 	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
 	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
 	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
 )
@@ -30,9 +31,10 @@ func (s *FeatureService) GetUserAttributeKeys(
 	ctx context.Context,
 	req *featureproto.GetUserAttributeKeysRequest,
 ) (*featureproto.GetUserAttributeKeysResponse, error) {
-	_, err := s.checkEnvironmentRole(
-		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
-		req.EnvironmentId)
+	_, err := s.checkPermission(ctx, req.EnvironmentId, role.Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_READ,
+	})
 	if err != nil {
 		s.logger.Error("Failed to get user attribute keys", zap.Error(err))
 		return nil, err
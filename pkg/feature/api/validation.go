@@ -19,6 +19,7 @@ import (
 	"errors"
 	"regexp"
 
+	evaluation "github.com/bucketeer-io/bucketeer/v2/evaluation/go"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
 	featuredomain "github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/uuid"
@@ -88,6 +89,8 @@ func validateCreateSegmentRequest(
 // no strategy, no SEGMENT/FEATURE_FLAG operators (no nesting, no flag cycles),
 // at least one clause per rule with attribute and values set,
 // unique uuid rule/clause ids, and rule/clause count limits.
+// Clauses nested in clause groups are counted and checked the same way,
+// and the groups must follow the same shape and depth limit as in feature rules.
 // Rule and clause ids must be generated before calling this function.
 func validateSegmentRules(rules []*featureproto.Rule) error {
 	if len(rules) > maxSegmentRules {
@@ -108,14 +111,18 @@ func validateSegmentRules(rules []*featureproto.Rule) error {
 		if rule.Strategy != nil {
 			return statusSegmentRuleStrategyNotAllowed.Err()
 		}
-		if len(rule.Clauses) == 0 {
+		if err := featuredomain.ValidateClauseGroups(rule); err != nil {
+			return api.NewGRPCStatus(err).Err()
+		}
+		clauses := featuredomain.RuleClauses(rule)
+		if len(clauses) == 0 {
 			return statusSegmentRuleClauseRequired.Err()
 		}
-		if len(rule.Clauses) > maxSegmentRuleClauses {
+		if len(clauses) > maxSegmentRuleClauses {
 			return statusExceededMaxSegmentRuleClauses.Err()
 		}
-		clauseIDs := make(map[string]struct{}, len(rule.Clauses))
-		for _, clause := range rule.Clauses {
+		clauseIDs := make(map[string]struct{}, len(clauses))
+		for _, clause := range clauses {
 			if clause == nil {
 				return statusSegmentRuleClauseRequired.Err()
 			}
@@ -138,6 +145,48 @@ func validateSegmentRules(rules []*featureproto.Rule) error {
 			if len(clause.Values) == 0 {
 				return statusSegmentRuleClauseValuesRequired.Err()
 			}
+			if err := validateClauseOperatorValues(clause); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateRuleChanges validates the clauses of the rules being created or updated.
+// Structural checks (ids, strategies, empty values) are done by the domain layer
+// when the changes are applied.
+func validateRuleChanges(changes []*featureproto.RuleChange) error {
+	for _, change := range changes {
+		if change == nil || change.Rule == nil {
+			continue
+		}
+		if change.ChangeType != featureproto.ChangeType_CREATE &&
+			change.ChangeType != featureproto.ChangeType_UPDATE {
+			continue
+		}
+		for _, clause := range featuredomain.RuleClauses(change.Rule) {
+			if clause == nil {
+				continue
+			}
+			if err := validateClauseOperatorValues(clause); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateClauseOperatorValues checks that the clause values are valid for its operator.
+// MATCHES_REGEX values must compile with the syntax every SDK supports,
+// otherwise the rule would silently never match in some of them.
+func validateClauseOperatorValues(clause *featureproto.Clause) error {
+	if clause.Operator != featureproto.Clause_MATCHES_REGEX {
+		return nil
+	}
+	for _, value := range clause.Values {
+		if _, err := evaluation.CompileRegex(value); err != nil {
+			return statusInvalidClauseRegex.Err()
 		}
 	}
 	return nil
@@ -276,6 +325,19 @@ func validateDeleteFeatureRequest(req *featureproto.DeleteFeatureRequest) error
 	return nil
 }
 
+func validateRestoreFeatureRequest(req *featureproto.RestoreFeatureRequest) error {
+	if req.EnvironmentId == "" {
+		return statusMissingEnvironmentID.Err()
+	}
+	if req.Id == "" {
+		return statusMissingID.Err()
+	}
+	if req.Version <= 0 && req.Timestamp <= 0 {
+		return statusMissingRestorePoint.Err()
+	}
+	return nil
+}
+
 func validateCloneFeatureRequest(req *featureproto.CloneFeatureRequest) error {
 	if req.Id == "" {
 		return statusMissingID.Err()
@@ -312,6 +374,20 @@ func validateBulkCloneFeatureRequest(req *featureproto.BulkCloneFeatureRequest)
 	return nil
 }
 
+func validateImportFeatureBundleRequest(req *featureproto.ImportFeatureBundleRequest) error {
+	if req.EnvironmentId == "" {
+		return statusMissingEnvironmentID.Err()
+	}
+	if len(req.Bundle) == 0 {
+		return statusMissingBundle.Err()
+	}
+	// Applying requires the token of a prior dry run so the caller has seen the diff.
+	if !req.DryRun && req.DiffToken == "" {
+		return statusMissingDiffToken.Err()
+	}
+	return nil
+}
+
 func (s *FeatureService) validateFeatureStatus(
 	ctx context.Context,
 	id, environmentId string,
@@ -326,22 +402,40 @@ func (s *FeatureService) validateFeatureStatus(
 	return nil
 }
 
+// validateEnvironmentSettings checks the update against the environment settings
+// and returns the environment so callers can apply its other policies (e.g. change approval).
 func (s *FeatureService) validateEnvironmentSettings(
 	ctx context.Context,
 	environmentId, updateComment string,
-) error {
+) (*envproto.EnvironmentV2, error) {
 	req := &envproto.GetEnvironmentV2Request{
 		Id: environmentId,
 	}
 	resp, err := s.environmentClient.GetEnvironmentV2(ctx, req)
 	if err != nil {
-		return api.NewGRPCStatus(err).Err()
+		return nil, api.NewGRPCStatus(err).Err()
 	}
 	if resp.Environment.RequireComment {
 		if updateComment == "" {
-			return statusCommentRequiredForUpdating.Err()
+			return nil, statusCommentRequiredForUpdating.Err()
 		}
 	}
+	return resp.Environment, nil
+}
+
+// checkDirectChangeAllowed rejects automated flag changes (flag triggers, scheduled changes and
+// bundle imports) that would bypass the change approval of a protected environment.
+// Changes that only turn a flag off don't call it, because blocking them would block incident response.
+func (s *FeatureService) checkDirectChangeAllowed(ctx context.Context, environmentId string) error {
+	resp, err := s.environmentClient.GetEnvironmentV2(ctx, &envproto.GetEnvironmentV2Request{
+		Id: environmentId,
+	})
+	if err != nil {
+		return api.NewGRPCStatus(err).Err()
+	}
+	if resp.Environment.RequireChangeApproval {
+		return statusChangeApprovalRequired.Err()
+	}
 	return nil
 }
 
@@ -19,7 +19,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
 )
@@ -378,6 +380,108 @@ func TestValidateSegmentRules(t *testing.T) {
 			},
 			expected: statusSegmentRuleClauseValuesRequired.Err(),
 		},
+		{
+			desc: "success: valid regex",
+			rules: func() []*featureproto.Rule {
+				rule := newValidSegmentRule(ruleID1, clauseID1)
+				rule.Clauses[0].Operator = featureproto.Clause_MATCHES_REGEX
+				rule.Clauses[0].Values = []string{`^user-[0-9]+$`}
+				return []*featureproto.Rule{rule}
+			},
+			expected: nil,
+		},
+		{
+			desc: "error: invalid regex",
+			rules: func() []*featureproto.Rule {
+				rule := newValidSegmentRule(ruleID1, clauseID1)
+				rule.Clauses[0].Operator = featureproto.Clause_MATCHES_REGEX
+				rule.Clauses[0].Values = []string{`^user-[0-9]+$`, `(unclosed`}
+				return []*featureproto.Rule{rule}
+			},
+			expected: statusInvalidClauseRegex.Err(),
+		},
+		{
+			desc: "success: clauses in clause groups",
+			rules: func() []*featureproto.Rule {
+				rule := newValidSegmentRule(ruleID1, clauseID1)
+				rule.ClauseGroups = []*featureproto.ClauseGroup{{
+					Operator: featureproto.ClauseGroup_OR,
+					Clauses:  newValidSegmentRule(ruleID1, clauseID2).Clauses,
+				}}
+				return []*featureproto.Rule{rule}
+			},
+			expected: nil,
+		},
+		{
+			desc: "success: rule with clause groups only",
+			rules: func() []*featureproto.Rule {
+				rule := newValidSegmentRule(ruleID1, clauseID1)
+				rule.ClauseGroups = []*featureproto.ClauseGroup{{
+					Operator: featureproto.ClauseGroup_NOT,
+					Clauses:  rule.Clauses,
+				}}
+				rule.Clauses = nil
+				return []*featureproto.Rule{rule}
+			},
+			expected: nil,
+		},
+		{
+			desc: "error: SEGMENT operator not allowed in clause groups",
+			rules: func() []*featureproto.Rule {
+				rule := newValidSegmentRule(ruleID1, clauseID1)
+				nested := newValidSegmentRule(ruleID1, clauseID2).Clauses
+				nested[0].Operator = featureproto.Clause_SEGMENT
+				rule.ClauseGroups = []*featureproto.ClauseGroup{{
+					Operator: featureproto.ClauseGroup_AND,
+					Groups: []*featureproto.ClauseGroup{{
+						Operator: featureproto.ClauseGroup_OR,
+						Clauses:  nested,
+					}},
+				}}
+				return []*featureproto.Rule{rule}
+			},
+			expected: statusSegmentRuleOperatorNotAllowed.Err(),
+		},
+		{
+			desc: "error: invalid regex in clause groups",
+			rules: func() []*featureproto.Rule {
+				rule := newValidSegmentRule(ruleID1, clauseID1)
+				nested := newValidSegmentRule(ruleID1, clauseID2).Clauses
+				nested[0].Operator = featureproto.Clause_MATCHES_REGEX
+				nested[0].Values = []string{`(unclosed`}
+				rule.ClauseGroups = []*featureproto.ClauseGroup{{
+					Operator: featureproto.ClauseGroup_OR,
+					Clauses:  nested,
+				}}
+				return []*featureproto.Rule{rule}
+			},
+			expected: statusInvalidClauseRegex.Err(),
+		},
+		{
+			desc: "error: duplicate clause ids across clause groups",
+			rules: func() []*featureproto.Rule {
+				rule := newValidSegmentRule(ruleID1, clauseID1)
+				rule.ClauseGroups = []*featureproto.ClauseGroup{{
+					Operator: featureproto.ClauseGroup_OR,
+					Clauses:  newValidSegmentRule(ruleID1, clauseID1).Clauses,
+				}}
+				return []*featureproto.Rule{rule}
+			},
+			expected: statusDuplicateSegmentRuleClauseID.Err(),
+		},
+		{
+			desc: "error: too many clauses including clause groups",
+			rules: func() []*featureproto.Rule {
+				rule := newValidSegmentRule(ruleID1, clauseID1)
+				group := &featureproto.ClauseGroup{Operator: featureproto.ClauseGroup_OR}
+				for i := 0; i < maxSegmentRuleClauses; i++ {
+					group.Clauses = append(group.Clauses, newValidSegmentRule(ruleID1, clauseID2).Clauses[0])
+				}
+				rule.ClauseGroups = []*featureproto.ClauseGroup{group}
+				return []*featureproto.Rule{rule}
+			},
+			expected: statusExceededMaxSegmentRuleClauses.Err(),
+		},
 	}
 
 	for _, p := range patterns {
@@ -387,3 +491,150 @@ func TestValidateSegmentRules(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSegmentRulesClauseGroupShape(t *testing.T) {
+	t.Parallel()
+	ruleID := "b52d3181-e6f0-4d4c-b40f-9891d56a708e"
+	clauseID := "4b8e2a0c-5b3f-4d3a-9c1e-7f6d5e4c3b2a"
+	nest := func(depth int) *featureproto.ClauseGroup {
+		group := &featureproto.ClauseGroup{
+			Operator: featureproto.ClauseGroup_OR,
+			Clauses:  newValidSegmentRule(ruleID, clauseID).Clauses,
+		}
+		for i := 1; i < depth; i++ {
+			group = &featureproto.ClauseGroup{
+				Operator: featureproto.ClauseGroup_AND,
+				Groups:   []*featureproto.ClauseGroup{group},
+			}
+		}
+		return group
+	}
+	patterns := []struct {
+		desc        string
+		group       *featureproto.ClauseGroup
+		expectedErr string
+	}{
+		{
+			desc:  "success: max depth",
+			group: nest(5),
+		},
+		{
+			desc:        "error: nested too deeply",
+			group:       nest(6),
+			expectedErr: "clause groups are nested too deeply",
+		},
+		{
+			desc:        "error: empty group",
+			group:       &featureproto.ClauseGroup{Operator: featureproto.ClauseGroup_AND},
+			expectedErr: "clause group must have at least one clause or group",
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			rule := &featureproto.Rule{
+				Id:           ruleID,
+				ClauseGroups: []*featureproto.ClauseGroup{p.group},
+			}
+			err := validateSegmentRules([]*featureproto.Rule{rule})
+			if p.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Contains(t, err.Error(), p.expectedErr)
+		})
+	}
+}
+
+func TestValidateRuleChanges(t *testing.T) {
+	t.Parallel()
+	newRuleChange := func(
+		changeType featureproto.ChangeType,
+		operator featureproto.Clause_Operator,
+		values ...string,
+	) *featureproto.RuleChange {
+		return &featureproto.RuleChange{
+			ChangeType: changeType,
+			Rule: &featureproto.Rule{
+				Id: "rule-id",
+				Clauses: []*featureproto.Clause{
+					{
+						Id:        "clause-id",
+						Attribute: "email",
+						Operator:  operator,
+						Values:    values,
+					},
+				},
+			},
+		}
+	}
+
+	patterns := []struct {
+		desc     string
+		changes  []*featureproto.RuleChange
+		expected error
+	}{
+		{
+			desc:     "success: no changes",
+			changes:  nil,
+			expected: nil,
+		},
+		{
+			desc: "success: non-regex operators are not checked",
+			changes: []*featureproto.RuleChange{
+				newRuleChange(featureproto.ChangeType_CREATE, featureproto.Clause_EQUALS, "(unclosed"),
+			},
+			expected: nil,
+		},
+		{
+			desc: "success: valid regex",
+			changes: []*featureproto.RuleChange{
+				newRuleChange(featureproto.ChangeType_CREATE, featureproto.Clause_MATCHES_REGEX, `@example\.com$`),
+				newRuleChange(featureproto.ChangeType_UPDATE, featureproto.Clause_MATCHES_REGEX, `^admin`),
+			},
+			expected: nil,
+		},
+		{
+			desc: "success: deleted rules are not checked",
+			changes: []*featureproto.RuleChange{
+				newRuleChange(featureproto.ChangeType_DELETE, featureproto.Clause_MATCHES_REGEX, "(unclosed"),
+			},
+			expected: nil,
+		},
+		{
+			desc: "error: invalid regex on create",
+			changes: []*featureproto.RuleChange{
+				newRuleChange(featureproto.ChangeType_CREATE, featureproto.Clause_MATCHES_REGEX, "(unclosed"),
+			},
+			expected: statusInvalidClauseRegex.Err(),
+		},
+		{
+			desc: "error: invalid regex on update",
+			changes: []*featureproto.RuleChange{
+				newRuleChange(featureproto.ChangeType_UPDATE, featureproto.Clause_MATCHES_REGEX, "[a-"),
+			},
+			expected: statusInvalidClauseRegex.Err(),
+		},
+		{
+			desc: "error: regex syntax not supported by every SDK",
+			changes: []*featureproto.RuleChange{
+				newRuleChange(featureproto.ChangeType_CREATE, featureproto.Clause_MATCHES_REGEX, "(?i)^admin$"),
+			},
+			expected: statusInvalidClauseRegex.Err(),
+		},
+		{
+			desc: "error: named group",
+			changes: []*featureproto.RuleChange{
+				newRuleChange(featureproto.ChangeType_UPDATE, featureproto.Clause_MATCHES_REGEX, "(?P<id>[0-9]+)"),
+			},
+			expected: statusInvalidClauseRegex.Err(),
+		},
+	}
+
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			err := validateRuleChanges(p.changes)
+			assert.Equal(t, p.expected, err)
+		})
+	}
+}
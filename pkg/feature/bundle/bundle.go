@@ -0,0 +1,310 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bundle converts the flag definitions of an environment to and from
+// a portable bundle that can be kept in version control and imported into
+// another environment or project.
+//
+// A bundle never contains environment-specific IDs: variations are referenced
+// by value, segments by name and prerequisites by feature ID and variation
+// value, so the same bundle can be applied to any environment.
+package bundle
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+// Version is the schema version written to exported bundles.
+const Version = 1
+
+var (
+	ErrUnsupportedVersion = errors.New("bundle: unsupported version")
+	ErrUnsupportedFormat  = errors.New("bundle: unsupported format")
+	ErrDuplicateFeature   = errors.New("bundle: duplicate feature id")
+	ErrDuplicateSegment   = errors.New("bundle: duplicate segment name")
+	ErrDuplicateVariation = errors.New("bundle: duplicate variation value")
+	ErrRuleIDRequired     = errors.New("bundle: rule id is required")
+	ErrUnknownVariation   = errors.New("bundle: unknown variation")
+	ErrUnknownSegment     = errors.New("bundle: unknown segment")
+	ErrUnknownFeature     = errors.New("bundle: unknown feature")
+	ErrUnknownEnum        = errors.New("bundle: unknown enum value")
+)
+
+type Bundle struct {
+	Version  int        `json:"version" yaml:"version"`
+	Tags     []string   `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Segments []*Segment `json:"segments,omitempty" yaml:"segments,omitempty"`
+	Features []*Feature `json:"features,omitempty" yaml:"features,omitempty"`
+}
+
+// Segment holds the definition of a segment. Uploaded segment users are not
+// part of a bundle.
+type Segment struct {
+	Name        string  `json:"name" yaml:"name"`
+	Description string  `json:"description,omitempty" yaml:"description,omitempty"`
+	Rules       []*Rule `json:"rules,omitempty" yaml:"rules,omitempty"`
+}
+
+type Feature struct {
+	ID                  string                `json:"id" yaml:"id"`
+	Name                string                `json:"name" yaml:"name"`
+	Description         string                `json:"description,omitempty" yaml:"description,omitempty"`
+	VariationType       string                `json:"variationType" yaml:"variationType"`
+	Variations          []*Variation          `json:"variations" yaml:"variations"`
+	Tags                []string              `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Enabled             bool                  `json:"enabled" yaml:"enabled"`
+	Archived            bool                  `json:"archived,omitempty" yaml:"archived,omitempty"`
+	Kind                string                `json:"kind,omitempty" yaml:"kind,omitempty"`
+	PlannedRemovalAt    int64                 `json:"plannedRemovalAt,omitempty" yaml:"plannedRemovalAt,omitempty"`
+	OffVariation        string                `json:"offVariation" yaml:"offVariation"`
+	DefaultStrategy     *Strategy             `json:"defaultStrategy" yaml:"defaultStrategy"`
+	Rules               []*Rule               `json:"rules,omitempty" yaml:"rules,omitempty"`
+	Targets             []*Target             `json:"targets,omitempty" yaml:"targets,omitempty"`
+	Prerequisites       []*Prerequisite       `json:"prerequisites,omitempty" yaml:"prerequisites,omitempty"`
+	AutoOpsRules        []*AutoOpsRule        `json:"autoOpsRules,omitempty" yaml:"autoOpsRules,omitempty"`
+	ProgressiveRollouts []*ProgressiveRollout `json:"progressiveRollouts,omitempty" yaml:"progressiveRollouts,omitempty"`
+}
+
+type Variation struct {
+	Value       string `json:"value" yaml:"value"`
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// Target lists the users individually targeted to the variation with the given value.
+type Target struct {
+	Variation string   `json:"variation" yaml:"variation"`
+	Users     []string `json:"users" yaml:"users"`
+}
+
+type Prerequisite struct {
+	FeatureID string `json:"featureId" yaml:"featureId"`
+	Variation string `json:"variation" yaml:"variation"`
+}
+
+// Rule keeps the rule ID so repeated imports update the same rule instead of
+// recreating it.
+type Rule struct {
+	ID           string         `json:"id,omitempty" yaml:"id,omitempty"`
+	Name         string         `json:"name,omitempty" yaml:"name,omitempty"`
+	Description  string         `json:"description,omitempty" yaml:"description,omitempty"`
+	Clauses      []*Clause      `json:"clauses" yaml:"clauses"`
+	ClauseGroups []*ClauseGroup `json:"clauseGroups,omitempty" yaml:"clauseGroups,omitempty"`
+	Strategy     *Strategy      `json:"strategy,omitempty" yaml:"strategy,omitempty"`
+}
+
+// ClauseGroup combines its clauses and nested groups with AND, OR or NOT.
+type ClauseGroup struct {
+	Operator string         `json:"operator" yaml:"operator"`
+	Clauses  []*Clause      `json:"clauses,omitempty" yaml:"clauses,omitempty"`
+	Groups   []*ClauseGroup `json:"groups,omitempty" yaml:"groups,omitempty"`
+}
+
+// Clause values reference segments by name for the SEGMENT operator and
+// variations by value for the FEATURE_FLAG operator.
+type Clause struct {
+	ID        string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Attribute string   `json:"attribute" yaml:"attribute"`
+	Operator  string   `json:"operator" yaml:"operator"`
+	Values    []string `json:"values" yaml:"values"`
+}
+
+type Strategy struct {
+	Type             string               `json:"type" yaml:"type"`
+	Variation        string               `json:"variation,omitempty" yaml:"variation,omitempty"`
+	Rollout          []*WeightedVariation `json:"rollout,omitempty" yaml:"rollout,omitempty"`
+	Audience         *Audience            `json:"audience,omitempty" yaml:"audience,omitempty"`
+	BucketBy         string               `json:"bucketBy,omitempty" yaml:"bucketBy,omitempty"`
+	BucketByFallback string               `json:"bucketByFallback,omitempty" yaml:"bucketByFallback,omitempty"`
+}
+
+type WeightedVariation struct {
+	Variation string `json:"variation" yaml:"variation"`
+	Weight    int32  `json:"weight" yaml:"weight"`
+}
+
+type Audience struct {
+	Percentage       int32  `json:"percentage" yaml:"percentage"`
+	DefaultVariation string `json:"defaultVariation,omitempty" yaml:"defaultVariation,omitempty"`
+	BucketBy         string `json:"bucketBy,omitempty" yaml:"bucketBy,omitempty"`
+}
+
+type AutoOpsRule struct {
+	OpsType          string             `json:"opsType" yaml:"opsType"`
+	EventRateClauses []*EventRateClause `json:"eventRateClauses,omitempty" yaml:"eventRateClauses,omitempty"`
+	DatetimeClauses  []*DatetimeClause  `json:"datetimeClauses,omitempty" yaml:"datetimeClauses,omitempty"`
+}
+
+type EventRateClause struct {
+	Variation     string  `json:"variation" yaml:"variation"`
+	GoalID        string  `json:"goalId" yaml:"goalId"`
+	MinCount      int64   `json:"minCount" yaml:"minCount"`
+	ThresholdRate float64 `json:"thresholdRate" yaml:"thresholdRate"`
+	Operator      string  `json:"operator" yaml:"operator"`
+	ActionType    string  `json:"actionType" yaml:"actionType"`
+}
+
+type DatetimeClause struct {
+	Time       int64       `json:"time" yaml:"time"`
+	ActionType string      `json:"actionType" yaml:"actionType"`
+	Recurrence *Recurrence `json:"recurrence,omitempty" yaml:"recurrence,omitempty"`
+}
+
+type Recurrence struct {
+	Frequency      string  `json:"frequency" yaml:"frequency"`
+	DaysOfWeek     []int32 `json:"daysOfWeek,omitempty" yaml:"daysOfWeek,omitempty"`
+	DayOfMonth     int32   `json:"dayOfMonth,omitempty" yaml:"dayOfMonth,omitempty"`
+	StartDate      int64   `json:"startDate,omitempty" yaml:"startDate,omitempty"`
+	EndDate        int64   `json:"endDate,omitempty" yaml:"endDate,omitempty"`
+	MaxOccurrences int32   `json:"maxOccurrences,omitempty" yaml:"maxOccurrences,omitempty"`
+	Timezone       string  `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+}
+
+type ProgressiveRollout struct {
+	Type             string             `json:"type" yaml:"type"`
+	ControlVariation string             `json:"controlVariation" yaml:"controlVariation"`
+	TargetVariation  string             `json:"targetVariation" yaml:"targetVariation"`
+	Interval         string             `json:"interval,omitempty" yaml:"interval,omitempty"`
+	Increments       int64              `json:"increments,omitempty" yaml:"increments,omitempty"`
+	Schedules        []*RolloutSchedule `json:"schedules" yaml:"schedules"`
+	GuardrailGoals   []*GuardrailGoal   `json:"guardrailGoals,omitempty" yaml:"guardrailGoals,omitempty"`
+}
+
+type RolloutSchedule struct {
+	ExecuteAt int64 `json:"executeAt" yaml:"executeAt"`
+	Weight    int32 `json:"weight" yaml:"weight"`
+}
+
+type GuardrailGoal struct {
+	GoalID    string  `json:"goalId" yaml:"goalId"`
+	Threshold float64 `json:"threshold,omitempty" yaml:"threshold,omitempty"`
+}
+
+// Marshal encodes the bundle. The output is deterministic, so exporting the
+// same environment twice produces identical bytes.
+func Marshal(b *Bundle, format featureproto.FeatureBundleFormat) ([]byte, error) {
+	switch format {
+	case featureproto.FeatureBundleFormat_FEATURE_BUNDLE_FORMAT_YAML:
+		return yaml.Marshal(b)
+	case featureproto.FeatureBundleFormat_FEATURE_BUNDLE_FORMAT_JSON:
+		data, err := json.MarshalIndent(b, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// Unmarshal decodes and validates a bundle in either YAML or JSON.
+func Unmarshal(data []byte) (*Bundle, error) {
+	b := &Bundle{}
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		if err := json.Unmarshal(trimmed, b); err != nil {
+			return nil, err
+		}
+	} else if err := yaml.UnmarshalStrict(trimmed, b); err != nil {
+		return nil, err
+	}
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *Bundle) validate() error {
+	if b.Version != Version {
+		return fmt.Errorf("%w: %d", ErrUnsupportedVersion, b.Version)
+	}
+	segments := make(map[string]struct{}, len(b.Segments))
+	for _, s := range b.Segments {
+		if _, ok := segments[s.Name]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateSegment, s.Name)
+		}
+		segments[s.Name] = struct{}{}
+	}
+	features := make(map[string]struct{}, len(b.Features))
+	for _, f := range b.Features {
+		if _, ok := features[f.ID]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateFeature, f.ID)
+		}
+		features[f.ID] = struct{}{}
+		values := make(map[string]struct{}, len(f.Variations))
+		for _, v := range f.Variations {
+			if _, ok := values[v.Value]; ok {
+				return fmt.Errorf("%w: %s: %s", ErrDuplicateVariation, f.ID, v.Value)
+			}
+			values[v.Value] = struct{}{}
+		}
+		for _, r := range f.Rules {
+			if r.ID == "" {
+				return fmt.Errorf("%w: %s", ErrRuleIDRequired, f.ID)
+			}
+		}
+	}
+	return nil
+}
+
+// Feature returns the feature with the given ID, or nil.
+func (b *Bundle) Feature(id string) *Feature {
+	for _, f := range b.Features {
+		if f.ID == id {
+			return f
+		}
+	}
+	return nil
+}
+
+// sortBundle orders every unordered collection so the encoding is stable.
+// Variations and rules keep their order because it is meaningful.
+func sortBundle(b *Bundle) {
+	sort.Strings(b.Tags)
+	sort.Slice(b.Segments, func(i, j int) bool { return b.Segments[i].Name < b.Segments[j].Name })
+	sort.Slice(b.Features, func(i, j int) bool { return b.Features[i].ID < b.Features[j].ID })
+	for _, f := range b.Features {
+		sort.Strings(f.Tags)
+		for _, t := range f.Targets {
+			sort.Strings(t.Users)
+		}
+		sort.Slice(f.Prerequisites, func(i, j int) bool {
+			return f.Prerequisites[i].FeatureID < f.Prerequisites[j].FeatureID
+		})
+		sort.Slice(f.AutoOpsRules, func(i, j int) bool {
+			return canonical(f.AutoOpsRules[i]) < canonical(f.AutoOpsRules[j])
+		})
+		sort.Slice(f.ProgressiveRollouts, func(i, j int) bool {
+			return canonical(f.ProgressiveRollouts[i]) < canonical(f.ProgressiveRollouts[j])
+		})
+	}
+}
+
+// canonical returns a stable string form of v used to order and compare
+// resources that have no portable identity.
+func canonical(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+func newTestBundle() *Bundle {
+	return &Bundle{
+		Version: Version,
+		Tags:    []string{"web", "android"},
+		Segments: []*Segment{
+			{Name: "beta", Description: "beta users"},
+			{Name: "alpha"},
+		},
+		Features: []*Feature{
+			{
+				ID:            "feature-b",
+				Name:          "Feature B",
+				VariationType: "BOOLEAN",
+				Variations: []*Variation{
+					{Value: "true", Name: "on"},
+					{Value: "false", Name: "off"},
+				},
+				Tags:            []string{"web", "android"},
+				OffVariation:    "false",
+				DefaultStrategy: &Strategy{Type: "FIXED", Variation: "true"},
+				Targets:         []*Target{{Variation: "true", Users: []string{"user-2", "user-1"}}},
+			},
+			{
+				ID:            "feature-a",
+				Name:          "Feature A",
+				VariationType: "STRING",
+				Variations: []*Variation{
+					{Value: "a", Name: "A"},
+					{Value: "b", Name: "B"},
+				},
+				OffVariation:    "b",
+				DefaultStrategy: &Strategy{Type: "FIXED", Variation: "a"},
+			},
+		},
+	}
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc   string
+		format featureproto.FeatureBundleFormat
+	}{
+		{
+			desc:   "yaml",
+			format: featureproto.FeatureBundleFormat_FEATURE_BUNDLE_FORMAT_YAML,
+		},
+		{
+			desc:   "json",
+			format: featureproto.FeatureBundleFormat_FEATURE_BUNDLE_FORMAT_JSON,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			b := newTestBundle()
+			sortBundle(b)
+			data, err := Marshal(b, p.format)
+			require.NoError(t, err)
+			again, err := Marshal(b, p.format)
+			require.NoError(t, err)
+			assert.Equal(t, data, again)
+
+			decoded, err := Unmarshal(data)
+			require.NoError(t, err)
+			assert.Equal(t, b, decoded)
+			assert.Equal(t, "feature-a", decoded.Features[0].ID)
+			assert.Equal(t, []string{"android", "web"}, decoded.Tags)
+			assert.Equal(t, []string{"user-1", "user-2"}, decoded.Features[1].Targets[0].Users)
+		})
+	}
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc        string
+		data        string
+		expectedErr error
+	}{
+		{
+			desc:        "err: unsupported version",
+			data:        "version: 2\n",
+			expectedErr: ErrUnsupportedVersion,
+		},
+		{
+			desc:        "err: duplicate feature",
+			data:        `{"version": 1, "features": [{"id": "f"}, {"id": "f"}]}`,
+			expectedErr: ErrDuplicateFeature,
+		},
+		{
+			desc:        "err: duplicate segment",
+			data:        "version: 1\nsegments:\n- name: s\n- name: s\n",
+			expectedErr: ErrDuplicateSegment,
+		},
+		{
+			desc: "err: duplicate variation",
+			data: "version: 1\nfeatures:\n- id: f\n  variations:\n" +
+				"  - value: a\n    name: A\n  - value: a\n    name: B\n",
+			expectedErr: ErrDuplicateVariation,
+		},
+		{
+			desc:        "err: rule id is required",
+			data:        "version: 1\nfeatures:\n- id: f\n  rules:\n  - clauses: []\n",
+			expectedErr: ErrRuleIDRequired,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			_, err := Unmarshal([]byte(p.data))
+			assert.True(t, errors.Is(err, p.expectedErr), err)
+		})
+	}
+}
+
+func TestUnmarshalUnknownField(t *testing.T) {
+	t.Parallel()
+	_, err := Unmarshal([]byte("version: 1\nunknown: true\n"))
+	assert.Error(t, err)
+}
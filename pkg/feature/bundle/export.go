@@ -0,0 +1,421 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"fmt"
+
+	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+// State is the set of resources of an environment that a bundle is
+// exported from or imported into.
+type State struct {
+	Features            []*featureproto.Feature
+	Segments            []*featureproto.Segment
+	Tags                []string
+	AutoOpsRules        []*autoopsproto.AutoOpsRule
+	ProgressiveRollouts []*autoopsproto.ProgressiveRollout
+}
+
+// Export builds a bundle from the state of an environment.
+// Finished, stopped and deleted auto operations and progressive rollouts are
+// left out because they cannot be recreated.
+func Export(s *State) (*Bundle, error) {
+	r := NewResolver(s.Segments, s.Features)
+	b := &Bundle{
+		Version: Version,
+		Tags:    append([]string{}, s.Tags...),
+	}
+	for _, seg := range s.Segments {
+		rules, err := r.exportRules(nil, seg.Rules, false)
+		if err != nil {
+			return nil, fmt.Errorf("segment %s: %w", seg.Name, err)
+		}
+		b.Segments = append(b.Segments, &Segment{
+			Name:        seg.Name,
+			Description: seg.Description,
+			Rules:       rules,
+		})
+	}
+	for _, f := range s.Features {
+		bf, err := r.exportFeature(f)
+		if err != nil {
+			return nil, fmt.Errorf("feature %s: %w", f.Id, err)
+		}
+		bf.AutoOpsRules, err = r.ExportAutoOpsRules(f, s.AutoOpsRules)
+		if err != nil {
+			return nil, fmt.Errorf("feature %s: %w", f.Id, err)
+		}
+		bf.ProgressiveRollouts, err = r.ExportProgressiveRollouts(f, s.ProgressiveRollouts)
+		if err != nil {
+			return nil, fmt.Errorf("feature %s: %w", f.Id, err)
+		}
+		b.Features = append(b.Features, bf)
+	}
+	sortBundle(b)
+	return b, nil
+}
+
+func (r *Resolver) exportFeature(f *featureproto.Feature) (*Feature, error) {
+	values := newVariationValues(f)
+	bf := &Feature{
+		ID:            f.Id,
+		Name:          f.Name,
+		Description:   f.Description,
+		VariationType: f.VariationType.String(),
+		Tags:          append([]string{}, f.Tags...),
+		Enabled:       f.Enabled,
+		Archived:      f.Archived,
+	}
+	if kind := f.GetLifecycle().GetKind(); kind != featureproto.FeatureLifecycle_KIND_UNSPECIFIED {
+		bf.Kind = kind.String()
+	}
+	bf.PlannedRemovalAt = f.GetLifecycle().GetPlannedRemovalAt()
+	for _, v := range f.Variations {
+		bf.Variations = append(bf.Variations, &Variation{
+			Value:       v.Value,
+			Name:        v.Name,
+			Description: v.Description,
+		})
+	}
+	var err error
+	if bf.OffVariation, err = values.get(f.OffVariation); err != nil {
+		return nil, err
+	}
+	if bf.DefaultStrategy, err = exportStrategy(values, f.DefaultStrategy); err != nil {
+		return nil, err
+	}
+	if bf.Rules, err = r.exportRules(values, f.Rules, true); err != nil {
+		return nil, err
+	}
+	if bf.Targets, err = exportTargets(values, f.Targets); err != nil {
+		return nil, err
+	}
+	for _, p := range f.Prerequisites {
+		pf, ok := r.features[p.FeatureId]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownFeature, p.FeatureId)
+		}
+		value, err := newVariationValues(pf).get(p.VariationId)
+		if err != nil {
+			return nil, err
+		}
+		bf.Prerequisites = append(bf.Prerequisites, &Prerequisite{
+			FeatureID: p.FeatureId,
+			Variation: value,
+		})
+	}
+	return bf, nil
+}
+
+// exportTargets returns the targets that have users, in variation order.
+func exportTargets(values variationValues, targets []*featureproto.Target) ([]*Target, error) {
+	var out []*Target
+	for _, t := range targets {
+		if len(t.Users) == 0 {
+			continue
+		}
+		value, err := values.get(t.Variation)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &Target{
+			Variation: value,
+			Users:     append([]string{}, t.Users...),
+		})
+	}
+	return out, nil
+}
+
+func exportStrategy(values variationValues, s *featureproto.Strategy) (*Strategy, error) {
+	if s == nil {
+		return nil, nil
+	}
+	out := &Strategy{Type: s.Type.String()}
+	switch s.Type {
+	case featureproto.Strategy_FIXED:
+		value, err := values.get(s.FixedStrategy.GetVariation())
+		if err != nil {
+			return nil, err
+		}
+		out.Variation = value
+	case featureproto.Strategy_ROLLOUT:
+		for _, v := range s.RolloutStrategy.GetVariations() {
+			value, err := values.get(v.Variation)
+			if err != nil {
+				return nil, err
+			}
+			out.Rollout = append(out.Rollout, &WeightedVariation{
+				Variation: value,
+				Weight:    v.Weight,
+			})
+		}
+		if a := s.RolloutStrategy.GetAudience(); a != nil {
+			value, err := values.get(a.DefaultVariation)
+			if err != nil {
+				return nil, err
+			}
+			out.Audience = &Audience{
+				Percentage:       a.Percentage,
+				DefaultVariation: value,
+				BucketBy:         a.BucketBy,
+			}
+		}
+		fallback, err := values.get(s.RolloutStrategy.GetBucketByFallbackVariation())
+		if err != nil {
+			return nil, err
+		}
+		out.BucketBy = s.RolloutStrategy.GetBucketBy()
+		out.BucketByFallback = fallback
+	}
+	return out, nil
+}
+
+// exportRules converts rules to their portable form. Segment rules have no
+// strategy and their IDs are generated on import, so keepIDs is false for them.
+func (r *Resolver) exportRules(
+	values variationValues,
+	rules []*featureproto.Rule,
+	keepIDs bool,
+) ([]*Rule, error) {
+	var out []*Rule
+	for _, rule := range rules {
+		br := &Rule{Name: rule.Name, Description: rule.Description}
+		if keepIDs {
+			br.ID = rule.Id
+		}
+		clauses, err := r.exportClauses(rule.Clauses, keepIDs)
+		if err != nil {
+			return nil, err
+		}
+		br.Clauses = clauses
+		groups, err := r.exportClauseGroups(rule.ClauseGroups, keepIDs)
+		if err != nil {
+			return nil, err
+		}
+		br.ClauseGroups = groups
+		strategy, err := exportStrategy(values, rule.Strategy)
+		if err != nil {
+			return nil, err
+		}
+		br.Strategy = strategy
+		out = append(out, br)
+	}
+	return out, nil
+}
+
+func (r *Resolver) exportClauses(clauses []*featureproto.Clause, keepIDs bool) ([]*Clause, error) {
+	var out []*Clause
+	for _, c := range clauses {
+		bc, err := r.exportClause(c)
+		if err != nil {
+			return nil, err
+		}
+		if !keepIDs {
+			bc.ID = ""
+		}
+		out = append(out, bc)
+	}
+	return out, nil
+}
+
+func (r *Resolver) exportClauseGroups(groups []*featureproto.ClauseGroup, keepIDs bool) ([]*ClauseGroup, error) {
+	var out []*ClauseGroup
+	for _, g := range groups {
+		clauses, err := r.exportClauses(g.Clauses, keepIDs)
+		if err != nil {
+			return nil, err
+		}
+		nested, err := r.exportClauseGroups(g.Groups, keepIDs)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &ClauseGroup{
+			Operator: g.Operator.String(),
+			Clauses:  clauses,
+			Groups:   nested,
+		})
+	}
+	return out, nil
+}
+
+func (r *Resolver) exportClause(c *featureproto.Clause) (*Clause, error) {
+	out := &Clause{
+		ID:        c.Id,
+		Attribute: c.Attribute,
+		Operator:  c.Operator.String(),
+		Values:    append([]string{}, c.Values...),
+	}
+	switch c.Operator {
+	case featureproto.Clause_SEGMENT:
+		for i, id := range c.Values {
+			name, ok := r.segmentNames[id]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownSegment, id)
+			}
+			out.Values[i] = name
+		}
+	case featureproto.Clause_FEATURE_FLAG:
+		f, ok := r.features[c.Attribute]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownFeature, c.Attribute)
+		}
+		values := newVariationValues(f)
+		for i, id := range c.Values {
+			value, err := values.get(id)
+			if err != nil {
+				return nil, err
+			}
+			out.Values[i] = value
+		}
+	}
+	return out, nil
+}
+
+// ExportAutoOpsRules converts the active auto operation rules of f.
+func (r *Resolver) ExportAutoOpsRules(
+	f *featureproto.Feature,
+	rules []*autoopsproto.AutoOpsRule,
+) ([]*AutoOpsRule, error) {
+	values := newVariationValues(f)
+	var out []*AutoOpsRule
+	for _, rule := range rules {
+		if rule.FeatureId != f.Id || rule.Deleted || !isActiveAutoOpsRule(rule) {
+			continue
+		}
+		br := &AutoOpsRule{OpsType: rule.OpsType.String()}
+		for _, c := range rule.Clauses {
+			switch {
+			case c.Clause.MessageIs(&autoopsproto.OpsEventRateClause{}):
+				ec := &autoopsproto.OpsEventRateClause{}
+				if err := c.Clause.UnmarshalTo(ec); err != nil {
+					return nil, err
+				}
+				value, err := values.get(ec.VariationId)
+				if err != nil {
+					return nil, err
+				}
+				br.EventRateClauses = append(br.EventRateClauses, &EventRateClause{
+					Variation:     value,
+					GoalID:        ec.GoalId,
+					MinCount:      ec.MinCount,
+					ThresholdRate: ec.ThreadsholdRate,
+					Operator:      ec.Operator.String(),
+					ActionType:    ec.ActionType.String(),
+				})
+			case c.Clause.MessageIs(&autoopsproto.DatetimeClause{}):
+				dc := &autoopsproto.DatetimeClause{}
+				if err := c.Clause.UnmarshalTo(dc); err != nil {
+					return nil, err
+				}
+				br.DatetimeClauses = append(br.DatetimeClauses, &DatetimeClause{
+					Time:       dc.Time,
+					ActionType: dc.ActionType.String(),
+					Recurrence: exportRecurrence(dc.Recurrence),
+				})
+			}
+		}
+		out = append(out, br)
+	}
+	return out, nil
+}
+
+func exportRecurrence(r *autoopsproto.RecurrenceRule) *Recurrence {
+	if r == nil {
+		return nil
+	}
+	return &Recurrence{
+		Frequency:      r.Frequency.String(),
+		DaysOfWeek:     append([]int32{}, r.DaysOfWeek...),
+		DayOfMonth:     r.DayOfMonth,
+		StartDate:      r.StartDate,
+		EndDate:        r.EndDate,
+		MaxOccurrences: r.MaxOccurrences,
+		Timezone:       r.Timezone,
+	}
+}
+
+// ExportProgressiveRollouts converts the waiting and running progressive rollouts of f.
+func (r *Resolver) ExportProgressiveRollouts(
+	f *featureproto.Feature,
+	rollouts []*autoopsproto.ProgressiveRollout,
+) ([]*ProgressiveRollout, error) {
+	values := newVariationValues(f)
+	var out []*ProgressiveRollout
+	for _, p := range rollouts {
+		if p.FeatureId != f.Id || !isActiveProgressiveRollout(p) {
+			continue
+		}
+		bp := &ProgressiveRollout{Type: p.Type.String()}
+		var control, target string
+		var schedules []*autoopsproto.ProgressiveRolloutSchedule
+		switch p.Type {
+		case autoopsproto.ProgressiveRollout_MANUAL_SCHEDULE:
+			c := &autoopsproto.ProgressiveRolloutManualScheduleClause{}
+			if err := p.Clause.UnmarshalTo(c); err != nil {
+				return nil, err
+			}
+			control, target, schedules = c.ControlVariationId, c.TargetVariationId, c.Schedules
+			// Fall back to the deprecated field for rollouts created before it was split.
+			if target == "" {
+				target = c.VariationId
+			}
+		case autoopsproto.ProgressiveRollout_TEMPLATE_SCHEDULE:
+			c := &autoopsproto.ProgressiveRolloutTemplateScheduleClause{}
+			if err := p.Clause.UnmarshalTo(c); err != nil {
+				return nil, err
+			}
+			control, target, schedules = c.ControlVariationId, c.TargetVariationId, c.Schedules
+			if target == "" {
+				target = c.VariationId
+			}
+			bp.Interval = c.Interval.String()
+			bp.Increments = c.Increments
+		}
+		var err error
+		if bp.ControlVariation, err = values.get(control); err != nil {
+			return nil, err
+		}
+		if bp.TargetVariation, err = values.get(target); err != nil {
+			return nil, err
+		}
+		for _, s := range schedules {
+			bp.Schedules = append(bp.Schedules, &RolloutSchedule{
+				ExecuteAt: s.ExecuteAt,
+				Weight:    s.Weight,
+			})
+		}
+		for _, g := range p.GuardrailGoals {
+			bp.GuardrailGoals = append(bp.GuardrailGoals, &GuardrailGoal{
+				GoalID:    g.GoalId,
+				Threshold: g.Threshold,
+			})
+		}
+		out = append(out, bp)
+	}
+	return out, nil
+}
+
+func isActiveAutoOpsRule(rule *autoopsproto.AutoOpsRule) bool {
+	return rule.AutoOpsStatus == autoopsproto.AutoOpsStatus_WAITING ||
+		rule.AutoOpsStatus == autoopsproto.AutoOpsStatus_RUNNING
+}
+
+func isActiveProgressiveRollout(p *autoopsproto.ProgressiveRollout) bool {
+	return p.Status == autoopsproto.ProgressiveRollout_WAITING ||
+		p.Status == autoopsproto.ProgressiveRollout_RUNNING
+}
@@ -0,0 +1,884 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/uuid"
+	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
+	commonproto "github.com/bucketeer-io/bucketeer/v2/proto/common"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+// DiffToken fingerprints every resource of an environment that a bundle
+// covers: feature versions, segment definitions, tags, auto operations and
+// progressive rollouts. A diff computed against one token must not be
+// applied once it changes.
+func DiffToken(s *State) (string, error) {
+	lines := make([]string, 0, len(s.Features)+len(s.Segments)+len(s.Tags)+
+		len(s.AutoOpsRules)+len(s.ProgressiveRollouts))
+	for _, f := range s.Features {
+		lines = append(lines, fmt.Sprintf("feature:%s:%d", f.Id, f.Version))
+	}
+	for _, seg := range s.Segments {
+		// Segment versions are deprecated, so the definition itself is hashed.
+		// User counts are left out since uploading users does not change it.
+		def, err := proto.MarshalOptions{Deterministic: true}.Marshal(&featureproto.Segment{
+			Name:        seg.Name,
+			Description: seg.Description,
+			Rules:       seg.Rules,
+			Deleted:     seg.Deleted,
+		})
+		if err != nil {
+			return "", err
+		}
+		sum := sha256.Sum256(def)
+		lines = append(lines, fmt.Sprintf("segment:%s:%s", seg.Id, hex.EncodeToString(sum[:])))
+	}
+	for _, t := range s.Tags {
+		lines = append(lines, "tag:"+t)
+	}
+	for _, r := range s.AutoOpsRules {
+		lines = append(lines, fmt.Sprintf("autoops:%s:%d", r.Id, r.UpdatedAt))
+	}
+	for _, r := range s.ProgressiveRollouts {
+		lines = append(lines, fmt.Sprintf("rollout:%s:%d", r.Id, r.UpdatedAt))
+	}
+	sort.Strings(lines)
+	sum := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// NewCreateFeatureRequest returns the request that creates f with its
+// variations. Everything else is applied by the follow-up FeatureUpdate.
+func NewCreateFeatureRequest(f *Feature) (*featureproto.CreateFeatureRequest, error) {
+	variationType, err := parseEnum(featureproto.Feature_VariationType_value, f.VariationType)
+	if err != nil {
+		return nil, err
+	}
+	req := &featureproto.CreateFeatureRequest{
+		Id:            f.ID,
+		Name:          f.Name,
+		Description:   f.Description,
+		Tags:          f.Tags,
+		VariationType: featureproto.Feature_VariationType(variationType),
+	}
+	if req.Kind, err = parseKind(f.Kind); err != nil {
+		return nil, err
+	}
+	req.PlannedRemovalAt = f.PlannedRemovalAt
+	on, off := 0, -1
+	for i, v := range f.Variations {
+		req.Variations = append(req.Variations, &featureproto.Variation{
+			Value:       v.Value,
+			Name:        v.Name,
+			Description: v.Description,
+		})
+		if s := f.DefaultStrategy; s != nil && s.Type == featureproto.Strategy_FIXED.String() && s.Variation == v.Value {
+			on = i
+		}
+		if v.Value == f.OffVariation {
+			off = i
+		}
+	}
+	if off < 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownVariation, f.OffVariation)
+	}
+	req.DefaultOnVariationIndex = wrapperspb.Int32(int32(on))
+	req.DefaultOffVariationIndex = wrapperspb.Int32(int32(off))
+	return req, nil
+}
+
+// FeatureUpdate returns the request that makes target match desired and the
+// names of the changed fields. The request is nil when nothing changed.
+// Variations are matched by value: new values get new IDs and values missing
+// from the bundle are deleted.
+func (r *Resolver) FeatureUpdate(
+	target *featureproto.Feature,
+	desired *Feature,
+) (*featureproto.UpdateFeatureRequest, []string, error) {
+	req := &featureproto.UpdateFeatureRequest{Id: target.Id}
+	var fields []string
+	if target.Name != desired.Name {
+		req.Name = wrapperspb.String(desired.Name)
+		fields = append(fields, "name")
+	}
+	if target.Description != desired.Description {
+		req.Description = wrapperspb.String(desired.Description)
+		fields = append(fields, "description")
+	}
+	if !sameSet(target.Tags, desired.Tags) {
+		req.Tags = &commonproto.StringListValue{Values: desired.Tags}
+		fields = append(fields, "tags")
+	}
+	if target.Enabled != desired.Enabled {
+		req.Enabled = wrapperspb.Bool(desired.Enabled)
+		fields = append(fields, "enabled")
+	}
+	if target.Archived != desired.Archived {
+		req.Archived = wrapperspb.Bool(desired.Archived)
+		fields = append(fields, "archived")
+	}
+	// A bundle without a kind leaves the kind unchanged, since it cannot be unset.
+	kind, err := parseKind(desired.Kind)
+	if err != nil {
+		return nil, nil, err
+	}
+	if kind != featureproto.FeatureLifecycle_KIND_UNSPECIFIED && kind != target.GetLifecycle().GetKind() {
+		req.Kind = kind
+		fields = append(fields, "kind")
+	}
+	if desired.PlannedRemovalAt != target.GetLifecycle().GetPlannedRemovalAt() {
+		req.PlannedRemovalAt = wrapperspb.Int64(desired.PlannedRemovalAt)
+		fields = append(fields, "planned_removal_at")
+	}
+	ids, variationChanges, err := diffVariations(target, desired.Variations)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(variationChanges) > 0 {
+		req.VariationChanges = variationChanges
+		fields = append(fields, "variations")
+	}
+	off, err := ids.get(desired.OffVariation)
+	if err != nil {
+		return nil, nil, err
+	}
+	if off != target.OffVariation {
+		req.OffVariation = wrapperspb.String(off)
+		fields = append(fields, "off_variation")
+	}
+	values := newVariationValues(target)
+	current, err := exportStrategy(values, target.DefaultStrategy)
+	if err != nil {
+		return nil, nil, err
+	}
+	if desired.DefaultStrategy != nil && canonical(current) != canonical(desired.DefaultStrategy) {
+		if req.DefaultStrategy, err = importStrategy(ids, desired.DefaultStrategy); err != nil {
+			return nil, nil, err
+		}
+		fields = append(fields, "default_strategy")
+	}
+	ruleChanges, ruleOrder, err := r.diffRules(target, values, ids, desired.Rules)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(ruleChanges) > 0 || len(ruleOrder) > 0 {
+		req.RuleChanges = ruleChanges
+		req.OrderedRuleIds = ruleOrder
+		fields = append(fields, "rules")
+	}
+	targetChanges, err := diffTargets(target, ids, desired.Targets)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(targetChanges) > 0 {
+		req.TargetChanges = targetChanges
+		fields = append(fields, "targets")
+	}
+	prerequisiteChanges, err := r.diffPrerequisites(target, desired.Prerequisites)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(prerequisiteChanges) > 0 {
+		req.PrerequisiteChanges = prerequisiteChanges
+		fields = append(fields, "prerequisites")
+	}
+	if len(fields) == 0 {
+		return nil, nil, nil
+	}
+	return req, fields, nil
+}
+
+// diffVariations returns the value to ID mapping the feature will have after
+// the returned changes are applied.
+func diffVariations(
+	target *featureproto.Feature,
+	desired []*Variation,
+) (variationIDs, []*featureproto.VariationChange, error) {
+	ids := newVariationIDs(target)
+	current := make(map[string]*featureproto.Variation, len(target.Variations))
+	for _, v := range target.Variations {
+		current[v.Id] = v
+	}
+	wanted := make(map[string]struct{}, len(desired))
+	var changes []*featureproto.VariationChange
+	for _, v := range desired {
+		wanted[v.Value] = struct{}{}
+		id, ok := ids[v.Value]
+		if !ok {
+			newID, err := uuid.NewUUID()
+			if err != nil {
+				return nil, nil, err
+			}
+			id = newID.String()
+			ids[v.Value] = id
+			changes = append(changes, &featureproto.VariationChange{
+				ChangeType: featureproto.ChangeType_CREATE,
+				Variation: &featureproto.Variation{
+					Id:          id,
+					Value:       v.Value,
+					Name:        v.Name,
+					Description: v.Description,
+				},
+			})
+			continue
+		}
+		if cv := current[id]; cv.Name != v.Name || cv.Description != v.Description {
+			changes = append(changes, &featureproto.VariationChange{
+				ChangeType: featureproto.ChangeType_UPDATE,
+				Variation: &featureproto.Variation{
+					Id:          id,
+					Value:       v.Value,
+					Name:        v.Name,
+					Description: v.Description,
+				},
+			})
+		}
+	}
+	for _, v := range target.Variations {
+		if _, ok := wanted[v.Value]; ok {
+			continue
+		}
+		delete(ids, v.Value)
+		changes = append(changes, &featureproto.VariationChange{
+			ChangeType: featureproto.ChangeType_DELETE,
+			Variation:  &featureproto.Variation{Id: v.Id},
+		})
+	}
+	return ids, changes, nil
+}
+
+// diffRules matches rules by ID. New rules are appended by the update, so the
+// rule order is only returned when the result would differ from the bundle.
+func (r *Resolver) diffRules(
+	target *featureproto.Feature,
+	values variationValues,
+	ids variationIDs,
+	desired []*Rule,
+) ([]*featureproto.RuleChange, []string, error) {
+	current := make(map[string]*featureproto.Rule, len(target.Rules))
+	for _, rule := range target.Rules {
+		current[rule.Id] = rule
+	}
+	wanted := make(map[string]struct{}, len(desired))
+	var changes []*featureproto.RuleChange
+	for _, dr := range desired {
+		wanted[dr.ID] = struct{}{}
+		changeType := featureproto.ChangeType_CREATE
+		if cr, ok := current[dr.ID]; ok {
+			exported, err := r.exportRules(values, []*featureproto.Rule{cr}, true)
+			if err != nil {
+				return nil, nil, err
+			}
+			if canonical(stripClauseIDs(exported, false)) == canonical(stripClauseIDs([]*Rule{dr}, false)) {
+				continue
+			}
+			changeType = featureproto.ChangeType_UPDATE
+		}
+		rule, err := r.importRule(ids, dr, true)
+		if err != nil {
+			return nil, nil, err
+		}
+		changes = append(changes, &featureproto.RuleChange{
+			ChangeType: changeType,
+			Rule:       rule,
+		})
+	}
+	var result []string
+	for _, rule := range target.Rules {
+		if _, ok := wanted[rule.Id]; ok {
+			result = append(result, rule.Id)
+			continue
+		}
+		changes = append(changes, &featureproto.RuleChange{
+			ChangeType: featureproto.ChangeType_DELETE,
+			Rule:       &featureproto.Rule{Id: rule.Id},
+		})
+	}
+	order := make([]string, 0, len(desired))
+	for _, dr := range desired {
+		order = append(order, dr.ID)
+		if _, ok := current[dr.ID]; !ok {
+			result = append(result, dr.ID)
+		}
+	}
+	if slices.Equal(result, order) {
+		return changes, nil, nil
+	}
+	return changes, order, nil
+}
+
+func diffTargets(
+	target *featureproto.Feature,
+	ids variationIDs,
+	desired []*Target,
+) ([]*featureproto.TargetChange, error) {
+	current := make(map[string][]string, len(target.Targets))
+	for _, t := range target.Targets {
+		current[t.Variation] = t.Users
+	}
+	wanted := make(map[string][]string, len(desired))
+	var changes []*featureproto.TargetChange
+	for _, t := range desired {
+		id, err := ids.get(t.Variation)
+		if err != nil {
+			return nil, err
+		}
+		wanted[id] = t.Users
+		if added := difference(t.Users, current[id]); len(added) > 0 {
+			changes = append(changes, &featureproto.TargetChange{
+				ChangeType: featureproto.ChangeType_CREATE,
+				Target:     &featureproto.Target{Variation: id, Users: added},
+			})
+		}
+	}
+	for _, t := range target.Targets {
+		if removed := difference(t.Users, wanted[t.Variation]); len(removed) > 0 {
+			changes = append(changes, &featureproto.TargetChange{
+				ChangeType: featureproto.ChangeType_DELETE,
+				Target:     &featureproto.Target{Variation: t.Variation, Users: removed},
+			})
+		}
+	}
+	return changes, nil
+}
+
+func (r *Resolver) diffPrerequisites(
+	target *featureproto.Feature,
+	desired []*Prerequisite,
+) ([]*featureproto.PrerequisiteChange, error) {
+	current := make(map[string]string, len(target.Prerequisites))
+	for _, p := range target.Prerequisites {
+		current[p.FeatureId] = p.VariationId
+	}
+	wanted := make(map[string]struct{}, len(desired))
+	var changes []*featureproto.PrerequisiteChange
+	for _, p := range desired {
+		f, ok := r.features[p.FeatureID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownFeature, p.FeatureID)
+		}
+		id, err := newVariationIDs(f).get(p.Variation)
+		if err != nil {
+			return nil, err
+		}
+		wanted[p.FeatureID] = struct{}{}
+		changeType := featureproto.ChangeType_CREATE
+		if cur, ok := current[p.FeatureID]; ok {
+			if cur == id {
+				continue
+			}
+			changeType = featureproto.ChangeType_UPDATE
+		}
+		changes = append(changes, &featureproto.PrerequisiteChange{
+			ChangeType:   changeType,
+			Prerequisite: &featureproto.Prerequisite{FeatureId: p.FeatureID, VariationId: id},
+		})
+	}
+	for _, p := range target.Prerequisites {
+		if _, ok := wanted[p.FeatureId]; ok {
+			continue
+		}
+		changes = append(changes, &featureproto.PrerequisiteChange{
+			ChangeType:   featureproto.ChangeType_DELETE,
+			Prerequisite: &featureproto.Prerequisite{FeatureId: p.FeatureId},
+		})
+	}
+	return changes, nil
+}
+
+// SegmentUpdate returns the request that makes target match desired and the
+// names of the changed fields. The request is nil when nothing changed.
+func (r *Resolver) SegmentUpdate(
+	target *featureproto.Segment,
+	desired *Segment,
+) (*featureproto.UpdateSegmentRequest, []string, error) {
+	req := &featureproto.UpdateSegmentRequest{Id: target.Id}
+	var fields []string
+	if target.Description != desired.Description {
+		req.Description = wrapperspb.String(desired.Description)
+		fields = append(fields, "description")
+	}
+	current, err := r.exportRules(nil, target.Rules, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	if canonical(current) != canonical(stripClauseIDs(desired.Rules, true)) {
+		// Segment rule and clause IDs are generated by UpdateSegment.
+		rules := make([]*featureproto.Rule, 0, len(desired.Rules))
+		for _, dr := range stripClauseIDs(desired.Rules, true) {
+			rule, err := r.importRule(nil, dr, false)
+			if err != nil {
+				return nil, nil, err
+			}
+			rules = append(rules, rule)
+		}
+		req.Rules = &featureproto.RuleListValue{Values: rules}
+		fields = append(fields, "rules")
+	}
+	if len(fields) == 0 {
+		return nil, nil, nil
+	}
+	return req, fields, nil
+}
+
+func (r *Resolver) importRule(ids variationIDs, rule *Rule, generateIDs bool) (*featureproto.Rule, error) {
+	out := &featureproto.Rule{Id: rule.ID, Name: rule.Name, Description: rule.Description}
+	clauses, err := r.importClauses(rule.Clauses, generateIDs)
+	if err != nil {
+		return nil, err
+	}
+	out.Clauses = clauses
+	groups, err := r.importClauseGroups(rule.ClauseGroups, generateIDs)
+	if err != nil {
+		return nil, err
+	}
+	out.ClauseGroups = groups
+	if rule.Strategy != nil {
+		strategy, err := importStrategy(ids, rule.Strategy)
+		if err != nil {
+			return nil, err
+		}
+		out.Strategy = strategy
+	}
+	return out, nil
+}
+
+func (r *Resolver) importClauses(clauses []*Clause, generateIDs bool) ([]*featureproto.Clause, error) {
+	var out []*featureproto.Clause
+	for _, c := range clauses {
+		clause, err := r.importClause(c)
+		if err != nil {
+			return nil, err
+		}
+		if clause.Id == "" && generateIDs {
+			id, err := uuid.NewUUID()
+			if err != nil {
+				return nil, err
+			}
+			clause.Id = id.String()
+		}
+		out = append(out, clause)
+	}
+	return out, nil
+}
+
+func (r *Resolver) importClauseGroups(groups []*ClauseGroup, generateIDs bool) ([]*featureproto.ClauseGroup, error) {
+	var out []*featureproto.ClauseGroup
+	for _, g := range groups {
+		operator, err := parseEnum(featureproto.ClauseGroup_Operator_value, g.Operator)
+		if err != nil {
+			return nil, err
+		}
+		clauses, err := r.importClauses(g.Clauses, generateIDs)
+		if err != nil {
+			return nil, err
+		}
+		nested, err := r.importClauseGroups(g.Groups, generateIDs)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &featureproto.ClauseGroup{
+			Operator: featureproto.ClauseGroup_Operator(operator),
+			Clauses:  clauses,
+			Groups:   nested,
+		})
+	}
+	return out, nil
+}
+
+func (r *Resolver) importClause(c *Clause) (*featureproto.Clause, error) {
+	operator, err := parseEnum(featureproto.Clause_Operator_value, c.Operator)
+	if err != nil {
+		return nil, err
+	}
+	out := &featureproto.Clause{
+		Id:        c.ID,
+		Attribute: c.Attribute,
+		Operator:  featureproto.Clause_Operator(operator),
+		Values:    append([]string{}, c.Values...),
+	}
+	switch out.Operator {
+	case featureproto.Clause_SEGMENT:
+		for i, name := range c.Values {
+			id, ok := r.segmentIDs[name]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownSegment, name)
+			}
+			out.Values[i] = id
+		}
+	case featureproto.Clause_FEATURE_FLAG:
+		f, ok := r.features[c.Attribute]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownFeature, c.Attribute)
+		}
+		ids := newVariationIDs(f)
+		for i, value := range c.Values {
+			id, err := ids.get(value)
+			if err != nil {
+				return nil, err
+			}
+			out.Values[i] = id
+		}
+	}
+	return out, nil
+}
+
+func importStrategy(ids variationIDs, s *Strategy) (*featureproto.Strategy, error) {
+	strategyType, err := parseEnum(featureproto.Strategy_Type_value, s.Type)
+	if err != nil {
+		return nil, err
+	}
+	out := &featureproto.Strategy{Type: featureproto.Strategy_Type(strategyType)}
+	switch out.Type {
+	case featureproto.Strategy_FIXED:
+		id, err := ids.get(s.Variation)
+		if err != nil {
+			return nil, err
+		}
+		out.FixedStrategy = &featureproto.FixedStrategy{Variation: id}
+	case featureproto.Strategy_ROLLOUT:
+		fallback, err := ids.get(s.BucketByFallback)
+		if err != nil {
+			return nil, err
+		}
+		rollout := &featureproto.RolloutStrategy{
+			BucketBy:                  s.BucketBy,
+			BucketByFallbackVariation: fallback,
+		}
+		for _, v := range s.Rollout {
+			id, err := ids.get(v.Variation)
+			if err != nil {
+				return nil, err
+			}
+			rollout.Variations = append(rollout.Variations, &featureproto.RolloutStrategy_Variation{
+				Variation: id,
+				Weight:    v.Weight,
+			})
+		}
+		if s.Audience != nil {
+			id, err := ids.get(s.Audience.DefaultVariation)
+			if err != nil {
+				return nil, err
+			}
+			rollout.Audience = &featureproto.Audience{
+				Percentage:       s.Audience.Percentage,
+				DefaultVariation: id,
+				BucketBy:         s.Audience.BucketBy,
+			}
+		}
+		out.RolloutStrategy = rollout
+	}
+	return out, nil
+}
+
+// AutoOpsRuleChanges matches the active auto operation rules of target by
+// content, since they have no portable identity. It returns the rules to
+// create and the IDs of the rules to delete.
+func (r *Resolver) AutoOpsRuleChanges(
+	target *featureproto.Feature,
+	current []*autoopsproto.AutoOpsRule,
+	desired []*AutoOpsRule,
+) ([]*autoopsproto.CreateAutoOpsRuleRequest, []string, error) {
+	wanted := make(map[string]int, len(desired))
+	for _, d := range desired {
+		wanted[canonical(d)]++
+	}
+	var deletes []string
+	for _, rule := range current {
+		exported, err := r.ExportAutoOpsRules(target, []*autoopsproto.AutoOpsRule{rule})
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(exported) == 0 {
+			continue
+		}
+		if key := canonical(exported[0]); wanted[key] > 0 {
+			wanted[key]--
+			continue
+		}
+		deletes = append(deletes, rule.Id)
+	}
+	ids := newVariationIDs(target)
+	var creates []*autoopsproto.CreateAutoOpsRuleRequest
+	for _, d := range desired {
+		key := canonical(d)
+		if wanted[key] == 0 {
+			continue
+		}
+		wanted[key]--
+		req, err := importAutoOpsRule(ids, target.Id, d)
+		if err != nil {
+			return nil, nil, err
+		}
+		creates = append(creates, req)
+	}
+	return creates, deletes, nil
+}
+
+func importAutoOpsRule(
+	ids variationIDs,
+	featureID string,
+	rule *AutoOpsRule,
+) (*autoopsproto.CreateAutoOpsRuleRequest, error) {
+	opsType, err := parseEnum(autoopsproto.OpsType_value, rule.OpsType)
+	if err != nil {
+		return nil, err
+	}
+	req := &autoopsproto.CreateAutoOpsRuleRequest{
+		FeatureId: featureID,
+		OpsType:   autoopsproto.OpsType(opsType),
+	}
+	for _, c := range rule.EventRateClauses {
+		id, err := ids.get(c.Variation)
+		if err != nil {
+			return nil, err
+		}
+		operator, err := parseEnum(autoopsproto.OpsEventRateClause_Operator_value, c.Operator)
+		if err != nil {
+			return nil, err
+		}
+		actionType, err := parseEnum(autoopsproto.ActionType_value, c.ActionType)
+		if err != nil {
+			return nil, err
+		}
+		req.OpsEventRateClauses = append(req.OpsEventRateClauses, &autoopsproto.OpsEventRateClause{
+			VariationId:     id,
+			GoalId:          c.GoalID,
+			MinCount:        c.MinCount,
+			ThreadsholdRate: c.ThresholdRate,
+			Operator:        autoopsproto.OpsEventRateClause_Operator(operator),
+			ActionType:      autoopsproto.ActionType(actionType),
+		})
+	}
+	for _, c := range rule.DatetimeClauses {
+		actionType, err := parseEnum(autoopsproto.ActionType_value, c.ActionType)
+		if err != nil {
+			return nil, err
+		}
+		clause := &autoopsproto.DatetimeClause{
+			Time:       c.Time,
+			ActionType: autoopsproto.ActionType(actionType),
+		}
+		if rec := c.Recurrence; rec != nil {
+			frequency, err := parseEnum(autoopsproto.RecurrenceRule_Frequency_value, rec.Frequency)
+			if err != nil {
+				return nil, err
+			}
+			clause.Recurrence = &autoopsproto.RecurrenceRule{
+				Frequency:      autoopsproto.RecurrenceRule_Frequency(frequency),
+				DaysOfWeek:     rec.DaysOfWeek,
+				DayOfMonth:     rec.DayOfMonth,
+				StartDate:      rec.StartDate,
+				EndDate:        rec.EndDate,
+				MaxOccurrences: rec.MaxOccurrences,
+				Timezone:       rec.Timezone,
+			}
+		}
+		req.DatetimeClauses = append(req.DatetimeClauses, clause)
+	}
+	return req, nil
+}
+
+// ProgressiveRolloutChanges matches the waiting and running progressive
+// rollouts of target by content. It returns the rollouts to create and the
+// IDs of the rollouts to delete.
+func (r *Resolver) ProgressiveRolloutChanges(
+	target *featureproto.Feature,
+	current []*autoopsproto.ProgressiveRollout,
+	desired []*ProgressiveRollout,
+) ([]*autoopsproto.CreateProgressiveRolloutRequest, []string, error) {
+	wanted := make(map[string]int, len(desired))
+	for _, d := range desired {
+		wanted[canonical(d)]++
+	}
+	var deletes []string
+	for _, p := range current {
+		exported, err := r.ExportProgressiveRollouts(target, []*autoopsproto.ProgressiveRollout{p})
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(exported) == 0 {
+			continue
+		}
+		if key := canonical(exported[0]); wanted[key] > 0 {
+			wanted[key]--
+			continue
+		}
+		deletes = append(deletes, p.Id)
+	}
+	ids := newVariationIDs(target)
+	var creates []*autoopsproto.CreateProgressiveRolloutRequest
+	for _, d := range desired {
+		key := canonical(d)
+		if wanted[key] == 0 {
+			continue
+		}
+		wanted[key]--
+		req, err := importProgressiveRollout(ids, target.Id, d)
+		if err != nil {
+			return nil, nil, err
+		}
+		creates = append(creates, req)
+	}
+	return creates, deletes, nil
+}
+
+func importProgressiveRollout(
+	ids variationIDs,
+	featureID string,
+	p *ProgressiveRollout,
+) (*autoopsproto.CreateProgressiveRolloutRequest, error) {
+	rolloutType, err := parseEnum(autoopsproto.ProgressiveRollout_Type_value, p.Type)
+	if err != nil {
+		return nil, err
+	}
+	control, err := ids.get(p.ControlVariation)
+	if err != nil {
+		return nil, err
+	}
+	target, err := ids.get(p.TargetVariation)
+	if err != nil {
+		return nil, err
+	}
+	schedules := make([]*autoopsproto.ProgressiveRolloutSchedule, 0, len(p.Schedules))
+	for _, s := range p.Schedules {
+		schedules = append(schedules, &autoopsproto.ProgressiveRolloutSchedule{
+			ExecuteAt: s.ExecuteAt,
+			Weight:    s.Weight,
+		})
+	}
+	req := &autoopsproto.CreateProgressiveRolloutRequest{FeatureId: featureID}
+	for _, g := range p.GuardrailGoals {
+		req.GuardrailGoals = append(req.GuardrailGoals, &autoopsproto.GuardrailGoal{
+			GoalId:    g.GoalID,
+			Threshold: g.Threshold,
+		})
+	}
+	switch autoopsproto.ProgressiveRollout_Type(rolloutType) {
+	case autoopsproto.ProgressiveRollout_MANUAL_SCHEDULE:
+		req.ProgressiveRolloutManualScheduleClause = &autoopsproto.ProgressiveRolloutManualScheduleClause{
+			Schedules:          schedules,
+			ControlVariationId: control,
+			TargetVariationId:  target,
+		}
+	case autoopsproto.ProgressiveRollout_TEMPLATE_SCHEDULE:
+		interval, err := parseEnum(autoopsproto.ProgressiveRolloutTemplateScheduleClause_Interval_value, p.Interval)
+		if err != nil {
+			return nil, err
+		}
+		req.ProgressiveRolloutTemplateScheduleClause = &autoopsproto.ProgressiveRolloutTemplateScheduleClause{
+			Schedules:          schedules,
+			Interval:           autoopsproto.ProgressiveRolloutTemplateScheduleClause_Interval(interval),
+			Increments:         p.Increments,
+			ControlVariationId: control,
+			TargetVariationId:  target,
+		}
+	}
+	return req, nil
+}
+
+func parseKind(name string) (featureproto.FeatureLifecycle_Kind, error) {
+	if name == "" {
+		return featureproto.FeatureLifecycle_KIND_UNSPECIFIED, nil
+	}
+	kind, err := parseEnum(featureproto.FeatureLifecycle_Kind_value, name)
+	if err != nil {
+		return featureproto.FeatureLifecycle_KIND_UNSPECIFIED, err
+	}
+	return featureproto.FeatureLifecycle_Kind(kind), nil
+}
+
+func parseEnum(values map[string]int32, name string) (int32, error) {
+	v, ok := values[name]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownEnum, name)
+	}
+	return v, nil
+}
+
+// stripClauseIDs returns copies of rules without clause IDs, and without rule
+// IDs when ruleIDs is true, so they can be compared by content.
+func stripClauseIDs(rules []*Rule, ruleIDs bool) []*Rule {
+	var out []*Rule
+	for _, rule := range rules {
+		copied := &Rule{
+			ID:           rule.ID,
+			Name:         rule.Name,
+			Description:  rule.Description,
+			Clauses:      stripClauses(rule.Clauses),
+			ClauseGroups: stripGroupClauseIDs(rule.ClauseGroups),
+			Strategy:     rule.Strategy,
+		}
+		if ruleIDs {
+			copied.ID = ""
+		}
+		out = append(out, copied)
+	}
+	return out
+}
+
+func stripClauses(clauses []*Clause) []*Clause {
+	var out []*Clause
+	for _, c := range clauses {
+		out = append(out, &Clause{
+			Attribute: c.Attribute,
+			Operator:  c.Operator,
+			Values:    c.Values,
+		})
+	}
+	return out
+}
+
+func stripGroupClauseIDs(groups []*ClauseGroup) []*ClauseGroup {
+	var out []*ClauseGroup
+	for _, g := range groups {
+		out = append(out, &ClauseGroup{
+			Operator: g.Operator,
+			Clauses:  stripClauses(g.Clauses),
+			Groups:   stripGroupClauseIDs(g.Groups),
+		})
+	}
+	return out
+}
+
+func sameSet(a, b []string) bool {
+	x := append([]string{}, a...)
+	y := append([]string{}, b...)
+	sort.Strings(x)
+	sort.Strings(y)
+	return slices.Equal(x, y)
+}
+
+// difference returns the elements of a that are not in b.
+func difference(a, b []string) []string {
+	var out []string
+	for _, v := range a {
+		if !slices.Contains(b, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,434 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+// newTestState returns the same flag definitions with environment-specific
+// IDs prefixed by env, as two environments that were set up separately would have.
+func newTestState(t *testing.T, env string) *State {
+	t.Helper()
+	featureA := &featureproto.Feature{
+		Id:   "feature-a",
+		Name: "Feature A",
+		Variations: []*featureproto.Variation{
+			{Id: env + "-a-1", Value: "a", Name: "A"},
+			{Id: env + "-a-2", Value: "b", Name: "B"},
+		},
+		Tags:         []string{"web"},
+		OffVariation: env + "-a-2",
+		DefaultStrategy: &featureproto.Strategy{
+			Type:          featureproto.Strategy_FIXED,
+			FixedStrategy: &featureproto.FixedStrategy{Variation: env + "-a-1"},
+		},
+		Targets: []*featureproto.Target{
+			{Variation: env + "-a-1"},
+			{Variation: env + "-a-2"},
+		},
+	}
+	featureB := &featureproto.Feature{
+		Id:            "feature-b",
+		Name:          "Feature B",
+		Enabled:       true,
+		VariationType: featureproto.Feature_BOOLEAN,
+		Variations: []*featureproto.Variation{
+			{Id: env + "-b-1", Value: "true", Name: "on"},
+			{Id: env + "-b-2", Value: "false", Name: "off"},
+		},
+		Tags:         []string{"web"},
+		OffVariation: env + "-b-2",
+		DefaultStrategy: &featureproto.Strategy{
+			Type: featureproto.Strategy_ROLLOUT,
+			RolloutStrategy: &featureproto.RolloutStrategy{
+				Variations: []*featureproto.RolloutStrategy_Variation{
+					{Variation: env + "-b-1", Weight: 60000},
+					{Variation: env + "-b-2", Weight: 40000},
+				},
+				BucketBy:                  "company",
+				BucketByFallbackVariation: env + "-b-2",
+			},
+		},
+		Rules: []*featureproto.Rule{
+			{
+				Id: "rule-1",
+				Clauses: []*featureproto.Clause{
+					{Id: env + "-clause-1", Operator: featureproto.Clause_SEGMENT, Values: []string{env + "-segment"}},
+				},
+				Strategy: &featureproto.Strategy{
+					Type:          featureproto.Strategy_FIXED,
+					FixedStrategy: &featureproto.FixedStrategy{Variation: env + "-b-1"},
+				},
+			},
+			{
+				Id:   "rule-2",
+				Name: "Feature A users outside beta",
+				Clauses: []*featureproto.Clause{
+					{
+						Id:        env + "-clause-2",
+						Attribute: "feature-a",
+						Operator:  featureproto.Clause_FEATURE_FLAG,
+						Values:    []string{env + "-a-1"},
+					},
+				},
+				ClauseGroups: []*featureproto.ClauseGroup{
+					{
+						Operator: featureproto.ClauseGroup_NOT,
+						Clauses: []*featureproto.Clause{
+							{Id: env + "-clause-3", Operator: featureproto.Clause_SEGMENT, Values: []string{env + "-segment"}},
+						},
+					},
+				},
+				Strategy: &featureproto.Strategy{
+					Type:          featureproto.Strategy_FIXED,
+					FixedStrategy: &featureproto.FixedStrategy{Variation: env + "-b-2"},
+				},
+			},
+		},
+		Targets: []*featureproto.Target{
+			{Variation: env + "-b-1", Users: []string{"user-1", "user-2"}},
+			{Variation: env + "-b-2"},
+		},
+		Prerequisites: []*featureproto.Prerequisite{
+			{FeatureId: "feature-a", VariationId: env + "-a-2"},
+		},
+	}
+	clause, err := anypb.New(&autoopsproto.OpsEventRateClause{
+		VariationId:     env + "-b-1",
+		GoalId:          "goal",
+		MinCount:        10,
+		ThreadsholdRate: 0.5,
+		ActionType:      autoopsproto.ActionType_DISABLE,
+	})
+	require.NoError(t, err)
+	return &State{
+		Features: []*featureproto.Feature{featureA, featureB},
+		Segments: []*featureproto.Segment{{Id: env + "-segment", Name: "beta"}},
+		Tags:     []string{"web"},
+		AutoOpsRules: []*autoopsproto.AutoOpsRule{
+			{
+				Id:        env + "-ops",
+				FeatureId: "feature-b",
+				OpsType:   autoopsproto.OpsType_EVENT_RATE,
+				Clauses:   []*autoopsproto.Clause{{Id: env + "-ops-clause", Clause: clause}},
+			},
+			{
+				Id:            env + "-finished",
+				FeatureId:     "feature-b",
+				OpsType:       autoopsproto.OpsType_EVENT_RATE,
+				AutoOpsStatus: autoopsproto.AutoOpsStatus_FINISHED,
+			},
+		},
+	}
+}
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+	b, err := Export(newTestState(t, "src"))
+	require.NoError(t, err)
+	require.Len(t, b.Features, 2)
+	f := b.Feature("feature-b")
+	require.NotNil(t, f)
+	assert.Equal(t, "BOOLEAN", f.VariationType)
+	assert.Equal(t, "false", f.OffVariation)
+	assert.Equal(t, []*WeightedVariation{
+		{Variation: "true", Weight: 60000},
+		{Variation: "false", Weight: 40000},
+	}, f.DefaultStrategy.Rollout)
+	assert.Equal(t, "company", f.DefaultStrategy.BucketBy)
+	assert.Equal(t, "false", f.DefaultStrategy.BucketByFallback)
+	assert.Equal(t, []string{"beta"}, f.Rules[0].Clauses[0].Values)
+	assert.Equal(t, []string{"a"}, f.Rules[1].Clauses[0].Values)
+	assert.Equal(t, "Feature A users outside beta", f.Rules[1].Name)
+	require.Len(t, f.Rules[1].ClauseGroups, 1)
+	assert.Equal(t, "NOT", f.Rules[1].ClauseGroups[0].Operator)
+	assert.Equal(t, []string{"beta"}, f.Rules[1].ClauseGroups[0].Clauses[0].Values)
+	assert.Equal(t, []*Target{{Variation: "true", Users: []string{"user-1", "user-2"}}}, f.Targets)
+	assert.Equal(t, []*Prerequisite{{FeatureID: "feature-a", Variation: "b"}}, f.Prerequisites)
+	// Finished auto operations are not exported.
+	require.Len(t, f.AutoOpsRules, 1)
+	assert.Equal(t, "true", f.AutoOpsRules[0].EventRateClauses[0].Variation)
+}
+
+func TestExportUnknownSegment(t *testing.T) {
+	t.Parallel()
+	s := newTestState(t, "src")
+	s.Segments = nil
+	_, err := Export(s)
+	assert.ErrorIs(t, err, ErrUnknownSegment)
+}
+
+func TestImportUnchangedAcrossEnvironments(t *testing.T) {
+	t.Parallel()
+	b, err := Export(newTestState(t, "src"))
+	require.NoError(t, err)
+	dst := newTestState(t, "dst")
+	r := NewResolver(dst.Segments, dst.Features)
+	for _, f := range dst.Features {
+		req, fields, err := r.FeatureUpdate(f, b.Feature(f.Id))
+		require.NoError(t, err)
+		assert.Nil(t, req, f.Id)
+		assert.Empty(t, fields, f.Id)
+		creates, deletes, err := r.AutoOpsRuleChanges(f, dst.AutoOpsRules, b.Feature(f.Id).AutoOpsRules)
+		require.NoError(t, err)
+		assert.Empty(t, creates)
+		assert.Empty(t, deletes)
+	}
+}
+
+func TestFeatureUpdate(t *testing.T) {
+	t.Parallel()
+	b, err := Export(newTestState(t, "src"))
+	require.NoError(t, err)
+	desired := b.Feature("feature-b")
+	desired.Name = "Renamed"
+	desired.Variations[1].Name = "disabled"
+	desired.Variations = append(desired.Variations, &Variation{Value: "maybe", Name: "maybe"})
+	desired.OffVariation = "maybe"
+	desired.Rules = []*Rule{desired.Rules[1], desired.Rules[0]}
+	desired.Rules[1].Strategy.Variation = "maybe"
+	desired.Targets = []*Target{{Variation: "true", Users: []string{"user-1", "user-3"}}}
+	desired.Prerequisites = nil
+
+	dst := newTestState(t, "dst")
+	r := NewResolver(dst.Segments, dst.Features)
+	req, fields, err := r.FeatureUpdate(dst.Features[1], desired)
+	require.NoError(t, err)
+	require.NotNil(t, req)
+	assert.Equal(t, []string{"name", "variations", "off_variation", "rules", "targets", "prerequisites"}, fields)
+	assert.Equal(t, "Renamed", req.Name.Value)
+
+	require.Len(t, req.VariationChanges, 2)
+	assert.Equal(t, featureproto.ChangeType_UPDATE, req.VariationChanges[0].ChangeType)
+	assert.Equal(t, "dst-b-2", req.VariationChanges[0].Variation.Id)
+	assert.Equal(t, featureproto.ChangeType_CREATE, req.VariationChanges[1].ChangeType)
+	newID := req.VariationChanges[1].Variation.Id
+	assert.NotEmpty(t, newID)
+	assert.Equal(t, newID, req.OffVariation.Value)
+
+	require.Len(t, req.RuleChanges, 1)
+	assert.Equal(t, featureproto.ChangeType_UPDATE, req.RuleChanges[0].ChangeType)
+	assert.Equal(t, "rule-1", req.RuleChanges[0].Rule.Id)
+	assert.Equal(t, newID, req.RuleChanges[0].Rule.Strategy.FixedStrategy.Variation)
+	assert.Equal(t, []string{"dst-segment"}, req.RuleChanges[0].Rule.Clauses[0].Values)
+	assert.Equal(t, []string{"rule-2", "rule-1"}, req.OrderedRuleIds)
+
+	assert.Equal(t, []*featureproto.TargetChange{
+		{
+			ChangeType: featureproto.ChangeType_CREATE,
+			Target:     &featureproto.Target{Variation: "dst-b-1", Users: []string{"user-3"}},
+		},
+		{
+			ChangeType: featureproto.ChangeType_DELETE,
+			Target:     &featureproto.Target{Variation: "dst-b-1", Users: []string{"user-2"}},
+		},
+	}, req.TargetChanges)
+	assert.Equal(t, []*featureproto.PrerequisiteChange{
+		{
+			ChangeType:   featureproto.ChangeType_DELETE,
+			Prerequisite: &featureproto.Prerequisite{FeatureId: "feature-a"},
+		},
+	}, req.PrerequisiteChanges)
+}
+
+func TestFeatureUpdateClauseGroups(t *testing.T) {
+	t.Parallel()
+	b, err := Export(newTestState(t, "src"))
+	require.NoError(t, err)
+	desired := b.Feature("feature-b")
+	desired.Rules[1].ClauseGroups[0].Operator = "OR"
+
+	dst := newTestState(t, "dst")
+	r := NewResolver(dst.Segments, dst.Features)
+	req, fields, err := r.FeatureUpdate(dst.Features[1], desired)
+	require.NoError(t, err)
+	require.NotNil(t, req)
+	assert.Equal(t, []string{"rules"}, fields)
+	require.Len(t, req.RuleChanges, 1)
+	rule := req.RuleChanges[0].Rule
+	assert.Equal(t, "rule-2", rule.Id)
+	assert.Equal(t, "Feature A users outside beta", rule.Name)
+	require.Len(t, rule.ClauseGroups, 1)
+	assert.Equal(t, featureproto.ClauseGroup_OR, rule.ClauseGroups[0].Operator)
+	assert.Equal(t, []string{"dst-segment"}, rule.ClauseGroups[0].Clauses[0].Values)
+	assert.NotEmpty(t, rule.ClauseGroups[0].Clauses[0].Id)
+
+	desired.Rules[1].ClauseGroups[0].Operator = "XOR"
+	_, _, err = r.FeatureUpdate(dst.Features[1], desired)
+	assert.Error(t, err)
+}
+
+func TestFeatureUpdateUnknownReference(t *testing.T) {
+	t.Parallel()
+	b, err := Export(newTestState(t, "src"))
+	require.NoError(t, err)
+	dst := newTestState(t, "dst")
+
+	desired := b.Feature("feature-b")
+	desired.OffVariation = "unknown"
+	r := NewResolver(dst.Segments, dst.Features)
+	_, _, err = r.FeatureUpdate(dst.Features[1], desired)
+	assert.ErrorIs(t, err, ErrUnknownVariation)
+
+	desired.OffVariation = "false"
+	r = NewResolver(nil, dst.Features)
+	_, _, err = r.FeatureUpdate(dst.Features[1], desired)
+	assert.ErrorIs(t, err, ErrUnknownSegment)
+}
+
+func TestFeatureUpdateLifecycle(t *testing.T) {
+	t.Parallel()
+	src := newTestState(t, "src")
+	src.Features[1].Lifecycle = &featureproto.FeatureLifecycle{
+		Kind:             featureproto.FeatureLifecycle_RELEASE,
+		PlannedRemovalAt: 2000000000,
+	}
+	b, err := Export(src)
+	require.NoError(t, err)
+	desired := b.Feature("feature-b")
+	assert.Equal(t, "RELEASE", desired.Kind)
+	assert.Equal(t, int64(2000000000), desired.PlannedRemovalAt)
+
+	dst := newTestState(t, "dst")
+	r := NewResolver(dst.Segments, dst.Features)
+	req, fields, err := r.FeatureUpdate(dst.Features[1], desired)
+	require.NoError(t, err)
+	require.NotNil(t, req)
+	assert.Equal(t, []string{"kind", "planned_removal_at"}, fields)
+	assert.Equal(t, featureproto.FeatureLifecycle_RELEASE, req.Kind)
+	assert.Equal(t, int64(2000000000), req.PlannedRemovalAt.Value)
+
+	create, err := NewCreateFeatureRequest(desired)
+	require.NoError(t, err)
+	assert.Equal(t, featureproto.FeatureLifecycle_RELEASE, create.Kind)
+	assert.Equal(t, int64(2000000000), create.PlannedRemovalAt)
+
+	desired.Kind = "UNKNOWN"
+	_, _, err = r.FeatureUpdate(dst.Features[1], desired)
+	assert.ErrorIs(t, err, ErrUnknownEnum)
+}
+
+func TestNewCreateFeatureRequest(t *testing.T) {
+	t.Parallel()
+	b, err := Export(newTestState(t, "src"))
+	require.NoError(t, err)
+	req, err := NewCreateFeatureRequest(b.Feature("feature-a"))
+	require.NoError(t, err)
+	assert.Equal(t, "feature-a", req.Id)
+	assert.Len(t, req.Variations, 2)
+	assert.Equal(t, int32(0), req.DefaultOnVariationIndex.Value)
+	assert.Equal(t, int32(1), req.DefaultOffVariationIndex.Value)
+}
+
+func TestAutoOpsRuleChanges(t *testing.T) {
+	t.Parallel()
+	dst := newTestState(t, "dst")
+	r := NewResolver(dst.Segments, dst.Features)
+	desired := []*AutoOpsRule{
+		{
+			OpsType:         "SCHEDULE",
+			DatetimeClauses: []*DatetimeClause{{Time: 1700000000, ActionType: "ENABLE"}},
+		},
+	}
+	creates, deletes, err := r.AutoOpsRuleChanges(dst.Features[1], dst.AutoOpsRules, desired)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dst-ops"}, deletes)
+	require.Len(t, creates, 1)
+	assert.Equal(t, "feature-b", creates[0].FeatureId)
+	assert.Equal(t, autoopsproto.OpsType_SCHEDULE, creates[0].OpsType)
+	assert.Equal(t, autoopsproto.ActionType_ENABLE, creates[0].DatetimeClauses[0].ActionType)
+}
+
+func TestDiffToken(t *testing.T) {
+	t.Parallel()
+	a := &featureproto.Feature{Id: "a", Version: 1}
+	b := &featureproto.Feature{Id: "b", Version: 2}
+	seg := &featureproto.Segment{Id: "s", Name: "Segment", IncludedUserCount: 1}
+	state := func(mutate func(*State)) *State {
+		s := &State{
+			Features:            []*featureproto.Feature{a, b},
+			Segments:            []*featureproto.Segment{seg},
+			Tags:                []string{"web"},
+			AutoOpsRules:        []*autoopsproto.AutoOpsRule{{Id: "r", UpdatedAt: 1}},
+			ProgressiveRollouts: []*autoopsproto.ProgressiveRollout{{Id: "p", UpdatedAt: 1}},
+		}
+		if mutate != nil {
+			mutate(s)
+		}
+		return s
+	}
+	token, err := DiffToken(state(nil))
+	require.NoError(t, err)
+
+	patterns := []struct {
+		desc    string
+		mutate  func(*State)
+		changed bool
+	}{
+		{
+			desc:   "features in another order",
+			mutate: func(s *State) { s.Features = []*featureproto.Feature{b, a} },
+		},
+		{
+			desc: "segment user count",
+			mutate: func(s *State) {
+				s.Segments = []*featureproto.Segment{{Id: "s", Name: "Segment", IncludedUserCount: 2}}
+			},
+		},
+		{
+			desc:    "feature version",
+			mutate:  func(s *State) { s.Features = []*featureproto.Feature{a, {Id: "b", Version: 3}} },
+			changed: true,
+		},
+		{
+			desc: "segment rules",
+			mutate: func(s *State) {
+				s.Segments = []*featureproto.Segment{{Id: "s", Name: "Segment", Rules: []*featureproto.Rule{{Id: "rule"}}}}
+			},
+			changed: true,
+		},
+		{
+			desc:    "tags",
+			mutate:  func(s *State) { s.Tags = nil },
+			changed: true,
+		},
+		{
+			desc:    "auto ops rule",
+			mutate:  func(s *State) { s.AutoOpsRules[0].UpdatedAt = 2 },
+			changed: true,
+		},
+		{
+			desc:    "progressive rollout",
+			mutate:  func(s *State) { s.ProgressiveRollouts = nil },
+			changed: true,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			actual, err := DiffToken(state(p.mutate))
+			require.NoError(t, err)
+			assert.Equal(t, p.changed, actual != token)
+		})
+	}
+}
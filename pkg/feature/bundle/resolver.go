@@ -0,0 +1,112 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"fmt"
+
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+// Resolver translates between the portable references of a bundle and the
+// IDs of an environment.
+type Resolver struct {
+	segmentIDs   map[string]string
+	segmentNames map[string]string
+	features     map[string]*featureproto.Feature
+}
+
+func NewResolver(segments []*featureproto.Segment, features []*featureproto.Feature) *Resolver {
+	r := &Resolver{
+		segmentIDs:   make(map[string]string, len(segments)),
+		segmentNames: make(map[string]string, len(segments)),
+		features:     make(map[string]*featureproto.Feature, len(features)),
+	}
+	for _, s := range segments {
+		r.SetSegment(s)
+	}
+	for _, f := range features {
+		r.SetFeature(f)
+	}
+	return r
+}
+
+// SetSegment registers a segment, e.g. one created during an import.
+func (r *Resolver) SetSegment(s *featureproto.Segment) {
+	r.segmentIDs[s.Name] = s.Id
+	r.segmentNames[s.Id] = s.Name
+}
+
+// SetFeature registers or replaces a feature, e.g. after it was created or updated.
+func (r *Resolver) SetFeature(f *featureproto.Feature) {
+	r.features[f.Id] = f
+}
+
+// Feature returns the registered feature with the given ID, or nil.
+func (r *Resolver) Feature(id string) *featureproto.Feature {
+	return r.features[id]
+}
+
+// Segment returns the ID of the segment with the given name.
+func (r *Resolver) Segment(name string) (string, bool) {
+	id, ok := r.segmentIDs[name]
+	return id, ok
+}
+
+// variationValues maps variation IDs to values.
+type variationValues map[string]string
+
+func newVariationValues(f *featureproto.Feature) variationValues {
+	values := make(variationValues, len(f.Variations))
+	for _, v := range f.Variations {
+		values[v.Id] = v.Value
+	}
+	return values
+}
+
+// get returns the value of the variation. An empty ID stays empty because
+// optional references such as an audience default variation may be unset.
+func (v variationValues) get(id string) (string, error) {
+	if id == "" {
+		return "", nil
+	}
+	value, ok := v[id]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownVariation, id)
+	}
+	return value, nil
+}
+
+// variationIDs maps variation values to IDs.
+type variationIDs map[string]string
+
+func newVariationIDs(f *featureproto.Feature) variationIDs {
+	ids := make(variationIDs, len(f.Variations))
+	for _, v := range f.Variations {
+		ids[v.Value] = v.Id
+	}
+	return ids
+}
+
+func (v variationIDs) get(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	id, ok := v[value]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownVariation, value)
+	}
+	return id, nil
+}
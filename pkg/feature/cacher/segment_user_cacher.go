@@ -37,10 +37,29 @@ type SegmentUserCacher interface {
 	RefreshAllEnvironmentCaches(ctx context.Context) error
 }
 
+const (
+	// segmentUserSettleTime covers the users written in the same second as the segment update
+	// and the clock skew between the database and this service.
+	segmentUserSettleTime = time.Minute
+	// segmentUserFullRefreshInterval rewrites the unchanged segments too,
+	// so the cache recovers if Redis loses the keys.
+	segmentUserFullRefreshInterval = time.Hour
+)
+
 type segmentUserCacher struct {
 	segStorage ftstorage.SegmentStorage
 	caches     []cachev3.SegmentUsersCache
 	logger     *zap.Logger
+
+	mu sync.Mutex
+	// versions holds the segment version last written to all the caches, keyed by environment and segment ID.
+	versions map[string]segmentUserVersion
+}
+
+type segmentUserVersion struct {
+	updatedAt int64
+	// readAt is when the users were read from the database.
+	readAt time.Time
 }
 
 // NewSegmentUserCacher creates a new SegmentUserCacher.
@@ -57,6 +76,7 @@ func NewSegmentUserCacher(
 		segStorage: segStorage,
 		caches:     caches,
 		logger:     logger.Named("segment-user-cacher"),
+		versions:   make(map[string]segmentUserVersion),
 	}
 }
 
@@ -76,8 +96,16 @@ func (c *segmentUserCacher) RefreshAllEnvironmentCaches(ctx context.Context) err
 	// Then, for each segment, fetch its users and cache them
 	// This avoids loading all users in a single query which could be problematic
 	// for large datasets (200k+ users)
+	inUse := make(map[string]struct{}, len(inUseSegments))
 	for _, seg := range inUseSegments {
+		key := segmentUserVersionKey(seg.EnvironmentID, seg.SegmentID)
+		inUse[key] = struct{}{}
 		segStartTime := time.Now()
+		// The gateways build the membership index once per segment version,
+		// so rewriting an unchanged segment only costs the database read and the Redis write.
+		if c.isCached(key, seg.UpdatedAt, segStartTime) {
+			continue
+		}
 		users, err := c.segStorage.ListSegmentUsersBySegment(ctx, seg.SegmentID, seg.EnvironmentID)
 		if err != nil {
 			c.logger.Error("Failed to list segment users",
@@ -103,14 +131,55 @@ func (c *segmentUserCacher) RefreshAllEnvironmentCaches(ctx context.Context) err
 			Rules:     seg.Rules,
 			UpdatedAt: seg.UpdatedAt,
 		}
-		c.putCache(segUsers, seg.EnvironmentID, len(users))
+		if c.putCache(segUsers, seg.EnvironmentID, len(users)) {
+			c.setVersion(key, segmentUserVersion{updatedAt: seg.UpdatedAt, readAt: segStartTime})
+		}
 	}
+	c.deleteUnusedVersions(inUse)
 
 	return nil
 }
 
+func segmentUserVersionKey(environmentID, segmentID string) string {
+	return environmentID + ":" + segmentID
+}
+
+// isCached reports whether the segment version is already in all the caches.
+// A segment updated shortly before the users were read is written again,
+// because the read may have missed the users saved with that update.
+func (c *segmentUserCacher) isCached(key string, updatedAt int64, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.versions[key]
+	if !ok || v.updatedAt != updatedAt {
+		return false
+	}
+	if v.readAt.Before(time.Unix(updatedAt, 0).Add(segmentUserSettleTime)) {
+		return false
+	}
+	return now.Sub(v.readAt) < segmentUserFullRefreshInterval
+}
+
+func (c *segmentUserCacher) setVersion(key string, v segmentUserVersion) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.versions[key] = v
+}
+
+// deleteUnusedVersions forgets the segments no longer referenced by any flag.
+func (c *segmentUserCacher) deleteUnusedVersions(inUse map[string]struct{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.versions {
+		if _, ok := inUse[key]; !ok {
+			delete(c.versions, key)
+		}
+	}
+}
+
 // putCache saves segment users to all Redis instances and records metrics.
-func (c *segmentUserCacher) putCache(segmentUsers *ftproto.SegmentUsers, environmentID string, userCount int) {
+// It reports whether all the instances were updated.
+func (c *segmentUserCacher) putCache(segmentUsers *ftproto.SegmentUsers, environmentID string, userCount int) bool {
 	var wg sync.WaitGroup
 	var hasError bool
 	var mu sync.Mutex
@@ -136,8 +205,9 @@ func (c *segmentUserCacher) putCache(segmentUsers *ftproto.SegmentUsers, environ
 	// Record metrics based on overall success/failure
 	if hasError {
 		recordCachePut(cacherTypeSegmentUser, environmentID, codeFail)
-	} else {
-		recordCachePut(cacherTypeSegmentUser, environmentID, codeSuccess)
-		recordFeaturesUpdated(cacherTypeSegmentUser, environmentID, userCount)
+		return false
 	}
+	recordCachePut(cacherTypeSegmentUser, environmentID, codeSuccess)
+	recordFeaturesUpdated(cacherTypeSegmentUser, environmentID, userCount)
+	return true
 }
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -142,6 +143,122 @@ func TestSegmentUserCacher_RefreshAllEnvironmentCaches(t *testing.T) {
 	}
 }
 
+func TestSegmentUserCacher_RefreshAllEnvironmentCachesVersions(t *testing.T) {
+	t.Parallel()
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	updatedAt := time.Now().Add(-24 * time.Hour).Unix()
+	key := segmentUserVersionKey("env-id-1", "seg-id-1")
+	expectRefresh := func(c *segmentUserCacher) {
+		c.segStorage.(*mockftstorage.MockSegmentStorage).EXPECT().
+			ListSegmentUsersBySegment(gomock.Any(), "seg-id-1", "env-id-1").
+			Return([]*ftproto.SegmentUser{
+				{Id: "user-id-1", SegmentId: "seg-id-1", UserId: "user-1"},
+			}, nil)
+		c.caches[0].(*mockcachev3.MockSegmentUsersCache).EXPECT().
+			Put(gomock.Any(), "env-id-1").
+			Return(nil)
+	}
+
+	patterns := []struct {
+		desc             string
+		versions         map[string]segmentUserVersion
+		setup            func(*segmentUserCacher)
+		expectedVersions map[string]int64
+	}{
+		{
+			desc:     "success: new segment is cached",
+			versions: map[string]segmentUserVersion{},
+			setup: func(c *segmentUserCacher) {
+				expectRefresh(c)
+			},
+			expectedVersions: map[string]int64{key: updatedAt},
+		},
+		{
+			desc: "success: unchanged segment is skipped",
+			versions: map[string]segmentUserVersion{
+				key: {updatedAt: updatedAt, readAt: time.Now().Add(-time.Minute)},
+			},
+			setup:            func(c *segmentUserCacher) {},
+			expectedVersions: map[string]int64{key: updatedAt},
+		},
+		{
+			desc: "success: updated segment is cached again",
+			versions: map[string]segmentUserVersion{
+				key: {updatedAt: updatedAt - 1, readAt: time.Now().Add(-time.Minute)},
+			},
+			setup: func(c *segmentUserCacher) {
+				expectRefresh(c)
+			},
+			expectedVersions: map[string]int64{key: updatedAt},
+		},
+		{
+			desc: "success: segment read right after its update is cached again",
+			versions: map[string]segmentUserVersion{
+				key: {updatedAt: updatedAt, readAt: time.Unix(updatedAt, 0)},
+			},
+			setup: func(c *segmentUserCacher) {
+				expectRefresh(c)
+			},
+			expectedVersions: map[string]int64{key: updatedAt},
+		},
+		{
+			desc: "success: unchanged segment is cached again after the full refresh interval",
+			versions: map[string]segmentUserVersion{
+				key: {updatedAt: updatedAt, readAt: time.Now().Add(-segmentUserFullRefreshInterval)},
+			},
+			setup: func(c *segmentUserCacher) {
+				expectRefresh(c)
+			},
+			expectedVersions: map[string]int64{key: updatedAt},
+		},
+		{
+			desc:     "success: version isn't saved when the cache put fails",
+			versions: map[string]segmentUserVersion{},
+			setup: func(c *segmentUserCacher) {
+				c.segStorage.(*mockftstorage.MockSegmentStorage).EXPECT().
+					ListSegmentUsersBySegment(gomock.Any(), "seg-id-1", "env-id-1").
+					Return([]*ftproto.SegmentUser{}, nil)
+				c.caches[0].(*mockcachev3.MockSegmentUsersCache).EXPECT().
+					Put(gomock.Any(), "env-id-1").
+					Return(errors.New("cache error"))
+			},
+			expectedVersions: map[string]int64{},
+		},
+		{
+			desc: "success: segment no longer in use is forgotten",
+			versions: map[string]segmentUserVersion{
+				segmentUserVersionKey("env-id-1", "seg-id-2"): {updatedAt: updatedAt, readAt: time.Now()},
+			},
+			setup: func(c *segmentUserCacher) {
+				expectRefresh(c)
+			},
+			expectedVersions: map[string]int64{key: updatedAt},
+		},
+	}
+
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			cacher := newSegmentUserCacherWithMock(t, controller, 1)
+			cacher.versions = p.versions
+			cacher.segStorage.(*mockftstorage.MockSegmentStorage).EXPECT().
+				ListAllInUseSegments(gomock.Any()).
+				Return([]*ftstorage.InUseSegment{
+					{SegmentID: "seg-id-1", EnvironmentID: "env-id-1", UpdatedAt: updatedAt},
+				}, nil)
+			p.setup(cacher)
+			err := cacher.RefreshAllEnvironmentCaches(context.Background())
+			require.NoError(t, err)
+			versions := make(map[string]int64, len(cacher.versions))
+			for k, v := range cacher.versions {
+				versions[k] = v.updatedAt
+			}
+			assert.Equal(t, p.expectedVersions, versions)
+		})
+	}
+}
+
 func TestSegmentUserCacher_PutCache(t *testing.T) {
 	t.Parallel()
 	controller := gomock.NewController(t)
@@ -250,5 +367,6 @@ func newSegmentUserCacherWithMock(t *testing.T, controller *gomock.Controller, n
 		segStorage: mockftstorage.NewMockSegmentStorage(controller),
 		caches:     caches,
 		logger:     logger,
+		versions:   make(map[string]segmentUserVersion),
 	}
 }
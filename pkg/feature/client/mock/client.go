@@ -42,6 +42,46 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// ApplyChangeRequest mocks base method.
+func (m *MockClient) ApplyChangeRequest(ctx context.Context, in *feature.ApplyChangeRequestRequest, opts ...grpc.CallOption) (*feature.ApplyChangeRequestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApplyChangeRequest", varargs...)
+	ret0, _ := ret[0].(*feature.ApplyChangeRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyChangeRequest indicates an expected call of ApplyChangeRequest.
+func (mr *MockClientMockRecorder) ApplyChangeRequest(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyChangeRequest", reflect.TypeOf((*MockClient)(nil).ApplyChangeRequest), varargs...)
+}
+
+// ApproveChangeRequest mocks base method.
+func (m *MockClient) ApproveChangeRequest(ctx context.Context, in *feature.ApproveChangeRequestRequest, opts ...grpc.CallOption) (*feature.ApproveChangeRequestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApproveChangeRequest", varargs...)
+	ret0, _ := ret[0].(*feature.ApproveChangeRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveChangeRequest indicates an expected call of ApproveChangeRequest.
+func (mr *MockClientMockRecorder) ApproveChangeRequest(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveChangeRequest", reflect.TypeOf((*MockClient)(nil).ApproveChangeRequest), varargs...)
+}
+
 // BulkCloneFeature mocks base method.
 func (m *MockClient) BulkCloneFeature(ctx context.Context, in *feature.BulkCloneFeatureRequest, opts ...grpc.CallOption) (*feature.BulkCloneFeatureResponse, error) {
 	m.ctrl.T.Helper()
@@ -134,6 +174,26 @@ func (mr *MockClientMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockClient)(nil).Close))
 }
 
+// CreateChangeRequest mocks base method.
+func (m *MockClient) CreateChangeRequest(ctx context.Context, in *feature.CreateChangeRequestRequest, opts ...grpc.CallOption) (*feature.CreateChangeRequestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateChangeRequest", varargs...)
+	ret0, _ := ret[0].(*feature.CreateChangeRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChangeRequest indicates an expected call of CreateChangeRequest.
+func (mr *MockClientMockRecorder) CreateChangeRequest(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChangeRequest", reflect.TypeOf((*MockClient)(nil).CreateChangeRequest), varargs...)
+}
+
 // CreateFeature mocks base method.
 func (m *MockClient) CreateFeature(ctx context.Context, in *feature.CreateFeatureRequest, opts ...grpc.CallOption) (*feature.CreateFeatureResponse, error) {
 	m.ctrl.T.Helper()
@@ -354,6 +414,26 @@ func (mr *MockClientMockRecorder) ExecuteScheduledFlagChange(ctx, in any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteScheduledFlagChange", reflect.TypeOf((*MockClient)(nil).ExecuteScheduledFlagChange), varargs...)
 }
 
+// ExportFeatureBundle mocks base method.
+func (m *MockClient) ExportFeatureBundle(ctx context.Context, in *feature.ExportFeatureBundleRequest, opts ...grpc.CallOption) (*feature.ExportFeatureBundleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportFeatureBundle", varargs...)
+	ret0, _ := ret[0].(*feature.ExportFeatureBundleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportFeatureBundle indicates an expected call of ExportFeatureBundle.
+func (mr *MockClientMockRecorder) ExportFeatureBundle(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportFeatureBundle", reflect.TypeOf((*MockClient)(nil).ExportFeatureBundle), varargs...)
+}
+
 // FlagTriggerWebhook mocks base method.
 func (m *MockClient) FlagTriggerWebhook(ctx context.Context, in *feature.FlagTriggerWebhookRequest, opts ...grpc.CallOption) (*feature.FlagTriggerWebhookResponse, error) {
 	m.ctrl.T.Helper()
@@ -374,6 +454,26 @@ func (mr *MockClientMockRecorder) FlagTriggerWebhook(ctx, in any, opts ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlagTriggerWebhook", reflect.TypeOf((*MockClient)(nil).FlagTriggerWebhook), varargs...)
 }
 
+// GetChangeRequest mocks base method.
+func (m *MockClient) GetChangeRequest(ctx context.Context, in *feature.GetChangeRequestRequest, opts ...grpc.CallOption) (*feature.GetChangeRequestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChangeRequest", varargs...)
+	ret0, _ := ret[0].(*feature.GetChangeRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangeRequest indicates an expected call of GetChangeRequest.
+func (mr *MockClientMockRecorder) GetChangeRequest(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangeRequest", reflect.TypeOf((*MockClient)(nil).GetChangeRequest), varargs...)
+}
+
 // GetFeature mocks base method.
 func (m *MockClient) GetFeature(ctx context.Context, in *feature.GetFeatureRequest, opts ...grpc.CallOption) (*feature.GetFeatureResponse, error) {
 	m.ctrl.T.Helper()
@@ -414,6 +514,26 @@ func (mr *MockClientMockRecorder) GetFeatures(ctx, in any, opts ...any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeatures", reflect.TypeOf((*MockClient)(nil).GetFeatures), varargs...)
 }
 
+// GetFlagDebtReport mocks base method.
+func (m *MockClient) GetFlagDebtReport(ctx context.Context, in *feature.GetFlagDebtReportRequest, opts ...grpc.CallOption) (*feature.GetFlagDebtReportResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFlagDebtReport", varargs...)
+	ret0, _ := ret[0].(*feature.GetFlagDebtReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFlagDebtReport indicates an expected call of GetFlagDebtReport.
+func (mr *MockClientMockRecorder) GetFlagDebtReport(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlagDebtReport", reflect.TypeOf((*MockClient)(nil).GetFlagDebtReport), varargs...)
+}
+
 // GetFlagTrigger mocks base method.
 func (m *MockClient) GetFlagTrigger(ctx context.Context, in *feature.GetFlagTriggerRequest, opts ...grpc.CallOption) (*feature.GetFlagTriggerResponse, error) {
 	m.ctrl.T.Helper()
@@ -514,6 +634,46 @@ func (mr *MockClientMockRecorder) GetUserAttributeKeys(ctx, in any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAttributeKeys", reflect.TypeOf((*MockClient)(nil).GetUserAttributeKeys), varargs...)
 }
 
+// ImportFeatureBundle mocks base method.
+func (m *MockClient) ImportFeatureBundle(ctx context.Context, in *feature.ImportFeatureBundleRequest, opts ...grpc.CallOption) (*feature.ImportFeatureBundleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportFeatureBundle", varargs...)
+	ret0, _ := ret[0].(*feature.ImportFeatureBundleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportFeatureBundle indicates an expected call of ImportFeatureBundle.
+func (mr *MockClientMockRecorder) ImportFeatureBundle(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportFeatureBundle", reflect.TypeOf((*MockClient)(nil).ImportFeatureBundle), varargs...)
+}
+
+// ListChangeRequests mocks base method.
+func (m *MockClient) ListChangeRequests(ctx context.Context, in *feature.ListChangeRequestsRequest, opts ...grpc.CallOption) (*feature.ListChangeRequestsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListChangeRequests", varargs...)
+	ret0, _ := ret[0].(*feature.ListChangeRequestsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChangeRequests indicates an expected call of ListChangeRequests.
+func (mr *MockClientMockRecorder) ListChangeRequests(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChangeRequests", reflect.TypeOf((*MockClient)(nil).ListChangeRequests), varargs...)
+}
+
 // ListEnabledFeatures mocks base method.
 func (m *MockClient) ListEnabledFeatures(ctx context.Context, in *feature.ListEnabledFeaturesRequest, opts ...grpc.CallOption) (*feature.ListEnabledFeaturesResponse, error) {
 	m.ctrl.T.Helper()
@@ -654,6 +814,46 @@ func (mr *MockClientMockRecorder) ListTags(ctx, in any, opts ...any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockClient)(nil).ListTags), varargs...)
 }
 
+// RejectChangeRequest mocks base method.
+func (m *MockClient) RejectChangeRequest(ctx context.Context, in *feature.RejectChangeRequestRequest, opts ...grpc.CallOption) (*feature.RejectChangeRequestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RejectChangeRequest", varargs...)
+	ret0, _ := ret[0].(*feature.RejectChangeRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectChangeRequest indicates an expected call of RejectChangeRequest.
+func (mr *MockClientMockRecorder) RejectChangeRequest(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectChangeRequest", reflect.TypeOf((*MockClient)(nil).RejectChangeRequest), varargs...)
+}
+
+// RestoreFeature mocks base method.
+func (m *MockClient) RestoreFeature(ctx context.Context, in *feature.RestoreFeatureRequest, opts ...grpc.CallOption) (*feature.RestoreFeatureResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreFeature", varargs...)
+	ret0, _ := ret[0].(*feature.RestoreFeatureResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreFeature indicates an expected call of RestoreFeature.
+func (mr *MockClientMockRecorder) RestoreFeature(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFeature", reflect.TypeOf((*MockClient)(nil).RestoreFeature), varargs...)
+}
+
 // UpdateFeature mocks base method.
 func (m *MockClient) UpdateFeature(ctx context.Context, in *feature.UpdateFeatureRequest, opts ...grpc.CallOption) (*feature.UpdateFeatureResponse, error) {
 	m.ctrl.T.Helper()
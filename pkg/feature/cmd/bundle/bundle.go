@@ -0,0 +1,62 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+	kingpin "gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/cli"
+	featureclient "github.com/bucketeer-io/bucketeer/v2/pkg/feature/client"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/rpc/client"
+)
+
+// RegisterCommand registers the `bundle` command and its export and import subcommands.
+func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) {
+	cmd := p.Command("bundle", "Export and import the flag definitions of an environment")
+	registerExportCommand(r, cmd)
+	registerImportCommand(r, cmd)
+}
+
+type connection struct {
+	certPath          *string
+	serviceTokenPath  *string
+	webGatewayAddress *string
+	environmentID     *string
+}
+
+func newConnection(cmd *kingpin.CmdClause) *connection {
+	return &connection{
+		certPath:          cmd.Flag("cert", "Path to TLS certificate.").Required().String(),
+		serviceTokenPath:  cmd.Flag("service-token", "Path to service token file.").Required().String(),
+		webGatewayAddress: cmd.Flag("web-gateway", "Address of web-gateway.").Required().String(),
+		environmentID:     cmd.Flag("environment-id", "The environment id.").Required().String(),
+	}
+}
+
+func (c *connection) featureClient(logger *zap.Logger) (featureclient.Client, error) {
+	creds, err := client.NewPerRPCCredentials(*c.serviceTokenPath)
+	if err != nil {
+		return nil, err
+	}
+	return featureclient.NewClient(*c.webGatewayAddress, *c.certPath,
+		client.WithPerRPCCredentials(creds),
+		client.WithDialTimeout(30*time.Second),
+		client.WithBlock(),
+		client.WithLogger(logger),
+	)
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"context"
+	"os"
+
+	"go.uber.org/zap"
+	kingpin "gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/cli"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/metrics"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+type exportCommand struct {
+	*kingpin.CmdClause
+	*connection
+	format *string
+	output *string
+}
+
+func registerExportCommand(r cli.CommandRegistry, p cli.ParentCommand) *exportCommand {
+	cmd := p.Command("export", "Export the flag definitions of an environment to a bundle")
+	command := &exportCommand{
+		CmdClause:  cmd,
+		connection: newConnection(cmd),
+		format:     cmd.Flag("format", "The format of the bundle.").Default("yaml").Enum("yaml", "json"),
+		output:     cmd.Flag("output", "Path of file to write the bundle. Defaults to stdout.").String(),
+	}
+	r.RegisterCommand(command)
+	return command
+}
+
+func (c *exportCommand) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.Logger) error {
+	client, err := c.featureClient(logger)
+	if err != nil {
+		logger.Error("Failed to create feature client", zap.Error(err))
+		return err
+	}
+	defer client.Close()
+	format := featureproto.FeatureBundleFormat_FEATURE_BUNDLE_FORMAT_YAML
+	if *c.format == "json" {
+		format = featureproto.FeatureBundleFormat_FEATURE_BUNDLE_FORMAT_JSON
+	}
+	resp, err := client.ExportFeatureBundle(ctx, &featureproto.ExportFeatureBundleRequest{
+		EnvironmentId: *c.environmentID,
+		Format:        format,
+	})
+	if err != nil {
+		logger.Error("Failed to export feature bundle", zap.Error(err))
+		return err
+	}
+	if *c.output == "" {
+		_, err = os.Stdout.Write(resp.Bundle)
+		return err
+	}
+	if err := os.WriteFile(*c.output, resp.Bundle, 0644); err != nil {
+		logger.Error("Failed to write bundle to file", zap.Error(err), zap.String("output", *c.output))
+		return err
+	}
+	logger.Info("Bundle exported", zap.String("output", *c.output))
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+	kingpin "gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/cli"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/metrics"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+var errPartialImport = errors.New("bundle: partially imported")
+
+type importCommand struct {
+	*kingpin.CmdClause
+	*connection
+	input   *string
+	comment *string
+	apply   *bool
+}
+
+func registerImportCommand(r cli.CommandRegistry, p cli.ParentCommand) *importCommand {
+	cmd := p.Command("import", "Show the changes needed for an environment to match a bundle and apply them")
+	command := &importCommand{
+		CmdClause:  cmd,
+		connection: newConnection(cmd),
+		input:      cmd.Flag("input", "Path of the bundle file.").Required().String(),
+		comment:    cmd.Flag("comment", "The comment recorded on the feature updates.").String(),
+		apply:      cmd.Flag("apply", "Apply the changes. Otherwise only the diff is shown.").Default("false").Bool(),
+	}
+	r.RegisterCommand(command)
+	return command
+}
+
+func (c *importCommand) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.Logger) error {
+	data, err := os.ReadFile(*c.input)
+	if err != nil {
+		logger.Error("Failed to read bundle file", zap.Error(err), zap.String("input", *c.input))
+		return err
+	}
+	client, err := c.featureClient(logger)
+	if err != nil {
+		logger.Error("Failed to create feature client", zap.Error(err))
+		return err
+	}
+	defer client.Close()
+	diff, err := client.ImportFeatureBundle(ctx, &featureproto.ImportFeatureBundleRequest{
+		EnvironmentId: *c.environmentID,
+		Bundle:        data,
+		DryRun:        true,
+	})
+	if err != nil {
+		logger.Error("Failed to compute the bundle diff", zap.Error(err))
+		return err
+	}
+	writeChanges(os.Stdout, diff.Changes)
+	if !*c.apply || len(diff.Changes) == 0 {
+		return nil
+	}
+	// The diff token makes the server refuse to apply the changes
+	// if any resource was modified since the diff above was computed.
+	resp, err := client.ImportFeatureBundle(ctx, &featureproto.ImportFeatureBundleRequest{
+		EnvironmentId: *c.environmentID,
+		Bundle:        data,
+		DiffToken:     diff.DiffToken,
+		Comment:       *c.comment,
+	})
+	if err != nil {
+		logger.Error("Failed to import feature bundle", zap.Error(err))
+		return err
+	}
+	if resp.Partial {
+		// Running the command again applies the remaining changes.
+		logger.Error("Bundle partially imported",
+			zap.Int("appliedChanges", len(resp.Changes)),
+			zap.Int("changes", len(diff.Changes)),
+			zap.String("error", resp.ErrorMessage),
+		)
+		return errPartialImport
+	}
+	logger.Info("Bundle imported", zap.Int("changes", len(diff.Changes)))
+	return nil
+}
+
+func writeChanges(w io.Writer, changes []*featureproto.FeatureBundleChange) {
+	if len(changes) == 0 {
+		fmt.Fprintln(w, "No changes")
+		return
+	}
+	for _, c := range changes {
+		line := fmt.Sprintf("%-8s %-19s %s", c.Action, c.ResourceType, c.ResourceId)
+		if len(c.Fields) > 0 {
+			line += " (" + strings.Join(c.Fields, ", ") + ")"
+		}
+		fmt.Fprintln(w, line)
+	}
+}
@@ -178,7 +178,7 @@ func buildDependencyTargets(features []*ftproto.Feature) map[string]bool {
 
 		// Check rules for FEATURE_FLAG clause type dependencies
 		for _, rule := range f.Rules {
-			for _, clause := range rule.Clauses {
+			for _, clause := range RuleClauses(rule) {
 				if clause.Operator == ftproto.Clause_FEATURE_FLAG {
 					// The attribute contains the feature ID being referenced
 					targets[clause.Attribute] = true
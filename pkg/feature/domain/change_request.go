@@ -0,0 +1,154 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"time"
+
+	pkgErr "github.com/bucketeer-io/bucketeer/v2/pkg/error"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/uuid"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+var (
+	ErrChangeRequestNotPending = pkgErr.NewErrorFailedPrecondition(
+		pkgErr.FeaturePackageName, "feature: change request is not pending")
+	ErrChangeRequestNotApproved = pkgErr.NewErrorFailedPrecondition(
+		pkgErr.FeaturePackageName, "feature: change request is not approved")
+	ErrChangeRequestSelfApproval = pkgErr.NewErrorPermissionDenied(
+		pkgErr.FeaturePackageName, "feature: change request cannot be approved by its author")
+	ErrChangeRequestAlreadyApproved = pkgErr.NewErrorAlreadyExists(
+		pkgErr.FeaturePackageName, "feature: change request already approved by this reviewer")
+)
+
+// ChangeRequest is the domain model wrapper for change requests
+type ChangeRequest struct {
+	*proto.ChangeRequest
+}
+
+// NewChangeRequest creates a new ChangeRequest domain object
+func NewChangeRequest(
+	featureID string,
+	environmentID string,
+	payload *proto.ScheduledChangePayload,
+	comment string,
+	flagVersionAtCreation int32,
+	minApprovers int32,
+	createdBy string,
+) (*ChangeRequest, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	if minApprovers < 1 {
+		minApprovers = 1
+	}
+	now := time.Now().Unix()
+	return &ChangeRequest{
+		ChangeRequest: &proto.ChangeRequest{
+			Id:                    id.String(),
+			FeatureId:             featureID,
+			EnvironmentId:         environmentID,
+			Payload:               payload,
+			Comment:               comment,
+			Status:                proto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING,
+			FlagVersionAtCreation: flagVersionAtCreation,
+			MinApprovers:          minApprovers,
+			CreatedBy:             createdBy,
+			CreatedAt:             now,
+			UpdatedAt:             now,
+		},
+	}, nil
+}
+
+// Approve records an approval from the reviewer.
+// The request moves to APPROVED once it has reached the minimum number of approvals.
+func (c *ChangeRequest) Approve(approvedBy, comment string) error {
+	if !c.IsPending() {
+		return ErrChangeRequestNotPending
+	}
+	if approvedBy == c.CreatedBy {
+		return ErrChangeRequestSelfApproval
+	}
+	for _, a := range c.Approvals {
+		if a.ApprovedBy == approvedBy {
+			return ErrChangeRequestAlreadyApproved
+		}
+	}
+	now := time.Now().Unix()
+	c.Approvals = append(c.Approvals, &proto.ChangeRequestApproval{
+		ApprovedBy: approvedBy,
+		Comment:    comment,
+		ApprovedAt: now,
+	})
+	if int32(len(c.Approvals)) >= c.MinApprovers {
+		c.Status = proto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_APPROVED
+	}
+	c.UpdatedBy = approvedBy
+	c.UpdatedAt = now
+	return nil
+}
+
+// Reject sets the status to REJECTED.
+// Both pending and approved requests can be rejected as long as they are not applied yet.
+func (c *ChangeRequest) Reject(rejectedBy, comment string) error {
+	if !c.IsPending() && !c.IsApproved() {
+		return ErrChangeRequestNotPending
+	}
+	c.Status = proto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_REJECTED
+	c.RejectedBy = rejectedBy
+	c.RejectionComment = comment
+	c.UpdatedBy = rejectedBy
+	c.UpdatedAt = time.Now().Unix()
+	return nil
+}
+
+// MarkApplied sets the status to APPLIED
+func (c *ChangeRequest) MarkApplied(appliedBy string) error {
+	if !c.IsApproved() {
+		return ErrChangeRequestNotApproved
+	}
+	c.Status = proto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_APPLIED
+	now := time.Now().Unix()
+	c.AppliedAt = now
+	c.UpdatedBy = appliedBy
+	c.UpdatedAt = now
+	return nil
+}
+
+// IsPending returns true if the status is PENDING
+func (c *ChangeRequest) IsPending() bool {
+	return c.Status == proto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING
+}
+
+// IsApproved returns true if the status is APPROVED
+func (c *ChangeRequest) IsApproved() bool {
+	return c.Status == proto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_APPROVED
+}
+
+// ApproverEmails returns the emails of the reviewers who approved the request
+func (c *ChangeRequest) ApproverEmails() []string {
+	emails := make([]string, 0, len(c.Approvals))
+	for _, a := range c.Approvals {
+		emails = append(emails, a.ApprovedBy)
+	}
+	return emails
+}
+
+// ChangeSummaries generates i18n-ready change summaries for the request payload
+func (c *ChangeRequest) ChangeSummaries(flag *proto.Feature, options *ChangeSummaryOptions) []*proto.ChangeSummary {
+	sfc := &ScheduledFlagChange{ScheduledFlagChange: &proto.ScheduledFlagChange{Payload: c.Payload}}
+	return sfc.GenerateChangeSummariesWithOptions(flag, options)
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+func newTestChangeRequest(t *testing.T, minApprovers int32) *ChangeRequest {
+	t.Helper()
+	cr, err := NewChangeRequest(
+		"feature-1",
+		"env-1",
+		&proto.ScheduledChangePayload{Enabled: wrapperspb.Bool(true)},
+		"Enable flag",
+		3,
+		minApprovers,
+		"author@example.com",
+	)
+	require.NoError(t, err)
+	return cr
+}
+
+func TestNewChangeRequest(t *testing.T) {
+	t.Parallel()
+
+	payload := &proto.ScheduledChangePayload{Enabled: wrapperspb.Bool(true)}
+	cr, err := NewChangeRequest(
+		"feature-1",
+		"env-1",
+		payload,
+		"Enable flag",
+		3,
+		2,
+		"author@example.com",
+	)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, cr.Id)
+	assert.Equal(t, "feature-1", cr.FeatureId)
+	assert.Equal(t, "env-1", cr.EnvironmentId)
+	assert.Equal(t, payload, cr.Payload)
+	assert.Equal(t, "Enable flag", cr.Comment)
+	assert.Equal(t, proto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING, cr.Status)
+	assert.Equal(t, int32(3), cr.FlagVersionAtCreation)
+	assert.Equal(t, int32(2), cr.MinApprovers)
+	assert.Equal(t, "author@example.com", cr.CreatedBy)
+	assert.True(t, cr.CreatedAt > 0)
+	assert.Equal(t, cr.CreatedAt, cr.UpdatedAt)
+}
+
+func TestNewChangeRequestDefaultMinApprovers(t *testing.T) {
+	t.Parallel()
+	cr := newTestChangeRequest(t, 0)
+	assert.Equal(t, int32(1), cr.MinApprovers)
+}
+
+func TestChangeRequestApprove(t *testing.T) {
+	t.Parallel()
+
+	patterns := []struct {
+		desc           string
+		minApprovers   int32
+		approvers      []string
+		expectedStatus proto.ChangeRequestStatus
+		expectedErr    error
+	}{
+		{
+			desc:           "err: author cannot approve",
+			minApprovers:   1,
+			approvers:      []string{"author@example.com"},
+			expectedStatus: proto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING,
+			expectedErr:    ErrChangeRequestSelfApproval,
+		},
+		{
+			desc:           "err: duplicate approval",
+			minApprovers:   2,
+			approvers:      []string{"reviewer1@example.com", "reviewer1@example.com"},
+			expectedStatus: proto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING,
+			expectedErr:    ErrChangeRequestAlreadyApproved,
+		},
+		{
+			desc:           "err: already approved",
+			minApprovers:   1,
+			approvers:      []string{"reviewer1@example.com", "reviewer2@example.com"},
+			expectedStatus: proto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_APPROVED,
+			expectedErr:    ErrChangeRequestNotPending,
+		},
+		{
+			desc:           "success: still pending",
+			minApprovers:   2,
+			approvers:      []string{"reviewer1@example.com"},
+			expectedStatus: proto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING,
+		},
+		{
+			desc:           "success: approved",
+			minApprovers:   2,
+			approvers:      []string{"reviewer1@example.com", "reviewer2@example.com"},
+			expectedStatus: proto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_APPROVED,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			cr := newTestChangeRequest(t, p.minApprovers)
+			var err error
+			for _, a := range p.approvers {
+				if err = cr.Approve(a, "lgtm"); err != nil {
+					break
+				}
+			}
+			assert.Equal(t, p.expectedErr, err)
+			assert.Equal(t, p.expectedStatus, cr.Status)
+		})
+	}
+}
+
+func TestChangeRequestReject(t *testing.T) {
+	t.Parallel()
+
+	cr := newTestChangeRequest(t, 1)
+	require.NoError(t, cr.Reject("reviewer@example.com", "not now"))
+	assert.Equal(t, proto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_REJECTED, cr.Status)
+	assert.Equal(t, "reviewer@example.com", cr.RejectedBy)
+	assert.Equal(t, "not now", cr.RejectionComment)
+	assert.Equal(t, "reviewer@example.com", cr.UpdatedBy)
+
+	// A rejected request cannot be rejected or approved again
+	assert.Equal(t, ErrChangeRequestNotPending, cr.Reject("reviewer@example.com", ""))
+	assert.Equal(t, ErrChangeRequestNotPending, cr.Approve("reviewer@example.com", ""))
+}
+
+func TestChangeRequestMarkApplied(t *testing.T) {
+	t.Parallel()
+
+	cr := newTestChangeRequest(t, 1)
+	assert.Equal(t, ErrChangeRequestNotApproved, cr.MarkApplied("author@example.com"))
+
+	require.NoError(t, cr.Approve("reviewer@example.com", ""))
+	require.NoError(t, cr.MarkApplied("author@example.com"))
+	assert.Equal(t, proto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_APPLIED, cr.Status)
+	assert.True(t, cr.AppliedAt > 0)
+	assert.Equal(t, "author@example.com", cr.UpdatedBy)
+	assert.Equal(t, []string{"reviewer@example.com"}, cr.ApproverEmails())
+
+	// An applied request cannot be rejected
+	assert.Equal(t, ErrChangeRequestNotPending, cr.Reject("reviewer@example.com", ""))
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	pkgErr "github.com/bucketeer-io/bucketeer/v2/pkg/error"
+	"github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+// maxClauseGroupDepth bounds how deeply clause groups can be nested in a rule.
+const maxClauseGroupDepth = 5
+
+var (
+	errClauseGroupEmpty = pkgErr.NewErrorInvalidArgEmpty(
+		pkgErr.FeaturePackageName, "feature: clause group must have at least one clause or group", "clause_groups")
+	errClauseGroupNotSingleChild = pkgErr.NewErrorInvalidArgNotMatchFormat(
+		pkgErr.FeaturePackageName, "feature: NOT clause group must have exactly one clause or group", "clause_groups")
+	errClauseGroupTooDeep = pkgErr.NewErrorInvalidArgNotMatchFormat(
+		pkgErr.FeaturePackageName, "feature: clause groups are nested too deeply", "clause_groups")
+	errClauseGroupUnknownOperator = pkgErr.NewErrorInvalidArgUnknown(
+		pkgErr.FeaturePackageName, "feature: unknown clause group operator", "clause_groups")
+)
+
+// RuleClauses returns every clause of the rule, including the ones nested in
+// its clause groups, in depth-first order.
+func RuleClauses(rule *feature.Rule) []*feature.Clause {
+	if len(rule.GetClauseGroups()) == 0 {
+		return rule.GetClauses()
+	}
+	clauses := append([]*feature.Clause{}, rule.GetClauses()...)
+	for _, g := range rule.GetClauseGroups() {
+		clauses = appendGroupClauses(clauses, g)
+	}
+	return clauses
+}
+
+func appendGroupClauses(clauses []*feature.Clause, group *feature.ClauseGroup) []*feature.Clause {
+	clauses = append(clauses, group.GetClauses()...)
+	for _, g := range group.GetGroups() {
+		clauses = appendGroupClauses(clauses, g)
+	}
+	return clauses
+}
+
+// validateRuleClauses validates the clauses of the rule, including the ones
+// nested in its clause groups, and the shape of the groups themselves.
+func validateRuleClauses(rule *feature.Rule) error {
+	if err := ValidateClauseGroups(rule); err != nil {
+		return err
+	}
+	return validateClauses(RuleClauses(rule))
+}
+
+// ValidateClauseGroups validates the shape and the nesting depth of the
+// clause groups of the rule. The clauses themselves are not validated.
+func ValidateClauseGroups(rule *feature.Rule) error {
+	for _, g := range rule.GetClauseGroups() {
+		if err := validateClauseGroup(g, 1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateClauseGroup(group *feature.ClauseGroup, depth int) error {
+	if depth > maxClauseGroupDepth {
+		return errClauseGroupTooDeep
+	}
+	children := len(group.GetClauses()) + len(group.GetGroups())
+	switch group.GetOperator() {
+	case feature.ClauseGroup_AND, feature.ClauseGroup_OR:
+		if children == 0 {
+			return errClauseGroupEmpty
+		}
+	case feature.ClauseGroup_NOT:
+		if children != 1 {
+			return errClauseGroupNotSingleChild
+		}
+	default:
+		return errClauseGroupUnknownOperator
+	}
+	for _, g := range group.GetGroups() {
+		if err := validateClauseGroup(g, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
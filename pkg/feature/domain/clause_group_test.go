@@ -0,0 +1,187 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+func TestRuleClauses(t *testing.T) {
+	t.Parallel()
+	c1 := &feature.Clause{Id: "c1"}
+	c2 := &feature.Clause{Id: "c2"}
+	c3 := &feature.Clause{Id: "c3"}
+	c4 := &feature.Clause{Id: "c4"}
+	rule := &feature.Rule{
+		Clauses: []*feature.Clause{c1},
+		ClauseGroups: []*feature.ClauseGroup{
+			{
+				Operator: feature.ClauseGroup_OR,
+				Clauses:  []*feature.Clause{c2},
+				Groups: []*feature.ClauseGroup{
+					{Operator: feature.ClauseGroup_NOT, Clauses: []*feature.Clause{c3}},
+				},
+			},
+			{Operator: feature.ClauseGroup_AND, Clauses: []*feature.Clause{c4}},
+		},
+	}
+	assert.Equal(t, []*feature.Clause{c1, c2, c3, c4}, RuleClauses(rule))
+	// The rule's own clauses are not modified.
+	assert.Equal(t, []*feature.Clause{c1}, rule.Clauses)
+	assert.Empty(t, RuleClauses(nil))
+}
+
+func TestValidateRuleClauses(t *testing.T) {
+	t.Parallel()
+	newClause := func(id string) *feature.Clause {
+		return &feature.Clause{
+			Id:        id,
+			Attribute: "plan",
+			Operator:  feature.Clause_EQUALS,
+			Values:    []string{"pro"},
+		}
+	}
+	const (
+		id1 = "8b8c1c4a-2f8e-4a9b-9b4e-0c2f5b1e8a01"
+		id2 = "8b8c1c4a-2f8e-4a9b-9b4e-0c2f5b1e8a02"
+		id3 = "8b8c1c4a-2f8e-4a9b-9b4e-0c2f5b1e8a03"
+	)
+	nest := func(depth int) *feature.ClauseGroup {
+		g := &feature.ClauseGroup{Operator: feature.ClauseGroup_AND, Clauses: []*feature.Clause{newClause(id2)}}
+		for range depth - 1 {
+			g = &feature.ClauseGroup{Operator: feature.ClauseGroup_AND, Groups: []*feature.ClauseGroup{g}}
+		}
+		return g
+	}
+	patterns := []struct {
+		desc     string
+		rule     *feature.Rule
+		expected error
+	}{
+		{
+			desc: "success: clauses and groups",
+			rule: &feature.Rule{
+				Clauses: []*feature.Clause{newClause(id1)},
+				ClauseGroups: []*feature.ClauseGroup{
+					{Operator: feature.ClauseGroup_OR, Clauses: []*feature.Clause{newClause(id2), newClause(id3)}},
+				},
+			},
+		},
+		{
+			desc: "success: groups only",
+			rule: &feature.Rule{
+				ClauseGroups: []*feature.ClauseGroup{
+					{Operator: feature.ClauseGroup_NOT, Clauses: []*feature.Clause{newClause(id1)}},
+				},
+			},
+		},
+		{
+			desc: "success: maximum depth",
+			rule: &feature.Rule{ClauseGroups: []*feature.ClauseGroup{nest(maxClauseGroupDepth)}},
+		},
+		{
+			desc: "err: empty group",
+			rule: &feature.Rule{
+				Clauses:      []*feature.Clause{newClause(id1)},
+				ClauseGroups: []*feature.ClauseGroup{{Operator: feature.ClauseGroup_OR}},
+			},
+			expected: errClauseGroupEmpty,
+		},
+		{
+			desc: "err: NOT group with two children",
+			rule: &feature.Rule{
+				ClauseGroups: []*feature.ClauseGroup{
+					{Operator: feature.ClauseGroup_NOT, Clauses: []*feature.Clause{newClause(id1), newClause(id2)}},
+				},
+			},
+			expected: errClauseGroupNotSingleChild,
+		},
+		{
+			desc: "err: unknown operator",
+			rule: &feature.Rule{
+				ClauseGroups: []*feature.ClauseGroup{
+					{Operator: feature.ClauseGroup_Operator(99), Clauses: []*feature.Clause{newClause(id1)}},
+				},
+			},
+			expected: errClauseGroupUnknownOperator,
+		},
+		{
+			desc:     "err: too deep",
+			rule:     &feature.Rule{ClauseGroups: []*feature.ClauseGroup{nest(maxClauseGroupDepth + 1)}},
+			expected: errClauseGroupTooDeep,
+		},
+		{
+			desc: "err: clause in group has no values",
+			rule: &feature.Rule{
+				ClauseGroups: []*feature.ClauseGroup{
+					{
+						Operator: feature.ClauseGroup_OR,
+						Clauses:  []*feature.Clause{{Id: id1, Attribute: "plan", Operator: feature.Clause_EQUALS}},
+					},
+				},
+			},
+			expected: errClauseValuesEmpty,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, p.expected, validateRuleClauses(p.rule))
+		})
+	}
+}
+
+func TestValidateRuleClausesDuplicatedIDAcrossGroups(t *testing.T) {
+	t.Parallel()
+	const id = "8b8c1c4a-2f8e-4a9b-9b4e-0c2f5b1e8a01"
+	clause := &feature.Clause{Id: id, Attribute: "plan", Operator: feature.Clause_EQUALS, Values: []string{"pro"}}
+	rule := &feature.Rule{
+		Clauses: []*feature.Clause{clause},
+		ClauseGroups: []*feature.ClauseGroup{
+			{Operator: feature.ClauseGroup_OR, Clauses: []*feature.Clause{clause}},
+		},
+	}
+	assert.Error(t, validateRuleClauses(rule))
+}
+
+func TestListSegmentIDsInClauseGroups(t *testing.T) {
+	t.Parallel()
+	f := &Feature{Feature: &feature.Feature{
+		Rules: []*feature.Rule{
+			{
+				ClauseGroups: []*feature.ClauseGroup{
+					{
+						Operator: feature.ClauseGroup_NOT,
+						Clauses: []*feature.Clause{
+							{Operator: feature.Clause_SEGMENT, Values: []string{"segment-1"}},
+						},
+					},
+					{
+						Operator: feature.ClauseGroup_OR,
+						Clauses: []*feature.Clause{
+							{Operator: feature.Clause_FEATURE_FLAG, Attribute: "feature-2", Values: []string{"v1"}},
+						},
+					},
+				},
+			},
+		},
+	}}
+	assert.Equal(t, []string{"segment-1"}, f.ListSegmentIDs())
+	assert.Equal(t, []string{"feature-2"}, f.FeatureIDsDependsOn())
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"gopkg.in/yaml.v2"
@@ -137,6 +138,18 @@ var (
 	)
 	ErrInvalidVariationWeightTotal = pkgErr.NewErrorInvalidArgNotMatchFormat(
 		pkgErr.FeaturePackageName, "feature: variation weights must sum to 100%", "variation")
+	ErrInvalidBucketBy = pkgErr.NewErrorInvalidArgNotMatchFormat(
+		pkgErr.FeaturePackageName, "feature: bucket by attribute must not be blank", "bucket_by")
+	ErrBucketByFallbackVariationNotFound = pkgErr.NewErrorNotFound(
+		pkgErr.FeaturePackageName,
+		"feature: bucket by fallback variation not found",
+		"bucket_by_fallback_variation",
+	)
+	ErrBucketByFallbackVariationWithoutBucketBy = pkgErr.NewErrorInvalidArgNotMatchFormat(
+		pkgErr.FeaturePackageName,
+		"feature: bucket by fallback variation requires a bucket by attribute",
+		"bucket_by_fallback_variation",
+	)
 	errMaintainerCannotBeEmpty = pkgErr.NewErrorInvalidArgEmpty(
 		pkgErr.FeaturePackageName, "feature: maintainer cannot be empty", "maintainer")
 	errPrerequisiteRequired = pkgErr.NewErrorInvalidArgNil(
@@ -354,7 +367,7 @@ func (f *Feature) AddRule(rule *feature.Rule) error {
 	if _, err := f.findRule(rule.Id); err == nil {
 		return errRuleAlreadyExists
 	}
-	if err := validateClauses(rule.Clauses); err != nil {
+	if err := validateRuleClauses(rule); err != nil {
 		return err
 	}
 	if err := validateStrategy(rule.Strategy, f.Variations); err != nil {
@@ -373,7 +386,7 @@ func (f *Feature) ChangeRule(rule *feature.Rule) error {
 	if err != nil {
 		return err
 	}
-	if err := validateClauses(rule.Clauses); err != nil {
+	if err := validateRuleClauses(rule); err != nil {
 		return err
 	}
 	if err := validateStrategy(rule.Strategy, f.Variations); err != nil {
@@ -538,6 +551,10 @@ func validateRolloutStrategy(strategy *feature.RolloutStrategy, variations []*fe
 		}
 	}
 
+	if err := validateBucketBy(strategy, variations); err != nil {
+		return err
+	}
+
 	// Validate variation weights
 	totalWeight := int32(0)
 	for _, v := range strategy.Variations {
@@ -550,6 +567,27 @@ func validateRolloutStrategy(strategy *feature.RolloutStrategy, variations []*fe
 	return nil
 }
 
+// validateBucketBy checks the optional user attributes used as the bucketing
+// key and the variation served when the user doesn't have them.
+func validateBucketBy(strategy *feature.RolloutStrategy, variations []*feature.Variation) error {
+	bucketBy := []string{strategy.BucketBy, strategy.Audience.GetBucketBy()}
+	for _, attr := range bucketBy {
+		if attr != "" && strings.TrimSpace(attr) != attr {
+			return ErrInvalidBucketBy
+		}
+	}
+	if strategy.BucketByFallbackVariation == "" {
+		return nil
+	}
+	if strategy.BucketBy == "" && strategy.Audience.GetBucketBy() == "" {
+		return ErrBucketByFallbackVariationWithoutBucketBy
+	}
+	if _, err := findVariation(strategy.BucketByFallbackVariation, variations); err != nil {
+		return ErrBucketByFallbackVariationNotFound
+	}
+	return nil
+}
+
 func validateFixedStrategy(strategy *feature.FixedStrategy, variations []*feature.Variation) error {
 	if _, err := findVariation(strategy.Variation, variations); err != nil {
 		return errVariationNotFound
@@ -1089,7 +1127,7 @@ func (f *Feature) ChangeRolloutStrategy(ruleID string, strategy *feature.Rollout
 func (f *Feature) ListSegmentIDs() []string {
 	mapIDs := make(map[string]struct{})
 	for _, r := range f.Rules {
-		for _, c := range r.Clauses {
+		for _, c := range RuleClauses(r) {
 			if c.Operator == feature.Clause_SEGMENT {
 				for _, v := range c.Values {
 					mapIDs[v] = struct{}{}
@@ -1120,6 +1158,13 @@ func (f *Feature) ResetSamplingSeed() error {
 	return nil
 }
 
+// SetExperimentAllocation replaces the experiment allocation evaluated for this flag.
+// The version is left untouched because running experiments measure a fixed flag version.
+func (f *Feature) SetExperimentAllocation(allocation *feature.ExperimentAllocation) {
+	f.ExperimentAllocation = allocation
+	f.UpdatedAt = time.Now().Unix()
+}
+
 func (f *Feature) AddPrerequisite(fID, variationID string) error {
 	if _, err := f.findPrerequisite(fID); err == nil {
 		return errPrerequisiteAlreadyExists
@@ -1271,7 +1316,7 @@ func (f *Feature) FeatureIDsDependsOn() []string {
 		ids = append(ids, p.FeatureId)
 	}
 	for _, p := range f.Rules {
-		for _, c := range p.Clauses {
+		for _, c := range RuleClauses(p) {
 			if c.Operator == feature.Clause_FEATURE_FLAG {
 				ids = append(ids, c.Attribute)
 			}
@@ -1309,6 +1354,13 @@ func (f *Feature) Clone(
 		VariationValueSchema: f.VariationValueSchema,
 		Archived:             false,
 	}}
+	if f.Lifecycle != nil {
+		// The state is derived again in the destination environment
+		newFeature.Lifecycle = &feature.FeatureLifecycle{
+			Kind:             f.Lifecycle.Kind,
+			PlannedRemovalAt: f.Lifecycle.PlannedRemovalAt,
+		}
+	}
 	for i := range newFeature.Variations {
 		id, err := uuid.NewUUID()
 		if err != nil {
@@ -1345,7 +1397,7 @@ func (f *Feature) filterRulesWithEnvironmentReferences(rules []*feature.Rule) []
 	filtered := make([]*feature.Rule, 0, len(rules))
 	for _, rule := range rules {
 		hasEnvironmentReference := false
-		for _, clause := range rule.Clauses {
+		for _, clause := range RuleClauses(rule) {
 			if clause.Operator == feature.Clause_FEATURE_FLAG ||
 				clause.Operator == feature.Clause_SEGMENT {
 				hasEnvironmentReference = true
@@ -1442,7 +1494,7 @@ func validateRules(rules []*feature.Rule, variations []*feature.Variation) error
 		if err := validateStrategy(r.Strategy, variations); err != nil {
 			return err
 		}
-		if err := validateClauses(r.Clauses); err != nil {
+		if err := validateRuleClauses(r); err != nil {
 			return err
 		}
 	}
@@ -1544,7 +1596,7 @@ func ValidateVariationUsage(
 
 		// Check if any deleted variations are used in FEATURE_FLAG rules
 		for _, rule := range f.Rules {
-			for _, clause := range rule.Clauses {
+			for _, clause := range RuleClauses(rule) {
 				if clause.Operator == feature.Clause_FEATURE_FLAG && clause.Attribute == targetFeatureID {
 					// FEATURE_FLAG clause values contain variation IDs, not values
 					// We should check if any clause values match deleted variation IDs
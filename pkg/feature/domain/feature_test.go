@@ -2614,6 +2614,24 @@ func TestResetSamplingSeed(t *testing.T) {
 	assert.NotEmpty(t, f.SamplingSeed)
 }
 
+func TestSetExperimentAllocation(t *testing.T) {
+	f := makeFeature("test-feature")
+	version := f.Version
+	allocation := &ftproto.ExperimentAllocation{
+		ExperimentId:        "exp-id",
+		LayerId:             "layer-id",
+		TrafficStart:        0,
+		TrafficEnd:          50000,
+		BaselineVariationId: f.Variations[0].Id,
+	}
+	f.SetExperimentAllocation(allocation)
+	assert.Equal(t, allocation, f.ExperimentAllocation)
+	assert.Equal(t, version, f.Version)
+	f.SetExperimentAllocation(nil)
+	assert.Nil(t, f.ExperimentAllocation)
+	assert.Equal(t, version, f.Version)
+}
+
 func TestFeatureIDsDependsOn(t *testing.T) {
 	t.Parallel()
 	patterns := []struct {
@@ -4598,6 +4616,100 @@ func TestValidateStrategy(t *testing.T) {
 			variations:  variations,
 			expectedErr: ErrInvalidVariationWeightTotal,
 		},
+		{
+			desc: "success: rollout strategy bucketed by attribute with fallback variation",
+			strategy: &ftproto.Strategy{
+				Type: ftproto.Strategy_ROLLOUT,
+				RolloutStrategy: &ftproto.RolloutStrategy{
+					Variations: []*ftproto.RolloutStrategy_Variation{
+						{Variation: id1.String(), Weight: 100000},
+					},
+					BucketBy:                  "company",
+					BucketByFallbackVariation: id2.String(),
+				},
+			},
+			variations:  variations,
+			expectedErr: nil,
+		},
+		{
+			desc: "success: audience bucketed by attribute with fallback variation",
+			strategy: &ftproto.Strategy{
+				Type: ftproto.Strategy_ROLLOUT,
+				RolloutStrategy: &ftproto.RolloutStrategy{
+					Variations: []*ftproto.RolloutStrategy_Variation{
+						{Variation: id1.String(), Weight: 100000},
+					},
+					Audience: &ftproto.Audience{
+						Percentage:       50,
+						DefaultVariation: id1.String(),
+						BucketBy:         "company",
+					},
+					BucketByFallbackVariation: id2.String(),
+				},
+			},
+			variations:  variations,
+			expectedErr: nil,
+		},
+		{
+			desc: "fail: blank bucket by attribute",
+			strategy: &ftproto.Strategy{
+				Type: ftproto.Strategy_ROLLOUT,
+				RolloutStrategy: &ftproto.RolloutStrategy{
+					Variations: []*ftproto.RolloutStrategy_Variation{
+						{Variation: id1.String(), Weight: 100000},
+					},
+					BucketBy: " ",
+				},
+			},
+			variations:  variations,
+			expectedErr: ErrInvalidBucketBy,
+		},
+		{
+			desc: "fail: audience bucket by attribute with surrounding whitespace",
+			strategy: &ftproto.Strategy{
+				Type: ftproto.Strategy_ROLLOUT,
+				RolloutStrategy: &ftproto.RolloutStrategy{
+					Variations: []*ftproto.RolloutStrategy_Variation{
+						{Variation: id1.String(), Weight: 100000},
+					},
+					Audience: &ftproto.Audience{
+						Percentage: 100,
+						BucketBy:   "company ",
+					},
+				},
+			},
+			variations:  variations,
+			expectedErr: ErrInvalidBucketBy,
+		},
+		{
+			desc: "fail: bucket by fallback variation without bucket by attribute",
+			strategy: &ftproto.Strategy{
+				Type: ftproto.Strategy_ROLLOUT,
+				RolloutStrategy: &ftproto.RolloutStrategy{
+					Variations: []*ftproto.RolloutStrategy_Variation{
+						{Variation: id1.String(), Weight: 100000},
+					},
+					BucketByFallbackVariation: id1.String(),
+				},
+			},
+			variations:  variations,
+			expectedErr: ErrBucketByFallbackVariationWithoutBucketBy,
+		},
+		{
+			desc: "fail: bucket by fallback variation not found",
+			strategy: &ftproto.Strategy{
+				Type: ftproto.Strategy_ROLLOUT,
+				RolloutStrategy: &ftproto.RolloutStrategy{
+					Variations: []*ftproto.RolloutStrategy_Variation{
+						{Variation: id1.String(), Weight: 100000},
+					},
+					BucketBy:                  "company",
+					BucketByFallbackVariation: "non-existent",
+				},
+			},
+			variations:  variations,
+			expectedErr: ErrBucketByFallbackVariationNotFound,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
@@ -685,7 +685,7 @@ func (f *Feature) updateAddRule(rule *feature.Rule) error {
 	if rule == nil {
 		return errRuleRequired
 	}
-	if err := validateClauses(rule.Clauses); err != nil {
+	if err := validateRuleClauses(rule); err != nil {
 		return err
 	}
 	if err := validateStrategy(rule.Strategy, f.Variations); err != nil {
@@ -706,7 +706,7 @@ func (f *Feature) updateChangeRule(rule *feature.Rule) error {
 	if err != nil {
 		return err
 	}
-	if err := validateClauses(rule.Clauses); err != nil {
+	if err := validateRuleClauses(rule); err != nil {
 		return err
 	}
 	if err := validateStrategy(rule.Strategy, f.Variations); err != nil {
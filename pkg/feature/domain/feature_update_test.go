@@ -1813,6 +1813,8 @@ func TestUpdateCompleteNoChangesScenario(t *testing.T) {
 	require.NoError(t, err)
 	clauseID, err := uuid.NewUUID()
 	require.NoError(t, err)
+	groupClauseID, err := uuid.NewUUID()
+	require.NoError(t, err)
 
 	fixedTimestamp := time.Now().Unix() - 3600 // 1 hour ago
 	originalVersion := int32(10)
@@ -1851,6 +1853,20 @@ func TestUpdateCompleteNoChangesScenario(t *testing.T) {
 							Values:    []string{"premium"},
 						},
 					},
+					ClauseGroups: []*feature.ClauseGroup{
+						{
+							Operator: feature.ClauseGroup_OR,
+							Clauses: []*feature.Clause{
+								{
+									Id:        groupClauseID.String(),
+									Attribute: "country",
+									Operator:  feature.Clause_EQUALS,
+									Values:    []string{"jp"},
+								},
+							},
+							Groups: []*feature.ClauseGroup{},
+						},
+					},
 				},
 			},
 			Prerequisites: []*feature.Prerequisite{
@@ -18,10 +18,12 @@ package domain
 import (
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"time"
 
 	"github.com/jinzhu/copier"
 
+	pb "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/bucketeer-io/bucketeer/v2/pkg/uuid"
@@ -30,6 +32,9 @@ import (
 
 type FlagTrigger struct {
 	*proto.FlagTrigger
+	// TokenHash is the SHA-256 hash of the token, used to look the trigger up
+	// since the stored token may be encrypted.
+	TokenHash string `json:"-"`
 }
 
 func NewFlagTrigger(
@@ -44,7 +49,7 @@ func NewFlagTrigger(
 	if err != nil {
 		return nil, err
 	}
-	return &FlagTrigger{&proto.FlagTrigger{
+	return &FlagTrigger{FlagTrigger: &proto.FlagTrigger{
 		Id:            triggerID.String(),
 		FeatureId:     featureId,
 		EnvironmentId: environmentId,
@@ -117,5 +122,18 @@ func (ft *FlagTrigger) GenerateToken() error {
 	h.Write([]byte(newTriggerUuid.String()))
 	hashed := h.Sum(nil)
 	ft.Token = base64.RawURLEncoding.EncodeToString(hashed)
+	ft.TokenHash = HashFlagTriggerToken(ft.Token)
 	return nil
 }
+
+func HashFlagTriggerToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// WithoutToken returns a copy of the trigger that can be stored in the domain events and audit logs.
+func (ft *FlagTrigger) WithoutToken() *proto.FlagTrigger {
+	masked := pb.Clone(ft.FlagTrigger).(*proto.FlagTrigger)
+	masked.Token = ""
+	return masked
+}
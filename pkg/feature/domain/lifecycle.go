@@ -0,0 +1,264 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	pkgErr "github.com/bucketeer-io/bucketeer/v2/pkg/error"
+	"github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+// SecondsRolledOutToRemove is how long a temporary flag can serve a single
+// variation before it is considered ready to be removed.
+const SecondsRolledOutToRemove = 30 * 24 * 60 * 60 // 30 days
+
+var (
+	errLifecycleKindUnknown = pkgErr.NewErrorInvalidArgUnknown(
+		pkgErr.FeaturePackageName, "feature: unknown flag kind", "kind")
+	errPlannedRemovalAtRequired = pkgErr.NewErrorInvalidArgEmpty(
+		pkgErr.FeaturePackageName,
+		"feature: planned removal date is required for release and experiment flags",
+		"planned_removal_at",
+	)
+	errPlannedRemovalAtInPast = pkgErr.NewErrorInvalidArgNotMatchFormat(
+		pkgErr.FeaturePackageName, "feature: planned removal date must be in the future", "planned_removal_at")
+)
+
+// LifecycleSignals carries what the lifecycle state is derived from, besides the flag itself.
+type LifecycleSignals struct {
+	// CodeRefCount is the number of code references to the flag.
+	CodeRefCount int64
+	// CodeRefsTracked is true when code references are reported for the environment.
+	// Without it, a flag with no code references is not assumed to be removed from the code.
+	CodeRefsTracked bool
+}
+
+// IsTemporaryKind reports whether flags of the kind are meant to be removed once rolled out.
+func IsTemporaryKind(kind feature.FeatureLifecycle_Kind) bool {
+	return kind == feature.FeatureLifecycle_RELEASE || kind == feature.FeatureLifecycle_EXPERIMENT
+}
+
+// UpdateLifecycle changes the kind and the planned removal date of the flag.
+// An unspecified kind and a nil date keep the current values. It reports whether anything changed.
+func (f *Feature) UpdateLifecycle(
+	kind feature.FeatureLifecycle_Kind,
+	plannedRemovalAt *wrapperspb.Int64Value,
+) (bool, error) {
+	if kind == feature.FeatureLifecycle_KIND_UNSPECIFIED && plannedRemovalAt == nil {
+		return false, nil
+	}
+	if _, ok := feature.FeatureLifecycle_Kind_name[int32(kind)]; !ok {
+		return false, errLifecycleKindUnknown
+	}
+	lifecycle := &feature.FeatureLifecycle{}
+	if f.Lifecycle != nil {
+		lifecycle = proto.Clone(f.Lifecycle).(*feature.FeatureLifecycle)
+	}
+	if kind != feature.FeatureLifecycle_KIND_UNSPECIFIED {
+		lifecycle.Kind = kind
+	}
+	now := time.Now().Unix()
+	if plannedRemovalAt != nil && plannedRemovalAt.Value != lifecycle.PlannedRemovalAt {
+		if plannedRemovalAt.Value != 0 && plannedRemovalAt.Value <= now {
+			return false, errPlannedRemovalAtInPast
+		}
+		lifecycle.PlannedRemovalAt = plannedRemovalAt.Value
+		// The maintainers are reminded again when the new date passes
+		lifecycle.RemovalRemindedAt = 0
+	}
+	if IsTemporaryKind(lifecycle.Kind) && lifecycle.PlannedRemovalAt == 0 {
+		return false, errPlannedRemovalAtRequired
+	}
+	if proto.Equal(f.Lifecycle, lifecycle) {
+		return false, nil
+	}
+	f.Lifecycle = lifecycle
+	f.UpdatedAt = now
+	return true, nil
+}
+
+// IsRemovalDue reports whether the flag is temporary and its planned removal date passed.
+func (f *Feature) IsRemovalDue(t time.Time) bool {
+	lifecycle := f.GetLifecycle()
+	if !IsTemporaryKind(lifecycle.GetKind()) || lifecycle.GetPlannedRemovalAt() == 0 {
+		return false
+	}
+	return t.Unix() >= lifecycle.PlannedRemovalAt
+}
+
+// DeriveLifecycleState returns the state the flag is in, given its usage, its code
+// references and its targeting. Kinds that are meant to stay, such as kill switches
+// and permissions, stay active until they are removed from the code.
+func (f *Feature) DeriveLifecycleState(signals LifecycleSignals, t time.Time) feature.FeatureLifecycle_State {
+	unused := f.LastUsedInfo != nil && f.IsStale(t)
+	if signals.CodeRefsTracked && signals.CodeRefCount == 0 && unused {
+		return feature.FeatureLifecycle_REMOVED_FROM_CODE
+	}
+	lifecycle := f.GetLifecycle()
+	kind := lifecycle.GetKind()
+	if kind != feature.FeatureLifecycle_KIND_UNSPECIFIED && !IsTemporaryKind(kind) {
+		return feature.FeatureLifecycle_ACTIVE
+	}
+	if !f.ServesSingleVariation() {
+		return feature.FeatureLifecycle_ACTIVE
+	}
+	if unused || f.IsRemovalDue(t) {
+		return feature.FeatureLifecycle_READY_TO_REMOVE
+	}
+	switch lifecycle.GetState() {
+	case feature.FeatureLifecycle_READY_TO_REMOVE:
+		return feature.FeatureLifecycle_READY_TO_REMOVE
+	case feature.FeatureLifecycle_ROLLED_OUT:
+		if t.Unix()-lifecycle.StateUpdatedAt >= SecondsRolledOutToRemove {
+			return feature.FeatureLifecycle_READY_TO_REMOVE
+		}
+	}
+	return feature.FeatureLifecycle_ROLLED_OUT
+}
+
+// SetLifecycleState records the derived state. It reports whether the state changed.
+// The version is left untouched because the state does not change what users get.
+func (f *Feature) SetLifecycleState(state feature.FeatureLifecycle_State, t time.Time) bool {
+	if f.Lifecycle == nil {
+		f.Lifecycle = &feature.FeatureLifecycle{}
+	}
+	if f.Lifecycle.State == state {
+		return false
+	}
+	f.Lifecycle.State = state
+	f.Lifecycle.StateUpdatedAt = t.Unix()
+	return true
+}
+
+// MarkRemovalReminded records that the maintainers were reminded of the planned removal date.
+func (f *Feature) MarkRemovalReminded(t time.Time) {
+	if f.Lifecycle == nil {
+		f.Lifecycle = &feature.FeatureLifecycle{}
+	}
+	f.Lifecycle.RemovalRemindedAt = t.Unix()
+}
+
+// IsRemovalReminderDue reports whether the planned removal date passed and
+// the maintainers have not been reminded since.
+func (f *Feature) IsRemovalReminderDue(t time.Time) bool {
+	return f.IsRemovalDue(t) && f.Lifecycle.RemovalRemindedAt < f.Lifecycle.PlannedRemovalAt
+}
+
+// ServesSingleVariation reports whether every user gets the same variation,
+// whatever the targets, rules and default strategy say.
+func (f *Feature) ServesSingleVariation() bool {
+	if !f.Enabled {
+		return f.OffVariation != ""
+	}
+	if f.ExperimentAllocation != nil {
+		return false
+	}
+	served := make(map[string]struct{})
+	if len(f.Prerequisites) > 0 && f.OffVariation != "" {
+		served[f.OffVariation] = struct{}{}
+	}
+	for _, t := range f.Targets {
+		if len(t.Users) > 0 {
+			served[t.Variation] = struct{}{}
+		}
+	}
+	for _, r := range f.Rules {
+		addServedVariations(served, r.Strategy)
+	}
+	addServedVariations(served, f.DefaultStrategy)
+	return len(served) == 1
+}
+
+func addServedVariations(served map[string]struct{}, strategy *feature.Strategy) {
+	if strategy == nil {
+		return
+	}
+	if strategy.Type == feature.Strategy_FIXED {
+		served[strategy.FixedStrategy.GetVariation()] = struct{}{}
+		return
+	}
+	rollout := strategy.RolloutStrategy
+	for _, v := range rollout.GetVariations() {
+		if v.Weight > 0 {
+			served[v.Variation] = struct{}{}
+		}
+	}
+	if audience := rollout.GetAudience(); audience.GetPercentage() > 0 && audience.GetPercentage() < 100 {
+		served[audience.DefaultVariation] = struct{}{}
+	}
+	if rollout.GetBucketBy() != "" && rollout.GetBucketByFallbackVariation() != "" {
+		served[rollout.BucketByFallbackVariation] = struct{}{}
+	}
+}
+
+// FlagDebtReport counts the flags by lifecycle state for each key returned by keys,
+// such as the flag tags. Flags without a key are counted under the empty key.
+// The entries with the most overdue flags come first.
+func FlagDebtReport(
+	features []*feature.Feature,
+	keys func(*feature.Feature) []string,
+	t time.Time,
+) []*feature.FlagDebtReportEntry {
+	entries := make(map[string]*feature.FlagDebtReportEntry)
+	for _, f := range features {
+		fkeys := keys(f)
+		if len(fkeys) == 0 {
+			fkeys = []string{""}
+		}
+		overdue := (&Feature{Feature: f}).IsRemovalDue(t)
+		for _, key := range fkeys {
+			entry, ok := entries[key]
+			if !ok {
+				entry = &feature.FlagDebtReportEntry{Key: key}
+				entries[key] = entry
+			}
+			entry.TotalCount++
+			switch f.GetLifecycle().GetState() {
+			case feature.FeatureLifecycle_ROLLED_OUT:
+				entry.RolledOutCount++
+			case feature.FeatureLifecycle_READY_TO_REMOVE:
+				entry.ReadyToRemoveCount++
+			case feature.FeatureLifecycle_REMOVED_FROM_CODE:
+				entry.RemovedFromCodeCount++
+			default:
+				entry.ActiveCount++
+			}
+			if overdue {
+				entry.OverdueCount++
+				entry.OverdueFeatureIds = append(entry.OverdueFeatureIds, f.Id)
+			}
+		}
+	}
+	report := make([]*feature.FlagDebtReportEntry, 0, len(entries))
+	for _, entry := range entries {
+		report = append(report, entry)
+	}
+	slices.SortFunc(report, func(a, b *feature.FlagDebtReportEntry) int {
+		if a.OverdueCount != b.OverdueCount {
+			return int(b.OverdueCount - a.OverdueCount)
+		}
+		if a.ReadyToRemoveCount != b.ReadyToRemoveCount {
+			return int(b.ReadyToRemoveCount - a.ReadyToRemoveCount)
+		}
+		return strings.Compare(a.Key, b.Key)
+	})
+	return report
+}
@@ -0,0 +1,407 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	ftproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+func makeRolledOutFeature(id string) *Feature {
+	f := makeFeature(id)
+	f.Targets = nil
+	f.Rules = nil
+	f.DefaultStrategy = &ftproto.Strategy{
+		Type:          ftproto.Strategy_FIXED,
+		FixedStrategy: &ftproto.FixedStrategy{Variation: "variation-A"},
+	}
+	return f
+}
+
+func TestUpdateLifecycle(t *testing.T) {
+	t.Parallel()
+	future := time.Now().Add(24 * time.Hour).Unix()
+	past := time.Now().Add(-24 * time.Hour).Unix()
+	patterns := []struct {
+		desc             string
+		current          *ftproto.FeatureLifecycle
+		kind             ftproto.FeatureLifecycle_Kind
+		plannedRemovalAt *wrapperspb.Int64Value
+		expected         *ftproto.FeatureLifecycle
+		expectedChanged  bool
+		expectedErr      error
+	}{
+		{
+			desc:     "no change requested",
+			current:  &ftproto.FeatureLifecycle{Kind: ftproto.FeatureLifecycle_OPERATIONAL},
+			expected: &ftproto.FeatureLifecycle{Kind: ftproto.FeatureLifecycle_OPERATIONAL},
+		},
+		{
+			desc:        "err: unknown kind",
+			kind:        ftproto.FeatureLifecycle_Kind(99),
+			expectedErr: errLifecycleKindUnknown,
+		},
+		{
+			desc:        "err: temporary kind without planned removal date",
+			kind:        ftproto.FeatureLifecycle_RELEASE,
+			expectedErr: errPlannedRemovalAtRequired,
+		},
+		{
+			desc: "err: clearing the planned removal date of a temporary kind",
+			current: &ftproto.FeatureLifecycle{
+				Kind:             ftproto.FeatureLifecycle_EXPERIMENT,
+				PlannedRemovalAt: future,
+			},
+			plannedRemovalAt: wrapperspb.Int64(0),
+			expectedErr:      errPlannedRemovalAtRequired,
+		},
+		{
+			desc:             "err: planned removal date in the past",
+			kind:             ftproto.FeatureLifecycle_RELEASE,
+			plannedRemovalAt: wrapperspb.Int64(past),
+			expectedErr:      errPlannedRemovalAtInPast,
+		},
+		{
+			desc:            "success: permanent kind without planned removal date",
+			kind:            ftproto.FeatureLifecycle_PERMISSION,
+			expected:        &ftproto.FeatureLifecycle{Kind: ftproto.FeatureLifecycle_PERMISSION},
+			expectedChanged: true,
+		},
+		{
+			desc:             "success: temporary kind",
+			kind:             ftproto.FeatureLifecycle_RELEASE,
+			plannedRemovalAt: wrapperspb.Int64(future),
+			expected: &ftproto.FeatureLifecycle{
+				Kind:             ftproto.FeatureLifecycle_RELEASE,
+				PlannedRemovalAt: future,
+			},
+			expectedChanged: true,
+		},
+		{
+			desc: "success: kind changed and past planned removal date kept",
+			current: &ftproto.FeatureLifecycle{
+				Kind:              ftproto.FeatureLifecycle_RELEASE,
+				PlannedRemovalAt:  past,
+				State:             ftproto.FeatureLifecycle_ROLLED_OUT,
+				RemovalRemindedAt: past,
+			},
+			kind: ftproto.FeatureLifecycle_EXPERIMENT,
+			expected: &ftproto.FeatureLifecycle{
+				Kind:              ftproto.FeatureLifecycle_EXPERIMENT,
+				PlannedRemovalAt:  past,
+				State:             ftproto.FeatureLifecycle_ROLLED_OUT,
+				RemovalRemindedAt: past,
+			},
+			expectedChanged: true,
+		},
+		{
+			desc: "success: postponed removal is reminded again",
+			current: &ftproto.FeatureLifecycle{
+				Kind:              ftproto.FeatureLifecycle_RELEASE,
+				PlannedRemovalAt:  past,
+				RemovalRemindedAt: past,
+			},
+			plannedRemovalAt: wrapperspb.Int64(future),
+			expected: &ftproto.FeatureLifecycle{
+				Kind:             ftproto.FeatureLifecycle_RELEASE,
+				PlannedRemovalAt: future,
+			},
+			expectedChanged: true,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			f := makeFeature("test-feature")
+			f.Lifecycle = p.current
+			changed, err := f.UpdateLifecycle(p.kind, p.plannedRemovalAt)
+			assert.Equal(t, p.expectedErr, err)
+			assert.Equal(t, p.expectedChanged, changed)
+			if err == nil {
+				assert.True(t, pb.Equal(p.expected, f.Lifecycle))
+			}
+		})
+	}
+}
+
+func TestServesSingleVariation(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc     string
+		update   func(f *Feature)
+		expected bool
+	}{
+		{
+			desc:     "targets and rules serve several variations",
+			update:   func(f *Feature) {},
+			expected: false,
+		},
+		{
+			desc: "disabled with off variation",
+			update: func(f *Feature) {
+				f.Enabled = false
+				f.OffVariation = "variation-B"
+			},
+			expected: true,
+		},
+		{
+			desc:     "default strategy only",
+			update:   func(f *Feature) { *f = *makeRolledOutFeature("test-feature") },
+			expected: true,
+		},
+		{
+			desc: "rollout with a single weighted variation",
+			update: func(f *Feature) {
+				*f = *makeRolledOutFeature("test-feature")
+				f.DefaultStrategy = &ftproto.Strategy{
+					Type: ftproto.Strategy_ROLLOUT,
+					RolloutStrategy: &ftproto.RolloutStrategy{
+						Variations: []*ftproto.RolloutStrategy_Variation{
+							{Variation: "variation-A", Weight: 100000},
+							{Variation: "variation-B", Weight: 0},
+						},
+					},
+				}
+			},
+			expected: true,
+		},
+		{
+			desc: "rollout with a partial audience",
+			update: func(f *Feature) {
+				*f = *makeRolledOutFeature("test-feature")
+				f.DefaultStrategy = &ftproto.Strategy{
+					Type: ftproto.Strategy_ROLLOUT,
+					RolloutStrategy: &ftproto.RolloutStrategy{
+						Variations: []*ftproto.RolloutStrategy_Variation{
+							{Variation: "variation-A", Weight: 100000},
+						},
+						Audience: &ftproto.Audience{Percentage: 50, DefaultVariation: "variation-B"},
+					},
+				}
+			},
+			expected: false,
+		},
+		{
+			desc: "prerequisite serves the off variation",
+			update: func(f *Feature) {
+				*f = *makeRolledOutFeature("test-feature")
+				f.OffVariation = "variation-C"
+				f.Prerequisites = []*ftproto.Prerequisite{{FeatureId: "other", VariationId: "v"}}
+			},
+			expected: false,
+		},
+		{
+			desc: "running experiment",
+			update: func(f *Feature) {
+				*f = *makeRolledOutFeature("test-feature")
+				f.ExperimentAllocation = &ftproto.ExperimentAllocation{ExperimentId: "exp-id"}
+			},
+			expected: false,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			f := makeFeature("test-feature")
+			p.update(f)
+			assert.Equal(t, p.expected, f.ServesSingleVariation())
+		})
+	}
+}
+
+func TestDeriveLifecycleState(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	stale := now.Unix() - SecondsToStale - 1
+	patterns := []struct {
+		desc     string
+		feature  *Feature
+		signals  LifecycleSignals
+		expected ftproto.FeatureLifecycle_State
+	}{
+		{
+			desc:     "serves several variations",
+			feature:  makeFeature("test-feature"),
+			expected: ftproto.FeatureLifecycle_ACTIVE,
+		},
+		{
+			desc:     "rolled out",
+			feature:  makeRolledOutFeature("test-feature"),
+			expected: ftproto.FeatureLifecycle_ROLLED_OUT,
+		},
+		{
+			desc: "permanent kind stays active when rolled out",
+			feature: func() *Feature {
+				f := makeRolledOutFeature("test-feature")
+				f.Lifecycle = &ftproto.FeatureLifecycle{Kind: ftproto.FeatureLifecycle_OPERATIONAL}
+				return f
+			}(),
+			expected: ftproto.FeatureLifecycle_ACTIVE,
+		},
+		{
+			desc: "rolled out and past the planned removal date",
+			feature: func() *Feature {
+				f := makeRolledOutFeature("test-feature")
+				f.Lifecycle = &ftproto.FeatureLifecycle{
+					Kind:             ftproto.FeatureLifecycle_RELEASE,
+					PlannedRemovalAt: now.Unix() - 1,
+				}
+				return f
+			}(),
+			expected: ftproto.FeatureLifecycle_READY_TO_REMOVE,
+		},
+		{
+			desc: "rolled out for long enough",
+			feature: func() *Feature {
+				f := makeRolledOutFeature("test-feature")
+				f.Lifecycle = &ftproto.FeatureLifecycle{
+					State:          ftproto.FeatureLifecycle_ROLLED_OUT,
+					StateUpdatedAt: now.Unix() - SecondsRolledOutToRemove,
+				}
+				return f
+			}(),
+			expected: ftproto.FeatureLifecycle_READY_TO_REMOVE,
+		},
+		{
+			desc: "rolled out and no longer requested",
+			feature: func() *Feature {
+				f := makeRolledOutFeature("test-feature")
+				f.LastUsedInfo = &ftproto.FeatureLastUsedInfo{LastUsedAt: stale}
+				return f
+			}(),
+			signals:  LifecycleSignals{CodeRefCount: 2, CodeRefsTracked: true},
+			expected: ftproto.FeatureLifecycle_READY_TO_REMOVE,
+		},
+		{
+			desc: "no code references left and no longer requested",
+			feature: func() *Feature {
+				f := makeFeature("test-feature")
+				f.Lifecycle = &ftproto.FeatureLifecycle{Kind: ftproto.FeatureLifecycle_PERMISSION}
+				f.LastUsedInfo = &ftproto.FeatureLastUsedInfo{LastUsedAt: stale}
+				return f
+			}(),
+			signals:  LifecycleSignals{CodeRefsTracked: true},
+			expected: ftproto.FeatureLifecycle_REMOVED_FROM_CODE,
+		},
+		{
+			desc: "code references not tracked",
+			feature: func() *Feature {
+				f := makeFeature("test-feature")
+				f.LastUsedInfo = &ftproto.FeatureLastUsedInfo{LastUsedAt: stale}
+				return f
+			}(),
+			expected: ftproto.FeatureLifecycle_ACTIVE,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			assert.Equal(t, p.expected, p.feature.DeriveLifecycleState(p.signals, now))
+		})
+	}
+}
+
+func TestSetLifecycleState(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	f := makeFeature("test-feature")
+	version := f.Version
+	assert.True(t, f.SetLifecycleState(ftproto.FeatureLifecycle_ROLLED_OUT, now))
+	assert.Equal(t, ftproto.FeatureLifecycle_ROLLED_OUT, f.Lifecycle.State)
+	assert.Equal(t, now.Unix(), f.Lifecycle.StateUpdatedAt)
+	assert.False(t, f.SetLifecycleState(ftproto.FeatureLifecycle_ROLLED_OUT, now.Add(time.Hour)))
+	assert.Equal(t, now.Unix(), f.Lifecycle.StateUpdatedAt)
+	assert.Equal(t, version, f.Version)
+}
+
+func TestIsRemovalReminderDue(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	f := makeFeature("test-feature")
+	assert.False(t, f.IsRemovalReminderDue(now))
+	f.Lifecycle = &ftproto.FeatureLifecycle{
+		Kind:             ftproto.FeatureLifecycle_RELEASE,
+		PlannedRemovalAt: now.Unix() + 60,
+	}
+	assert.False(t, f.IsRemovalReminderDue(now))
+	f.Lifecycle.PlannedRemovalAt = now.Unix() - 60
+	assert.True(t, f.IsRemovalReminderDue(now))
+	f.MarkRemovalReminded(now)
+	assert.False(t, f.IsRemovalReminderDue(now))
+	f.Lifecycle.Kind = ftproto.FeatureLifecycle_OPERATIONAL
+	f.Lifecycle.RemovalRemindedAt = 0
+	assert.False(t, f.IsRemovalReminderDue(now))
+}
+
+func TestFlagDebtReport(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	features := []*ftproto.Feature{
+		{
+			Id:   "f1",
+			Tags: []string{"web", "ios"},
+			Lifecycle: &ftproto.FeatureLifecycle{
+				Kind:             ftproto.FeatureLifecycle_RELEASE,
+				PlannedRemovalAt: now.Unix() - 60,
+				State:            ftproto.FeatureLifecycle_READY_TO_REMOVE,
+			},
+		},
+		{
+			Id:        "f2",
+			Tags:      []string{"web"},
+			Lifecycle: &ftproto.FeatureLifecycle{State: ftproto.FeatureLifecycle_ROLLED_OUT},
+		},
+		{
+			Id:   "f3",
+			Tags: []string{"web"},
+		},
+		{
+			Id:        "f4",
+			Lifecycle: &ftproto.FeatureLifecycle{State: ftproto.FeatureLifecycle_REMOVED_FROM_CODE},
+		},
+	}
+	report := FlagDebtReport(features, func(f *ftproto.Feature) []string { return f.Tags }, now)
+	require.Len(t, report, 3)
+	expected := []*ftproto.FlagDebtReportEntry{
+		{
+			Key:                "ios",
+			TotalCount:         1,
+			ReadyToRemoveCount: 1,
+			OverdueCount:       1,
+			OverdueFeatureIds:  []string{"f1"},
+		},
+		{
+			Key:                "web",
+			TotalCount:         3,
+			ActiveCount:        1,
+			RolledOutCount:     1,
+			ReadyToRemoveCount: 1,
+			OverdueCount:       1,
+			OverdueFeatureIds:  []string{"f1"},
+		},
+		{
+			Key:                  "",
+			TotalCount:           1,
+			RemovedFromCodeCount: 1,
+		},
+	}
+	for i := range expected {
+		assert.True(t, pb.Equal(expected[i], report[i]), report[i])
+	}
+}
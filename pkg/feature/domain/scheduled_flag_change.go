@@ -163,7 +163,9 @@ func (s *ScheduledFlagChange) DetermineCategory() proto.ScheduledChangeCategory
 		s.Payload.DefaultStrategy != nil
 
 	hasVariations := len(s.Payload.VariationChanges) > 0 ||
-		s.Payload.OffVariation != nil
+		s.Payload.OffVariation != nil ||
+		s.Payload.VariationValueSchema != nil ||
+		s.Payload.ClearVariationValueSchema != nil
 
 	// ResetSamplingSeed is excluded from hasSettings because it's a modifier
 	// that accompanies targeting/variation changes, not a standalone setting
@@ -171,8 +173,11 @@ func (s *ScheduledFlagChange) DetermineCategory() proto.ScheduledChangeCategory
 		s.Payload.Name != nil ||
 		s.Payload.Description != nil ||
 		len(s.Payload.TagChanges) > 0 ||
+		s.Payload.Tags != nil ||
 		s.Payload.Archived != nil ||
-		s.Payload.Maintainer != nil
+		s.Payload.Maintainer != nil ||
+		s.Payload.Kind != proto.FeatureLifecycle_KIND_UNSPECIFIED ||
+		s.Payload.PlannedRemovalAt != nil
 
 	categoryCount := 0
 	if hasTargeting {
@@ -219,12 +224,18 @@ const (
 	MsgKeyResetSamplingSeed       = "ScheduledChange.ResetSamplingSeed"
 	MsgKeyAddTag                  = "ScheduledChange.AddTag"
 	MsgKeyRemoveTag               = "ScheduledChange.RemoveTag"
+	MsgKeyUpdateTags              = "ScheduledChange.UpdateTags"
+	MsgKeyChangeKind              = "ScheduledChange.ChangeKind"
+	MsgKeyChangePlannedRemovalAt  = "ScheduledChange.ChangePlannedRemovalAt"
+	MsgKeyClearPlannedRemovalAt   = "ScheduledChange.ClearPlannedRemovalAt"
 	MsgKeyAddVariation            = "ScheduledChange.AddVariation"
 	MsgKeyUpdateVariation         = "ScheduledChange.UpdateVariation"
 	MsgKeyChangeVariationValue    = "ScheduledChange.ChangeVariationValue"
 	MsgKeyRenameVariation         = "ScheduledChange.RenameVariation"
 	MsgKeyDeleteVariation         = "ScheduledChange.DeleteVariation"
 	MsgKeyChangeOffVariation      = "ScheduledChange.ChangeOffVariation"
+	MsgKeyUpdateValueSchema       = "ScheduledChange.UpdateVariationValueSchema"
+	MsgKeyClearValueSchema        = "ScheduledChange.ClearVariationValueSchema"
 	MsgKeyAddRule                 = "ScheduledChange.AddRule"
 	MsgKeyUpdateRule              = "ScheduledChange.UpdateRule"
 	MsgKeyDeleteRule              = "ScheduledChange.DeleteRule"
@@ -234,6 +245,8 @@ const (
 	MsgKeyAddFeatureFlagClause    = "ScheduledChange.AddFeatureFlagClauseToRule"
 	MsgKeyUpdateFeatureFlagClause = "ScheduledChange.UpdateFeatureFlagClauseInRule"
 	MsgKeyRemoveFeatureFlagClause = "ScheduledChange.RemoveFeatureFlagClauseFromRule"
+	MsgKeyRenameRule              = "ScheduledChange.RenameRule"
+	MsgKeyUpdateRuleClauseGroups  = "ScheduledChange.UpdateRuleClauseGroups"
 	MsgKeyTargetUsers             = "ScheduledChange.TargetUsers"
 	MsgKeyRemoveTargeting         = "ScheduledChange.RemoveTargeting"
 	MsgKeyAddPrerequisite         = "ScheduledChange.AddPrerequisite"
@@ -332,6 +345,36 @@ func (s *ScheduledFlagChange) GenerateChangeSummariesWithOptions(
 		}
 	}
 
+	// Replaced tags (Settings)
+	if s.Payload.Tags != nil {
+		summaries = append(summaries, newChangeSummary(MsgKeyUpdateTags, map[string]string{
+			"tags": strings.Join(s.Payload.Tags.Values, ", "),
+		}))
+	}
+
+	// Lifecycle changes (Settings)
+	if s.Payload.Kind != proto.FeatureLifecycle_KIND_UNSPECIFIED {
+		summaries = append(summaries, newChangeSummary(MsgKeyChangeKind, map[string]string{
+			"kind": s.Payload.Kind.String(),
+		}))
+	}
+	if s.Payload.PlannedRemovalAt != nil {
+		if s.Payload.PlannedRemovalAt.Value == 0 {
+			summaries = append(summaries, newChangeSummary(MsgKeyClearPlannedRemovalAt, nil))
+		} else {
+			summaries = append(summaries, newChangeSummary(MsgKeyChangePlannedRemovalAt, map[string]string{
+				"date": time.Unix(s.Payload.PlannedRemovalAt.Value, 0).UTC().Format(time.DateOnly),
+			}))
+		}
+	}
+
+	// Variation value schema change (Variations)
+	if s.Payload.ClearVariationValueSchema.GetValue() {
+		summaries = append(summaries, newChangeSummary(MsgKeyClearValueSchema, nil))
+	} else if s.Payload.VariationValueSchema != nil {
+		summaries = append(summaries, newChangeSummary(MsgKeyUpdateValueSchema, nil))
+	}
+
 	// Variation changes (Variations)
 	for _, vc := range s.Payload.VariationChanges {
 		if vc.Variation == nil {
@@ -522,6 +565,18 @@ func (s *ScheduledFlagChange) CountChanges() int {
 	if len(s.Payload.OrderedRuleIds) > 0 {
 		count++
 	}
+	if s.Payload.Tags != nil {
+		count++
+	}
+	if s.Payload.VariationValueSchema != nil || s.Payload.ClearVariationValueSchema != nil {
+		count++
+	}
+	if s.Payload.Kind != proto.FeatureLifecycle_KIND_UNSPECIFIED {
+		count++
+	}
+	if s.Payload.PlannedRemovalAt != nil {
+		count++
+	}
 	return count
 }
 
@@ -565,10 +620,14 @@ func sfcGetVariationName(flag *proto.Feature, variationID string) string {
 }
 
 func sfcDescribeRule(rule *proto.Rule, flag *proto.Feature, options *ChangeSummaryOptions) string {
-	if rule == nil || len(rule.Clauses) == 0 {
+	if rule != nil && rule.Name != "" {
+		return rule.Name
+	}
+	clauses := RuleClauses(rule)
+	if len(clauses) == 0 {
 		return "(no conditions)"
 	}
-	return sfcDescribeClause(rule.Clauses[0], flag, options)
+	return sfcDescribeClause(clauses[0], flag, options)
 }
 
 func sfcBuildRuleClauseSummaries(
@@ -580,16 +639,35 @@ func sfcBuildRuleClauseSummaries(
 	ruleLabel := sfcRuleLabel(flag, newRule.Id)
 	var summaries []*proto.ChangeSummary
 
-	oldClauseByKey := make(map[string]*proto.Clause, len(originalRule.Clauses))
-	for i, clause := range originalRule.Clauses {
+	// Clauses are matched across the whole rule, so moving a clause between
+	// clause groups is reported as a group change rather than a remove and add.
+	oldClauses := RuleClauses(originalRule)
+	newClauses := RuleClauses(newRule)
+	oldClauseByKey := make(map[string]*proto.Clause, len(oldClauses))
+	for i, clause := range oldClauses {
 		oldClauseByKey[sfcClauseKey(clause, i)] = clause
 	}
-	newClauseByKey := make(map[string]*proto.Clause, len(newRule.Clauses))
-	for i, clause := range newRule.Clauses {
+	newClauseByKey := make(map[string]*proto.Clause, len(newClauses))
+	for i, clause := range newClauses {
 		newClauseByKey[sfcClauseKey(clause, i)] = clause
 	}
 
-	for i, newClause := range newRule.Clauses {
+	if originalRule.Name != newRule.Name {
+		summaries = append(summaries, newChangeSummary(MsgKeyRenameRule, map[string]string{
+			"rule":    ruleLabel,
+			"oldName": originalRule.Name,
+			"newName": newRule.Name,
+		}))
+	}
+	if sfcClauseGroupsShape(originalRule) != sfcClauseGroupsShape(newRule) {
+		summaries = append(summaries, newChangeSummary(MsgKeyUpdateRuleClauseGroups, map[string]string{
+			"rule":      ruleLabel,
+			"oldGroups": sfcDescribeClauseGroups(originalRule.ClauseGroups, flag, options),
+			"newGroups": sfcDescribeClauseGroups(newRule.ClauseGroups, flag, options),
+		}))
+	}
+
+	for i, newClause := range newClauses {
 		key := sfcClauseKey(newClause, i)
 		oldClause, exists := oldClauseByKey[key]
 		if !exists {
@@ -617,7 +695,7 @@ func sfcBuildRuleClauseSummaries(
 		}))
 	}
 
-	for i, oldClause := range originalRule.Clauses {
+	for i, oldClause := range oldClauses {
 		key := sfcClauseKey(oldClause, i)
 		if _, exists := newClauseByKey[key]; exists {
 			continue
@@ -640,12 +718,65 @@ func sfcRuleLabel(flag *proto.Feature, ruleID string) string {
 	}
 	for i, rule := range flag.Rules {
 		if rule.Id == ruleID {
+			if rule.Name != "" {
+				return fmt.Sprintf("rule #%d (%s)", i+1, rule.Name)
+			}
 			return fmt.Sprintf("rule #%d", i+1)
 		}
 	}
 	return "rule"
 }
 
+// sfcClauseGroupsShape returns the operators and clause placement of the
+// rule's clause groups, ignoring clause contents, which are diffed separately.
+func sfcClauseGroupsShape(rule *proto.Rule) string {
+	var sb strings.Builder
+	var write func(groups []*proto.ClauseGroup)
+	write = func(groups []*proto.ClauseGroup) {
+		for _, g := range groups {
+			sb.WriteString(g.Operator.String())
+			sb.WriteString("[")
+			for i, c := range g.Clauses {
+				sb.WriteString(sfcClauseKey(c, i))
+				sb.WriteString(",")
+			}
+			write(g.Groups)
+			sb.WriteString("]")
+		}
+	}
+	write(rule.GetClauseGroups())
+	return sb.String()
+}
+
+func sfcDescribeClauseGroups(
+	groups []*proto.ClauseGroup,
+	flag *proto.Feature,
+	options *ChangeSummaryOptions,
+) string {
+	if len(groups) == 0 {
+		return "(no groups)"
+	}
+	descs := make([]string, 0, len(groups))
+	for _, g := range groups {
+		descs = append(descs, sfcDescribeClauseGroup(g, flag, options))
+	}
+	return strings.Join(descs, " AND ")
+}
+
+func sfcDescribeClauseGroup(group *proto.ClauseGroup, flag *proto.Feature, options *ChangeSummaryOptions) string {
+	children := make([]string, 0, len(group.Clauses)+len(group.Groups))
+	for _, c := range group.Clauses {
+		children = append(children, sfcDescribeClause(c, flag, options))
+	}
+	for _, g := range group.Groups {
+		children = append(children, sfcDescribeClauseGroup(g, flag, options))
+	}
+	if group.Operator == proto.ClauseGroup_NOT {
+		return fmt.Sprintf("NOT (%s)", strings.Join(children, " AND "))
+	}
+	return fmt.Sprintf("(%s)", strings.Join(children, " "+group.Operator.String()+" "))
+}
+
 func sfcClauseKey(clause *proto.Clause, index int) string {
 	if clause == nil {
 		return fmt.Sprintf("idx-%d", index)
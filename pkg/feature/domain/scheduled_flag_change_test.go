@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	"github.com/bucketeer-io/bucketeer/v2/proto/common"
 	proto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
 )
 
@@ -673,6 +674,34 @@ func TestGenerateChangeSummaries_MultipleChanges(t *testing.T) {
 	assert.Equal(t, MsgKeyAddTag, summaries[3].MessageKey)
 }
 
+func TestGenerateChangeSummaries_TagsSchemaAndLifecycle(t *testing.T) {
+	t.Parallel()
+
+	plannedRemovalAt := time.Date(2030, 1, 2, 12, 0, 0, 0, time.UTC).Unix()
+	sfc := &ScheduledFlagChange{
+		ScheduledFlagChange: &proto.ScheduledFlagChange{
+			Payload: &proto.ScheduledChangePayload{
+				Tags:                      &common.StringListValue{Values: []string{"web", "ios"}},
+				ClearVariationValueSchema: wrapperspb.Bool(true),
+				Kind:                      proto.FeatureLifecycle_RELEASE,
+				PlannedRemovalAt:          wrapperspb.Int64(plannedRemovalAt),
+			},
+		},
+	}
+
+	assert.Equal(t, 4, sfc.CountChanges())
+	assert.Equal(t, proto.ScheduledChangeCategory_SCHEDULED_CHANGE_CATEGORY_MIXED, sfc.DetermineCategory())
+	summaries := sfc.GenerateChangeSummaries(nil)
+	require.Len(t, summaries, 4)
+	assert.Equal(t, MsgKeyUpdateTags, summaries[0].MessageKey)
+	assert.Equal(t, "web, ios", summaries[0].Values["tags"])
+	assert.Equal(t, MsgKeyChangeKind, summaries[1].MessageKey)
+	assert.Equal(t, "RELEASE", summaries[1].Values["kind"])
+	assert.Equal(t, MsgKeyChangePlannedRemovalAt, summaries[2].MessageKey)
+	assert.Equal(t, "2030-01-02", summaries[2].Values["date"])
+	assert.Equal(t, MsgKeyClearValueSchema, summaries[3].MessageKey)
+}
+
 func TestGenerateChangeSummaries_NilPayload(t *testing.T) {
 	t.Parallel()
 
@@ -1082,3 +1111,101 @@ func TestGenerateChangeSummaries_WithResolutionOptions(t *testing.T) {
 	assert.Contains(t, summaries[1].Values["oldClause"], "Control (var-a)")
 	assert.Contains(t, summaries[1].Values["newClause"], "Enabled (var-b)")
 }
+
+func TestGenerateChangeSummaries_RuleNameAndClauseGroups(t *testing.T) {
+	t.Parallel()
+
+	jp := &proto.Clause{Id: "clause-jp", Attribute: "country", Operator: proto.Clause_EQUALS, Values: []string{"jp"}}
+	pro := &proto.Clause{Id: "clause-pro", Attribute: "plan", Operator: proto.Clause_EQUALS, Values: []string{"pro"}}
+	beta := &proto.Clause{Id: "clause-beta", Attribute: "beta", Operator: proto.Clause_EQUALS, Values: []string{"true"}}
+	baseFlag := &proto.Feature{
+		Rules: []*proto.Rule{
+			{
+				Id:      "rule-1",
+				Name:    "JP pro",
+				Clauses: []*proto.Clause{jp},
+				ClauseGroups: []*proto.ClauseGroup{
+					{Operator: proto.ClauseGroup_AND, Clauses: []*proto.Clause{pro, beta}},
+				},
+			},
+		},
+	}
+
+	sfc := &ScheduledFlagChange{
+		ScheduledFlagChange: &proto.ScheduledFlagChange{
+			Payload: &proto.ScheduledChangePayload{
+				RuleChanges: []*proto.RuleChange{
+					{
+						ChangeType: proto.ChangeType_UPDATE,
+						Rule: &proto.Rule{
+							Id:      "rule-1",
+							Name:    "JP pro or beta",
+							Clauses: []*proto.Clause{jp},
+							ClauseGroups: []*proto.ClauseGroup{
+								{Operator: proto.ClauseGroup_OR, Clauses: []*proto.Clause{pro, beta}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	summaries := sfc.GenerateChangeSummaries(baseFlag)
+	require.Len(t, summaries, 2)
+	assert.Equal(t, MsgKeyRenameRule, summaries[0].MessageKey)
+	assert.Equal(t, "rule #1 (JP pro)", summaries[0].Values["rule"])
+	assert.Equal(t, "JP pro", summaries[0].Values["oldName"])
+	assert.Equal(t, "JP pro or beta", summaries[0].Values["newName"])
+	assert.Equal(t, MsgKeyUpdateRuleClauseGroups, summaries[1].MessageKey)
+	assert.Equal(t, "(plan EQUALS pro AND beta EQUALS true)", summaries[1].Values["oldGroups"])
+	assert.Equal(t, "(plan EQUALS pro OR beta EQUALS true)", summaries[1].Values["newGroups"])
+}
+
+func TestGenerateChangeSummaries_ClauseInGroupUpdated(t *testing.T) {
+	t.Parallel()
+
+	baseFlag := &proto.Feature{
+		Rules: []*proto.Rule{
+			{
+				Id: "rule-1",
+				ClauseGroups: []*proto.ClauseGroup{
+					{
+						Operator: proto.ClauseGroup_NOT,
+						Clauses: []*proto.Clause{
+							{Id: "clause-a", Attribute: "email", Operator: proto.Clause_ENDS_WITH, Values: []string{"@example.com"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	sfc := &ScheduledFlagChange{
+		ScheduledFlagChange: &proto.ScheduledFlagChange{
+			Payload: &proto.ScheduledChangePayload{
+				RuleChanges: []*proto.RuleChange{
+					{
+						ChangeType: proto.ChangeType_UPDATE,
+						Rule: &proto.Rule{
+							Id: "rule-1",
+							ClauseGroups: []*proto.ClauseGroup{
+								{
+									Operator: proto.ClauseGroup_NOT,
+									Clauses: []*proto.Clause{
+										{Id: "clause-a", Attribute: "email", Operator: proto.Clause_ENDS_WITH, Values: []string{"@corp.io"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	summaries := sfc.GenerateChangeSummaries(baseFlag)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, MsgKeyUpdateClauseInRule, summaries[0].MessageKey)
+	assert.Contains(t, summaries[0].Values["oldClause"], "@example.com")
+	assert.Contains(t, summaries[0].Values["newClause"], "@corp.io")
+}
@@ -402,7 +402,7 @@ func (d *ConflictDetector) validateFeatureFlagReferences(
 			continue
 		}
 
-		for _, clause := range rc.Rule.Clauses {
+		for _, clause := range featuredomain.RuleClauses(rc.Rule) {
 			if clause == nil || clause.Operator != proto.Clause_FEATURE_FLAG {
 				continue
 			}
@@ -488,7 +488,7 @@ func scheduleReferencesFlag(payload *proto.ScheduledChangePayload, targetFlagID
 	// In this case, the clause's attribute field contains the referenced feature ID
 	for _, rc := range payload.RuleChanges {
 		if rc != nil && rc.Rule != nil {
-			for _, clause := range rc.Rule.Clauses {
+			for _, clause := range featuredomain.RuleClauses(rc.Rule) {
 				if clause != nil &&
 					clause.Operator == proto.Clause_FEATURE_FLAG &&
 					clause.Attribute == targetFlagID {
@@ -0,0 +1,68 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mock/$GOFILE
+package v2
+
+import (
+	"context"
+
+	pkgErr "github.com/bucketeer-io/bucketeer/v2/pkg/error"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+var (
+	ErrChangeRequestAlreadyExists = pkgErr.NewErrorAlreadyExists(
+		pkgErr.FeaturePackageName,
+		"change request already exists",
+	)
+	ErrChangeRequestNotFound = pkgErr.NewErrorNotFound(
+		pkgErr.FeaturePackageName,
+		"change request not found",
+		"change_request",
+	)
+	ErrChangeRequestUnexpectedAffectedRows = pkgErr.NewErrorUnexpectedAffectedRows(
+		pkgErr.FeaturePackageName,
+		"change request unexpected affected rows",
+	)
+)
+
+// ListChangeRequestsParams carries list intent for ListChangeRequests
+// without database-specific types.
+type ListChangeRequestsParams struct {
+	EnvironmentID  string
+	FeatureID      string
+	Statuses       []proto.ChangeRequestStatus
+	OrderBy        proto.ListChangeRequestsRequest_OrderBy
+	OrderDirection proto.ListChangeRequestsRequest_OrderDirection
+	// PageSize is the row limit; use database.QueryNoLimit for an uncapped list.
+	PageSize int
+	Offset   int
+}
+
+// ChangeRequestStorage defines the interface for change request storage operations
+type ChangeRequestStorage interface {
+	// CreateChangeRequest creates a new change request
+	CreateChangeRequest(ctx context.Context, cr *domain.ChangeRequest) error
+	// UpdateChangeRequest updates an existing change request
+	UpdateChangeRequest(ctx context.Context, cr *domain.ChangeRequest) error
+	// GetChangeRequest retrieves a change request by ID
+	GetChangeRequest(ctx context.Context, id, environmentID string) (*domain.ChangeRequest, error)
+	// ListChangeRequests lists change requests with filtering and pagination
+	ListChangeRequests(
+		ctx context.Context,
+		params ListChangeRequestsParams,
+	) ([]*proto.ChangeRequest, int, int64, error)
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+
+	pb "google.golang.org/protobuf/proto"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/crypto"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+// EncryptedFlagTriggerStorage stores the flag trigger tokens encrypted by a SecretCipher.
+type EncryptedFlagTriggerStorage interface {
+	FlagTriggerStorage
+	crypto.SecretRotator
+}
+
+type encryptedFlagTriggerStorage struct {
+	FlagTriggerStorage
+	cipher crypto.SecretCipher
+}
+
+func NewEncryptedFlagTriggerStorage(
+	storage FlagTriggerStorage,
+	cipher crypto.SecretCipher,
+) EncryptedFlagTriggerStorage {
+	return &encryptedFlagTriggerStorage{FlagTriggerStorage: storage, cipher: cipher}
+}
+
+func (s *encryptedFlagTriggerStorage) CreateFlagTrigger(
+	ctx context.Context,
+	flagTrigger *domain.FlagTrigger,
+) error {
+	encrypted, err := s.encrypt(ctx, flagTrigger)
+	if err != nil {
+		return err
+	}
+	return s.FlagTriggerStorage.CreateFlagTrigger(ctx, encrypted)
+}
+
+func (s *encryptedFlagTriggerStorage) UpdateFlagTrigger(
+	ctx context.Context,
+	flagTrigger *domain.FlagTrigger,
+) error {
+	encrypted, err := s.encrypt(ctx, flagTrigger)
+	if err != nil {
+		return err
+	}
+	return s.FlagTriggerStorage.UpdateFlagTrigger(ctx, encrypted)
+}
+
+func (s *encryptedFlagTriggerStorage) GetFlagTrigger(
+	ctx context.Context,
+	id, environmentId string,
+) (*domain.FlagTrigger, error) {
+	flagTrigger, err := s.FlagTriggerStorage.GetFlagTrigger(ctx, id, environmentId)
+	if err != nil {
+		return nil, err
+	}
+	if flagTrigger.Token, err = s.cipher.Decrypt(ctx, flagTrigger.Token); err != nil {
+		return nil, err
+	}
+	return flagTrigger, nil
+}
+
+func (s *encryptedFlagTriggerStorage) GetFlagTriggerByToken(
+	ctx context.Context,
+	token string,
+) (*domain.FlagTrigger, error) {
+	flagTrigger, err := s.FlagTriggerStorage.GetFlagTriggerByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if flagTrigger.Token, err = s.cipher.Decrypt(ctx, flagTrigger.Token); err != nil {
+		return nil, err
+	}
+	return flagTrigger, nil
+}
+
+func (s *encryptedFlagTriggerStorage) ListFlagTriggers(
+	ctx context.Context,
+	params ListFlagTriggersParams,
+) ([]*proto.FlagTrigger, int, int64, error) {
+	flagTriggers, nextOffset, totalCount, err := s.FlagTriggerStorage.ListFlagTriggers(ctx, params)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	for _, ft := range flagTriggers {
+		if ft.Token, err = s.cipher.Decrypt(ctx, ft.Token); err != nil {
+			return nil, 0, 0, err
+		}
+	}
+	return flagTriggers, nextOffset, totalCount, nil
+}
+
+func (s *encryptedFlagTriggerStorage) RotateSecrets(ctx context.Context, environmentID string) (int, error) {
+	flagTriggers, _, _, err := s.FlagTriggerStorage.ListFlagTriggers(ctx, ListFlagTriggersParams{
+		EnvironmentID: environmentID,
+		PageSize:      database.QueryNoLimit,
+	})
+	if err != nil {
+		return 0, err
+	}
+	rotated := 0
+	for _, ft := range flagTriggers {
+		needsRotation, err := s.cipher.NeedsRotation(ctx, environmentID, ft.Token)
+		if err != nil {
+			return rotated, err
+		}
+		if !needsRotation {
+			continue
+		}
+		flagTrigger, err := s.GetFlagTrigger(ctx, ft.Id, environmentID)
+		if err != nil {
+			return rotated, err
+		}
+		if err := s.UpdateFlagTrigger(ctx, flagTrigger); err != nil {
+			return rotated, err
+		}
+		rotated++
+	}
+	return rotated, nil
+}
+
+// encrypt returns a copy of the trigger with its token encrypted, since the caller
+// still needs the plaintext token to build the trigger URL.
+func (s *encryptedFlagTriggerStorage) encrypt(
+	ctx context.Context,
+	flagTrigger *domain.FlagTrigger,
+) (*domain.FlagTrigger, error) {
+	token, err := s.cipher.Encrypt(ctx, flagTrigger.EnvironmentId, flagTrigger.Token)
+	if err != nil {
+		return nil, err
+	}
+	encrypted := &domain.FlagTrigger{
+		FlagTrigger: pb.Clone(flagTrigger.FlagTrigger).(*proto.FlagTrigger),
+		TokenHash:   flagTrigger.TokenHash,
+	}
+	if encrypted.TokenHash == "" && flagTrigger.Token != "" {
+		encrypted.TokenHash = domain.HashFlagTriggerToken(flagTrigger.Token)
+	}
+	encrypted.Token = token
+	return encrypted, nil
+}
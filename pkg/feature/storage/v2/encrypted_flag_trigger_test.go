@@ -0,0 +1,125 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	cryptomock "github.com/bucketeer-io/bucketeer/v2/pkg/crypto/mock"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+type fakeFlagTriggerStorage struct {
+	FlagTriggerStorage
+	flagTriggers map[string]*domain.FlagTrigger
+}
+
+func (s *fakeFlagTriggerStorage) CreateFlagTrigger(_ context.Context, ft *domain.FlagTrigger) error {
+	s.flagTriggers[ft.Id] = ft
+	return nil
+}
+
+func (s *fakeFlagTriggerStorage) UpdateFlagTrigger(_ context.Context, ft *domain.FlagTrigger) error {
+	s.flagTriggers[ft.Id] = ft
+	return nil
+}
+
+func (s *fakeFlagTriggerStorage) GetFlagTrigger(_ context.Context, id, _ string) (*domain.FlagTrigger, error) {
+	ft := s.flagTriggers[id]
+	return &domain.FlagTrigger{FlagTrigger: ft.FlagTrigger, TokenHash: ft.TokenHash}, nil
+}
+
+func (s *fakeFlagTriggerStorage) ListFlagTriggers(
+	_ context.Context,
+	_ ListFlagTriggersParams,
+) ([]*proto.FlagTrigger, int, int64, error) {
+	var flagTriggers []*proto.FlagTrigger
+	for _, ft := range s.flagTriggers {
+		flagTriggers = append(flagTriggers, &proto.FlagTrigger{Id: ft.Id, Token: ft.Token})
+	}
+	return flagTriggers, 0, int64(len(flagTriggers)), nil
+}
+
+func newTestSecretCipher(t *testing.T, mockController *gomock.Controller) *cryptomock.MockSecretCipher {
+	t.Helper()
+	cipher := cryptomock.NewMockSecretCipher(mockController)
+	cipher.EXPECT().Encrypt(gomock.Any(), "env-0", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, plaintext string) (string, error) {
+			return "enc:" + plaintext, nil
+		},
+	).AnyTimes()
+	cipher.EXPECT().Decrypt(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, value string) (string, error) {
+			return strings.TrimPrefix(value, "enc:"), nil
+		},
+	).AnyTimes()
+	return cipher
+}
+
+func TestEncryptedFlagTriggerStorageCreateAndGet(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	inner := &fakeFlagTriggerStorage{flagTriggers: map[string]*domain.FlagTrigger{}}
+	s := NewEncryptedFlagTriggerStorage(inner, newTestSecretCipher(t, mockController))
+
+	ft := &domain.FlagTrigger{FlagTrigger: &proto.FlagTrigger{Id: "id-0", EnvironmentId: "env-0"}}
+	require.NoError(t, ft.GenerateToken())
+	token := ft.Token
+	require.NoError(t, s.CreateFlagTrigger(context.Background(), ft))
+	// The caller keeps the plaintext token to build the trigger URL.
+	assert.Equal(t, token, ft.Token)
+	assert.Equal(t, "enc:"+token, inner.flagTriggers["id-0"].Token)
+	assert.Equal(t, domain.HashFlagTriggerToken(token), inner.flagTriggers["id-0"].TokenHash)
+
+	actual, err := s.GetFlagTrigger(context.Background(), "id-0", "env-0")
+	require.NoError(t, err)
+	assert.Equal(t, token, actual.Token)
+	list, _, _, err := s.ListFlagTriggers(context.Background(), ListFlagTriggersParams{EnvironmentID: "env-0"})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, token, list[0].Token)
+}
+
+func TestEncryptedFlagTriggerStorageRotateSecrets(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	inner := &fakeFlagTriggerStorage{flagTriggers: map[string]*domain.FlagTrigger{
+		"id-0": {FlagTrigger: &proto.FlagTrigger{Id: "id-0", EnvironmentId: "env-0", Token: "plaintext"}},
+		"id-1": {FlagTrigger: &proto.FlagTrigger{Id: "id-1", EnvironmentId: "env-0", Token: "enc:current"}},
+	}}
+	cipher := newTestSecretCipher(t, mockController)
+	cipher.EXPECT().NeedsRotation(gomock.Any(), "env-0", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, value string) (bool, error) {
+			return !strings.HasPrefix(value, "enc:"), nil
+		},
+	).Times(2)
+	s := NewEncryptedFlagTriggerStorage(inner, cipher)
+
+	rotated, err := s.RotateSecrets(context.Background(), "env-0")
+	require.NoError(t, err)
+	assert.Equal(t, 1, rotated)
+	assert.Equal(t, "enc:plaintext", inner.flagTriggers["id-0"].Token)
+	assert.Equal(t, domain.HashFlagTriggerToken("plaintext"), inner.flagTriggers["id-0"].TokenHash)
+	assert.Equal(t, "enc:current", inner.flagTriggers["id-1"].Token)
+}
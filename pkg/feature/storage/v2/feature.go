@@ -71,6 +71,13 @@ type ListFeaturesFilteredByExperimentParams struct {
 type FeatureStorage interface {
 	CreateFeature(ctx context.Context, feature *domain.Feature, environmentID string) error
 	UpdateFeature(ctx context.Context, feature *domain.Feature, environmentID string) error
+	// UpdateFeatureLifecycle writes the lifecycle only, leaving the version and the rest of the flag untouched.
+	UpdateFeatureLifecycle(
+		ctx context.Context,
+		id string,
+		lifecycle *proto.FeatureLifecycle,
+		environmentID string,
+	) error
 	GetFeature(ctx context.Context, id, environmentID string) (*domain.Feature, error)
 	GetFeatureByVersion(ctx context.Context, id string, version int32, environmentID string) (*domain.Feature, error)
 	ListFeatures(ctx context.Context, p ListFeaturesParams) ([]*proto.Feature, int, int64, error)
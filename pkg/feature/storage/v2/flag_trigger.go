@@ -47,6 +47,7 @@ var (
 
 // ListFlagTriggersParams carries list intent for ListFlagTriggers without database-specific types.
 type ListFlagTriggersParams struct {
+	// FeatureID filters on the feature; empty lists the triggers of the whole environment.
 	FeatureID      string
 	EnvironmentID  string
 	OrderBy        proto.ListFlagTriggersRequest_OrderBy
@@ -179,3 +179,17 @@ func (mr *MockFeatureStorageMockRecorder) UpdateFeature(ctx, feature, environmen
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFeature", reflect.TypeOf((*MockFeatureStorage)(nil).UpdateFeature), ctx, feature, environmentID)
 }
+
+// UpdateFeatureLifecycle mocks base method.
+func (m *MockFeatureStorage) UpdateFeatureLifecycle(ctx context.Context, id string, lifecycle *feature.FeatureLifecycle, environmentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFeatureLifecycle", ctx, id, lifecycle, environmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFeatureLifecycle indicates an expected call of UpdateFeatureLifecycle.
+func (mr *MockFeatureStorageMockRecorder) UpdateFeatureLifecycle(ctx, id, lifecycle, environmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFeatureLifecycle", reflect.TypeOf((*MockFeatureStorage)(nil).UpdateFeatureLifecycle), ctx, id, lifecycle, environmentID)
+}
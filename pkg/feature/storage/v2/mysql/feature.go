@@ -36,6 +36,8 @@ var (
 	createFeatureSQLQuery string
 	//go:embed sql/feature/update_feature.sql
 	updateFeatureSQLQuery string
+	//go:embed sql/feature/update_feature_lifecycle.sql
+	updateFeatureLifecycleSQLQuery string
 	//go:embed sql/feature/select_all_environment_features.sql
 	selectAllEnvironmentFeaturesSQLQuery string
 	//go:embed sql/feature/select_features_by_environment.sql
@@ -94,6 +96,8 @@ func (s *featureStorage) CreateFeature(
 		feature.Maintainer,
 		feature.SamplingSeed,
 		mysqlstorage.JSONObject{Val: feature.Prerequisites},
+		mysqlstorage.JSONObject{Val: feature.ExperimentAllocation},
+		mysqlstorage.JSONObject{Val: feature.Lifecycle},
 		environmentID,
 	)
 	if err != nil {
@@ -134,6 +138,8 @@ func (s *featureStorage) UpdateFeature(
 		feature.Maintainer,
 		feature.SamplingSeed,
 		mysqlstorage.JSONObject{Val: feature.Prerequisites},
+		mysqlstorage.JSONObject{Val: feature.ExperimentAllocation},
+		mysqlstorage.JSONObject{Val: feature.Lifecycle},
 		feature.Id,
 		environmentID,
 	)
@@ -150,6 +156,32 @@ func (s *featureStorage) UpdateFeature(
 	return nil
 }
 
+func (s *featureStorage) UpdateFeatureLifecycle(
+	ctx context.Context,
+	id string,
+	lifecycle *proto.FeatureLifecycle,
+	environmentID string,
+) error {
+	result, err := s.qe.ExecContext(
+		ctx,
+		updateFeatureLifecycleSQLQuery,
+		mysqlstorage.JSONObject{Val: lifecycle},
+		id,
+		environmentID,
+	)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return v2fs.ErrFeatureUnexpectedAffectedRows
+	}
+	return nil
+}
+
 func (s *featureStorage) GetFeature(
 	ctx context.Context,
 	id, environmentID string,
@@ -184,6 +216,8 @@ func (s *featureStorage) GetFeature(
 		&feature.Maintainer,
 		&feature.SamplingSeed,
 		&mysqlstorage.JSONObject{Val: &feature.Prerequisites},
+		&mysqlstorage.JSONObject{Val: &feature.ExperimentAllocation},
+		&mysqlstorage.JSONObject{Val: &feature.Lifecycle},
 	)
 	if err != nil {
 		if errors.Is(err, mysqlstorage.ErrNoRows) {
@@ -504,6 +538,8 @@ func (s *featureStorage) ListFeatures(
 			&feature.Maintainer,
 			&feature.SamplingSeed,
 			&mysqlstorage.JSONObject{Val: &feature.Prerequisites},
+			&mysqlstorage.JSONObject{Val: &feature.ExperimentAllocation},
+			&mysqlstorage.JSONObject{Val: &feature.Lifecycle},
 			&feature.AutoOpsSummary.ProgressiveRolloutCount,
 			&feature.AutoOpsSummary.ScheduleCount,
 			&feature.AutoOpsSummary.KillSwitchCount,
@@ -606,6 +642,8 @@ func (s *featureStorage) ListFeaturesFilteredByExperiment(
 			&feature.Maintainer,
 			&feature.SamplingSeed,
 			&mysqlstorage.JSONObject{Val: &feature.Prerequisites},
+			&mysqlstorage.JSONObject{Val: &feature.ExperimentAllocation},
+			&mysqlstorage.JSONObject{Val: &feature.Lifecycle},
 			&feature.AutoOpsSummary.ProgressiveRolloutCount,
 			&feature.AutoOpsSummary.ScheduleCount,
 			&feature.AutoOpsSummary.KillSwitchCount,
@@ -670,6 +708,8 @@ func (s *featureStorage) ListFeaturesByEnvironment(
 			&feature.Maintainer,
 			&feature.SamplingSeed,
 			&mysqlstorage.JSONObject{Val: &feature.Prerequisites},
+			&mysqlstorage.JSONObject{Val: &feature.ExperimentAllocation},
+			&mysqlstorage.JSONObject{Val: &feature.Lifecycle},
 		)
 		if err != nil {
 			return nil, err
@@ -716,6 +756,8 @@ func (s *featureStorage) ListAllEnvironmentFeatures(
 			&feature.Maintainer,
 			&feature.SamplingSeed,
 			&mysqlstorage.JSONObject{Val: &feature.Prerequisites},
+			&mysqlstorage.JSONObject{Val: &feature.ExperimentAllocation},
+			&mysqlstorage.JSONObject{Val: &feature.Lifecycle},
 		)
 		if err != nil {
 			return nil, err
@@ -158,6 +158,65 @@ func TestUpdateFeatureMySQL(t *testing.T) {
 	}
 }
 
+func TestUpdateFeatureLifecycleMySQL(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*featureStorage)
+		expectedErr error
+	}{
+		{
+			desc: "ErrFeatureUnexpectedAffectedRows",
+			setup: func(s *featureStorage) {
+				result := mock.NewMockResult(mockController)
+				s.qe.(*mock.MockClient).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+				result.EXPECT().RowsAffected().Return(int64(0), nil)
+			},
+			expectedErr: v2fs.ErrFeatureUnexpectedAffectedRows,
+		},
+		{
+			desc: "error",
+			setup: func(s *featureStorage) {
+				s.qe.(*mock.MockClient).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errors.New("error"))
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "success",
+			setup: func(s *featureStorage) {
+				result := mock.NewMockResult(mockController)
+				s.qe.(*mock.MockClient).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+				result.EXPECT().RowsAffected().Return(int64(1), nil)
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := &featureStorage{qe: mock.NewMockClient(mockController)}
+			if p.setup != nil {
+				p.setup(storage)
+			}
+			err := storage.UpdateFeatureLifecycle(
+				context.Background(),
+				"id",
+				&proto.FeatureLifecycle{State: proto.FeatureLifecycle_ROLLED_OUT},
+				"env",
+			)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
 func TestGetFeatureMySQL(t *testing.T) {
 	t.Parallel()
 	mockController := gomock.NewController(t)
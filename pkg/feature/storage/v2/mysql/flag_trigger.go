@@ -65,6 +65,7 @@ func (f *flagTriggerStorage) CreateFlagTrigger(
 		flagTrigger.TriggerCount,
 		flagTrigger.LastTriggeredAt,
 		flagTrigger.Token,
+		flagTrigger.TokenHash,
 		flagTrigger.Disabled,
 		flagTrigger.CreatedAt,
 		flagTrigger.UpdatedAt,
@@ -90,6 +91,7 @@ func (f *flagTriggerStorage) UpdateFlagTrigger(
 		flagTrigger.TriggerCount,
 		flagTrigger.LastTriggeredAt,
 		flagTrigger.Token,
+		flagTrigger.TokenHash,
 		flagTrigger.Disabled,
 		flagTrigger.CreatedAt,
 		flagTrigger.UpdatedAt,
@@ -135,6 +137,7 @@ func (f *flagTriggerStorage) GetFlagTrigger(
 	id, environmentId string,
 ) (*domain.FlagTrigger, error) {
 	trigger := proto.FlagTrigger{}
+	var tokenHash string
 	err := f.qe.QueryRowContext(
 		ctx,
 		getFlagTriggerSQL,
@@ -150,6 +153,7 @@ func (f *flagTriggerStorage) GetFlagTrigger(
 		&trigger.TriggerCount,
 		&trigger.LastTriggeredAt,
 		&trigger.Token,
+		&tokenHash,
 		&trigger.Disabled,
 		&trigger.CreatedAt,
 		&trigger.UpdatedAt,
@@ -160,7 +164,7 @@ func (f *flagTriggerStorage) GetFlagTrigger(
 		}
 		return nil, err
 	}
-	return &domain.FlagTrigger{FlagTrigger: &trigger}, nil
+	return &domain.FlagTrigger{FlagTrigger: &trigger, TokenHash: tokenHash}, nil
 }
 
 func (f *flagTriggerStorage) GetFlagTriggerByToken(
@@ -168,10 +172,11 @@ func (f *flagTriggerStorage) GetFlagTriggerByToken(
 	token string,
 ) (*domain.FlagTrigger, error) {
 	trigger := proto.FlagTrigger{}
+	var tokenHash string
 	err := f.qe.QueryRowContext(
 		ctx,
 		getFlagTriggerByTokenSQL,
-		token,
+		domain.HashFlagTriggerToken(token),
 	).Scan(
 		&trigger.Id,
 		&trigger.FeatureId,
@@ -182,6 +187,7 @@ func (f *flagTriggerStorage) GetFlagTriggerByToken(
 		&trigger.TriggerCount,
 		&trigger.LastTriggeredAt,
 		&trigger.Token,
+		&tokenHash,
 		&trigger.Disabled,
 		&trigger.CreatedAt,
 		&trigger.UpdatedAt,
@@ -192,7 +198,7 @@ func (f *flagTriggerStorage) GetFlagTriggerByToken(
 		}
 		return nil, err
 	}
-	return &domain.FlagTrigger{FlagTrigger: &trigger}, nil
+	return &domain.FlagTrigger{FlagTrigger: &trigger, TokenHash: tokenHash}, nil
 }
 
 func (f *flagTriggerStorage) ListFlagTriggers(
@@ -246,17 +252,19 @@ func (f *flagTriggerStorage) ListFlagTriggers(
 
 func listFlagTriggersOptionsFromParams(p v2fs.ListFlagTriggersParams) (*mysqlstorage.ListOptions, error) {
 	filters := []*mysqlstorage.FilterV2{
-		{
-			Column:   "feature_id",
-			Operator: mysqlstorage.OperatorEqual,
-			Value:    p.FeatureID,
-		},
 		{
 			Column:   "environment_id",
 			Operator: mysqlstorage.OperatorEqual,
 			Value:    p.EnvironmentID,
 		},
 	}
+	if p.FeatureID != "" {
+		filters = append(filters, &mysqlstorage.FilterV2{
+			Column:   "feature_id",
+			Operator: mysqlstorage.OperatorEqual,
+			Value:    p.FeatureID,
+		})
+	}
 	orders, err := listFlagTriggersOrders(p.OrderBy, p.OrderDirection)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,2 @@
+SELECT COUNT(1)
+FROM change_request
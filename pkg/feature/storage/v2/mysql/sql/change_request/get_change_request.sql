@@ -0,0 +1,20 @@
+SELECT
+    id,
+    feature_id,
+    environment_id,
+    payload,
+    comment,
+    status,
+    flag_version_at_creation,
+    min_approvers,
+    approvals,
+    rejected_by,
+    rejection_comment,
+    created_by,
+    created_at,
+    updated_by,
+    updated_at,
+    applied_at
+FROM change_request
+WHERE id = ?
+  AND environment_id = ?
@@ -0,0 +1,13 @@
+UPDATE change_request
+SET payload = ?,
+    comment = ?,
+    status = ?,
+    min_approvers = ?,
+    approvals = ?,
+    rejected_by = ?,
+    rejection_comment = ?,
+    updated_by = ?,
+    updated_at = ?,
+    applied_at = ?
+WHERE id = ?
+  AND environment_id = ?
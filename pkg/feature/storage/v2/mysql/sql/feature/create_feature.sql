@@ -21,10 +21,12 @@ INSERT INTO feature (
     maintainer,
     sampling_seed,
     prerequisites,
+    experiment_allocation,
+    lifecycle,
     environment_id
 ) VALUES (
      ?, ?, ?, ?, ?, ?, ?, ?, ?, ?,
      ?, ?, ?, ?, ?, ?, ?, ?, ?, ?,
-     ?,
+     ?, ?, ?,
      ?, ?
  )
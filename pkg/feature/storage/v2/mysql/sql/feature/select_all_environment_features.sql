@@ -19,7 +19,9 @@ SELECT
     ft.tags AS feature_tags,
     ft.maintainer AS feature_maintainer,
     ft.sampling_seed AS feature_sampling_seed,
-    ft.prerequisites AS feature_prerequisites
+    ft.prerequisites AS feature_prerequisites,
+    ft.experiment_allocation AS feature_experiment_allocation,
+    ft.lifecycle AS feature_lifecycle
 FROM feature ft
 JOIN environment_v2 env ON ft.environment_id = env.id
 WHERE ft.deleted = 0
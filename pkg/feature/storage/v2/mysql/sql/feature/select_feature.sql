@@ -20,7 +20,9 @@ SELECT
     tags,
     maintainer,
     sampling_seed,
-    prerequisites
+    prerequisites,
+    experiment_allocation,
+    lifecycle
 FROM
     feature
 WHERE
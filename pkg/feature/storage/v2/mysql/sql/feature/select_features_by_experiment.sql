@@ -21,6 +21,8 @@ SELECT DISTINCT
     feature.maintainer,
     feature.sampling_seed,
     feature.prerequisites,
+    feature.experiment_allocation,
+    feature.lifecycle,
     (
         SELECT COUNT(aor.id)
         FROM auto_ops_rule aor
@@ -21,7 +21,9 @@ SET
     tags = ?,
     maintainer = ?,
     sampling_seed = ?,
-    prerequisites = ?
+    prerequisites = ?,
+    experiment_allocation = ?,
+    lifecycle = ?
 WHERE
     id = ? AND
     environment_id = ?
@@ -0,0 +1,7 @@
+UPDATE
+    feature
+SET
+    lifecycle = ?
+WHERE
+    id = ? AND
+    environment_id = ?
@@ -7,6 +7,7 @@ SELECT id,
        trigger_count,
        last_triggered_at,
        token,
+       token_hash,
        disabled,
        created_at,
        updated_at
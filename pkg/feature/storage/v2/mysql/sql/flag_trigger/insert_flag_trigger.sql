@@ -7,7 +7,8 @@ INSERT INTO flag_trigger(id,
                          trigger_count,
                          last_triggered_at,
                          token,
+                         token_hash,
                          disabled,
                          created_at,
                          updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
\ No newline at end of file
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
\ No newline at end of file
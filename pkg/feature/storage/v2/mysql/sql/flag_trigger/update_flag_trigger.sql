@@ -6,6 +6,7 @@ SET feature_id        = ?,
     trigger_count     = ?,
     last_triggered_at = ?,
     token             = ?,
+    token_hash        = ?,
     disabled          = ?,
     created_at        = ?,
     updated_at        = ?
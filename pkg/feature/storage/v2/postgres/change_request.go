@@ -0,0 +1,265 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
+	v2fs "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2"
+	pgstorage "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+var (
+	//go:embed sql/change_request/insert_change_request.sql
+	insertChangeRequestSQL string
+	//go:embed sql/change_request/update_change_request.sql
+	updateChangeRequestSQL string
+	//go:embed sql/change_request/get_change_request.sql
+	getChangeRequestSQL string
+	//go:embed sql/change_request/list_change_requests.sql
+	listChangeRequestsSQL string
+	//go:embed sql/change_request/count_change_requests.sql
+	countChangeRequestsSQL string
+)
+
+type changeRequestStorage struct {
+	qe pgstorage.QueryExecer
+}
+
+// NewChangeRequestStorage creates a new ChangeRequestStorage
+func NewChangeRequestStorage(qe pgstorage.QueryExecer) v2fs.ChangeRequestStorage {
+	return &changeRequestStorage{qe: qe}
+}
+
+func (s *changeRequestStorage) CreateChangeRequest(
+	ctx context.Context,
+	cr *domain.ChangeRequest,
+) error {
+	_, err := s.qe.ExecContext(
+		ctx,
+		insertChangeRequestSQL,
+		cr.Id,
+		cr.FeatureId,
+		cr.EnvironmentId,
+		pgstorage.JSONObject{Val: cr.Payload},
+		cr.Comment,
+		int32(cr.Status),
+		cr.FlagVersionAtCreation,
+		cr.MinApprovers,
+		pgstorage.JSONObject{Val: cr.Approvals},
+		cr.RejectedBy,
+		cr.RejectionComment,
+		cr.CreatedBy,
+		cr.CreatedAt,
+		cr.UpdatedBy,
+		cr.UpdatedAt,
+		cr.AppliedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgstorage.ErrDuplicateEntry) {
+			return v2fs.ErrChangeRequestAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *changeRequestStorage) UpdateChangeRequest(
+	ctx context.Context,
+	cr *domain.ChangeRequest,
+) error {
+	result, err := s.qe.ExecContext(
+		ctx,
+		updateChangeRequestSQL,
+		pgstorage.JSONObject{Val: cr.Payload},
+		cr.Comment,
+		int32(cr.Status),
+		cr.MinApprovers,
+		pgstorage.JSONObject{Val: cr.Approvals},
+		cr.RejectedBy,
+		cr.RejectionComment,
+		cr.UpdatedBy,
+		cr.UpdatedAt,
+		cr.AppliedAt,
+		cr.Id,
+		cr.EnvironmentId,
+	)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return v2fs.ErrChangeRequestUnexpectedAffectedRows
+	}
+	return nil
+}
+
+func (s *changeRequestStorage) GetChangeRequest(
+	ctx context.Context,
+	id, environmentID string,
+) (*domain.ChangeRequest, error) {
+	cr := proto.ChangeRequest{}
+	var status int32
+	err := s.qe.QueryRowContext(
+		ctx,
+		getChangeRequestSQL,
+		id,
+		environmentID,
+	).Scan(
+		&cr.Id,
+		&cr.FeatureId,
+		&cr.EnvironmentId,
+		&pgstorage.JSONObject{Val: &cr.Payload},
+		&cr.Comment,
+		&status,
+		&cr.FlagVersionAtCreation,
+		&cr.MinApprovers,
+		&pgstorage.JSONObject{Val: &cr.Approvals},
+		&cr.RejectedBy,
+		&cr.RejectionComment,
+		&cr.CreatedBy,
+		&cr.CreatedAt,
+		&cr.UpdatedBy,
+		&cr.UpdatedAt,
+		&cr.AppliedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgstorage.ErrNoRows) {
+			return nil, v2fs.ErrChangeRequestNotFound
+		}
+		return nil, err
+	}
+	cr.Status = proto.ChangeRequestStatus(status)
+	return &domain.ChangeRequest{ChangeRequest: &cr}, nil
+}
+
+func changeRequestsListOptions(p v2fs.ListChangeRequestsParams) *pgstorage.ListOptions {
+	var filters []*pgstorage.Filter
+	if p.EnvironmentID != "" {
+		filters = append(filters, &pgstorage.Filter{
+			Column:   "environment_id",
+			Operator: pgstorage.OperatorEqual,
+			Value:    p.EnvironmentID,
+		})
+	}
+	if p.FeatureID != "" {
+		filters = append(filters, &pgstorage.Filter{
+			Column:   "feature_id",
+			Operator: pgstorage.OperatorEqual,
+			Value:    p.FeatureID,
+		})
+	}
+	var inFilters []*pgstorage.InFilter
+	if len(p.Statuses) > 0 {
+		statusValues := make([]interface{}, 0, len(p.Statuses))
+		for _, status := range p.Statuses {
+			statusValues = append(statusValues, int32(status))
+		}
+		inFilters = append(inFilters, &pgstorage.InFilter{
+			Column: "status",
+			Values: statusValues,
+		})
+	}
+	return &pgstorage.ListOptions{
+		Filters:   filters,
+		InFilters: inFilters,
+		Orders:    changeRequestsOrders(p.OrderBy, p.OrderDirection),
+		Limit:     p.PageSize,
+		Offset:    p.Offset,
+	}
+}
+
+func changeRequestsOrders(
+	orderBy proto.ListChangeRequestsRequest_OrderBy,
+	orderDirection proto.ListChangeRequestsRequest_OrderDirection,
+) []*pgstorage.Order {
+	direction := pgstorage.OrderDirectionAsc
+	if orderDirection == proto.ListChangeRequestsRequest_DESC {
+		direction = pgstorage.OrderDirectionDesc
+	}
+	switch orderBy {
+	case proto.ListChangeRequestsRequest_CREATED_AT:
+		return []*pgstorage.Order{pgstorage.NewOrder("created_at", direction)}
+	case proto.ListChangeRequestsRequest_UPDATED_AT:
+		return []*pgstorage.Order{pgstorage.NewOrder("updated_at", direction)}
+	default:
+		return []*pgstorage.Order{pgstorage.NewOrder("created_at", pgstorage.OrderDirectionDesc)}
+	}
+}
+
+func (s *changeRequestStorage) ListChangeRequests(
+	ctx context.Context,
+	params v2fs.ListChangeRequestsParams,
+) ([]*proto.ChangeRequest, int, int64, error) {
+	options := changeRequestsListOptions(params)
+	whereParts := options.CreateWhereParts()
+	whereSQL, whereArgs := pgstorage.ConstructWhereSQLString(whereParts)
+	orderBySQL := pgstorage.ConstructOrderBySQLString(options.Orders)
+	limitOffsetSQL := pgstorage.ConstructLimitOffsetSQLString(options.Limit, options.Offset)
+	query := fmt.Sprintf(listChangeRequestsSQL, whereSQL, orderBySQL, limitOffsetSQL)
+	rows, err := s.qe.QueryContext(ctx, query, whereArgs...)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	defer rows.Close()
+
+	changeRequests := make([]*proto.ChangeRequest, 0, options.Limit)
+	for rows.Next() {
+		cr := proto.ChangeRequest{}
+		var status int32
+		err := rows.Scan(
+			&cr.Id,
+			&cr.FeatureId,
+			&cr.EnvironmentId,
+			&pgstorage.JSONObject{Val: &cr.Payload},
+			&cr.Comment,
+			&status,
+			&cr.FlagVersionAtCreation,
+			&cr.MinApprovers,
+			&pgstorage.JSONObject{Val: &cr.Approvals},
+			&cr.RejectedBy,
+			&cr.RejectionComment,
+			&cr.CreatedBy,
+			&cr.CreatedAt,
+			&cr.UpdatedBy,
+			&cr.UpdatedAt,
+			&cr.AppliedAt,
+		)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		cr.Status = proto.ChangeRequestStatus(status)
+		changeRequests = append(changeRequests, &cr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, 0, err
+	}
+
+	nextOffset := options.Offset + len(changeRequests)
+	var totalCount int64
+	countQuery := fmt.Sprintf(countChangeRequestsSQL, whereSQL)
+	if err := s.qe.QueryRowContext(ctx, countQuery, whereArgs...).Scan(&totalCount); err != nil {
+		return nil, 0, 0, err
+	}
+	return changeRequests, nextOffset, totalCount, nil
+}
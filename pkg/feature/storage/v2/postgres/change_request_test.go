@@ -0,0 +1,312 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
+	v2fs "github.com/bucketeer-io/bucketeer/v2/pkg/feature/storage/v2"
+	pgstorage "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres/mock"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+func TestNewChangeRequestStorage(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	storage := NewChangeRequestStorage(mock.NewMockQueryExecer(mockController))
+	assert.IsType(t, &changeRequestStorage{}, storage)
+}
+
+func TestChangeRequestStorageCreateChangeRequest(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc          string
+		setup         func(storage *changeRequestStorage)
+		changeRequest *domain.ChangeRequest
+		expectedErr   error
+	}{
+		{
+			desc: "error: general error",
+			setup: func(s *changeRequestStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errors.New("error"))
+			},
+			changeRequest: &domain.ChangeRequest{
+				ChangeRequest: &proto.ChangeRequest{
+					Id:            "cr-1",
+					FeatureId:     "feature-1",
+					EnvironmentId: "env-1",
+				},
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "error: duplicate entry",
+			setup: func(s *changeRequestStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, pgstorage.ErrDuplicateEntry)
+			},
+			changeRequest: &domain.ChangeRequest{
+				ChangeRequest: &proto.ChangeRequest{
+					Id:            "cr-1",
+					FeatureId:     "feature-1",
+					EnvironmentId: "env-1",
+				},
+			},
+			expectedErr: v2fs.ErrChangeRequestAlreadyExists,
+		},
+		{
+			desc: "success",
+			setup: func(s *changeRequestStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, nil)
+			},
+			changeRequest: &domain.ChangeRequest{
+				ChangeRequest: &proto.ChangeRequest{
+					Id:            "cr-1",
+					FeatureId:     "feature-1",
+					EnvironmentId: "env-1",
+					MinApprovers:  1,
+					Status:        proto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING,
+				},
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := &changeRequestStorage{qe: mock.NewMockQueryExecer(mockController)}
+			p.setup(storage)
+			err := storage.CreateChangeRequest(context.Background(), p.changeRequest)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestChangeRequestStorageUpdateChangeRequest(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc          string
+		setup         func(storage *changeRequestStorage)
+		changeRequest *domain.ChangeRequest
+		expectedErr   error
+	}{
+		{
+			desc: "error: exec error",
+			setup: func(s *changeRequestStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errors.New("error"))
+			},
+			changeRequest: &domain.ChangeRequest{
+				ChangeRequest: &proto.ChangeRequest{
+					Id:            "cr-1",
+					EnvironmentId: "env-1",
+				},
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "error: no rows affected",
+			setup: func(s *changeRequestStorage) {
+				result := mock.NewMockResult(mockController)
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+				result.EXPECT().RowsAffected().Return(int64(0), nil)
+			},
+			changeRequest: &domain.ChangeRequest{
+				ChangeRequest: &proto.ChangeRequest{
+					Id:            "cr-1",
+					EnvironmentId: "env-1",
+				},
+			},
+			expectedErr: v2fs.ErrChangeRequestUnexpectedAffectedRows,
+		},
+		{
+			desc: "success",
+			setup: func(s *changeRequestStorage) {
+				result := mock.NewMockResult(mockController)
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+				result.EXPECT().RowsAffected().Return(int64(1), nil)
+			},
+			changeRequest: &domain.ChangeRequest{
+				ChangeRequest: &proto.ChangeRequest{
+					Id:            "cr-1",
+					EnvironmentId: "env-1",
+					Status:        proto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_APPROVED,
+					Approvals: []*proto.ChangeRequestApproval{
+						{ApprovedBy: "reviewer@example.com", ApprovedAt: 1700000000},
+					},
+				},
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := &changeRequestStorage{qe: mock.NewMockQueryExecer(mockController)}
+			p.setup(storage)
+			err := storage.UpdateChangeRequest(context.Background(), p.changeRequest)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestChangeRequestStorageGetChangeRequest(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc          string
+		setup         func(storage *changeRequestStorage)
+		id            string
+		environmentID string
+		expectedErr   error
+	}{
+		{
+			desc: "error: general error",
+			setup: func(s *changeRequestStorage) {
+				row := mock.NewMockRow(mockController)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+				row.EXPECT().Scan(gomock.Any()).Return(errors.New("error"))
+			},
+			id:            "cr-1",
+			environmentID: "env-1",
+			expectedErr:   errors.New("error"),
+		},
+		{
+			desc: "error: not found",
+			setup: func(s *changeRequestStorage) {
+				row := mock.NewMockRow(mockController)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+				row.EXPECT().Scan(gomock.Any()).Return(pgstorage.ErrNoRows)
+			},
+			id:            "cr-1",
+			environmentID: "env-1",
+			expectedErr:   v2fs.ErrChangeRequestNotFound,
+		},
+		{
+			desc: "success",
+			setup: func(s *changeRequestStorage) {
+				row := mock.NewMockRow(mockController)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+				row.EXPECT().Scan(gomock.Any()).Return(nil)
+			},
+			id:            "cr-1",
+			environmentID: "env-1",
+			expectedErr:   nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := &changeRequestStorage{qe: mock.NewMockQueryExecer(mockController)}
+			p.setup(storage)
+			_, err := storage.GetChangeRequest(context.Background(), p.id, p.environmentID)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestChangeRequestStorageListChangeRequests(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc           string
+		setup          func(storage *changeRequestStorage)
+		params         v2fs.ListChangeRequestsParams
+		expected       []*proto.ChangeRequest
+		expectedCursor int
+		expectedErr    error
+	}{
+		{
+			desc: "error: query error",
+			setup: func(s *changeRequestStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errors.New("error"))
+			},
+			params:      v2fs.ListChangeRequestsParams{},
+			expected:    nil,
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "success: empty result",
+			setup: func(s *changeRequestStorage) {
+				rows := mock.NewMockRows(mockController)
+				rows.EXPECT().Close().Return(nil)
+				rows.EXPECT().Next().Return(false)
+				rows.EXPECT().Err().Return(nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(rows, nil)
+				row := mock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+			},
+			params: v2fs.ListChangeRequestsParams{
+				EnvironmentID: "env-1",
+				FeatureID:     "feature-1",
+				Statuses: []proto.ChangeRequestStatus{
+					proto.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING,
+				},
+				PageSize: 10,
+			},
+			expected:       []*proto.ChangeRequest{},
+			expectedCursor: 0,
+			expectedErr:    nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := &changeRequestStorage{qe: mock.NewMockQueryExecer(mockController)}
+			p.setup(storage)
+			expected, nextOffset, _, err := storage.ListChangeRequests(context.Background(), p.params)
+			assert.Equal(t, p.expectedErr, err)
+			assert.Equal(t, p.expected, expected)
+			assert.Equal(t, p.expectedCursor, nextOffset)
+		})
+	}
+}
@@ -34,6 +34,8 @@ var (
 	createFeatureSQLQuery string
 	//go:embed sql/feature/update_feature.sql
 	updateFeatureSQLQuery string
+	//go:embed sql/feature/update_feature_lifecycle.sql
+	updateFeatureLifecycleSQLQuery string
 	//go:embed sql/feature/select_feature.sql
 	selectFeatureSQLQuery string
 	//go:embed sql/feature/select_feature_by_version.sql
@@ -92,6 +94,8 @@ func (s *featureStorage) CreateFeature(
 		feature.Maintainer,
 		feature.SamplingSeed,
 		pgstorage.JSONObject{Val: feature.Prerequisites},
+		pgstorage.JSONObject{Val: feature.ExperimentAllocation},
+		pgstorage.JSONObject{Val: feature.Lifecycle},
 		environmentID,
 	)
 	if err != nil {
@@ -132,6 +136,8 @@ func (s *featureStorage) UpdateFeature(
 		feature.Maintainer,
 		feature.SamplingSeed,
 		pgstorage.JSONObject{Val: feature.Prerequisites},
+		pgstorage.JSONObject{Val: feature.ExperimentAllocation},
+		pgstorage.JSONObject{Val: feature.Lifecycle},
 		feature.Id,
 		environmentID,
 	)
@@ -148,6 +154,32 @@ func (s *featureStorage) UpdateFeature(
 	return nil
 }
 
+func (s *featureStorage) UpdateFeatureLifecycle(
+	ctx context.Context,
+	id string,
+	lifecycle *proto.FeatureLifecycle,
+	environmentID string,
+) error {
+	result, err := s.qe.ExecContext(
+		ctx,
+		updateFeatureLifecycleSQLQuery,
+		pgstorage.JSONObject{Val: lifecycle},
+		id,
+		environmentID,
+	)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return v2fs.ErrFeatureUnexpectedAffectedRows
+	}
+	return nil
+}
+
 func (s *featureStorage) GetFeature(
 	ctx context.Context,
 	id, environmentID string,
@@ -182,6 +214,8 @@ func (s *featureStorage) GetFeature(
 		&feature.Maintainer,
 		&feature.SamplingSeed,
 		&pgstorage.JSONObject{Val: &feature.Prerequisites},
+		&pgstorage.JSONObject{Val: &feature.ExperimentAllocation},
+		&pgstorage.JSONObject{Val: &feature.Lifecycle},
 	)
 	if err != nil {
 		if errors.Is(err, pgstorage.ErrNoRows) {
@@ -497,6 +531,8 @@ func (s *featureStorage) ListFeatures(
 			&feature.Maintainer,
 			&feature.SamplingSeed,
 			&pgstorage.JSONObject{Val: &feature.Prerequisites},
+			&pgstorage.JSONObject{Val: &feature.ExperimentAllocation},
+			&pgstorage.JSONObject{Val: &feature.Lifecycle},
 			&feature.AutoOpsSummary.ProgressiveRolloutCount,
 			&feature.AutoOpsSummary.ScheduleCount,
 			&feature.AutoOpsSummary.KillSwitchCount,
@@ -599,6 +635,8 @@ func (s *featureStorage) ListFeaturesFilteredByExperiment(
 			&feature.Maintainer,
 			&feature.SamplingSeed,
 			&pgstorage.JSONObject{Val: &feature.Prerequisites},
+			&pgstorage.JSONObject{Val: &feature.ExperimentAllocation},
+			&pgstorage.JSONObject{Val: &feature.Lifecycle},
 			&feature.AutoOpsSummary.ProgressiveRolloutCount,
 			&feature.AutoOpsSummary.ScheduleCount,
 			&feature.AutoOpsSummary.KillSwitchCount,
@@ -663,6 +701,8 @@ func (s *featureStorage) ListFeaturesByEnvironment(
 			&feature.Maintainer,
 			&feature.SamplingSeed,
 			&pgstorage.JSONObject{Val: &feature.Prerequisites},
+			&pgstorage.JSONObject{Val: &feature.ExperimentAllocation},
+			&pgstorage.JSONObject{Val: &feature.Lifecycle},
 		)
 		if err != nil {
 			return nil, err
@@ -709,6 +749,8 @@ func (s *featureStorage) ListAllEnvironmentFeatures(
 			&feature.Maintainer,
 			&feature.SamplingSeed,
 			&pgstorage.JSONObject{Val: &feature.Prerequisites},
+			&pgstorage.JSONObject{Val: &feature.ExperimentAllocation},
+			&pgstorage.JSONObject{Val: &feature.Lifecycle},
 		)
 		if err != nil {
 			return nil, err
@@ -181,6 +181,64 @@ func TestUpdateFeature(t *testing.T) {
 	}
 }
 
+func TestUpdateFeatureLifecycle(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	patterns := []struct {
+		desc        string
+		setup       func(*featureStorage)
+		expectedErr error
+	}{
+		{
+			desc: "ErrFeatureUnexpectedAffectedRows",
+			setup: func(s *featureStorage) {
+				result := pgmock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(0), nil)
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+			},
+			expectedErr: v2fs.ErrFeatureUnexpectedAffectedRows,
+		},
+		{
+			desc: "Error",
+			setup: func(s *featureStorage) {
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errInternal)
+			},
+			expectedErr: errInternal,
+		},
+		{
+			desc: "Success",
+			setup: func(s *featureStorage) {
+				result := pgmock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(1), nil)
+				s.qe.(*pgmock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newFeatureStorageWithMock(t, mockController)
+			if p.setup != nil {
+				p.setup(storage)
+			}
+			err := storage.UpdateFeatureLifecycle(
+				context.Background(),
+				"id",
+				&proto.FeatureLifecycle{State: proto.FeatureLifecycle_ROLLED_OUT},
+				"env",
+			)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
 func TestGetFeature(t *testing.T) {
 	t.Parallel()
 	mockController := gomock.NewController(t)
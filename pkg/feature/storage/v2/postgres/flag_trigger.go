@@ -81,6 +81,7 @@ func (f *flagTriggerStorage) CreateFlagTrigger(
 		flagTrigger.TriggerCount,
 		flagTrigger.LastTriggeredAt,
 		flagTrigger.Token,
+		flagTrigger.TokenHash,
 		flagTrigger.Disabled,
 		flagTrigger.CreatedAt,
 		flagTrigger.UpdatedAt,
@@ -106,6 +107,7 @@ func (f *flagTriggerStorage) UpdateFlagTrigger(
 		flagTrigger.TriggerCount,
 		flagTrigger.LastTriggeredAt,
 		flagTrigger.Token,
+		flagTrigger.TokenHash,
 		flagTrigger.Disabled,
 		flagTrigger.CreatedAt,
 		flagTrigger.UpdatedAt,
@@ -151,6 +153,7 @@ func (f *flagTriggerStorage) GetFlagTrigger(
 	id, environmentId string,
 ) (*domain.FlagTrigger, error) {
 	trigger := proto.FlagTrigger{}
+	var tokenHash string
 	var actionBool bool
 	err := f.qe.QueryRowContext(
 		ctx,
@@ -167,6 +170,7 @@ func (f *flagTriggerStorage) GetFlagTrigger(
 		&trigger.TriggerCount,
 		&trigger.LastTriggeredAt,
 		&trigger.Token,
+		&tokenHash,
 		&trigger.Disabled,
 		&trigger.CreatedAt,
 		&trigger.UpdatedAt,
@@ -178,7 +182,7 @@ func (f *flagTriggerStorage) GetFlagTrigger(
 		return nil, err
 	}
 	trigger.Action = boolToAction(actionBool)
-	return &domain.FlagTrigger{FlagTrigger: &trigger}, nil
+	return &domain.FlagTrigger{FlagTrigger: &trigger, TokenHash: tokenHash}, nil
 }
 
 func (f *flagTriggerStorage) GetFlagTriggerByToken(
@@ -186,11 +190,12 @@ func (f *flagTriggerStorage) GetFlagTriggerByToken(
 	token string,
 ) (*domain.FlagTrigger, error) {
 	trigger := proto.FlagTrigger{}
+	var tokenHash string
 	var actionBool bool
 	err := f.qe.QueryRowContext(
 		ctx,
 		getFlagTriggerByTokenSQL,
-		token,
+		domain.HashFlagTriggerToken(token),
 	).Scan(
 		&trigger.Id,
 		&trigger.FeatureId,
@@ -201,6 +206,7 @@ func (f *flagTriggerStorage) GetFlagTriggerByToken(
 		&trigger.TriggerCount,
 		&trigger.LastTriggeredAt,
 		&trigger.Token,
+		&tokenHash,
 		&trigger.Disabled,
 		&trigger.CreatedAt,
 		&trigger.UpdatedAt,
@@ -212,7 +218,7 @@ func (f *flagTriggerStorage) GetFlagTriggerByToken(
 		return nil, err
 	}
 	trigger.Action = boolToAction(actionBool)
-	return &domain.FlagTrigger{FlagTrigger: &trigger}, nil
+	return &domain.FlagTrigger{FlagTrigger: &trigger, TokenHash: tokenHash}, nil
 }
 
 func (f *flagTriggerStorage) ListFlagTriggers(
@@ -268,17 +274,19 @@ func (f *flagTriggerStorage) ListFlagTriggers(
 
 func listFlagTriggersOptionsFromParams(p v2fs.ListFlagTriggersParams) (*pgstorage.ListOptions, error) {
 	filters := []*pgstorage.Filter{
-		{
-			Column:   "feature_id",
-			Operator: pgstorage.OperatorEqual,
-			Value:    p.FeatureID,
-		},
 		{
 			Column:   "environment_id",
 			Operator: pgstorage.OperatorEqual,
 			Value:    p.EnvironmentID,
 		},
 	}
+	if p.FeatureID != "" {
+		filters = append(filters, &pgstorage.Filter{
+			Column:   "feature_id",
+			Operator: pgstorage.OperatorEqual,
+			Value:    p.FeatureID,
+		})
+	}
 	orders, err := listFlagTriggersOrders(p.OrderBy, p.OrderDirection)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,3 @@
+SELECT COUNT(1)
+FROM change_request
+%s
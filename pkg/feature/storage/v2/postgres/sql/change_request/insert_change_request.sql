@@ -0,0 +1,18 @@
+INSERT INTO change_request (
+    id,
+    feature_id,
+    environment_id,
+    payload,
+    comment,
+    status,
+    flag_version_at_creation,
+    min_approvers,
+    approvals,
+    rejected_by,
+    rejection_comment,
+    created_by,
+    created_at,
+    updated_by,
+    updated_at,
+    applied_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
@@ -0,0 +1,13 @@
+UPDATE change_request
+SET payload = $1,
+    comment = $2,
+    status = $3,
+    min_approvers = $4,
+    approvals = $5,
+    rejected_by = $6,
+    rejection_comment = $7,
+    updated_by = $8,
+    updated_at = $9,
+    applied_at = $10
+WHERE id = $11
+  AND environment_id = $12
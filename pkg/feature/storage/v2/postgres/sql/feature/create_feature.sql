@@ -21,9 +21,11 @@ INSERT INTO feature (
     maintainer,
     sampling_seed,
     prerequisites,
+    experiment_allocation,
+    lifecycle,
     environment_id
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7, $8, $9, $10,
     $11, $12, $13, $14, $15, $16, $17, $18, $19, $20,
-    $21, $22, $23
+    $21, $22, $23, $24, $25
 )
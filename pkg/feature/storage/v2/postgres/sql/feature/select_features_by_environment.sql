@@ -18,7 +18,9 @@ SELECT
     ft.tags AS feature_tags,
     ft.maintainer AS feature_maintainer,
     ft.sampling_seed AS feature_sampling_seed,
-    ft.prerequisites AS feature_prerequisites
+    ft.prerequisites AS feature_prerequisites,
+    ft.experiment_allocation AS feature_experiment_allocation,
+    ft.lifecycle AS feature_lifecycle
 FROM feature ft
 WHERE ft.deleted = FALSE AND ft.environment_id = $1
 ORDER BY ft.id
@@ -32,6 +32,8 @@ SELECT
     feature.maintainer,
     feature.sampling_seed,
     feature.prerequisites,
+    feature.experiment_allocation,
+    feature.lifecycle,
     COALESCE(auto_ops_counts.progressive_rollout_count, 0) AS progressive_rollout_count,
     COALESCE(auto_ops_counts.schedule_count, 0) AS schedule_count,
     COALESCE(auto_ops_counts.kill_switch_count, 0) AS kill_switch_count,
@@ -21,7 +21,9 @@ SET
     tags = $18,
     maintainer = $19,
     sampling_seed = $20,
-    prerequisites = $21
+    prerequisites = $21,
+    experiment_allocation = $22,
+    lifecycle = $23
 WHERE
-    id = $22 AND
-    environment_id = $23
+    id = $24 AND
+    environment_id = $25
@@ -0,0 +1,7 @@
+UPDATE
+    feature
+SET
+    lifecycle = $1
+WHERE
+    id = $2 AND
+    environment_id = $3
@@ -7,8 +7,9 @@ SELECT id,
        trigger_count,
        last_triggered_at,
        token,
+       token_hash,
        disabled,
        created_at,
        updated_at
 from flag_trigger
-where token = $1
+where token_hash = $1
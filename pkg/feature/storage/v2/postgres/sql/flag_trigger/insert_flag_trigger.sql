@@ -7,7 +7,8 @@ INSERT INTO flag_trigger(id,
                          trigger_count,
                          last_triggered_at,
                          token,
+                         token_hash,
                          disabled,
                          created_at,
                          updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
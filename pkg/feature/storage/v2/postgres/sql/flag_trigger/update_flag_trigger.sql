@@ -6,8 +6,9 @@ SET feature_id        = $1,
     trigger_count     = $5,
     last_triggered_at = $6,
     token             = $7,
-    disabled          = $8,
-    created_at        = $9,
-    updated_at        = $10
-WHERE id = $11
-  AND environment_id = $12
+    token_hash        = $8,
+    disabled          = $9,
+    created_at        = $10,
+    updated_at        = $11
+WHERE id = $12
+  AND environment_id = $13
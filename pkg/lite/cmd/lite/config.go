@@ -0,0 +1,61 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lite
+
+import (
+	"embed"
+	"os"
+	"path/filepath"
+)
+
+// The subscriber configurations mirror the docker-compose ones with every
+// subscription on the in-process pubsub and the data warehouse on the main
+// SQLite connection.
+//
+//go:embed config/*.json
+var configFS embed.FS
+
+type configPaths struct {
+	subscribers         string
+	onDemandSubscribers string
+	processors          string
+	onDemandProcessors  string
+	email               string
+}
+
+// writeConfigs writes the embedded configurations to dir, where the
+// subscriber reads them from.
+func writeConfigs(dir string) (*configPaths, error) {
+	entries, err := configFS.ReadDir("config")
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		data, err := configFS.ReadFile("config/" + e.Name())
+		if err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(filepath.Join(dir, e.Name()), data, 0600); err != nil {
+			return nil, err
+		}
+	}
+	return &configPaths{
+		subscribers:         filepath.Join(dir, "subscribers.json"),
+		onDemandSubscribers: filepath.Join(dir, "onDemandSubscribers.json"),
+		processors:          filepath.Join(dir, "processors.json"),
+		onDemandProcessors:  filepath.Join(dir, "onDemandProcessors.json"),
+		email:               filepath.Join(dir, "email.json"),
+	}, nil
+}
@@ -0,0 +1,455 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lite runs the web, api, batch and subscriber servers in one process,
+// backed by SQLite, an embedded Redis and the in-process pubsub. It is meant
+// for integration tests and small self-hosted installations.
+package lite
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	kingpin "gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/bucketeer-io/bucketeer/v2/migration"
+	apicmd "github.com/bucketeer-io/bucketeer/v2/pkg/api/cmd"
+	batchclient "github.com/bucketeer-io/bucketeer/v2/pkg/batch/client"
+	batchserver "github.com/bucketeer-io/bucketeer/v2/pkg/batch/cmd/server"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/cli"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/crypto"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/metrics"
+	rpcclient "github.com/bucketeer-io/bucketeer/v2/pkg/rpc/client"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/sqlite"
+	subscriberserver "github.com/bucketeer-io/bucketeer/v2/pkg/subscriber/cmd/server"
+	webserver "github.com/bucketeer-io/bucketeer/v2/pkg/web/cmd/server"
+)
+
+const (
+	command = "lite"
+
+	// The web health check server always binds to 8000.
+	webHealthCheckPort   = 8000
+	webGRPCGatewayPort   = 9089
+	webDashboardPort     = 9103
+	webAutoOpsPort       = 9094
+	apiPort              = 9120
+	apiGRPCGatewayPort   = 9121
+	apiRESTPort          = 9122
+	batchPort            = 9130
+	batchGRPCGatewayPort = 9131
+	subscriberPort       = 9140
+
+	routerShutdownTimeout = 10 * time.Second
+)
+
+// webService is a gRPC service of the web server and the port it listens on.
+type webService struct {
+	name     string
+	portFlag string
+	port     int
+}
+
+var webServices = []webService{
+	{"bucketeer.account.AccountService", "account-service-port", 9091},
+	{"bucketeer.auth.AuthService", "auth-service-port", 9092},
+	{"bucketeer.auditlog.AuditLogService", "audit-log-service-port", 9093},
+	{"bucketeer.autoops.AutoOpsService", "auto-ops-service-port", webAutoOpsPort},
+	{"bucketeer.environment.EnvironmentService", "environment-service-port", 9095},
+	{"bucketeer.eventcounter.EventCounterService", "event-counter-service-port", 9096},
+	{"bucketeer.experiment.ExperimentService", "experiment-service-port", 9097},
+	{"bucketeer.feature.FeatureService", "feature-service-port", 9098},
+	{"bucketeer.subscription.SubscriptionService", "subscription-service-port", 9100},
+	{"bucketeer.push.PushService", "push-service-port", 9101},
+	{"bucketeer.tag.TagService", "tag-service-port", 9104},
+	{"bucketeer.coderef.CodeReferenceService", "code-reference-service-port", 9105},
+	{"bucketeer.team.TeamService", "team-service-port", 9107},
+	{"bucketeer.insights.InsightsService", "insights-service-port", 9108},
+	{"bucketeer.aichat.AIChatService", "aichat-service-port", 9109},
+	{"bucketeer.notification.NotificationService", "notification-service-port", 9110},
+}
+
+type lite struct {
+	*kingpin.CmdClause
+	sqlitePath          *string
+	redisAddr           *string
+	webGatewayPort      *int
+	apiGatewayPort      *int
+	certPath            *string
+	keyPath             *string
+	serviceTokenPath    *string
+	oauthPublicKeyPath  *string
+	oauthPrivateKeyPath *string
+	oauthConfigPath     *string
+	oauthIssuer         *string
+	oauthAudience       *string
+	webURL              *string
+	webConsoleEnvJSPath *string
+	emailConfigPath     *string
+	timezone            *string
+	secretKeyFile       *string
+}
+
+func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
+	cmd := p.Command(command, "Run web, api, batch and subscriber in a single process backed by SQLite")
+	l := &lite{
+		CmdClause:  cmd,
+		sqlitePath: cmd.Flag("sqlite-path", "Path to the SQLite database file.").Default("bucketeer.db").String(),
+		redisAddr: cmd.Flag(
+			"redis-addr",
+			"Address of an external Redis. An embedded in-memory Redis is used when empty.",
+		).String(),
+		webGatewayPort: cmd.Flag(
+			"web-gateway-port",
+			"Port to serve the web gRPC, gRPC-Web and REST APIs and the console on.",
+		).Default("9001").Int(),
+		apiGatewayPort: cmd.Flag(
+			"api-gateway-port",
+			"Port to serve the SDK gRPC and REST APIs on.",
+		).Default("9000").Int(),
+		certPath:         cmd.Flag("cert", "Path to TLS certificate.").Required().String(),
+		keyPath:          cmd.Flag("key", "Path to TLS key.").Required().String(),
+		serviceTokenPath: cmd.Flag("service-token", "Path to service token.").Required().String(),
+		oauthPublicKeyPath: cmd.Flag(
+			"oauth-public-key",
+			"Path to public key used to verify oauth token.",
+		).Required().String(),
+		oauthPrivateKeyPath: cmd.Flag(
+			"oauth-private-key",
+			"Path to private key for signing oauth token.",
+		).Required().String(),
+		oauthConfigPath: cmd.Flag("oauth-config-path", "Path to oauth config.").Required().String(),
+		oauthIssuer:     cmd.Flag("oauth-issuer", "The issuer url").Default("https://localhost").String(),
+		oauthAudience: cmd.Flag(
+			"oauth-audience",
+			"The oauth audience registered in the token",
+		).Default("bucketeer").String(),
+		webURL: cmd.Flag(
+			"web-url",
+			"Web console URL. Defaults to https://localhost:<web-gateway-port>.",
+		).String(),
+		webConsoleEnvJSPath: cmd.Flag("web-console-env-js-path", "console env js path").String(),
+		emailConfigPath: cmd.Flag(
+			"email-config-path",
+			"Path to email config. Emails are disabled when empty.",
+		).String(),
+		timezone: cmd.Flag("timezone", "Time zone").Default("UTC").String(),
+		secretKeyFile: cmd.Flag(
+			"secret-encryption-key-file",
+			"Path to the AES-256 key file encrypting the stored secrets. Secrets are stored in plaintext when empty.",
+		).String(),
+	}
+	r.RegisterCommand(l)
+	return l
+}
+
+func (l *lite) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.Logger) error {
+	if err := l.migrate(ctx, logger); err != nil {
+		return err
+	}
+
+	redisAddr := *l.redisAddr
+	if redisAddr == "" {
+		redis, err := miniredis.Run()
+		if err != nil {
+			logger.Error("Failed to start the embedded redis", zap.Error(err))
+			return err
+		}
+		defer redis.Close()
+		redisAddr = redis.Addr()
+	}
+
+	configDir, err := os.MkdirTemp("", "bucketeer-lite")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(configDir)
+	configs, err := writeConfigs(configDir)
+	if err != nil {
+		logger.Error("Failed to write the subscriber configs", zap.Error(err))
+		return err
+	}
+	if *l.emailConfigPath != "" {
+		configs.email = *l.emailConfigPath
+	}
+
+	services := l.services(redisAddr, configs)
+	cmds := make([]cli.Command, 0, len(services))
+	for _, s := range services {
+		cmd, err := s.command()
+		if err != nil {
+			logger.Error("Failed to configure service", zap.Error(err), zap.String("service", s.name))
+			return err
+		}
+		cmds = append(cmds, cmd)
+	}
+
+	routers := map[int][]route{
+		*l.webGatewayPort: webRoutes(),
+		*l.apiGatewayPort: apiRoutes(),
+	}
+	for port, routes := range routers {
+		r, err := newRouter(port, routes, *l.certPath, *l.keyPath, logger.Named("router"))
+		if err != nil {
+			logger.Error("Failed to create router", zap.Error(err), zap.Int("port", port))
+			return err
+		}
+		go r.Run(*l.certPath, *l.keyPath) // nolint:errcheck
+		defer r.Stop(routerShutdownTimeout)
+	}
+
+	group, ctx := errgroup.WithContext(ctx)
+	for i, s := range services {
+		group.Go(func() error {
+			return s.run(ctx, cmds[i], metrics, logger)
+		})
+	}
+
+	creds, err := rpcclient.NewPerRPCCredentials(*l.serviceTokenPath)
+	if err != nil {
+		return err
+	}
+	client, err := batchclient.NewClient(l.gatewayAddr(), *l.certPath,
+		rpcclient.WithPerRPCCredentials(creds),
+		rpcclient.WithDialTimeout(30*time.Second),
+		rpcclient.WithBlock(),
+		rpcclient.WithLogger(logger),
+	)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	group.Go(func() error {
+		newScheduler(client, schedules, logger).Run(ctx)
+		return nil
+	})
+
+	logger.Info("Bucketeer lite is running",
+		zap.Int("webGatewayPort", *l.webGatewayPort),
+		zap.Int("apiGatewayPort", *l.apiGatewayPort),
+		zap.String("sqlitePath", *l.sqlitePath),
+	)
+	return group.Wait()
+}
+
+func (l *lite) migrate(ctx context.Context, logger *zap.Logger) error {
+	client, err := sqlite.NewClient(ctx, *l.sqlitePath, sqlite.WithLogger(logger))
+	if err != nil {
+		logger.Error("Failed to open the SQLite database", zap.Error(err), zap.String("path", *l.sqlitePath))
+		return err
+	}
+	defer client.Close()
+	migrations, err := fs.Sub(migration.SQLite, "sqlite")
+	if err != nil {
+		return err
+	}
+	if err := sqlite.Migrate(ctx, client, migrations, logger); err != nil {
+		logger.Error("Failed to migrate the SQLite database", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// gatewayAddr is the address the services reach each other through, as they
+// do through the envoy sidecar in a regular deployment.
+func (l *lite) gatewayAddr() string {
+	return fmt.Sprintf("localhost:%d", *l.webGatewayPort)
+}
+
+func (l *lite) consoleURL() string {
+	if *l.webURL != "" {
+		return *l.webURL
+	}
+	return "https://" + l.gatewayAddr()
+}
+
+func (l *lite) services(redisAddr string, configs *configPaths) []*service {
+	return []*service{
+		{name: "web", register: webserver.RegisterCommand, args: l.webArgs(redisAddr)},
+		{name: "api", register: apicmd.RegisterCommand, args: l.apiArgs(redisAddr)},
+		{name: "batch", register: batchserver.RegisterCommand, args: l.batchArgs(redisAddr)},
+		{name: "subscriber", register: subscriberserver.RegisterCommand, args: l.subscriberArgs(redisAddr, configs)},
+	}
+}
+
+func (l *lite) webArgs(redisAddr string) *args {
+	a := newArgs("bucketeer-web")
+	l.commonArgs(a)
+	l.redisArgs(a, redisAddr)
+	l.secretEncryptionArgs(a)
+	for _, s := range webServices {
+		a.set(s.portFlag, s.port)
+	}
+	for _, name := range []string{
+		"account-service", "auth-service", "batch-service", "environment-service", "experiment-service",
+		"feature-service", "autoops-service", "code-reference-service", "team-service",
+	} {
+		a.set(name, l.gatewayAddr())
+	}
+	return a.
+		set("project", command).
+		set("dashboard-service-port", webDashboardPort).
+		set("web-grpc-gateway-port", webGRPCGatewayPort).
+		set("pubsub-type", "memory").
+		set("domain-topic", "domain").
+		set("bulk-segment-users-received-topic", "bulk-segment-users-received").
+		set("data-warehouse-type", "mysql").
+		set("timezone", *l.timezone).
+		set("oauth-public-key", *l.oauthPublicKeyPath).
+		set("oauth-private-key", *l.oauthPrivateKeyPath).
+		set("oauth-config-path", *l.oauthConfigPath).
+		set("web-console-env-js-path", *l.webConsoleEnvJSPath).
+		set("web-url", l.consoleURL()).
+		fallback("webhook-base-url", l.consoleURL()).
+		// Webhook credentials are not encrypted with Cloud KMS, so the name is unused.
+		fallback("webhook-kms-resource-name", command)
+}
+
+func (l *lite) apiArgs(redisAddr string) *args {
+	a := newArgs("bucketeer-api")
+	l.commonArgs(a)
+	for _, name := range []string{
+		"feature-service", "account-service", "push-service", "code-ref-service", "audit-log-service",
+		"tag-service", "team-service", "subscription-service", "experiment-service", "environment-service",
+		"event-counter-service",
+	} {
+		a.set(name, l.gatewayAddr())
+	}
+	return a.
+		set("project", command).
+		set("port", apiPort).
+		set("grpc-gateway-port", apiGRPCGatewayPort).
+		set("rest-port", apiRESTPort).
+		set("pubsub-type", "memory").
+		set("goal-topic", "goal").
+		set("evaluation-topic", "evaluation").
+		set("metrics-topic", "metrics").
+		set("cache-invalidation-topic", "cache-invalidation").
+		set("redis-server-name", "api-gateway").
+		set("redis-addr", redisAddr).
+		set("redis-mode", "standalone")
+}
+
+func (l *lite) batchArgs(redisAddr string) *args {
+	a := newArgs("bucketeer-batch")
+	l.commonArgs(a)
+	l.redisArgs(a, redisAddr)
+	l.secretEncryptionArgs(a)
+	for _, name := range []string{
+		"account-service", "environment-service", "experiment-service", "auto-ops-service",
+		"event-counter-service", "push-service", "feature-service", "subscription-service", "batch-service",
+	} {
+		a.set(name, l.gatewayAddr())
+	}
+	return a.
+		set("port", batchPort).
+		set("grpc-gateway-port", batchGRPCGatewayPort).
+		set("timezone", *l.timezone).
+		set("web-url", l.consoleURL()).
+		set("oauth-public-key", *l.oauthPublicKeyPath).
+		set("oauth-issuer", *l.oauthIssuer).
+		set("oauth-audience", *l.oauthAudience).
+		set("mysql-db-open-conns", 10).
+		// The model id of the docker-compose httpstan image.
+		fallback("stan-model-id", "y3qsnd7m").
+		// The single binary samples the experiments in process instead of calling httpstan.
+		fallback("binomial-sampler", "beta-binomial")
+}
+
+func (l *lite) subscriberArgs(redisAddr string, configs *configPaths) *args {
+	a := newArgs("bucketeer-subscriber")
+	l.commonArgs(a)
+	l.redisArgs(a, redisAddr)
+	l.secretEncryptionArgs(a)
+	for _, name := range []string{
+		"environment-service", "experiment-service", "auto-ops-service", "event-counter-service",
+		"push-service", "feature-service", "subscription-service", "batch-service",
+	} {
+		a.set(name, l.gatewayAddr())
+	}
+	return a.
+		set("port", subscriberPort).
+		set("web-url", l.consoleURL()).
+		set("email-config-path", configs.email).
+		set("subscriber-config", configs.subscribers).
+		set("on-demand-subscriber-config", configs.onDemandSubscribers).
+		set("processors-config", configs.processors).
+		set("on-demand-processors-config", configs.onDemandProcessors).
+		set("mysql-db-open-conns", 10)
+}
+
+// commonArgs sets the TLS files and the storage. The MySQL flags are required
+// by every server but unused with SQLite.
+func (l *lite) commonArgs(a *args) {
+	a.set("cert", *l.certPath).
+		set("key", *l.keyPath).
+		set("service-token", *l.serviceTokenPath).
+		set("storage-type", "sqlite").
+		set("sqlite-path", *l.sqlitePath).
+		set("mysql-user", command).
+		set("mysql-pass", command).
+		set("mysql-host", "localhost").
+		set("mysql-port", 3306).
+		set("mysql-db-name", command)
+}
+
+func (l *lite) redisArgs(a *args, redisAddr string) {
+	for _, prefix := range []string{"persistent-redis", "non-persistent-redis"} {
+		a.set(prefix+"-server-name", prefix).
+			set(prefix+"-addr", redisAddr).
+			set(prefix+"-mode", "standalone")
+	}
+}
+
+// secretEncryptionArgs makes the servers storing secrets share the local key file.
+func (l *lite) secretEncryptionArgs(a *args) {
+	if *l.secretKeyFile == "" {
+		return
+	}
+	a.set("secret-encryption-backend", crypto.KeyBackendLocal).
+		set("secret-encryption-key", *l.secretKeyFile)
+}
+
+func webRoutes() []route {
+	routes := make([]route, 0, len(webServices)+8)
+	for _, s := range webServices {
+		routes = append(routes, route{prefix: "/" + s.name + "/", port: s.port})
+	}
+	return append(routes,
+		route{prefix: "/bucketeer.batch.BatchService/", port: batchPort},
+		route{prefix: "/hook", port: webAutoOpsPort},
+		route{prefix: "/webhook/", port: webGRPCGatewayPort},
+		route{prefix: "/v1/", port: webGRPCGatewayPort},
+		route{prefix: "/v1/aichat/chat", port: webDashboardPort},
+		route{prefix: "/health", port: webHealthCheckPort},
+		route{prefix: "/ready", port: webHealthCheckPort},
+		route{prefix: "/", port: webDashboardPort},
+	)
+}
+
+func apiRoutes() []route {
+	return []route{
+		{prefix: "/bucketeer.gateway.Gateway/", port: apiPort},
+		{prefix: "/v1/gateway/", port: apiRESTPort},
+		{prefix: "/health", port: apiPort},
+		{prefix: "/ready", port: apiPort},
+		{prefix: "/", port: apiGRPCGatewayPort},
+	}
+}
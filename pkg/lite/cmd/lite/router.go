@@ -0,0 +1,141 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lite
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// route forwards the requests whose path starts with prefix to the local port.
+// A prefix ending without a slash matches the gRPC service of that name.
+type route struct {
+	prefix string
+	port   int
+}
+
+// router is the single TLS entry point that stands in for the envoy and nginx
+// proxies of a regular deployment. It forwards gRPC, gRPC-Web and REST
+// requests to the service ports of the same process.
+type router struct {
+	server *http.Server
+	logger *zap.Logger
+}
+
+func newRouter(
+	port int,
+	routes []route,
+	certPath, keyPath string,
+	logger *zap.Logger,
+) (*router, error) {
+	transport, err := newBackendTransport(certPath)
+	if err != nil {
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	for _, r := range routes {
+		mux.Handle(r.prefix, newReverseProxy(r.port, transport, logger))
+	}
+	return &router{
+		server: &http.Server{
+			Addr:              fmt.Sprintf(":%d", port),
+			Handler:           mux,
+			ReadHeaderTimeout: 30 * time.Second,
+		},
+		logger: logger,
+	}, nil
+}
+
+// newBackendTransport returns a transport that speaks HTTP/2 over TLS to the
+// services, which serve gRPC and REST on the same TLS listener.
+func newBackendTransport(certPath string) (*http.Transport, error) {
+	cert, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(cert) {
+		return nil, fmt.Errorf("lite: failed to parse certificate %s", certPath)
+	}
+	return &http.Transport{
+		TLSClientConfig: &tls.Config{
+			RootCAs:    pool,
+			ServerName: "localhost",
+			MinVersion: tls.VersionTLS12,
+		},
+		ForceAttemptHTTP2:   true,
+		MaxIdleConnsPerHost: 100,
+		// Close idle connections before the backends do (60s by default),
+		// otherwise a proxied request may race with the backend's GOAWAY
+		// and fail since its body can't be replayed.
+		IdleConnTimeout: 30 * time.Second,
+	}, nil
+}
+
+func newReverseProxy(port int, transport http.RoundTripper, logger *zap.Logger) *httputil.ReverseProxy {
+	target := &url.URL{Scheme: "https", Host: fmt.Sprintf("localhost:%d", port)}
+	return &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(target)
+			r.SetXForwarded()
+		},
+		Transport: transport,
+		// Flush immediately so server streams and SSE are not buffered.
+		FlushInterval: -1,
+		ErrorHandler: func(w http.ResponseWriter, req *http.Request, err error) {
+			logger.Error("Failed to proxy request",
+				zap.Error(err),
+				zap.String("path", req.URL.Path),
+				zap.Int("port", port),
+			)
+			if strings.HasPrefix(req.Header.Get("Content-Type"), "application/grpc") {
+				// gRPC clients read the status from the headers, not the HTTP code.
+				w.Header().Set("Content-Type", req.Header.Get("Content-Type"))
+				w.Header().Set("Grpc-Status", "14") // UNAVAILABLE
+				w.Header().Set("Grpc-Message", "upstream unavailable")
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+}
+
+func (r *router) Run(certPath, keyPath string) error {
+	err := r.server.ListenAndServeTLS(certPath, keyPath)
+	if err != nil && err != http.ErrServerClosed {
+		r.logger.Error("Failed to serve", zap.Error(err), zap.String("addr", r.server.Addr))
+		return err
+	}
+	return nil
+}
+
+func (r *router) Stop(timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := r.server.Shutdown(ctx); err != nil {
+		r.logger.Error("Failed to shut down router", zap.Error(err), zap.String("addr", r.server.Addr))
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lite
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	batchclient "github.com/bucketeer-io/bucketeer/v2/pkg/batch/client"
+	batchproto "github.com/bucketeer-io/bucketeer/v2/proto/batch"
+)
+
+const (
+	everyMinute = time.Minute
+	everyDay    = 24 * time.Hour
+	everyWeek   = 7 * everyDay
+)
+
+type schedule struct {
+	job      batchproto.BatchJob
+	interval time.Duration
+}
+
+// schedules follows the docker-compose crontab, which runs the experiment jobs
+// every minute, and the Helm chart for the jobs the crontab leaves out.
+// The DomainEventInformer is run by the subscriber, not by the cron.
+var schedules = []schedule{
+	{batchproto.BatchJob_ExperimentStatusUpdater, everyMinute},
+	{batchproto.BatchJob_ExperimentRunningWatcher, everyDay},
+	{batchproto.BatchJob_FeatureStaleWatcher, everyWeek},
+	{batchproto.BatchJob_DatetimeWatcher, everyMinute},
+	{batchproto.BatchJob_EventCountWatcher, everyMinute},
+	{batchproto.BatchJob_RedisCounterDeleter, everyDay},
+	{batchproto.BatchJob_ProgressiveRolloutWatcher, everyMinute},
+	{batchproto.BatchJob_ExperimentCalculator, everyMinute},
+	{batchproto.BatchJob_FeatureFlagCacher, everyMinute},
+	{batchproto.BatchJob_SegmentUserCacher, everyMinute},
+	{batchproto.BatchJob_ApiKeyCacher, everyMinute},
+	{batchproto.BatchJob_AutoOpsRulesCacher, everyMinute},
+	{batchproto.BatchJob_ExperimentCacher, everyMinute},
+	{batchproto.BatchJob_TagDeleter, everyMinute},
+	{batchproto.BatchJob_FeatureAutoArchiver, everyDay},
+	{batchproto.BatchJob_ScheduledFlagChangeExecutor, everyMinute},
+	{batchproto.BatchJob_MonthlySummarizer, everyDay},
+	{batchproto.BatchJob_FeatureLifecycleUpdater, everyDay},
+	{batchproto.BatchJob_SecretKeyRotator, everyWeek},
+}
+
+// scheduler stands in for the Kubernetes CronJobs. Each job runs in its own
+// goroutine, so a long job only delays its own next run.
+type scheduler struct {
+	client    batchclient.Client
+	schedules []schedule
+	logger    *zap.Logger
+}
+
+func newScheduler(client batchclient.Client, schedules []schedule, logger *zap.Logger) *scheduler {
+	return &scheduler{
+		client:    client,
+		schedules: schedules,
+		logger:    logger.Named("scheduler"),
+	}
+}
+
+// Run blocks until ctx is done. Like cron, the first run of a job is one
+// interval after the start.
+func (s *scheduler) Run(ctx context.Context) {
+	wg := sync.WaitGroup{}
+	for _, sc := range s.schedules {
+		wg.Add(1)
+		go func(sc schedule) {
+			defer wg.Done()
+			s.loop(ctx, sc)
+		}(sc)
+	}
+	wg.Wait()
+}
+
+func (s *scheduler) loop(ctx context.Context, sc schedule) {
+	ticker := time.NewTicker(sc.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.execute(ctx, sc.job)
+		}
+	}
+}
+
+func (s *scheduler) execute(ctx context.Context, job batchproto.BatchJob) {
+	startTime := time.Now()
+	_, err := s.client.ExecuteBatchJob(ctx, &batchproto.BatchJobRequest{Job: job})
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		s.logger.Error("Failed to execute batch job",
+			zap.Error(err),
+			zap.Stringer("job", job),
+			zap.Duration("elapsed", time.Since(startTime)),
+		)
+		return
+	}
+	s.logger.Debug("Batch job executed",
+		zap.Stringer("job", job),
+		zap.Duration("elapsed", time.Since(startTime)),
+	)
+}
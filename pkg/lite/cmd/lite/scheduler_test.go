@@ -0,0 +1,91 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	batchclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/batch/client/mock"
+	batchproto "github.com/bucketeer-io/bucketeer/v2/proto/batch"
+)
+
+func TestSchedulesCoverBatchJobs(t *testing.T) {
+	t.Parallel()
+	scheduled := make(map[batchproto.BatchJob]bool, len(schedules))
+	for _, s := range schedules {
+		assert.False(t, scheduled[s.job], "%s is scheduled twice", s.job)
+		assert.Positive(t, s.interval, s.job.String())
+		scheduled[s.job] = true
+	}
+	for value, name := range batchproto.BatchJob_name {
+		job := batchproto.BatchJob(value)
+		if job == batchproto.BatchJob_DomainEventInformer {
+			assert.False(t, scheduled[job], name)
+			continue
+		}
+		assert.True(t, scheduled[job], "%s is not scheduled", name)
+	}
+}
+
+func TestSchedulerRun(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	client := batchclientmock.NewMockClient(mockController)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	executed := make(chan batchproto.BatchJob, 2)
+	client.EXPECT().ExecuteBatchJob(gomock.Any(), &batchproto.BatchJobRequest{
+		Job: batchproto.BatchJob_FeatureFlagCacher,
+	}).DoAndReturn(func(
+		_ context.Context,
+		req *batchproto.BatchJobRequest,
+		_ ...any,
+	) (*batchproto.BatchJobResponse, error) {
+		select {
+		case executed <- req.Job:
+		default:
+		}
+		return &batchproto.BatchJobResponse{}, nil
+	}).MinTimes(2)
+
+	s := newScheduler(client, []schedule{
+		{batchproto.BatchJob_FeatureFlagCacher, 10 * time.Millisecond},
+	}, zap.NewNop())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+	for i := 0; i < 2; i++ {
+		select {
+		case job := <-executed:
+			assert.Equal(t, batchproto.BatchJob_FeatureFlagCacher, job)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the job")
+		}
+	}
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("scheduler did not stop")
+	}
+}
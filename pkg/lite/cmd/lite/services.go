@@ -0,0 +1,116 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lite
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+	kingpin "gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/cli"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/metrics"
+)
+
+var envarTransformRegexp = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+// service is one of the Bucketeer servers run in-process. Its command is
+// registered on a kingpin application of its own, named like the standalone
+// binary, so any flag not set by lite can still be given through the same
+// environment variables, e.g. BUCKETEER_WEB_ACCESS_TOKEN_TTL.
+type service struct {
+	name     string
+	register func(cli.CommandRegistry, cli.ParentCommand) cli.Command
+	args     *args
+}
+
+func (s *service) appName() string {
+	return "bucketeer-" + s.name
+}
+
+// command parses the arguments and returns the server command ready to run.
+func (s *service) command() (cli.Command, error) {
+	app := kingpin.New(s.appName(), "")
+	app.DefaultEnvars()
+	cmd := s.register(nopRegistry{}, app)
+	argv := append([]string{cmd.FullCommand()}, s.args.list()...)
+	if _, err := app.Parse(argv); err != nil {
+		return nil, fmt.Errorf("lite: failed to parse the %s arguments: %w", s.name, err)
+	}
+	return cmd, nil
+}
+
+func (s *service) run(ctx context.Context, cmd cli.Command, m metrics.Metrics, logger *zap.Logger) error {
+	return cmd.Run(ctx, &serviceMetrics{Metrics: m, path: "/metrics/" + s.name}, logger.Named(s.name))
+}
+
+type nopRegistry struct{}
+
+func (nopRegistry) RegisterCommand(cli.Command) {}
+
+// serviceMetrics gives each service a registry of its own, served at path, so
+// the collectors the services have in common do not collide.
+type serviceMetrics struct {
+	metrics.Metrics
+	path string
+}
+
+func (m *serviceMetrics) DefaultRegisterer() metrics.Registerer {
+	return m.Registerer(m.path)
+}
+
+// args builds the command line of a service.
+type args struct {
+	app    string
+	names  []string
+	values map[string]string
+}
+
+func newArgs(app string) *args {
+	return &args{app: app, values: make(map[string]string)}
+}
+
+// set passes the flag regardless of the environment.
+func (a *args) set(name string, value any) *args {
+	if _, ok := a.values[name]; !ok {
+		a.names = append(a.names, name)
+	}
+	a.values[name] = fmt.Sprint(value)
+	return a
+}
+
+// fallback passes the flag unless its environment variable is set.
+func (a *args) fallback(name string, value any) *args {
+	if _, ok := os.LookupEnv(a.envar(name)); ok {
+		return a
+	}
+	return a.set(name, value)
+}
+
+func (a *args) envar(name string) string {
+	return strings.ToUpper(envarTransformRegexp.ReplaceAllString(a.app+"_"+name, "_"))
+}
+
+func (a *args) list() []string {
+	list := make([]string, 0, len(a.names))
+	for _, name := range a.names {
+		list = append(list, fmt.Sprintf("--%s=%s", name, a.values[name]))
+	}
+	return list
+}
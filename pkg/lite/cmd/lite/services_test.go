@@ -0,0 +1,76 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kingpin "gopkg.in/alecthomas/kingpin.v2"
+)
+
+func newTestLite(t *testing.T) *lite {
+	t.Helper()
+	app := kingpin.New("bucketeer", "")
+	cmd := RegisterCommand(nopRegistry{}, app)
+	_, err := app.Parse([]string{
+		"lite",
+		"--cert=tls.crt",
+		"--key=tls.key",
+		"--service-token=token",
+		"--oauth-public-key=public.pem",
+		"--oauth-private-key=private.pem",
+		"--oauth-config-path=oauth.json",
+	})
+	require.NoError(t, err)
+	return cmd.(*lite)
+}
+
+func TestServicesParse(t *testing.T) {
+	l := newTestLite(t)
+	configs, err := writeConfigs(t.TempDir())
+	require.NoError(t, err)
+	services := l.services("localhost:6379", configs)
+	require.Len(t, services, 4)
+	for _, s := range services {
+		_, err := s.command()
+		assert.NoError(t, err, s.name)
+	}
+}
+
+func TestArgs(t *testing.T) {
+	t.Setenv("BUCKETEER_BATCH_STAN_MODEL_ID", "model")
+	t.Setenv("BUCKETEER_BATCH_PORT", "1")
+	a := newArgs("bucketeer-batch").
+		set("port", 9130).
+		fallback("stan-model-id", "y3qsnd7m").
+		fallback("timezone", "UTC").
+		set("port", 9131)
+	assert.Equal(t, []string{"--port=9131", "--timezone=UTC"}, a.list())
+	assert.Equal(t, "BUCKETEER_BATCH_STAN_MODEL_ID", a.envar("stan-model-id"))
+}
+
+func TestRoutesAreUnique(t *testing.T) {
+	t.Parallel()
+	for name, routes := range map[string][]route{"web": webRoutes(), "api": apiRoutes()} {
+		prefixes := make(map[string]struct{}, len(routes))
+		for _, r := range routes {
+			_, ok := prefixes[r.prefix]
+			assert.False(t, ok, "%s: %s", name, r.prefix)
+			prefixes[r.prefix] = struct{}{}
+		}
+	}
+}
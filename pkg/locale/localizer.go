@@ -105,7 +105,11 @@ const (
 	FlagTrigger                  = "FlagTrigger"
 	CodeReference                = "CodeReference"
 	Team                         = "Team"
+	SCIMToken                    = "SCIMToken"
+	CustomRole                   = "CustomRole"
 	ScheduledFlagChange          = "ScheduledFlagChange"
+	ChangeRequest                = "ChangeRequest"
+	Guardrail                    = "Guardrail"
 	// error sentence
 	RequiredFieldTemplate = "RequiredField"
 	InternalServerError   = "InternalServerError"
@@ -171,6 +175,10 @@ const (
 	FailedTemplate                 = "Failed"
 	SkippedTemplate                = "Skipped"
 	AppliedNowTemplate             = "AppliedNow"
+	ApprovedTemplate               = "Approved"
+	RejectedTemplate               = "Rejected"
+	AppliedTemplate                = "Applied"
+	TriggeredTemplate              = "Triggered"
 )
 
 // subscription notifications
@@ -179,6 +187,23 @@ const (
 	NotificationExperimentRunning          = "NotificationExperimentRunning"
 	NotificationExperimentDaysLeftTemplate = "NotificationExperimentDaysLeft"
 	NotificationMAUCountTemplate           = "NotificationMAUCount"
+	NotificationFeatureStaleTitle          = "NotificationFeatureStaleTitle"
+	NotificationExperimentRunningTitle     = "NotificationExperimentRunningTitle"
+	NotificationMAUCountTitle              = "NotificationMAUCountTitle"
+	NotificationFeatureRemovalDue          = "NotificationFeatureRemovalDue"
+	NotificationFeatureRemovalDueTitle     = "NotificationFeatureRemovalDueTitle"
+	NotificationDemoOrganizationCreated    = "NotificationDemoOrganizationCreated"
+	NotificationEnvironment                = "NotificationEnvironment"
+	NotificationEntityID                   = "NotificationEntityID"
+	NotificationEditor                     = "NotificationEditor"
+	NotificationEventCount                 = "NotificationEventCount"
+	NotificationUserCount                  = "NotificationUserCount"
+	NotificationOrganizationID             = "NotificationOrganizationID"
+	NotificationOrganizationName           = "NotificationOrganizationName"
+	NotificationOwnerEmail                 = "NotificationOwnerEmail"
+	NotificationOpenLink                   = "NotificationOpenLink"
+	NotificationEmailSubjectTemplate       = "NotificationEmailSubject"
+	NotificationEmailFooter                = "NotificationEmailFooter"
 )
 
 func init() {
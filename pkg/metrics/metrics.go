@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -65,6 +66,7 @@ type metrics struct {
 	mux          *http.ServeMux
 	server       *http.Server
 	defaultPath  string
+	mu           sync.Mutex
 	registries   map[string]*registry
 	opts         *options
 	logger       *zap.Logger
@@ -107,23 +109,24 @@ func NewMetrics(port int, path string, opts ...Option) Metrics {
 }
 
 func (m *metrics) DefaultRegisterer() Registerer {
-	r := m.registries[m.defaultPath]
-	return r
+	return m.Registerer(m.defaultPath)
 }
 
+// Registerer returns the registry served at path, creating it if needed.
+// Registries created after Run are served as well.
 func (m *metrics) Registerer(path string) Registerer {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	if r, ok := m.registries[path]; ok {
 		return r
 	}
 	r := &registry{Registry: prometheus.NewRegistry()}
 	m.registries[path] = r
+	m.mux.Handle(path, promhttp.HandlerFor(r, promhttp.HandlerOpts{}))
 	return r
 }
 
 func (m *metrics) Run() error {
-	for p, r := range m.registries {
-		m.mux.Handle(p, promhttp.HandlerFor(r, promhttp.HandlerOpts{}))
-	}
 	m.mux.HandleFunc("/health", func(w http.ResponseWriter, req *http.Request) {
 		w.Write([]byte("healthy")) // nolint:errcheck
 	})
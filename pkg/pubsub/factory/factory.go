@@ -25,6 +25,8 @@ import (
 
 	"github.com/bucketeer-io/bucketeer/v2/pkg/metrics"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/kafka"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/memory"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/puller"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/redis"
@@ -39,6 +41,10 @@ const (
 	Google PubSubType = "google"
 	// RedisStream represents Redis Streams.
 	RedisStream PubSubType = "redis-stream"
+	// Kafka represents Apache Kafka.
+	Kafka PubSubType = "kafka"
+	// Memory represents the in-process PubSub, shared by the services of one process.
+	Memory PubSubType = "memory"
 )
 
 // ClientFactory represents a factory for creating PubSub clients.
@@ -56,12 +62,12 @@ type Client interface {
 	CreatePublisherInProject(topic, project string) (publisher.Publisher, error)
 	// CreatePuller creates a puller for the given subscription and topic.
 	// PullerOption is optional. For GCP, ExpirationPolicy sets auto-deletion
-	// of inactive subscriptions. For Redis, options are ignored.
+	// of inactive subscriptions. For Redis, Kafka and memory, options are ignored.
 	CreatePuller(subscription, topic string, opts ...puller.PullerOption) (puller.Puller, error)
 	// SubscriptionExists checks if a subscription exists.
 	SubscriptionExists(subscription string) (bool, error)
 	// DeleteSubscription deletes a subscription. Topic is the Redis Streams base name
-	// (same as CreatePuller); it is ignored for Google Pub/Sub.
+	// (same as CreatePuller); it is ignored for Google Pub/Sub and Kafka.
 	DeleteSubscription(subscription, topic string) error
 	// Close closes the client.
 	Close() error
@@ -76,6 +82,9 @@ type options struct {
 	logger         *zap.Logger
 	partitionCount int
 	idleTime       int // Redis Stream idle time in seconds
+	// Kafka
+	kafkaBrokers        []string
+	maxDeliveryAttempts int
 }
 
 // Option is a function that configures options.
@@ -116,7 +125,7 @@ func WithLogger(logger *zap.Logger) Option {
 	}
 }
 
-// WithPartitionCount sets the number of partitions for Redis Streams
+// WithPartitionCount sets the number of partitions for Redis Streams and Kafka topics
 func WithPartitionCount(count int) Option {
 	return func(opts *options) {
 		opts.partitionCount = count
@@ -130,6 +139,22 @@ func WithIdleTime(idleTimeSeconds int) Option {
 	}
 }
 
+// WithKafkaBrokers sets the addresses of the Kafka brokers.
+func WithKafkaBrokers(brokers []string) Option {
+	return func(opts *options) {
+		opts.kafkaBrokers = brokers
+	}
+}
+
+// WithMaxDeliveryAttempts sets how many times Kafka and the in-process PubSub
+// deliver a message before moving it to the dead-letter topic of the
+// subscription, or dropping it for the in-process PubSub.
+func WithMaxDeliveryAttempts(attempts int) Option {
+	return func(opts *options) {
+		opts.maxDeliveryAttempts = attempts
+	}
+}
+
 // NewClient creates a new PubSub client based on the provided options.
 func NewClient(ctx context.Context, opts ...Option) (Client, error) {
 	options := &options{
@@ -206,6 +231,38 @@ func NewClient(ctx context.Context, opts ...Option) (Client, error) {
 		// Redis Stream client already implements our interface
 		return client, nil
 
+	case Kafka:
+		if len(options.kafkaBrokers) == 0 {
+			return nil, fmt.Errorf("brokers are required for Kafka")
+		}
+		kafkaOpts := []kafka.Option{}
+		if options.metrics != nil {
+			kafkaOpts = append(kafkaOpts, kafka.WithMetrics(options.metrics))
+		}
+		if options.logger != nil {
+			kafkaOpts = append(kafkaOpts, kafka.WithLogger(options.logger))
+		}
+		if options.partitionCount > 0 {
+			kafkaOpts = append(kafkaOpts, kafka.WithPartitionCount(options.partitionCount))
+		}
+		if options.maxDeliveryAttempts > 0 {
+			kafkaOpts = append(kafkaOpts, kafka.WithMaxDeliveryAttempts(options.maxDeliveryAttempts))
+		}
+		return kafka.NewClient(options.kafkaBrokers, kafkaOpts...)
+
+	case Memory:
+		memoryOpts := []memory.Option{}
+		if options.metrics != nil {
+			memoryOpts = append(memoryOpts, memory.WithMetrics(options.metrics))
+		}
+		if options.logger != nil {
+			memoryOpts = append(memoryOpts, memory.WithLogger(options.logger))
+		}
+		if options.maxDeliveryAttempts > 0 {
+			memoryOpts = append(memoryOpts, memory.WithMaxDeliveryAttempts(options.maxDeliveryAttempts))
+		}
+		return memory.NewClient(memoryOpts...), nil
+
 	default:
 		return nil, fmt.Errorf("unsupported PubSub type: %s", options.pubSubType)
 	}
@@ -0,0 +1,279 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kafka provides a Kafka implementation of the PubSub publisher and puller.
+//
+// Subscriptions are consumer groups. Messages are keyed by environment so that the
+// events of an environment keep their order within a partition. Acknowledged messages
+// are committed in offset order, and negatively acknowledged messages are redelivered
+// through a retry topic owned by the subscription until they reach the maximum number
+// of delivery attempts and are moved to its dead-letter topic.
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/metrics"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/puller"
+)
+
+const (
+	defaultPartitionCount      = 16
+	defaultMaxDeliveryAttempts = 5
+	adminTimeout               = 30 * time.Second
+
+	retryTopicSuffix      = "-retry"
+	deadLetterTopicSuffix = "-dead-letter"
+)
+
+var (
+	ErrNoBrokers           = errors.New("kafka: no brokers")
+	ErrInvalidTopic        = errors.New("kafka: invalid topic")
+	ErrInvalidSubscription = errors.New("kafka: invalid subscription")
+)
+
+// Client is a Kafka implementation that can create publishers and pullers.
+type Client struct {
+	brokers []string
+	admin   *kafka.Client
+	opts    *options
+	logger  *zap.Logger
+}
+
+type options struct {
+	metrics             metrics.Registerer
+	logger              *zap.Logger
+	partitionCount      int
+	maxDeliveryAttempts int
+}
+
+type Option func(*options)
+
+// WithMetrics sets the metrics registerer for the client.
+func WithMetrics(registerer metrics.Registerer) Option {
+	return func(opts *options) {
+		opts.metrics = registerer
+	}
+}
+
+// WithLogger sets the logger for the client.
+func WithLogger(logger *zap.Logger) Option {
+	return func(opts *options) {
+		opts.logger = logger
+	}
+}
+
+// WithPartitionCount sets the number of partitions of the topics created by the client.
+func WithPartitionCount(count int) Option {
+	return func(opts *options) {
+		opts.partitionCount = count
+	}
+}
+
+// WithMaxDeliveryAttempts sets how many times a message is delivered
+// before it is moved to the dead-letter topic of the subscription.
+func WithMaxDeliveryAttempts(attempts int) Option {
+	return func(opts *options) {
+		opts.maxDeliveryAttempts = attempts
+	}
+}
+
+// NewClient creates a new Kafka client for the given brokers.
+func NewClient(brokers []string, opts ...Option) (*Client, error) {
+	if len(brokers) == 0 {
+		return nil, ErrNoBrokers
+	}
+	options := &options{
+		logger:              zap.NewNop(),
+		partitionCount:      defaultPartitionCount,
+		maxDeliveryAttempts: defaultMaxDeliveryAttempts,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return &Client{
+		brokers: brokers,
+		admin: &kafka.Client{
+			Addr:    kafka.TCP(brokers...),
+			Timeout: adminTimeout,
+		},
+		opts:   options,
+		logger: options.logger.Named("kafka-pubsub"),
+	}, nil
+}
+
+// CreatePublisher creates a publisher for the given topic, creating the topic if needed.
+func (c *Client) CreatePublisher(topic string) (publisher.Publisher, error) {
+	if topic == "" {
+		return nil, ErrInvalidTopic
+	}
+	if err := c.ensureTopics(topic); err != nil {
+		return nil, err
+	}
+	if c.opts.metrics != nil {
+		publisher.RegisterMetrics(c.opts.metrics)
+	}
+	return newPublisher(c.newWriter(topic), topic, c.logger), nil
+}
+
+// CreatePublisherInProject creates a publisher for the given topic.
+// Kafka has no projects, so this behaves the same as CreatePublisher.
+func (c *Client) CreatePublisherInProject(topic, _ string) (publisher.Publisher, error) {
+	return c.CreatePublisher(topic)
+}
+
+// CreatePuller creates a puller reading the topic with the subscription as consumer group.
+// PullerOption is accepted for interface compatibility but ignored, since consumer
+// groups expire according to the broker's offsets retention.
+func (c *Client) CreatePuller(subscription, topic string, _ ...puller.PullerOption) (puller.Puller, error) {
+	if subscription == "" {
+		return nil, ErrInvalidSubscription
+	}
+	if topic == "" {
+		return nil, ErrInvalidTopic
+	}
+	retryTopic := subscription + retryTopicSuffix
+	deadLetterTopic := subscription + deadLetterTopicSuffix
+	if err := c.ensureTopics(topic, retryTopic, deadLetterTopic); err != nil {
+		return nil, err
+	}
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     c.brokers,
+		GroupID:     subscription,
+		GroupTopics: []string{topic, retryTopic},
+		StartOffset: kafka.FirstOffset,
+		// Commits are sent asynchronously so that acknowledging does not block the handler.
+		CommitInterval: time.Second,
+	})
+	return newPuller(
+		reader,
+		c.newWriter(""),
+		subscription,
+		retryTopic,
+		deadLetterTopic,
+		c.opts.maxDeliveryAttempts,
+		c.logger,
+	), nil
+}
+
+// SubscriptionExists checks if the consumer group of the subscription exists.
+func (c *Client) SubscriptionExists(subscription string) (bool, error) {
+	if subscription == "" {
+		return false, ErrInvalidSubscription
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), adminTimeout)
+	defer cancel()
+	resp, err := c.admin.DescribeGroups(ctx, &kafka.DescribeGroupsRequest{
+		GroupIDs: []string{subscription},
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, g := range resp.Groups {
+		if g.GroupID != subscription {
+			continue
+		}
+		if errors.Is(g.Error, kafka.GroupIdNotFound) {
+			return false, nil
+		}
+		if g.Error != nil {
+			return false, g.Error
+		}
+		return g.GroupState != "Dead", nil
+	}
+	return false, nil
+}
+
+// DeleteSubscription deletes the consumer group of the subscription and its retry topic.
+// The dead-letter topic is kept so the messages in it can still be inspected.
+func (c *Client) DeleteSubscription(subscription, _ string) error {
+	if subscription == "" {
+		return ErrInvalidSubscription
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), adminTimeout)
+	defer cancel()
+	var errs []error
+	groups, err := c.admin.DeleteGroups(ctx, &kafka.DeleteGroupsRequest{
+		GroupIDs: []string{subscription},
+	})
+	if err != nil {
+		errs = append(errs, err)
+	} else if err := groups.Errors[subscription]; err != nil && !errors.Is(err, kafka.GroupIdNotFound) {
+		errs = append(errs, err)
+	}
+	retryTopic := subscription + retryTopicSuffix
+	topics, err := c.admin.DeleteTopics(ctx, &kafka.DeleteTopicsRequest{
+		Topics: []string{retryTopic},
+	})
+	if err != nil {
+		errs = append(errs, err)
+	} else if err := topics.Errors[retryTopic]; err != nil && !errors.Is(err, kafka.UnknownTopicOrPartition) {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("kafka delete subscription: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// Close closes the client.
+// Publishers and pullers own their connections and are closed by Stop and Pull.
+func (c *Client) Close() error {
+	return nil
+}
+
+func (c *Client) newWriter(topic string) *kafka.Writer {
+	return &kafka.Writer{
+		Addr:         kafka.TCP(c.brokers...),
+		Topic:        topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+		// The default of one second delays every Publish call waiting for a batch.
+		BatchTimeout: 10 * time.Millisecond,
+	}
+}
+
+// ensureTopics creates the topics that do not exist yet.
+func (c *Client) ensureTopics(topics ...string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), adminTimeout)
+	defer cancel()
+	configs := make([]kafka.TopicConfig, 0, len(topics))
+	for _, t := range topics {
+		configs = append(configs, kafka.TopicConfig{
+			Topic:             t,
+			NumPartitions:     c.opts.partitionCount,
+			ReplicationFactor: -1,
+		})
+	}
+	resp, err := c.admin.CreateTopics(ctx, &kafka.CreateTopicsRequest{Topics: configs})
+	if err != nil {
+		c.logger.Error("Failed to create topics", zap.Error(err), zap.Strings("topics", topics))
+		return err
+	}
+	for topic, err := range resp.Errors {
+		if err == nil || errors.Is(err, kafka.TopicAlreadyExists) {
+			continue
+		}
+		c.logger.Error("Failed to create topic", zap.Error(err), zap.String("topic", topic))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/proto" // nolint:staticcheck
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/trace"
+)
+
+const (
+	idHeader        = "id"
+	messagingSystem = "kafka"
+)
+
+type messageWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// environmentMessage is implemented by the events that belong to an environment.
+type environmentMessage interface {
+	GetEnvironmentId() string
+}
+
+type kafkaPublisher struct {
+	writer messageWriter
+	topic  string
+	logger *zap.Logger
+}
+
+func newPublisher(writer messageWriter, topic string, logger *zap.Logger) publisher.Publisher {
+	return &kafkaPublisher{
+		writer: writer,
+		topic:  topic,
+		logger: logger.Named("kafka-publisher"),
+	}
+}
+
+func (p *kafkaPublisher) Publish(ctx context.Context, msg publisher.Message) (err error) {
+	startTime := time.Now()
+	ctx, span := trace.StartProducerSpan(ctx, messagingSystem, p.topic)
+	defer func() {
+		publisher.ObservePublish(p.topic, err, startTime)
+		trace.EndSpan(span, err)
+	}()
+	m, err := p.newMessage(ctx, msg)
+	if err != nil {
+		return err
+	}
+	if err = p.writer.WriteMessages(ctx, m); err != nil {
+		p.logger.Error("Failed to write message",
+			zap.Error(err),
+			zap.String("topic", p.topic),
+			zap.String("id", msg.GetId()),
+		)
+	}
+	return
+}
+
+func (p *kafkaPublisher) PublishMulti(ctx context.Context, messages []publisher.Message) (errs map[string]error) {
+	startTime := time.Now()
+	ctx, span := trace.StartProducerSpan(ctx, messagingSystem, p.topic)
+	defer func() {
+		publisher.ObservePublishMulti(p.topic, len(messages), errs, startTime)
+		span.End()
+	}()
+	errs = make(map[string]error)
+	ids := make([]string, 0, len(messages))
+	msgs := make([]kafka.Message, 0, len(messages))
+	for _, msg := range messages {
+		m, err := p.newMessage(ctx, msg)
+		if err != nil {
+			errs[msg.GetId()] = err
+			continue
+		}
+		ids = append(ids, msg.GetId())
+		msgs = append(msgs, m)
+	}
+	if len(msgs) == 0 {
+		return
+	}
+	err := p.writer.WriteMessages(ctx, msgs...)
+	if err == nil {
+		return
+	}
+	p.logger.Error("Failed to write messages",
+		zap.Error(err),
+		zap.String("topic", p.topic),
+		zap.Int("count", len(msgs)),
+	)
+	// The writer reports the error of each message when only some of them failed.
+	var writeErrs kafka.WriteErrors
+	if errors.As(err, &writeErrs) && len(writeErrs) == len(msgs) {
+		for i, e := range writeErrs {
+			if e != nil {
+				errs[ids[i]] = e
+			}
+		}
+		return
+	}
+	for _, id := range ids {
+		errs[id] = err
+	}
+	return
+}
+
+func (p *kafkaPublisher) Stop() {
+	if err := p.writer.Close(); err != nil {
+		p.logger.Error("Failed to close writer", zap.Error(err), zap.String("topic", p.topic))
+	}
+}
+
+// newMessage encodes the message, keyed by its environment so that the events of
+// an environment go to the same partition. Messages without one are spread by id.
+// The trace context of ctx is carried in the headers.
+func (p *kafkaPublisher) newMessage(ctx context.Context, msg publisher.Message) (kafka.Message, error) {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		p.logger.Error("Failed to marshal message", zap.Error(err), zap.Any("message", msg))
+		return kafka.Message{}, publisher.ErrBadMessage
+	}
+	key := msg.GetId()
+	if m, ok := msg.(environmentMessage); ok && m.GetEnvironmentId() != "" {
+		key = m.GetEnvironmentId()
+	}
+	headers := []kafka.Header{{Key: idHeader, Value: []byte(msg.GetId())}}
+	carrier := make(map[string]string)
+	trace.InjectAttributes(ctx, carrier)
+	for k, v := range carrier {
+		headers = append(headers, kafka.Header{Key: k, Value: []byte(v)})
+	}
+	return kafka.Message{
+		Key:     []byte(key),
+		Value:   data,
+		Headers: headers,
+	}, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher"
+	domainproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+)
+
+func TestPublish(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc        string
+		msg         publisher.Message
+		writeErr    error
+		expectedKey string
+		expectedErr error
+	}{
+		{
+			desc:        "keyed by environment",
+			msg:         &domainproto.Event{Id: "id-0", EnvironmentId: "env-0"},
+			expectedKey: "env-0",
+		},
+		{
+			desc:        "keyed by id without environment",
+			msg:         &domainproto.Event{Id: "id-0"},
+			expectedKey: "id-0",
+		},
+		{
+			desc:        "err: write",
+			msg:         &domainproto.Event{Id: "id-0", EnvironmentId: "env-0"},
+			writeErr:    errors.New("write"),
+			expectedKey: "env-0",
+			expectedErr: errors.New("write"),
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			w := &fakeWriter{err: p.writeErr}
+			pub := newPublisher(w, "topic", zap.NewNop())
+			err := pub.Publish(context.Background(), p.msg)
+			assert.Equal(t, p.expectedErr, err)
+			require.Len(t, w.messages, 1)
+			assert.Equal(t, p.expectedKey, string(w.messages[0].Key))
+			assert.Equal(t, []kafka.Header{{Key: idHeader, Value: []byte("id-0")}}, w.messages[0].Headers)
+		})
+	}
+}
+
+func TestPublishMulti(t *testing.T) {
+	t.Parallel()
+	errWrite := errors.New("write")
+	messages := []publisher.Message{
+		&domainproto.Event{Id: "id-0", EnvironmentId: "env-0"},
+		&domainproto.Event{Id: "id-1", EnvironmentId: "env-1"},
+	}
+	patterns := []struct {
+		desc     string
+		writeErr error
+		expected map[string]error
+	}{
+		{
+			desc:     "success",
+			expected: map[string]error{},
+		},
+		{
+			desc:     "partial failure",
+			writeErr: kafka.WriteErrors{nil, errWrite},
+			expected: map[string]error{"id-1": errWrite},
+		},
+		{
+			desc:     "failure",
+			writeErr: errWrite,
+			expected: map[string]error{"id-0": errWrite, "id-1": errWrite},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			w := &fakeWriter{err: p.writeErr}
+			pub := newPublisher(w, "topic", zap.NewNop())
+			errs := pub.PublishMulti(context.Background(), messages)
+			assert.Equal(t, p.expected, errs)
+			assert.Len(t, w.messages, 2)
+		})
+	}
+}
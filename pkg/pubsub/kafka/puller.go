@@ -0,0 +1,276 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/puller"
+)
+
+const (
+	deliveryAttemptHeader = "delivery-attempt"
+	redeliveryTimeout     = 10 * time.Second
+)
+
+type messageReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+type kafkaPuller struct {
+	reader              messageReader
+	writer              messageWriter
+	subscription        string
+	retryTopic          string
+	deadLetterTopic     string
+	maxDeliveryAttempts int
+	tracker             *offsetTracker
+	logger              *zap.Logger
+}
+
+func newPuller(
+	reader messageReader,
+	writer messageWriter,
+	subscription, retryTopic, deadLetterTopic string,
+	maxDeliveryAttempts int,
+	logger *zap.Logger,
+) puller.Puller {
+	return &kafkaPuller{
+		reader:              reader,
+		writer:              writer,
+		subscription:        subscription,
+		retryTopic:          retryTopic,
+		deadLetterTopic:     deadLetterTopic,
+		maxDeliveryAttempts: maxDeliveryAttempts,
+		tracker:             newOffsetTracker(),
+		logger:              logger.Named("kafka-puller"),
+	}
+}
+
+// Pull fetches the messages of the subscription and calls the handler for each of them
+// until the context is canceled. Like Google Pub/Sub, it returns nil in that case.
+func (p *kafkaPuller) Pull(ctx context.Context, handler func(context.Context, *puller.Message)) error {
+	defer p.close()
+	for {
+		m, err := p.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, io.EOF) {
+				return nil
+			}
+			p.logger.Error("Failed to fetch message",
+				zap.Error(err),
+				zap.String("subscription", p.subscription),
+			)
+			return err
+		}
+		p.tracker.track(m.Topic, m.Partition, m.Offset)
+		handler(ctx, p.newMessage(ctx, m))
+	}
+}
+
+func (p *kafkaPuller) SubscriptionName() string {
+	return p.subscription
+}
+
+func (p *kafkaPuller) newMessage(ctx context.Context, m kafka.Message) *puller.Message {
+	attributes := make(map[string]string, len(m.Headers))
+	for _, h := range m.Headers {
+		attributes[h.Key] = string(h.Value)
+	}
+	id := attributes[idHeader]
+	if id == "" {
+		id = fmt.Sprintf("%s/%d/%d", m.Topic, m.Partition, m.Offset)
+	}
+	attempt := deliveryAttempt(attributes)
+	attributes[deliveryAttemptHeader] = strconv.Itoa(attempt)
+	var once sync.Once
+	return &puller.Message{
+		ID:         id,
+		Data:       m.Value,
+		Attributes: attributes,
+		Ack: func() {
+			once.Do(func() { p.settle(m) })
+		},
+		Nack: func() {
+			once.Do(func() {
+				// While shutting down, the message is left uncommitted instead, so the
+				// consumer that takes over the partition receives it again.
+				if ctx.Err() != nil {
+					return
+				}
+				p.redeliver(m, attempt)
+			})
+		},
+	}
+}
+
+// redeliver sends a negatively acknowledged message to the retry topic of the
+// subscription, or to its dead-letter topic once it was delivered too many times.
+// If that fails the message is left uncommitted so that it is not lost.
+func (p *kafkaPuller) redeliver(m kafka.Message, attempt int) {
+	topic := p.retryTopic
+	if attempt >= p.maxDeliveryAttempts {
+		topic = p.deadLetterTopic
+	}
+	headers := make([]kafka.Header, 0, len(m.Headers)+1)
+	for _, h := range m.Headers {
+		if h.Key != deliveryAttemptHeader {
+			headers = append(headers, h)
+		}
+	}
+	headers = append(headers, kafka.Header{
+		Key:   deliveryAttemptHeader,
+		Value: []byte(strconv.Itoa(attempt + 1)),
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), redeliveryTimeout)
+	defer cancel()
+	if err := p.writer.WriteMessages(ctx, kafka.Message{
+		Topic:   topic,
+		Key:     m.Key,
+		Value:   m.Value,
+		Headers: headers,
+	}); err != nil {
+		p.logger.Error("Failed to redeliver message",
+			zap.Error(err),
+			zap.String("subscription", p.subscription),
+			zap.String("topic", topic),
+			zap.Int("deliveryAttempt", attempt),
+		)
+		return
+	}
+	if topic == p.deadLetterTopic {
+		p.logger.Warn("Message moved to the dead-letter topic",
+			zap.String("subscription", p.subscription),
+			zap.String("topic", topic),
+			zap.Int("deliveryAttempt", attempt),
+		)
+	}
+	p.settle(m)
+}
+
+// settle marks the message as processed and commits the offsets that became contiguous.
+func (p *kafkaPuller) settle(m kafka.Message) {
+	offset, ok := p.tracker.settle(m.Topic, m.Partition, m.Offset)
+	if !ok {
+		return
+	}
+	if err := p.reader.CommitMessages(context.Background(), kafka.Message{
+		Topic:     m.Topic,
+		Partition: m.Partition,
+		Offset:    offset,
+	}); err != nil {
+		p.logger.Warn("Failed to commit offset",
+			zap.Error(err),
+			zap.String("subscription", p.subscription),
+			zap.String("topic", m.Topic),
+			zap.Int("partition", m.Partition),
+			zap.Int64("offset", offset),
+		)
+	}
+}
+
+func (p *kafkaPuller) close() {
+	if err := p.reader.Close(); err != nil {
+		p.logger.Error("Failed to close reader", zap.Error(err), zap.String("subscription", p.subscription))
+	}
+	if err := p.writer.Close(); err != nil {
+		p.logger.Error("Failed to close writer", zap.Error(err), zap.String("subscription", p.subscription))
+	}
+}
+
+func deliveryAttempt(attributes map[string]string) int {
+	attempt, err := strconv.Atoi(attributes[deliveryAttemptHeader])
+	if err != nil || attempt < 1 {
+		return 1
+	}
+	return attempt
+}
+
+type topicPartition struct {
+	topic     string
+	partition int
+}
+
+type partitionOffsets struct {
+	// pending holds the offsets fetched and not committed yet, in increasing order.
+	pending []int64
+	settled map[int64]struct{}
+}
+
+// offsetTracker finds the offsets that can be committed when messages are settled
+// out of order. Kafka only stores one offset per partition, so an offset is
+// committed once it and all the offsets fetched before it are settled.
+type offsetTracker struct {
+	mu         sync.Mutex
+	partitions map[topicPartition]*partitionOffsets
+}
+
+func newOffsetTracker() *offsetTracker {
+	return &offsetTracker{partitions: make(map[topicPartition]*partitionOffsets)}
+}
+
+func (t *offsetTracker) track(topic string, partition int, offset int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	key := topicPartition{topic: topic, partition: partition}
+	po, ok := t.partitions[key]
+	// An offset that is not after the last one means the partition was rewound,
+	// e.g. because it was assigned to this consumer again after a rebalance.
+	if !ok || (len(po.pending) > 0 && offset <= po.pending[len(po.pending)-1]) {
+		po = &partitionOffsets{settled: make(map[int64]struct{})}
+		t.partitions[key] = po
+	}
+	po.pending = append(po.pending, offset)
+}
+
+// settle marks the offset as settled and returns the last offset
+// of the settled run at the start of the partition, if any.
+func (t *offsetTracker) settle(topic string, partition int, offset int64) (int64, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	po, ok := t.partitions[topicPartition{topic: topic, partition: partition}]
+	if !ok {
+		return 0, false
+	}
+	i := sort.Search(len(po.pending), func(i int) bool { return po.pending[i] >= offset })
+	if i == len(po.pending) || po.pending[i] != offset {
+		// The offset was tracked before the partition was rewound.
+		return 0, false
+	}
+	po.settled[offset] = struct{}{}
+	last, committable := int64(0), false
+	for len(po.pending) > 0 {
+		o := po.pending[0]
+		if _, ok := po.settled[o]; !ok {
+			break
+		}
+		delete(po.settled, o)
+		po.pending = po.pending[1:]
+		last, committable = o, true
+	}
+	return last, committable
+}
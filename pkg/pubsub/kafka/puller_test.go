@@ -0,0 +1,231 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/puller"
+)
+
+type fakeWriter struct {
+	mu       sync.Mutex
+	messages []kafka.Message
+	err      error
+	closed   bool
+}
+
+func (w *fakeWriter) WriteMessages(_ context.Context, msgs ...kafka.Message) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.messages = append(w.messages, msgs...)
+	return w.err
+}
+
+func (w *fakeWriter) Close() error {
+	w.closed = true
+	return nil
+}
+
+type fakeReader struct {
+	mu       sync.Mutex
+	messages []kafka.Message
+	commits  []kafka.Message
+	closed   bool
+}
+
+func (r *fakeReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	r.mu.Lock()
+	if len(r.messages) > 0 {
+		m := r.messages[0]
+		r.messages = r.messages[1:]
+		r.mu.Unlock()
+		return m, nil
+	}
+	r.mu.Unlock()
+	<-ctx.Done()
+	return kafka.Message{}, ctx.Err()
+}
+
+func (r *fakeReader) CommitMessages(_ context.Context, msgs ...kafka.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.commits = append(r.commits, msgs...)
+	return nil
+}
+
+func (r *fakeReader) Close() error {
+	r.closed = true
+	return nil
+}
+
+func newPullerWithFakes(messages ...kafka.Message) (*kafkaPuller, *fakeReader, *fakeWriter) {
+	r := &fakeReader{messages: messages}
+	w := &fakeWriter{}
+	p := newPuller(r, w, "sub", "sub-retry", "sub-dead-letter", 3, zap.NewNop()).(*kafkaPuller)
+	return p, r, w
+}
+
+func TestPull(t *testing.T) {
+	t.Parallel()
+	p, r, w := newPullerWithFakes(
+		kafka.Message{Topic: "topic", Partition: 0, Offset: 0, Value: []byte("a"),
+			Headers: []kafka.Header{{Key: idHeader, Value: []byte("id-0")}}},
+		kafka.Message{Topic: "topic", Partition: 0, Offset: 1, Value: []byte("b")},
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	var received []*puller.Message
+	err := p.Pull(ctx, func(_ context.Context, msg *puller.Message) {
+		received = append(received, msg)
+		if len(received) == 2 {
+			cancel()
+		}
+	})
+	require.NoError(t, err)
+	require.Len(t, received, 2)
+	assert.Equal(t, "id-0", received[0].ID)
+	assert.Equal(t, []byte("a"), received[0].Data)
+	assert.Equal(t, "1", received[0].Attributes[deliveryAttemptHeader])
+	assert.Equal(t, "topic/0/1", received[1].ID)
+
+	// Acknowledging out of order commits once the offsets are contiguous.
+	received[1].Ack()
+	assert.Empty(t, r.commits)
+	received[0].Ack()
+	received[0].Ack()
+	assert.Equal(t, []kafka.Message{{Topic: "topic", Partition: 0, Offset: 1}}, r.commits)
+	assert.True(t, r.closed)
+	assert.True(t, w.closed)
+}
+
+func TestNack(t *testing.T) {
+	t.Parallel()
+	newMessage := func(attempt string) kafka.Message {
+		return kafka.Message{
+			Topic:     "topic",
+			Partition: 1,
+			Offset:    5,
+			Key:       []byte("env-0"),
+			Value:     []byte("a"),
+			Headers: []kafka.Header{
+				{Key: idHeader, Value: []byte("id-0")},
+				{Key: deliveryAttemptHeader, Value: []byte(attempt)},
+			},
+		}
+	}
+	patterns := []struct {
+		desc            string
+		attempt         string
+		writeErr        error
+		canceled        bool
+		expectedTopic   string
+		expectedAttempt string
+		expectedCommit  bool
+	}{
+		{
+			desc:            "redelivered through the retry topic",
+			attempt:         "1",
+			expectedTopic:   "sub-retry",
+			expectedAttempt: "2",
+			expectedCommit:  true,
+		},
+		{
+			desc:            "moved to the dead-letter topic",
+			attempt:         "3",
+			expectedTopic:   "sub-dead-letter",
+			expectedAttempt: "4",
+			expectedCommit:  true,
+		},
+		{
+			desc:            "left uncommitted when the redelivery fails",
+			attempt:         "1",
+			writeErr:        errors.New("write"),
+			expectedTopic:   "sub-retry",
+			expectedAttempt: "2",
+		},
+		{
+			desc:     "left uncommitted while shutting down",
+			attempt:  "1",
+			canceled: true,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			pl, r, w := newPullerWithFakes()
+			w.err = p.writeErr
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if p.canceled {
+				cancel()
+			}
+			m := newMessage(p.attempt)
+			pl.tracker.track(m.Topic, m.Partition, m.Offset)
+			pl.newMessage(ctx, m).Nack()
+			if p.expectedTopic == "" {
+				assert.Empty(t, w.messages)
+			} else {
+				require.Len(t, w.messages, 1)
+				assert.Equal(t, p.expectedTopic, w.messages[0].Topic)
+				assert.Equal(t, m.Key, w.messages[0].Key)
+				assert.Equal(t, m.Value, w.messages[0].Value)
+				assert.Equal(t, []kafka.Header{
+					{Key: idHeader, Value: []byte("id-0")},
+					{Key: deliveryAttemptHeader, Value: []byte(p.expectedAttempt)},
+				}, w.messages[0].Headers)
+			}
+			if p.expectedCommit {
+				assert.Equal(t, []kafka.Message{{Topic: "topic", Partition: 1, Offset: 5}}, r.commits)
+			} else {
+				assert.Empty(t, r.commits)
+			}
+		})
+	}
+}
+
+func TestOffsetTracker(t *testing.T) {
+	t.Parallel()
+	tracker := newOffsetTracker()
+	for _, o := range []int64{10, 11, 12} {
+		tracker.track("topic", 0, o)
+	}
+	tracker.track("topic", 1, 3)
+
+	_, ok := tracker.settle("topic", 0, 11)
+	assert.False(t, ok)
+	offset, ok := tracker.settle("topic", 0, 10)
+	assert.True(t, ok)
+	assert.Equal(t, int64(11), offset)
+	offset, ok = tracker.settle("topic", 1, 3)
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), offset)
+	_, ok = tracker.settle("other", 0, 12)
+	assert.False(t, ok)
+
+	// After a rewind, the offsets tracked before are ignored.
+	tracker.track("topic", 0, 11)
+	_, ok = tracker.settle("topic", 0, 12)
+	assert.False(t, ok)
+	offset, ok = tracker.settle("topic", 0, 11)
+	assert.True(t, ok)
+	assert.Equal(t, int64(11), offset)
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package memory provides an in-process implementation of the PubSub publisher and puller.
+//
+// Topics and subscriptions live in a Broker. Clients created without one share the
+// default broker of the process, so that services running in the same binary exchange
+// messages as they would through a real PubSub. Like Google Pub/Sub, a message is
+// delivered to every subscription of its topic that exists when it is published.
+// Nothing is persisted: the pending messages are lost when the process exits.
+package memory
+
+import (
+	"errors"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/metrics"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/puller"
+)
+
+const defaultMaxDeliveryAttempts = 5
+
+var (
+	ErrInvalidTopic        = errors.New("memory: invalid topic")
+	ErrInvalidSubscription = errors.New("memory: invalid subscription")
+
+	defaultBroker = NewBroker()
+)
+
+// Broker holds the subscriptions of the topics.
+type Broker struct {
+	mu sync.RWMutex
+	// subscriptions by topic, then by name.
+	topics        map[string]map[string]*subscription
+	subscriptions map[string]*subscription
+}
+
+// NewBroker creates an empty broker.
+func NewBroker() *Broker {
+	return &Broker{
+		topics:        make(map[string]map[string]*subscription),
+		subscriptions: make(map[string]*subscription),
+	}
+}
+
+// DefaultBroker returns the broker shared by the clients of the process.
+func DefaultBroker() *Broker {
+	return defaultBroker
+}
+
+func (b *Broker) subscribe(name, topic string) *subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if sub, ok := b.subscriptions[name]; ok && sub.topic == topic {
+		return sub
+	}
+	b.unsubscribe(name)
+	sub := newSubscription(name, topic)
+	b.subscriptions[name] = sub
+	if b.topics[topic] == nil {
+		b.topics[topic] = make(map[string]*subscription)
+	}
+	b.topics[topic][name] = sub
+	return sub
+}
+
+// unsubscribe must be called with the lock held.
+func (b *Broker) unsubscribe(name string) {
+	sub, ok := b.subscriptions[name]
+	if !ok {
+		return
+	}
+	delete(b.subscriptions, name)
+	delete(b.topics[sub.topic], name)
+	if len(b.topics[sub.topic]) == 0 {
+		delete(b.topics, sub.topic)
+	}
+}
+
+func (b *Broker) publish(topic string, msgs ...*message) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, sub := range b.topics[topic] {
+		sub.push(msgs...)
+	}
+}
+
+// Client is an in-process implementation that can create publishers and pullers.
+type Client struct {
+	broker *Broker
+	opts   *options
+	logger *zap.Logger
+}
+
+type options struct {
+	broker              *Broker
+	metrics             metrics.Registerer
+	logger              *zap.Logger
+	maxDeliveryAttempts int
+}
+
+type Option func(*options)
+
+// WithBroker sets the broker of the client instead of the default one of the process.
+func WithBroker(broker *Broker) Option {
+	return func(opts *options) {
+		opts.broker = broker
+	}
+}
+
+// WithMetrics sets the metrics registerer for the client.
+func WithMetrics(registerer metrics.Registerer) Option {
+	return func(opts *options) {
+		opts.metrics = registerer
+	}
+}
+
+// WithLogger sets the logger for the client.
+func WithLogger(logger *zap.Logger) Option {
+	return func(opts *options) {
+		opts.logger = logger
+	}
+}
+
+// WithMaxDeliveryAttempts sets how many times a negatively acknowledged message
+// is delivered before it is dropped.
+func WithMaxDeliveryAttempts(attempts int) Option {
+	return func(opts *options) {
+		opts.maxDeliveryAttempts = attempts
+	}
+}
+
+// NewClient creates a new in-process client.
+func NewClient(opts ...Option) *Client {
+	options := &options{
+		broker:              defaultBroker,
+		logger:              zap.NewNop(),
+		maxDeliveryAttempts: defaultMaxDeliveryAttempts,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return &Client{
+		broker: options.broker,
+		opts:   options,
+		logger: options.logger.Named("memory-pubsub"),
+	}
+}
+
+// CreatePublisher creates a publisher for the given topic.
+func (c *Client) CreatePublisher(topic string) (publisher.Publisher, error) {
+	if topic == "" {
+		return nil, ErrInvalidTopic
+	}
+	if c.opts.metrics != nil {
+		publisher.RegisterMetrics(c.opts.metrics)
+	}
+	return newPublisher(c.broker, topic, c.logger), nil
+}
+
+// CreatePublisherInProject creates a publisher for the given topic.
+// There are no projects in memory, so this behaves the same as CreatePublisher.
+func (c *Client) CreatePublisherInProject(topic, _ string) (publisher.Publisher, error) {
+	return c.CreatePublisher(topic)
+}
+
+// CreatePuller creates the subscription if needed and a puller reading it.
+// PullerOption is accepted for interface compatibility but ignored.
+func (c *Client) CreatePuller(subscription, topic string, _ ...puller.PullerOption) (puller.Puller, error) {
+	if subscription == "" {
+		return nil, ErrInvalidSubscription
+	}
+	if topic == "" {
+		return nil, ErrInvalidTopic
+	}
+	sub := c.broker.subscribe(subscription, topic)
+	return newPuller(sub, c.opts.maxDeliveryAttempts, c.logger), nil
+}
+
+// SubscriptionExists checks if the subscription exists.
+func (c *Client) SubscriptionExists(subscription string) (bool, error) {
+	if subscription == "" {
+		return false, ErrInvalidSubscription
+	}
+	c.broker.mu.RLock()
+	defer c.broker.mu.RUnlock()
+	_, ok := c.broker.subscriptions[subscription]
+	return ok, nil
+}
+
+// DeleteSubscription deletes the subscription and its pending messages.
+func (c *Client) DeleteSubscription(subscription, _ string) error {
+	if subscription == "" {
+		return ErrInvalidSubscription
+	}
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+	c.broker.unsubscribe(subscription)
+	return nil
+}
+
+// Close closes the client. The broker and its subscriptions are kept.
+func (c *Client) Close() error {
+	return nil
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto" // nolint:staticcheck
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/puller"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/trace"
+	domainproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+)
+
+// pull runs the puller in the background and returns the received messages.
+func pull(t *testing.T, p puller.Puller) <-chan *puller.Message {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	received := make(chan *puller.Message, 10)
+	go p.Pull(ctx, func(_ context.Context, msg *puller.Message) { // nolint:errcheck
+		received <- msg
+	})
+	return received
+}
+
+func receive(t *testing.T, received <-chan *puller.Message, timeout time.Duration) *puller.Message {
+	t.Helper()
+	select {
+	case msg := <-received:
+		return msg
+	case <-time.After(timeout):
+		require.Fail(t, "no message received")
+		return nil
+	}
+}
+
+func TestPublishFansOutToSubscriptions(t *testing.T) {
+	t.Parallel()
+	client := NewClient(WithBroker(NewBroker()))
+	pub, err := client.CreatePublisher("topic")
+	require.NoError(t, err)
+
+	// Published before any subscription exists, so it is not delivered.
+	require.NoError(t, pub.Publish(context.Background(), &domainproto.Event{Id: "id-0"}))
+
+	p1, err := client.CreatePuller("sub-1", "topic")
+	require.NoError(t, err)
+	p2, err := client.CreatePuller("sub-2", "topic")
+	require.NoError(t, err)
+	other, err := client.CreatePuller("sub-3", "other-topic")
+	require.NoError(t, err)
+	errs := pub.PublishMulti(context.Background(), []publisher.Message{
+		&domainproto.Event{Id: "id-1", EnvironmentId: "env-0"},
+		&domainproto.Event{Id: "id-2", EnvironmentId: "env-0"},
+	})
+	assert.Empty(t, errs)
+
+	for _, p := range []puller.Puller{p1, p2} {
+		received := pull(t, p)
+		for _, id := range []string{"id-1", "id-2"} {
+			msg := receive(t, received, time.Second)
+			assert.Equal(t, id, msg.ID)
+			event := &domainproto.Event{}
+			require.NoError(t, proto.Unmarshal(msg.Data, event))
+			assert.Equal(t, id, event.Id)
+			assert.Equal(t, "env-0", event.EnvironmentId)
+			msg.Ack()
+		}
+	}
+	select {
+	case msg := <-pull(t, other):
+		assert.Fail(t, "unexpected message", msg.ID)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestNackRedelivers(t *testing.T) {
+	t.Parallel()
+	client := NewClient(WithBroker(NewBroker()), WithMaxDeliveryAttempts(2))
+	pub, err := client.CreatePublisher("topic")
+	require.NoError(t, err)
+	p, err := client.CreatePuller("sub", "topic")
+	require.NoError(t, err)
+	received := pull(t, p)
+	require.NoError(t, pub.Publish(context.Background(), &domainproto.Event{Id: "id-0"}))
+
+	msg := receive(t, received, time.Second)
+	assert.Equal(t, "id-0", msg.Attributes[idAttribute])
+	assert.Equal(t, "1", msg.Attributes[deliveryAttemptAttribute])
+	msg.Nack()
+	msg = receive(t, received, 3*redeliveryDelay)
+	assert.Equal(t, "id-0", msg.ID)
+	assert.Equal(t, "2", msg.Attributes[deliveryAttemptAttribute])
+	// The last attempt is dropped instead of being delivered again.
+	msg.Nack()
+	select {
+	case msg := <-received:
+		assert.Fail(t, "unexpected message", msg.ID)
+	case <-time.After(2 * redeliveryDelay):
+	}
+}
+
+func TestPublishPropagatesTraceContext(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prevProvider := otel.GetTracerProvider()
+	prevPropagator := otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	}()
+
+	client := NewClient(WithBroker(NewBroker()))
+	pub, err := client.CreatePublisher("topic")
+	require.NoError(t, err)
+	p, err := client.CreatePuller("sub", "topic")
+	require.NoError(t, err)
+	received := pull(t, p)
+
+	ctx, span := trace.StartSpan(context.Background(), "UpdateFeature")
+	require.NoError(t, pub.Publish(ctx, &domainproto.Event{Id: "id-0"}))
+	span.End()
+
+	msg := receive(t, received, time.Second)
+	assert.Equal(t, "id-0", msg.Attributes[idAttribute])
+	_, consumer := trace.StartConsumerSpan(context.Background(), "process", msg.Attributes)
+	consumer.End()
+	msg.Ack()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	traceID := span.SpanContext().TraceID()
+	for _, s := range spans {
+		assert.Equal(t, traceID, s.SpanContext().TraceID(), s.Name())
+	}
+	assert.Equal(t, "topic publish", spans[0].Name())
+	assert.Equal(t, spans[0].SpanContext().SpanID(), spans[2].Parent().SpanID())
+}
+
+func TestSubscriptions(t *testing.T) {
+	t.Parallel()
+	client := NewClient(WithBroker(NewBroker()))
+	exists, err := client.SubscriptionExists("sub")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = client.CreatePuller("sub", "topic")
+	require.NoError(t, err)
+	exists, err = client.SubscriptionExists("sub")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	require.NoError(t, client.DeleteSubscription("sub", "topic"))
+	exists, err = client.SubscriptionExists("sub")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = client.CreatePuller("", "topic")
+	assert.Equal(t, ErrInvalidSubscription, err)
+	_, err = client.CreatePublisher("")
+	assert.Equal(t, ErrInvalidTopic, err)
+}
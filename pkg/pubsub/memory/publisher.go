@@ -0,0 +1,92 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/proto" // nolint:staticcheck
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/trace"
+)
+
+// messagingSystem identifies the in-memory broker in the tracing spans.
+const messagingSystem = "memory"
+
+type memoryPublisher struct {
+	broker *Broker
+	topic  string
+	logger *zap.Logger
+}
+
+func newPublisher(broker *Broker, topic string, logger *zap.Logger) publisher.Publisher {
+	return &memoryPublisher{
+		broker: broker,
+		topic:  topic,
+		logger: logger.Named("memory-publisher"),
+	}
+}
+
+func (p *memoryPublisher) Publish(ctx context.Context, msg publisher.Message) (err error) {
+	startTime := time.Now()
+	ctx, span := trace.StartProducerSpan(ctx, messagingSystem, p.topic)
+	defer func() {
+		publisher.ObservePublish(p.topic, err, startTime)
+		trace.EndSpan(span, err)
+	}()
+	m, err := p.newMessage(ctx, msg)
+	if err != nil {
+		return err
+	}
+	p.broker.publish(p.topic, m)
+	return nil
+}
+
+func (p *memoryPublisher) PublishMulti(ctx context.Context, messages []publisher.Message) (errs map[string]error) {
+	startTime := time.Now()
+	ctx, span := trace.StartProducerSpan(ctx, messagingSystem, p.topic)
+	defer func() {
+		publisher.ObservePublishMulti(p.topic, len(messages), errs, startTime)
+		span.End()
+	}()
+	errs = make(map[string]error)
+	msgs := make([]*message, 0, len(messages))
+	for _, msg := range messages {
+		m, err := p.newMessage(ctx, msg)
+		if err != nil {
+			errs[msg.GetId()] = err
+			continue
+		}
+		msgs = append(msgs, m)
+	}
+	p.broker.publish(p.topic, msgs...)
+	return
+}
+
+func (p *memoryPublisher) Stop() {}
+
+func (p *memoryPublisher) newMessage(ctx context.Context, msg publisher.Message) (*message, error) {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		p.logger.Error("Failed to marshal message", zap.Error(err), zap.Any("message", msg))
+		return nil, publisher.ErrBadMessage
+	}
+	carrier := make(map[string]string)
+	trace.InjectAttributes(ctx, carrier)
+	return &message{id: msg.GetId(), data: data, trace: carrier}, nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/puller"
+)
+
+const (
+	// The processors ack messages without the "id" attribute as missing-ID errors.
+	idAttribute              = "id"
+	deliveryAttemptAttribute = "delivery-attempt"
+	redeliveryDelay          = time.Second
+)
+
+type message struct {
+	id   string
+	data []byte
+	// trace carries the trace context of the publisher.
+	trace   map[string]string
+	attempt int
+}
+
+// subscription is an unbounded queue of the messages waiting to be pulled.
+type subscription struct {
+	name   string
+	topic  string
+	mu     sync.Mutex
+	queue  []*message
+	notify chan struct{}
+}
+
+func newSubscription(name, topic string) *subscription {
+	return &subscription{
+		name:   name,
+		topic:  topic,
+		notify: make(chan struct{}, 1),
+	}
+}
+
+func (s *subscription) push(msgs ...*message) {
+	if len(msgs) == 0 {
+		return
+	}
+	s.mu.Lock()
+	for _, m := range msgs {
+		// Each subscription counts the delivery attempts of its own copy.
+		copied := *m
+		s.queue = append(s.queue, &copied)
+	}
+	s.mu.Unlock()
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// pop waits for the next message until the context is canceled.
+func (s *subscription) pop(ctx context.Context) (*message, bool) {
+	for {
+		s.mu.Lock()
+		if len(s.queue) > 0 {
+			m := s.queue[0]
+			s.queue[0] = nil
+			s.queue = s.queue[1:]
+			more := len(s.queue) > 0
+			s.mu.Unlock()
+			if more {
+				// Wake up the other pullers of the subscription.
+				select {
+				case s.notify <- struct{}{}:
+				default:
+				}
+			}
+			return m, true
+		}
+		s.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-s.notify:
+		}
+	}
+}
+
+type memoryPuller struct {
+	sub                 *subscription
+	maxDeliveryAttempts int
+	logger              *zap.Logger
+}
+
+func newPuller(sub *subscription, maxDeliveryAttempts int, logger *zap.Logger) puller.Puller {
+	return &memoryPuller{
+		sub:                 sub,
+		maxDeliveryAttempts: maxDeliveryAttempts,
+		logger:              logger.Named("memory-puller"),
+	}
+}
+
+// Pull calls the handler for each message of the subscription until the context
+// is canceled. Like Google Pub/Sub, it returns nil in that case.
+func (p *memoryPuller) Pull(ctx context.Context, handler func(context.Context, *puller.Message)) error {
+	for {
+		m, ok := p.sub.pop(ctx)
+		if !ok {
+			return nil
+		}
+		handler(ctx, p.newMessage(m))
+	}
+}
+
+func (p *memoryPuller) SubscriptionName() string {
+	return p.sub.name
+}
+
+func (p *memoryPuller) newMessage(m *message) *puller.Message {
+	attempt := m.attempt + 1
+	var once sync.Once
+	attributes := make(map[string]string, len(m.trace)+2)
+	for k, v := range m.trace {
+		attributes[k] = v
+	}
+	attributes[idAttribute] = m.id
+	attributes[deliveryAttemptAttribute] = strconv.Itoa(attempt)
+	return &puller.Message{
+		ID:         m.id,
+		Data:       m.data,
+		Attributes: attributes,
+		// Once acknowledged, a later Nack must not deliver the message again.
+		Ack: func() {
+			once.Do(func() {})
+		},
+		Nack: func() {
+			once.Do(func() { p.redeliver(m, attempt) })
+		},
+	}
+}
+
+// redeliver queues a negatively acknowledged message again after a delay,
+// or drops it once it was delivered too many times.
+func (p *memoryPuller) redeliver(m *message, attempt int) {
+	if attempt >= p.maxDeliveryAttempts {
+		p.logger.Error("Dropping message after too many delivery attempts",
+			zap.String("subscription", p.sub.name),
+			zap.String("id", m.id),
+			zap.Int("attempts", attempt),
+		)
+		return
+	}
+	redelivered := &message{id: m.id, data: m.data, trace: m.trace, attempt: attempt}
+	time.AfterFunc(redeliveryDelay, func() {
+		p.sub.push(redelivered)
+	})
+}
@@ -17,6 +17,7 @@ package publisher
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -79,3 +80,32 @@ func registerMetrics(r metrics.Registerer) {
 		)
 	})
 }
+
+// RegisterMetrics registers the publisher metrics.
+// Publishers of other backends use it to report the same metrics as this package.
+func RegisterMetrics(r metrics.Registerer) {
+	registerMetrics(r)
+}
+
+// ObservePublish records the result of publishing one message to the topic.
+func ObservePublish(topic string, err error, startTime time.Time) {
+	code := convertErrorToCode(err)
+	handledCounter.WithLabelValues(topic, methodPublish, code).Inc()
+	handledHistogram.WithLabelValues(topic, methodPublish, code).Observe(time.Since(startTime).Seconds())
+}
+
+// ObservePublishMulti records the result of publishing a batch of messages to the topic.
+func ObservePublishMulti(topic string, messages int, errs map[string]error, startTime time.Time) {
+	for _, err := range errs {
+		code := convertErrorToCode(err)
+		handledCounter.WithLabelValues(topic, methodPublishMulti, code).Inc()
+	}
+	if successes := messages - len(errs); successes > 0 {
+		handledCounter.WithLabelValues(topic, methodPublishMulti, codeOK).Add(float64(successes))
+	}
+	histogramCode := codeOK
+	if len(errs) > 0 {
+		histogramCode = codeUnknown
+	}
+	handledHistogram.WithLabelValues(topic, methodPublishMulti, histogramCode).Observe(time.Since(startTime).Seconds())
+}
@@ -26,10 +26,13 @@ import (
 	"google.golang.org/protobuf/runtime/protoiface"
 
 	"github.com/bucketeer-io/bucketeer/v2/pkg/metrics"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/trace"
 )
 
 const (
 	idAttribute = "id"
+	// messagingSystem identifies Google Cloud Pub/Sub in the tracing spans
+	messagingSystem = "gcp_pubsub"
 )
 
 var (
@@ -89,11 +92,10 @@ func NewPublisher(topic *pubsub.Topic, opts ...Option) Publisher {
 
 func (p *publisher) Publish(ctx context.Context, msg Message) (err error) {
 	startTime := time.Now()
+	ctx, span := trace.StartProducerSpan(ctx, messagingSystem, p.topic.ID())
 	defer func() {
-		topicID := p.topic.ID()
-		code := convertErrorToCode(err)
-		handledCounter.WithLabelValues(topicID, methodPublish, code).Inc()
-		handledHistogram.WithLabelValues(topicID, methodPublish, code).Observe(time.Since(startTime).Seconds())
+		ObservePublish(p.topic.ID(), err, startTime)
+		trace.EndSpan(span, err)
 	}()
 	data, err := proto.Marshal(msg)
 	if err != nil {
@@ -102,7 +104,7 @@ func (p *publisher) Publish(ctx context.Context, msg Message) (err error) {
 	}
 	res := p.topic.Publish(ctx, &pubsub.Message{
 		Data:       data,
-		Attributes: map[string]string{idAttribute: msg.GetId()},
+		Attributes: newAttributes(ctx, msg.GetId()),
 	})
 	_, err = res.Get(ctx)
 	return
@@ -110,20 +112,10 @@ func (p *publisher) Publish(ctx context.Context, msg Message) (err error) {
 
 func (p *publisher) PublishMulti(ctx context.Context, messages []Message) (errors map[string]error) {
 	startTime := time.Now()
+	ctx, span := trace.StartProducerSpan(ctx, messagingSystem, p.topic.ID())
 	defer func() {
-		topicID := p.topic.ID()
-		for _, err := range errors {
-			code := convertErrorToCode(err)
-			handledCounter.WithLabelValues(topicID, methodPublishMulti, code).Inc()
-		}
-		if successes := len(messages) - len(errors); successes > 0 {
-			handledCounter.WithLabelValues(topicID, methodPublishMulti, codeOK).Add(float64(successes))
-		}
-		histogramCode := codeOK
-		if len(errors) > 0 {
-			histogramCode = codeUnknown
-		}
-		handledHistogram.WithLabelValues(topicID, methodPublishMulti, histogramCode).Observe(time.Since(startTime).Seconds())
+		ObservePublishMulti(p.topic.ID(), len(messages), errors, startTime)
+		span.End()
 	}()
 	errors = make(map[string]error)
 	results := make(map[string]*pubsub.PublishResult, len(messages))
@@ -137,7 +129,7 @@ func (p *publisher) PublishMulti(ctx context.Context, messages []Message) (error
 		}
 		results[id] = p.topic.Publish(ctx, &pubsub.Message{
 			Data:       data,
-			Attributes: map[string]string{idAttribute: id},
+			Attributes: newAttributes(ctx, id),
 		})
 	}
 	for id, result := range results {
@@ -148,6 +140,14 @@ func (p *publisher) PublishMulti(ctx context.Context, messages []Message) (error
 	return
 }
 
+// newAttributes returns the message attributes carrying the ID
+// and the trace context, so the subscriber can continue the trace.
+func newAttributes(ctx context.Context, id string) map[string]string {
+	attributes := map[string]string{idAttribute: id}
+	trace.InjectAttributes(ctx, attributes)
+	return attributes
+}
+
 func (p *publisher) Stop() {
 	p.topic.Stop()
 }
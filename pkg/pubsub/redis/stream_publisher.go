@@ -27,6 +27,13 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/metrics"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher"
 	v3 "github.com/bucketeer-io/bucketeer/v2/pkg/redis/v3"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/trace"
+)
+
+const (
+	// attributeFieldPrefix marks the stream fields holding message attributes rather than the data
+	attributeFieldPrefix = "attr:"
+	messagingSystem      = "redis"
 )
 
 var (
@@ -101,7 +108,11 @@ func (p *StreamPublisher) getStreamKey(id string) string {
 }
 
 // Publish publishes a message to the stream
-func (p *StreamPublisher) Publish(ctx context.Context, msg publisher.Message) error {
+func (p *StreamPublisher) Publish(ctx context.Context, msg publisher.Message) (err error) {
+	ctx, span := trace.StartProducerSpan(ctx, messagingSystem, p.streamBase)
+	defer func() {
+		trace.EndSpan(span, err)
+	}()
 	data, err := proto.Marshal(msg)
 	if err != nil {
 		p.logger.Error("Failed to marshal message", zap.Error(err), zap.Any("message", msg))
@@ -120,6 +131,12 @@ func (p *StreamPublisher) Publish(ctx context.Context, msg publisher.Message) er
 	values := map[string]interface{}{
 		messageID: data,
 	}
+	// Carry the trace context in prefixed fields so the puller can tell them from the data
+	carrier := make(map[string]string)
+	trace.InjectAttributes(ctx, carrier)
+	for k, v := range carrier {
+		values[attributeFieldPrefix+k] = v
+	}
 
 	// Add the message to the stream
 	_, err = p.redisClient.XAdd(ctx, streamKey, values)
@@ -285,30 +285,20 @@ func (p *StreamPuller) Pull(ctx context.Context, handler func(context.Context, *
 						)
 					}
 
-					// Extract data from the message
-					// In Redis Streams, values are a map where the key is the field name and the value is the field value
-					// We expect one field with the message ID as the key and the serialized message as the value
-					var data []byte
-					for _, value := range msg.Values {
-						if s, ok := value.(string); ok {
-							data = []byte(s)
-							break
-						} else if b, ok := value.([]byte); ok {
-							data = b
-							break
-						}
+					// Extract data and attributes from the message
+					attributes := map[string]string{
+						"id":     msg.ID,
+						"stream": streamKey,
 					}
+					data := parseStreamValues(msg.Values, attributes)
 
 					// Create a message with Ack/Nack functions
 					message := &puller.Message{
-						ID:   msg.ID,
-						Data: data,
-						Attributes: map[string]string{
-							"id":     msg.ID,
-							"stream": streamKey,
-						},
-						Ack:  ackFunc,
-						Nack: nackFunc,
+						ID:         msg.ID,
+						Data:       data,
+						Attributes: attributes,
+						Ack:        ackFunc,
+						Nack:       nackFunc,
 					}
 
 					// Handle message
@@ -319,6 +309,36 @@ func (p *StreamPuller) Pull(ctx context.Context, handler func(context.Context, *
 	}
 }
 
+// parseStreamValues returns the serialized message held in the stream entry values
+// and copies the attributes carried by the publisher, such as the trace context, into attributes.
+// In Redis Streams, values are a map where the key is the field name and the value is the field value.
+// We expect one field with the message ID as the key and the serialized message as the value,
+// plus the attribute fields prefixed with attributeFieldPrefix.
+// Attributes set by the puller take precedence over the ones of the publisher.
+func parseStreamValues(values map[string]interface{}, attributes map[string]string) []byte {
+	var data []byte
+	for field, value := range values {
+		if key, ok := strings.CutPrefix(field, attributeFieldPrefix); ok {
+			if _, exists := attributes[key]; exists {
+				continue
+			}
+			if s, ok := value.(string); ok {
+				attributes[key] = s
+			}
+			continue
+		}
+		if data != nil {
+			continue
+		}
+		if s, ok := value.(string); ok {
+			data = []byte(s)
+		} else if b, ok := value.([]byte); ok {
+			data = b
+		}
+	}
+	return data
+}
+
 // consumerGroupExists checks if a consumer group exists for a stream
 func (p *StreamPuller) consumerGroupExists(ctx context.Context, streamKey, groupName string) (bool, error) {
 	// Check if the stream exists first
@@ -491,31 +511,23 @@ func (p *StreamPuller) reprocessClaimedMessages(ctx context.Context, claimed []g
 			)
 		}
 
-		// Extract data from the message
-		var data []byte
-		for _, value := range msg.Values {
-			if s, ok := value.(string); ok {
-				data = []byte(s)
-				break
-			} else if b, ok := value.([]byte); ok {
-				data = b
-				break
-			}
+		// Extract data and attributes from the message.
+		// Note: "id" must be set so downstream processors don't silently ACK
+		// the message (they treat an empty "id" attribute as a missing-ID error).
+		attributes := map[string]string{
+			"id":      msg.ID,
+			"stream":  streamKey,
+			"claimed": "true",
 		}
+		data := parseStreamValues(msg.Values, attributes)
 
 		// Create a message with Ack/Nack functions.
-		// Note: "id" must be set so downstream processors don't silently ACK
-		// the message (they treat an empty "id" attribute as a missing-ID error).
 		message := &puller.Message{
-			ID:   msg.ID,
-			Data: data,
-			Attributes: map[string]string{
-				"id":      msg.ID,
-				"stream":  streamKey,
-				"claimed": "true",
-			},
-			Ack:  ackFunc,
-			Nack: nackFunc,
+			ID:         msg.ID,
+			Data:       data,
+			Attributes: attributes,
+			Ack:        ackFunc,
+			Nack:       nackFunc,
 		}
 
 		// Process the message in a goroutine to avoid blocking the recovery loop
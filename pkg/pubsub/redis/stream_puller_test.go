@@ -0,0 +1,67 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStreamValues(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc               string
+		values             map[string]interface{}
+		expectedData       []byte
+		expectedAttributes map[string]string
+	}{
+		{
+			desc:               "data only",
+			values:             map[string]interface{}{"message-id": "data"},
+			expectedData:       []byte("data"),
+			expectedAttributes: map[string]string{"id": "1-0"},
+		},
+		{
+			desc: "data with the trace context",
+			values: map[string]interface{}{
+				"message-id":                         []byte("data"),
+				attributeFieldPrefix + "traceparent": "00-0102-03-01",
+			},
+			expectedData: []byte("data"),
+			expectedAttributes: map[string]string{
+				"id":          "1-0",
+				"traceparent": "00-0102-03-01",
+			},
+		},
+		{
+			desc: "puller attributes take precedence",
+			values: map[string]interface{}{
+				"message-id":                "data",
+				attributeFieldPrefix + "id": "spoofed",
+			},
+			expectedData:       []byte("data"),
+			expectedAttributes: map[string]string{"id": "1-0"},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			attributes := map[string]string{"id": "1-0"}
+			data := parseStreamValues(p.values, attributes)
+			assert.Equal(t, p.expectedData, data)
+			assert.Equal(t, p.expectedAttributes, attributes)
+		})
+	}
+}
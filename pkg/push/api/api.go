@@ -165,12 +165,11 @@ func (s *PushService) CreatePush(
 			push.Id,
 			eventproto.Event_PUSH_CREATED,
 			&eventproto.PushCreatedEvent{
-				FcmServiceAccount: push.FcmServiceAccount,
-				Tags:              push.Tags,
-				Name:              push.Name,
+				Tags: push.Tags,
+				Name: push.Name,
 			},
 			req.EnvironmentId,
-			push,
+			push.WithoutSecret(),
 			nil,
 		)
 		if err != nil {
@@ -251,8 +250,8 @@ func (s *PushService) UpdatePush(
 				Tags: req.Tags,
 			},
 			req.EnvironmentId,
-			updated,
-			push,
+			updated.WithoutSecret(),
+			push.WithoutSecret(),
 		)
 		if err != nil {
 			return err
@@ -334,13 +333,12 @@ func (s *PushService) DeletePush(
 			push.Id,
 			eventproto.Event_PUSH_DELETED,
 			&eventproto.PushCreatedEvent{
-				FcmServiceAccount: push.FcmServiceAccount,
-				Tags:              push.Tags,
-				Name:              push.Name,
+				Tags: push.Tags,
+				Name: push.Name,
 			},
 			req.EnvironmentId,
-			nil,  // Current state: entity no longer exists
-			push, // Previous state: what was deleted
+			nil,                  // Current state: entity no longer exists
+			push.WithoutSecret(), // Previous state: what was deleted
 		)
 		if err != nil {
 			return err
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/jinzhu/copier"
+	pb "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	err "github.com/bucketeer-io/bucketeer/v2/pkg/error"
@@ -161,6 +162,14 @@ func (p *Push) SetDeleted() {
 	p.UpdatedAt = time.Now().Unix()
 }
 
+// WithoutSecret returns a copy of the push without the FCM service account,
+// which can be stored in the domain events and audit logs.
+func (p *Push) WithoutSecret() *proto.Push {
+	masked := pb.Clone(p.Push).(*proto.Push)
+	masked.FcmServiceAccount = ""
+	return masked
+}
+
 func (p *Push) ExistTag(findTag string) bool {
 	for _, t := range p.Tags {
 		if t == findTag {
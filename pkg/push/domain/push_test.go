@@ -440,3 +440,14 @@ func TestRemoveTag(t *testing.T) {
 		})
 	}
 }
+
+func TestWithoutSecret(t *testing.T) {
+	t.Parallel()
+	push, err := NewPush("name-1", "sa", []string{"tag-1"})
+	assert.NoError(t, err)
+	masked := push.WithoutSecret()
+	assert.Equal(t, "", masked.FcmServiceAccount)
+	assert.Equal(t, push.Id, masked.Id)
+	assert.Equal(t, []string{"tag-1"}, masked.Tags)
+	assert.Equal(t, "sa", push.FcmServiceAccount)
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+
+	pb "google.golang.org/protobuf/proto"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/crypto"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/push/domain"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/push"
+)
+
+// EncryptedPushStorage stores the FCM service accounts encrypted by a SecretCipher.
+type EncryptedPushStorage interface {
+	PushStorage
+	crypto.SecretRotator
+}
+
+type encryptedPushStorage struct {
+	PushStorage
+	cipher crypto.SecretCipher
+}
+
+func NewEncryptedPushStorage(storage PushStorage, cipher crypto.SecretCipher) EncryptedPushStorage {
+	return &encryptedPushStorage{PushStorage: storage, cipher: cipher}
+}
+
+func (s *encryptedPushStorage) CreatePush(ctx context.Context, e *domain.Push, environmentId string) error {
+	encrypted, err := s.encrypt(ctx, e, environmentId)
+	if err != nil {
+		return err
+	}
+	return s.PushStorage.CreatePush(ctx, encrypted, environmentId)
+}
+
+func (s *encryptedPushStorage) UpdatePush(ctx context.Context, e *domain.Push, environmentId string) error {
+	encrypted, err := s.encrypt(ctx, e, environmentId)
+	if err != nil {
+		return err
+	}
+	return s.PushStorage.UpdatePush(ctx, encrypted, environmentId)
+}
+
+func (s *encryptedPushStorage) GetPush(ctx context.Context, id, environmentId string) (*domain.Push, error) {
+	push, err := s.PushStorage.GetPush(ctx, id, environmentId)
+	if err != nil {
+		return nil, err
+	}
+	if push.FcmServiceAccount, err = s.cipher.Decrypt(ctx, push.FcmServiceAccount); err != nil {
+		return nil, err
+	}
+	return push, nil
+}
+
+func (s *encryptedPushStorage) ListPushes(
+	ctx context.Context,
+	p ListPushesParams,
+) ([]*proto.Push, int, int64, error) {
+	pushes, nextOffset, totalCount, err := s.PushStorage.ListPushes(ctx, p)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	for _, push := range pushes {
+		if push.FcmServiceAccount, err = s.cipher.Decrypt(ctx, push.FcmServiceAccount); err != nil {
+			return nil, 0, 0, err
+		}
+	}
+	return pushes, nextOffset, totalCount, nil
+}
+
+func (s *encryptedPushStorage) RotateSecrets(ctx context.Context, environmentID string) (int, error) {
+	rotated := 0
+	// The deleted pushes are rotated as well, since their rows are kept.
+	for _, deleted := range []bool{false, true} {
+		pushes, _, _, err := s.PushStorage.ListPushes(ctx, ListPushesParams{
+			PageSize:       database.QueryNoLimit,
+			EnvironmentIDs: []string{environmentID},
+			Deleted:        deleted,
+		})
+		if err != nil {
+			return rotated, err
+		}
+		for _, p := range pushes {
+			needsRotation, err := s.cipher.NeedsRotation(ctx, environmentID, p.FcmServiceAccount)
+			if err != nil {
+				return rotated, err
+			}
+			if !needsRotation {
+				continue
+			}
+			push, err := s.GetPush(ctx, p.Id, environmentID)
+			if err != nil {
+				return rotated, err
+			}
+			if err := s.UpdatePush(ctx, push, environmentID); err != nil {
+				return rotated, err
+			}
+			rotated++
+		}
+	}
+	return rotated, nil
+}
+
+// encrypt returns a copy of the push with its service account encrypted,
+// so the caller's object keeps the plaintext.
+func (s *encryptedPushStorage) encrypt(
+	ctx context.Context,
+	e *domain.Push,
+	environmentId string,
+) (*domain.Push, error) {
+	serviceAccount, err := s.cipher.Encrypt(ctx, environmentId, e.FcmServiceAccount)
+	if err != nil {
+		return nil, err
+	}
+	encrypted := &domain.Push{Push: pb.Clone(e.Push).(*proto.Push)}
+	encrypted.FcmServiceAccount = serviceAccount
+	return encrypted, nil
+}
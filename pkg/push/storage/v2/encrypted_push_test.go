@@ -0,0 +1,125 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	cryptomock "github.com/bucketeer-io/bucketeer/v2/pkg/crypto/mock"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/push/domain"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/push"
+)
+
+type fakePushStorage struct {
+	PushStorage
+	pushes map[string]*proto.Push
+}
+
+func (s *fakePushStorage) CreatePush(_ context.Context, e *domain.Push, _ string) error {
+	s.pushes[e.Id] = e.Push
+	return nil
+}
+
+func (s *fakePushStorage) UpdatePush(_ context.Context, e *domain.Push, _ string) error {
+	s.pushes[e.Id] = e.Push
+	return nil
+}
+
+func (s *fakePushStorage) GetPush(_ context.Context, id, _ string) (*domain.Push, error) {
+	return &domain.Push{Push: &proto.Push{
+		Id:                id,
+		FcmServiceAccount: s.pushes[id].FcmServiceAccount,
+		Deleted:           s.pushes[id].Deleted,
+	}}, nil
+}
+
+func (s *fakePushStorage) ListPushes(_ context.Context, p ListPushesParams) ([]*proto.Push, int, int64, error) {
+	var pushes []*proto.Push
+	for _, push := range s.pushes {
+		if push.Deleted == p.Deleted {
+			pushes = append(pushes, &proto.Push{Id: push.Id, FcmServiceAccount: push.FcmServiceAccount})
+		}
+	}
+	return pushes, 0, int64(len(pushes)), nil
+}
+
+func newTestSecretCipher(t *testing.T, mockController *gomock.Controller) *cryptomock.MockSecretCipher {
+	t.Helper()
+	cipher := cryptomock.NewMockSecretCipher(mockController)
+	cipher.EXPECT().Encrypt(gomock.Any(), "env-0", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, plaintext string) (string, error) {
+			return "enc:" + plaintext, nil
+		},
+	).AnyTimes()
+	cipher.EXPECT().Decrypt(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, value string) (string, error) {
+			return strings.TrimPrefix(value, "enc:"), nil
+		},
+	).AnyTimes()
+	return cipher
+}
+
+func TestEncryptedPushStorageCreateAndGet(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	inner := &fakePushStorage{pushes: map[string]*proto.Push{}}
+	s := NewEncryptedPushStorage(inner, newTestSecretCipher(t, mockController))
+
+	push := &domain.Push{Push: &proto.Push{Id: "id-0", FcmServiceAccount: "sa"}}
+	require.NoError(t, s.CreatePush(context.Background(), push, "env-0"))
+	assert.Equal(t, "sa", push.FcmServiceAccount)
+	assert.Equal(t, "enc:sa", inner.pushes["id-0"].FcmServiceAccount)
+
+	actual, err := s.GetPush(context.Background(), "id-0", "env-0")
+	require.NoError(t, err)
+	assert.Equal(t, "sa", actual.FcmServiceAccount)
+	list, _, _, err := s.ListPushes(context.Background(), ListPushesParams{EnvironmentIDs: []string{"env-0"}})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "sa", list[0].FcmServiceAccount)
+}
+
+func TestEncryptedPushStorageRotateSecrets(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	inner := &fakePushStorage{pushes: map[string]*proto.Push{
+		"id-0": {Id: "id-0", FcmServiceAccount: "sa-0"},
+		"id-1": {Id: "id-1", FcmServiceAccount: "sa-1", Deleted: true},
+		"id-2": {Id: "id-2", FcmServiceAccount: "enc:sa-2"},
+	}}
+	cipher := newTestSecretCipher(t, mockController)
+	cipher.EXPECT().NeedsRotation(gomock.Any(), "env-0", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, value string) (bool, error) {
+			return !strings.HasPrefix(value, "enc:"), nil
+		},
+	).Times(3)
+	s := NewEncryptedPushStorage(inner, cipher)
+
+	rotated, err := s.RotateSecrets(context.Background(), "env-0")
+	require.NoError(t, err)
+	assert.Equal(t, 2, rotated)
+	assert.Equal(t, "enc:sa-0", inner.pushes["id-0"].FcmServiceAccount)
+	assert.Equal(t, "enc:sa-1", inner.pushes["id-1"].FcmServiceAccount)
+	assert.True(t, inner.pushes["id-1"].Deleted)
+	assert.Equal(t, "enc:sa-2", inner.pushes["id-2"].FcmServiceAccount)
+}
@@ -323,6 +323,7 @@ func NewClient(addr string, opts ...Option) (Client, error) {
 	default: // RedisModeAuto
 		clientType, rc = detectRedisMode(addr, clusterOpts, standardOpts, logger)
 	}
+	rc.AddHook(tracingHook{})
 
 	// Non-blocking startup: try to ping but don't fail if Redis is unavailable.
 	// This allows the service to start in degraded mode using database fallback,
@@ -0,0 +1,80 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	goredis "github.com/redis/go-redis/v9"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/trace"
+)
+
+// tracingHook records an OpenTelemetry span for each command and pipeline.
+// Most methods of Client have no context, so their spans start a new trace.
+type tracingHook struct{}
+
+func (tracingHook) DialHook(next goredis.DialHook) goredis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+func (tracingHook) ProcessHook(next goredis.ProcessHook) goredis.ProcessHook {
+	return func(ctx context.Context, cmd goredis.Cmder) error {
+		ctx, span := trace.StartSpan(
+			ctx,
+			"redis."+cmd.Name(),
+			oteltrace.WithSpanKind(oteltrace.SpanKindClient),
+			oteltrace.WithAttributes(
+				semconv.DBSystemNameRedis,
+				semconv.DBOperationName(cmd.Name()),
+			),
+		)
+		err := next(ctx, cmd)
+		trace.EndSpan(span, spanError(err))
+		return err
+	}
+}
+
+func (tracingHook) ProcessPipelineHook(next goredis.ProcessPipelineHook) goredis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []goredis.Cmder) error {
+		ctx, span := trace.StartSpan(
+			ctx,
+			"redis.pipeline",
+			oteltrace.WithSpanKind(oteltrace.SpanKindClient),
+			oteltrace.WithAttributes(
+				semconv.DBSystemNameRedis,
+				semconv.DBOperationName("pipeline"),
+				semconv.DBOperationBatchSize(len(cmds)),
+			),
+		)
+		err := next(ctx, cmds)
+		trace.EndSpan(span, spanError(err))
+		return err
+	}
+}
+
+// spanError filters out the reply of a missing key, which is not a failure.
+func spanError(err error) error {
+	if errors.Is(err, goredis.Nil) {
+		return nil
+	}
+	return err
+}
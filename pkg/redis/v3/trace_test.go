@@ -0,0 +1,88 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func TestTracingHook(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(prev)
+
+	errInternal := errors.New("internal")
+	patterns := []struct {
+		desc         string
+		err          error
+		expectedCode codes.Code
+	}{
+		{
+			desc:         "ok",
+			err:          nil,
+			expectedCode: codes.Unset,
+		},
+		{
+			desc:         "missing key is not an error",
+			err:          goredis.Nil,
+			expectedCode: codes.Unset,
+		},
+		{
+			desc:         "error",
+			err:          errInternal,
+			expectedCode: codes.Error,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			var spanInNext oteltrace.SpanContext
+			process := tracingHook{}.ProcessHook(func(ctx context.Context, cmd goredis.Cmder) error {
+				spanInNext = oteltrace.SpanContextFromContext(ctx)
+				return p.err
+			})
+			err := process(context.Background(), goredis.NewStringCmd(context.Background(), "get", "key"))
+			assert.Equal(t, p.err, err)
+			spans := recorder.Ended()
+			require.NotEmpty(t, spans)
+			span := spans[len(spans)-1]
+			assert.Equal(t, "redis.get", span.Name())
+			assert.Equal(t, p.expectedCode, span.Status().Code)
+			assert.Equal(t, span.SpanContext().SpanID(), spanInNext.SpanID())
+		})
+	}
+
+	pipeline := tracingHook{}.ProcessPipelineHook(func(ctx context.Context, cmds []goredis.Cmder) error {
+		return nil
+	})
+	err := pipeline(context.Background(), []goredis.Cmder{
+		goredis.NewStringCmd(context.Background(), "get", "key-0"),
+		goredis.NewStringCmd(context.Background(), "get", "key-1"),
+	})
+	require.NoError(t, err)
+	spans := recorder.Ended()
+	assert.Equal(t, "redis.pipeline", spans[len(spans)-1].Name())
+}
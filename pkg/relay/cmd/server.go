@@ -0,0 +1,394 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	kingpin "gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
+	apiclient "github.com/bucketeer-io/bucketeer/v2/pkg/api/client"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/api/stream"
+	cachev3 "github.com/bucketeer-io/bucketeer/v2/pkg/cache/v3"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/cli"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/health"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/metrics"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/relay/relay"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/rest"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/rpc"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/rpc/client"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/rpc/gateway"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+	gwproto "github.com/bucketeer-io/bucketeer/v2/proto/gateway"
+)
+
+const (
+	command = "server"
+
+	// sdkVersion is reported to the upstream gateway for the requests made by the relay.
+	sdkVersion = "relay-proxy"
+
+	serverShutDownTimeout = 30 * time.Second
+	grpcStopTimeout       = 5 * time.Second
+)
+
+type server struct {
+	*kingpin.CmdClause
+	port                              *int
+	grpcGatewayPort                   *int
+	upstreamAddress                   *string
+	upstreamCertPath                  *string
+	apiKeyPaths                       *[]string
+	snapshotPath                      *string
+	syncInterval                      *time.Duration
+	forwardInterval                   *time.Duration
+	eventBufferSize                   *int
+	certPath                          *string
+	keyPath                           *string
+	metricsWorkers                    *int
+	metricsQueueSize                  *int
+	oldestEventTimestamp              *time.Duration
+	furthestEventTimestamp            *time.Duration
+	apiKeyMemoryCacheEvictionInterval *time.Duration
+	featuresMemoryCacheTTL            *time.Duration
+	segmentUsersMemoryCacheTTL        *time.Duration
+	featureFlagDiffGracePeriod        *time.Duration
+	sseHeartbeatInterval              *time.Duration
+	sseMaxConnections                 *int
+}
+
+func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
+	cmd := p.Command(command, "Start the relay proxy server")
+	server := &server{
+		CmdClause:       cmd,
+		port:            cmd.Flag("port", "Port to bind to.").Default("9090").Int(),
+		grpcGatewayPort: cmd.Flag("grpc-gateway-port", "Port to bind to for gRPC-gateway.").Default("9089").Int(),
+		upstreamAddress: cmd.Flag(
+			"upstream-address",
+			"Address of the upstream bucketeer api-gateway.",
+		).Required().String(),
+		upstreamCertPath: cmd.Flag(
+			"upstream-cert",
+			"Path to the TLS certificate used to verify the upstream api-gateway.",
+		).Required().String(),
+		apiKeyPaths: cmd.Flag(
+			"api-key",
+			"Path to a SERVER API key of an environment to relay. Repeat the flag for each environment.",
+		).Required().Strings(),
+		snapshotPath: cmd.Flag(
+			"snapshot-path",
+			"Path to the file where the synced flags, segments and API keys are persisted.",
+		).Required().String(),
+		syncInterval: cmd.Flag(
+			"sync-interval",
+			"Interval between syncs with the upstream api-gateway.",
+		).Default("10s").Duration(),
+		forwardInterval: cmd.Flag(
+			"forward-interval",
+			"Interval between flushes of the buffered events to the upstream api-gateway.",
+		).Default("10s").Duration(),
+		eventBufferSize: cmd.Flag(
+			"event-buffer-size",
+			"Maximum number of events kept while the upstream api-gateway is unreachable. "+
+				"The oldest events are dropped when it is full.",
+		).Default("100000").Int(),
+		certPath: cmd.Flag("cert", "Path to TLS certificate.").Required().String(),
+		keyPath:  cmd.Flag("key", "Path to TLS key.").Required().String(),
+		metricsWorkers: cmd.Flag(
+			"metrics-workers",
+			"Number of background workers processing metrics events.",
+		).Default("4").Int(),
+		metricsQueueSize: cmd.Flag(
+			"metrics-queue-size",
+			"Buffered channel capacity for pending metrics event batches.",
+		).Default("4096").Int(),
+		oldestEventTimestamp: cmd.Flag(
+			"oldest-event-timestamp",
+			"The duration of oldest event timestamp from processing time to allow.",
+		).Default("744h").Duration(),
+		furthestEventTimestamp: cmd.Flag(
+			"furthest-event-timestamp",
+			"The duration of furthest event timestamp from processing time to allow.",
+		).Default("1h").Duration(),
+		apiKeyMemoryCacheEvictionInterval: cmd.Flag(
+			"api-key-memory-cache-eviction-interval",
+			"Eviction interval for the in-memory API key cache.",
+		).Default("30s").Duration(),
+		featuresMemoryCacheTTL: cmd.Flag(
+			"features-memory-cache-ttl",
+			"TTL for the in-memory features cache.",
+		).Default("1m").Duration(),
+		segmentUsersMemoryCacheTTL: cmd.Flag(
+			"segment-users-memory-cache-ttl",
+			"TTL for the in-memory segment users cache.",
+		).Default("1m").Duration(),
+		featureFlagDiffGracePeriod: cmd.Flag(
+			"feature-flag-diff-grace-period",
+			"Grace window applied to the diff filters for GetFeatureFlags and GetEvaluations.",
+		).Default("10m").Duration(),
+		sseHeartbeatInterval: cmd.Flag("sse-heartbeat-interval",
+			"Interval between SSE heartbeat comments on the stream_evaluations endpoint.",
+		).Default("25s").Duration(),
+		sseMaxConnections: cmd.Flag("sse-max-connections",
+			"Maximum number of concurrent SSE connections.",
+		).Default("10000").Int(),
+	}
+	r.RegisterCommand(server)
+	return server
+}
+
+func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.Logger) error {
+	registerer := metrics.DefaultRegisterer()
+
+	apiKeys, err := readAPIKeys(*s.apiKeyPaths)
+	if err != nil {
+		return err
+	}
+
+	// The connection is not blocking so the relay starts serving from the snapshot
+	// even when the upstream is unreachable.
+	upstreamClient, err := apiclient.NewClient(*s.upstreamAddress, *s.upstreamCertPath,
+		client.WithDialTimeout(30*time.Second),
+		client.WithMetrics(registerer),
+		client.WithLogger(logger),
+	)
+	if err != nil {
+		return err
+	}
+
+	snapshot := relay.NewSnapshot(*s.snapshotPath)
+	if err := snapshot.Load(); err != nil {
+		return fmt.Errorf("failed to load snapshot: %w", err)
+	}
+
+	inMemoryCache := cachev3.NewInMemoryCache(
+		cachev3.WithEvictionInterval(*s.apiKeyMemoryCacheEvictionInterval),
+	)
+	featuresL1Cache := cachev3.NewFeaturesCache(inMemoryCache, *s.featuresMemoryCacheTTL)
+	featuresL2Cache := cachev3.NewFeaturesCache(snapshot, 0)
+	streamDispatcher := stream.NewDispatcher(
+		*s.sseMaxConnections,
+		func(envID string) ([]*featureproto.Feature, error) {
+			fs, err := featuresL1Cache.Get(envID)
+			if err == nil {
+				return fs.Features, nil
+			}
+			fs, err = featuresL2Cache.Get(envID)
+			if err != nil {
+				return nil, err
+			}
+			return fs.Features, nil
+		},
+		logger,
+	)
+	invalidator := api.NewCacheInvalidator(
+		cachev3.NewFeaturesCache(inMemoryCache, 0),
+		cachev3.NewSegmentUsersCache(inMemoryCache, 0),
+		cachev3.NewSegmentUserIndexCache(inMemoryCache, 0),
+		cachev3.NewEnvironmentAPIKeyCache(inMemoryCache, 0),
+		streamDispatcher,
+		logger,
+	)
+
+	syncer := relay.NewSyncer(upstreamClient, apiKeys, snapshot, invalidator, sdkVersion, *s.syncInterval, logger)
+	forwarder := relay.NewForwarder(
+		upstreamClient, syncer, sdkVersion, *s.forwardInterval, *s.eventBufferSize, logger,
+	)
+	backgroundCtx, backgroundCancel := context.WithCancel(context.Background())
+	defer backgroundCancel()
+	go syncer.Run(backgroundCtx)
+	go forwarder.Run(backgroundCtx)
+
+	// The gateway handlers read the snapshot as their L2 cache.
+	// A miss falls back to these offline stubs instead of the backend services.
+	featureClient := relay.NewOfflineFeatureClient()
+	accountStorage := relay.NewOfflineAccountStorage()
+	// API keys are kept by their hash, so both cache layers read the snapshot directly.
+	apiKeyCache := relay.NewAPIKeyCache(snapshot)
+
+	grpcGatewayService := api.NewGrpcGatewayService(
+		ctx,
+		featureClient,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		accountStorage,
+		forwarder,
+		forwarder,
+		snapshot,
+		api.WithInMemoryCache(inMemoryCache),
+		api.WithEnvironmentAPIKeyCache(apiKeyCache),
+		api.WithAPIKeyMemoryCacheEvictionInterval(*s.apiKeyMemoryCacheEvictionInterval),
+		api.WithFeaturesMemoryCacheTTL(*s.featuresMemoryCacheTTL),
+		api.WithSegmentUsersMemoryCacheTTL(*s.segmentUsersMemoryCacheTTL),
+		api.WithFeatureFlagDiffGracePeriod(*s.featureFlagDiffGracePeriod),
+		api.WithOldestEventTimestamp(*s.oldestEventTimestamp),
+		api.WithFurthestEventTimestamp(*s.furthestEventTimestamp),
+		api.WithMetrics(registerer),
+		api.WithMetricsWorkers(*s.metricsWorkers),
+		api.WithMetricsQueueSize(*s.metricsQueueSize),
+		api.WithLogger(logger),
+	)
+	gatewayServer, ok := grpcGatewayService.(gwproto.GatewayServer)
+	if !ok {
+		return fmt.Errorf("unexpected gateway service type %T", grpcGatewayService)
+	}
+	service := relay.NewSDKGatewayService(gatewayServer)
+
+	healthCheckCtx, healthCheckCancel := context.WithCancel(context.Background())
+	defer healthCheckCancel()
+
+	healthChecker := health.NewGrpcChecker(
+		health.WithTimeout(5*time.Second),
+		health.WithCheck("metrics", metrics.Check),
+	)
+	go healthChecker.Run(healthCheckCtx)
+
+	server := rpc.NewServer(service, *s.certPath, *s.keyPath,
+		"relay",
+		rpc.WithPort(*s.port),
+		rpc.WithMetrics(registerer),
+		rpc.WithLogger(logger),
+		rpc.WithService(healthChecker),
+		rpc.WithHandler("/health", healthChecker),
+		rpc.WithHandler("/ready", healthChecker),
+	)
+	go server.Run()
+
+	grpcGatewayAddr := fmt.Sprintf(":%d", *s.grpcGatewayPort)
+	grpcAddr := fmt.Sprintf("localhost:%d", *s.port)
+	gatewayHandler := func(ctx context.Context,
+		mux *runtime.ServeMux,
+		opts []grpc.DialOption,
+	) error {
+		return gwproto.RegisterGatewayHandlerFromEndpoint(ctx, mux, grpcAddr, opts)
+	}
+	relayGateway, err := gateway.NewGateway(
+		grpcGatewayAddr,
+		gateway.WithLogger(logger.Named("relay-grpc-gateway")),
+		gateway.WithMetrics(registerer),
+		gateway.WithCertPath(*s.certPath),
+		gateway.WithKeyPath(*s.keyPath),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create relay gateway: %w", err)
+	}
+	serverCtx, serverCtxCancel := context.WithCancel(context.Background())
+	defer serverCtxCancel()
+	if err := relayGateway.Start(serverCtx, gatewayHandler); err != nil {
+		return fmt.Errorf("failed to start relay gateway: %w", err)
+	}
+
+	restHealthChecker := health.NewRestChecker(
+		api.Version, api.Service,
+		health.WithTimeout(5*time.Second),
+		health.WithCheck("metrics", metrics.Check),
+	)
+	go restHealthChecker.Run(healthCheckCtx)
+
+	gatewayService := api.NewGatewayService(
+		featureClient,
+		nil,
+		nil,
+		forwarder,
+		forwarder,
+		forwarder,
+		accountStorage,
+		snapshot,
+		streamDispatcher,
+		*s.sseHeartbeatInterval,
+		api.WithInMemoryCache(inMemoryCache),
+		api.WithEnvironmentAPIKeyCache(apiKeyCache),
+		api.WithAPIKeyMemoryCacheEvictionInterval(*s.apiKeyMemoryCacheEvictionInterval),
+		api.WithFeaturesMemoryCacheTTL(*s.featuresMemoryCacheTTL),
+		api.WithSegmentUsersMemoryCacheTTL(*s.segmentUsersMemoryCacheTTL),
+		api.WithFeatureFlagDiffGracePeriod(*s.featureFlagDiffGracePeriod),
+		api.WithOldestEventTimestamp(*s.oldestEventTimestamp),
+		api.WithFurthestEventTimestamp(*s.furthestEventTimestamp),
+		api.WithMetrics(registerer),
+		api.WithLogger(logger),
+	)
+	httpServer := rest.NewServer(
+		*s.certPath, *s.keyPath,
+		rest.WithLogger(logger),
+		rest.WithService(gatewayService),
+		rest.WithService(restHealthChecker),
+		rest.WithMetrics(registerer),
+	)
+	go httpServer.Run()
+
+	defer func() {
+		shutdownStartTime := time.Now()
+
+		healthChecker.Stop()
+		restHealthChecker.Stop()
+
+		// Close all active SSE connections so httpServer.Shutdown returns promptly.
+		streamDispatcher.Shutdown()
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			relayGateway.Stop(serverShutDownTimeout)
+		}()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			httpServer.Stop(serverShutDownTimeout)
+		}()
+		wg.Wait()
+
+		server.Stop(grpcStopTimeout)
+		if metricsPool, ok := service.(interface{ ShutdownMetricsPool() }); ok {
+			metricsPool.ShutdownMetricsPool()
+		}
+
+		// No request can publish anymore, so the remaining events are flushed
+		// before the upstream connection is closed.
+		forwarder.Stop()
+		backgroundCancel()
+		if err := snapshot.Save(); err != nil {
+			logger.Error("Failed to save snapshot", zap.Error(err))
+		}
+		upstreamClient.Close()
+
+		logger.Info("Graceful shutdown sequence completed",
+			zap.Duration("total_elapsed", time.Since(shutdownStartTime)),
+		)
+	}()
+
+	<-ctx.Done()
+	return nil
+}
+
+func readAPIKeys(paths []string) ([]string, error) {
+	apiKeys := make([]string, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		apiKeys = append(apiKeys, strings.TrimSpace(string(data)))
+	}
+	return apiKeys, nil
+}
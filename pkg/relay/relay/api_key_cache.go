@@ -0,0 +1,96 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	accountdomain "github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/cache"
+	cachev3 "github.com/bucketeer-io/bucketeer/v2/pkg/cache/v3"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+)
+
+// environmentAPIKeyPrefix mirrors the key layout of cachev3.EnvironmentAPIKeyCache,
+// with the hash of the API key in place of the key itself.
+const environmentAPIKeyPrefix = "environment_apikey:"
+
+// apiKeyCache keeps the SDK API keys in the snapshot by their hash, so the keys
+// of the relay's clients are never written to disk.
+// It implements cachev3.EnvironmentAPIKeyCache for the gateway handlers, which
+// look keys up by the value of the authorization header.
+type apiKeyCache struct {
+	snapshot *Snapshot
+}
+
+// NewAPIKeyCache returns the API key cache the relay's gateway must use.
+func NewAPIKeyCache(snapshot *Snapshot) cachev3.EnvironmentAPIKeyCache {
+	return &apiKeyCache{snapshot: snapshot}
+}
+
+// Get returns the entry of the API key with the key itself filled back in,
+// which the caller already holds.
+func (c *apiKeyCache) Get(apiKey string) (*accountproto.EnvironmentAPIKey, error) {
+	envAPIKey, err := c.getByHash(accountdomain.HashAPIKey(apiKey))
+	if err != nil {
+		return nil, err
+	}
+	envAPIKey.ApiKey.ApiKey = apiKey
+	return envAPIKey, nil
+}
+
+func (c *apiKeyCache) Put(envAPIKey *accountproto.EnvironmentAPIKey) error {
+	return c.putByHash(accountdomain.HashAPIKey(envAPIKey.ApiKey.ApiKey), envAPIKey)
+}
+
+func (c *apiKeyCache) Evict(apiKey string) error {
+	return c.evictByHash(accountdomain.HashAPIKey(apiKey))
+}
+
+func (c *apiKeyCache) getByHash(hash string) (*accountproto.EnvironmentAPIKey, error) {
+	value, err := c.snapshot.Get(environmentAPIKeyPrefix + hash)
+	if err != nil {
+		return nil, err
+	}
+	b, err := cache.Bytes(value)
+	if err != nil {
+		return nil, err
+	}
+	envAPIKey := &accountproto.EnvironmentAPIKey{}
+	if err := proto.Unmarshal(b, envAPIKey); err != nil {
+		return nil, err
+	}
+	if envAPIKey.ApiKey == nil {
+		envAPIKey.ApiKey = &accountproto.APIKey{}
+	}
+	return envAPIKey, nil
+}
+
+// putByHash stores the entry without the key itself.
+func (c *apiKeyCache) putByHash(hash string, envAPIKey *accountproto.EnvironmentAPIKey) error {
+	stored := proto.Clone(envAPIKey).(*accountproto.EnvironmentAPIKey)
+	if stored.ApiKey != nil {
+		stored.ApiKey.ApiKey = ""
+	}
+	b, err := proto.Marshal(stored)
+	if err != nil {
+		return err
+	}
+	return c.snapshot.Put(environmentAPIKeyPrefix+hash, b, 0)
+}
+
+func (c *apiKeyCache) evictByHash(hash string) error {
+	return c.snapshot.Delete(environmentAPIKeyPrefix + hash)
+}
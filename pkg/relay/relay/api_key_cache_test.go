@@ -0,0 +1,60 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	accountdomain "github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/cache"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	environmentproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
+)
+
+func TestAPIKeyCache(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "snapshot")
+	snapshot := NewSnapshot(path)
+	c := NewAPIKeyCache(snapshot)
+
+	require.NoError(t, c.Put(&accountproto.EnvironmentAPIKey{
+		Environment: &environmentproto.EnvironmentV2{Id: "ns0"},
+		ApiKey:      &accountproto.APIKey{Id: "id-0", ApiKey: "secret-key", Role: accountproto.APIKey_SDK_CLIENT},
+	}))
+	assert.Equal(t, []string{environmentAPIKeyPrefix + accountdomain.HashAPIKey("secret-key")}, snapshot.Keys(""))
+	require.NoError(t, snapshot.Save())
+	loaded := NewSnapshot(path)
+	require.NoError(t, loaded.Load())
+	stored, err := (&apiKeyCache{snapshot: loaded}).getByHash(accountdomain.HashAPIKey("secret-key"))
+	require.NoError(t, err)
+	assert.Equal(t, "", stored.ApiKey.ApiKey)
+
+	actual, err := c.Get("secret-key")
+	require.NoError(t, err)
+	assert.Equal(t, "id-0", actual.ApiKey.Id)
+	assert.Equal(t, "secret-key", actual.ApiKey.ApiKey)
+	assert.Equal(t, "ns0", actual.Environment.Id)
+
+	_, err = c.Get("other-key")
+	assert.ErrorIs(t, err, cache.ErrNotFound)
+
+	require.NoError(t, c.Evict("secret-key"))
+	_, err = c.Get("secret-key")
+	assert.ErrorIs(t, err, cache.ErrNotFound)
+}
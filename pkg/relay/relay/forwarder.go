@@ -0,0 +1,297 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+
+	apiclient "github.com/bucketeer-io/bucketeer/v2/pkg/api/client"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher"
+	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/client"
+	gwproto "github.com/bucketeer-io/bucketeer/v2/proto/gateway"
+)
+
+const (
+	forwardBatchSize   = 500
+	finalFlushTimeout  = 10 * time.Second
+	forwardCallTimeout = 30 * time.Second
+)
+
+// APIKeyResolver returns the server API key used to reach the upstream for an environment.
+type APIKeyResolver interface {
+	APIKey(environmentID string) (string, bool)
+}
+
+// Forwarder is the publisher the relay gives to the gateway handlers.
+// Events are buffered in memory and sent to the upstream RegisterEvents
+// endpoint in the background. Events that cannot be delivered stay in the
+// buffer and are retried on the next flush; when the buffer is full the
+// oldest events are dropped.
+type Forwarder struct {
+	client     apiclient.Client
+	resolver   APIKeyResolver
+	sdkVersion string
+	interval   time.Duration
+	maxSize    int
+	mu         sync.Mutex
+	events     []*eventproto.Event
+	stopOnce   sync.Once
+	stopCh     chan struct{}
+	doneCh     chan struct{}
+	logger     *zap.Logger
+}
+
+func NewForwarder(
+	client apiclient.Client,
+	resolver APIKeyResolver,
+	sdkVersion string,
+	interval time.Duration,
+	maxSize int,
+	logger *zap.Logger,
+) *Forwarder {
+	return &Forwarder{
+		client:     client,
+		resolver:   resolver,
+		sdkVersion: sdkVersion,
+		interval:   interval,
+		maxSize:    maxSize,
+		events:     make([]*eventproto.Event, 0),
+		stopCh:     make(chan struct{}),
+		doneCh:     make(chan struct{}),
+		logger:     logger.Named("forwarder"),
+	}
+}
+
+func (f *Forwarder) Publish(ctx context.Context, msg publisher.Message) error {
+	event, ok := msg.(*eventproto.Event)
+	if !ok {
+		return publisher.ErrBadMessage
+	}
+	f.append([]*eventproto.Event{event})
+	return nil
+}
+
+func (f *Forwarder) PublishMulti(ctx context.Context, messages []publisher.Message) map[string]error {
+	errs := make(map[string]error)
+	events := make([]*eventproto.Event, 0, len(messages))
+	for _, msg := range messages {
+		event, ok := msg.(*eventproto.Event)
+		if !ok {
+			errs[msg.GetId()] = publisher.ErrBadMessage
+			continue
+		}
+		events = append(events, event)
+	}
+	f.append(events)
+	return errs
+}
+
+// Stop makes Run flush the buffer one last time and waits for it to return.
+func (f *Forwarder) Stop() {
+	f.stopOnce.Do(func() {
+		close(f.stopCh)
+	})
+	<-f.doneCh
+}
+
+// Run flushes the buffer on every interval until Stop is called or the context is done.
+func (f *Forwarder) Run(ctx context.Context) {
+	defer close(f.doneCh)
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			f.Flush(ctx)
+		case <-f.stopCh:
+			f.finalFlush()
+			return
+		case <-ctx.Done():
+			f.finalFlush()
+			return
+		}
+	}
+}
+
+func (f *Forwarder) finalFlush() {
+	ctx, cancel := context.WithTimeout(context.Background(), finalFlushTimeout)
+	defer cancel()
+	f.Flush(ctx)
+	if n := f.Len(); n > 0 {
+		f.logger.Warn("Events could not be forwarded before stopping", zap.Int("size", n))
+	}
+}
+
+// Len returns the number of buffered events.
+func (f *Forwarder) Len() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.events)
+}
+
+// Flush sends the buffered events to the upstream, grouped by environment.
+func (f *Forwarder) Flush(ctx context.Context) {
+	f.mu.Lock()
+	events := f.events
+	f.events = make([]*eventproto.Event, 0)
+	f.mu.Unlock()
+	if len(events) == 0 {
+		return
+	}
+	environmentIDs := []string{}
+	byEnvironment := make(map[string][]*eventproto.Event)
+	for _, e := range events {
+		if _, ok := byEnvironment[e.EnvironmentId]; !ok {
+			environmentIDs = append(environmentIDs, e.EnvironmentId)
+		}
+		byEnvironment[e.EnvironmentId] = append(byEnvironment[e.EnvironmentId], e)
+	}
+	retry := make([]*eventproto.Event, 0)
+	for _, environmentID := range environmentIDs {
+		retry = append(retry, f.forward(ctx, environmentID, byEnvironment[environmentID])...)
+	}
+	f.prepend(retry)
+}
+
+// eventSource is the SDK that sent an event to the relay.
+type eventSource struct {
+	sourceID   eventproto.SourceId
+	sdkVersion string
+}
+
+// source returns the SDK recorded in the goal or evaluation event, so the
+// upstream attributes it to that SDK rather than to the relay. Events that
+// carry none are reported with the relay's own source and version.
+func (f *Forwarder) source(e *eventproto.Event) eventSource {
+	src := eventSource{}
+	switch {
+	case e.Event.MessageIs(&eventproto.GoalEvent{}):
+		goal := &eventproto.GoalEvent{}
+		if err := e.Event.UnmarshalTo(goal); err == nil {
+			src = eventSource{sourceID: goal.SourceId, sdkVersion: goal.SdkVersion}
+		}
+	case e.Event.MessageIs(&eventproto.EvaluationEvent{}):
+		evaluation := &eventproto.EvaluationEvent{}
+		if err := e.Event.UnmarshalTo(evaluation); err == nil {
+			src = eventSource{sourceID: evaluation.SourceId, sdkVersion: evaluation.SdkVersion}
+		}
+	}
+	if src.sourceID == eventproto.SourceId_UNKNOWN {
+		src.sourceID = eventproto.SourceId_GO_SERVER
+	}
+	if src.sdkVersion == "" {
+		src.sdkVersion = f.sdkVersion
+	}
+	return src
+}
+
+// forward sends the events of one environment and returns the ones to retry.
+// Events are sent in one request per source, since the source ID and SDK
+// version are set on the request rather than on each event.
+func (f *Forwarder) forward(ctx context.Context, environmentID string, events []*eventproto.Event) []*eventproto.Event {
+	apiKey, ok := f.resolver.APIKey(environmentID)
+	if !ok {
+		// The environment has not been synced yet, so the key to use is unknown.
+		return events
+	}
+	sources := []eventSource{}
+	bySource := make(map[eventSource][]*eventproto.Event)
+	for _, e := range events {
+		src := f.source(e)
+		if _, ok := bySource[src]; !ok {
+			sources = append(sources, src)
+		}
+		bySource[src] = append(bySource[src], e)
+	}
+	retry := make([]*eventproto.Event, 0)
+	for i, src := range sources {
+		sourceEvents := bySource[src]
+		for start := 0; start < len(sourceEvents); start += forwardBatchSize {
+			end := min(start+forwardBatchSize, len(sourceEvents))
+			batch := sourceEvents[start:end]
+			resp, err := f.send(ctx, apiKey, src, batch)
+			if err != nil {
+				retry = append(retry, sourceEvents[start:]...)
+				for _, rest := range sources[i+1:] {
+					retry = append(retry, bySource[rest]...)
+				}
+				f.logger.Warn("Failed to forward events to upstream",
+					zap.Error(err),
+					zap.String("environmentId", environmentID),
+					zap.Int("size", len(retry)),
+				)
+				return retry
+			}
+			for _, e := range batch {
+				if rerr, ok := resp.Errors[e.Id]; ok && rerr.Retriable {
+					retry = append(retry, e)
+				}
+			}
+		}
+	}
+	return retry
+}
+
+func (f *Forwarder) send(
+	ctx context.Context,
+	apiKey string,
+	src eventSource,
+	events []*eventproto.Event,
+) (*gwproto.RegisterEventsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, forwardCallTimeout)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", apiKey)
+	return f.client.RegisterEvents(ctx, &gwproto.RegisterEventsRequest{
+		Events:     events,
+		SdkVersion: src.sdkVersion,
+		SourceId:   src.sourceID,
+	})
+}
+
+func (f *Forwarder) append(events []*eventproto.Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = append(f.events, events...)
+	f.trim()
+}
+
+// prepend puts events back in front of the ones published during the flush.
+func (f *Forwarder) prepend(events []*eventproto.Event) {
+	if len(events) == 0 {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = append(events, f.events...)
+	f.trim()
+}
+
+// trim drops the oldest events over the buffer size. The caller must hold the lock.
+func (f *Forwarder) trim() {
+	over := len(f.events) - f.maxSize
+	if over <= 0 {
+		return
+	}
+	f.events = f.events[over:]
+	f.logger.Warn("Event buffer is full, dropped the oldest events",
+		zap.Int("dropped", over),
+		zap.Int("size", f.maxSize),
+	)
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	apiclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/api/client/mock"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher"
+	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/client"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+	gwproto "github.com/bucketeer-io/bucketeer/v2/proto/gateway"
+)
+
+type staticAPIKeyResolver map[string]string
+
+func (r staticAPIKeyResolver) APIKey(environmentID string) (string, bool) {
+	apiKey, ok := r[environmentID]
+	return apiKey, ok
+}
+
+func TestForwarderPublishMulti(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	client := apiclientmock.NewMockClient(mockController)
+	f := NewForwarder(client, staticAPIKeyResolver{}, "relay", time.Second, 2, zap.NewNop())
+	errs := f.PublishMulti(context.Background(), []publisher.Message{
+		&eventproto.Event{Id: "e1"},
+		&featureproto.Feature{Id: "bad"},
+		&eventproto.Event{Id: "e2"},
+		&eventproto.Event{Id: "e3"},
+	})
+	assert.Equal(t, map[string]error{"bad": publisher.ErrBadMessage}, errs)
+	// The buffer holds two events, so the oldest one was dropped.
+	assert.Equal(t, 2, f.Len())
+	assert.Equal(t, "e2", f.events[0].Id)
+}
+
+func TestForwarderFlush(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*apiclientmock.MockClient)
+		expectedIDs []string
+	}{
+		{
+			desc: "success",
+			setup: func(c *apiclientmock.MockClient) {
+				c.EXPECT().RegisterEvents(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(
+						_ context.Context, req *gwproto.RegisterEventsRequest, _ ...interface{},
+					) (*gwproto.RegisterEventsResponse, error) {
+						assert.Len(t, req.Events, 2)
+						return &gwproto.RegisterEventsResponse{}, nil
+					})
+			},
+			expectedIDs: []string{"e3"},
+		},
+		{
+			desc: "upstream unavailable",
+			setup: func(c *apiclientmock.MockClient) {
+				c.EXPECT().RegisterEvents(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+			},
+			expectedIDs: []string{"e1", "e2", "e3"},
+		},
+		{
+			desc: "retriable errors are kept",
+			setup: func(c *apiclientmock.MockClient) {
+				c.EXPECT().RegisterEvents(gomock.Any(), gomock.Any()).Return(&gwproto.RegisterEventsResponse{
+					Errors: map[string]*gwproto.RegisterEventsResponse_Error{
+						"e1": {Retriable: true},
+						"e2": {Retriable: false},
+					},
+				}, nil)
+			},
+			expectedIDs: []string{"e1", "e3"},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			client := apiclientmock.NewMockClient(mockController)
+			p.setup(client)
+			// ns1 has not been synced yet, so its events wait for the api key.
+			f := NewForwarder(client, staticAPIKeyResolver{"ns0": "server-key"}, "relay", time.Second, 10, zap.NewNop())
+			f.PublishMulti(context.Background(), []publisher.Message{
+				&eventproto.Event{Id: "e1", EnvironmentId: "ns0"},
+				&eventproto.Event{Id: "e2", EnvironmentId: "ns0"},
+				&eventproto.Event{Id: "e3", EnvironmentId: "ns1"},
+			})
+			f.Flush(context.Background())
+			ids := []string{}
+			for _, e := range f.events {
+				ids = append(ids, e.Id)
+			}
+			assert.Equal(t, p.expectedIDs, ids)
+		})
+	}
+}
+
+func TestForwarderFlushKeepsEventSource(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	goal, err := anypb.New(&eventproto.GoalEvent{SourceId: eventproto.SourceId_ANDROID, SdkVersion: "2.1.0"})
+	require.NoError(t, err)
+	evaluation, err := anypb.New(&eventproto.EvaluationEvent{SourceId: eventproto.SourceId_IOS, SdkVersion: "2.2.0"})
+	require.NoError(t, err)
+
+	client := apiclientmock.NewMockClient(mockController)
+	sent := make(map[eventproto.SourceId]*gwproto.RegisterEventsRequest)
+	client.EXPECT().RegisterEvents(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context, req *gwproto.RegisterEventsRequest, _ ...interface{},
+		) (*gwproto.RegisterEventsResponse, error) {
+			sent[req.SourceId] = req
+			return &gwproto.RegisterEventsResponse{}, nil
+		}).Times(3)
+	f := NewForwarder(client, staticAPIKeyResolver{"ns0": "server-key"}, "relay", time.Second, 10, zap.NewNop())
+	f.PublishMulti(context.Background(), []publisher.Message{
+		&eventproto.Event{Id: "e1", EnvironmentId: "ns0", Event: goal},
+		&eventproto.Event{Id: "e2", EnvironmentId: "ns0", Event: evaluation},
+		&eventproto.Event{Id: "e3", EnvironmentId: "ns0", Event: goal},
+		&eventproto.Event{Id: "e4", EnvironmentId: "ns0"},
+	})
+	f.Flush(context.Background())
+
+	assert.Equal(t, 0, f.Len())
+	require.Len(t, sent, 3)
+	assert.Equal(t, "2.1.0", sent[eventproto.SourceId_ANDROID].SdkVersion)
+	assert.Len(t, sent[eventproto.SourceId_ANDROID].Events, 2)
+	assert.Equal(t, "2.2.0", sent[eventproto.SourceId_IOS].SdkVersion)
+	assert.Len(t, sent[eventproto.SourceId_IOS].Events, 1)
+	// Events without a source are reported as the relay's.
+	assert.Equal(t, "relay", sent[eventproto.SourceId_GO_SERVER].SdkVersion)
+	assert.Equal(t, "e4", sent[eventproto.SourceId_GO_SERVER].Events[0].Id)
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	accdomain "github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	accstorage "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2"
+	featureclient "github.com/bucketeer-io/bucketeer/v2/pkg/feature/client"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+)
+
+var errNotInSnapshot = status.Error(codes.Unavailable, "relay: not in snapshot")
+
+// offlineFeatureClient answers the gateway's storage fallback.
+// The relay serves only what the syncer has put in the snapshot, so a miss
+// never reaches a backend and the SDK is asked to retry instead.
+// Only the methods used by the SDK endpoints are implemented.
+type offlineFeatureClient struct {
+	featureclient.Client
+}
+
+func NewOfflineFeatureClient() featureclient.Client {
+	return offlineFeatureClient{}
+}
+
+func (offlineFeatureClient) ListFeatures(
+	ctx context.Context,
+	req *featureproto.ListFeaturesRequest,
+	opts ...grpc.CallOption,
+) (*featureproto.ListFeaturesResponse, error) {
+	return nil, errNotInSnapshot
+}
+
+func (offlineFeatureClient) ListSegmentUsers(
+	ctx context.Context,
+	req *featureproto.ListSegmentUsersRequest,
+	opts ...grpc.CallOption,
+) (*featureproto.ListSegmentUsersResponse, error) {
+	return nil, errNotInSnapshot
+}
+
+func (offlineFeatureClient) GetSegment(
+	ctx context.Context,
+	req *featureproto.GetSegmentRequest,
+	opts ...grpc.CallOption,
+) (*featureproto.GetSegmentResponse, error) {
+	return nil, errNotInSnapshot
+}
+
+func (offlineFeatureClient) Close() {}
+
+// offlineAccountStorage treats API keys missing from the snapshot as invalid
+// and leaves the last-used timestamps to the upstream gateway.
+// Only the methods used by the SDK endpoints are implemented.
+type offlineAccountStorage struct {
+	accstorage.AccountStorage
+}
+
+func NewOfflineAccountStorage() accstorage.AccountStorage {
+	return offlineAccountStorage{}
+}
+
+func (offlineAccountStorage) GetEnvironmentAPIKey(
+	ctx context.Context,
+	apiKey string,
+) (*accdomain.EnvironmentAPIKey, error) {
+	return nil, accstorage.ErrAPIKeyNotFound
+}
+
+func (offlineAccountStorage) UpdateAPIKeyLastUsedAt(
+	ctx context.Context,
+	id, environmentID string,
+	lastUsedAt int64,
+) (bool, error) {
+	return false, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/rpc"
+	gwproto "github.com/bucketeer-io/bucketeer/v2/proto/gateway"
+)
+
+// sdkGatewayService exposes only the SDK endpoints of the gateway.
+// The relay has no backend services behind it, so the other RPCs answer Unimplemented.
+type sdkGatewayService struct {
+	gwproto.UnimplementedGatewayServer
+	gateway gwproto.GatewayServer
+}
+
+// NewSDKGatewayService wraps a gateway service created by api.NewGrpcGatewayService.
+func NewSDKGatewayService(gateway gwproto.GatewayServer) rpc.Service {
+	return &sdkGatewayService{gateway: gateway}
+}
+
+func (s *sdkGatewayService) Register(server *grpc.Server) {
+	gwproto.RegisterGatewayServer(server, s)
+}
+
+// ShutdownMetricsPool stops the wrapped gateway's metrics workers.
+func (s *sdkGatewayService) ShutdownMetricsPool() {
+	if metricsPool, ok := s.gateway.(interface{ ShutdownMetricsPool() }); ok {
+		metricsPool.ShutdownMetricsPool()
+	}
+}
+
+func (s *sdkGatewayService) Ping(
+	ctx context.Context,
+	req *gwproto.PingRequest,
+) (*gwproto.PingResponse, error) {
+	return s.gateway.Ping(ctx, req)
+}
+
+func (s *sdkGatewayService) GetEvaluations(
+	ctx context.Context,
+	req *gwproto.GetEvaluationsRequest,
+) (*gwproto.GetEvaluationsResponse, error) {
+	return s.gateway.GetEvaluations(ctx, req)
+}
+
+func (s *sdkGatewayService) GetEvaluation(
+	ctx context.Context,
+	req *gwproto.GetEvaluationRequest,
+) (*gwproto.GetEvaluationResponse, error) {
+	return s.gateway.GetEvaluation(ctx, req)
+}
+
+func (s *sdkGatewayService) GetFeatureFlags(
+	ctx context.Context,
+	req *gwproto.GetFeatureFlagsRequest,
+) (*gwproto.GetFeatureFlagsResponse, error) {
+	return s.gateway.GetFeatureFlags(ctx, req)
+}
+
+func (s *sdkGatewayService) GetSegmentUsers(
+	ctx context.Context,
+	req *gwproto.GetSegmentUsersRequest,
+) (*gwproto.GetSegmentUsersResponse, error) {
+	return s.gateway.GetSegmentUsers(ctx, req)
+}
+
+func (s *sdkGatewayService) RegisterEvents(
+	ctx context.Context,
+	req *gwproto.RegisterEventsRequest,
+) (*gwproto.RegisterEventsResponse, error) {
+	return s.gateway.RegisterEvents(ctx, req)
+}
+
+func (s *sdkGatewayService) Track(
+	ctx context.Context,
+	req *gwproto.TrackRequest,
+) (*gwproto.TrackResponse, error) {
+	return s.gateway.Track(ctx, req)
+}
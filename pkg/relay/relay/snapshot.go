@@ -0,0 +1,183 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/cache"
+)
+
+var errSnapshotInvalidKey = errors.New("relay: snapshot key must be a string")
+
+// Snapshot is the relay's local copy of the gateway L2 cache.
+// It implements cache.MultiGetCache so the gateway handlers can read from it
+// exactly as they read from Redis, and it can be persisted to disk so the relay
+// keeps serving after a restart while the upstream is unreachable.
+// Expirations are ignored because the syncer owns every entry.
+type Snapshot struct {
+	mu      sync.RWMutex
+	entries map[string][]byte
+	path    string
+}
+
+func NewSnapshot(path string) *Snapshot {
+	return &Snapshot{
+		entries: make(map[string][]byte),
+		path:    path,
+	}
+}
+
+// Load replaces the entries with the ones saved on disk.
+// A missing file is not an error, the relay starts empty and fills up on the first sync.
+func (s *Snapshot) Load() error {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	entries := make(map[string][]byte)
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&entries); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.entries = entries
+	s.mu.Unlock()
+	return nil
+}
+
+// Save writes the entries to disk.
+// It writes to a temporary file first and renames it so a crash never leaves a partial snapshot.
+func (s *Snapshot) Save() error {
+	var buf bytes.Buffer
+	s.mu.RLock()
+	err := gob.NewEncoder(&buf).Encode(s.entries)
+	s.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+func (s *Snapshot) Get(key interface{}) (interface{}, error) {
+	k, ok := key.(string)
+	if !ok {
+		return nil, errSnapshotInvalidKey
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, ok := s.entries[k]
+	if !ok {
+		return nil, cache.ErrNotFound
+	}
+	return value, nil
+}
+
+func (s *Snapshot) Put(key interface{}, value interface{}, _ time.Duration) error {
+	k, ok := key.(string)
+	if !ok {
+		return errSnapshotInvalidKey
+	}
+	b, err := cache.Bytes(value)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[k] = b
+	return nil
+}
+
+func (s *Snapshot) GetMulti(keys interface{}, ignoreNotFound bool) ([]interface{}, error) {
+	ks, ok := keys.([]string)
+	if !ok {
+		return nil, errSnapshotInvalidKey
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	values := make([]interface{}, 0, len(ks))
+	for _, k := range ks {
+		value, ok := s.entries[k]
+		if !ok {
+			if ignoreNotFound {
+				continue
+			}
+			return nil, cache.ErrNotFound
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// Scan supports the prefix patterns ("<prefix>*") used by the gateway caches.
+// All matching keys are returned at once, so the returned cursor is always 0.
+func (s *Snapshot) Scan(_, key, _ interface{}) (uint64, []string, error) {
+	pattern, ok := key.(string)
+	if !ok {
+		return 0, nil, errSnapshotInvalidKey
+	}
+	return 0, s.Keys(strings.TrimSuffix(pattern, "*")), nil
+}
+
+func (s *Snapshot) SMembers(key string) ([]string, error) {
+	return nil, cache.ErrNotFound
+}
+
+func (s *Snapshot) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+	return nil
+}
+
+// Keys returns the sorted keys starting with prefix.
+func (s *Snapshot) Keys(prefix string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := []string{}
+	for k := range s.entries {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
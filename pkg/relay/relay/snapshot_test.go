@@ -0,0 +1,89 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/cache"
+)
+
+func TestSnapshotGetPut(t *testing.T) {
+	t.Parallel()
+	s := NewSnapshot(filepath.Join(t.TempDir(), "snapshot"))
+
+	_, err := s.Get("key-0")
+	assert.Equal(t, cache.ErrNotFound, err)
+
+	require.NoError(t, s.Put("key-0", []byte("value-0"), 0))
+	v, err := s.Get("key-0")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value-0"), v)
+
+	assert.Equal(t, cache.ErrInvalidType, s.Put("key-1", "value-1", 0))
+	assert.Equal(t, errSnapshotInvalidKey, s.Put(1, []byte("value-1"), 0))
+
+	require.NoError(t, s.Delete("key-0"))
+	_, err = s.Get("key-0")
+	assert.Equal(t, cache.ErrNotFound, err)
+}
+
+func TestSnapshotGetMultiAndScan(t *testing.T) {
+	t.Parallel()
+	s := NewSnapshot(filepath.Join(t.TempDir(), "snapshot"))
+	require.NoError(t, s.Put("ns0:segment_users:s1", []byte("1"), 0))
+	require.NoError(t, s.Put("ns0:segment_users:s0", []byte("0"), 0))
+	require.NoError(t, s.Put("ns1:segment_users:s2", []byte("2"), 0))
+
+	cursor, keys, err := s.Scan(uint64(0), "ns0:segment_users:*", int64(100))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), cursor)
+	assert.Equal(t, []string{"ns0:segment_users:s0", "ns0:segment_users:s1"}, keys)
+
+	values, err := s.GetMulti(keys, false)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{[]byte("0"), []byte("1")}, values)
+
+	_, err = s.GetMulti([]string{"ns0:segment_users:s0", "missing"}, false)
+	assert.Equal(t, cache.ErrNotFound, err)
+	values, err = s.GetMulti([]string{"ns0:segment_users:s0", "missing"}, true)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{[]byte("0")}, values)
+}
+
+func TestSnapshotSaveLoad(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "snapshot")
+
+	empty := NewSnapshot(path)
+	require.NoError(t, empty.Load())
+	assert.Empty(t, empty.Keys(""))
+
+	s := NewSnapshot(path)
+	require.NoError(t, s.Put("key-0", []byte("value-0"), 0))
+	require.NoError(t, s.Put("key-1", []byte("value-1"), 0))
+	require.NoError(t, s.Save())
+
+	loaded := NewSnapshot(path)
+	require.NoError(t, loaded.Load())
+	assert.Equal(t, []string{"key-0", "key-1"}, loaded.Keys(""))
+	v, err := loaded.Get("key-1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value-1"), v)
+}
@@ -0,0 +1,429 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	evaluation "github.com/bucketeer-io/bucketeer/v2/evaluation/go"
+	accountdomain "github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	apiclient "github.com/bucketeer-io/bucketeer/v2/pkg/api/client"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/cache"
+	cachev3 "github.com/bucketeer-io/bucketeer/v2/pkg/cache/v3"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/client"
+	domaineventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+	gwproto "github.com/bucketeer-io/bucketeer/v2/proto/gateway"
+)
+
+// Archived flags are kept as long as the gateway reports them to SDKs polling with a diff.
+const secondsToKeepArchivedFlags = 30 * 24 * 60 * 60
+
+var errAPIKeyNotListed = errors.New("relay: the server api key is not listed by the upstream")
+
+// EventHandler is implemented by the gateway cache invalidator.
+// The syncer synthesizes domain events for each flag and segment change so the
+// L1 cache is evicted and the SSE streams are notified the same way as in the gateway.
+// API keys need no events because the gateway reads them from the snapshot only.
+type EventHandler interface {
+	HandleEvent(event *domaineventproto.Event)
+}
+
+type syncState struct {
+	featureFlagsID     string
+	segmentRequestedAt int64
+}
+
+// Syncer polls the upstream gateway with each configured server API key and
+// keeps the snapshot in the same shape the subscriber keeps the Redis cache.
+// Upstream errors are logged and the snapshot is left as is, so the relay keeps
+// serving the last known state.
+type Syncer struct {
+	client            apiclient.Client
+	apiKeys           []string
+	snapshot          *Snapshot
+	featuresCache     cachev3.FeaturesCache
+	segmentUsersCache cachev3.SegmentUsersCache
+	apiKeyCache       *apiKeyCache
+	eventHandler      EventHandler
+	sdkVersion        string
+	interval          time.Duration
+	states            map[string]*syncState
+	mu                sync.RWMutex
+	environments      map[string]string
+	logger            *zap.Logger
+}
+
+func NewSyncer(
+	client apiclient.Client,
+	apiKeys []string,
+	snapshot *Snapshot,
+	eventHandler EventHandler,
+	sdkVersion string,
+	interval time.Duration,
+	logger *zap.Logger,
+) *Syncer {
+	return &Syncer{
+		client:            client,
+		apiKeys:           apiKeys,
+		snapshot:          snapshot,
+		featuresCache:     cachev3.NewFeaturesCache(snapshot, 0),
+		segmentUsersCache: cachev3.NewSegmentUsersCache(snapshot, 0),
+		apiKeyCache:       &apiKeyCache{snapshot: snapshot},
+		eventHandler:      eventHandler,
+		sdkVersion:        sdkVersion,
+		interval:          interval,
+		states:            make(map[string]*syncState),
+		environments:      make(map[string]string),
+		logger:            logger.Named("syncer"),
+	}
+}
+
+// Run syncs immediately and then on every interval until the context is done.
+func (s *Syncer) Run(ctx context.Context) {
+	s.Sync(ctx)
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.Sync(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// APIKey returns the server API key the relay uses for the environment.
+// It is known once the environment has been synced at least once.
+func (s *Syncer) APIKey(environmentID string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	apiKey, ok := s.environments[environmentID]
+	return apiKey, ok
+}
+
+// Sync pulls every configured environment once and saves the snapshot when anything changed.
+// It must not be called concurrently.
+func (s *Syncer) Sync(ctx context.Context) {
+	changed := false
+	for i, apiKey := range s.apiKeys {
+		c, err := s.syncEnvironment(ctx, apiKey)
+		changed = changed || c
+		if err != nil {
+			s.logger.Error("Failed to sync environment from upstream",
+				zap.Error(err),
+				zap.Int("apiKeyIndex", i),
+			)
+		}
+	}
+	if !changed {
+		return
+	}
+	if err := s.snapshot.Save(); err != nil {
+		s.logger.Error("Failed to save snapshot", zap.Error(err))
+	}
+}
+
+func (s *Syncer) syncEnvironment(ctx context.Context, apiKey string) (bool, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", apiKey)
+	environmentID, keysChanged, err := s.syncAPIKeys(ctx, apiKey)
+	if err != nil {
+		return false, err
+	}
+	state, ok := s.states[apiKey]
+	if !ok {
+		state = &syncState{}
+		s.states[apiKey] = state
+	}
+	featuresChanged, err := s.syncFeatures(ctx, environmentID, state)
+	if err != nil {
+		return keysChanged, err
+	}
+	segmentsChanged, err := s.syncSegmentUsers(ctx, environmentID, state)
+	return keysChanged || featuresChanged || segmentsChanged, err
+}
+
+func (s *Syncer) syncAPIKeys(ctx context.Context, apiKey string) (string, bool, error) {
+	resp, err := s.client.ListSDKAPIKeys(ctx, &gwproto.ListSDKAPIKeysRequest{})
+	if err != nil {
+		return "", false, err
+	}
+	var environmentID string
+	ownHash := accountdomain.HashAPIKey(apiKey)
+	latest := make(map[string]*accountproto.EnvironmentAPIKey, len(resp.SdkApiKeys))
+	for _, k := range resp.SdkApiKeys {
+		envAPIKey := k.EnvironmentApiKey
+		// The last used time changes on every request and is recorded by the upstream only.
+		envAPIKey.ApiKey.LastUsedAt = 0
+		latest[k.ApiKeySha256] = envAPIKey
+		if k.ApiKeySha256 == ownHash {
+			environmentID = envAPIKey.Environment.Id
+		}
+	}
+	if environmentID == "" {
+		return "", false, errAPIKeyNotListed
+	}
+	s.mu.Lock()
+	s.environments[environmentID] = apiKey
+	s.mu.Unlock()
+
+	changed := false
+	for _, key := range s.snapshot.Keys(environmentAPIKeyPrefix) {
+		hash := strings.TrimPrefix(key, environmentAPIKeyPrefix)
+		if _, ok := latest[hash]; ok {
+			continue
+		}
+		stored, err := s.apiKeyCache.getByHash(hash)
+		if err != nil || stored.Environment.GetId() != environmentID {
+			continue
+		}
+		if err := s.apiKeyCache.evictByHash(hash); err != nil {
+			return environmentID, changed, err
+		}
+		changed = true
+	}
+	for hash, envAPIKey := range latest {
+		stored, err := s.apiKeyCache.getByHash(hash)
+		if err == nil && proto.Equal(stored, envAPIKey) {
+			continue
+		}
+		if err := s.apiKeyCache.putByHash(hash, envAPIKey); err != nil {
+			return environmentID, changed, err
+		}
+		changed = true
+	}
+	return environmentID, changed, nil
+}
+
+func (s *Syncer) syncFeatures(ctx context.Context, environmentID string, state *syncState) (bool, error) {
+	stored, err := s.featuresCache.Get(environmentID)
+	if err != nil && !errors.Is(err, cache.ErrNotFound) {
+		return false, err
+	}
+	var previous []*featureproto.Feature
+	if stored != nil {
+		previous = stored.Features
+		// After a restart the ID is recomputed from the snapshot so an unchanged
+		// environment is not downloaded again.
+		if state.featureFlagsID == "" {
+			state.featureFlagsID = activeFeaturesID(previous)
+		}
+	}
+	// A zero requested_at makes the upstream return the full set whenever the ID
+	// differs, so the snapshot is never built from a partial diff.
+	resp, err := s.client.GetFeatureFlags(ctx, &gwproto.GetFeatureFlagsRequest{
+		FeatureFlagsId: state.featureFlagsID,
+		RequestedAt:    0,
+		SourceId:       eventproto.SourceId_GO_SERVER,
+		SdkVersion:     s.sdkVersion,
+	})
+	if err != nil {
+		return false, err
+	}
+	if stored != nil && resp.FeatureFlagsId == state.featureFlagsID {
+		return false, nil
+	}
+	features, changes := mergeFeatures(previous, resp.Features, time.Now().Unix())
+	next := &featureproto.Features{
+		Id:       evaluation.GenerateFeaturesID(features),
+		Features: features,
+	}
+	state.featureFlagsID = resp.FeatureFlagsId
+	if stored != nil && proto.Equal(stored, next) {
+		return false, nil
+	}
+	if err := s.featuresCache.Put(next, environmentID); err != nil {
+		return false, err
+	}
+	for _, c := range changes {
+		s.eventHandler.HandleEvent(featureEvent(environmentID, c.previous, c.current))
+	}
+	return true, nil
+}
+
+func (s *Syncer) syncSegmentUsers(ctx context.Context, environmentID string, state *syncState) (bool, error) {
+	stored, err := s.segmentUsersCache.GetAll(environmentID)
+	if err != nil {
+		return false, err
+	}
+	known := make(map[string]*featureproto.SegmentUsers, len(stored))
+	segmentIDs := make([]string, 0, len(stored))
+	for _, su := range stored {
+		known[su.SegmentId] = su
+		segmentIDs = append(segmentIDs, su.SegmentId)
+	}
+	sort.Strings(segmentIDs)
+	resp, err := s.client.GetSegmentUsers(ctx, &gwproto.GetSegmentUsersRequest{
+		SegmentIds:  segmentIDs,
+		RequestedAt: state.segmentRequestedAt,
+		SourceId:    eventproto.SourceId_GO_SERVER,
+		SdkVersion:  s.sdkVersion,
+	})
+	if err != nil {
+		return false, err
+	}
+	changed := false
+	latest := make(map[string]struct{}, len(resp.SegmentUsers))
+	for _, su := range resp.SegmentUsers {
+		latest[su.SegmentId] = struct{}{}
+		if p, ok := known[su.SegmentId]; ok && proto.Equal(p, su) {
+			continue
+		}
+		if err := s.segmentUsersCache.Put(su, environmentID); err != nil {
+			return changed, err
+		}
+		s.eventHandler.HandleEvent(segmentEvent(environmentID, su.SegmentId))
+		changed = true
+	}
+	deleted := resp.DeletedSegmentIds
+	if resp.ForceUpdate {
+		// A forced update carries every segment in use, so the rest are gone.
+		deleted = make([]string, 0, len(segmentIDs))
+		for _, id := range segmentIDs {
+			if _, ok := latest[id]; !ok {
+				deleted = append(deleted, id)
+			}
+		}
+	}
+	for _, id := range deleted {
+		if _, ok := known[id]; !ok {
+			continue
+		}
+		if err := s.segmentUsersCache.Evict(id, environmentID); err != nil {
+			return changed, err
+		}
+		s.eventHandler.HandleEvent(segmentEvent(environmentID, id))
+		changed = true
+	}
+	state.segmentRequestedAt = resp.RequestedAt
+	return changed, nil
+}
+
+type featureChange struct {
+	previous *featureproto.Feature
+	current  *featureproto.Feature
+}
+
+// mergeFeatures applies the full flag list returned by the upstream to the flags in the snapshot.
+// The upstream leaves archived flags out of the list, so a flag that disappeared is kept
+// as archived for thirty days, which lets the relay report it to SDKs polling with a diff.
+func mergeFeatures(
+	previous, latest []*featureproto.Feature,
+	now int64,
+) ([]*featureproto.Feature, []featureChange) {
+	previousByID := make(map[string]*featureproto.Feature, len(previous))
+	for _, f := range previous {
+		previousByID[f.Id] = f
+	}
+	latestIDs := make(map[string]struct{}, len(latest))
+	features := make([]*featureproto.Feature, 0, len(latest))
+	changes := []featureChange{}
+	for _, f := range latest {
+		latestIDs[f.Id] = struct{}{}
+		features = append(features, f)
+		p, ok := previousByID[f.Id]
+		if !ok || !proto.Equal(p, f) {
+			changes = append(changes, featureChange{previous: p, current: f})
+		}
+	}
+	for _, p := range previous {
+		if _, ok := latestIDs[p.Id]; ok {
+			continue
+		}
+		if p.Archived {
+			if p.UpdatedAt >= now-secondsToKeepArchivedFlags {
+				features = append(features, p)
+			}
+			continue
+		}
+		archived := proto.Clone(p).(*featureproto.Feature)
+		archived.Archived = true
+		archived.UpdatedAt = now
+		features = append(features, archived)
+		changes = append(changes, featureChange{previous: p, current: archived})
+	}
+	return features, changes
+}
+
+// activeFeaturesID returns the ID the upstream computes for the flags, which excludes archived ones.
+func activeFeaturesID(features []*featureproto.Feature) string {
+	active := make([]*featureproto.Feature, 0, len(features))
+	for _, f := range features {
+		if !f.Archived {
+			active = append(active, f)
+		}
+	}
+	if len(active) == 0 {
+		return ""
+	}
+	return evaluation.GenerateFeaturesID(active)
+}
+
+func featureEvent(environmentID string, previous, current *featureproto.Feature) *domaineventproto.Event {
+	return &domaineventproto.Event{
+		Timestamp:          time.Now().Unix(),
+		EntityType:         domaineventproto.Event_FEATURE,
+		EntityId:           current.Id,
+		Type:               domaineventproto.Event_FEATURE_UPDATED,
+		EntityData:         featureTagsJSON(current),
+		PreviousEntityData: featureTagsJSON(previous),
+		EnvironmentId:      environmentID,
+	}
+}
+
+// featureTagsJSON encodes the only entity field the stream dispatcher reads.
+func featureTagsJSON(feature *featureproto.Feature) string {
+	if feature == nil {
+		return ""
+	}
+	data, err := json.Marshal(struct {
+		Tags []string `json:"tags"`
+	}{Tags: feature.Tags})
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func segmentEvent(environmentID, segmentID string) *domaineventproto.Event {
+	event := &domaineventproto.Event{
+		Timestamp:     time.Now().Unix(),
+		EntityType:    domaineventproto.Event_SEGMENT,
+		EntityId:      segmentID,
+		Type:          domaineventproto.Event_SEGMENT_BULK_UPLOAD_USERS_STATUS_CHANGED,
+		EnvironmentId: environmentID,
+	}
+	data, err := anypb.New(&domaineventproto.SegmentBulkUploadUsersStatusChangedEvent{
+		SegmentId: segmentID,
+		Status:    featureproto.Segment_SUCEEDED,
+	})
+	if err == nil {
+		event.Data = data
+	}
+	return event
+}
@@ -0,0 +1,261 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	accountdomain "github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	apiclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/api/client/mock"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	environmentproto "github.com/bucketeer-io/bucketeer/v2/proto/environment"
+	domaineventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+	gwproto "github.com/bucketeer-io/bucketeer/v2/proto/gateway"
+)
+
+type recordingEventHandler struct {
+	mu     sync.Mutex
+	events []*domaineventproto.Event
+}
+
+func (h *recordingEventHandler) HandleEvent(event *domaineventproto.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.events = append(h.events, event)
+}
+
+func (h *recordingEventHandler) reset() []*domaineventproto.Event {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	events := h.events
+	h.events = nil
+	return events
+}
+
+func TestSyncerSync(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	const (
+		serverKey = "server-key"
+		clientKey = "client-key"
+		envID     = "ns0"
+	)
+	env := &environmentproto.EnvironmentV2{Id: envID}
+	apiKeysResp := &gwproto.ListSDKAPIKeysResponse{
+		SdkApiKeys: []*gwproto.ListSDKAPIKeysResponse_SDKAPIKey{
+			{
+				ApiKeySha256: accountdomain.HashAPIKey(serverKey),
+				EnvironmentApiKey: &accountproto.EnvironmentAPIKey{
+					Environment: env,
+					ApiKey:      &accountproto.APIKey{Id: "id-0", Role: accountproto.APIKey_SDK_SERVER},
+				},
+			},
+			{
+				ApiKeySha256: accountdomain.HashAPIKey(clientKey),
+				EnvironmentApiKey: &accountproto.EnvironmentAPIKey{
+					Environment: env,
+					ApiKey:      &accountproto.APIKey{Id: "id-1", Role: accountproto.APIKey_SDK_CLIENT},
+				},
+			},
+		},
+	}
+	f1 := &featureproto.Feature{Id: "f1", Tags: []string{"web"}, UpdatedAt: 10}
+	f2 := &featureproto.Feature{Id: "f2", Tags: []string{"ios"}, UpdatedAt: 20}
+	su1 := &featureproto.SegmentUsers{SegmentId: "s1", UpdatedAt: 10}
+
+	path := filepath.Join(t.TempDir(), "snapshot")
+	snapshot := NewSnapshot(path)
+	client := apiclientmock.NewMockClient(mockController)
+	handler := &recordingEventHandler{}
+	syncer := NewSyncer(client, []string{serverKey}, snapshot, handler, "relay", 0, zap.NewNop())
+
+	// First sync downloads everything.
+	client.EXPECT().ListSDKAPIKeys(gomock.Any(), gomock.Any()).Return(apiKeysResp, nil)
+	client.EXPECT().GetFeatureFlags(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context, req *gwproto.GetFeatureFlagsRequest, _ ...interface{},
+		) (*gwproto.GetFeatureFlagsResponse, error) {
+			assert.Equal(t, "", req.FeatureFlagsId)
+			assert.Equal(t, int64(0), req.RequestedAt)
+			return &gwproto.GetFeatureFlagsResponse{
+				FeatureFlagsId: "ff-1",
+				Features:       []*featureproto.Feature{f1, f2},
+				ForceUpdate:    true,
+			}, nil
+		})
+	client.EXPECT().GetSegmentUsers(gomock.Any(), gomock.Any()).Return(&gwproto.GetSegmentUsersResponse{
+		SegmentUsers: []*featureproto.SegmentUsers{su1},
+		RequestedAt:  100,
+		ForceUpdate:  true,
+	}, nil)
+	syncer.Sync(context.Background())
+
+	key, ok := syncer.APIKey(envID)
+	assert.True(t, ok)
+	assert.Equal(t, serverKey, key)
+	stored, err := syncer.apiKeyCache.Get(clientKey)
+	require.NoError(t, err)
+	assert.Equal(t, envID, stored.Environment.Id)
+	assert.Equal(t, "id-1", stored.ApiKey.Id)
+	for _, key := range snapshot.Keys(environmentAPIKeyPrefix) {
+		assert.NotContains(t, key, clientKey)
+		assert.NotContains(t, key, serverKey)
+	}
+	features, err := syncer.featuresCache.Get(envID)
+	require.NoError(t, err)
+	assert.Len(t, features.Features, 2)
+	segmentUsers, err := syncer.segmentUsersCache.Get("s1", envID)
+	require.NoError(t, err)
+	assert.Equal(t, "s1", segmentUsers.SegmentId)
+	// Two flags and one segment were added.
+	assert.Len(t, handler.reset(), 3)
+
+	loaded := NewSnapshot(path)
+	require.NoError(t, loaded.Load())
+	assert.Equal(t, snapshot.Keys(""), loaded.Keys(""))
+
+	// Nothing changed upstream.
+	client.EXPECT().ListSDKAPIKeys(gomock.Any(), gomock.Any()).Return(apiKeysResp, nil)
+	client.EXPECT().GetFeatureFlags(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context, req *gwproto.GetFeatureFlagsRequest, _ ...interface{},
+		) (*gwproto.GetFeatureFlagsResponse, error) {
+			assert.Equal(t, "ff-1", req.FeatureFlagsId)
+			return &gwproto.GetFeatureFlagsResponse{FeatureFlagsId: "ff-1"}, nil
+		})
+	client.EXPECT().GetSegmentUsers(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context, req *gwproto.GetSegmentUsersRequest, _ ...interface{},
+		) (*gwproto.GetSegmentUsersResponse, error) {
+			assert.Equal(t, []string{"s1"}, req.SegmentIds)
+			assert.Equal(t, int64(100), req.RequestedAt)
+			return &gwproto.GetSegmentUsersResponse{RequestedAt: 200}, nil
+		})
+	syncer.Sync(context.Background())
+	assert.Empty(t, handler.reset())
+
+	// f2 was archived, the segment is no longer used and the client key was deleted.
+	client.EXPECT().ListSDKAPIKeys(gomock.Any(), gomock.Any()).Return(&gwproto.ListSDKAPIKeysResponse{
+		SdkApiKeys: apiKeysResp.SdkApiKeys[:1],
+	}, nil)
+	client.EXPECT().GetFeatureFlags(gomock.Any(), gomock.Any()).Return(&gwproto.GetFeatureFlagsResponse{
+		FeatureFlagsId: "ff-2",
+		Features:       []*featureproto.Feature{f1},
+		ForceUpdate:    true,
+	}, nil)
+	client.EXPECT().GetSegmentUsers(gomock.Any(), gomock.Any()).Return(&gwproto.GetSegmentUsersResponse{
+		DeletedSegmentIds: []string{"s1"},
+		RequestedAt:       300,
+	}, nil)
+	syncer.Sync(context.Background())
+
+	_, err = syncer.apiKeyCache.Get(clientKey)
+	assert.Error(t, err)
+	features, err = syncer.featuresCache.Get(envID)
+	require.NoError(t, err)
+	require.Len(t, features.Features, 2)
+	assert.False(t, features.Features[0].Archived)
+	assert.True(t, features.Features[1].Archived)
+	_, err = syncer.segmentUsersCache.Get("s1", envID)
+	assert.Error(t, err)
+	events := handler.reset()
+	require.Len(t, events, 2)
+	assert.Equal(t, domaineventproto.Event_FEATURE, events[0].EntityType)
+	assert.Equal(t, `{"tags":["ios"]}`, events[0].EntityData)
+	assert.Equal(t, domaineventproto.Event_SEGMENT, events[1].EntityType)
+}
+
+func TestSyncerSyncUpstreamUnavailable(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	snapshot := NewSnapshot(filepath.Join(t.TempDir(), "snapshot"))
+	require.NoError(t, snapshot.Put("ns0:features", []byte{}, 0))
+	client := apiclientmock.NewMockClient(mockController)
+	client.EXPECT().ListSDKAPIKeys(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+	handler := &recordingEventHandler{}
+	syncer := NewSyncer(client, []string{"server-key"}, snapshot, handler, "relay", 0, zap.NewNop())
+
+	syncer.Sync(context.Background())
+	_, ok := syncer.APIKey("ns0")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"ns0:features"}, snapshot.Keys(""))
+	assert.Empty(t, handler.reset())
+}
+
+func TestMergeFeatures(t *testing.T) {
+	t.Parallel()
+	now := int64(secondsToKeepArchivedFlags * 2)
+	patterns := []struct {
+		desc             string
+		previous         []*featureproto.Feature
+		latest           []*featureproto.Feature
+		expected         []*featureproto.Feature
+		expectedChangeID []string
+	}{
+		{
+			desc:             "new and updated flags",
+			previous:         []*featureproto.Feature{{Id: "f1", Version: 1}, {Id: "f2", Version: 1}},
+			latest:           []*featureproto.Feature{{Id: "f1", Version: 1}, {Id: "f2", Version: 2}, {Id: "f3"}},
+			expected:         []*featureproto.Feature{{Id: "f1", Version: 1}, {Id: "f2", Version: 2}, {Id: "f3"}},
+			expectedChangeID: []string{"f2", "f3"},
+		},
+		{
+			desc:             "missing flag is kept as archived",
+			previous:         []*featureproto.Feature{{Id: "f1"}, {Id: "f2"}},
+			latest:           []*featureproto.Feature{{Id: "f1"}},
+			expected:         []*featureproto.Feature{{Id: "f1"}, {Id: "f2", Archived: true, UpdatedAt: now}},
+			expectedChangeID: []string{"f2"},
+		},
+		{
+			desc: "archived flags are dropped after thirty days",
+			previous: []*featureproto.Feature{
+				{Id: "f1", Archived: true, UpdatedAt: now - 10},
+				{Id: "f2", Archived: true, UpdatedAt: now - secondsToKeepArchivedFlags - 10},
+			},
+			latest:           []*featureproto.Feature{},
+			expected:         []*featureproto.Feature{{Id: "f1", Archived: true, UpdatedAt: now - 10}},
+			expectedChangeID: []string{},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			features, changes := mergeFeatures(p.previous, p.latest, now)
+			require.Len(t, features, len(p.expected))
+			for i := range p.expected {
+				assert.True(t, proto.Equal(p.expected[i], features[i]), "%d", i)
+			}
+			ids := []string{}
+			for _, c := range changes {
+				ids = append(ids, c.current.Id)
+			}
+			assert.Equal(t, p.expectedChangeID, ids)
+		})
+	}
+}
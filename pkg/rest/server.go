@@ -107,6 +107,7 @@ func (s *Server) Stop(timeout time.Duration) {
 
 func (s *Server) setup() {
 	mws := newMiddleWares()
+	mws.Append(TracingServerMiddleware)
 	mws.Append(LogServerMiddleware(s.logger))
 	mws.Append(MetricsServerMiddleware)
 	for _, service := range s.services {
@@ -0,0 +1,192 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package role
+
+import (
+	"context"
+	"slices"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	accdomain "github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/rpc"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+)
+
+// Permission is an action on a resource that a request requires.
+type Permission struct {
+	ResourceType accountproto.Permission_ResourceType
+	Action       accountproto.Permission_Action
+	// ResourceID is empty when the action is not bound to a single resource, such as listing.
+	ResourceID string
+	// Tags returns the tags of the resource. It is called only when the permissions
+	// can't be decided without them, so it may query the storage.
+	Tags func() ([]string, error)
+}
+
+// CheckPermission checks that the account is granted all the permissions in the environment.
+// The environment role grants the permissions of its built-in role,
+// and the custom roles assigned to the account add theirs.
+func CheckPermission(
+	ctx context.Context,
+	environmentID string,
+	getAccountFunc func(email string) (*accountproto.GetAccountV2ByEnvironmentIDResponse, error),
+	permissions ...Permission,
+) (*eventproto.Editor, error) {
+	token, ok := rpc.GetAccessToken(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	// Service tokens bypass all permission checks, as in CheckEnvironmentRole.
+	if token.IsSystemAdmin && token.IsServiceToken {
+		return &eventproto.Editor{
+			Email:   token.Email,
+			Name:    token.Name,
+			IsAdmin: true,
+		}, nil
+	}
+	publicAPIEditor := getAPIKeyEditor(ctx)
+	if publicAPIEditor != nil && publicAPIEditor.Token != "" && token.IsSystemAdmin {
+		resp, err := getAccountFunc(publicAPIEditor.Maintainer)
+		if err != nil {
+			return nil, err
+		}
+		account := accdomain.AccountV2{AccountV2: resp.Account}
+		return &eventproto.Editor{
+			Email:            publicAPIEditor.Maintainer,
+			Name:             account.GetAccountFullName(),
+			PublicApiEditor:  publicAPIEditor,
+			EnvironmentRoles: account.EnvironmentRoles,
+			OrganizationRole: account.OrganizationRole,
+		}, nil
+	}
+	// System admins can read from any org without membership.
+	if token.IsSystemAdmin && readOnly(permissions) {
+		return &eventproto.Editor{
+			Email:   token.Email,
+			Name:    token.Name,
+			IsAdmin: true,
+		}, nil
+	}
+	resp, err := getAccountFunc(token.Email)
+	if err != nil {
+		if code := status.Code(err); code == codes.NotFound {
+			return nil, ErrUnauthenticated
+		}
+		return nil, ErrInternal
+	}
+	account := resp.Account
+	if account.Disabled {
+		return nil, ErrUnauthenticated
+	}
+	editor := &eventproto.Editor{
+		Email:            account.Email,
+		Name:             token.Name,
+		IsAdmin:          token.IsSystemAdmin,
+		EnvironmentRoles: account.EnvironmentRoles,
+		OrganizationRole: account.OrganizationRole,
+	}
+	if account.OrganizationRole >= accountproto.AccountV2_Role_Organization_ADMIN {
+		return editor, nil
+	}
+	var granted []*accountproto.Permission
+	if builtIn := accdomain.BuiltInCustomRole(getRole(account.EnvironmentRoles, environmentID)); builtIn != nil {
+		granted = append(granted, builtIn.Permissions...)
+	}
+	for _, r := range resp.CustomRoles {
+		granted = append(granted, r.Permissions...)
+	}
+	for _, p := range permissions {
+		allowed, err := allows(granted, p)
+		if err != nil {
+			return nil, ErrInternal
+		}
+		if !allowed {
+			return nil, ErrPermissionDenied
+		}
+	}
+	return editor, nil
+}
+
+func CheckPermissionWithLog(
+	ctx context.Context,
+	environmentID string,
+	getAccountFunc func(email string) (*accountproto.GetAccountV2ByEnvironmentIDResponse, error),
+	logger *zap.Logger,
+	unauthenticatedErr error,
+	permissionDeniedErr error,
+	defaultErrFunc func(error) error,
+	permissions ...Permission,
+) (*eventproto.Editor, error) {
+	editor, err := CheckPermission(ctx, environmentID, getAccountFunc, permissions...)
+	if err != nil {
+		required := make([]string, 0, len(permissions))
+		for _, p := range permissions {
+			required = append(required, p.ResourceType.String()+":"+p.Action.String())
+		}
+		logFields := []zap.Field{
+			zap.Error(err),
+			zap.String("environmentId", environmentID),
+			zap.Strings("requiredPermissions", required),
+		}
+		if token, ok := rpc.GetAccessToken(ctx); ok {
+			logFields = append(logFields, zap.String("email", token.Email))
+		}
+		fields := log.FieldsFromIncomingContext(ctx).AddFields(logFields...)
+		switch status.Code(err) {
+		case codes.Unauthenticated:
+			logger.Error("Unauthenticated", fields...)
+			return nil, unauthenticatedErr
+		case codes.PermissionDenied:
+			logger.Error("Permission denied", fields...)
+			return nil, permissionDeniedErr
+		default:
+			logger.Error("Failed to check permission", fields...)
+			return nil, defaultErrFunc(err)
+		}
+	}
+	return editor, nil
+}
+
+func allows(granted []*accountproto.Permission, p Permission) (bool, error) {
+	resource := accdomain.Resource{Type: p.ResourceType, ID: p.ResourceID}
+	if accdomain.Allows(granted, resource, p.Action) {
+		return true, nil
+	}
+	if p.Tags == nil || !slices.ContainsFunc(granted, func(g *accountproto.Permission) bool {
+		return g.ResourceType == p.ResourceType && len(g.Tags) > 0 && slices.Contains(g.Actions, p.Action)
+	}) {
+		return false, nil
+	}
+	tags, err := p.Tags()
+	if err != nil {
+		return false, err
+	}
+	resource.Tags = tags
+	return accdomain.Allows(granted, resource, p.Action), nil
+}
+
+func readOnly(permissions []Permission) bool {
+	for _, p := range permissions {
+		if p.Action != accountproto.Permission_READ {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,201 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package role
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/token"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+)
+
+func TestCheckPermission(t *testing.T) {
+	t.Parallel()
+
+	userCtx := getContextWithToken(t, &token.AccessToken{Email: "test@example.com", Name: "test"})
+	accountResp := func(
+		envRole accountproto.AccountV2_Role_Environment,
+		customRoles ...*accountproto.CustomRole,
+	) func(string) (*accountproto.GetAccountV2ByEnvironmentIDResponse, error) {
+		return func(string) (*accountproto.GetAccountV2ByEnvironmentIDResponse, error) {
+			return &accountproto.GetAccountV2ByEnvironmentIDResponse{
+				Account: &accountproto.AccountV2{
+					Email: "test@example.com",
+					EnvironmentRoles: []*accountproto.AccountV2_EnvironmentRole{
+						{EnvironmentId: "ns0", Role: envRole},
+					},
+				},
+				CustomRoles: customRoles,
+			}, nil
+		}
+	}
+	toggleWeb := &accountproto.CustomRole{
+		Id: "toggle-web",
+		Permissions: []*accountproto.Permission{
+			{
+				ResourceType: accountproto.Permission_FEATURE,
+				Actions:      []accountproto.Permission_Action{accountproto.Permission_TOGGLE},
+				Tags:         []string{"web"},
+			},
+			{
+				ResourceType: accountproto.Permission_EXPERIMENT,
+				Actions: []accountproto.Permission_Action{
+					accountproto.Permission_CREATE,
+					accountproto.Permission_UPDATE,
+				},
+			},
+		},
+	}
+	toggle := Permission{
+		ResourceType: accountproto.Permission_FEATURE,
+		Action:       accountproto.Permission_TOGGLE,
+		ResourceID:   "feature-id",
+		Tags:         func() ([]string, error) { return []string{"web"}, nil },
+	}
+	patterns := []struct {
+		desc           string
+		ctx            context.Context
+		getAccountFunc func(string) (*accountproto.GetAccountV2ByEnvironmentIDResponse, error)
+		permissions    []Permission
+		expectedErr    error
+	}{
+		{
+			desc:        "err: unauthenticated",
+			ctx:         context.Background(),
+			permissions: []Permission{toggle},
+			expectedErr: ErrUnauthenticated,
+		},
+		{
+			desc: "success: service token",
+			ctx: getContextWithToken(t, &token.AccessToken{
+				Email: "batch@example.com", IsSystemAdmin: true, IsServiceToken: true,
+			}),
+			permissions: []Permission{toggle},
+		},
+		{
+			desc: "success: system admin reads",
+			ctx:  getContextWithToken(t, &token.AccessToken{Email: "admin@example.com", IsSystemAdmin: true}),
+			permissions: []Permission{{
+				ResourceType: accountproto.Permission_FEATURE,
+				Action:       accountproto.Permission_READ,
+			}},
+		},
+		{
+			desc: "err: account not found",
+			ctx:  userCtx,
+			getAccountFunc: func(string) (*accountproto.GetAccountV2ByEnvironmentIDResponse, error) {
+				return nil, status.Error(codes.NotFound, "")
+			},
+			permissions: []Permission{toggle},
+			expectedErr: ErrUnauthenticated,
+		},
+		{
+			desc:           "success: editor",
+			ctx:            userCtx,
+			getAccountFunc: accountResp(accountproto.AccountV2_Role_Environment_EDITOR),
+			permissions:    []Permission{toggle},
+		},
+		{
+			desc:           "err: viewer cannot toggle",
+			ctx:            userCtx,
+			getAccountFunc: accountResp(accountproto.AccountV2_Role_Environment_VIEWER),
+			permissions:    []Permission{toggle},
+			expectedErr:    ErrPermissionDenied,
+		},
+		{
+			desc:           "success: custom role toggles a tagged flag",
+			ctx:            userCtx,
+			getAccountFunc: accountResp(accountproto.AccountV2_Role_Environment_VIEWER, toggleWeb),
+			permissions:    []Permission{toggle},
+		},
+		{
+			desc:           "err: custom role cannot change targeting",
+			ctx:            userCtx,
+			getAccountFunc: accountResp(accountproto.AccountV2_Role_Environment_VIEWER, toggleWeb),
+			permissions: []Permission{toggle, {
+				ResourceType: accountproto.Permission_FEATURE,
+				Action:       accountproto.Permission_UPDATE_TARGETING,
+				ResourceID:   "feature-id",
+			}},
+			expectedErr: ErrPermissionDenied,
+		},
+		{
+			desc:           "err: custom role cannot toggle other tags",
+			ctx:            userCtx,
+			getAccountFunc: accountResp(accountproto.AccountV2_Role_Environment_UNASSIGNED, toggleWeb),
+			permissions: []Permission{{
+				ResourceType: accountproto.Permission_FEATURE,
+				Action:       accountproto.Permission_TOGGLE,
+				Tags:         func() ([]string, error) { return []string{"ios"}, nil },
+			}},
+			expectedErr: ErrPermissionDenied,
+		},
+		{
+			desc:           "err: failed to get tags",
+			ctx:            userCtx,
+			getAccountFunc: accountResp(accountproto.AccountV2_Role_Environment_UNASSIGNED, toggleWeb),
+			permissions: []Permission{{
+				ResourceType: accountproto.Permission_FEATURE,
+				Action:       accountproto.Permission_TOGGLE,
+				Tags:         func() ([]string, error) { return nil, errors.New("error") },
+			}},
+			expectedErr: ErrInternal,
+		},
+		{
+			desc:           "success: custom role without environment role",
+			ctx:            userCtx,
+			getAccountFunc: accountResp(accountproto.AccountV2_Role_Environment_UNASSIGNED, toggleWeb),
+			permissions: []Permission{{
+				ResourceType: accountproto.Permission_EXPERIMENT,
+				Action:       accountproto.Permission_CREATE,
+			}},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			_, err := CheckPermission(p.ctx, "ns0", p.getAccountFunc, p.permissions...)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestCheckPermissionWithLog(t *testing.T) {
+	t.Parallel()
+	ctx := getContextWithToken(t, &token.AccessToken{Email: "test@example.com"})
+	unauthenticatedErr := errors.New("unauthenticated")
+	permissionDeniedErr := errors.New("permission denied")
+	_, err := CheckPermissionWithLog(
+		ctx,
+		"ns0",
+		func(string) (*accountproto.GetAccountV2ByEnvironmentIDResponse, error) {
+			return &accountproto.GetAccountV2ByEnvironmentIDResponse{
+				Account: &accountproto.AccountV2{Email: "test@example.com"},
+			}, nil
+		},
+		zap.NewNop(),
+		unauthenticatedErr,
+		permissionDeniedErr,
+		func(err error) error { return err },
+		Permission{ResourceType: accountproto.Permission_SEGMENT, Action: accountproto.Permission_READ},
+	)
+	assert.Equal(t, permissionDeniedErr, err)
+}
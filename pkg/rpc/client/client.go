@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"go.opencensus.io/plugin/ocgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -99,6 +100,7 @@ func NewClientConn(addr string, certPath string, opts ...Option) (*grpc.ClientCo
 		grpc.WithTransportCredentials(cred),
 		grpc.WithUnaryInterceptor(options.unaryInterceptor()),
 		grpc.WithStatsHandler(options.statsHandler),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 	}
 	if options.perRPCCredentials != nil {
 		dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(options.perRPCCredentials))
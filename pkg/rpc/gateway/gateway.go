@@ -25,6 +25,8 @@ import (
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -363,6 +365,9 @@ func (g *Gateway) Start(ctx context.Context,
 		grpc.WithInitialConnWindowSize(g.opts.initialConnWindowSize),
 	)
 
+	// Propagate the trace context of the HTTP request to the gRPC server
+	dialOpts = append(dialOpts, grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+
 	// Register all the provided handler registrars
 	// The context will be used to manage the lifecycle of gRPC client connections.
 	// When the context is cancelled (after all shutdown logic completes), the gRPC
@@ -375,8 +380,10 @@ func (g *Gateway) Start(ctx context.Context,
 
 	// Create and start the HTTP server
 	g.httpServer = &http.Server{
-		Addr:         g.restAddr,
-		Handler:      mux,
+		Addr: g.restAddr,
+		// The paths contain resource IDs, so the span is named after the gateway
+		// and the RPC is identified by the child span of the gRPC client.
+		Handler:      otelhttp.NewHandler(mux, "grpc-gateway"),
 		ReadTimeout:  g.opts.httpReadTimeout,
 		WriteTimeout: g.opts.httpWriteTimeout,
 		IdleTimeout:  g.opts.httpIdleTimeout,
@@ -47,6 +47,9 @@ type Server struct {
 	readTimeout   time.Duration
 	writeTimeout  time.Duration
 	idleTimeout   time.Duration
+
+	// streamingMethods holds the full method names of the registered streaming RPCs.
+	streamingMethods map[string]struct{}
 }
 
 type httpHandler struct {
@@ -181,10 +184,12 @@ func (s *Server) setupRPC() {
 		grpc.Creds(creds),
 		grpc.ChainUnaryInterceptor(interceptors...),
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
+		grpc.StatsHandler(TracingServerHandler()),
 	)
 	for _, service := range s.services {
 		service.Register(s.rpcServer)
 	}
+	s.streamingMethods = streamingMethods(s.rpcServer)
 
 	// DEPRECATED: grpc-web support for legacy Node.js SDK
 	// TODO: Remove once Node.js SDK migrates to gRPC-Gateway (REST) or pure gRPC
@@ -205,6 +210,9 @@ func (s *Server) setupHTTP() {
 		if s.grpcWebServer.IsGrpcWebRequest(req) {
 			s.grpcWebServer.ServeHTTP(resp, req)
 		} else if isRPC(req) {
+			if _, ok := s.streamingMethods[req.URL.Path]; ok {
+				clearDeadlines(resp)
+			}
 			s.rpcServer.ServeHTTP(resp, req)
 		} else {
 			mux.ServeHTTP(resp, req)
@@ -227,6 +235,28 @@ func (s *Server) runServer() {
 	}
 }
 
+// streamingMethods lists the streaming RPCs registered on the server.
+func streamingMethods(server *grpc.Server) map[string]struct{} {
+	methods := make(map[string]struct{})
+	for name, info := range server.GetServiceInfo() {
+		for _, m := range info.Methods {
+			if m.IsServerStream || m.IsClientStream {
+				methods[fmt.Sprintf("/%s/%s", name, m.Name)] = struct{}{}
+			}
+		}
+	}
+	return methods
+}
+
+// clearDeadlines removes the per-stream read and write deadlines set from the
+// server timeouts, so long-lived streaming RPCs are not reset after writeTimeout.
+// The streams are still bounded by the client context and the server shutdown.
+func clearDeadlines(resp http.ResponseWriter) {
+	rc := http.NewResponseController(resp)
+	_ = rc.SetReadDeadline(time.Time{})
+	_ = rc.SetWriteDeadline(time.Time{})
+}
+
 func isRPC(req *http.Request) bool {
 	if req.ProtoMajor == 2 &&
 		strings.HasPrefix(req.Header.Get("Content-Type"), "application/grpc") {
@@ -23,10 +23,12 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	grpchealth "google.golang.org/grpc/health"
 	pb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 
@@ -226,6 +228,17 @@ func TestRPCHandlerUnauthenticated(t *testing.T) {
 	}
 }
 
+func TestStreamingMethods(t *testing.T) {
+	t.Parallel()
+	server := grpc.NewServer()
+	proto.RegisterTestServiceServer(server, &testService{})
+	pb.RegisterHealthServer(server, grpchealth.NewServer())
+	methods := streamingMethods(server)
+	assert.Contains(t, methods, "/grpc.health.v1.Health/Watch")
+	assert.NotContains(t, methods, "/grpc.health.v1.Health/Check")
+	assert.NotContains(t, methods, "/bucketeer.test.TestService/Test")
+}
+
 func TestMain(m *testing.M) {
 	// Because os.Exit doesn't return, we need to call defer in separated function.
 	code := testMain(m)
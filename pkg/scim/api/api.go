@@ -0,0 +1,270 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	accountclient "github.com/bucketeer-io/bucketeer/v2/pkg/account/client"
+	accountdomain "github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	v2as "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2"
+	teamclient "github.com/bucketeer-io/bucketeer/v2/pkg/team/client"
+)
+
+const (
+	pathPrefix           = "/scim/v2/"
+	organizationPath     = pathPrefix + "organizations/{organizationID}"
+	contentType          = "application/scim+json"
+	maxRequestBodyBytes  = 1024 * 1024 // 1MB
+	defaultPageSize      = 100
+	maxPageSize          = 1000
+	lastUsedAtUpdateSecs = 60
+)
+
+// apiError is returned by the handlers to be rendered as a SCIM error response.
+type apiError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("scim: %d %s: %s", e.status, e.scimType, e.detail)
+}
+
+func newAPIError(status int, scimType, detail string) *apiError {
+	return &apiError{status: status, scimType: scimType, detail: detail}
+}
+
+var (
+	errUnauthenticated  = newAPIError(http.StatusUnauthorized, "", "invalid or missing bearer token")
+	errPermissionDenied = newAPIError(http.StatusForbidden, "", "the token is not issued for this organization")
+)
+
+type options struct {
+	logger  *zap.Logger
+	baseURL string
+}
+
+type Option func(*options)
+
+func WithLogger(l *zap.Logger) Option {
+	return func(opts *options) {
+		opts.logger = l
+	}
+}
+
+// WithBaseURL sets the public URL of the web console used to build the resource locations.
+func WithBaseURL(u string) Option {
+	return func(opts *options) {
+		opts.baseURL = strings.TrimSuffix(u, "/")
+	}
+}
+
+// SCIMService serves the SCIM 2.0 Users and Groups endpoints of each organization.
+// Users are mapped to accounts and groups to teams. Every change goes through the
+// account and team services so that the domain events and audit logs are written.
+type SCIMService struct {
+	accountClient  accountclient.Client
+	teamClient     teamclient.Client
+	accountStorage v2as.AccountStorage
+	handler        http.Handler
+	opts           *options
+	logger         *zap.Logger
+}
+
+// NewSCIMService creates a new SCIM service.
+// The account storage is only used to authenticate the SCIM tokens.
+func NewSCIMService(
+	accountClient accountclient.Client,
+	teamClient teamclient.Client,
+	accountStorage v2as.AccountStorage,
+	opts ...Option,
+) *SCIMService {
+	dopts := &options{
+		logger: zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(dopts)
+	}
+	s := &SCIMService{
+		accountClient:  accountClient,
+		teamClient:     teamClient,
+		accountStorage: accountStorage,
+		opts:           dopts,
+		logger:         dopts.logger.Named("scim"),
+	}
+	mux := http.NewServeMux()
+	mux.Handle("GET "+organizationPath+"/Users", s.handle(s.listUsers))
+	mux.Handle("POST "+organizationPath+"/Users", s.handle(s.createUser))
+	mux.Handle("GET "+organizationPath+"/Users/{id}", s.handle(s.getUser))
+	mux.Handle("PUT "+organizationPath+"/Users/{id}", s.handle(s.replaceUser))
+	mux.Handle("PATCH "+organizationPath+"/Users/{id}", s.handle(s.patchUser))
+	mux.Handle("DELETE "+organizationPath+"/Users/{id}", s.handle(s.deleteUser))
+	mux.Handle("GET "+organizationPath+"/Groups", s.handle(s.listGroups))
+	mux.Handle("POST "+organizationPath+"/Groups", s.handle(s.createGroup))
+	mux.Handle("GET "+organizationPath+"/Groups/{id}", s.handle(s.getGroup))
+	mux.Handle("PUT "+organizationPath+"/Groups/{id}", s.handle(s.replaceGroup))
+	mux.Handle("PATCH "+organizationPath+"/Groups/{id}", s.handle(s.patchGroup))
+	mux.Handle("DELETE "+organizationPath+"/Groups/{id}", s.handle(s.deleteGroup))
+	s.handler = mux
+	return s
+}
+
+// Register registers the SCIM handlers with the HTTP mux.
+func (s *SCIMService) Register(mux *http.ServeMux) {
+	mux.Handle(pathPrefix, s.handler)
+}
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, organizationID string) error
+
+// handle authenticates the request with the organization's SCIM token
+// and renders the error returned by the handler.
+func (s *SCIMService) handle(h handlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		organizationID := r.PathValue("organizationID")
+		if err := s.authenticate(r.Context(), r, organizationID); err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodyBytes)
+		if err := h(w, r, organizationID); err != nil {
+			s.writeError(w, r, err)
+		}
+	})
+}
+
+func (s *SCIMService) authenticate(ctx context.Context, r *http.Request, organizationID string) error {
+	parts := strings.SplitN(r.Header.Get("Authorization"), " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") || parts[1] == "" {
+		return errUnauthenticated
+	}
+	token, err := s.accountStorage.GetSCIMTokenByHash(ctx, accountdomain.HashSCIMToken(parts[1]))
+	if err != nil {
+		if errors.Is(err, v2as.ErrSCIMTokenNotFound) {
+			return errUnauthenticated
+		}
+		return err
+	}
+	if token.OrganizationId != organizationID {
+		s.logger.Warn("SCIM token used for another organization",
+			zap.String("tokenId", token.Id),
+			zap.String("organizationId", organizationID),
+		)
+		return errPermissionDenied
+	}
+	// Throttle the write since the identity providers call the endpoints in bursts
+	now := time.Now().Unix()
+	if now-token.LastUsedAt >= lastUsedAtUpdateSecs {
+		if err := s.accountStorage.UpdateSCIMTokenLastUsedAt(ctx, token.Id, now); err != nil {
+			s.logger.Warn("Failed to update the SCIM token last used at",
+				zap.Error(err),
+				zap.String("tokenId", token.Id),
+			)
+		}
+	}
+	return nil
+}
+
+func (s *SCIMService) baseURL(organizationID string) string {
+	return fmt.Sprintf("%s/scim/v2/organizations/%s", s.opts.baseURL, organizationID)
+}
+
+func decodeBody(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return newAPIError(http.StatusBadRequest, scimTypeInvalidSyntax, "invalid request body")
+	}
+	return nil
+}
+
+// pagination returns the 1-based start index and the page size of a list request.
+func pagination(r *http.Request) (int, int) {
+	startIndex, err := strconv.Atoi(r.URL.Query().Get("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+	count, err := strconv.Atoi(r.URL.Query().Get("count"))
+	if err != nil || count < 1 {
+		count = defaultPageSize
+	}
+	if count > maxPageSize {
+		count = maxPageSize
+	}
+	return startIndex, count
+}
+
+func writeResponse(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body) //nolint:errcheck
+}
+
+func writeList(w http.ResponseWriter, total int64, startIndex int, resources []any) {
+	if resources == nil {
+		resources = []any{}
+	}
+	writeResponse(w, http.StatusOK, &listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: total,
+		StartIndex:   int64(startIndex),
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+func (s *SCIMService) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) {
+		apiErr = s.convertError(r, err)
+	}
+	writeResponse(w, apiErr.status, &errorResponse{
+		Schemas:  []string{schemaError},
+		Status:   strconv.Itoa(apiErr.status),
+		SCIMType: apiErr.scimType,
+		Detail:   apiErr.detail,
+	})
+}
+
+// convertError maps the errors returned by the account and team services to SCIM errors.
+func (s *SCIMService) convertError(r *http.Request, err error) *apiError {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.NotFound:
+		return newAPIError(http.StatusNotFound, "", "resource not found")
+	case codes.AlreadyExists:
+		return newAPIError(http.StatusConflict, scimTypeUniqueness, st.Message())
+	case codes.InvalidArgument:
+		return newAPIError(http.StatusBadRequest, scimTypeInvalidValue, st.Message())
+	case codes.FailedPrecondition:
+		return newAPIError(http.StatusBadRequest, "", st.Message())
+	}
+	s.logger.Error("Failed to handle the SCIM request",
+		zap.Error(err),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+	)
+	return newAPIError(http.StatusInternalServerError, "", "internal server error")
+}
@@ -0,0 +1,204 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	accountclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/account/client/mock"
+	accountdomain "github.com/bucketeer-io/bucketeer/v2/pkg/account/domain"
+	v2as "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2"
+	accstoragemock "github.com/bucketeer-io/bucketeer/v2/pkg/account/storage/v2/mock"
+	teamclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/team/client/mock"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	teamproto "github.com/bucketeer-io/bucketeer/v2/proto/team"
+)
+
+const (
+	testToken          = "scim-token"
+	testOrganizationID = "org-0"
+)
+
+func TestNewSCIMService(t *testing.T) {
+	t.Parallel()
+	s := NewSCIMService(nil, nil, nil, WithBaseURL("https://example.com/"))
+	assert.IsType(t, &SCIMService{}, s)
+	assert.Equal(t, "https://example.com", s.opts.baseURL)
+}
+
+func TestAuthenticate(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc           string
+		authorization  string
+		setup          func(*SCIMService)
+		expectedStatus int
+	}{
+		{
+			desc:           "err: missing token",
+			authorization:  "",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			desc:          "err: unknown token",
+			authorization: "Bearer unknown",
+			setup: func(s *SCIMService) {
+				s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().GetSCIMTokenByHash(
+					gomock.Any(), accountdomain.HashSCIMToken("unknown"),
+				).Return(nil, v2as.ErrSCIMTokenNotFound)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			desc:          "err: token of another organization",
+			authorization: "Bearer " + testToken,
+			setup: func(s *SCIMService) {
+				s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().GetSCIMTokenByHash(
+					gomock.Any(), accountdomain.HashSCIMToken(testToken),
+				).Return(newSCIMToken("org-1", time.Now().Unix()), nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			desc:          "success: last used at is updated",
+			authorization: "Bearer " + testToken,
+			setup: func(s *SCIMService) {
+				s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().GetSCIMTokenByHash(
+					gomock.Any(), accountdomain.HashSCIMToken(testToken),
+				).Return(newSCIMToken(testOrganizationID, 0), nil)
+				s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().UpdateSCIMTokenLastUsedAt(
+					gomock.Any(), "token-id", gomock.Any(),
+				).Return(nil)
+				expectListTeams(s, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:          "success: recently used",
+			authorization: "Bearer " + testToken,
+			setup: func(s *SCIMService) {
+				s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().GetSCIMTokenByHash(
+					gomock.Any(), accountdomain.HashSCIMToken(testToken),
+				).Return(newSCIMToken(testOrganizationID, time.Now().Unix()), nil)
+				expectListTeams(s, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			s := createSCIMService(mockController)
+			if p.setup != nil {
+				p.setup(s)
+			}
+			req := httptest.NewRequest(http.MethodGet, "/scim/v2/organizations/org-0/Groups", nil)
+			req.Header.Set("Authorization", p.authorization)
+			rec := httptest.NewRecorder()
+			mux := http.NewServeMux()
+			s.Register(mux)
+			mux.ServeHTTP(rec, req)
+			assert.Equal(t, p.expectedStatus, rec.Code)
+			assert.Equal(t, contentType, rec.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func TestConvertError(t *testing.T) {
+	t.Parallel()
+	s := NewSCIMService(nil, nil, nil)
+	req := httptest.NewRequest(http.MethodGet, "/scim/v2/organizations/org-0/Users", nil)
+	patterns := []struct {
+		err              error
+		expectedStatus   int
+		expectedSCIMType string
+	}{
+		{status.Error(codes.NotFound, "not found"), http.StatusNotFound, ""},
+		{status.Error(codes.AlreadyExists, "exists"), http.StatusConflict, scimTypeUniqueness},
+		{status.Error(codes.InvalidArgument, "invalid"), http.StatusBadRequest, scimTypeInvalidValue},
+		{status.Error(codes.Internal, "internal"), http.StatusInternalServerError, ""},
+	}
+	for _, p := range patterns {
+		actual := s.convertError(req, p.err)
+		assert.Equal(t, p.expectedStatus, actual.status)
+		assert.Equal(t, p.expectedSCIMType, actual.scimType)
+	}
+}
+
+func createSCIMService(c *gomock.Controller) *SCIMService {
+	return NewSCIMService(
+		accountclientmock.NewMockClient(c),
+		teamclientmock.NewMockClient(c),
+		accstoragemock.NewMockAccountStorage(c),
+	)
+}
+
+func newSCIMToken(organizationID string, lastUsedAt int64) *accountdomain.SCIMToken {
+	return &accountdomain.SCIMToken{
+		SCIMToken: &accountproto.SCIMToken{
+			Id:             "token-id",
+			OrganizationId: organizationID,
+			LastUsedAt:     lastUsedAt,
+		},
+	}
+}
+
+func expectAuthenticated(s *SCIMService) {
+	s.accountStorage.(*accstoragemock.MockAccountStorage).EXPECT().GetSCIMTokenByHash(
+		gomock.Any(), accountdomain.HashSCIMToken(testToken),
+	).Return(newSCIMToken(testOrganizationID, time.Now().Unix()), nil)
+}
+
+func expectListTeams(s *SCIMService, teams []*teamproto.Team) {
+	s.teamClient.(*teamclientmock.MockClient).EXPECT().ListTeams(
+		gomock.Any(), &teamproto.ListTeamsRequest{OrganizationId: testOrganizationID},
+	).Return(&teamproto.ListTeamsResponse{Teams: teams}, nil).AnyTimes()
+}
+
+func serve(t *testing.T, s *SCIMService, method, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, "/scim/v2/organizations/"+testOrganizationID+path, reader)
+	req = req.WithContext(context.Background())
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	rec := httptest.NewRecorder()
+	mux := http.NewServeMux()
+	s.Register(mux)
+	mux.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeResponse(t *testing.T, rec *httptest.ResponseRecorder, v any) {
+	t.Helper()
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), v))
+}
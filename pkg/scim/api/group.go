@@ -0,0 +1,419 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+
+	teamdomain "github.com/bucketeer-io/bucketeer/v2/pkg/team/domain"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	teamproto "github.com/bucketeer-io/bucketeer/v2/proto/team"
+)
+
+func (s *SCIMService) listGroups(w http.ResponseWriter, r *http.Request, organizationID string) error {
+	ctx := r.Context()
+	teams, err := s.listTeams(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	if filter := r.URL.Query().Get("filter"); filter != "" {
+		displayName, err := parseEqFilter(filter, "displayName")
+		if err != nil {
+			return newAPIError(http.StatusBadRequest, scimTypeInvalidFilter, "only `displayName eq` is supported")
+		}
+		teams = slices.DeleteFunc(teams, func(t *teamproto.Team) bool {
+			return t.Name != displayName
+		})
+	}
+	total := len(teams)
+	startIndex, count := pagination(r)
+	teams = teams[min(startIndex-1, total):min(startIndex-1+count, total)]
+	// The identity providers exclude the members when they only look groups up
+	withMembers := !strings.Contains(strings.ToLower(r.URL.Query().Get("excludedAttributes")), "members")
+	baseURL := s.baseURL(organizationID)
+	resources := make([]any, 0, len(teams))
+	for _, t := range teams {
+		var members []*accountproto.AccountV2
+		if withMembers {
+			if members, err = s.listMembers(ctx, organizationID, t.Name); err != nil {
+				return err
+			}
+		}
+		resources = append(resources, toGroup(baseURL, t, members))
+	}
+	writeList(w, int64(total), startIndex, resources)
+	return nil
+}
+
+func (s *SCIMService) createGroup(w http.ResponseWriter, r *http.Request, organizationID string) error {
+	ctx := r.Context()
+	var g group
+	if err := decodeBody(r, &g); err != nil {
+		return err
+	}
+	displayName := strings.TrimSpace(g.DisplayName)
+	if displayName == "" {
+		return newAPIError(http.StatusBadRequest, scimTypeInvalidValue, "displayName is required")
+	}
+	teams, err := s.listTeams(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	if slices.ContainsFunc(teams, func(t *teamproto.Team) bool { return t.Name == displayName }) {
+		return newAPIError(http.StatusConflict, scimTypeUniqueness, "the group already exists")
+	}
+	resp, err := s.teamClient.CreateTeam(ctx, &teamproto.CreateTeamRequest{
+		Name:           displayName,
+		OrganizationId: organizationID,
+	})
+	if err != nil {
+		return err
+	}
+	teams = append(teams, resp.Team)
+	for _, m := range g.Members {
+		if err := s.setMembership(ctx, organizationID, teams, resp.Team, m.Value, true); err != nil {
+			return err
+		}
+	}
+	return s.writeGroup(w, r, http.StatusCreated, organizationID, resp.Team)
+}
+
+func (s *SCIMService) getGroup(w http.ResponseWriter, r *http.Request, organizationID string) error {
+	teams, err := s.listTeams(r.Context(), organizationID)
+	if err != nil {
+		return err
+	}
+	team, err := findTeam(teams, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+	return s.writeGroup(w, r, http.StatusOK, organizationID, team)
+}
+
+// replaceGroup sets the members of the group. The environment roles of every member
+// are recomputed, so pushing a group again also applies a changed role mapping.
+func (s *SCIMService) replaceGroup(w http.ResponseWriter, r *http.Request, organizationID string) error {
+	ctx := r.Context()
+	var g group
+	if err := decodeBody(r, &g); err != nil {
+		return err
+	}
+	teams, err := s.listTeams(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	team, err := findTeam(teams, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+	if err := checkDisplayName(team, g.DisplayName); err != nil {
+		return err
+	}
+	emails := make([]string, 0, len(g.Members))
+	for _, m := range g.Members {
+		emails = append(emails, m.Value)
+	}
+	if err := s.replaceMembers(ctx, organizationID, teams, team, emails); err != nil {
+		return err
+	}
+	return s.writeGroup(w, r, http.StatusOK, organizationID, team)
+}
+
+func (s *SCIMService) patchGroup(w http.ResponseWriter, r *http.Request, organizationID string) error {
+	ctx := r.Context()
+	var req patchRequest
+	if err := decodeBody(r, &req); err != nil {
+		return err
+	}
+	teams, err := s.listTeams(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	team, err := findTeam(teams, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+	for _, op := range req.Operations {
+		if err := s.applyGroupOperation(ctx, organizationID, teams, team, op); err != nil {
+			return err
+		}
+	}
+	return s.writeGroup(w, r, http.StatusOK, organizationID, team)
+}
+
+// deleteGroup removes the team from its members before deleting it,
+// since a team cannot be deleted while an account belongs to it.
+func (s *SCIMService) deleteGroup(w http.ResponseWriter, r *http.Request, organizationID string) error {
+	ctx := r.Context()
+	teams, err := s.listTeams(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	team, err := findTeam(teams, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+	if err := s.replaceMembers(ctx, organizationID, teams, team, nil); err != nil {
+		return err
+	}
+	_, err = s.teamClient.DeleteTeam(ctx, &teamproto.DeleteTeamRequest{
+		Id:             team.Id,
+		OrganizationId: organizationID,
+	})
+	if err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *SCIMService) applyGroupOperation(
+	ctx context.Context,
+	organizationID string,
+	teams []*teamproto.Team,
+	team *teamproto.Team,
+	op patchOperation,
+) error {
+	switch strings.ToLower(op.Op) {
+	case "add", "replace":
+		if op.Path == "" {
+			var v group
+			if err := json.Unmarshal(op.Value, &v); err != nil {
+				return newAPIError(http.StatusBadRequest, scimTypeInvalidValue, "value must be an object")
+			}
+			if err := checkDisplayName(team, v.DisplayName); err != nil {
+				return err
+			}
+			if v.Members == nil {
+				return nil
+			}
+			return s.applyMembers(ctx, organizationID, teams, team, op.Op, v.Members)
+		}
+		if strings.EqualFold(op.Path, "displayName") {
+			var displayName string
+			if err := json.Unmarshal(op.Value, &displayName); err != nil {
+				return newAPIError(http.StatusBadRequest, scimTypeInvalidValue, "displayName must be a string")
+			}
+			return checkDisplayName(team, displayName)
+		}
+		if !strings.EqualFold(op.Path, "members") {
+			return newAPIError(http.StatusBadRequest, scimTypeInvalidPath, "unsupported path: "+op.Path)
+		}
+		var members []member
+		if err := json.Unmarshal(op.Value, &members); err != nil {
+			return newAPIError(http.StatusBadRequest, scimTypeInvalidValue, "members must be an array")
+		}
+		return s.applyMembers(ctx, organizationID, teams, team, op.Op, members)
+	case "remove":
+		id, err := parseMemberPath(op.Path)
+		if err != nil {
+			return newAPIError(http.StatusBadRequest, scimTypeInvalidPath, "unsupported path: "+op.Path)
+		}
+		if id != "" {
+			return s.setMembership(ctx, organizationID, teams, team, id, false)
+		}
+		if len(op.Value) == 0 {
+			return s.replaceMembers(ctx, organizationID, teams, team, nil)
+		}
+		var members []member
+		if err := json.Unmarshal(op.Value, &members); err != nil {
+			return newAPIError(http.StatusBadRequest, scimTypeInvalidValue, "members must be an array")
+		}
+		for _, m := range members {
+			if err := s.setMembership(ctx, organizationID, teams, team, m.Value, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return newAPIError(http.StatusBadRequest, scimTypeInvalidSyntax, "unsupported operation: "+op.Op)
+}
+
+func (s *SCIMService) applyMembers(
+	ctx context.Context,
+	organizationID string,
+	teams []*teamproto.Team,
+	team *teamproto.Team,
+	op string,
+	members []member,
+) error {
+	emails := make([]string, 0, len(members))
+	for _, m := range members {
+		emails = append(emails, m.Value)
+	}
+	if strings.EqualFold(op, "replace") {
+		return s.replaceMembers(ctx, organizationID, teams, team, emails)
+	}
+	for _, email := range emails {
+		if err := s.setMembership(ctx, organizationID, teams, team, email, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replaceMembers makes the given accounts the only members of the team.
+func (s *SCIMService) replaceMembers(
+	ctx context.Context,
+	organizationID string,
+	teams []*teamproto.Team,
+	team *teamproto.Team,
+	emails []string,
+) error {
+	current, err := s.listMembers(ctx, organizationID, team.Name)
+	if err != nil {
+		return err
+	}
+	for _, a := range current {
+		if slices.Contains(emails, a.Email) {
+			continue
+		}
+		if err := s.setMembership(ctx, organizationID, teams, team, a.Email, false); err != nil {
+			return err
+		}
+	}
+	for _, email := range emails {
+		if err := s.setMembership(ctx, organizationID, teams, team, email, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setMembership adds the account to or removes it from the team through UpdateAccountV2.
+// When any team the account belongs to, before or after the change, has an environment
+// role mapping, the environment roles are recomputed from the teams it belongs to after it.
+func (s *SCIMService) setMembership(
+	ctx context.Context,
+	organizationID string,
+	teams []*teamproto.Team,
+	team *teamproto.Team,
+	email string,
+	isMember bool,
+) error {
+	account, err := s.getAccount(ctx, organizationID, email)
+	if err != nil {
+		return err
+	}
+	req := &accountproto.UpdateAccountV2Request{
+		Email:          email,
+		OrganizationId: organizationID,
+	}
+	newTeams := slices.DeleteFunc(slices.Clone(account.Teams), func(name string) bool {
+		return name == team.Name
+	})
+	hasTeam := slices.Contains(account.Teams, team.Name)
+	switch {
+	case isMember && !hasTeam:
+		req.TeamChanges = []*accountproto.TeamChange{
+			{ChangeType: accountproto.ChangeType_CREATE, Team: team.Name},
+		}
+	case !isMember && hasTeam:
+		req.TeamChanges = []*accountproto.TeamChange{
+			{ChangeType: accountproto.ChangeType_DELETE, Team: team.Name},
+		}
+	}
+	if isMember {
+		newTeams = append(newTeams, team.Name)
+	}
+	if teamdomain.HasEnvironmentRoles(teams, account.Teams) || teamdomain.HasEnvironmentRoles(teams, newTeams) {
+		roles := teamdomain.MergeEnvironmentRoles(teams, newTeams, account.EnvironmentRoles)
+		switch {
+		case len(roles) == 0 && len(account.EnvironmentRoles) > 0:
+			req.ClearEnvironmentRoles = true
+		case len(roles) > 0 && !teamdomain.EqualEnvironmentRoles(roles, account.EnvironmentRoles):
+			req.EnvironmentRoles = roles
+		}
+	}
+	if len(req.TeamChanges) == 0 && len(req.EnvironmentRoles) == 0 && !req.ClearEnvironmentRoles {
+		return nil
+	}
+	_, err = s.accountClient.UpdateAccountV2(ctx, req)
+	return err
+}
+
+func (s *SCIMService) writeGroup(
+	w http.ResponseWriter,
+	r *http.Request,
+	statusCode int,
+	organizationID string,
+	team *teamproto.Team,
+) error {
+	members, err := s.listMembers(r.Context(), organizationID, team.Name)
+	if err != nil {
+		return err
+	}
+	writeResponse(w, statusCode, toGroup(s.baseURL(organizationID), team, members))
+	return nil
+}
+
+func (s *SCIMService) listTeams(ctx context.Context, organizationID string) ([]*teamproto.Team, error) {
+	// No page size lists all the teams of the organization
+	resp, err := s.teamClient.ListTeams(ctx, &teamproto.ListTeamsRequest{
+		OrganizationId: organizationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Teams, nil
+}
+
+// teamIDs returns the team IDs keyed by the team names the accounts refer to.
+func (s *SCIMService) teamIDs(ctx context.Context, organizationID string) (map[string]string, error) {
+	teams, err := s.listTeams(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]string, len(teams))
+	for _, t := range teams {
+		ids[t.Name] = t.Id
+	}
+	return ids, nil
+}
+
+func (s *SCIMService) listMembers(
+	ctx context.Context,
+	organizationID, teamName string,
+) ([]*accountproto.AccountV2, error) {
+	resp, err := s.accountClient.ListAccountsV2(ctx, &accountproto.ListAccountsV2Request{
+		OrganizationId: organizationID,
+		Teams:          []string{teamName},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Accounts, nil
+}
+
+func findTeam(teams []*teamproto.Team, id string) (*teamproto.Team, error) {
+	for _, t := range teams {
+		if t.Id == id {
+			return t, nil
+		}
+	}
+	return nil, newAPIError(http.StatusNotFound, "", "group not found")
+}
+
+// checkDisplayName rejects renames because the accounts refer to the teams by name.
+func checkDisplayName(team *teamproto.Team, displayName string) error {
+	if displayName == "" || displayName == team.Name {
+		return nil
+	}
+	return newAPIError(http.StatusBadRequest, scimTypeMutability, "groups cannot be renamed")
+}
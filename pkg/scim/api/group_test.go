@@ -0,0 +1,368 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	accountclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/account/client/mock"
+	teamclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/team/client/mock"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	teamproto "github.com/bucketeer-io/bucketeer/v2/proto/team"
+)
+
+var (
+	testDevelopers = &teamproto.Team{
+		Id:   "team-dev",
+		Name: "developers",
+		EnvironmentRoles: []*accountproto.AccountV2_EnvironmentRole{
+			{EnvironmentId: "env-dev", Role: accountproto.AccountV2_Role_Environment_EDITOR},
+			{EnvironmentId: "env-prd", Role: accountproto.AccountV2_Role_Environment_VIEWER},
+		},
+	}
+	testOperators = &teamproto.Team{
+		Id:   "team-ops",
+		Name: "operators",
+		EnvironmentRoles: []*accountproto.AccountV2_EnvironmentRole{
+			{EnvironmentId: "env-prd", Role: accountproto.AccountV2_Role_Environment_EDITOR},
+		},
+	}
+	testUnmapped = &teamproto.Team{Id: "team-qa", Name: "qa"}
+)
+
+func TestCreateGroup(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc         string
+		body         string
+		setup        func(*SCIMService)
+		expectedCode int
+	}{
+		{
+			desc:         "err: missing display name",
+			body:         `{"members":[]}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			desc: "err: already exists",
+			body: `{"displayName":"developers"}`,
+			setup: func(s *SCIMService) {
+				expectListTeams(s, []*teamproto.Team{testDevelopers})
+			},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			desc: "success",
+			body: `{"displayName":"operators","members":[{"value":"bob@example.com"}]}`,
+			setup: func(s *SCIMService) {
+				expectListTeams(s, []*teamproto.Team{testDevelopers})
+				s.teamClient.(*teamclientmock.MockClient).EXPECT().CreateTeam(
+					gomock.Any(), &teamproto.CreateTeamRequest{
+						Name:           "operators",
+						OrganizationId: testOrganizationID,
+					},
+				).Return(&teamproto.CreateTeamResponse{Team: testOperators}, nil)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().GetAccountV2(
+					gomock.Any(), gomock.Any(),
+				).Return(&accountproto.GetAccountV2Response{Account: &accountproto.AccountV2{
+					Email: "bob@example.com",
+					Teams: []string{"developers"},
+				}}, nil)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().UpdateAccountV2(
+					gomock.Any(), &accountproto.UpdateAccountV2Request{
+						Email:          "bob@example.com",
+						OrganizationId: testOrganizationID,
+						TeamChanges: []*accountproto.TeamChange{
+							{ChangeType: accountproto.ChangeType_CREATE, Team: "operators"},
+						},
+						EnvironmentRoles: []*accountproto.AccountV2_EnvironmentRole{
+							{EnvironmentId: "env-dev", Role: accountproto.AccountV2_Role_Environment_EDITOR},
+							{EnvironmentId: "env-prd", Role: accountproto.AccountV2_Role_Environment_EDITOR},
+						},
+					},
+				).Return(&accountproto.UpdateAccountV2Response{}, nil)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().ListAccountsV2(
+					gomock.Any(), &accountproto.ListAccountsV2Request{
+						OrganizationId: testOrganizationID,
+						Teams:          []string{"operators"},
+					},
+				).Return(&accountproto.ListAccountsV2Response{
+					Accounts: []*accountproto.AccountV2{{Email: "bob@example.com"}},
+				}, nil)
+			},
+			expectedCode: http.StatusCreated,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			s := createSCIMService(mockController)
+			expectAuthenticated(s)
+			if p.setup != nil {
+				p.setup(s)
+			}
+			rec := serve(t, s, http.MethodPost, "/Groups", p.body)
+			assert.Equal(t, p.expectedCode, rec.Code)
+			if rec.Code == http.StatusCreated {
+				var g group
+				decodeResponse(t, rec, &g)
+				assert.Equal(t, "team-ops", g.ID)
+				assert.Len(t, g.Members, 1)
+			}
+		})
+	}
+}
+
+func TestPatchGroup(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc         string
+		id           string
+		body         string
+		setup        func(*SCIMService)
+		expectedCode int
+	}{
+		{
+			desc: "err: group not found",
+			id:   "unknown",
+			body: `{"Operations":[]}`,
+			setup: func(s *SCIMService) {
+				expectListTeams(s, []*teamproto.Team{testDevelopers})
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			desc: "err: rename",
+			id:   "team-dev",
+			body: `{"Operations":[{"op":"replace","path":"displayName","value":"devs"}]}`,
+			setup: func(s *SCIMService) {
+				expectListTeams(s, []*teamproto.Team{testDevelopers})
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			desc: "success: removing the last mapped team clears the roles",
+			id:   "team-dev",
+			body: `{"Operations":[{"op":"remove","path":"members[value eq \"bob@example.com\"]"}]}`,
+			setup: func(s *SCIMService) {
+				expectListTeams(s, []*teamproto.Team{testDevelopers, testUnmapped})
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().GetAccountV2(
+					gomock.Any(), gomock.Any(),
+				).Return(&accountproto.GetAccountV2Response{Account: &accountproto.AccountV2{
+					Email:            "bob@example.com",
+					Teams:            []string{"developers", "qa"},
+					EnvironmentRoles: testDevelopers.EnvironmentRoles,
+				}}, nil)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().UpdateAccountV2(
+					gomock.Any(), &accountproto.UpdateAccountV2Request{
+						Email:          "bob@example.com",
+						OrganizationId: testOrganizationID,
+						TeamChanges: []*accountproto.TeamChange{
+							{ChangeType: accountproto.ChangeType_DELETE, Team: "developers"},
+						},
+						ClearEnvironmentRoles: true,
+					},
+				).Return(&accountproto.UpdateAccountV2Response{}, nil)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().ListAccountsV2(
+					gomock.Any(), gomock.Any(),
+				).Return(&accountproto.ListAccountsV2Response{}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			desc: "success: joining a mapped team keeps the custom roles of the account",
+			id:   "team-dev",
+			body: `{"Operations":[{"op":"add","path":"members","value":[{"value":"bob@example.com"}]}]}`,
+			setup: func(s *SCIMService) {
+				expectListTeams(s, []*teamproto.Team{testDevelopers, testUnmapped})
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().GetAccountV2(
+					gomock.Any(), gomock.Any(),
+				).Return(&accountproto.GetAccountV2Response{Account: &accountproto.AccountV2{
+					Email: "bob@example.com",
+					EnvironmentRoles: []*accountproto.AccountV2_EnvironmentRole{
+						{
+							EnvironmentId: "env-dev",
+							Role:          accountproto.AccountV2_Role_Environment_VIEWER,
+							CustomRoleIds: []string{"custom-role-1"},
+						},
+					},
+				}}, nil)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().UpdateAccountV2(
+					gomock.Any(), &accountproto.UpdateAccountV2Request{
+						Email:          "bob@example.com",
+						OrganizationId: testOrganizationID,
+						TeamChanges: []*accountproto.TeamChange{
+							{ChangeType: accountproto.ChangeType_CREATE, Team: "developers"},
+						},
+						EnvironmentRoles: []*accountproto.AccountV2_EnvironmentRole{
+							{
+								EnvironmentId: "env-dev",
+								Role:          accountproto.AccountV2_Role_Environment_EDITOR,
+								CustomRoleIds: []string{"custom-role-1"},
+							},
+							{EnvironmentId: "env-prd", Role: accountproto.AccountV2_Role_Environment_VIEWER},
+						},
+					},
+				).Return(&accountproto.UpdateAccountV2Response{}, nil)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().ListAccountsV2(
+					gomock.Any(), gomock.Any(),
+				).Return(&accountproto.ListAccountsV2Response{}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			desc: "success: custom roles are compared with the mapped roles",
+			id:   "team-qa",
+			body: `{"Operations":[{"op":"add","path":"members","value":[{"value":"bob@example.com"}]}]}`,
+			setup: func(s *SCIMService) {
+				expectListTeams(s, []*teamproto.Team{testDevelopers, testUnmapped})
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().GetAccountV2(
+					gomock.Any(), gomock.Any(),
+				).Return(&accountproto.GetAccountV2Response{Account: &accountproto.AccountV2{
+					Email: "bob@example.com",
+					Teams: []string{"developers"},
+					EnvironmentRoles: []*accountproto.AccountV2_EnvironmentRole{
+						{
+							EnvironmentId: "env-dev",
+							Role:          accountproto.AccountV2_Role_Environment_EDITOR,
+							CustomRoleIds: []string{"custom-role-1"},
+						},
+						{EnvironmentId: "env-prd", Role: accountproto.AccountV2_Role_Environment_VIEWER},
+					},
+				}}, nil)
+				// The roles are unchanged, so only the team is added.
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().UpdateAccountV2(
+					gomock.Any(), &accountproto.UpdateAccountV2Request{
+						Email:          "bob@example.com",
+						OrganizationId: testOrganizationID,
+						TeamChanges: []*accountproto.TeamChange{
+							{ChangeType: accountproto.ChangeType_CREATE, Team: "qa"},
+						},
+					},
+				).Return(&accountproto.UpdateAccountV2Response{}, nil)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().ListAccountsV2(
+					gomock.Any(), gomock.Any(),
+				).Return(&accountproto.ListAccountsV2Response{}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			desc: "success: unmapped team keeps the roles",
+			id:   "team-qa",
+			body: `{"Operations":[{"op":"add","path":"members","value":[{"value":"bob@example.com"}]}]}`,
+			setup: func(s *SCIMService) {
+				expectListTeams(s, []*teamproto.Team{testDevelopers, testUnmapped})
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().GetAccountV2(
+					gomock.Any(), gomock.Any(),
+				).Return(&accountproto.GetAccountV2Response{Account: &accountproto.AccountV2{
+					Email: "bob@example.com",
+					EnvironmentRoles: []*accountproto.AccountV2_EnvironmentRole{
+						{EnvironmentId: "env-dev", Role: accountproto.AccountV2_Role_Environment_VIEWER},
+					},
+				}}, nil)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().UpdateAccountV2(
+					gomock.Any(), &accountproto.UpdateAccountV2Request{
+						Email:          "bob@example.com",
+						OrganizationId: testOrganizationID,
+						TeamChanges: []*accountproto.TeamChange{
+							{ChangeType: accountproto.ChangeType_CREATE, Team: "qa"},
+						},
+					},
+				).Return(&accountproto.UpdateAccountV2Response{}, nil)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().ListAccountsV2(
+					gomock.Any(), gomock.Any(),
+				).Return(&accountproto.ListAccountsV2Response{}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			s := createSCIMService(mockController)
+			expectAuthenticated(s)
+			if p.setup != nil {
+				p.setup(s)
+			}
+			rec := serve(t, s, http.MethodPatch, "/Groups/"+p.id, p.body)
+			assert.Equal(t, p.expectedCode, rec.Code)
+		})
+	}
+}
+
+func TestDeleteGroup(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	s := createSCIMService(mockController)
+	expectAuthenticated(s)
+	expectListTeams(s, []*teamproto.Team{testUnmapped})
+	s.accountClient.(*accountclientmock.MockClient).EXPECT().ListAccountsV2(
+		gomock.Any(), gomock.Any(),
+	).Return(&accountproto.ListAccountsV2Response{
+		Accounts: []*accountproto.AccountV2{{Email: "bob@example.com", Teams: []string{"qa"}}},
+	}, nil)
+	s.accountClient.(*accountclientmock.MockClient).EXPECT().GetAccountV2(
+		gomock.Any(), gomock.Any(),
+	).Return(&accountproto.GetAccountV2Response{Account: &accountproto.AccountV2{
+		Email: "bob@example.com",
+		Teams: []string{"qa"},
+	}}, nil)
+	s.accountClient.(*accountclientmock.MockClient).EXPECT().UpdateAccountV2(
+		gomock.Any(), &accountproto.UpdateAccountV2Request{
+			Email:          "bob@example.com",
+			OrganizationId: testOrganizationID,
+			TeamChanges: []*accountproto.TeamChange{
+				{ChangeType: accountproto.ChangeType_DELETE, Team: "qa"},
+			},
+		},
+	).Return(&accountproto.UpdateAccountV2Response{}, nil)
+	s.teamClient.(*teamclientmock.MockClient).EXPECT().DeleteTeam(
+		gomock.Any(), &teamproto.DeleteTeamRequest{
+			Id:             "team-qa",
+			OrganizationId: testOrganizationID,
+		},
+	).Return(&teamproto.DeleteTeamResponse{}, nil)
+	rec := serve(t, s, http.MethodDelete, "/Groups/team-qa", "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestParseMemberPath(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		path        string
+		expected    string
+		expectedErr error
+	}{
+		{"members", "", nil},
+		{`members[value eq "bob@example.com"]`, "bob@example.com", nil},
+		{`members[display eq "bob"]`, "", errInvalidPath},
+		{"emails", "", errInvalidPath},
+	}
+	for _, p := range patterns {
+		actual, err := parseMemberPath(p.path)
+		assert.Equal(t, p.expectedErr, err, p.path)
+		assert.Equal(t, p.expected, actual, p.path)
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	teamproto "github.com/bucketeer-io/bucketeer/v2/proto/team"
+)
+
+const (
+	schemaUser         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaPatchOp      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	schemaError        = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	resourceTypeUser  = "User"
+	resourceTypeGroup = "Group"
+
+	scimTypeInvalidFilter = "invalidFilter"
+	scimTypeInvalidSyntax = "invalidSyntax"
+	scimTypeInvalidValue  = "invalidValue"
+	scimTypeInvalidPath   = "invalidPath"
+	scimTypeMutability    = "mutability"
+	scimTypeUniqueness    = "uniqueness"
+)
+
+var (
+	errInvalidFilter = errors.New("scim: unsupported filter")
+	errInvalidPath   = errors.New("scim: unsupported path")
+)
+
+type user struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	UserName    string   `json:"userName"`
+	Name        *name    `json:"name,omitempty"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Emails      []email  `json:"emails,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+	Groups      []member `json:"groups,omitempty"`
+	Meta        *meta    `json:"meta,omitempty"`
+}
+
+type name struct {
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	Formatted  string `json:"formatted,omitempty"`
+}
+
+type email struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type group struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []member `json:"members,omitempty"`
+	Meta        *meta    `json:"meta,omitempty"`
+}
+
+type member struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+type meta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location,omitempty"`
+}
+
+type listResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int64    `json:"totalResults"`
+	StartIndex   int64    `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type errorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	SCIMType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// toUser converts an account into a SCIM user.
+// The account email is used as both the id and the userName.
+func toUser(baseURL string, a *accountproto.AccountV2, teamIDs map[string]string) *user {
+	active := !a.Disabled
+	u := &user{
+		Schemas:  []string{schemaUser},
+		ID:       a.Email,
+		UserName: a.Email,
+		Name: &name{
+			GivenName:  a.FirstName,
+			FamilyName: a.LastName,
+		},
+		DisplayName: strings.TrimSpace(a.FirstName + " " + a.LastName),
+		Emails:      []email{{Value: a.Email, Primary: true}},
+		Active:      &active,
+		Meta: &meta{
+			ResourceType: resourceTypeUser,
+			Location:     fmt.Sprintf("%s/Users/%s", baseURL, url.PathEscape(a.Email)),
+		},
+	}
+	for _, teamName := range a.Teams {
+		id, ok := teamIDs[teamName]
+		if !ok {
+			continue
+		}
+		u.Groups = append(u.Groups, member{
+			Value:   id,
+			Display: teamName,
+			Ref:     fmt.Sprintf("%s/Groups/%s", baseURL, id),
+		})
+	}
+	return u
+}
+
+// toGroup converts a team and its member accounts into a SCIM group.
+func toGroup(baseURL string, t *teamproto.Team, members []*accountproto.AccountV2) *group {
+	g := &group{
+		Schemas:     []string{schemaGroup},
+		ID:          t.Id,
+		DisplayName: t.Name,
+		Meta: &meta{
+			ResourceType: resourceTypeGroup,
+			Location:     fmt.Sprintf("%s/Groups/%s", baseURL, t.Id),
+		},
+	}
+	for _, a := range members {
+		g.Members = append(g.Members, member{
+			Value:   a.Email,
+			Display: a.Email,
+			Ref:     fmt.Sprintf("%s/Users/%s", baseURL, url.PathEscape(a.Email)),
+		})
+	}
+	return g
+}
+
+// parseEqFilter parses the only filter form the identity providers use for lookups,
+// `<attribute> eq "<value>"`, and returns the value.
+func parseEqFilter(filter, attribute string) (string, error) {
+	parts := strings.SplitN(strings.TrimSpace(filter), " ", 3)
+	if len(parts) != 3 ||
+		!strings.EqualFold(parts[0], attribute) ||
+		!strings.EqualFold(parts[1], "eq") {
+		return "", errInvalidFilter
+	}
+	value, err := strconv.Unquote(parts[2])
+	if err != nil {
+		return "", errInvalidFilter
+	}
+	return value, nil
+}
+
+// parseMemberPath parses a `members[value eq "<id>"]` path and returns the id.
+// An empty id is returned for a plain `members` path.
+func parseMemberPath(path string) (string, error) {
+	if strings.EqualFold(path, "members") {
+		return "", nil
+	}
+	if !strings.HasPrefix(strings.ToLower(path), "members[") || !strings.HasSuffix(path, "]") {
+		return "", errInvalidPath
+	}
+	id, err := parseEqFilter(path[len("members["):len(path)-1], "value")
+	if err != nil {
+		return "", errInvalidPath
+	}
+	return id, nil
+}
+
+// parseBool accepts both JSON booleans and the "True"/"False" strings
+// some identity providers send for the active attribute.
+func parseBool(raw json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return false, err
+	}
+	return strconv.ParseBool(s)
+}
@@ -0,0 +1,283 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+)
+
+// userChange holds the user attributes an identity provider asked to change.
+type userChange struct {
+	firstName *string
+	lastName  *string
+	active    *bool
+}
+
+func (s *SCIMService) listUsers(w http.ResponseWriter, r *http.Request, organizationID string) error {
+	ctx := r.Context()
+	teamIDs, err := s.teamIDs(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	baseURL := s.baseURL(organizationID)
+	startIndex, count := pagination(r)
+	if filter := r.URL.Query().Get("filter"); filter != "" {
+		userName, err := parseEqFilter(filter, "userName")
+		if err != nil {
+			return newAPIError(http.StatusBadRequest, scimTypeInvalidFilter, "only `userName eq` is supported")
+		}
+		account, err := s.getAccount(ctx, organizationID, userName)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				writeList(w, 0, startIndex, nil)
+				return nil
+			}
+			return err
+		}
+		writeList(w, 1, startIndex, []any{toUser(baseURL, account, teamIDs)})
+		return nil
+	}
+	resp, err := s.accountClient.ListAccountsV2(ctx, &accountproto.ListAccountsV2Request{
+		OrganizationId: organizationID,
+		PageSize:       int64(count),
+		Cursor:         strconv.Itoa(startIndex - 1),
+		OrderBy:        accountproto.ListAccountsV2Request_EMAIL,
+	})
+	if err != nil {
+		return err
+	}
+	resources := make([]any, 0, len(resp.Accounts))
+	for _, a := range resp.Accounts {
+		resources = append(resources, toUser(baseURL, a, teamIDs))
+	}
+	writeList(w, resp.TotalCount, startIndex, resources)
+	return nil
+}
+
+func (s *SCIMService) createUser(w http.ResponseWriter, r *http.Request, organizationID string) error {
+	ctx := r.Context()
+	var u user
+	if err := decodeBody(r, &u); err != nil {
+		return err
+	}
+	email := strings.TrimSpace(u.UserName)
+	if email == "" {
+		return newAPIError(http.StatusBadRequest, scimTypeInvalidValue, "userName is required")
+	}
+	req := &accountproto.CreateAccountV2Request{
+		OrganizationId:   organizationID,
+		Email:            email,
+		OrganizationRole: accountproto.AccountV2_Role_Organization_MEMBER,
+		// The environment roles are granted through the group mappings
+		AllowEmptyEnvironmentRoles: true,
+	}
+	if u.Name != nil {
+		req.FirstName = u.Name.GivenName
+		req.LastName = u.Name.FamilyName
+	}
+	if _, err := s.accountClient.CreateAccountV2(ctx, req); err != nil {
+		return err
+	}
+	if u.Active != nil && !*u.Active {
+		if _, err := s.accountClient.DisableAccountV2(ctx, &accountproto.DisableAccountV2Request{
+			Email:          email,
+			OrganizationId: organizationID,
+		}); err != nil {
+			return err
+		}
+	}
+	return s.writeUser(w, r, http.StatusCreated, organizationID, email)
+}
+
+func (s *SCIMService) getUser(w http.ResponseWriter, r *http.Request, organizationID string) error {
+	return s.writeUser(w, r, http.StatusOK, organizationID, r.PathValue("id"))
+}
+
+func (s *SCIMService) replaceUser(w http.ResponseWriter, r *http.Request, organizationID string) error {
+	var u user
+	if err := decodeBody(r, &u); err != nil {
+		return err
+	}
+	change := &userChange{active: u.Active}
+	if u.Name != nil {
+		change.firstName = &u.Name.GivenName
+		change.lastName = &u.Name.FamilyName
+	}
+	if err := s.updateUser(r.Context(), organizationID, r.PathValue("id"), change); err != nil {
+		return err
+	}
+	return s.writeUser(w, r, http.StatusOK, organizationID, r.PathValue("id"))
+}
+
+func (s *SCIMService) patchUser(w http.ResponseWriter, r *http.Request, organizationID string) error {
+	var req patchRequest
+	if err := decodeBody(r, &req); err != nil {
+		return err
+	}
+	change := &userChange{}
+	for _, op := range req.Operations {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+		default:
+			return newAPIError(http.StatusBadRequest, scimTypeMutability, "unsupported operation: "+op.Op)
+		}
+		if op.Path != "" {
+			if err := change.apply(op.Path, op.Value); err != nil {
+				return err
+			}
+			continue
+		}
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(op.Value, &values); err != nil {
+			return newAPIError(http.StatusBadRequest, scimTypeInvalidValue, "value must be an object")
+		}
+		for path, value := range values {
+			if err := change.apply(path, value); err != nil {
+				return err
+			}
+		}
+	}
+	if err := s.updateUser(r.Context(), organizationID, r.PathValue("id"), change); err != nil {
+		return err
+	}
+	return s.writeUser(w, r, http.StatusOK, organizationID, r.PathValue("id"))
+}
+
+func (s *SCIMService) deleteUser(w http.ResponseWriter, r *http.Request, organizationID string) error {
+	_, err := s.accountClient.DeleteAccountV2(r.Context(), &accountproto.DeleteAccountV2Request{
+		Email:          r.PathValue("id"),
+		OrganizationId: organizationID,
+	})
+	if err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// apply records the change of a single attribute.
+// Attributes the accounts do not have, e.g. emails or externalId, are ignored.
+func (c *userChange) apply(path string, value json.RawMessage) error {
+	invalid := newAPIError(http.StatusBadRequest, scimTypeInvalidValue, "invalid value for "+path)
+	switch strings.ToLower(path) {
+	case "active":
+		active, err := parseBool(value)
+		if err != nil {
+			return invalid
+		}
+		c.active = &active
+	case "name.givenname":
+		var v string
+		if err := json.Unmarshal(value, &v); err != nil {
+			return invalid
+		}
+		c.firstName = &v
+	case "name.familyname":
+		var v string
+		if err := json.Unmarshal(value, &v); err != nil {
+			return invalid
+		}
+		c.lastName = &v
+	case "name":
+		var v name
+		if err := json.Unmarshal(value, &v); err != nil {
+			return invalid
+		}
+		c.firstName = &v.GivenName
+		c.lastName = &v.FamilyName
+	}
+	return nil
+}
+
+// updateUser applies the change using the account commands.
+// Deactivation disables the account instead of deleting it.
+func (s *SCIMService) updateUser(ctx context.Context, organizationID, email string, c *userChange) error {
+	account, err := s.getAccount(ctx, organizationID, email)
+	if err != nil {
+		return err
+	}
+	req := &accountproto.UpdateAccountV2Request{
+		Email:          email,
+		OrganizationId: organizationID,
+	}
+	if c.firstName != nil && *c.firstName != "" && *c.firstName != account.FirstName {
+		req.FirstName = wrapperspb.String(*c.firstName)
+	}
+	if c.lastName != nil && *c.lastName != "" && *c.lastName != account.LastName {
+		req.LastName = wrapperspb.String(*c.lastName)
+	}
+	if req.FirstName != nil || req.LastName != nil {
+		if _, err := s.accountClient.UpdateAccountV2(ctx, req); err != nil {
+			return err
+		}
+	}
+	if c.active == nil || *c.active != account.Disabled {
+		return nil
+	}
+	if *c.active {
+		_, err = s.accountClient.EnableAccountV2(ctx, &accountproto.EnableAccountV2Request{
+			Email:          email,
+			OrganizationId: organizationID,
+		})
+		return err
+	}
+	_, err = s.accountClient.DisableAccountV2(ctx, &accountproto.DisableAccountV2Request{
+		Email:          email,
+		OrganizationId: organizationID,
+	})
+	return err
+}
+
+func (s *SCIMService) writeUser(
+	w http.ResponseWriter,
+	r *http.Request,
+	statusCode int,
+	organizationID, email string,
+) error {
+	account, err := s.getAccount(r.Context(), organizationID, email)
+	if err != nil {
+		return err
+	}
+	teamIDs, err := s.teamIDs(r.Context(), organizationID)
+	if err != nil {
+		return err
+	}
+	writeResponse(w, statusCode, toUser(s.baseURL(organizationID), account, teamIDs))
+	return nil
+}
+
+func (s *SCIMService) getAccount(
+	ctx context.Context,
+	organizationID, email string,
+) (*accountproto.AccountV2, error) {
+	resp, err := s.accountClient.GetAccountV2(ctx, &accountproto.GetAccountV2Request{
+		Email:          email,
+		OrganizationId: organizationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Account, nil
+}
@@ -0,0 +1,269 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	accountclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/account/client/mock"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	teamproto "github.com/bucketeer-io/bucketeer/v2/proto/team"
+)
+
+func TestListUsers(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc          string
+		path          string
+		setup         func(*SCIMService)
+		expectedCode  int
+		expectedTotal int64
+	}{
+		{
+			desc:         "err: unsupported filter",
+			path:         "/Users?filter=" + "emails%20co%20%22a%22",
+			setup:        func(s *SCIMService) { expectListTeams(s, nil) },
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			desc: "success: filter by user name not found",
+			path: "/Users?filter=" + "userName%20eq%20%22bob@example.com%22",
+			setup: func(s *SCIMService) {
+				expectListTeams(s, nil)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().GetAccountV2(
+					gomock.Any(), gomock.Any(),
+				).Return(nil, status.Error(codes.NotFound, "not found"))
+			},
+			expectedCode:  http.StatusOK,
+			expectedTotal: 0,
+		},
+		{
+			desc: "success: paging",
+			path: "/Users?startIndex=11&count=10",
+			setup: func(s *SCIMService) {
+				expectListTeams(s, nil)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().ListAccountsV2(
+					gomock.Any(), &accountproto.ListAccountsV2Request{
+						OrganizationId: testOrganizationID,
+						PageSize:       10,
+						Cursor:         "10",
+						OrderBy:        accountproto.ListAccountsV2Request_EMAIL,
+					},
+				).Return(&accountproto.ListAccountsV2Response{
+					Accounts:   []*accountproto.AccountV2{{Email: "bob@example.com"}},
+					TotalCount: 11,
+				}, nil)
+			},
+			expectedCode:  http.StatusOK,
+			expectedTotal: 11,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			s := createSCIMService(mockController)
+			expectAuthenticated(s)
+			p.setup(s)
+			rec := serve(t, s, http.MethodGet, p.path, "")
+			assert.Equal(t, p.expectedCode, rec.Code)
+			if rec.Code == http.StatusOK {
+				var resp listResponse
+				decodeResponse(t, rec, &resp)
+				assert.Equal(t, p.expectedTotal, resp.TotalResults)
+			}
+		})
+	}
+}
+
+func TestCreateUser(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	teams := []*teamproto.Team{{Id: "team-id", Name: "developers"}}
+	patterns := []struct {
+		desc         string
+		body         string
+		setup        func(*SCIMService)
+		expectedCode int
+	}{
+		{
+			desc:         "err: missing user name",
+			body:         `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"]}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			desc: "err: already exists",
+			body: `{"userName":"bob@example.com"}`,
+			setup: func(s *SCIMService) {
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().CreateAccountV2(
+					gomock.Any(), gomock.Any(),
+				).Return(nil, status.Error(codes.AlreadyExists, "member already exists"))
+			},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			desc: "success: inactive user is disabled",
+			body: `{"userName":"bob@example.com","name":{"givenName":"Bob","familyName":"Smith"},"active":false}`,
+			setup: func(s *SCIMService) {
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().CreateAccountV2(
+					gomock.Any(), &accountproto.CreateAccountV2Request{
+						OrganizationId:             testOrganizationID,
+						Email:                      "bob@example.com",
+						FirstName:                  "Bob",
+						LastName:                   "Smith",
+						OrganizationRole:           accountproto.AccountV2_Role_Organization_MEMBER,
+						AllowEmptyEnvironmentRoles: true,
+					},
+				).Return(&accountproto.CreateAccountV2Response{}, nil)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().DisableAccountV2(
+					gomock.Any(), &accountproto.DisableAccountV2Request{
+						Email:          "bob@example.com",
+						OrganizationId: testOrganizationID,
+					},
+				).Return(&accountproto.DisableAccountV2Response{}, nil)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().GetAccountV2(
+					gomock.Any(), gomock.Any(),
+				).Return(&accountproto.GetAccountV2Response{Account: &accountproto.AccountV2{
+					Email:     "bob@example.com",
+					FirstName: "Bob",
+					LastName:  "Smith",
+					Disabled:  true,
+					Teams:     []string{"developers"},
+				}}, nil)
+				expectListTeams(s, teams)
+			},
+			expectedCode: http.StatusCreated,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			s := createSCIMService(mockController)
+			expectAuthenticated(s)
+			if p.setup != nil {
+				p.setup(s)
+			}
+			rec := serve(t, s, http.MethodPost, "/Users", p.body)
+			assert.Equal(t, p.expectedCode, rec.Code)
+			if rec.Code == http.StatusCreated {
+				var u user
+				decodeResponse(t, rec, &u)
+				assert.Equal(t, "bob@example.com", u.ID)
+				assert.False(t, *u.Active)
+				assert.Equal(t, []member{{
+					Value:   "team-id",
+					Display: "developers",
+					Ref:     "/scim/v2/organizations/org-0/Groups/team-id",
+				}}, u.Groups)
+			}
+		})
+	}
+}
+
+func TestPatchUser(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	account := &accountproto.AccountV2{
+		Email:     "bob@example.com",
+		FirstName: "Bob",
+		LastName:  "Smith",
+	}
+	patterns := []struct {
+		desc         string
+		body         string
+		setup        func(*SCIMService)
+		expectedCode int
+	}{
+		{
+			desc:         "err: unsupported operation",
+			body:         `{"Operations":[{"op":"remove","path":"name.givenName"}]}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			desc: "success: deactivate",
+			body: `{"Operations":[{"op":"Replace","path":"active","value":"False"}]}`,
+			setup: func(s *SCIMService) {
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().GetAccountV2(
+					gomock.Any(), gomock.Any(),
+				).Return(&accountproto.GetAccountV2Response{Account: account}, nil).Times(2)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().DisableAccountV2(
+					gomock.Any(), &accountproto.DisableAccountV2Request{
+						Email:          "bob@example.com",
+						OrganizationId: testOrganizationID,
+					},
+				).Return(&accountproto.DisableAccountV2Response{}, nil)
+				expectListTeams(s, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			desc: "success: rename without path",
+			body: `{"Operations":[{"op":"replace","value":{"name.givenName":"Robert","active":true}}]}`,
+			setup: func(s *SCIMService) {
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().GetAccountV2(
+					gomock.Any(), gomock.Any(),
+				).Return(&accountproto.GetAccountV2Response{Account: account}, nil).Times(2)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().UpdateAccountV2(
+					gomock.Any(), &accountproto.UpdateAccountV2Request{
+						Email:          "bob@example.com",
+						OrganizationId: testOrganizationID,
+						FirstName:      wrapperspb.String("Robert"),
+					},
+				).Return(&accountproto.UpdateAccountV2Response{}, nil)
+				expectListTeams(s, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			s := createSCIMService(mockController)
+			expectAuthenticated(s)
+			if p.setup != nil {
+				p.setup(s)
+			}
+			rec := serve(t, s, http.MethodPatch, "/Users/bob@example.com", p.body)
+			assert.Equal(t, p.expectedCode, rec.Code)
+		})
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	s := createSCIMService(mockController)
+	expectAuthenticated(s)
+	s.accountClient.(*accountclientmock.MockClient).EXPECT().DeleteAccountV2(
+		gomock.Any(), &accountproto.DeleteAccountV2Request{
+			Email:          "bob@example.com",
+			OrganizationId: testOrganizationID,
+		},
+	).Return(&accountproto.DeleteAccountV2Response{}, nil)
+	rec := serve(t, s, http.MethodDelete, "/Users/bob@example.com", "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
@@ -173,6 +173,8 @@ func (c *client) ExecContext(ctx context.Context, query string, args ...interfac
 	if ok {
 		return tx.ExecContext(ctx, query, args...)
 	}
+	ctx, end := startSpan(ctx, operationExec, query)
+	defer end(&err)
 
 	sret, err := c.db.ExecContext(ctx, query, args...)
 	err = convertMySQLError(err)
@@ -187,6 +189,8 @@ func (c *client) QueryContext(ctx context.Context, query string, args ...interfa
 	if ok {
 		return tx.QueryContext(ctx, query, args...)
 	}
+	ctx, end := startSpan(ctx, operationQuery, query)
+	defer end(&err)
 
 	srows, err := c.db.QueryContext(ctx, query, args...)
 	return &rows{srows}, err
@@ -200,6 +204,8 @@ func (c *client) QueryRowContext(ctx context.Context, query string, args ...inte
 	if ok {
 		return tx.QueryRowContext(ctx, query, args...)
 	}
+	ctx, end := startSpan(ctx, operationQueryRow, query)
+	defer end(&err)
 
 	r := &row{c.db.QueryRowContext(ctx, query, args...)}
 	err = r.Err()
@@ -231,6 +237,9 @@ func (c *client) RunInTransaction(ctx context.Context, tx Transaction, f func()
 func (c *client) RunInTransactionV2(
 	ctx context.Context,
 	f func(ctx context.Context, ctxWithTx Transaction) error) error {
+	var err error
+	ctx, end := startSpan(ctx, operationRunInTransaction, "")
+	defer end(&err)
 	tx, err := c.BeginTx(ctx)
 	if err != nil {
 		return fmt.Errorf("client: begin tx: %w", err)
@@ -0,0 +1,71 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestStartSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(prev)
+
+	patterns := []struct {
+		desc         string
+		err          error
+		expectedCode codes.Code
+	}{
+		{
+			desc:         "ok",
+			err:          nil,
+			expectedCode: codes.Unset,
+		},
+		{
+			desc:         "no rows is not an error",
+			err:          ErrNoRows,
+			expectedCode: codes.Unset,
+		},
+		{
+			desc:         "error",
+			err:          errors.New("error"),
+			expectedCode: codes.Error,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			_, end := startSpan(context.Background(), operationQuery, "SELECT id FROM feature WHERE id = ?")
+			end(&p.err)
+			spans := recorder.Ended()
+			require.NotEmpty(t, spans)
+			span := spans[len(spans)-1]
+			assert.Equal(t, "mysql.Query", span.Name())
+			assert.Equal(t, p.expectedCode, span.Status().Code)
+			assert.Contains(t, span.Attributes(), attribute.String("db.system.name", "mysql"))
+			assert.Contains(t, span.Attributes(), attribute.String("db.query.text", "SELECT id FROM feature WHERE id = ?"))
+		})
+	}
+}
@@ -35,6 +35,8 @@ type transaction struct {
 func (tx *transaction) ExecContext(ctx context.Context, query string, args ...interface{}) (Result, error) {
 	var err error
 	defer record()(operationExec, &err)
+	ctx, end := startSpan(ctx, operationExec, query)
+	defer end(&err)
 	sret, err := tx.stx.ExecContext(ctx, query, args...)
 	err = convertMySQLError(err)
 	return &result{sret}, err
@@ -43,6 +45,8 @@ func (tx *transaction) ExecContext(ctx context.Context, query string, args ...in
 func (tx *transaction) QueryContext(ctx context.Context, query string, args ...interface{}) (Rows, error) {
 	var err error
 	defer record()(operationQuery, &err)
+	ctx, end := startSpan(ctx, operationQuery, query)
+	defer end(&err)
 	srows, err := tx.stx.QueryContext(ctx, query, args...)
 	return &rows{srows}, err
 }
@@ -50,6 +54,8 @@ func (tx *transaction) QueryContext(ctx context.Context, query string, args ...i
 func (tx *transaction) QueryRowContext(ctx context.Context, query string, args ...interface{}) Row {
 	var err error
 	defer record()(operationQueryRow, &err)
+	ctx, end := startSpan(ctx, operationQueryRow, query)
+	defer end(&err)
 	r := &row{tx.stx.QueryRowContext(ctx, query, args...)}
 	err = r.Err()
 	return r
@@ -0,0 +1,51 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/trace"
+)
+
+// startSpan starts an OpenTelemetry span for the operation.
+// The query is recorded without its arguments, so no user data ends up in the span.
+// A query returning no rows is not treated as an error.
+func startSpan(ctx context.Context, operation, query string) (context.Context, func(err *error)) {
+	attrs := []attribute.KeyValue{
+		semconv.DBSystemNamePostgreSQL,
+		semconv.DBOperationName(operation),
+	}
+	if query != "" {
+		attrs = append(attrs, semconv.DBQueryText(query))
+	}
+	ctx, span := trace.StartSpan(
+		ctx,
+		"postgres."+operation,
+		oteltrace.WithSpanKind(oteltrace.SpanKindClient),
+		oteltrace.WithAttributes(attrs...),
+	)
+	return ctx, func(err *error) {
+		if *err == ErrNoRows {
+			trace.EndSpan(span, nil)
+			return
+		}
+		trace.EndSpan(span, *err)
+	}
+}
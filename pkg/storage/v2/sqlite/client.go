@@ -0,0 +1,227 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sqlite provides an embedded SQLite implementation of mysql.Client.
+//
+// The client accepts the MySQL dialect used by the mysql storage packages and
+// rewrites it to SQLite on the fly, so those storages run unchanged on a single
+// database file. It backs the lite mode, where running a MySQL server is not
+// desirable.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"time"
+
+	"go.uber.org/zap"
+	_ "modernc.org/sqlite"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/metrics"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/mysql"
+)
+
+type contextKey string
+
+const transactionKey contextKey = "transaction"
+
+type options struct {
+	busyTimeout  time.Duration
+	maxOpenConns int
+	logger       *zap.Logger
+	metrics      metrics.Registerer
+}
+
+func defaultOptions() *options {
+	return &options{
+		busyTimeout:  10 * time.Second,
+		maxOpenConns: 4,
+		logger:       zap.NewNop(),
+	}
+}
+
+type Option func(*options)
+
+// WithBusyTimeout sets how long a statement waits for a lock held by another
+// connection before failing.
+func WithBusyTimeout(timeout time.Duration) Option {
+	return func(opts *options) {
+		opts.busyTimeout = timeout
+	}
+}
+
+func WithMaxOpenConns(moc int) Option {
+	return func(opts *options) {
+		opts.maxOpenConns = moc
+	}
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return func(opts *options) {
+		opts.logger = logger
+	}
+}
+
+func WithMetrics(r metrics.Registerer) Option {
+	return func(opts *options) {
+		opts.metrics = r
+	}
+}
+
+type client struct {
+	db     *sql.DB
+	opts   *options
+	logger *zap.Logger
+}
+
+// NewClient opens the SQLite database stored at path, creating it when it does
+// not exist. The database runs in WAL mode and transactions take the write
+// lock when they begin, so that several clients in the same process can share
+// the file.
+func NewClient(ctx context.Context, path string, opts ...Option) (mysql.Client, error) {
+	dopts := defaultOptions()
+	for _, opt := range opts {
+		opt(dopts)
+	}
+	if dopts.metrics != nil {
+		registerMetrics(dopts.metrics)
+	}
+	logger := dopts.logger.Named("sqlite")
+	db, err := sql.Open("sqlite", dsn(path, dopts))
+	if err != nil {
+		logger.Error("Failed to open db", zap.Error(err))
+		return nil, err
+	}
+	db.SetMaxOpenConns(dopts.maxOpenConns)
+	db.SetMaxIdleConns(dopts.maxOpenConns)
+	if err := db.PingContext(ctx); err != nil {
+		logger.Error("Failed to ping db", zap.Error(err), zap.String("path", path))
+		db.Close()
+		return nil, err
+	}
+	return &client{
+		db:     db,
+		opts:   dopts,
+		logger: logger,
+	}, nil
+}
+
+func dsn(path string, opts *options) string {
+	q := url.Values{}
+	q.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", opts.busyTimeout.Milliseconds()))
+	q.Add("_pragma", "journal_mode(WAL)")
+	q.Add("_pragma", "foreign_keys(1)")
+	// MySQL compares with the utf8mb4_bin collation, which makes LIKE case-sensitive.
+	q.Add("_pragma", "case_sensitive_like(1)")
+	q.Set("_txlock", "immediate")
+	return fmt.Sprintf("file:%s?%s", path, q.Encode())
+}
+
+func (c *client) Close() error {
+	return c.db.Close()
+}
+
+func (c *client) ExecContext(ctx context.Context, query string, args ...interface{}) (mysql.Result, error) {
+	var err error
+	defer record()(operationExec, &err)
+
+	tx, ok := ctx.Value(transactionKey).(mysql.Transaction)
+	if ok {
+		return tx.ExecContext(ctx, query, args...)
+	}
+
+	sret, err := c.db.ExecContext(ctx, rewrite(query), normalizeArgs(args)...)
+	err = convertSQLiteError(err)
+	return sret, err
+}
+
+func (c *client) QueryContext(ctx context.Context, query string, args ...interface{}) (mysql.Rows, error) {
+	var err error
+	defer record()(operationQuery, &err)
+
+	tx, ok := ctx.Value(transactionKey).(mysql.Transaction)
+	if ok {
+		return tx.QueryContext(ctx, query, args...)
+	}
+
+	srows, err := c.db.QueryContext(ctx, rewrite(query), normalizeArgs(args)...)
+	return &rows{srows}, err
+}
+
+func (c *client) QueryRowContext(ctx context.Context, query string, args ...interface{}) mysql.Row {
+	var err error
+	defer record()(operationQueryRow, &err)
+
+	tx, ok := ctx.Value(transactionKey).(mysql.Transaction)
+	if ok {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+
+	r := &row{c.db.QueryRowContext(ctx, rewrite(query), normalizeArgs(args)...)}
+	err = r.Err()
+	return r
+}
+
+// Deprecated
+func (c *client) BeginTx(ctx context.Context) (mysql.Transaction, error) {
+	var err error
+	defer record()(operationBeginTx, &err)
+	stx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &transaction{stx}, nil
+}
+
+func (c *client) RunInTransaction(ctx context.Context, tx mysql.Transaction, f func() error) error {
+	var err error
+	defer record()(operationRunInTransaction, &err)
+	defer func() {
+		if err != nil {
+			tx.Rollback() // nolint:errcheck
+		}
+	}()
+	if err = f(); err == nil {
+		err = tx.Commit()
+	}
+	return err
+}
+
+// RunInTransactionV2 runs f in a new transaction. When ctx already carries a
+// transaction, f joins it instead: SQLite has a single writer, so a nested
+// transaction on another connection would wait for the outer one forever.
+func (c *client) RunInTransactionV2(
+	ctx context.Context,
+	f func(ctx context.Context, ctxWithTx mysql.Transaction) error) error {
+	if tx, ok := ctx.Value(transactionKey).(mysql.Transaction); ok {
+		return f(ctx, tx)
+	}
+	tx, err := c.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("client: begin tx: %w", err)
+	}
+	ctx = context.WithValue(ctx, transactionKey, tx)
+	defer record()(operationRunInTransaction, &err)
+	defer func() {
+		if err != nil {
+			tx.Rollback() // nolint:errcheck
+		}
+	}()
+	if err = f(ctx, tx); err == nil {
+		err = tx.Commit()
+	}
+	return err
+}
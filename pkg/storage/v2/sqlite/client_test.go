@@ -0,0 +1,140 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/migration"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/mysql"
+)
+
+func newTestClient(t *testing.T) mysql.Client {
+	t.Helper()
+	client, err := NewClient(context.Background(), filepath.Join(t.TempDir(), "bucketeer.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestClientUpsertAndDuplicateEntry(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	client := newTestClient(t)
+	_, err := client.ExecContext(ctx, "CREATE TABLE tag (id VARCHAR(255) PRIMARY KEY, name TEXT, data TEXT)")
+	require.NoError(t, err)
+
+	upsert := "INSERT INTO tag (id, name, data) VALUES (?, ?, ?) " +
+		"ON DUPLICATE KEY UPDATE name = VALUES(name), data = VALUES(data)"
+	_, err = client.ExecContext(ctx, upsert, "t1", "first", mysql.JSONObject{Val: []string{"a"}})
+	require.NoError(t, err)
+	_, err = client.ExecContext(ctx, upsert, "t1", "second", mysql.JSONObject{Val: []string{"a", "b"}})
+	require.NoError(t, err)
+
+	var name string
+	var data []string
+	err = client.QueryRowContext(
+		ctx,
+		"SELECT name, data FROM tag WHERE id = ? AND JSON_CONTAINS(data, ?)",
+		"t1", `"b"`,
+	).Scan(&name, &mysql.JSONObject{Val: &data})
+	require.NoError(t, err)
+	assert.Equal(t, "second", name)
+	assert.Equal(t, []string{"a", "b"}, data)
+
+	_, err = client.ExecContext(ctx, "INSERT INTO tag (id, name) VALUES (?, ?)", "t1", "third")
+	assert.Equal(t, mysql.ErrDuplicateEntry, err)
+
+	err = client.QueryRowContext(ctx, "SELECT name FROM tag WHERE id = ?", "t2").Scan(&name)
+	assert.Equal(t, mysql.ErrNoRows, err)
+}
+
+func TestClientRunInTransactionV2(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	client := newTestClient(t)
+	_, err := client.ExecContext(ctx, "CREATE TABLE tag (id VARCHAR(255) PRIMARY KEY)")
+	require.NoError(t, err)
+
+	errRollback := errors.New("rollback")
+	err = client.RunInTransactionV2(ctx, func(ctx context.Context, _ mysql.Transaction) error {
+		if _, err := client.ExecContext(ctx, "INSERT INTO tag (id) VALUES (?)", "t1"); err != nil {
+			return err
+		}
+		return errRollback
+	})
+	assert.Equal(t, errRollback, err)
+
+	err = client.RunInTransactionV2(ctx, func(ctx context.Context, _ mysql.Transaction) error {
+		if _, err := client.ExecContext(ctx, "INSERT INTO tag (id) VALUES (?)", "t2"); err != nil {
+			return err
+		}
+		// A nested transaction joins the outer one instead of waiting for it.
+		return client.RunInTransactionV2(ctx, func(ctx context.Context, _ mysql.Transaction) error {
+			_, err := client.ExecContext(ctx, "INSERT INTO tag (id) VALUES (?)", "t3")
+			return err
+		})
+	})
+	require.NoError(t, err)
+
+	var count int
+	require.NoError(t, client.QueryRowContext(ctx, "SELECT COUNT(*) FROM tag").Scan(&count))
+	assert.Equal(t, 2, count)
+}
+
+func TestMigrate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	client := newTestClient(t)
+	migrations, err := fs.Sub(migration.SQLite, "sqlite")
+	require.NoError(t, err)
+	files, err := fs.Glob(migrations, "*.sql")
+	require.NoError(t, err)
+
+	require.NoError(t, Migrate(ctx, client, migrations, zap.NewNop()))
+	// Applying them again is a no-op.
+	require.NoError(t, Migrate(ctx, client, migrations, zap.NewNop()))
+
+	var count int
+	require.NoError(t, client.QueryRowContext(ctx, "SELECT COUNT(*) FROM schema_revisions").Scan(&count))
+	assert.Equal(t, len(files), count)
+	require.NoError(t, client.QueryRowContext(ctx, "SELECT COUNT(*) FROM feature").Scan(&count))
+	assert.Equal(t, 0, count)
+
+	// The environment list query selects environment_v2.*, so the columns must be in the MySQL order.
+	rows, err := client.QueryContext(ctx, "SELECT name FROM pragma_table_info('environment_v2') ORDER BY cid")
+	require.NoError(t, err)
+	defer rows.Close()
+	var columns []string
+	for rows.Next() {
+		var name string
+		require.NoError(t, rows.Scan(&name))
+		columns = append(columns, name)
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, []string{
+		"id", "name", "url_code", "description", "project_id", "organization_id", "archived", "require_comment",
+		"created_at", "updated_at", "auto_archive_enabled", "auto_archive_unused_days",
+		"auto_archive_check_code_refs", "require_change_approval", "change_approval_min_approvers",
+	}, columns)
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlite
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// functionRenames maps the MySQL functions to their SQLite equivalents, or to
+	// the mysql_* functions registered by this package when SQLite has none.
+	functionRenames = []struct {
+		re   *regexp.Regexp
+		repl string
+	}{
+		{regexp.MustCompile(`(?i)\bIF\s*\(`), "IIF("},
+		{regexp.MustCompile(`(?i)\bLEAST\s*\(`), "MIN("},
+		{regexp.MustCompile(`(?i)\bGREATEST\s*\(`), "MAX("},
+		{regexp.MustCompile(`(?i)\bJSON_ARRAYAGG\s*\(`), "JSON_GROUP_ARRAY("},
+		{regexp.MustCompile(`(?i)\bJSON_CONTAINS\s*\(`), "MYSQL_JSON_CONTAINS("},
+		{regexp.MustCompile(`(?i)\bJSON_EXTRACT\s*\(`), "MYSQL_JSON_EXTRACT("},
+		{regexp.MustCompile(`(?i)\bJSON_LENGTH\s*\(`), "MYSQL_JSON_LENGTH("},
+		{regexp.MustCompile(`(?i)\bCONCAT\s*\(`), "MYSQL_CONCAT("},
+		{regexp.MustCompile(`(?i)\bFROM_UNIXTIME\s*\(`), "MYSQL_FROM_UNIXTIME("},
+		{regexp.MustCompile(`(?i)\bUNIX_TIMESTAMP\s*\(`), "MYSQL_UNIX_TIMESTAMP("},
+		{regexp.MustCompile(`(?i)\bSHA2\s*\(`), "MYSQL_SHA2("},
+	}
+	insertIgnoreRe   = regexp.MustCompile(`(?i)\bINSERT\s+IGNORE\b`)
+	onDuplicateKeyRe = regexp.MustCompile(`(?i)\bON\s+DUPLICATE\s+KEY\s+UPDATE\b`)
+	insertedValueRe  = regexp.MustCompile("(?i)\\bVALUES\\s*\\(\\s*`?(\\w+)`?\\s*\\)")
+	dateArithmeticRe = regexp.MustCompile(
+		`(?i)\bDATE_(SUB|ADD)\s*\(\s*(NOW\(\)|[^,()]+)\s*,\s*INTERVAL\s+(\d+)\s+(\w+)\s*\)`,
+	)
+	nowRe             = regexp.MustCompile(`(?i)\bNOW\(\)`)
+	castIntegerRe     = regexp.MustCompile(`(?i)\bAS\s+(UN)?SIGNED\b`)
+	varSampRe         = regexp.MustCompile(`(?i)\bVAR_SAMP\s*\(`)
+	dateIntervalUnits = map[string]string{
+		"SECOND": "seconds",
+		"MINUTE": "minutes",
+		"HOUR":   "hours",
+		"DAY":    "days",
+		"MONTH":  "months",
+		"YEAR":   "years",
+	}
+)
+
+// rewrite translates a query written for MySQL into SQLite. It covers the
+// constructs used by the mysql storage packages rather than the whole dialect.
+func rewrite(query string) string {
+	query = insertIgnoreRe.ReplaceAllString(query, "INSERT OR IGNORE")
+	if loc := onDuplicateKeyRe.FindStringIndex(query); loc != nil {
+		// In the update list VALUES(col) refers to the value the INSERT tried to write.
+		update := insertedValueRe.ReplaceAllString(query[loc[1]:], "excluded.$1")
+		query = query[:loc[0]] + "ON CONFLICT DO UPDATE SET" + update
+	}
+	query = dateArithmeticRe.ReplaceAllStringFunc(query, rewriteDateArithmetic)
+	query = nowRe.ReplaceAllString(query, "DATETIME('now')")
+	query = castIntegerRe.ReplaceAllString(query, "AS INTEGER")
+	query = rewriteVarSamp(query)
+	for _, r := range functionRenames {
+		query = r.re.ReplaceAllString(query, r.repl)
+	}
+	return query
+}
+
+func rewriteDateArithmetic(expr string) string {
+	m := dateArithmeticRe.FindStringSubmatch(expr)
+	unit, ok := dateIntervalUnits[strings.ToUpper(m[4])]
+	if !ok {
+		return expr
+	}
+	sign := "+"
+	if strings.EqualFold(m[1], "SUB") {
+		sign = "-"
+	}
+	return fmt.Sprintf("DATETIME(%s, '%s%s %s')", m[2], sign, m[3], unit)
+}
+
+// rewriteVarSamp expands VAR_SAMP(x), which SQLite lacks, into
+// (Σx² - (Σx)²/n) / (n - 1).
+func rewriteVarSamp(query string) string {
+	for {
+		loc := varSampRe.FindStringIndex(query)
+		if loc == nil {
+			return query
+		}
+		end := closingParen(query, loc[1])
+		if end < 0 {
+			return query
+		}
+		x := "(" + strings.TrimSpace(query[loc[1]:end]) + ")"
+		expanded := fmt.Sprintf(
+			"((SUM(%[1]s * %[1]s) - SUM(%[1]s) * SUM(%[1]s) * 1.0 / COUNT(%[1]s)) / NULLIF(COUNT(%[1]s) - 1, 0))",
+			x,
+		)
+		query = query[:loc[0]] + expanded + query[end+1:]
+	}
+}
+
+// closingParen returns the index of the parenthesis closing the one opened just
+// before start, or -1 when it is unbalanced.
+func closingParen(s string, start int) int {
+	depth := 1
+	inString := false
+	for i := start; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\'':
+			inString = !inString
+		case inString:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
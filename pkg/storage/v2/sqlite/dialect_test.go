@@ -0,0 +1,77 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRewrite(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc     string
+		input    string
+		expected string
+	}{
+		{
+			desc:     "untouched",
+			input:    "SELECT id FROM feature WHERE environment_id = ? LIMIT 1",
+			expected: "SELECT id FROM feature WHERE environment_id = ? LIMIT 1",
+		},
+		{
+			desc: "on duplicate key update",
+			input: "INSERT INTO tag (id, name) VALUES (?, ?) " +
+				"ON DUPLICATE KEY UPDATE name = VALUES(name), updated_at = VALUES(`updated_at`)",
+			expected: "INSERT INTO tag (id, name) VALUES (?, ?) " +
+				"ON CONFLICT DO UPDATE SET name = excluded.name, updated_at = excluded.updated_at",
+		},
+		{
+			desc:     "insert ignore",
+			input:    "insert ignore INTO tag (id) VALUES (?)",
+			expected: "INSERT OR IGNORE INTO tag (id) VALUES (?)",
+		},
+		{
+			desc: "functions",
+			input: "SELECT IF(a, 1, 0), IFNULL(b, 0), LEAST(c, d), " +
+				"JSON_LENGTH(e), GROUP_CONCAT(f), CONCAT('\"', g)",
+			expected: "SELECT IIF(a, 1, 0), IFNULL(b, 0), MIN(c, d), " +
+				"MYSQL_JSON_LENGTH(e), GROUP_CONCAT(f), MYSQL_CONCAT('\"', g)",
+		},
+		{
+			desc:     "date arithmetic",
+			input:    "WHERE t >= DATE_SUB(now(), INTERVAL 7 DAY) AND u < DATE_ADD(t, INTERVAL 2 HOUR)",
+			expected: "WHERE t >= DATETIME(DATETIME('now'), '-7 days') AND u < DATETIME(t, '+2 hours')",
+		},
+		{
+			desc:     "cast",
+			input:    "CAST(UNIX_TIMESTAMP(timestamp) AS SIGNED)",
+			expected: "CAST(MYSQL_UNIX_TIMESTAMP(timestamp) AS INTEGER)",
+		},
+		{
+			desc:  "var_samp",
+			input: "IFNULL(VAR_SAMP(MIN(a, b)), 0)",
+			expected: "IFNULL(((SUM((MIN(a, b)) * (MIN(a, b))) - SUM((MIN(a, b))) * SUM((MIN(a, b))) * 1.0 / " +
+				"COUNT((MIN(a, b)))) / NULLIF(COUNT((MIN(a, b))) - 1, 0)), 0)",
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, p.expected, rewrite(p.input))
+		})
+	}
+}
@@ -0,0 +1,387 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlite
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"modernc.org/sqlite"
+)
+
+const datetimeFormat = "2006-01-02 15:04:05"
+
+var errInvalidJSONPath = errors.New("sqlite: invalid json path")
+
+// The MySQL functions used by the storages that SQLite lacks or implements with
+// different semantics. rewrite points the queries at these names.
+func init() {
+	sqlite.MustRegisterDeterministicScalarFunction("mysql_json_contains", -1, jsonContains)
+	sqlite.MustRegisterDeterministicScalarFunction("mysql_json_extract", 2, jsonExtract)
+	sqlite.MustRegisterDeterministicScalarFunction("mysql_json_length", 1, jsonLength)
+	sqlite.MustRegisterDeterministicScalarFunction("mysql_concat", -1, concat)
+	sqlite.MustRegisterDeterministicScalarFunction("mysql_from_unixtime", 1, fromUnixtime)
+	sqlite.MustRegisterScalarFunction("mysql_unix_timestamp", -1, unixTimestamp)
+	sqlite.MustRegisterDeterministicScalarFunction("mysql_sha2", 2, sha2)
+}
+
+// jsonContains implements JSON_CONTAINS(target, candidate[, path]).
+func jsonContains(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, fmt.Errorf("sqlite: json_contains takes 2 or 3 arguments, got %d", len(args))
+	}
+	target, ok, err := decodeJSON(args[0])
+	if err != nil || !ok {
+		return nil, err
+	}
+	candidate, ok, err := decodeJSON(args[1])
+	if err != nil || !ok {
+		return nil, err
+	}
+	if len(args) == 3 {
+		path, ok := asString(args[2])
+		if !ok {
+			return nil, nil
+		}
+		values, _, err := evalJSONPath(target, path)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) == 0 {
+			return nil, nil
+		}
+		target = values[0]
+	}
+	if contains(target, candidate) {
+		return int64(1), nil
+	}
+	return int64(0), nil
+}
+
+// contains follows MySQL: scalars compare equal, an array contains every element
+// of a candidate array or the candidate itself, and an object contains every
+// key of a candidate object with a contained value.
+func contains(target, candidate interface{}) bool {
+	switch t := target.(type) {
+	case []interface{}:
+		if c, ok := candidate.([]interface{}); ok {
+			for _, cv := range c {
+				if !contains(t, cv) {
+					return false
+				}
+			}
+			return true
+		}
+		for _, tv := range t {
+			if contains(tv, candidate) {
+				return true
+			}
+		}
+		return false
+	case map[string]interface{}:
+		c, ok := candidate.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, cv := range c {
+			tv, ok := t[k]
+			if !ok || !contains(tv, cv) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(target, candidate)
+	}
+}
+
+// jsonExtract implements JSON_EXTRACT(doc, path). Unlike the SQLite builtin it
+// accepts the [*] and .* wildcards, whose matches are returned as an array.
+func jsonExtract(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	doc, ok, err := decodeJSON(args[0])
+	if err != nil || !ok {
+		return nil, err
+	}
+	path, ok := asString(args[1])
+	if !ok {
+		return nil, nil
+	}
+	values, wildcard, err := evalJSONPath(doc, path)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(values) == 0:
+		return nil, nil
+	case wildcard:
+		return encodeJSON(values)
+	}
+	switch v := values[0].(type) {
+	case nil:
+		return nil, nil
+	case bool:
+		if v {
+			return int64(1), nil
+		}
+		return int64(0), nil
+	case float64:
+		if v == float64(int64(v)) {
+			return int64(v), nil
+		}
+		return v, nil
+	case string:
+		return v, nil
+	default:
+		return encodeJSON(v)
+	}
+}
+
+// jsonLength implements JSON_LENGTH(doc).
+func jsonLength(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	doc, ok, err := decodeJSON(args[0])
+	if err != nil || !ok {
+		return nil, err
+	}
+	switch v := doc.(type) {
+	case []interface{}:
+		return int64(len(v)), nil
+	case map[string]interface{}:
+		return int64(len(v)), nil
+	default:
+		return int64(1), nil
+	}
+}
+
+// concat implements CONCAT, which returns NULL when any argument is NULL.
+func concat(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	var b strings.Builder
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case nil:
+			return nil, nil
+		case []byte:
+			b.Write(v)
+		default:
+			fmt.Fprint(&b, v)
+		}
+	}
+	return b.String(), nil
+}
+
+// fromUnixtime implements FROM_UNIXTIME(seconds) in UTC.
+func fromUnixtime(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	var sec int64
+	switch v := args[0].(type) {
+	case nil:
+		return nil, nil
+	case int64:
+		sec = v
+	case float64:
+		sec = int64(v)
+	default:
+		s, _ := asString(v)
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, nil
+		}
+		sec = n
+	}
+	return time.Unix(sec, 0).UTC().Format(datetimeFormat), nil
+}
+
+// unixTimestamp implements UNIX_TIMESTAMP([datetime]) for UTC datetimes.
+func unixTimestamp(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	switch len(args) {
+	case 0:
+		return time.Now().Unix(), nil
+	case 1:
+	default:
+		return nil, fmt.Errorf("sqlite: unix_timestamp takes at most 1 argument, got %d", len(args))
+	}
+	if t, ok := args[0].(time.Time); ok {
+		return t.Unix(), nil
+	}
+	s, ok := asString(args[0])
+	if !ok {
+		return nil, nil
+	}
+	for _, layout := range []string{timeFormat, time.RFC3339Nano} {
+		if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return t.Unix(), nil
+		}
+	}
+	return nil, nil
+}
+
+// sha2 implements SHA2(str, hash_length), returning the lowercase hex digest.
+func sha2(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	s, ok := asString(args[0])
+	if !ok {
+		return nil, nil
+	}
+	length, ok := args[1].(int64)
+	if !ok {
+		return nil, nil
+	}
+	var h hash.Hash
+	switch length {
+	case 0, 256:
+		h = sha256.New()
+	case 224:
+		h = sha256.New224()
+	case 384:
+		h = sha512.New384()
+	case 512:
+		h = sha512.New()
+	default:
+		return nil, nil
+	}
+	h.Write([]byte(s))
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func asString(v driver.Value) (string, bool) {
+	switch s := v.(type) {
+	case string:
+		return s, true
+	case []byte:
+		return string(s), true
+	default:
+		return "", false
+	}
+}
+
+// decodeJSON reports false when the value is SQL NULL.
+func decodeJSON(v driver.Value) (interface{}, bool, error) {
+	var doc interface{}
+	switch s := v.(type) {
+	case nil:
+		return nil, false, nil
+	case int64, float64:
+		return float64Of(s), true, nil
+	default:
+		text, _ := asString(s)
+		if err := json.Unmarshal([]byte(text), &doc); err != nil {
+			return nil, false, fmt.Errorf("sqlite: invalid json text: %w", err)
+		}
+	}
+	return doc, true, nil
+}
+
+func float64Of(v driver.Value) float64 {
+	if n, ok := v.(int64); ok {
+		return float64(n)
+	}
+	return v.(float64)
+}
+
+func encodeJSON(v interface{}) (driver.Value, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// evalJSONPath evaluates a MySQL JSON path made of $, .key, [n], [*] and .*
+// legs. It also reports whether the path had a wildcard.
+func evalJSONPath(doc interface{}, path string) ([]interface{}, bool, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, false, errInvalidJSONPath
+	}
+	values := []interface{}{doc}
+	wildcard := false
+	for rest := path[1:]; rest != ""; {
+		var next []interface{}
+		switch {
+		case strings.HasPrefix(rest, "[*]"):
+			wildcard = true
+			rest = rest[3:]
+			for _, v := range values {
+				if a, ok := v.([]interface{}); ok {
+					next = append(next, a...)
+				}
+			}
+		case strings.HasPrefix(rest, "["):
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, false, errInvalidJSONPath
+			}
+			i, err := strconv.Atoi(strings.TrimSpace(rest[1:end]))
+			if err != nil {
+				return nil, false, errInvalidJSONPath
+			}
+			rest = rest[end+1:]
+			for _, v := range values {
+				if a, ok := v.([]interface{}); ok && i < len(a) {
+					next = append(next, a[i])
+				}
+			}
+		case strings.HasPrefix(rest, ".*"):
+			wildcard = true
+			rest = rest[2:]
+			for _, v := range values {
+				if m, ok := v.(map[string]interface{}); ok {
+					for _, mv := range m {
+						next = append(next, mv)
+					}
+				}
+			}
+		case strings.HasPrefix(rest, "."):
+			key, n := jsonPathKey(rest[1:])
+			if key == "" {
+				return nil, false, errInvalidJSONPath
+			}
+			rest = rest[1+n:]
+			for _, v := range values {
+				if m, ok := v.(map[string]interface{}); ok {
+					if mv, ok := m[key]; ok {
+						next = append(next, mv)
+					}
+				}
+			}
+		default:
+			return nil, false, errInvalidJSONPath
+		}
+		values = next
+	}
+	return values, wildcard, nil
+}
+
+// jsonPathKey returns a member name, quoted or not, and the bytes it spans.
+func jsonPathKey(s string) (string, int) {
+	if strings.HasPrefix(s, `"`) {
+		end := strings.IndexByte(s[1:], '"')
+		if end < 0 {
+			return "", 0
+		}
+		return s[1 : end+1], end + 2
+	}
+	end := strings.IndexAny(s, ".[")
+	if end < 0 {
+		end = len(s)
+	}
+	return s[:end], end
+}
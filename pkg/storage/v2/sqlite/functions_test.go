@@ -0,0 +1,170 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlite
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONContains(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc     string
+		args     []driver.Value
+		expected driver.Value
+	}{
+		{
+			desc:     "array contains scalar",
+			args:     []driver.Value{`["a","b"]`, `"b"`},
+			expected: int64(1),
+		},
+		{
+			desc:     "array does not contain scalar",
+			args:     []driver.Value{`["a","b"]`, `"c"`},
+			expected: int64(0),
+		},
+		{
+			desc:     "array contains array",
+			args:     []driver.Value{`[1,2,3]`, `[3,1]`},
+			expected: int64(1),
+		},
+		{
+			desc:     "object contains object",
+			args:     []driver.Value{`{"a":1,"b":{"c":[1,2]}}`, `{"b":{"c":[2]}}`},
+			expected: int64(1),
+		},
+		{
+			desc:     "with path",
+			args:     []driver.Value{[]byte(`{"ids":["x"]}`), `"x"`, "$.ids"},
+			expected: int64(1),
+		},
+		{
+			desc:     "null target",
+			args:     []driver.Value{nil, `"x"`},
+			expected: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			actual, err := jsonContains(nil, p.args)
+			require.NoError(t, err)
+			assert.Equal(t, p.expected, actual)
+		})
+	}
+}
+
+func TestJSONExtract(t *testing.T) {
+	t.Parallel()
+	doc := `{"version":3,"name":"f","rate":0.5,"rules":[{"clauses":[{"operator":11},{"operator":0}]},` +
+		`{"clauses":[{"operator":2}]}]}`
+	patterns := []struct {
+		desc     string
+		path     string
+		expected driver.Value
+		isErr    bool
+	}{
+		{
+			desc:     "integer",
+			path:     "$.version",
+			expected: int64(3),
+		},
+		{
+			desc:     "float",
+			path:     "$.rate",
+			expected: 0.5,
+		},
+		{
+			desc:     "string",
+			path:     "$.name",
+			expected: "f",
+		},
+		{
+			desc:     "index",
+			path:     "$.rules[1].clauses[0]",
+			expected: `{"operator":2}`,
+		},
+		{
+			desc:     "wildcard",
+			path:     "$.rules[*].clauses[*].operator",
+			expected: "[11,0,2]",
+		},
+		{
+			desc:     "missing",
+			path:     "$.missing",
+			expected: nil,
+		},
+		{
+			desc:  "invalid path",
+			path:  "version",
+			isErr: true,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			actual, err := jsonExtract(nil, []driver.Value{doc, p.path})
+			if p.isErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, p.expected, actual)
+		})
+	}
+}
+
+func TestConcat(t *testing.T) {
+	t.Parallel()
+	actual, err := concat(nil, []driver.Value{`"`, "id", int64(1), []byte(`"`)})
+	require.NoError(t, err)
+	assert.Equal(t, `"id1"`, actual)
+	actual, err = concat(nil, []driver.Value{"id", nil})
+	require.NoError(t, err)
+	assert.Nil(t, actual)
+}
+
+func TestSHA2(t *testing.T) {
+	t.Parallel()
+	actual, err := sha2(nil, []driver.Value{"abc", int64(256)})
+	require.NoError(t, err)
+	assert.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", actual)
+	actual, err = sha2(nil, []driver.Value{[]byte("abc"), int64(0)})
+	require.NoError(t, err)
+	assert.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", actual)
+	actual, err = sha2(nil, []driver.Value{"abc", int64(1)})
+	require.NoError(t, err)
+	assert.Nil(t, actual)
+	actual, err = sha2(nil, []driver.Value{nil, int64(256)})
+	require.NoError(t, err)
+	assert.Nil(t, actual)
+}
+
+func TestUnixTime(t *testing.T) {
+	t.Parallel()
+	actual, err := fromUnixtime(nil, []driver.Value{int64(1700000000)})
+	require.NoError(t, err)
+	assert.Equal(t, "2023-11-14 22:13:20", actual)
+	actual, err = unixTimestamp(nil, []driver.Value{"2023-11-14 22:13:20"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1700000000), actual)
+	actual, err = unixTimestamp(nil, []driver.Value{"2023-11-14 22:13:20.25"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1700000000), actual)
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/mysql"
+)
+
+const (
+	createRevisionsTableSQL = `
+		CREATE TABLE IF NOT EXISTS schema_revisions (
+			version VARCHAR(255) NOT NULL,
+			executed_at BIGINT NOT NULL,
+			PRIMARY KEY (version)
+		)`
+	selectRevisionSQL = "SELECT COUNT(*) FROM schema_revisions WHERE version = ?"
+	insertRevisionSQL = "INSERT INTO schema_revisions (version, executed_at) VALUES (?, ?)"
+)
+
+// Migrate applies the *.sql files in migrations that have not been applied yet,
+// in file name order. Each file runs in its own transaction and is recorded in
+// the schema_revisions table under its name without the extension.
+func Migrate(ctx context.Context, client mysql.Client, migrations fs.FS, logger *zap.Logger) error {
+	if _, err := client.ExecContext(ctx, createRevisionsTableSQL); err != nil {
+		return fmt.Errorf("sqlite: create schema_revisions table: %w", err)
+	}
+	files, err := fs.Glob(migrations, "*.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		version := strings.TrimSuffix(file, ".sql")
+		var count int
+		if err := client.QueryRowContext(ctx, selectRevisionSQL, version).Scan(&count); err != nil {
+			return fmt.Errorf("sqlite: check revision %s: %w", version, err)
+		}
+		if count > 0 {
+			continue
+		}
+		script, err := fs.ReadFile(migrations, file)
+		if err != nil {
+			return err
+		}
+		err = client.RunInTransactionV2(ctx, func(ctx context.Context, tx mysql.Transaction) error {
+			if _, err := tx.ExecContext(ctx, string(script)); err != nil {
+				return err
+			}
+			_, err := tx.ExecContext(ctx, insertRevisionSQL, version, time.Now().Unix())
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("sqlite: apply migration %s: %w", version, err)
+		}
+		logger.Info("Applied migration", zap.String("version", version))
+	}
+	return nil
+}
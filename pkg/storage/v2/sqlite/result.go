@@ -0,0 +1,107 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlite
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/mysql"
+)
+
+// timeFormat is the layout of the DATETIME values written by this package.
+// It sorts chronologically as text, which is how SQLite compares it.
+const timeFormat = "2006-01-02 15:04:05.999999"
+
+type row struct {
+	srow *sql.Row
+}
+
+func (r *row) Err() error {
+	err := r.srow.Err()
+	if err == sql.ErrNoRows {
+		return mysql.ErrNoRows
+	}
+	return err
+}
+
+func (r *row) Scan(dest ...interface{}) error {
+	err := r.srow.Scan(scanDest(dest)...)
+	if err == sql.ErrNoRows {
+		return mysql.ErrNoRows
+	}
+	return err
+}
+
+type rows struct {
+	*sql.Rows
+}
+
+func (r *rows) Scan(dest ...interface{}) error {
+	return r.Rows.Scan(scanDest(dest)...)
+}
+
+// normalizeArgs converts the arguments into the values the MySQL driver would
+// have sent. JSON documents are bound as text instead of blobs, because SQLite
+// only parses text as JSON, and times are bound in timeFormat.
+func normalizeArgs(args []interface{}) []interface{} {
+	normalized := make([]interface{}, len(args))
+	for i, arg := range args {
+		if valuer, ok := arg.(driver.Valuer); ok {
+			v, err := valuer.Value()
+			if err != nil {
+				// Keep the valuer so that the driver reports the error.
+				normalized[i] = arg
+				continue
+			}
+			arg = v
+		}
+		switch v := arg.(type) {
+		case []byte:
+			normalized[i] = string(v)
+		case time.Time:
+			normalized[i] = v.UTC().Format(timeFormat)
+		default:
+			normalized[i] = arg
+		}
+	}
+	return normalized
+}
+
+// scanDest wraps the sql.Scanner destinations so that they receive text
+// columns as []byte, which is what the MySQL driver returns for JSON columns.
+func scanDest(dest []interface{}) []interface{} {
+	wrapped := make([]interface{}, len(dest))
+	for i, d := range dest {
+		if s, ok := d.(sql.Scanner); ok {
+			wrapped[i] = &textScanner{s}
+			continue
+		}
+		wrapped[i] = d
+	}
+	return wrapped
+}
+
+type textScanner struct {
+	sql.Scanner
+}
+
+func (s *textScanner) Scan(src interface{}) error {
+	if text, ok := src.(string); ok {
+		return s.Scanner.Scan([]byte(text))
+	}
+	return s.Scanner.Scan(src)
+}
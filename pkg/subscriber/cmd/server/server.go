@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
@@ -35,8 +36,13 @@ import (
 	btclient "github.com/bucketeer-io/bucketeer/v2/pkg/batch/client"
 	cachev3 "github.com/bucketeer-io/bucketeer/v2/pkg/cache/v3"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/cli"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/crypto"
+	cryptostorage "github.com/bucketeer-io/bucketeer/v2/pkg/crypto/storage/v2"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/email"
 	environmentclient "github.com/bucketeer-io/bucketeer/v2/pkg/environment/client"
+	v2es "github.com/bucketeer-io/bucketeer/v2/pkg/environment/storage/v2"
+	environmentmysql "github.com/bucketeer-io/bucketeer/v2/pkg/environment/storage/v2/mysql"
+	environmentpostgres "github.com/bucketeer-io/bucketeer/v2/pkg/environment/storage/v2/postgres"
 	ecdwh "github.com/bucketeer-io/bucketeer/v2/pkg/eventcounter/storage/v2/dwh_database"
 	ecbigquery "github.com/bucketeer-io/bucketeer/v2/pkg/eventcounter/storage/v2/dwh_database/bigquery"
 	ecmysql "github.com/bucketeer-io/bucketeer/v2/pkg/eventcounter/storage/v2/dwh_database/mysql"
@@ -59,6 +65,7 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/mysql"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/sqlite"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/subscriber"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/subscriber/processor"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/subscriber/storage/dwhstorage"
@@ -71,6 +78,10 @@ import (
 	subscriptionclient "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/client"
 	subscriptionsender "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/sender"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/sender/notifier"
+	v2ss "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2"
+	subscriptionmysql "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2/mysql"
+	subscriptionpostgres "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2/postgres"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/webhook"
 )
 
 const (
@@ -109,6 +120,14 @@ type server struct {
 	postgresSSLRootCert *string
 	postgresSSLCert     *string
 	postgresSSLKey      *string
+	// SQLite
+	sqlitePath *string
+	// Secret encryption
+	secretEncryptionBackend      *string
+	secretEncryptionKey          *string
+	secretEncryptionAWSRegion    *string
+	secretEncryptionVaultAddress *string
+	secretEncryptionVaultToken   *string
 	// gRPC service
 	environmentService          *string
 	experimentService           *string
@@ -150,7 +169,7 @@ func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
 		serviceTokenPath: cmd.Flag("service-token", "Path to service token.").Required().String(),
 		webURL:           cmd.Flag("web-url", "Web console URL.").Required().String(),
 		emailConfigPath:  cmd.Flag("email-config-path", "Path to email config.").Required().String(),
-		operationalDatabaseType: cmd.Flag("storage-type", "Operational database type (mysql, postgres).").
+		operationalDatabaseType: cmd.Flag("storage-type", "Operational database type (mysql, postgres, sqlite).").
 			Default("mysql").String(),
 		mysqlUser:        cmd.Flag("mysql-user", "MySQL user.").Required().String(),
 		mysqlPass:        cmd.Flag("mysql-pass", "MySQL password.").Required().String(),
@@ -179,6 +198,30 @@ func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
 			"postgres-ssl-key",
 			"Path to the PostgreSQL SSL client private key file.",
 		).String(),
+		sqlitePath: cmd.Flag(
+			"sqlite-path",
+			"Path to the SQLite database file used when storage-type=sqlite.",
+		).Default("bucketeer.db").String(),
+		secretEncryptionBackend: cmd.Flag(
+			"secret-encryption-backend",
+			"Backend wrapping the data keys of the stored secrets (none, local, gcp-kms, aws-kms, vault).",
+		).Default(string(crypto.KeyBackendNone)).String(),
+		secretEncryptionKey: cmd.Flag(
+			"secret-encryption-key",
+			"Key file path for local, key name for gcp-kms and vault, or key ID for aws-kms.",
+		).String(),
+		secretEncryptionAWSRegion: cmd.Flag(
+			"secret-encryption-aws-region",
+			"Region of the AWS KMS key.",
+		).String(),
+		secretEncryptionVaultAddress: cmd.Flag(
+			"secret-encryption-vault-address",
+			"Address of the Vault server.",
+		).String(),
+		secretEncryptionVaultToken: cmd.Flag(
+			"secret-encryption-vault-token",
+			"Token used to authenticate to Vault.",
+		).String(),
 		environmentService: cmd.Flag(
 			"environment-service",
 			"bucketeer-environment-service address.",
@@ -298,6 +341,10 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 	var adminAuditLogStorage v2als.AdminAuditLogStorage
 	var experimentStorage operationalstorage.ExperimentStorage
 	var autoOpsRuleStorage operationalstorage.AutoOpsRuleStorage
+	var webhookStorage v2ss.WebhookStorage
+	var webhookDeliveryStorage v2ss.WebhookDeliveryStorage
+	var environmentStorage v2es.EnvironmentStorage
+	var dataKeyStorage cryptostorage.DataKeyStorage
 	if *s.operationalDatabaseType == "postgres" {
 		if *s.postgresUser == "" || *s.postgresHost == "" || *s.postgresDBName == "" {
 			return fmt.Errorf("postgres-user, postgres-host, and postgres-db-name are required when storage-type=postgres")
@@ -316,6 +363,10 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		adminAuditLogStorage = auditlogpostgres.NewAdminAuditLogStorage(postgresClient)
 		experimentStorage = oppostgres.NewExperimentStorage(postgresClient)
 		autoOpsRuleStorage = oppostgres.NewAutoOpsRuleStorage(postgresClient)
+		webhookStorage = subscriptionpostgres.NewWebhookStorage(postgresClient)
+		webhookDeliveryStorage = subscriptionpostgres.NewWebhookDeliveryStorage(postgresClient)
+		environmentStorage = environmentpostgres.NewEnvironmentStorage(postgresClient)
+		dataKeyStorage = cryptostorage.NewPostgresDataKeyStorage(postgresClient)
 	} else {
 		dbClient = database.NewMySQLStorageClient(mysqlClient)
 		pushStorage = pushstorage.NewMySQLPushStorage(mysqlClient)
@@ -327,6 +378,18 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		adminAuditLogStorage = auditlogmysql.NewAdminAuditLogStorage(mysqlClient)
 		experimentStorage = opmysql.NewExperimentStorage(mysqlClient)
 		autoOpsRuleStorage = opmysql.NewAutoOpsRuleStorage(mysqlClient)
+		webhookStorage = subscriptionmysql.NewWebhookStorage(mysqlClient)
+		webhookDeliveryStorage = subscriptionmysql.NewWebhookDeliveryStorage(mysqlClient)
+		environmentStorage = environmentmysql.NewEnvironmentStorage(mysqlClient)
+		dataKeyStorage = cryptostorage.NewMySQLDataKeyStorage(mysqlClient)
+	}
+	secretCipher, err := s.createSecretCipher(ctx, dataKeyStorage, environmentStorage)
+	if err != nil {
+		logger.Error("Failed to create the secret cipher", zap.Error(err))
+		return err
+	}
+	if secretCipher != nil {
+		pushStorage = pushstorage.NewEncryptedPushStorage(pushStorage, secretCipher)
 	}
 
 	creds, err := client.NewPerRPCCredentials(*s.serviceTokenPath)
@@ -415,11 +478,37 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		return err
 	}
 
+	// Email service
+	emailConfig, err := s.readEmailConfig(logger)
+	if err != nil {
+		return err
+	}
+	emailService, err := email.NewService(*emailConfig, logger)
+	if err != nil {
+		logger.Error("Failed to create email service", zap.Error(err))
+		return err
+	}
+
 	slackNotifier := notifier.NewSlackNotifier(*s.webURL)
+	teamsNotifier := notifier.NewTeamsNotifier(
+		*s.webURL,
+		notifier.WithMetrics(registerer),
+		notifier.WithLogger(logger),
+	)
+	webhookNotifier := notifier.NewWebhookNotifier(
+		notifier.WithMetrics(registerer),
+		notifier.WithLogger(logger),
+	)
+	emailNotifier := notifier.NewEmailNotifier(
+		*s.webURL,
+		emailService,
+		notifier.WithMetrics(registerer),
+		notifier.WithLogger(logger),
+	)
 
 	notificationSender := subscriptionsender.NewSender(
 		subscriptionClient,
-		[]notifier.Notifier{slackNotifier},
+		[]notifier.Notifier{slackNotifier, teamsNotifier, webhookNotifier, emailNotifier},
 		subscriptionsender.WithMetrics(registerer),
 		subscriptionsender.WithLogger(logger),
 	)
@@ -476,6 +565,8 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		accountStorage,
 		experimentStorage,
 		autoOpsRuleStorage,
+		webhookStorage,
+		webhookDeliveryStorage,
 		persistentRedisClient,
 		nonPersistentRedisClient,
 		experimentClient,
@@ -483,6 +574,7 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		batchClient,
 		autoOpsClient,
 		notificationSender,
+		emailService,
 		cacheInvalidationPublisher,
 		registerer,
 		logger,
@@ -663,6 +755,13 @@ func (s *server) createCacheInvalidationPublisher(
 			factoryOpts = append(factoryOpts, factory.WithPartitionCount(conf.RedisPartitionCount))
 		}
 		backendCleanup = func() { _ = redisClient.Close() }
+	case factory.Kafka:
+		if conf.KafkaBrokers != "" {
+			factoryOpts = append(factoryOpts, factory.WithKafkaBrokers(strings.Split(conf.KafkaBrokers, ",")))
+		}
+		if conf.KafkaPartitionCount > 0 {
+			factoryOpts = append(factoryOpts, factory.WithPartitionCount(conf.KafkaPartitionCount))
+		}
 	}
 	client, err := factory.NewClient(ctx, factoryOpts...)
 	if err != nil {
@@ -701,6 +800,30 @@ func (s *server) createCacheInvalidationPublisher(
 	return pub, cleanup, nil
 }
 
+// createSecretCipher returns nil when the secrets are stored in plaintext.
+func (s *server) createSecretCipher(
+	ctx context.Context,
+	dataKeyStorage cryptostorage.DataKeyStorage,
+	environmentStorage v2es.EnvironmentStorage,
+) (crypto.SecretCipher, error) {
+	return crypto.NewSecretCipher(
+		ctx,
+		crypto.KeyBackend(*s.secretEncryptionBackend),
+		*s.secretEncryptionKey,
+		dataKeyStorage,
+		func(ctx context.Context, environmentID string) (string, error) {
+			env, err := environmentStorage.GetEnvironmentV2(ctx, environmentID)
+			if err != nil {
+				return "", err
+			}
+			return env.OrganizationId, nil
+		},
+		crypto.WithAWSRegion(*s.secretEncryptionAWSRegion),
+		crypto.WithVaultAddress(*s.secretEncryptionVaultAddress),
+		crypto.WithVaultToken(*s.secretEncryptionVaultToken),
+	)
+}
+
 func (s *server) createMySQLClient(
 	ctx context.Context,
 	registerer metrics.Registerer,
@@ -708,6 +831,14 @@ func (s *server) createMySQLClient(
 ) (mysql.Client, error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
+	if *s.operationalDatabaseType == "sqlite" {
+		return sqlite.NewClient(
+			ctx,
+			*s.sqlitePath,
+			sqlite.WithLogger(logger),
+			sqlite.WithMetrics(registerer),
+		)
+	}
 	return mysql.NewClient(
 		ctx,
 		*s.mysqlUser, *s.mysqlPass, *s.mysqlHost,
@@ -997,6 +1128,8 @@ func (s *server) registerPubSubProcessorMap(
 	accountStorage accstorage.AccountStorage,
 	experimentStorage operationalstorage.ExperimentStorage,
 	autoOpsRuleStorage operationalstorage.AutoOpsRuleStorage,
+	webhookStorage v2ss.WebhookStorage,
+	webhookDeliveryStorage v2ss.WebhookDeliveryStorage,
 	persistentRedisClient redisv3.Client,
 	nonPersistentRedisClient redisv3.Client,
 	exClient experimentclient.Client,
@@ -1004,6 +1137,7 @@ func (s *server) registerPubSubProcessorMap(
 	batchClient btclient.Client,
 	opsClient autoopsclient.Client,
 	sender subscriptionsender.Sender,
+	emailService email.Service,
 	cacheInvalidationPublisher publisher.Publisher,
 	registerer metrics.Registerer,
 	logger *zap.Logger,
@@ -1039,6 +1173,19 @@ func (s *server) registerPubSubProcessorMap(
 			processor.NewDomainEventInformer(environmentClient, sender, logger),
 		)
 
+		processors.RegisterProcessor(
+			processor.WebhookDelivererName,
+			processor.NewWebhookDeliverer(
+				webhookStorage,
+				webhookDeliveryStorage,
+				webhook.NewDeliverer(
+					webhook.WithMetrics(registerer),
+					webhook.WithLogger(logger),
+				),
+				logger,
+			),
+		)
+
 		nonPersistentRedisCache := cachev3.NewRedisCache(nonPersistentRedisClient)
 		processors.RegisterProcessor(
 			processor.CacheRefresherName,
@@ -1086,17 +1233,6 @@ func (s *server) registerPubSubProcessorMap(
 			)
 		}
 
-		// Email service
-		emailConfig, err := s.readEmailConfig(logger)
-		if err != nil {
-			return nil, nil, err
-		}
-		emailService, err := email.NewService(*emailConfig, logger)
-		if err != nil {
-			logger.Error("Failed to create email service", zap.Error(err))
-			return nil, nil, err
-		}
-
 		// Email sender processor
 		emailSender := processor.NewEmailSender(
 			processorsConfigMap[processor.EmailSenderName],
@@ -248,6 +248,8 @@ func (s *onDemandSubscriber) createPubSubClient(ctx context.Context) error {
 		if s.configuration.RedisPartitionCount > 0 {
 			factoryOpts = append(factoryOpts, factory.WithPartitionCount(s.configuration.RedisPartitionCount))
 		}
+	case factory.Kafka:
+		factoryOpts = append(factoryOpts, kafkaFactoryOptions(s.configuration.Configuration)...)
 	}
 
 	// Create the PubSub client using the factory with context.Background()
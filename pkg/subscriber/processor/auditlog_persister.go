@@ -29,6 +29,7 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/puller/codes"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/storage"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/subscriber"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/trace"
 	domainevent "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
 )
 
@@ -159,8 +160,11 @@ func (a auditLogPersister) createAuditLogs(
 	createFunc func(ctx context.Context, auditLog *domain.AuditLog) error,
 ) {
 	for i, aud := range auditlogs {
-		if err := createFunc(ctx, aud); err != nil {
+		// Continue the trace of the request that published the event
+		spanCtx, span := trace.StartConsumerSpan(ctx, subscriberSpanName(subscriberAuditLog), messages[i].Attributes)
+		if err := createFunc(spanCtx, aud); err != nil {
 			if errors.Is(err, v2als.ErrAuditLogAlreadyExists) || errors.Is(err, v2als.ErrAdminAuditLogAlreadyExists) {
+				span.End()
 				subscriberHandledCounter.WithLabelValues(subscriberAuditLog, codes.NonRepeatableError.String()).Inc()
 				messages[i].Ack()
 			} else {
@@ -180,11 +184,13 @@ func (a auditLogPersister) createAuditLogs(
 					zap.String("entity_data", aud.EntityData),
 					zap.String("previous_entity_data", aud.PreviousEntityData),
 				)
+				trace.EndSpan(span, err)
 				subscriberHandledCounter.WithLabelValues(subscriberAuditLog, codes.RepeatableError.String()).Inc()
 				messages[i].Nack()
 			}
 			continue
 		}
+		span.End()
 		subscriberHandledCounter.WithLabelValues(subscriberAuditLog, codes.OK.String()).Inc()
 		messages[i].Ack()
 	}
@@ -39,6 +39,7 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/puller"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/puller/codes"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/subscriber"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/trace"
 	autoopsproto "github.com/bucketeer-io/bucketeer/v2/proto/autoops"
 	domaineventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
 	experimentproto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
@@ -237,8 +238,14 @@ func workerIndex(envID string, n int) int {
 }
 
 func (c *cacheRefresher) handleMessage(ctx context.Context, msg *puller.Message) {
+	// Continue the trace of the request that published the event
+	ctx, span := trace.StartConsumerSpan(ctx, subscriberSpanName(subscriberCacheRefresher), msg.Attributes)
+	var err error
+	defer func() {
+		trace.EndSpan(span, err)
+	}()
 	event := &domaineventproto.Event{}
-	if err := proto.Unmarshal(msg.Data, event); err != nil {
+	if err = proto.Unmarshal(msg.Data, event); err != nil {
 		c.logger.Error("Failed to unmarshal domain event",
 			zap.Error(err),
 			zap.String("msgID", msg.ID),
@@ -247,7 +254,7 @@ func (c *cacheRefresher) handleMessage(ctx context.Context, msg *puller.Message)
 		msg.Ack()
 		return
 	}
-	if err := c.refresh(ctx, event); err != nil {
+	if err = c.refresh(ctx, event); err != nil {
 		if errors.Is(err, errCacheRefresherBadMessage) {
 			subscriberHandledCounter.WithLabelValues(subscriberCacheRefresher, codes.BadMessage.String()).Inc()
 			msg.Ack()
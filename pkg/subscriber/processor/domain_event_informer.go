@@ -139,7 +139,7 @@ func (d domainEventInformer) createNotificationEvent(
 	if err != nil {
 		return nil, err
 	}
-	st, err := d.convSourceType(event.EntityType)
+	st, err := convSourceType(event.EntityType)
 	if err != nil {
 		d.logger.Error("Failed to convert source type", zap.Error(err))
 		return nil, err
@@ -193,7 +193,7 @@ func (d domainEventInformer) unmarshalMessage(msg *puller.Message) (*domainevent
 	return event, nil
 }
 
-func (d domainEventInformer) convSourceType(
+func convSourceType(
 	entityType domaineventproto.Event_EntityType,
 ) (subscriptionproto.Subscription_SourceType, error) {
 	switch entityType {
@@ -237,6 +237,14 @@ func (d domainEventInformer) convSourceType(
 		return subscriptionproto.Subscription_DOMAIN_EVENT_TEAM, nil
 	case domaineventproto.Event_SCHEDULED_FLAG_CHANGE:
 		return subscriptionproto.Subscription_DOMAIN_EVENT_SCHEDULED_FLAG_CHANGE, nil
+	case domaineventproto.Event_CHANGE_REQUEST:
+		return subscriptionproto.Subscription_DOMAIN_EVENT_CHANGE_REQUEST, nil
+	case domaineventproto.Event_WEBHOOK:
+		return subscriptionproto.Subscription_DOMAIN_EVENT_WEBHOOK, nil
+	case domaineventproto.Event_SCIM_TOKEN:
+		return subscriptionproto.Subscription_DOMAIN_EVENT_SCIM_TOKEN, nil
+	case domaineventproto.Event_CUSTOM_ROLE:
+		return subscriptionproto.Subscription_DOMAIN_EVENT_CUSTOM_ROLE, nil
 	}
 	return subscriptionproto.Subscription_SourceType(0), ErrUnknownSourceType
 }
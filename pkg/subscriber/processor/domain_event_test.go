@@ -129,12 +129,9 @@ func TestCreateNotificationEvent(t *testing.T) {
 
 func TestConvSourceType(t *testing.T) {
 	t.Parallel()
-	mockController := gomock.NewController(t)
-	defer mockController.Finish()
 	for k, v := range domaineventproto.Event_EntityType_name {
 		t.Run(v, func(t *testing.T) {
-			i := newDomainEventInformer(t, mockController)
-			_, err := i.convSourceType(domaineventproto.Event_EntityType(k))
+			_, err := convSourceType(domaineventproto.Event_EntityType(k))
 			assert.NoError(t, err)
 		})
 	}
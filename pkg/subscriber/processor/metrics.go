@@ -34,6 +34,7 @@ const (
 	subscriberSegmentUser           = "SegmentUser"
 	subscriberDemoOrganizationEvent = "DemoOrganizationEvent"
 	subscriberCacheRefresher        = "CacheRefresher"
+	subscriberWebhookDeliverer      = "WebhookDeliverer"
 )
 
 const (
@@ -34,6 +34,7 @@ import (
 	pushdomain "github.com/bucketeer-io/bucketeer/v2/pkg/push/domain"
 	pushstorage "github.com/bucketeer-io/bucketeer/v2/pkg/push/storage/v2"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/subscriber"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/trace"
 	btproto "github.com/bucketeer-io/bucketeer/v2/proto/batch"
 	domaineventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
 	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
@@ -106,6 +107,16 @@ func (p pushSender) Process(ctx context.Context, msgChan <-chan *puller.Message)
 }
 
 func (p pushSender) handle(msg *puller.Message) {
+	// Continue the trace of the request that published the event
+	ctx, span := trace.StartConsumerSpan(
+		context.Background(),
+		subscriberSpanName(subscriberPushSender),
+		msg.Attributes,
+	)
+	var err error
+	defer func() {
+		trace.EndSpan(span, err)
+	}()
 	event, err := p.unmarshalMessage(msg)
 	if err != nil {
 		msg.Ack()
@@ -124,7 +135,7 @@ func (p pushSender) handle(msg *puller.Message) {
 		p.logger.Warn("Message contains an empty FeatureID", zap.Any("event", event))
 		return
 	}
-	if err := p.send(featureID, event.EnvironmentId); err != nil {
+	if err = p.send(ctx, featureID, event.EnvironmentId); err != nil {
 		msg.Ack()
 		subscriberHandledCounter.WithLabelValues(subscriberPushSender, codes.NonRepeatableError.String()).Inc()
 		return
@@ -133,8 +144,8 @@ func (p pushSender) handle(msg *puller.Message) {
 	subscriberHandledCounter.WithLabelValues(subscriberPushSender, codes.OK.String()).Inc()
 }
 
-func (p pushSender) send(featureID, environmentId string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (p pushSender) send(ctx context.Context, featureID, environmentId string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 	resp, err := p.featureClient.GetFeature(ctx, &featureproto.GetFeatureRequest{
 		Id:            featureID,
@@ -55,7 +55,7 @@ type segmentUserPersisterConfig struct {
 	FlushSize          int    `json:"flushSize"`
 	FlushInterval      int    `json:"flushInterval"`
 	// PubSub configuration
-	PubSubType          string `json:"pubSubType"`          // google or redis-stream
+	PubSubType          string `json:"pubSubType"`          // google, redis-stream or memory
 	RedisServerName     string `json:"redisServerName"`     // Redis server name
 	RedisAddr           string `json:"redisAddr"`           // Redis address
 	RedisPoolSize       int    `json:"redisPoolSize"`       // Redis pool size
@@ -112,6 +112,8 @@ func NewSegmentUserPersister(
 		pubSubType = factory.RedisStream
 	case "google":
 		pubSubType = factory.Google
+	case "memory":
+		pubSubType = factory.Memory
 	default:
 		// Default to Google for backward compatibility
 		pubSubType = factory.Google
@@ -0,0 +1,348 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/puller"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/puller/codes"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscriber"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
+	v2ss "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/webhook"
+	domaineventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+)
+
+const (
+	// webhookQueueSize bounds the deliveries waiting for one webhook.
+	// When the queue is full, the delivery is recorded as failed so it can be replayed.
+	webhookQueueSize = 100
+	// webhookDeliveryTimeout bounds the delivery of one event to one webhook,
+	// including the retries with backoff.
+	webhookDeliveryTimeout = 10 * time.Minute
+	// webhookRecordTimeout bounds the recording of a delivery result.
+	webhookRecordTimeout = 10 * time.Second
+)
+
+var errWebhookQueueFull = errors.New("webhook: too many pending deliveries")
+
+type webhookDeliverer struct {
+	webhookStorage         v2ss.WebhookStorage
+	webhookDeliveryStorage v2ss.WebhookDeliveryStorage
+	deliverer              webhook.Deliverer
+	queueSize              int
+	mu                     sync.Mutex
+	queues                 map[string]*webhookQueue
+	workers                sync.WaitGroup
+	logger                 *zap.Logger
+}
+
+// webhookQueue holds the deliveries waiting for one webhook.
+type webhookQueue struct {
+	environmentID string
+	jobs          chan *webhookDeliveryJob
+}
+
+type webhookDeliveryJob struct {
+	webhook  *domain.Webhook
+	delivery *domain.WebhookDelivery
+}
+
+// NewWebhookDeliverer creates a processor that posts the environment domain events
+// to the webhooks subscribing to their entity type and records every delivery.
+// Each webhook has its own queue and worker, so a slow endpoint only delays its own deliveries
+// and never the pull loop.
+func NewWebhookDeliverer(
+	webhookStorage v2ss.WebhookStorage,
+	webhookDeliveryStorage v2ss.WebhookDeliveryStorage,
+	deliverer webhook.Deliverer,
+	logger *zap.Logger,
+) subscriber.PubSubProcessor {
+	return &webhookDeliverer{
+		webhookStorage:         webhookStorage,
+		webhookDeliveryStorage: webhookDeliveryStorage,
+		deliverer:              deliverer,
+		queueSize:              webhookQueueSize,
+		queues:                 make(map[string]*webhookQueue),
+		logger:                 logger,
+	}
+}
+
+func (w *webhookDeliverer) Process(ctx context.Context, msgChan <-chan *puller.Message) error {
+	defer w.stopWorkers()
+	for {
+		select {
+		case msg, ok := <-msgChan:
+			if !ok {
+				w.logger.Error("webhookDeliverer: message channel closed")
+				return nil
+			}
+			subscriberReceivedCounter.WithLabelValues(subscriberWebhookDeliverer).Inc()
+			w.handleMessage(ctx, msg)
+		case <-ctx.Done():
+			w.logger.Debug("subscriber context done, stopped processing messages")
+			return nil
+		}
+	}
+}
+
+func (w *webhookDeliverer) handleMessage(ctx context.Context, msg *puller.Message) {
+	if id := msg.Attributes["id"]; id == "" {
+		msg.Ack()
+		subscriberHandledCounter.WithLabelValues(subscriberWebhookDeliverer, codes.MissingID.String()).Inc()
+		return
+	}
+	event := &domaineventproto.Event{}
+	if err := proto.Unmarshal(msg.Data, event); err != nil {
+		w.logger.Error("Failed to unmarshal message", zap.Error(err), zap.String("msgID", msg.ID))
+		subscriberHandledCounter.WithLabelValues(subscriberWebhookDeliverer, codes.BadMessage.String()).Inc()
+		msg.Ack()
+		return
+	}
+	// Webhooks belong to an environment, so the admin events are never delivered.
+	if event.IsAdminEvent {
+		subscriberHandledCounter.WithLabelValues(subscriberWebhookDeliverer, codes.OK.String()).Inc()
+		msg.Ack()
+		return
+	}
+	sourceType, err := convSourceType(event.EntityType)
+	if err != nil {
+		w.logger.Error("Failed to convert source type",
+			zap.Error(err),
+			zap.String("entityType", event.EntityType.String()),
+		)
+		subscriberHandledCounter.WithLabelValues(subscriberWebhookDeliverer, codes.BadMessage.String()).Inc()
+		msg.Ack()
+		return
+	}
+	webhooks, err := w.listWebhooks(ctx, event.EnvironmentId)
+	if err != nil {
+		w.logger.Error("Failed to list webhooks",
+			zap.Error(err),
+			zap.String("environmentId", event.EnvironmentId),
+		)
+		subscriberHandledCounter.WithLabelValues(subscriberWebhookDeliverer, codes.RepeatableError.String()).Inc()
+		msg.Nack()
+		return
+	}
+	w.removeQueues(event.EnvironmentId, webhooks)
+	webhooks = subscribedWebhooks(webhooks, sourceType)
+	if len(webhooks) == 0 {
+		subscriberHandledCounter.WithLabelValues(subscriberWebhookDeliverer, codes.OK.String()).Inc()
+		msg.Ack()
+		return
+	}
+	payload, err := protojson.Marshal(event)
+	if err != nil {
+		w.logger.Error("Failed to marshal event", zap.Error(err), zap.String("eventId", event.Id))
+		subscriberHandledCounter.WithLabelValues(subscriberWebhookDeliverer, codes.BadMessage.String()).Inc()
+		msg.Ack()
+		return
+	}
+	// The message is acked only once every delivery is saved as pending, so no delivery is lost
+	// when the process stops before finishing it. When a delivery could not be saved, the message
+	// is redelivered and the webhooks already queued may receive the event twice.
+	// The receivers can tell the duplicates apart by the event ID in the payload.
+	if ok := w.enqueue(ctx, event, sourceType, string(payload), webhooks); !ok {
+		subscriberHandledCounter.WithLabelValues(subscriberWebhookDeliverer, codes.RepeatableError.String()).Inc()
+		msg.Nack()
+		return
+	}
+	subscriberHandledCounter.WithLabelValues(subscriberWebhookDeliverer, codes.OK.String()).Inc()
+	msg.Ack()
+}
+
+// listWebhooks lists the enabled webhooks of the environment.
+func (w *webhookDeliverer) listWebhooks(ctx context.Context, environmentID string) ([]*domain.Webhook, error) {
+	disabled := false
+	webhooks, _, _, err := w.webhookStorage.ListWebhooks(ctx, v2ss.ListWebhooksParams{
+		EnvironmentID: environmentID,
+		Disabled:      &disabled,
+		PageSize:      database.QueryNoLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	enabled := make([]*domain.Webhook, 0, len(webhooks))
+	for _, wh := range webhooks {
+		enabled = append(enabled, &domain.Webhook{Webhook: wh})
+	}
+	return enabled, nil
+}
+
+func subscribedWebhooks(
+	webhooks []*domain.Webhook,
+	sourceType subscriptionproto.Subscription_SourceType,
+) []*domain.Webhook {
+	subscribed := make([]*domain.Webhook, 0, len(webhooks))
+	for _, wh := range webhooks {
+		if wh.Subscribes(sourceType) {
+			subscribed = append(subscribed, wh)
+		}
+	}
+	return subscribed
+}
+
+// enqueue saves the deliveries of the event as pending and queues them without waiting for them.
+// It reports false when a delivery could not be saved.
+func (w *webhookDeliverer) enqueue(
+	ctx context.Context,
+	event *domaineventproto.Event,
+	sourceType subscriptionproto.Subscription_SourceType,
+	payload string,
+	webhooks []*domain.Webhook,
+) bool {
+	ok := true
+	for _, wh := range webhooks {
+		delivery, err := domain.NewWebhookDelivery(
+			wh.Id,
+			event.EnvironmentId,
+			event.Id,
+			sourceType,
+			event.EntityId,
+			event.Type.String(),
+			payload,
+		)
+		if err != nil {
+			w.logger.Error("Failed to create webhook delivery",
+				zap.Error(err),
+				zap.String("environmentId", event.EnvironmentId),
+				zap.String("webhookId", wh.Id),
+				zap.String("eventId", event.Id),
+			)
+			ok = false
+			continue
+		}
+		if err := w.webhookDeliveryStorage.CreateWebhookDelivery(ctx, delivery); err != nil {
+			w.logger.Error("Failed to save webhook delivery",
+				zap.Error(err),
+				zap.String("environmentId", event.EnvironmentId),
+				zap.String("webhookId", wh.Id),
+				zap.String("eventId", event.Id),
+			)
+			ok = false
+			continue
+		}
+		job := &webhookDeliveryJob{webhook: wh, delivery: delivery}
+		select {
+		case w.queue(ctx, wh) <- job:
+		default:
+			delivery.SetResult(0, 0, 0, errWebhookQueueFull)
+			w.record(job)
+		}
+	}
+	return ok
+}
+
+// queue returns the queue of the webhook, starting its worker on first use.
+func (w *webhookDeliverer) queue(ctx context.Context, wh *domain.Webhook) chan<- *webhookDeliveryJob {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if q, ok := w.queues[wh.Id]; ok {
+		return q.jobs
+	}
+	q := &webhookQueue{
+		environmentID: wh.EnvironmentId,
+		jobs:          make(chan *webhookDeliveryJob, w.queueSize),
+	}
+	w.queues[wh.Id] = q
+	w.workers.Add(1)
+	go w.work(ctx, q.jobs)
+	return q.jobs
+}
+
+// removeQueues closes the queues of the environment webhooks that are no longer enabled,
+// so the workers of deleted or disabled webhooks stop once their queued deliveries are finished.
+func (w *webhookDeliverer) removeQueues(environmentID string, enabled []*domain.Webhook) {
+	ids := make(map[string]struct{}, len(enabled))
+	for _, wh := range enabled {
+		ids[wh.Id] = struct{}{}
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for id, q := range w.queues {
+		if q.environmentID != environmentID {
+			continue
+		}
+		if _, ok := ids[id]; ok {
+			continue
+		}
+		close(q.jobs)
+		delete(w.queues, id)
+	}
+}
+
+// work delivers the queued jobs in order until the queue is closed.
+// Once the context is done the remaining deliveries fail fast and are recorded for replay.
+func (w *webhookDeliverer) work(ctx context.Context, q <-chan *webhookDeliveryJob) {
+	defer w.workers.Done()
+	for job := range q {
+		w.deliver(ctx, job)
+	}
+}
+
+// stopWorkers closes the queues and waits until the queued deliveries are recorded.
+func (w *webhookDeliverer) stopWorkers() {
+	w.mu.Lock()
+	for id, q := range w.queues {
+		close(q.jobs)
+		delete(w.queues, id)
+	}
+	w.mu.Unlock()
+	w.workers.Wait()
+}
+
+func (w *webhookDeliverer) deliver(ctx context.Context, job *webhookDeliveryJob) {
+	ctx, cancel := context.WithTimeout(ctx, webhookDeliveryTimeout)
+	defer cancel()
+	wh, delivery := job.webhook, job.delivery
+	w.deliverer.Deliver(ctx, wh.Webhook, delivery, int(wh.MaxRetries)+1)
+	if delivery.Status == subscriptionproto.WebhookDelivery_FAILED {
+		w.logger.Warn("Failed to deliver webhook",
+			zap.String("environmentId", delivery.EnvironmentId),
+			zap.String("webhookId", wh.Id),
+			zap.String("eventId", delivery.EventId),
+			zap.Int32("statusCode", delivery.StatusCode),
+			zap.String("error", delivery.ErrorMessage),
+		)
+	}
+	w.record(job)
+}
+
+// record updates the pending delivery with its result. It doesn't use the processor context,
+// so the deliveries failed by the shutdown are still recorded.
+// When the update fails, the delivery stays pending and can be replayed.
+func (w *webhookDeliverer) record(job *webhookDeliveryJob) {
+	ctx, cancel := context.WithTimeout(context.Background(), webhookRecordTimeout)
+	defer cancel()
+	if err := w.webhookDeliveryStorage.UpdateWebhookDelivery(ctx, job.delivery); err != nil {
+		w.logger.Error("Failed to record webhook delivery",
+			zap.Error(err),
+			zap.String("environmentId", job.delivery.EnvironmentId),
+			zap.String("webhookId", job.webhook.Id),
+			zap.String("eventId", job.delivery.EventId),
+		)
+	}
+}
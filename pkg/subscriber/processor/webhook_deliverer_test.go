@@ -0,0 +1,328 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/puller"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
+	v2ss "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2"
+	storagemock "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2/mock"
+	webhookmock "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/webhook/mock"
+	domaineventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+)
+
+func TestWebhookDelivererHandleMessage(t *testing.T) {
+	t.Parallel()
+
+	featureEvent := &domaineventproto.Event{
+		Id:            "event-id",
+		EntityType:    domaineventproto.Event_FEATURE,
+		EntityId:      "feature-id",
+		Type:          domaineventproto.Event_FEATURE_CREATED,
+		EnvironmentId: "env-id",
+	}
+	featureWebhook := &subscriptionproto.Webhook{
+		Id:            "webhook-feature",
+		EnvironmentId: "env-id",
+		Url:           "https://example.com/feature",
+		SourceTypes:   []subscriptionproto.Subscription_SourceType{subscriptionproto.Subscription_DOMAIN_EVENT_FEATURE},
+		MaxRetries:    2,
+	}
+	goalWebhook := &subscriptionproto.Webhook{
+		Id:            "webhook-goal",
+		EnvironmentId: "env-id",
+		Url:           "https://example.com/goal",
+		SourceTypes:   []subscriptionproto.Subscription_SourceType{subscriptionproto.Subscription_DOMAIN_EVENT_GOAL},
+	}
+	disabled := false
+	listParams := v2ss.ListWebhooksParams{
+		EnvironmentID: "env-id",
+		Disabled:      &disabled,
+		PageSize:      database.QueryNoLimit,
+	}
+
+	patterns := []struct {
+		desc         string
+		event        *domaineventproto.Event
+		setup        func(*webhookDeliverer)
+		expectedAck  bool
+		expectedNack bool
+	}{
+		{
+			desc: "skip: admin event",
+			event: &domaineventproto.Event{
+				Id:           "event-id",
+				EntityType:   domaineventproto.Event_ADMIN_ACCOUNT,
+				IsAdminEvent: true,
+			},
+			expectedAck: true,
+		},
+		{
+			desc:  "nack: failed to list webhooks",
+			event: featureEvent,
+			setup: func(w *webhookDeliverer) {
+				w.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().ListWebhooks(
+					gomock.Any(), listParams,
+				).Return(nil, 0, int64(0), errors.New("error"))
+			},
+			expectedNack: true,
+		},
+		{
+			desc:  "skip: no subscribed webhooks",
+			event: featureEvent,
+			setup: func(w *webhookDeliverer) {
+				w.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().ListWebhooks(
+					gomock.Any(), listParams,
+				).Return([]*subscriptionproto.Webhook{goalWebhook}, 1, int64(1), nil)
+			},
+			expectedAck: true,
+		},
+		{
+			desc:  "success: delivers to the subscribed webhooks only",
+			event: featureEvent,
+			setup: func(w *webhookDeliverer) {
+				w.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().ListWebhooks(
+					gomock.Any(), listParams,
+				).Return([]*subscriptionproto.Webhook{featureWebhook, goalWebhook}, 2, int64(2), nil)
+				w.webhookDeliveryStorage.(*storagemock.MockWebhookDeliveryStorage).EXPECT().CreateWebhookDelivery(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(_ context.Context, d *domain.WebhookDelivery) error {
+					assert.Equal(t, "webhook-feature", d.WebhookId)
+					assert.Equal(t, "event-id", d.EventId)
+					assert.Equal(t, subscriptionproto.Subscription_DOMAIN_EVENT_FEATURE, d.SourceType)
+					assert.Equal(t, "feature-id", d.EntityId)
+					assert.Equal(t, domaineventproto.Event_FEATURE_CREATED.String(), d.EventType)
+					assert.Equal(t, subscriptionproto.WebhookDelivery_PENDING, d.Status)
+					var payload map[string]interface{}
+					require.NoError(t, json.Unmarshal([]byte(d.Payload), &payload))
+					assert.Equal(t, "event-id", payload["id"])
+					return nil
+				})
+				w.deliverer.(*webhookmock.MockDeliverer).EXPECT().Deliver(
+					gomock.Any(), featureWebhook, gomock.Any(), 3,
+				).Do(func(_ context.Context, _ *subscriptionproto.Webhook, d *domain.WebhookDelivery, _ int) {
+					d.SetResult(500, time.Millisecond, 3, errors.New("unexpected status code"))
+				})
+				w.webhookDeliveryStorage.(*storagemock.MockWebhookDeliveryStorage).EXPECT().UpdateWebhookDelivery(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(_ context.Context, d *domain.WebhookDelivery) error {
+					assert.Equal(t, "webhook-feature", d.WebhookId)
+					assert.Equal(t, subscriptionproto.WebhookDelivery_FAILED, d.Status)
+					assert.Equal(t, int32(3), d.Attempts)
+					return nil
+				})
+			},
+			expectedAck: true,
+		},
+		{
+			desc:  "nack: failed to save the pending delivery",
+			event: featureEvent,
+			setup: func(w *webhookDeliverer) {
+				w.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().ListWebhooks(
+					gomock.Any(), listParams,
+				).Return([]*subscriptionproto.Webhook{featureWebhook}, 1, int64(1), nil)
+				w.webhookDeliveryStorage.(*storagemock.MockWebhookDeliveryStorage).EXPECT().CreateWebhookDelivery(
+					gomock.Any(), gomock.Any(),
+				).Return(errors.New("error"))
+			},
+			expectedNack: true,
+		},
+		{
+			desc:  "ack: failed to record the delivery result",
+			event: featureEvent,
+			setup: func(w *webhookDeliverer) {
+				w.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().ListWebhooks(
+					gomock.Any(), listParams,
+				).Return([]*subscriptionproto.Webhook{featureWebhook}, 1, int64(1), nil)
+				w.webhookDeliveryStorage.(*storagemock.MockWebhookDeliveryStorage).EXPECT().CreateWebhookDelivery(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				w.deliverer.(*webhookmock.MockDeliverer).EXPECT().Deliver(
+					gomock.Any(), featureWebhook, gomock.Any(), 3,
+				)
+				w.webhookDeliveryStorage.(*storagemock.MockWebhookDeliveryStorage).EXPECT().UpdateWebhookDelivery(
+					gomock.Any(), gomock.Any(),
+				).Return(errors.New("error"))
+			},
+			expectedAck: true,
+		},
+		{
+			desc:  "ack: records a failed delivery when the queue is full",
+			event: featureEvent,
+			setup: func(w *webhookDeliverer) {
+				// A queue without a worker never accepts a job.
+				w.queues[featureWebhook.Id] = &webhookQueue{
+					environmentID: featureWebhook.EnvironmentId,
+					jobs:          make(chan *webhookDeliveryJob),
+				}
+				w.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().ListWebhooks(
+					gomock.Any(), listParams,
+				).Return([]*subscriptionproto.Webhook{featureWebhook}, 1, int64(1), nil)
+				w.webhookDeliveryStorage.(*storagemock.MockWebhookDeliveryStorage).EXPECT().CreateWebhookDelivery(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				w.webhookDeliveryStorage.(*storagemock.MockWebhookDeliveryStorage).EXPECT().UpdateWebhookDelivery(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(_ context.Context, d *domain.WebhookDelivery) error {
+					assert.Equal(t, subscriptionproto.WebhookDelivery_FAILED, d.Status)
+					assert.Equal(t, errWebhookQueueFull.Error(), d.ErrorMessage)
+					assert.Equal(t, int32(0), d.Attempts)
+					return nil
+				})
+			},
+			expectedAck: true,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			mockController := gomock.NewController(t)
+			defer mockController.Finish()
+			w := newWebhookDelivererWithMock(t, mockController)
+			if p.setup != nil {
+				p.setup(w)
+			}
+			data, err := proto.Marshal(p.event)
+			require.NoError(t, err)
+			var acked, nacked bool
+			w.handleMessage(context.Background(), &puller.Message{
+				ID:         "msg-id",
+				Data:       data,
+				Attributes: map[string]string{"id": p.event.Id},
+				Ack:        func() { acked = true },
+				Nack:       func() { nacked = true },
+			})
+			w.stopWorkers()
+			assert.Equal(t, p.expectedAck, acked)
+			assert.Equal(t, p.expectedNack, nacked)
+		})
+	}
+}
+
+func TestWebhookDelivererDoesNotWaitForDeliveries(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	w := newWebhookDelivererWithMock(t, mockController)
+
+	wh := &subscriptionproto.Webhook{
+		Id:            "webhook-id",
+		EnvironmentId: "env-id",
+		Url:           "https://example.com",
+		SourceTypes:   []subscriptionproto.Subscription_SourceType{subscriptionproto.Subscription_DOMAIN_EVENT_FEATURE},
+	}
+	w.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().ListWebhooks(
+		gomock.Any(), gomock.Any(),
+	).Return([]*subscriptionproto.Webhook{wh}, 1, int64(1), nil).Times(2)
+	release := make(chan struct{})
+	w.deliverer.(*webhookmock.MockDeliverer).EXPECT().Deliver(
+		gomock.Any(), wh, gomock.Any(), 1,
+	).Do(func(context.Context, *subscriptionproto.Webhook, *domain.WebhookDelivery, int) {
+		<-release
+	}).Times(2)
+	w.webhookDeliveryStorage.(*storagemock.MockWebhookDeliveryStorage).EXPECT().CreateWebhookDelivery(
+		gomock.Any(), gomock.Any(),
+	).Return(nil).Times(2)
+	w.webhookDeliveryStorage.(*storagemock.MockWebhookDeliveryStorage).EXPECT().UpdateWebhookDelivery(
+		gomock.Any(), gomock.Any(),
+	).Return(nil).Times(2)
+
+	for _, id := range []string{"event-1", "event-2"} {
+		data, err := proto.Marshal(&domaineventproto.Event{
+			Id:            id,
+			EntityType:    domaineventproto.Event_FEATURE,
+			EnvironmentId: "env-id",
+		})
+		require.NoError(t, err)
+		var acked bool
+		w.handleMessage(context.Background(), &puller.Message{
+			ID:         id,
+			Data:       data,
+			Attributes: map[string]string{"id": id},
+			Ack:        func() { acked = true },
+			Nack:       func() {},
+		})
+		// The message is acked while the endpoint is still blocking the first delivery.
+		assert.True(t, acked)
+	}
+	close(release)
+	w.stopWorkers()
+}
+
+func TestWebhookDelivererRemovesQueuesOfDisabledWebhooks(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	w := newWebhookDelivererWithMock(t, mockController)
+
+	enabled := &subscriptionproto.Webhook{
+		Id:            "webhook-enabled",
+		EnvironmentId: "env-id",
+		Url:           "https://example.com",
+		SourceTypes:   []subscriptionproto.Subscription_SourceType{subscriptionproto.Subscription_DOMAIN_EVENT_GOAL},
+	}
+	removed := make(chan *webhookDeliveryJob)
+	w.queues["webhook-enabled"] = &webhookQueue{environmentID: "env-id", jobs: make(chan *webhookDeliveryJob)}
+	w.queues["webhook-removed"] = &webhookQueue{environmentID: "env-id", jobs: removed}
+	w.queues["webhook-other"] = &webhookQueue{environmentID: "env-other", jobs: make(chan *webhookDeliveryJob)}
+	w.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().ListWebhooks(
+		gomock.Any(), gomock.Any(),
+	).Return([]*subscriptionproto.Webhook{enabled}, 1, int64(1), nil)
+
+	data, err := proto.Marshal(&domaineventproto.Event{
+		Id:            "event-id",
+		EntityType:    domaineventproto.Event_FEATURE,
+		EnvironmentId: "env-id",
+	})
+	require.NoError(t, err)
+	w.handleMessage(context.Background(), &puller.Message{
+		ID:         "msg-id",
+		Data:       data,
+		Attributes: map[string]string{"id": "event-id"},
+		Ack:        func() {},
+		Nack:       func() {},
+	})
+	assert.Contains(t, w.queues, "webhook-enabled")
+	assert.Contains(t, w.queues, "webhook-other")
+	assert.NotContains(t, w.queues, "webhook-removed")
+	_, open := <-removed
+	assert.False(t, open)
+	w.stopWorkers()
+}
+
+func newWebhookDelivererWithMock(t *testing.T, c *gomock.Controller) *webhookDeliverer {
+	t.Helper()
+	return &webhookDeliverer{
+		webhookStorage:         storagemock.NewMockWebhookStorage(c),
+		webhookDeliveryStorage: storagemock.NewMockWebhookDeliveryStorage(c),
+		deliverer:              webhookmock.NewMockDeliverer(c),
+		queueSize:              webhookQueueSize,
+		queues:                 make(map[string]*webhookQueue),
+		logger:                 zap.NewNop(),
+	}
+}
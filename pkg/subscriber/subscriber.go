@@ -17,6 +17,7 @@ package subscriber
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -38,6 +39,10 @@ const (
 	PubSubTypeGoogle = "google"
 	// PubSubTypeRedisStream is the Redis Stream implementation
 	PubSubTypeRedisStream = "redis-stream"
+	// PubSubTypeKafka is the Apache Kafka implementation
+	PubSubTypeKafka = "kafka"
+	// PubSubTypeMemory is the in-process implementation used by the lite mode
+	PubSubTypeMemory = "memory"
 
 	// DefaultPubSubType is the default PubSub implementation
 	DefaultPubSubType = PubSubTypeRedisStream
@@ -98,6 +103,10 @@ type Configuration struct {
 	RedisPartitionCount int    `json:"redisPartitionCount,omitempty"`
 	RedisIdleTime       int    `json:"redisIdleTime,omitempty"`
 	RedisMode           string `json:"redisMode,omitempty"`
+	// Kafka configuration (used when PubSubType is "kafka")
+	KafkaBrokers             string `json:"kafkaBrokers,omitempty"` // comma-separated
+	KafkaPartitionCount      int    `json:"kafkaPartitionCount,omitempty"`
+	KafkaMaxDeliveryAttempts int    `json:"kafkaMaxDeliveryAttempts,omitempty"`
 }
 
 type pubSubSubscriber struct {
@@ -213,6 +222,8 @@ func (s pubSubSubscriber) createPuller(
 		if s.configuration.RedisIdleTime > 0 {
 			factoryOpts = append(factoryOpts, factory.WithIdleTime(s.configuration.RedisIdleTime))
 		}
+	case factory.Kafka:
+		factoryOpts = append(factoryOpts, kafkaFactoryOptions(s.configuration)...)
 	}
 
 	// Create the PubSub client using the factory with context.Background()
@@ -245,6 +256,21 @@ func (s pubSubSubscriber) createPuller(
 	return rateLimitedPuller
 }
 
+// kafkaFactoryOptions returns the factory options for the Kafka backend
+func kafkaFactoryOptions(conf Configuration) []factory.Option {
+	var opts []factory.Option
+	if conf.KafkaBrokers != "" {
+		opts = append(opts, factory.WithKafkaBrokers(strings.Split(conf.KafkaBrokers, ",")))
+	}
+	if conf.KafkaPartitionCount > 0 {
+		opts = append(opts, factory.WithPartitionCount(conf.KafkaPartitionCount))
+	}
+	if conf.KafkaMaxDeliveryAttempts > 0 {
+		opts = append(opts, factory.WithMaxDeliveryAttempts(conf.KafkaMaxDeliveryAttempts))
+	}
+	return opts
+}
+
 // createRedisClient creates a Redis client from the configuration
 func createRedisClient(ctx context.Context,
 	conf Configuration,
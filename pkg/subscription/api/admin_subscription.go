@@ -401,7 +401,7 @@ func (s *SubscriptionService) GetAdminSubscription(
 		)
 		return nil, api.NewGRPCStatus(err).Err()
 	}
-	return &subscriptionproto.GetAdminSubscriptionResponse{Subscription: subscription.Subscription}, nil
+	return &subscriptionproto.GetAdminSubscriptionResponse{Subscription: subscription.WithoutSecrets()}, nil
 }
 
 func validateGetAdminSubscriptionRequest(
@@ -443,7 +443,7 @@ func (s *SubscriptionService) ListAdminSubscriptions(
 		return nil, err
 	}
 	return &subscriptionproto.ListAdminSubscriptionsResponse{
-		Subscriptions: subscriptions,
+		Subscriptions: withoutSecrets(subscriptions),
 		Cursor:        cursor,
 		TotalCount:    totalCount,
 	}, nil
@@ -471,6 +471,9 @@ func (s *SubscriptionService) ListEnabledAdminSubscriptions(
 	if err != nil {
 		return nil, err
 	}
+	if !isServiceToken(ctx) {
+		subscriptions = withoutSecrets(subscriptions)
+	}
 	return &subscriptionproto.ListEnabledAdminSubscriptionsResponse{
 		Subscriptions: subscriptions,
 		Cursor:        cursor,
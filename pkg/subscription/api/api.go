@@ -27,8 +27,10 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/role"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/rpc"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database"
 	v2 "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/webhook"
 	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
 	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
 	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
@@ -50,6 +52,9 @@ type SubscriptionService struct {
 	dbClient                 database.Client
 	adminSubscriptionStorage v2.AdminSubscriptionStorage
 	subscriptionStorage      v2.SubscriptionStorage
+	webhookStorage           v2.WebhookStorage
+	webhookDeliveryStorage   v2.WebhookDeliveryStorage
+	webhookDeliverer         webhook.Deliverer
 	accountClient            accountclient.Client
 	domainEventPublisher     publisher.Publisher
 	opts                     *options
@@ -60,6 +65,9 @@ func NewSubscriptionService(
 	dbClient database.Client,
 	adminSubscriptionStorage v2.AdminSubscriptionStorage,
 	subscriptionStorage v2.SubscriptionStorage,
+	webhookStorage v2.WebhookStorage,
+	webhookDeliveryStorage v2.WebhookDeliveryStorage,
+	webhookDeliverer webhook.Deliverer,
 	accountClient accountclient.Client,
 	domainEventPublisher publisher.Publisher,
 	opts ...Option,
@@ -74,6 +82,9 @@ func NewSubscriptionService(
 		dbClient:                 dbClient,
 		adminSubscriptionStorage: adminSubscriptionStorage,
 		subscriptionStorage:      subscriptionStorage,
+		webhookStorage:           webhookStorage,
+		webhookDeliveryStorage:   webhookDeliveryStorage,
+		webhookDeliverer:         webhookDeliverer,
 		accountClient:            accountClient,
 		domainEventPublisher:     domainEventPublisher,
 		opts:                     dopts,
@@ -173,3 +184,10 @@ func (s *SubscriptionService) publishDomainEvents(ctx context.Context, events []
 	}
 	return s.domainEventPublisher.PublishMulti(ctx, messages)
 }
+
+// isServiceToken reports whether the request comes from an internal job,
+// such as the notification sender, which needs the recipient credentials.
+func isServiceToken(ctx context.Context) bool {
+	token, ok := rpc.GetAccessToken(ctx)
+	return ok && token.IsSystemAdmin && token.IsServiceToken
+}
@@ -35,6 +35,7 @@ import (
 	dbmock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database/mock"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
 	v2mock "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2/mock"
+	webhookmock "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/webhook/mock"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/token"
 	proto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
 )
@@ -56,6 +57,9 @@ func TestNewSubscriptionService(t *testing.T) {
 		dbClient,
 		v2mock.NewMockAdminSubscriptionStorage(mockController),
 		v2mock.NewMockSubscriptionStorage(mockController),
+		v2mock.NewMockWebhookStorage(mockController),
+		v2mock.NewMockWebhookDeliveryStorage(mockController),
+		webhookmock.NewMockDeliverer(mockController),
 		accountClientMock,
 		pm,
 		WithLogger(logger),
@@ -84,6 +88,9 @@ func newSubscriptionServiceWithMock(
 		dbClient:                 dbmock.NewMockClient(c),
 		adminSubscriptionStorage: v2mock.NewMockAdminSubscriptionStorage(c),
 		subscriptionStorage:      v2mock.NewMockSubscriptionStorage(c),
+		webhookStorage:           v2mock.NewMockWebhookStorage(c),
+		webhookDeliveryStorage:   v2mock.NewMockWebhookDeliveryStorage(c),
+		webhookDeliverer:         webhookmock.NewMockDeliverer(c),
 		accountClient:            accountClientMock,
 		domainEventPublisher:     publishermock.NewMockPublisher(c),
 		logger:                   zap.NewNop(),
@@ -131,6 +138,9 @@ func newSubscriptionService(c *gomock.Controller, specifiedEnvironmentId *string
 		dbClient:                 dbClient,
 		adminSubscriptionStorage: v2mock.NewMockAdminSubscriptionStorage(c),
 		subscriptionStorage:      v2mock.NewMockSubscriptionStorage(c),
+		webhookStorage:           v2mock.NewMockWebhookStorage(c),
+		webhookDeliveryStorage:   v2mock.NewMockWebhookDeliveryStorage(c),
+		webhookDeliverer:         webhookmock.NewMockDeliverer(c),
 		accountClient:            accountClientMock,
 		domainEventPublisher:     publishermock.NewMockPublisher(c),
 		logger:                   zap.NewNop(),
@@ -49,6 +49,51 @@ var (
 		"webhook URL must be specified",
 		"WebhookURL",
 	))
+	statusMicrosoftTeamsRecipientRequired = api.NewGRPCStatus(err.NewErrorInvalidArgEmpty(
+		err.SubscriptionPackageName,
+		"microsoft teams recipient must be specified",
+		"NotificationMicrosoftTeamsRecipient",
+	))
+	statusMicrosoftTeamsRecipientWebhookURLRequired = api.NewGRPCStatus(err.NewErrorInvalidArgEmpty(
+		err.SubscriptionPackageName,
+		"webhook URL must be specified",
+		"WebhookURL",
+	))
+	statusWebhookRecipientRequired = api.NewGRPCStatus(err.NewErrorInvalidArgEmpty(
+		err.SubscriptionPackageName,
+		"webhook recipient must be specified",
+		"NotificationWebhookRecipient",
+	))
+	statusWebhookRecipientURLRequired = api.NewGRPCStatus(err.NewErrorInvalidArgEmpty(
+		err.SubscriptionPackageName,
+		"webhook URL must be specified",
+		"WebhookURL",
+	))
+	statusWebhookRecipientSecretRequired = api.NewGRPCStatus(err.NewErrorInvalidArgEmpty(
+		err.SubscriptionPackageName,
+		"webhook secret must be specified",
+		"WebhookSecret",
+	))
+	statusInvalidWebhookURL = api.NewGRPCStatus(err.NewErrorInvalidArgNotMatchFormat(
+		err.SubscriptionPackageName,
+		"webhook URL must be a valid http or https URL",
+		"WebhookURL",
+	))
+	statusDisallowedWebhookURL = api.NewGRPCStatus(err.NewErrorInvalidArgNotMatchFormat(
+		err.SubscriptionPackageName,
+		"webhook URL must not point to a loopback, link-local or private host",
+		"WebhookURL",
+	))
+	statusEmailRecipientRequired = api.NewGRPCStatus(err.NewErrorInvalidArgEmpty(
+		err.SubscriptionPackageName,
+		"email addresses must be specified",
+		"NotificationEmailRecipient",
+	))
+	statusInvalidEmailAddress = api.NewGRPCStatus(err.NewErrorInvalidArgNotMatchFormat(
+		err.SubscriptionPackageName,
+		"email address is invalid",
+		"Email",
+	))
 	statusInvalidCursor = api.NewGRPCStatus(
 		err.NewErrorInvalidArgNotMatchFormat(err.SubscriptionPackageName, "cursor is invalid", "Cursor"),
 	)
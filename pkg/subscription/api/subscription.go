@@ -80,6 +80,7 @@ func (s *SubscriptionService) CreateSubscription(
 		return nil, api.NewGRPCStatus(err).Err()
 	}
 
+	masked := subscription.WithoutSecrets()
 	event, err := domainevent.NewEvent(
 		editor,
 		eventproto.Event_SUBSCRIPTION,
@@ -87,12 +88,12 @@ func (s *SubscriptionService) CreateSubscription(
 		eventproto.Event_SUBSCRIPTION_CREATED,
 		&eventproto.SubscriptionCreatedEvent{
 			SourceTypes:     subscription.SourceTypes,
-			Recipient:       subscription.Recipient,
+			Recipient:       masked.Recipient,
 			Name:            subscription.Name,
 			FeatureFlagTags: subscription.FeatureFlagTags,
 		},
 		req.EnvironmentId,
-		subscription.Subscription,
+		masked,
 		nil,
 	)
 	if err != nil {
@@ -119,7 +120,7 @@ func (s *SubscriptionService) CreateSubscription(
 		return nil, err
 	}
 	return &subscriptionproto.CreateSubscriptionResponse{
-		Subscription: subscription.Subscription,
+		Subscription: masked,
 	}, nil
 }
 
@@ -176,7 +177,7 @@ func (s *SubscriptionService) updateSubscriptionMySQL(
 		if err != nil {
 			return err
 		}
-		updatedSubscription = updated.Subscription
+		updatedSubscription = updated.WithoutSecrets()
 		event, err = domainevent.NewEvent(
 			editor,
 			eventproto.Event_SUBSCRIPTION,
@@ -191,7 +192,7 @@ func (s *SubscriptionService) updateSubscriptionMySQL(
 			},
 			ID,
 			updatedSubscription,
-			subscription,
+			subscription.WithoutSecrets(),
 		)
 		if err != nil {
 			return err
@@ -256,8 +257,8 @@ func (s *SubscriptionService) DeleteSubscription(
 			eventproto.Event_SUBSCRIPTION_DELETED,
 			&eventproto.SubscriptionDeletedEvent{},
 			req.EnvironmentId,
-			nil,                       // Current state: entity no longer exists
-			subscription.Subscription, // Previous state: what was deleted
+			nil,                           // Current state: entity no longer exists
+			subscription.WithoutSecrets(), // Previous state: what was deleted
 		)
 		if err = s.subscriptionStorage.DeleteSubscription(contextWithTx, req.Id, req.EnvironmentId); err != nil {
 			return err
@@ -320,7 +321,7 @@ func (s *SubscriptionService) GetSubscription(
 		)
 		return nil, api.NewGRPCStatus(err).Err()
 	}
-	return &subscriptionproto.GetSubscriptionResponse{Subscription: subscription.Subscription}, nil
+	return &subscriptionproto.GetSubscriptionResponse{Subscription: subscription.WithoutSecrets()}, nil
 }
 
 func (s *SubscriptionService) ListSubscriptions(
@@ -371,7 +372,7 @@ func (s *SubscriptionService) ListSubscriptions(
 		return nil, err
 	}
 	return &subscriptionproto.ListSubscriptionsResponse{
-		Subscriptions: subscriptions,
+		Subscriptions: withoutSecrets(subscriptions),
 		Cursor:        cursor,
 		TotalCount:    totalCount,
 	}, nil
@@ -429,6 +430,9 @@ func (s *SubscriptionService) ListEnabledSubscriptions(
 	if err != nil {
 		return nil, err
 	}
+	if !isServiceToken(ctx) {
+		subscriptions = withoutSecrets(subscriptions)
+	}
 	return &subscriptionproto.ListEnabledSubscriptionsResponse{
 		Subscriptions: subscriptions,
 		Cursor:        cursor,
@@ -457,3 +461,12 @@ func (s *SubscriptionService) listSubscriptions(
 	}
 	return subscriptions, strconv.Itoa(nextCursor), totalCount, nil
 }
+
+// withoutSecrets masks the recipient credentials of the subscriptions returned by the API.
+func withoutSecrets(subscriptions []*subscriptionproto.Subscription) []*subscriptionproto.Subscription {
+	masked := make([]*subscriptionproto.Subscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		masked = append(masked, (&domain.Subscription{Subscription: subscription}).WithoutSecrets())
+	}
+	return masked
+}
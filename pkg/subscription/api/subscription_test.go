@@ -21,14 +21,17 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/metadata"
+	pb "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	accountclientmock "github.com/bucketeer-io/bucketeer/v2/pkg/account/client/mock"
 	publishermock "github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher/mock"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/rpc"
 	dbmock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database/mock"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
 	v2ss "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2"
 	storagemock "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2/mock"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/token"
 	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
 	proto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
 )
@@ -101,6 +104,107 @@ func TestCreateSubscriptionMySQL(t *testing.T) {
 			},
 			expectedErr: statusSlackRecipientWebhookURLRequired.Err(),
 		},
+		{
+			desc: "err: ErrMicrosoftTeamsRecipientRequired",
+			input: &proto.CreateSubscriptionRequest{
+				Name: "sname",
+				SourceTypes: []proto.Subscription_SourceType{
+					proto.Subscription_DOMAIN_EVENT_FEATURE,
+				},
+				Recipient: &proto.Recipient{
+					Type: proto.Recipient_MicrosoftTeamsChannel,
+				},
+			},
+			expectedErr: statusMicrosoftTeamsRecipientRequired.Err(),
+		},
+		{
+			desc: "err: ErrMicrosoftTeamsRecipientInvalidWebhookURL",
+			input: &proto.CreateSubscriptionRequest{
+				Name: "sname",
+				SourceTypes: []proto.Subscription_SourceType{
+					proto.Subscription_DOMAIN_EVENT_FEATURE,
+				},
+				Recipient: &proto.Recipient{
+					Type: proto.Recipient_MicrosoftTeamsChannel,
+					MicrosoftTeamsChannelRecipient: &proto.MicrosoftTeamsChannelRecipient{
+						WebhookUrl: "ftp://example.com",
+					},
+				},
+			},
+			expectedErr: statusInvalidWebhookURL.Err(),
+		},
+		{
+			desc: "err: ErrWebhookRecipientURLRequired",
+			input: &proto.CreateSubscriptionRequest{
+				Name: "sname",
+				SourceTypes: []proto.Subscription_SourceType{
+					proto.Subscription_DOMAIN_EVENT_FEATURE,
+				},
+				Recipient: &proto.Recipient{
+					Type:             proto.Recipient_Webhook,
+					WebhookRecipient: &proto.WebhookRecipient{Secret: "secret"},
+				},
+			},
+			expectedErr: statusWebhookRecipientURLRequired.Err(),
+		},
+		{
+			desc: "err: ErrDisallowedWebhookURL",
+			input: &proto.CreateSubscriptionRequest{
+				Name: "sname",
+				SourceTypes: []proto.Subscription_SourceType{
+					proto.Subscription_DOMAIN_EVENT_FEATURE,
+				},
+				Recipient: &proto.Recipient{
+					Type:             proto.Recipient_Webhook,
+					WebhookRecipient: &proto.WebhookRecipient{Url: "http://10.0.0.1/hook", Secret: "secret"},
+				},
+			},
+			expectedErr: statusDisallowedWebhookURL.Err(),
+		},
+		{
+			desc: "err: ErrWebhookRecipientSecretRequired",
+			input: &proto.CreateSubscriptionRequest{
+				Name: "sname",
+				SourceTypes: []proto.Subscription_SourceType{
+					proto.Subscription_DOMAIN_EVENT_FEATURE,
+				},
+				Recipient: &proto.Recipient{
+					Type:             proto.Recipient_Webhook,
+					WebhookRecipient: &proto.WebhookRecipient{Url: "https://example.com/hook"},
+				},
+			},
+			expectedErr: statusWebhookRecipientSecretRequired.Err(),
+		},
+		{
+			desc: "err: ErrEmailRecipientRequired",
+			input: &proto.CreateSubscriptionRequest{
+				Name: "sname",
+				SourceTypes: []proto.Subscription_SourceType{
+					proto.Subscription_DOMAIN_EVENT_FEATURE,
+				},
+				Recipient: &proto.Recipient{
+					Type:           proto.Recipient_Email,
+					EmailRecipient: &proto.EmailRecipient{},
+				},
+			},
+			expectedErr: statusEmailRecipientRequired.Err(),
+		},
+		{
+			desc: "err: ErrInvalidEmailAddress",
+			input: &proto.CreateSubscriptionRequest{
+				Name: "sname",
+				SourceTypes: []proto.Subscription_SourceType{
+					proto.Subscription_DOMAIN_EVENT_FEATURE,
+				},
+				Recipient: &proto.Recipient{
+					Type: proto.Recipient_Email,
+					EmailRecipient: &proto.EmailRecipient{
+						Addresses: []string{"test@example.com", "invalid"},
+					},
+				},
+			},
+			expectedErr: statusInvalidEmailAddress.Err(),
+		},
 		{
 			desc: "err: ErrNameRequired",
 			input: &proto.CreateSubscriptionRequest{
@@ -578,7 +682,7 @@ func TestListSubscriptionsMySQL(t *testing.T) {
 			ctx = setToken(t, ctx, p.isSystemAdmin)
 			actual, err := s.ListSubscriptions(ctx, p.input)
 			assert.Equal(t, p.expectedErr, err)
-			assert.Equal(t, p.expected, actual)
+			assert.True(t, pb.Equal(p.expected, actual), "expected: %v, actual: %v", p.expected, actual)
 		})
 	}
 }
@@ -657,3 +761,57 @@ func TestListEnabledSubscriptionsMySQL(t *testing.T) {
 		})
 	}
 }
+
+func TestListEnabledSubscriptionsMasksRecipients(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc           string
+		isServiceToken bool
+		expected       string
+	}{
+		{
+			desc:           "viewer",
+			isServiceToken: false,
+			expected:       "https://hooks.slack.com/********",
+		},
+		{
+			desc:           "service token",
+			isServiceToken: true,
+			expected:       "https://hooks.slack.com/services/secret",
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			s := newSubscriptionService(
+				mockController,
+				nil,
+				toPtr(accountproto.AccountV2_Role_Organization_MEMBER),
+				toPtr(accountproto.AccountV2_Role_Environment_VIEWER),
+			)
+			s.subscriptionStorage.(*storagemock.MockSubscriptionStorage).EXPECT().ListSubscriptions(
+				gomock.Any(), gomock.Any(),
+			).Return([]*proto.Subscription{
+				{
+					Id: "id-0",
+					Recipient: &proto.Recipient{
+						Type: proto.Recipient_SlackChannel,
+						SlackChannelRecipient: &proto.SlackChannelRecipient{
+							WebhookUrl: "https://hooks.slack.com/services/secret",
+						},
+					},
+				},
+			}, 1, int64(1), nil)
+			ctx := context.WithValue(context.Background(), rpc.AccessTokenKey, &token.AccessToken{
+				Email:          "email",
+				IsSystemAdmin:  p.isServiceToken,
+				IsServiceToken: p.isServiceToken,
+			})
+			actual, err := s.ListEnabledSubscriptions(ctx, &proto.ListEnabledSubscriptionsRequest{EnvironmentId: "ns0"})
+			assert.NoError(t, err)
+			assert.Equal(t, p.expected, actual.Subscriptions[0].Recipient.SlackChannelRecipient.WebhookUrl)
+		})
+	}
+}
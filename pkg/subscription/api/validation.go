@@ -15,9 +15,19 @@
 package api
 
 import (
+	"errors"
+	"net/url"
+	"regexp"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/webhook"
 	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
 )
 
+var (
+	// nolint:lll
+	emailRegex = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
+)
+
 func (s *SubscriptionService) validateCreateSubscriptionRequest(
 	req *subscriptionproto.CreateSubscriptionRequest,
 ) error {
@@ -52,8 +62,15 @@ func (s *SubscriptionService) validateRecipient(
 	if recipient == nil {
 		return statusRecipientRequired.Err()
 	}
-	if recipient.Type == subscriptionproto.Recipient_SlackChannel {
+	switch recipient.Type {
+	case subscriptionproto.Recipient_SlackChannel:
 		return s.validateSlackRecipient(recipient.SlackChannelRecipient)
+	case subscriptionproto.Recipient_MicrosoftTeamsChannel:
+		return s.validateMicrosoftTeamsRecipient(recipient.MicrosoftTeamsChannelRecipient)
+	case subscriptionproto.Recipient_Webhook:
+		return s.validateWebhookRecipient(recipient.WebhookRecipient)
+	case subscriptionproto.Recipient_Email:
+		return s.validateEmailRecipient(recipient.EmailRecipient)
 	}
 	return statusUnknownRecipient.Err()
 }
@@ -71,6 +88,71 @@ func (s *SubscriptionService) validateSlackRecipient(
 	return nil
 }
 
+func (s *SubscriptionService) validateMicrosoftTeamsRecipient(
+	tr *subscriptionproto.MicrosoftTeamsChannelRecipient,
+) error {
+	if tr == nil {
+		return statusMicrosoftTeamsRecipientRequired.Err()
+	}
+	if tr.WebhookUrl == "" {
+		return statusMicrosoftTeamsRecipientWebhookURLRequired.Err()
+	}
+	if !isHTTPURL(tr.WebhookUrl) {
+		return statusInvalidWebhookURL.Err()
+	}
+	return nil
+}
+
+func (s *SubscriptionService) validateWebhookRecipient(
+	wr *subscriptionproto.WebhookRecipient,
+) error {
+	if wr == nil {
+		return statusWebhookRecipientRequired.Err()
+	}
+	if wr.Url == "" {
+		return statusWebhookRecipientURLRequired.Err()
+	}
+	if err := validateWebhookURL(wr.Url); err != nil {
+		return err
+	}
+	if wr.Secret == "" {
+		return statusWebhookRecipientSecretRequired.Err()
+	}
+	return nil
+}
+
+func (s *SubscriptionService) validateEmailRecipient(
+	er *subscriptionproto.EmailRecipient,
+) error {
+	if er == nil || len(er.Addresses) == 0 {
+		return statusEmailRecipientRequired.Err()
+	}
+	for _, address := range er.Addresses {
+		if !emailRegex.MatchString(address) {
+			return statusInvalidEmailAddress.Err()
+		}
+	}
+	return nil
+}
+
+func isHTTPURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "https" || u.Scheme == "http") && u.Host != ""
+}
+
+func validateWebhookURL(rawURL string) error {
+	if err := webhook.ValidateURL(rawURL); err != nil {
+		if errors.Is(err, webhook.ErrDisallowedHost) {
+			return statusDisallowedWebhookURL.Err()
+		}
+		return statusInvalidWebhookURL.Err()
+	}
+	return nil
+}
+
 func (s *SubscriptionService) validateUpdateSubscriptionRequest(
 	req *subscriptionproto.UpdateSubscriptionRequest,
 ) error {
@@ -98,3 +180,40 @@ func validateGetSubscriptionRequest(req *subscriptionproto.GetSubscriptionReques
 	}
 	return nil
 }
+
+func validateCreateWebhookRequest(req *subscriptionproto.CreateWebhookRequest) error {
+	if req.Name == "" {
+		return statusNameRequired.Err()
+	}
+	if req.Url == "" {
+		return statusWebhookRecipientURLRequired.Err()
+	}
+	if err := validateWebhookURL(req.Url); err != nil {
+		return err
+	}
+	if req.Secret == "" {
+		return statusWebhookRecipientSecretRequired.Err()
+	}
+	if len(req.SourceTypes) == 0 {
+		return statusSourceTypesRequired.Err()
+	}
+	return nil
+}
+
+func validateUpdateWebhookRequest(req *subscriptionproto.UpdateWebhookRequest) error {
+	if req.Id == "" {
+		return statusIDRequired.Err()
+	}
+	if req.Name != nil && req.Name.Value == "" {
+		return statusNameRequired.Err()
+	}
+	if req.Url != nil {
+		if err := validateWebhookURL(req.Url.Value); err != nil {
+			return err
+		}
+	}
+	if req.Secret != nil && req.Secret.Value == "" {
+		return statusWebhookRecipientSecretRequired.Err()
+	}
+	return nil
+}
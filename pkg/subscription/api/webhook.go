@@ -0,0 +1,445 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/api/api"
+	domainevent "github.com/bucketeer-io/bucketeer/v2/pkg/domainevent/domain"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/log"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
+	v2ss "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	eventproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+)
+
+// A replay is a manual request, so it's attempted only once and the result is returned to the caller.
+const replayMaxAttempts = 1
+
+func (s *SubscriptionService) GetWebhook(
+	ctx context.Context,
+	req *subscriptionproto.GetWebhookRequest,
+) (*subscriptionproto.GetWebhookResponse, error) {
+	_, err := s.checkEnvironmentRole(
+		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
+		req.EnvironmentId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, statusIDRequired.Err()
+	}
+	webhook, err := s.webhookStorage.GetWebhook(ctx, req.Id, req.EnvironmentId)
+	if err != nil {
+		if errors.Is(err, v2ss.ErrWebhookNotFound) {
+			return nil, statusNotFound.Err()
+		}
+		s.logger.Error(
+			"Failed to get webhook",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("id", req.Id),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	return &subscriptionproto.GetWebhookResponse{Webhook: webhook.WithoutSecret()}, nil
+}
+
+func (s *SubscriptionService) ListWebhooks(
+	ctx context.Context,
+	req *subscriptionproto.ListWebhooksRequest,
+) (*subscriptionproto.ListWebhooksResponse, error) {
+	_, err := s.checkEnvironmentRole(
+		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
+		req.EnvironmentId)
+	if err != nil {
+		return nil, err
+	}
+	var disabled *bool
+	if req.Disabled != nil {
+		disabled = &req.Disabled.Value
+	}
+	webhooks, nextCursor, totalCount, err := s.webhookStorage.ListWebhooks(ctx, v2ss.ListWebhooksParams{
+		EnvironmentID: req.EnvironmentId,
+		Disabled:      disabled,
+		SearchKeyword: req.SearchKeyword,
+		PageSize:      req.PageSize,
+		Cursor:        req.Cursor,
+	})
+	if err != nil {
+		if errors.Is(err, v2ss.ErrInvalidCursor) {
+			return nil, statusInvalidCursor.Err()
+		}
+		s.logger.Error(
+			"Failed to list webhooks",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	for i, w := range webhooks {
+		webhooks[i] = (&domain.Webhook{Webhook: w}).WithoutSecret()
+	}
+	return &subscriptionproto.ListWebhooksResponse{
+		Webhooks:   webhooks,
+		Cursor:     strconv.Itoa(nextCursor),
+		TotalCount: totalCount,
+	}, nil
+}
+
+func (s *SubscriptionService) CreateWebhook(
+	ctx context.Context,
+	req *subscriptionproto.CreateWebhookRequest,
+) (*subscriptionproto.CreateWebhookResponse, error) {
+	editor, err := s.checkEnvironmentRole(
+		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
+		req.EnvironmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCreateWebhookRequest(req); err != nil {
+		return nil, err
+	}
+	webhook, err := domain.NewWebhook(
+		req.EnvironmentId,
+		req.Name,
+		req.Url,
+		req.Secret,
+		req.SourceTypes,
+		req.MaxRetries,
+	)
+	if err != nil {
+		s.logger.Error(
+			"Failed to create a new webhook",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	event, err := domainevent.NewEvent(
+		editor,
+		eventproto.Event_WEBHOOK,
+		webhook.Id,
+		eventproto.Event_WEBHOOK_CREATED,
+		&eventproto.WebhookCreatedEvent{
+			Id:          webhook.Id,
+			Name:        webhook.Name,
+			Url:         webhook.Url,
+			SourceTypes: webhook.SourceTypes,
+			MaxRetries:  webhook.MaxRetries,
+		},
+		req.EnvironmentId,
+		webhook.WithoutSecret(),
+		nil,
+	)
+	if err != nil {
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	err = s.dbClient.RunInTransactionV2(ctx, func(contextWithTx context.Context) error {
+		return s.webhookStorage.CreateWebhook(contextWithTx, webhook)
+	})
+	if err != nil {
+		if errors.Is(err, v2ss.ErrWebhookAlreadyExists) {
+			return nil, statusAlreadyExists.Err()
+		}
+		s.logger.Error(
+			"Failed to create webhook",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	if err := s.publishWebhookEvent(ctx, event, req.EnvironmentId, webhook.Id); err != nil {
+		return nil, err
+	}
+	return &subscriptionproto.CreateWebhookResponse{Webhook: webhook.WithoutSecret()}, nil
+}
+
+func (s *SubscriptionService) UpdateWebhook(
+	ctx context.Context,
+	req *subscriptionproto.UpdateWebhookRequest,
+) (*subscriptionproto.UpdateWebhookResponse, error) {
+	editor, err := s.checkEnvironmentRole(
+		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
+		req.EnvironmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateUpdateWebhookRequest(req); err != nil {
+		return nil, err
+	}
+	var updated *domain.Webhook
+	var event *eventproto.Event
+	err = s.dbClient.RunInTransactionV2(ctx, func(contextWithTx context.Context) error {
+		webhook, err := s.webhookStorage.GetWebhook(contextWithTx, req.Id, req.EnvironmentId)
+		if err != nil {
+			return err
+		}
+		updated, err = webhook.Update(req.Name, req.Url, req.Secret, req.SourceTypes, req.MaxRetries, req.Disabled)
+		if err != nil {
+			return err
+		}
+		event, err = domainevent.NewEvent(
+			editor,
+			eventproto.Event_WEBHOOK,
+			webhook.Id,
+			eventproto.Event_WEBHOOK_UPDATED,
+			&eventproto.WebhookUpdatedEvent{
+				Id:            webhook.Id,
+				Name:          req.Name,
+				Url:           req.Url,
+				SourceTypes:   req.SourceTypes,
+				MaxRetries:    req.MaxRetries,
+				Disabled:      req.Disabled,
+				SecretChanged: req.Secret != nil,
+			},
+			req.EnvironmentId,
+			updated.WithoutSecret(),
+			webhook.WithoutSecret(),
+		)
+		if err != nil {
+			return err
+		}
+		return s.webhookStorage.UpdateWebhook(contextWithTx, updated)
+	})
+	if err != nil {
+		if errors.Is(err, v2ss.ErrWebhookNotFound) || errors.Is(err, v2ss.ErrWebhookUnexpectedAffectedRows) {
+			return nil, statusNotFound.Err()
+		}
+		s.logger.Error(
+			"Failed to update webhook",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("id", req.Id),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	if err := s.publishWebhookEvent(ctx, event, req.EnvironmentId, req.Id); err != nil {
+		return nil, err
+	}
+	return &subscriptionproto.UpdateWebhookResponse{Webhook: updated.WithoutSecret()}, nil
+}
+
+func (s *SubscriptionService) DeleteWebhook(
+	ctx context.Context,
+	req *subscriptionproto.DeleteWebhookRequest,
+) (*subscriptionproto.DeleteWebhookResponse, error) {
+	editor, err := s.checkEnvironmentRole(
+		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
+		req.EnvironmentId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, statusIDRequired.Err()
+	}
+	var event *eventproto.Event
+	err = s.dbClient.RunInTransactionV2(ctx, func(contextWithTx context.Context) error {
+		webhook, err := s.webhookStorage.GetWebhook(contextWithTx, req.Id, req.EnvironmentId)
+		if err != nil {
+			return err
+		}
+		event, err = domainevent.NewEvent(
+			editor,
+			eventproto.Event_WEBHOOK,
+			webhook.Id,
+			eventproto.Event_WEBHOOK_DELETED,
+			&eventproto.WebhookDeletedEvent{
+				Id:   webhook.Id,
+				Name: webhook.Name,
+			},
+			req.EnvironmentId,
+			nil,                     // Current state: entity no longer exists
+			webhook.WithoutSecret(), // Previous state: what was deleted
+		)
+		if err != nil {
+			return err
+		}
+		// The delivery log of the webhook is deleted by the foreign key.
+		return s.webhookStorage.DeleteWebhook(contextWithTx, req.Id, req.EnvironmentId)
+	})
+	if err != nil {
+		if errors.Is(err, v2ss.ErrWebhookNotFound) || errors.Is(err, v2ss.ErrWebhookUnexpectedAffectedRows) {
+			return nil, statusNotFound.Err()
+		}
+		s.logger.Error(
+			"Failed to delete webhook",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("id", req.Id),
+				zap.String("environmentId", req.EnvironmentId),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	if err := s.publishWebhookEvent(ctx, event, req.EnvironmentId, req.Id); err != nil {
+		return nil, err
+	}
+	return &subscriptionproto.DeleteWebhookResponse{}, nil
+}
+
+func (s *SubscriptionService) ListWebhookDeliveries(
+	ctx context.Context,
+	req *subscriptionproto.ListWebhookDeliveriesRequest,
+) (*subscriptionproto.ListWebhookDeliveriesResponse, error) {
+	_, err := s.checkEnvironmentRole(
+		ctx, accountproto.AccountV2_Role_Environment_VIEWER,
+		req.EnvironmentId)
+	if err != nil {
+		return nil, err
+	}
+	deliveries, nextCursor, totalCount, err := s.webhookDeliveryStorage.ListWebhookDeliveries(
+		ctx,
+		v2ss.ListWebhookDeliveriesParams{
+			EnvironmentID: req.EnvironmentId,
+			WebhookID:     req.WebhookId,
+			Status:        req.Status,
+			PageSize:      req.PageSize,
+			Cursor:        req.Cursor,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, v2ss.ErrInvalidCursor) {
+			return nil, statusInvalidCursor.Err()
+		}
+		s.logger.Error(
+			"Failed to list webhook deliveries",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentId", req.EnvironmentId),
+				zap.String("webhookId", req.WebhookId),
+			)...,
+		)
+		return nil, api.NewGRPCStatus(err).Err()
+	}
+	return &subscriptionproto.ListWebhookDeliveriesResponse{
+		Deliveries: deliveries,
+		Cursor:     strconv.Itoa(nextCursor),
+		TotalCount: totalCount,
+	}, nil
+}
+
+// ReplayWebhookDelivery posts the payload of a past delivery to its webhook again.
+// The replay is recorded as a new delivery that references the replayed one.
+func (s *SubscriptionService) ReplayWebhookDelivery(
+	ctx context.Context,
+	req *subscriptionproto.ReplayWebhookDeliveryRequest,
+) (*subscriptionproto.ReplayWebhookDeliveryResponse, error) {
+	editor, err := s.checkEnvironmentRole(
+		ctx, accountproto.AccountV2_Role_Environment_EDITOR,
+		req.EnvironmentId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Id == "" {
+		return nil, statusIDRequired.Err()
+	}
+	delivery, err := s.webhookDeliveryStorage.GetWebhookDelivery(ctx, req.Id, req.EnvironmentId)
+	if err != nil {
+		return nil, s.replayError(ctx, err, req)
+	}
+	webhook, err := s.webhookStorage.GetWebhook(ctx, delivery.WebhookId, req.EnvironmentId)
+	if err != nil {
+		return nil, s.replayError(ctx, err, req)
+	}
+	replay, err := delivery.NewReplay()
+	if err != nil {
+		return nil, s.replayError(ctx, err, req)
+	}
+	s.webhookDeliverer.Deliver(ctx, webhook.Webhook, replay, replayMaxAttempts)
+	event, err := domainevent.NewEvent(
+		editor,
+		eventproto.Event_WEBHOOK,
+		webhook.Id,
+		eventproto.Event_WEBHOOK_DELIVERY_REPLAYED,
+		&eventproto.WebhookDeliveryReplayedEvent{
+			Id:                 replay.Id,
+			WebhookId:          webhook.Id,
+			ReplayedDeliveryId: delivery.Id,
+			EventId:            replay.EventId,
+			StatusCode:         replay.StatusCode,
+		},
+		req.EnvironmentId,
+		replay.WebhookDelivery,
+		nil,
+	)
+	if err != nil {
+		return nil, s.replayError(ctx, err, req)
+	}
+	err = s.dbClient.RunInTransactionV2(ctx, func(contextWithTx context.Context) error {
+		return s.webhookDeliveryStorage.CreateWebhookDelivery(contextWithTx, replay)
+	})
+	if err != nil {
+		return nil, s.replayError(ctx, err, req)
+	}
+	if err := s.publishWebhookEvent(ctx, event, req.EnvironmentId, webhook.Id); err != nil {
+		return nil, err
+	}
+	return &subscriptionproto.ReplayWebhookDeliveryResponse{Delivery: replay.WebhookDelivery}, nil
+}
+
+func (s *SubscriptionService) replayError(
+	ctx context.Context,
+	err error,
+	req *subscriptionproto.ReplayWebhookDeliveryRequest,
+) error {
+	if errors.Is(err, v2ss.ErrWebhookDeliveryNotFound) || errors.Is(err, v2ss.ErrWebhookNotFound) {
+		return statusNotFound.Err()
+	}
+	s.logger.Error(
+		"Failed to replay webhook delivery",
+		log.FieldsFromIncomingContext(ctx).AddFields(
+			zap.Error(err),
+			zap.String("id", req.Id),
+			zap.String("environmentId", req.EnvironmentId),
+		)...,
+	)
+	return api.NewGRPCStatus(err).Err()
+}
+
+func (s *SubscriptionService) publishWebhookEvent(
+	ctx context.Context,
+	event *eventproto.Event,
+	environmentID, webhookID string,
+) error {
+	if err := s.domainEventPublisher.Publish(ctx, event); err != nil {
+		s.logger.Error(
+			"Failed to publish event",
+			log.FieldsFromIncomingContext(ctx).AddFields(
+				zap.Error(err),
+				zap.String("environmentId", environmentID),
+				zap.String("webhookId", webhookID),
+			)...,
+		)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,517 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	publishermock "github.com/bucketeer-io/bucketeer/v2/pkg/pubsub/publisher/mock"
+	dbmock "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database/mock"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
+	v2ss "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2"
+	storagemock "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2/mock"
+	webhookmock "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/webhook/mock"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+)
+
+func newWebhookTestContext(t *testing.T) context.Context {
+	t.Helper()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{
+		"accept-language": []string{"ja"},
+	})
+	return setToken(t, ctx, true)
+}
+
+func newTestWebhook(t *testing.T) *domain.Webhook {
+	t.Helper()
+	webhook, err := domain.NewWebhook(
+		"ns0",
+		"name",
+		"https://example.com/hook",
+		"secret",
+		[]proto.Subscription_SourceType{proto.Subscription_DOMAIN_EVENT_FEATURE},
+		nil,
+	)
+	require.NoError(t, err)
+	return webhook
+}
+
+func expectTransaction(s *SubscriptionService) {
+	s.dbClient.(*dbmock.MockClient).EXPECT().RunInTransactionV2(
+		gomock.Any(), gomock.Any(),
+	).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+}
+
+func TestCreateWebhook(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	ctx := newWebhookTestContext(t)
+
+	sourceTypes := []proto.Subscription_SourceType{proto.Subscription_DOMAIN_EVENT_FEATURE}
+	patterns := []struct {
+		desc        string
+		setup       func(*SubscriptionService)
+		input       *proto.CreateWebhookRequest
+		expectedErr error
+	}{
+		{
+			desc: "err: name required",
+			input: &proto.CreateWebhookRequest{
+				EnvironmentId: "ns0",
+				Url:           "https://example.com/hook",
+				Secret:        "secret",
+				SourceTypes:   sourceTypes,
+			},
+			expectedErr: statusNameRequired.Err(),
+		},
+		{
+			desc: "err: invalid url",
+			input: &proto.CreateWebhookRequest{
+				EnvironmentId: "ns0",
+				Name:          "name",
+				Url:           "ftp://example.com/hook",
+				Secret:        "secret",
+				SourceTypes:   sourceTypes,
+			},
+			expectedErr: statusInvalidWebhookURL.Err(),
+		},
+		{
+			desc: "err: private host",
+			input: &proto.CreateWebhookRequest{
+				EnvironmentId: "ns0",
+				Name:          "name",
+				Url:           "http://169.254.169.254/latest/meta-data",
+				Secret:        "secret",
+				SourceTypes:   sourceTypes,
+			},
+			expectedErr: statusDisallowedWebhookURL.Err(),
+		},
+		{
+			desc: "err: secret required",
+			input: &proto.CreateWebhookRequest{
+				EnvironmentId: "ns0",
+				Name:          "name",
+				Url:           "https://example.com/hook",
+				SourceTypes:   sourceTypes,
+			},
+			expectedErr: statusWebhookRecipientSecretRequired.Err(),
+		},
+		{
+			desc: "err: source types required",
+			input: &proto.CreateWebhookRequest{
+				EnvironmentId: "ns0",
+				Name:          "name",
+				Url:           "https://example.com/hook",
+				Secret:        "secret",
+			},
+			expectedErr: statusSourceTypesRequired.Err(),
+		},
+		{
+			desc: "err: already exists",
+			setup: func(s *SubscriptionService) {
+				expectTransaction(s)
+				s.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().CreateWebhook(
+					gomock.Any(), gomock.Any(),
+				).Return(v2ss.ErrWebhookAlreadyExists)
+			},
+			input: &proto.CreateWebhookRequest{
+				EnvironmentId: "ns0",
+				Name:          "name",
+				Url:           "https://example.com/hook",
+				Secret:        "secret",
+				SourceTypes:   sourceTypes,
+			},
+			expectedErr: statusAlreadyExists.Err(),
+		},
+		{
+			desc: "success",
+			setup: func(s *SubscriptionService) {
+				expectTransaction(s)
+				s.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().CreateWebhook(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.domainEventPublisher.(*publishermock.MockPublisher).EXPECT().Publish(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+			},
+			input: &proto.CreateWebhookRequest{
+				EnvironmentId: "ns0",
+				Name:          "name",
+				Url:           "https://example.com/hook",
+				Secret:        "secret",
+				SourceTypes:   sourceTypes,
+				MaxRetries:    wrapperspb.Int32(5),
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			service := newSubscriptionServiceWithMock(t, mockController)
+			if p.setup != nil {
+				p.setup(service)
+			}
+			resp, err := service.CreateWebhook(ctx, p.input)
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, p.input.Name, resp.Webhook.Name)
+				assert.Equal(t, int32(5), resp.Webhook.MaxRetries)
+				assert.Empty(t, resp.Webhook.Secret)
+			}
+		})
+	}
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	ctx := newWebhookTestContext(t)
+
+	patterns := []struct {
+		desc        string
+		setup       func(*SubscriptionService)
+		input       *proto.UpdateWebhookRequest
+		expectedErr error
+	}{
+		{
+			desc:        "err: id required",
+			input:       &proto.UpdateWebhookRequest{EnvironmentId: "ns0"},
+			expectedErr: statusIDRequired.Err(),
+		},
+		{
+			desc: "err: invalid url",
+			input: &proto.UpdateWebhookRequest{
+				Id:            "id",
+				EnvironmentId: "ns0",
+				Url:           wrapperspb.String("invalid"),
+			},
+			expectedErr: statusInvalidWebhookURL.Err(),
+		},
+		{
+			desc: "err: loopback host",
+			input: &proto.UpdateWebhookRequest{
+				Id:            "id",
+				EnvironmentId: "ns0",
+				Url:           wrapperspb.String("http://localhost:8080/hook"),
+			},
+			expectedErr: statusDisallowedWebhookURL.Err(),
+		},
+		{
+			desc: "err: not found",
+			setup: func(s *SubscriptionService) {
+				expectTransaction(s)
+				s.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().GetWebhook(
+					gomock.Any(), "id", "ns0",
+				).Return(nil, v2ss.ErrWebhookNotFound)
+			},
+			input: &proto.UpdateWebhookRequest{
+				Id:            "id",
+				EnvironmentId: "ns0",
+				Disabled:      wrapperspb.Bool(true),
+			},
+			expectedErr: statusNotFound.Err(),
+		},
+		{
+			desc: "success",
+			setup: func(s *SubscriptionService) {
+				expectTransaction(s)
+				s.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().GetWebhook(
+					gomock.Any(), "id", "ns0",
+				).Return(newTestWebhook(t), nil)
+				s.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().UpdateWebhook(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(_ context.Context, w *domain.Webhook) error {
+					assert.True(t, w.Disabled)
+					assert.Equal(t, "new-secret", w.Secret)
+					return nil
+				})
+				s.domainEventPublisher.(*publishermock.MockPublisher).EXPECT().Publish(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+			},
+			input: &proto.UpdateWebhookRequest{
+				Id:            "id",
+				EnvironmentId: "ns0",
+				Secret:        wrapperspb.String("new-secret"),
+				Disabled:      wrapperspb.Bool(true),
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			service := newSubscriptionServiceWithMock(t, mockController)
+			if p.setup != nil {
+				p.setup(service)
+			}
+			resp, err := service.UpdateWebhook(ctx, p.input)
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.True(t, resp.Webhook.Disabled)
+				assert.Empty(t, resp.Webhook.Secret)
+			}
+		})
+	}
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	ctx := newWebhookTestContext(t)
+
+	patterns := []struct {
+		desc        string
+		setup       func(*SubscriptionService)
+		input       *proto.DeleteWebhookRequest
+		expectedErr error
+	}{
+		{
+			desc:        "err: id required",
+			input:       &proto.DeleteWebhookRequest{EnvironmentId: "ns0"},
+			expectedErr: statusIDRequired.Err(),
+		},
+		{
+			desc: "err: not found",
+			setup: func(s *SubscriptionService) {
+				expectTransaction(s)
+				s.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().GetWebhook(
+					gomock.Any(), "id", "ns0",
+				).Return(nil, v2ss.ErrWebhookNotFound)
+			},
+			input:       &proto.DeleteWebhookRequest{Id: "id", EnvironmentId: "ns0"},
+			expectedErr: statusNotFound.Err(),
+		},
+		{
+			desc: "success",
+			setup: func(s *SubscriptionService) {
+				expectTransaction(s)
+				s.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().GetWebhook(
+					gomock.Any(), "id", "ns0",
+				).Return(newTestWebhook(t), nil)
+				s.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().DeleteWebhook(
+					gomock.Any(), "id", "ns0",
+				).Return(nil)
+				s.domainEventPublisher.(*publishermock.MockPublisher).EXPECT().Publish(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+			},
+			input:       &proto.DeleteWebhookRequest{Id: "id", EnvironmentId: "ns0"},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			service := newSubscriptionServiceWithMock(t, mockController)
+			if p.setup != nil {
+				p.setup(service)
+			}
+			_, err := service.DeleteWebhook(ctx, p.input)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestGetWebhook(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	ctx := newWebhookTestContext(t)
+
+	service := newSubscriptionServiceWithMock(t, mockController)
+	_, err := service.GetWebhook(ctx, &proto.GetWebhookRequest{EnvironmentId: "ns0"})
+	assert.Equal(t, statusIDRequired.Err(), err)
+
+	service = newSubscriptionServiceWithMock(t, mockController)
+	service.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().GetWebhook(
+		gomock.Any(), "id", "ns0",
+	).Return(nil, v2ss.ErrWebhookNotFound)
+	_, err = service.GetWebhook(ctx, &proto.GetWebhookRequest{Id: "id", EnvironmentId: "ns0"})
+	assert.Equal(t, statusNotFound.Err(), err)
+
+	service = newSubscriptionServiceWithMock(t, mockController)
+	service.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().GetWebhook(
+		gomock.Any(), "id", "ns0",
+	).Return(newTestWebhook(t), nil)
+	resp, err := service.GetWebhook(ctx, &proto.GetWebhookRequest{Id: "id", EnvironmentId: "ns0"})
+	require.NoError(t, err)
+	assert.Equal(t, "name", resp.Webhook.Name)
+	assert.Empty(t, resp.Webhook.Secret)
+}
+
+func TestListWebhooks(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	ctx := newWebhookTestContext(t)
+
+	service := newSubscriptionServiceWithMock(t, mockController)
+	service.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().ListWebhooks(
+		gomock.Any(), gomock.Any(),
+	).Return(nil, 0, int64(0), v2ss.ErrInvalidCursor)
+	_, err := service.ListWebhooks(ctx, &proto.ListWebhooksRequest{EnvironmentId: "ns0", Cursor: "invalid"})
+	assert.Equal(t, statusInvalidCursor.Err(), err)
+
+	service = newSubscriptionServiceWithMock(t, mockController)
+	disabled := false
+	service.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().ListWebhooks(
+		gomock.Any(),
+		v2ss.ListWebhooksParams{
+			EnvironmentID: "ns0",
+			Disabled:      &disabled,
+			SearchKeyword: "hook",
+			PageSize:      10,
+		},
+	).Return([]*proto.Webhook{newTestWebhook(t).Webhook}, 1, int64(1), nil)
+	resp, err := service.ListWebhooks(ctx, &proto.ListWebhooksRequest{
+		EnvironmentId: "ns0",
+		Disabled:      wrapperspb.Bool(false),
+		SearchKeyword: "hook",
+		PageSize:      10,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Webhooks, 1)
+	assert.Empty(t, resp.Webhooks[0].Secret)
+	assert.Equal(t, "1", resp.Cursor)
+	assert.Equal(t, int64(1), resp.TotalCount)
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	ctx := newWebhookTestContext(t)
+
+	service := newSubscriptionServiceWithMock(t, mockController)
+	service.webhookDeliveryStorage.(*storagemock.MockWebhookDeliveryStorage).EXPECT().ListWebhookDeliveries(
+		gomock.Any(),
+		v2ss.ListWebhookDeliveriesParams{
+			EnvironmentID: "ns0",
+			WebhookID:     "webhook-id",
+			Status:        proto.WebhookDelivery_FAILED,
+			PageSize:      10,
+			Cursor:        "10",
+		},
+	).Return([]*proto.WebhookDelivery{{Id: "delivery-id"}}, 11, int64(11), nil)
+	resp, err := service.ListWebhookDeliveries(ctx, &proto.ListWebhookDeliveriesRequest{
+		EnvironmentId: "ns0",
+		WebhookId:     "webhook-id",
+		Status:        proto.WebhookDelivery_FAILED,
+		PageSize:      10,
+		Cursor:        "10",
+	})
+	require.NoError(t, err)
+	assert.Len(t, resp.Deliveries, 1)
+	assert.Equal(t, "11", resp.Cursor)
+	assert.Equal(t, int64(11), resp.TotalCount)
+}
+
+func TestReplayWebhookDelivery(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	ctx := newWebhookTestContext(t)
+
+	webhook := newTestWebhook(t)
+	delivery, err := domain.NewWebhookDelivery(
+		webhook.Id,
+		"ns0",
+		"event-id",
+		proto.Subscription_DOMAIN_EVENT_FEATURE,
+		"feature-id",
+		"FEATURE_CREATED",
+		`{"id":"event-id"}`,
+	)
+	require.NoError(t, err)
+	delivery.SetResult(500, time.Millisecond, 4, errors.New("unexpected status code"))
+
+	patterns := []struct {
+		desc        string
+		setup       func(*SubscriptionService)
+		input       *proto.ReplayWebhookDeliveryRequest
+		expectedErr error
+	}{
+		{
+			desc:        "err: id required",
+			input:       &proto.ReplayWebhookDeliveryRequest{EnvironmentId: "ns0"},
+			expectedErr: statusIDRequired.Err(),
+		},
+		{
+			desc: "err: delivery not found",
+			setup: func(s *SubscriptionService) {
+				s.webhookDeliveryStorage.(*storagemock.MockWebhookDeliveryStorage).EXPECT().GetWebhookDelivery(
+					gomock.Any(), "delivery-id", "ns0",
+				).Return(nil, v2ss.ErrWebhookDeliveryNotFound)
+			},
+			input:       &proto.ReplayWebhookDeliveryRequest{Id: "delivery-id", EnvironmentId: "ns0"},
+			expectedErr: statusNotFound.Err(),
+		},
+		{
+			desc: "success",
+			setup: func(s *SubscriptionService) {
+				s.webhookDeliveryStorage.(*storagemock.MockWebhookDeliveryStorage).EXPECT().GetWebhookDelivery(
+					gomock.Any(), "delivery-id", "ns0",
+				).Return(delivery, nil)
+				s.webhookStorage.(*storagemock.MockWebhookStorage).EXPECT().GetWebhook(
+					gomock.Any(), webhook.Id, "ns0",
+				).Return(webhook, nil)
+				s.webhookDeliverer.(*webhookmock.MockDeliverer).EXPECT().Deliver(
+					gomock.Any(), webhook.Webhook, gomock.Any(), replayMaxAttempts,
+				).Do(func(_ context.Context, _ *proto.Webhook, d *domain.WebhookDelivery, _ int) {
+					d.SetResult(200, time.Millisecond, 1, nil)
+				})
+				expectTransaction(s)
+				s.webhookDeliveryStorage.(*storagemock.MockWebhookDeliveryStorage).EXPECT().CreateWebhookDelivery(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.domainEventPublisher.(*publishermock.MockPublisher).EXPECT().Publish(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+			},
+			input:       &proto.ReplayWebhookDeliveryRequest{Id: "delivery-id", EnvironmentId: "ns0"},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			service := newSubscriptionServiceWithMock(t, mockController)
+			if p.setup != nil {
+				p.setup(service)
+			}
+			resp, err := service.ReplayWebhookDelivery(ctx, p.input)
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.NotEqual(t, delivery.Id, resp.Delivery.Id)
+				assert.Equal(t, delivery.Id, resp.Delivery.ReplayedDeliveryId)
+				assert.Equal(t, delivery.Payload, resp.Delivery.Payload)
+				assert.Equal(t, proto.WebhookDelivery_SUCCEEDED, resp.Delivery.Status)
+				assert.Equal(t, int32(1), resp.Delivery.Attempts)
+			}
+		})
+	}
+}
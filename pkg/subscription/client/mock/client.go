@@ -94,6 +94,26 @@ func (mr *MockClientMockRecorder) CreateSubscription(ctx, in any, opts ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockClient)(nil).CreateSubscription), varargs...)
 }
 
+// CreateWebhook mocks base method.
+func (m *MockClient) CreateWebhook(ctx context.Context, in *subscription.CreateWebhookRequest, opts ...grpc.CallOption) (*subscription.CreateWebhookResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateWebhook", varargs...)
+	ret0, _ := ret[0].(*subscription.CreateWebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockClientMockRecorder) CreateWebhook(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockClient)(nil).CreateWebhook), varargs...)
+}
+
 // DeleteAdminSubscription mocks base method.
 func (m *MockClient) DeleteAdminSubscription(ctx context.Context, in *subscription.DeleteAdminSubscriptionRequest, opts ...grpc.CallOption) (*subscription.DeleteAdminSubscriptionResponse, error) {
 	m.ctrl.T.Helper()
@@ -134,6 +154,26 @@ func (mr *MockClientMockRecorder) DeleteSubscription(ctx, in any, opts ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockClient)(nil).DeleteSubscription), varargs...)
 }
 
+// DeleteWebhook mocks base method.
+func (m *MockClient) DeleteWebhook(ctx context.Context, in *subscription.DeleteWebhookRequest, opts ...grpc.CallOption) (*subscription.DeleteWebhookResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWebhook", varargs...)
+	ret0, _ := ret[0].(*subscription.DeleteWebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockClientMockRecorder) DeleteWebhook(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockClient)(nil).DeleteWebhook), varargs...)
+}
+
 // DisableAdminSubscription mocks base method.
 func (m *MockClient) DisableAdminSubscription(ctx context.Context, in *subscription.DisableAdminSubscriptionRequest, opts ...grpc.CallOption) (*subscription.DisableAdminSubscriptionResponse, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +254,26 @@ func (mr *MockClientMockRecorder) GetSubscription(ctx, in any, opts ...any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockClient)(nil).GetSubscription), varargs...)
 }
 
+// GetWebhook mocks base method.
+func (m *MockClient) GetWebhook(ctx context.Context, in *subscription.GetWebhookRequest, opts ...grpc.CallOption) (*subscription.GetWebhookResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWebhook", varargs...)
+	ret0, _ := ret[0].(*subscription.GetWebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhook indicates an expected call of GetWebhook.
+func (mr *MockClientMockRecorder) GetWebhook(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*MockClient)(nil).GetWebhook), varargs...)
+}
+
 // ListAdminSubscriptions mocks base method.
 func (m *MockClient) ListAdminSubscriptions(ctx context.Context, in *subscription.ListAdminSubscriptionsRequest, opts ...grpc.CallOption) (*subscription.ListAdminSubscriptionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -294,6 +354,66 @@ func (mr *MockClientMockRecorder) ListSubscriptions(ctx, in any, opts ...any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockClient)(nil).ListSubscriptions), varargs...)
 }
 
+// ListWebhookDeliveries mocks base method.
+func (m *MockClient) ListWebhookDeliveries(ctx context.Context, in *subscription.ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*subscription.ListWebhookDeliveriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", varargs...)
+	ret0, _ := ret[0].(*subscription.ListWebhookDeliveriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockClientMockRecorder) ListWebhookDeliveries(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockClient)(nil).ListWebhookDeliveries), varargs...)
+}
+
+// ListWebhooks mocks base method.
+func (m *MockClient) ListWebhooks(ctx context.Context, in *subscription.ListWebhooksRequest, opts ...grpc.CallOption) (*subscription.ListWebhooksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWebhooks", varargs...)
+	ret0, _ := ret[0].(*subscription.ListWebhooksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *MockClientMockRecorder) ListWebhooks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockClient)(nil).ListWebhooks), varargs...)
+}
+
+// ReplayWebhookDelivery mocks base method.
+func (m *MockClient) ReplayWebhookDelivery(ctx context.Context, in *subscription.ReplayWebhookDeliveryRequest, opts ...grpc.CallOption) (*subscription.ReplayWebhookDeliveryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplayWebhookDelivery", varargs...)
+	ret0, _ := ret[0].(*subscription.ReplayWebhookDeliveryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayWebhookDelivery indicates an expected call of ReplayWebhookDelivery.
+func (mr *MockClientMockRecorder) ReplayWebhookDelivery(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayWebhookDelivery", reflect.TypeOf((*MockClient)(nil).ReplayWebhookDelivery), varargs...)
+}
+
 // UpdateAdminSubscription mocks base method.
 func (m *MockClient) UpdateAdminSubscription(ctx context.Context, in *subscription.UpdateAdminSubscriptionRequest, opts ...grpc.CallOption) (*subscription.UpdateAdminSubscriptionResponse, error) {
 	m.ctrl.T.Helper()
@@ -333,3 +453,23 @@ func (mr *MockClientMockRecorder) UpdateSubscription(ctx, in any, opts ...any) *
 	varargs := append([]any{ctx, in}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockClient)(nil).UpdateSubscription), varargs...)
 }
+
+// UpdateWebhook mocks base method.
+func (m *MockClient) UpdateWebhook(ctx context.Context, in *subscription.UpdateWebhookRequest, opts ...grpc.CallOption) (*subscription.UpdateWebhookResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWebhook", varargs...)
+	ret0, _ := ret[0].(*subscription.UpdateWebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook.
+func (mr *MockClientMockRecorder) UpdateWebhook(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockClient)(nil).UpdateWebhook), varargs...)
+}
@@ -77,7 +77,7 @@ func (h *adminSubscriptionCommandHandler) Handle(ctx context.Context, cmd Comman
 func (h *adminSubscriptionCommandHandler) create(ctx context.Context, cmd *proto.CreateAdminSubscriptionCommand) error {
 	return h.createEvent(ctx, eventproto.Event_ADMIN_SUBSCRIPTION_CREATED, &eventproto.AdminSubscriptionCreatedEvent{
 		SourceTypes: h.subscription.SourceTypes,
-		Recipient:   h.subscription.Recipient,
+		Recipient:   h.subscription.WithoutSecrets().Recipient,
 		Name:        h.subscription.Name,
 	})
 }
@@ -157,7 +157,7 @@ func (h *adminSubscriptionCommandHandler) createEvent(
 ) error {
 	var prev *proto.Subscription
 	if h.previousSubscription != nil && h.previousSubscription.Subscription != nil {
-		prev = h.previousSubscription.Subscription
+		prev = h.previousSubscription.WithoutSecrets()
 	}
 	e, err := domainevent.NewAdminEvent(
 		h.editor,
@@ -165,7 +165,7 @@ func (h *adminSubscriptionCommandHandler) createEvent(
 		h.subscription.Id,
 		eventType,
 		event,
-		h.subscription.Subscription,
+		h.subscription.WithoutSecrets(),
 		prev,
 	)
 	if err != nil {
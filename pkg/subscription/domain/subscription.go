@@ -17,10 +17,13 @@ package domain
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"net/url"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/jinzhu/copier"
+	pb "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	err "github.com/bucketeer-io/bucketeer/v2/pkg/error"
@@ -51,6 +54,9 @@ var (
 	)
 )
 
+// maskedSecret replaces the credentials in the masked recipients.
+const maskedSecret = "********"
+
 type Subscription struct {
 	*proto.Subscription
 }
@@ -80,8 +86,15 @@ func NewSubscription(
 }
 
 func ID(recipient *proto.Recipient) (string, error) {
-	if recipient.Type == proto.Recipient_SlackChannel {
+	switch recipient.Type {
+	case proto.Recipient_SlackChannel:
 		return SlackChannelRecipientID(recipient.SlackChannelRecipient.WebhookUrl), nil
+	case proto.Recipient_MicrosoftTeamsChannel:
+		return MicrosoftTeamsChannelRecipientID(recipient.MicrosoftTeamsChannelRecipient.WebhookUrl), nil
+	case proto.Recipient_Webhook:
+		return WebhookRecipientID(recipient.WebhookRecipient.Url), nil
+	case proto.Recipient_Email:
+		return EmailRecipientID(recipient.EmailRecipient.Addresses), nil
 	}
 	return "", ErrUnknownRecipient
 }
@@ -91,6 +104,69 @@ func SlackChannelRecipientID(webhookURL string) string {
 	return hex.EncodeToString(hashed[:])
 }
 
+func MicrosoftTeamsChannelRecipientID(webhookURL string) string {
+	hashed := sha256.Sum256([]byte(webhookURL))
+	return hex.EncodeToString(hashed[:])
+}
+
+func WebhookRecipientID(url string) string {
+	hashed := sha256.Sum256([]byte(url))
+	return hex.EncodeToString(hashed[:])
+}
+
+// EmailRecipientID doesn't depend on the order of the addresses,
+// so the same set of addresses can't be subscribed twice.
+func EmailRecipientID(addresses []string) string {
+	sorted := make([]string, len(addresses))
+	copy(sorted, addresses)
+	sort.Strings(sorted)
+	hashed := sha256.Sum256([]byte(strings.Join(sorted, ",")))
+	return hex.EncodeToString(hashed[:])
+}
+
+// RecipientSecrets returns the fields of the recipient that hold credentials,
+// so that they can be encrypted before being stored.
+func RecipientSecrets(recipient *proto.Recipient) []*string {
+	var secrets []*string
+	if r := recipient.GetSlackChannelRecipient(); r != nil {
+		secrets = append(secrets, &r.WebhookUrl)
+	}
+	if r := recipient.GetMicrosoftTeamsChannelRecipient(); r != nil {
+		secrets = append(secrets, &r.WebhookUrl)
+	}
+	if r := recipient.GetWebhookRecipient(); r != nil {
+		secrets = append(secrets, &r.Secret)
+	}
+	return secrets
+}
+
+// WithoutSecrets returns a copy of the subscription whose recipient credentials are masked.
+// The webhook URLs keep their host so that users can still tell the recipients apart.
+func (s *Subscription) WithoutSecrets() *proto.Subscription {
+	masked := pb.Clone(s.Subscription).(*proto.Subscription)
+	if r := masked.Recipient.GetSlackChannelRecipient(); r != nil {
+		r.WebhookUrl = maskURL(r.WebhookUrl)
+	}
+	if r := masked.Recipient.GetMicrosoftTeamsChannelRecipient(); r != nil {
+		r.WebhookUrl = maskURL(r.WebhookUrl)
+	}
+	if r := masked.Recipient.GetWebhookRecipient(); r != nil {
+		r.Secret = ""
+	}
+	return masked
+}
+
+func maskURL(rawURL string) string {
+	if rawURL == "" {
+		return ""
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return maskedSecret
+	}
+	return u.Scheme + "://" + u.Host + "/" + maskedSecret
+}
+
 func (s *Subscription) UpdateSubscription(
 	name *wrapperspb.StringValue,
 	sourceTypes []proto.Subscription_SourceType,
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	pb "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	proto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
@@ -378,3 +379,131 @@ func TestUpdateFeatureFlagTags(t *testing.T) {
 		})
 	}
 }
+
+func TestID(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc      string
+		recipient *proto.Recipient
+		expected  string
+	}{
+		{
+			desc: "slack",
+			recipient: &proto.Recipient{
+				Type:                  proto.Recipient_SlackChannel,
+				SlackChannelRecipient: &proto.SlackChannelRecipient{WebhookUrl: "url"},
+			},
+			expected: SlackChannelRecipientID("url"),
+		},
+		{
+			desc: "microsoft teams",
+			recipient: &proto.Recipient{
+				Type:                           proto.Recipient_MicrosoftTeamsChannel,
+				MicrosoftTeamsChannelRecipient: &proto.MicrosoftTeamsChannelRecipient{WebhookUrl: "url"},
+			},
+			expected: MicrosoftTeamsChannelRecipientID("url"),
+		},
+		{
+			desc: "webhook",
+			recipient: &proto.Recipient{
+				Type:             proto.Recipient_Webhook,
+				WebhookRecipient: &proto.WebhookRecipient{Url: "url", Secret: "secret"},
+			},
+			expected: WebhookRecipientID("url"),
+		},
+		{
+			desc: "email",
+			recipient: &proto.Recipient{
+				Type:           proto.Recipient_Email,
+				EmailRecipient: &proto.EmailRecipient{Addresses: []string{"b@example.com", "a@example.com"}},
+			},
+			expected: EmailRecipientID([]string{"a@example.com", "b@example.com"}),
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			actual, err := ID(p.recipient)
+			assert.NoError(t, err)
+			assert.Equal(t, p.expected, actual)
+		})
+	}
+}
+
+func TestWithoutSecrets(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc      string
+		recipient *proto.Recipient
+		expected  *proto.Recipient
+	}{
+		{
+			desc: "slack",
+			recipient: &proto.Recipient{
+				Type:                  proto.Recipient_SlackChannel,
+				SlackChannelRecipient: &proto.SlackChannelRecipient{WebhookUrl: "https://hooks.slack.com/services/T/B/X"},
+			},
+			expected: &proto.Recipient{
+				Type:                  proto.Recipient_SlackChannel,
+				SlackChannelRecipient: &proto.SlackChannelRecipient{WebhookUrl: "https://hooks.slack.com/********"},
+			},
+		},
+		{
+			desc: "microsoft teams without host",
+			recipient: &proto.Recipient{
+				Type:                           proto.Recipient_MicrosoftTeamsChannel,
+				MicrosoftTeamsChannelRecipient: &proto.MicrosoftTeamsChannelRecipient{WebhookUrl: "url"},
+			},
+			expected: &proto.Recipient{
+				Type:                           proto.Recipient_MicrosoftTeamsChannel,
+				MicrosoftTeamsChannelRecipient: &proto.MicrosoftTeamsChannelRecipient{WebhookUrl: "********"},
+			},
+		},
+		{
+			desc: "webhook",
+			recipient: &proto.Recipient{
+				Type:             proto.Recipient_Webhook,
+				WebhookRecipient: &proto.WebhookRecipient{Url: "https://example.com/hook", Secret: "secret"},
+			},
+			expected: &proto.Recipient{
+				Type:             proto.Recipient_Webhook,
+				WebhookRecipient: &proto.WebhookRecipient{Url: "https://example.com/hook"},
+			},
+		},
+		{
+			desc: "email",
+			recipient: &proto.Recipient{
+				Type:           proto.Recipient_Email,
+				EmailRecipient: &proto.EmailRecipient{Addresses: []string{"a@example.com"}},
+			},
+			expected: &proto.Recipient{
+				Type:           proto.Recipient_Email,
+				EmailRecipient: &proto.EmailRecipient{Addresses: []string{"a@example.com"}},
+			},
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			s := &Subscription{&proto.Subscription{Id: "id", Recipient: p.recipient}}
+			original := pb.Clone(p.recipient)
+			actual := s.WithoutSecrets()
+			assert.True(t, pb.Equal(p.expected, actual.Recipient), actual.Recipient)
+			assert.True(t, pb.Equal(original, s.Recipient))
+		})
+	}
+}
+
+func TestRecipientSecrets(t *testing.T) {
+	t.Parallel()
+	recipient := &proto.Recipient{
+		Type:             proto.Recipient_Webhook,
+		WebhookRecipient: &proto.WebhookRecipient{Url: "url", Secret: "secret"},
+	}
+	secrets := RecipientSecrets(recipient)
+	assert.Len(t, secrets, 1)
+	*secrets[0] = "encrypted"
+	assert.Equal(t, "encrypted", recipient.WebhookRecipient.Secret)
+	assert.Empty(t, RecipientSecrets(&proto.Recipient{
+		Type:           proto.Recipient_Email,
+		EmailRecipient: &proto.EmailRecipient{Addresses: []string{"a@example.com"}},
+	}))
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	err "github.com/bucketeer-io/bucketeer/v2/pkg/error"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/uuid"
+	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+)
+
+const (
+	// DefaultWebhookMaxRetries is used when the number of retries is not specified.
+	DefaultWebhookMaxRetries = 3
+	// MaxWebhookMaxRetries caps the retries so that a failing endpoint can't block the delivery for long.
+	MaxWebhookMaxRetries = 10
+)
+
+var (
+	ErrWebhookInvalidMaxRetries = err.NewErrorOutOfRange(
+		err.SubscriptionPackageName,
+		"max retries is out of range",
+		"max_retries",
+		0,
+		MaxWebhookMaxRetries,
+	)
+)
+
+type Webhook struct {
+	*subscriptionproto.Webhook
+}
+
+func NewWebhook(
+	environmentID, name, url, secret string,
+	sourceTypes []subscriptionproto.Subscription_SourceType,
+	maxRetries *wrapperspb.Int32Value,
+) (*Webhook, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	retries := int32(DefaultWebhookMaxRetries)
+	if maxRetries != nil {
+		retries = maxRetries.Value
+	}
+	if err := validateMaxRetries(retries); err != nil {
+		return nil, err
+	}
+	sorted := append([]subscriptionproto.Subscription_SourceType{}, sourceTypes...)
+	sortSourceType(sorted)
+	now := time.Now().Unix()
+	return &Webhook{&subscriptionproto.Webhook{
+		Id:            id.String(),
+		EnvironmentId: environmentID,
+		Name:          name,
+		Url:           url,
+		Secret:        secret,
+		SourceTypes:   sorted,
+		MaxRetries:    retries,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}}, nil
+}
+
+// Update returns an updated copy of the webhook.
+// Nil values and empty source types are left unchanged.
+func (w *Webhook) Update(
+	name, url, secret *wrapperspb.StringValue,
+	sourceTypes []subscriptionproto.Subscription_SourceType,
+	maxRetries *wrapperspb.Int32Value,
+	disabled *wrapperspb.BoolValue,
+) (*Webhook, error) {
+	updated := &Webhook{proto.Clone(w.Webhook).(*subscriptionproto.Webhook)}
+	if name != nil {
+		updated.Name = name.Value
+	}
+	if url != nil {
+		updated.Url = url.Value
+	}
+	if secret != nil {
+		updated.Secret = secret.Value
+	}
+	if len(sourceTypes) > 0 {
+		updated.SourceTypes = append([]subscriptionproto.Subscription_SourceType{}, sourceTypes...)
+		sortSourceType(updated.SourceTypes)
+	}
+	if maxRetries != nil {
+		if err := validateMaxRetries(maxRetries.Value); err != nil {
+			return nil, err
+		}
+		updated.MaxRetries = maxRetries.Value
+	}
+	if disabled != nil {
+		updated.Disabled = disabled.Value
+	}
+	updated.UpdatedAt = time.Now().Unix()
+	return updated, nil
+}
+
+// Subscribes reports whether the events of the source type are delivered to the webhook.
+func (w *Webhook) Subscribes(sourceType subscriptionproto.Subscription_SourceType) bool {
+	return containsSourceType(sourceType, w.SourceTypes)
+}
+
+// WithoutSecret returns a copy of the webhook that can be stored in the domain events and audit logs.
+func (w *Webhook) WithoutSecret() *subscriptionproto.Webhook {
+	masked := proto.Clone(w.Webhook).(*subscriptionproto.Webhook)
+	masked.Secret = ""
+	return masked
+}
+
+func validateMaxRetries(maxRetries int32) error {
+	if maxRetries < 0 || maxRetries > MaxWebhookMaxRetries {
+		return ErrWebhookInvalidMaxRetries
+	}
+	return nil
+}
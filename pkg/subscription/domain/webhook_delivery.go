@@ -0,0 +1,82 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"time"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/uuid"
+	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+)
+
+type WebhookDelivery struct {
+	*subscriptionproto.WebhookDelivery
+}
+
+func NewWebhookDelivery(
+	webhookID, environmentID, eventID string,
+	sourceType subscriptionproto.Subscription_SourceType,
+	entityID, eventType, payload string,
+) (*WebhookDelivery, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookDelivery{&subscriptionproto.WebhookDelivery{
+		Id:            id.String(),
+		WebhookId:     webhookID,
+		EnvironmentId: environmentID,
+		EventId:       eventID,
+		SourceType:    sourceType,
+		EntityId:      entityID,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        subscriptionproto.WebhookDelivery_PENDING,
+		CreatedAt:     time.Now().Unix(),
+	}}, nil
+}
+
+// NewReplay returns a new delivery with the same event and payload as the delivery.
+func (d *WebhookDelivery) NewReplay() (*WebhookDelivery, error) {
+	replay, err := NewWebhookDelivery(
+		d.WebhookId,
+		d.EnvironmentId,
+		d.EventId,
+		d.SourceType,
+		d.EntityId,
+		d.EventType,
+		d.Payload,
+	)
+	if err != nil {
+		return nil, err
+	}
+	replay.ReplayedDeliveryId = d.Id
+	return replay, nil
+}
+
+// SetResult records the outcome of the last attempt.
+// The status code is 0 when no response was received.
+func (d *WebhookDelivery) SetResult(statusCode int, latency time.Duration, attempts int, err error) {
+	d.StatusCode = int32(statusCode)
+	d.LatencyMs = latency.Milliseconds()
+	d.Attempts = int32(attempts)
+	if err != nil {
+		d.Status = subscriptionproto.WebhookDelivery_FAILED
+		d.ErrorMessage = err.Error()
+		return
+	}
+	d.Status = subscriptionproto.WebhookDelivery_SUCCEEDED
+	d.ErrorMessage = ""
+}
@@ -0,0 +1,199 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+)
+
+func TestNewWebhook(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc               string
+		maxRetries         *wrapperspb.Int32Value
+		expectedMaxRetries int32
+		expectedErr        error
+	}{
+		{
+			desc:               "default max retries",
+			expectedMaxRetries: DefaultWebhookMaxRetries,
+		},
+		{
+			desc:               "no retries",
+			maxRetries:         wrapperspb.Int32(0),
+			expectedMaxRetries: 0,
+		},
+		{
+			desc:        "err: negative max retries",
+			maxRetries:  wrapperspb.Int32(-1),
+			expectedErr: ErrWebhookInvalidMaxRetries,
+		},
+		{
+			desc:        "err: too many retries",
+			maxRetries:  wrapperspb.Int32(MaxWebhookMaxRetries + 1),
+			expectedErr: ErrWebhookInvalidMaxRetries,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			actual, err := NewWebhook(
+				"env-id",
+				"name",
+				"https://example.com/hook",
+				"secret",
+				[]proto.Subscription_SourceType{
+					proto.Subscription_DOMAIN_EVENT_GOAL,
+					proto.Subscription_DOMAIN_EVENT_FEATURE,
+				},
+				p.maxRetries,
+			)
+			assert.Equal(t, p.expectedErr, err)
+			if p.expectedErr != nil {
+				return
+			}
+			assert.NotEmpty(t, actual.Id)
+			assert.Equal(t, "env-id", actual.EnvironmentId)
+			assert.Equal(t, "name", actual.Name)
+			assert.Equal(t, "https://example.com/hook", actual.Url)
+			assert.Equal(t, "secret", actual.Secret)
+			assert.Equal(t, []proto.Subscription_SourceType{
+				proto.Subscription_DOMAIN_EVENT_FEATURE,
+				proto.Subscription_DOMAIN_EVENT_GOAL,
+			}, actual.SourceTypes)
+			assert.Equal(t, p.expectedMaxRetries, actual.MaxRetries)
+			assert.False(t, actual.Disabled)
+			assert.NotZero(t, actual.CreatedAt)
+			assert.Equal(t, actual.CreatedAt, actual.UpdatedAt)
+		})
+	}
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	t.Parallel()
+	webhook, err := NewWebhook(
+		"env-id",
+		"name",
+		"https://example.com/hook",
+		"secret",
+		[]proto.Subscription_SourceType{proto.Subscription_DOMAIN_EVENT_FEATURE},
+		nil,
+	)
+	require.NoError(t, err)
+	webhook.UpdatedAt = 0
+
+	updated, err := webhook.Update(nil, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, webhook.Name, updated.Name)
+	assert.Equal(t, webhook.Url, updated.Url)
+	assert.Equal(t, webhook.Secret, updated.Secret)
+	assert.Equal(t, webhook.SourceTypes, updated.SourceTypes)
+	assert.Equal(t, webhook.MaxRetries, updated.MaxRetries)
+	assert.NotZero(t, updated.UpdatedAt)
+
+	updated, err = webhook.Update(
+		wrapperspb.String("new-name"),
+		wrapperspb.String("https://example.com/new"),
+		wrapperspb.String("new-secret"),
+		[]proto.Subscription_SourceType{proto.Subscription_DOMAIN_EVENT_SEGMENT},
+		wrapperspb.Int32(5),
+		wrapperspb.Bool(true),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "new-name", updated.Name)
+	assert.Equal(t, "https://example.com/new", updated.Url)
+	assert.Equal(t, "new-secret", updated.Secret)
+	assert.Equal(t, []proto.Subscription_SourceType{proto.Subscription_DOMAIN_EVENT_SEGMENT}, updated.SourceTypes)
+	assert.Equal(t, int32(5), updated.MaxRetries)
+	assert.True(t, updated.Disabled)
+	// The original webhook must not be changed.
+	assert.Equal(t, "name", webhook.Name)
+	assert.False(t, webhook.Disabled)
+
+	_, err = webhook.Update(nil, nil, nil, nil, wrapperspb.Int32(-1), nil)
+	assert.Equal(t, ErrWebhookInvalidMaxRetries, err)
+}
+
+func TestWebhookSubscribes(t *testing.T) {
+	t.Parallel()
+	webhook := &Webhook{&proto.Webhook{
+		SourceTypes: []proto.Subscription_SourceType{proto.Subscription_DOMAIN_EVENT_FEATURE},
+	}}
+	assert.True(t, webhook.Subscribes(proto.Subscription_DOMAIN_EVENT_FEATURE))
+	assert.False(t, webhook.Subscribes(proto.Subscription_DOMAIN_EVENT_GOAL))
+}
+
+func TestWebhookWithoutSecret(t *testing.T) {
+	t.Parallel()
+	webhook := &Webhook{&proto.Webhook{Id: "id", Secret: "secret"}}
+	masked := webhook.WithoutSecret()
+	assert.Equal(t, "id", masked.Id)
+	assert.Empty(t, masked.Secret)
+	assert.Equal(t, "secret", webhook.Secret)
+}
+
+func TestWebhookDeliveryNewReplay(t *testing.T) {
+	t.Parallel()
+	delivery, err := NewWebhookDelivery(
+		"webhook-id",
+		"env-id",
+		"event-id",
+		proto.Subscription_DOMAIN_EVENT_FEATURE,
+		"feature-id",
+		"FEATURE_CREATED",
+		`{"id":"event-id"}`,
+	)
+	require.NoError(t, err)
+	delivery.SetResult(500, time.Second, 3, errors.New("unexpected status code: 500"))
+
+	replay, err := delivery.NewReplay()
+	require.NoError(t, err)
+	assert.NotEqual(t, delivery.Id, replay.Id)
+	assert.Equal(t, delivery.Id, replay.ReplayedDeliveryId)
+	assert.Equal(t, delivery.WebhookId, replay.WebhookId)
+	assert.Equal(t, delivery.EnvironmentId, replay.EnvironmentId)
+	assert.Equal(t, delivery.EventId, replay.EventId)
+	assert.Equal(t, delivery.SourceType, replay.SourceType)
+	assert.Equal(t, delivery.EntityId, replay.EntityId)
+	assert.Equal(t, delivery.EventType, replay.EventType)
+	assert.Equal(t, delivery.Payload, replay.Payload)
+	assert.Equal(t, proto.WebhookDelivery_PENDING, replay.Status)
+	assert.Zero(t, replay.Attempts)
+}
+
+func TestWebhookDeliverySetResult(t *testing.T) {
+	t.Parallel()
+	delivery := &WebhookDelivery{&proto.WebhookDelivery{}}
+	delivery.SetResult(0, 1500*time.Millisecond, 4, errors.New("connection refused"))
+	assert.Equal(t, proto.WebhookDelivery_FAILED, delivery.Status)
+	assert.Equal(t, int32(0), delivery.StatusCode)
+	assert.Equal(t, int64(1500), delivery.LatencyMs)
+	assert.Equal(t, int32(4), delivery.Attempts)
+	assert.Equal(t, "connection refused", delivery.ErrorMessage)
+
+	delivery.SetResult(204, 20*time.Millisecond, 1, nil)
+	assert.Equal(t, proto.WebhookDelivery_SUCCEEDED, delivery.Status)
+	assert.Equal(t, int32(204), delivery.StatusCode)
+	assert.Equal(t, int64(20), delivery.LatencyMs)
+	assert.Equal(t, int32(1), delivery.Attempts)
+	assert.Empty(t, delivery.ErrorMessage)
+}
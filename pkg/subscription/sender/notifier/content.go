@@ -0,0 +1,262 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+
+	domainevent "github.com/bucketeer-io/bucketeer/v2/pkg/domainevent/domain"
+	featuredomain "github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/locale"
+	domainproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+	senderproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription/sender"
+)
+
+// content is a channel-agnostic rendering of a notification.
+// It is used by the notifiers that build their own layout from it, such as Teams and email.
+type content struct {
+	title string
+	text  string
+	color string
+	facts []fact
+	links []link
+}
+
+type fact struct {
+	name  string
+	value string
+}
+
+type link struct {
+	text string
+	url  string
+}
+
+func newContent(
+	webURL string,
+	notification *senderproto.Notification,
+	localizer locale.Localizer,
+) (*content, error) {
+	switch notification.Type {
+	case senderproto.Notification_DomainEvent:
+		return newDomainEventContent(webURL, notification.DomainEventNotification, localizer)
+	case senderproto.Notification_FeatureStale:
+		return newFeatureStaleContent(webURL, notification.FeatureStaleNotification, localizer)
+	case senderproto.Notification_FeatureRemovalDue:
+		return newFeatureRemovalDueContent(webURL, notification.FeatureRemovalDueNotification, localizer)
+	case senderproto.Notification_ExperimentRunning:
+		return newExperimentRunningContent(webURL, notification.ExperimentRunningNotification, localizer)
+	case senderproto.Notification_MauCount:
+		return newMAUCountContent(notification.MauCountNotification, localizer), nil
+	case senderproto.Notification_DemoOrganizationCreation:
+		return newDemoOrganizationCreationContent(webURL, notification.DemoOrganizationCreationNotification, localizer)
+	}
+	return nil, ErrUnknownNotification
+}
+
+func newDomainEventContent(
+	webURL string,
+	notification *senderproto.DomainEventNotification,
+	localizer locale.Localizer,
+) (*content, error) {
+	url, err := domainEventURL(webURL, notification)
+	if err != nil {
+		return nil, err
+	}
+	localizedMessage := domainevent.LocalizedMessage(notification.Type, localizer)
+	facts := []fact{
+		{name: localizer.MustLocalize(locale.NotificationEnvironment), value: notification.EnvironmentName},
+		{name: localizer.MustLocalize(locale.NotificationEntityID), value: notification.EntityId},
+	}
+	if notification.Editor != nil {
+		facts = append(facts, fact{
+			name:  localizer.MustLocalize(locale.NotificationEditor),
+			value: notification.Editor.Email,
+		})
+	}
+	return &content{
+		title: localizedMessage.Message,
+		color: "#36a64f",
+		facts: facts,
+		links: []link{{text: localizer.MustLocalize(locale.NotificationOpenLink), url: url}},
+	}, nil
+}
+
+func newFeatureStaleContent(
+	webURL string,
+	notification *senderproto.FeatureStaleNotification,
+	localizer locale.Localizer,
+) (*content, error) {
+	links := make([]link, 0, len(notification.Features))
+	for _, feature := range notification.Features {
+		url, err := domainevent.URL(
+			domainproto.Event_FEATURE,
+			webURL,
+			notification.EnvironmentUrlCode,
+			feature.Id,
+		)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, link{text: fmt.Sprintf("%s (%s)", feature.Name, feature.Id), url: url})
+	}
+	return &content{
+		title: localizer.MustLocalize(locale.NotificationFeatureStaleTitle),
+		text: localizer.MustLocalizeWithTemplate(
+			locale.NotificationFeatureStaleTemplate,
+			strconv.Itoa(featuredomain.SecondsToStale/24/60/60),
+		),
+		color: "#F4D03F",
+		facts: []fact{
+			{name: localizer.MustLocalize(locale.NotificationEnvironment), value: notification.EnvironmentName},
+		},
+		links: links,
+	}, nil
+}
+
+func newFeatureRemovalDueContent(
+	webURL string,
+	notification *senderproto.FeatureRemovalDueNotification,
+	localizer locale.Localizer,
+) (*content, error) {
+	links := make([]link, 0, len(notification.Features))
+	for _, feature := range notification.Features {
+		url, err := domainevent.URL(
+			domainproto.Event_FEATURE,
+			webURL,
+			notification.EnvironmentUrlCode,
+			feature.Id,
+		)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, link{text: fmt.Sprintf("%s (%s)", feature.Name, feature.Maintainer), url: url})
+	}
+	return &content{
+		title: localizer.MustLocalize(locale.NotificationFeatureRemovalDueTitle),
+		text:  localizer.MustLocalize(locale.NotificationFeatureRemovalDue),
+		color: "#E67E22",
+		facts: []fact{
+			{name: localizer.MustLocalize(locale.NotificationEnvironment), value: notification.EnvironmentName},
+		},
+		links: links,
+	}, nil
+}
+
+func newExperimentRunningContent(
+	webURL string,
+	notification *senderproto.ExperimentRunningNotification,
+	localizer locale.Localizer,
+) (*content, error) {
+	now := time.Now()
+	links := make([]link, 0, len(notification.Experiments))
+	for _, e := range notification.Experiments {
+		url, err := domainevent.URL(
+			domainproto.Event_EXPERIMENT,
+			webURL,
+			notification.EnvironmentUrlCode,
+			e.Id,
+		)
+		if err != nil {
+			return nil, err
+		}
+		daysLeft := localizer.MustLocalizeWithTemplate(
+			locale.NotificationExperimentDaysLeftTemplate,
+			strconv.Itoa(lastDays(now, time.Unix(e.StopAt, 0))),
+		)
+		links = append(links, link{text: fmt.Sprintf("%s (%s)", e.Name, daysLeft), url: url})
+	}
+	return &content{
+		title: localizer.MustLocalize(locale.NotificationExperimentRunningTitle),
+		text:  localizer.MustLocalize(locale.NotificationExperimentRunning),
+		color: "#3498DB",
+		facts: []fact{
+			{name: localizer.MustLocalize(locale.NotificationEnvironment), value: notification.EnvironmentName},
+		},
+		links: links,
+	}, nil
+}
+
+func newMAUCountContent(
+	notification *senderproto.MauCountNotification,
+	localizer locale.Localizer,
+) *content {
+	p := message.NewPrinter(language.English)
+	return &content{
+		title: localizer.MustLocalize(locale.NotificationMAUCountTitle),
+		text: localizer.MustLocalizeWithTemplate(
+			locale.NotificationMAUCountTemplate,
+			strconv.Itoa(int(notification.Month)),
+		),
+		color: "#3498DB",
+		facts: []fact{
+			{name: localizer.MustLocalize(locale.NotificationEnvironment), value: notification.EnvironmentName},
+			{name: localizer.MustLocalize(locale.NotificationEventCount), value: p.Sprintf("%d", notification.EventCount)},
+			{name: localizer.MustLocalize(locale.NotificationUserCount), value: p.Sprintf("%d", notification.UserCount)},
+		},
+	}
+}
+
+func newDemoOrganizationCreationContent(
+	webURL string,
+	notification *senderproto.DemoOrganizationCreationNotification,
+	localizer locale.Localizer,
+) (*content, error) {
+	url, err := domainevent.URL(
+		domainproto.Event_ORGANIZATION,
+		webURL,
+		"",
+		notification.OrganizationId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &content{
+		title: localizer.MustLocalize(locale.NotificationDemoOrganizationCreated),
+		color: "#36a64f",
+		facts: []fact{
+			{name: localizer.MustLocalize(locale.NotificationOrganizationID), value: notification.OrganizationId},
+			{name: localizer.MustLocalize(locale.NotificationOrganizationName), value: notification.OrganizationName},
+			{name: localizer.MustLocalize(locale.NotificationOwnerEmail), value: notification.OwnerEmail},
+		},
+		links: []link{{text: localizer.MustLocalize(locale.NotificationOpenLink), url: url}},
+	}, nil
+}
+
+func domainEventURL(webURL string, notification *senderproto.DomainEventNotification) (string, error) {
+	id := notification.EntityId
+	// For AutoOpsRule and ProgressiveRollout, the id in the url is the feature_id
+	if notification.EntityType == domainproto.Event_AUTOOPS_RULE ||
+		notification.EntityType == domainproto.Event_PROGRESSIVE_ROLLOUT {
+		var entityData map[string]any
+		if err := json.Unmarshal([]byte(notification.EntityData), &entityData); err == nil {
+			if featureID, ok := entityData["feature_id"].(string); ok && featureID != "" {
+				id = featureID
+			}
+		}
+	}
+	return domainevent.URL(
+		notification.EntityType,
+		webURL,
+		notification.EnvironmentUrlCode,
+		id,
+	)
+}
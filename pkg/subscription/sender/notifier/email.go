@@ -0,0 +1,170 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/email"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/locale"
+	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+	senderproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription/sender"
+)
+
+var emailTemplate = template.Must(template.New("notification").Parse(`<!DOCTYPE html>
+<html>
+<body style="font-family: sans-serif; color: #333333;">
+<div style="border-left: 4px solid {{ .Color }}; padding-left: 12px;">
+<h2>{{ .Title }}</h2>
+{{- if .Text }}
+<p>{{ .Text }}</p>
+{{- end }}
+{{- if .Facts }}
+<table>
+{{- range .Facts }}
+<tr><th style="text-align: left; padding-right: 12px;">{{ .Name }}</th><td>{{ .Value }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+{{- if .Links }}
+<ul>
+{{- range .Links }}
+<li><a href="{{ .URL }}">{{ .Text }}</a></li>
+{{- end }}
+</ul>
+{{- end }}
+</div>
+<p style="color: #999999; font-size: 12px;">{{ .Footer }}</p>
+</body>
+</html>
+`))
+
+type emailTemplateData struct {
+	Title  string
+	Text   string
+	Color  string
+	Facts  []emailTemplateFact
+	Links  []emailTemplateLink
+	Footer string
+}
+
+type emailTemplateFact struct {
+	Name  string
+	Value string
+}
+
+type emailTemplateLink struct {
+	Text string
+	URL  string
+}
+
+type emailNotifier struct {
+	webURL       string
+	emailService email.Service
+	logger       *zap.Logger
+	opts         *options
+}
+
+func NewEmailNotifier(webURL string, emailService email.Service, opts ...Option) Notifier {
+	options := defaultOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.metrics != nil {
+		registerMetrics(options.metrics)
+	}
+	return &emailNotifier{
+		webURL:       webURL,
+		emailService: emailService,
+		opts:         &options,
+		logger:       options.logger.Named("email-notifier"),
+	}
+}
+
+func (n *emailNotifier) RecipientType() subscriptionproto.Recipient_Type {
+	return subscriptionproto.Recipient_Email
+}
+
+func (n *emailNotifier) Notify(
+	ctx context.Context,
+	notification *senderproto.Notification,
+	recipient *subscriptionproto.Recipient,
+	language subscriptionproto.Recipient_Language,
+) error {
+	if recipient.Type != subscriptionproto.Recipient_Email {
+		return nil
+	}
+	receivedCounter.WithLabelValues(typeEmail).Inc()
+	if err := n.notify(ctx, notification, recipient.EmailRecipient, language); err != nil {
+		n.logger.Error("Failed to notify",
+			zap.Error(err),
+		)
+		handledCounter.WithLabelValues(typeEmail, codeFail).Inc()
+		return err
+	}
+	handledCounter.WithLabelValues(typeEmail, codeSuccess).Inc()
+	return nil
+}
+
+func (n *emailNotifier) notify(
+	ctx context.Context,
+	notification *senderproto.Notification,
+	emailRecipient *subscriptionproto.EmailRecipient,
+	language subscriptionproto.Recipient_Language,
+) error {
+	localizer, err := newLocalizer(ctx, language)
+	if err != nil {
+		return err
+	}
+	c, err := newContent(n.webURL, notification, localizer)
+	if err != nil {
+		return err
+	}
+	subject, body, err := n.render(c, localizer)
+	if err != nil {
+		return err
+	}
+	if len(emailRecipient.GetAddresses()) == 0 {
+		return nil
+	}
+	// Send a single message to all the addresses,
+	// so a retry after a failure never re-sends to an address that already got it.
+	return n.emailService.SendNotificationEmail(ctx, emailRecipient.Addresses, subject, body)
+}
+
+func (n *emailNotifier) render(c *content, localizer locale.Localizer) (string, string, error) {
+	data := emailTemplateData{
+		Title:  c.title,
+		Text:   c.text,
+		Color:  c.color,
+		Footer: localizer.MustLocalize(locale.NotificationEmailFooter),
+	}
+	for _, f := range c.facts {
+		data.Facts = append(data.Facts, emailTemplateFact{Name: f.name, Value: f.value})
+	}
+	for _, l := range c.links {
+		data.Links = append(data.Links, emailTemplateLink{Text: l.text, URL: l.url})
+	}
+	var buf bytes.Buffer
+	if err := emailTemplate.Execute(&buf, data); err != nil {
+		return "", "", err
+	}
+	subject := localizer.MustLocalizeWithTemplate(locale.NotificationEmailSubjectTemplate, c.title)
+	return subject, buf.String(), nil
+}
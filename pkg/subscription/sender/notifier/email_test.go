@@ -0,0 +1,147 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	experimentproto "github.com/bucketeer-io/bucketeer/v2/proto/experiment"
+	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+	senderproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription/sender"
+)
+
+type sentEmail struct {
+	to      []string
+	subject string
+	body    string
+}
+
+type fakeEmailService struct {
+	sent []sentEmail
+	errs map[string]error
+}
+
+func (s *fakeEmailService) SendWelcomeEmail(ctx context.Context, to string, language string) error {
+	return nil
+}
+
+func (s *fakeEmailService) SendNotificationEmail(ctx context.Context, to []string, subject, body string) error {
+	for _, address := range to {
+		if err, ok := s.errs[address]; ok {
+			return err
+		}
+	}
+	s.sent = append(s.sent, sentEmail{to: to, subject: subject, body: body})
+	return nil
+}
+
+func TestEmailNotifierNotify(t *testing.T) {
+	t.Parallel()
+
+	notification := &senderproto.Notification{
+		Type: senderproto.Notification_ExperimentRunning,
+		ExperimentRunningNotification: &senderproto.ExperimentRunningNotification{
+			EnvironmentName:    "env-name",
+			EnvironmentUrlCode: "env-url",
+			Experiments: []*experimentproto.Experiment{
+				{Id: "eid", Name: "<experiment>"},
+			},
+		},
+	}
+	patterns := []struct {
+		desc            string
+		language        subscriptionproto.Recipient_Language
+		errs            map[string]error
+		expectedSubject string
+		expectedSent    int
+		expectedErr     bool
+	}{
+		{
+			desc:            "success: english",
+			language:        subscriptionproto.Recipient_ENGLISH,
+			expectedSubject: "[Bucketeer] Running experiments",
+			expectedSent:    1,
+		},
+		{
+			desc:            "success: japanese",
+			language:        subscriptionproto.Recipient_JAPANESE,
+			expectedSubject: "[Bucketeer] 実行中のエクスペリメント",
+			expectedSent:    1,
+		},
+		{
+			desc:            "error: one of the addresses fails",
+			language:        subscriptionproto.Recipient_ENGLISH,
+			errs:            map[string]error{"a@example.com": errors.New("test")},
+			expectedSubject: "[Bucketeer] Running experiments",
+			expectedSent:    0,
+			expectedErr:     true,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			svc := &fakeEmailService{errs: p.errs}
+			n := &emailNotifier{
+				webURL:       "https://bucketeer.io",
+				emailService: svc,
+				logger:       zap.NewNop(),
+			}
+			err := n.Notify(
+				context.Background(),
+				notification,
+				&subscriptionproto.Recipient{
+					Type: subscriptionproto.Recipient_Email,
+					EmailRecipient: &subscriptionproto.EmailRecipient{
+						Addresses: []string{"a@example.com", "b@example.com"},
+					},
+				},
+				p.language,
+			)
+			assert.Equal(t, p.expectedErr, err != nil)
+			assert.Len(t, svc.sent, p.expectedSent)
+			for _, s := range svc.sent {
+				assert.Equal(t, []string{"a@example.com", "b@example.com"}, s.to)
+				assert.Equal(t, p.expectedSubject, s.subject)
+				assert.Contains(t, s.body, "env-name")
+				assert.Contains(t, s.body, `href="https://bucketeer.io/env-url/experiments/eid"`)
+				// Entity names are escaped in the HTML body.
+				assert.Contains(t, s.body, "&lt;experiment&gt;")
+			}
+		})
+	}
+}
+
+func TestEmailNotifierInvalidLanguage(t *testing.T) {
+	t.Parallel()
+	n := &emailNotifier{
+		emailService: &fakeEmailService{},
+		logger:       zap.NewNop(),
+	}
+	err := n.Notify(
+		context.Background(),
+		&senderproto.Notification{Type: senderproto.Notification_MauCount},
+		&subscriptionproto.Recipient{
+			Type:           subscriptionproto.Recipient_Email,
+			EmailRecipient: &subscriptionproto.EmailRecipient{Addresses: []string{"a@example.com"}},
+		},
+		subscriptionproto.Recipient_Language(100),
+	)
+	assert.ErrorIs(t, err, ErrInvalidLanguage)
+}
@@ -15,6 +15,8 @@
 package notifier
 
 import (
+	"sync"
+
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/bucketeer-io/bucketeer/v2/pkg/metrics"
@@ -22,11 +24,16 @@ import (
 
 const (
 	typeSlack   = "Slack"
+	typeTeams   = "MicrosoftTeams"
+	typeWebhook = "Webhook"
+	typeEmail   = "Email"
 	codeSuccess = "Success"
 	codeFail    = "Fail"
 )
 
 var (
+	registerOnce sync.Once
+
 	receivedCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: "bucketeer",
@@ -45,8 +52,10 @@ var (
 )
 
 func registerMetrics(r metrics.Registerer) {
-	r.MustRegister(
-		receivedCounter,
-		handledCounter,
-	)
+	registerOnce.Do(func() {
+		r.MustRegister(
+			receivedCounter,
+			handledCounter,
+		)
+	})
 }
@@ -55,3 +55,17 @@ func (mr *MockNotifierMockRecorder) Notify(ctx, notification, recipient, languag
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), ctx, notification, recipient, language)
 }
+
+// RecipientType mocks base method.
+func (m *MockNotifier) RecipientType() subscription.Recipient_Type {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecipientType")
+	ret0, _ := ret[0].(subscription.Recipient_Type)
+	return ret0
+}
+
+// RecipientType indicates an expected call of RecipientType.
+func (mr *MockNotifierMockRecorder) RecipientType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecipientType", reflect.TypeOf((*MockNotifier)(nil).RecipientType))
+}
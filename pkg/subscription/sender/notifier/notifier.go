@@ -17,12 +17,26 @@ package notifier
 
 import (
 	"context"
+	"errors"
 
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/locale"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/metrics"
 	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
 	senderproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription/sender"
 )
 
+var (
+	ErrUnknownNotification = errors.New("notifier: unknown notification")
+	ErrInvalidLanguage     = errors.New("notifier: invalid language")
+)
+
 type Notifier interface {
+	// RecipientType returns the recipient type the notifier delivers to.
+	// The sender uses it to select the notifier for each subscription.
+	RecipientType() subscriptionproto.Recipient_Type
 	Notify(
 		ctx context.Context,
 		notification *senderproto.Notification,
@@ -30,3 +44,45 @@ type Notifier interface {
 		language subscriptionproto.Recipient_Language,
 	) error
 }
+
+type options struct {
+	metrics metrics.Registerer
+	logger  *zap.Logger
+}
+
+var defaultOptions = options{
+	logger: zap.NewNop(),
+}
+
+type Option func(*options)
+
+func WithMetrics(r metrics.Registerer) Option {
+	return func(opts *options) {
+		opts.metrics = r
+	}
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return func(opts *options) {
+		opts.logger = logger
+	}
+}
+
+func newLocalizer(
+	ctx context.Context,
+	language subscriptionproto.Recipient_Language,
+) (locale.Localizer, error) {
+	var l string
+	switch language {
+	case subscriptionproto.Recipient_JAPANESE:
+		l = locale.Ja
+	case subscriptionproto.Recipient_ENGLISH:
+		l = locale.En
+	default:
+		return nil, ErrInvalidLanguage
+	}
+	ctx = metadata.NewIncomingContext(ctx, metadata.MD{
+		"accept-language": []string{l},
+	})
+	return locale.NewLocalizer(ctx), nil
+}
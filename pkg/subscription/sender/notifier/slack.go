@@ -16,8 +16,6 @@ package notifier
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -26,12 +24,10 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
-	"google.golang.org/grpc/metadata"
 
 	domainevent "github.com/bucketeer-io/bucketeer/v2/pkg/domainevent/domain"
 	featuredomain "github.com/bucketeer-io/bucketeer/v2/pkg/feature/domain"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/locale"
-	"github.com/bucketeer-io/bucketeer/v2/pkg/metrics"
 	subscriptiondomain "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
 	domainproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
 	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
@@ -42,34 +38,6 @@ const (
 	linkTemplate = "<%s|%s>"
 )
 
-var (
-	ErrUnknownNotification = errors.New("slacknotifier: unknown notification")
-	ErrInvalidLanguage     = errors.New("slacknotifier: invalid language")
-)
-
-type options struct {
-	metrics metrics.Registerer
-	logger  *zap.Logger
-}
-
-var defaultOptions = options{
-	logger: zap.NewNop(),
-}
-
-type Option func(*options)
-
-func WithMetrics(r metrics.Registerer) Option {
-	return func(opts *options) {
-		opts.metrics = r
-	}
-}
-
-func WithLogger(logger *zap.Logger) Option {
-	return func(opts *options) {
-		opts.logger = logger
-	}
-}
-
 type slackNotifier struct {
 	webURL string
 	logger *zap.Logger
@@ -91,6 +59,10 @@ func NewSlackNotifier(webURL string, opts ...Option) Notifier {
 	}
 }
 
+func (n *slackNotifier) RecipientType() subscriptionproto.Recipient_Type {
+	return subscriptionproto.Recipient_SlackChannel
+}
+
 func (n *slackNotifier) Notify(
 	ctx context.Context,
 	notification *senderproto.Notification,
@@ -118,7 +90,7 @@ func (n *slackNotifier) notify(
 	slackRecipient *subscriptionproto.SlackChannelRecipient,
 	language subscriptionproto.Recipient_Language,
 ) error {
-	localizer, err := n.newLocalizer(ctx, language)
+	localizer, err := newLocalizer(ctx, language)
 	if err != nil {
 		return err
 	}
@@ -133,25 +105,6 @@ func (n *slackNotifier) notify(
 	return nil
 }
 
-func (n *slackNotifier) newLocalizer(
-	ctx context.Context,
-	language subscriptionproto.Recipient_Language,
-) (locale.Localizer, error) {
-	var l string
-	switch language {
-	case subscriptionproto.Recipient_JAPANESE:
-		l = locale.Ja
-	case subscriptionproto.Recipient_ENGLISH:
-		l = locale.En
-	default:
-		return nil, ErrInvalidLanguage
-	}
-	ctx = metadata.NewIncomingContext(ctx, metadata.MD{
-		"accept-language": []string{l},
-	})
-	return locale.NewLocalizer(ctx), nil
-}
-
 func (n *slackNotifier) createMessage(
 	notification *senderproto.Notification,
 	slackRecipient *subscriptionproto.SlackChannelRecipient,
@@ -176,6 +129,8 @@ func (n *slackNotifier) createAttachment(
 		return n.createDomainEventAttachment(notification.DomainEventNotification, localizer)
 	case senderproto.Notification_FeatureStale:
 		return n.createFeatureStaleAttachment(notification.FeatureStaleNotification, localizer)
+	case senderproto.Notification_FeatureRemovalDue:
+		return n.createFeatureRemovalDueAttachment(notification.FeatureRemovalDueNotification, localizer)
 	case senderproto.Notification_ExperimentRunning:
 		return n.createExperimentRunningAttachment(notification.ExperimentRunningNotification, localizer)
 	case senderproto.Notification_MauCount:
@@ -223,25 +178,7 @@ func (n *slackNotifier) createDomainEventAttachment(
 	// handle loc if multi-lang is necessary
 	localizedMessage := domainevent.LocalizedMessage(notification.Type, localizer)
 
-	id := notification.EntityId
-
-	// For AutoOpsRule and ProgressiveRollout, the id in the url is the feature_id
-	if notification.EntityType == domainproto.Event_AUTOOPS_RULE ||
-		notification.EntityType == domainproto.Event_PROGRESSIVE_ROLLOUT {
-		var entityData map[string]any
-		if err := json.Unmarshal([]byte(notification.EntityData), &entityData); err == nil {
-			if featureID, ok := entityData["feature_id"].(string); ok && featureID != "" {
-				id = featureID
-			}
-		}
-	}
-
-	url, err := domainevent.URL(
-		notification.EntityType,
-		n.webURL,
-		notification.EnvironmentUrlCode,
-		id,
-	)
+	url, err := domainEventURL(n.webURL, notification)
 	if err != nil {
 		return nil, err
 	}
@@ -289,6 +226,36 @@ func (n *slackNotifier) createFeatureStaleAttachment(
 	return attachment, nil
 }
 
+func (n *slackNotifier) createFeatureRemovalDueAttachment(
+	notification *senderproto.FeatureRemovalDueNotification,
+	localizer locale.Localizer,
+) (*slack.Attachment, error) {
+	featureListMsg := ""
+	for _, feature := range notification.Features {
+		url, err := domainevent.URL(
+			domainproto.Event_FEATURE,
+			n.webURL,
+			notification.EnvironmentUrlCode,
+			feature.Id,
+		)
+		if err != nil {
+			return nil, err
+		}
+		newLine := "- ID: `" + feature.Id + "`, Name: *" + fmt.Sprintf(linkTemplate, url, feature.Name) + "*" +
+			", Maintainer: " + feature.Maintainer + "\n"
+		featureListMsg = featureListMsg + newLine
+	}
+	attachment := &slack.Attachment{
+		Color:      "#E67E22",
+		MarkdownIn: []string{"text"},
+		Text: localizer.MustLocalize(locale.NotificationFeatureRemovalDue) + "\n\n" +
+			"Environment: " + notification.EnvironmentName + "\n\n" +
+			"Feature flags: \n\n" +
+			featureListMsg,
+	}
+	return attachment, nil
+}
+
 func (n *slackNotifier) createExperimentRunningAttachment(
 	notification *senderproto.ExperimentRunningNotification,
 	localizer locale.Localizer,
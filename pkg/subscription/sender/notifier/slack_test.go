@@ -189,6 +189,48 @@ func TestCreateFeatureStaleAttachment(t *testing.T) {
 	}
 }
 
+func TestCreateFeatureRemovalDueAttachment(t *testing.T) {
+	t.Parallel()
+
+	patterns := []struct {
+		desc         string
+		lang         string
+		expectedText string
+	}{
+		{
+			desc:         "english",
+			lang:         locale.En,
+			expectedText: "There are feature flags past their planned removal date.",
+		},
+		{
+			desc:         "japanese",
+			lang:         locale.Ja,
+			expectedText: "削除予定日を過ぎたフィーチャーフラグがあります。",
+		},
+	}
+
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			notifier := &slackNotifier{webURL: "https://example.com", logger: zap.NewNop()}
+			attachment, err := notifier.createFeatureRemovalDueAttachment(
+				&senderproto.FeatureRemovalDueNotification{
+					EnvironmentName:    "test-env",
+					EnvironmentUrlCode: "test",
+					Features: []*featureproto.Feature{
+						{Id: "feature-id-1", Name: "feature-name-1", Maintainer: "alice@example.com"},
+					},
+				},
+				newTestLocalizer(p.lang),
+			)
+			assert.NoError(t, err)
+			assert.Contains(t, attachment.Text, p.expectedText)
+			assert.Contains(t, attachment.Text, "https://example.com/test/features/feature-id-1")
+			assert.Contains(t, attachment.Text, "alice@example.com")
+		})
+	}
+}
+
 func TestCreateExperimentRunningAttachment(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,231 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	subscriptiondomain "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
+	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+	senderproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription/sender"
+)
+
+const (
+	adaptiveCardContentType = "application/vnd.microsoft.card.adaptive"
+	adaptiveCardSchema      = "http://adaptivecards.io/schemas/adaptive-card.json"
+	adaptiveCardVersion     = "1.4"
+	teamsRequestTimeout     = 10 * time.Second
+)
+
+type teamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []teamsAttachment `json:"attachments"`
+}
+
+type teamsAttachment struct {
+	ContentType string       `json:"contentType"`
+	Content     adaptiveCard `json:"content"`
+}
+
+type adaptiveCard struct {
+	Schema  string                `json:"$schema"`
+	Type    string                `json:"type"`
+	Version string                `json:"version"`
+	Body    []adaptiveCardElement `json:"body"`
+	Actions []adaptiveCardAction  `json:"actions,omitempty"`
+}
+
+type adaptiveCardElement struct {
+	Type   string             `json:"type"`
+	Text   string             `json:"text,omitempty"`
+	Weight string             `json:"weight,omitempty"`
+	Size   string             `json:"size,omitempty"`
+	Wrap   bool               `json:"wrap,omitempty"`
+	Facts  []adaptiveCardFact `json:"facts,omitempty"`
+}
+
+type adaptiveCardFact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+type adaptiveCardAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+type teamsNotifier struct {
+	webURL     string
+	httpClient *http.Client
+	logger     *zap.Logger
+	opts       *options
+}
+
+func NewTeamsNotifier(webURL string, opts ...Option) Notifier {
+	options := defaultOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.metrics != nil {
+		registerMetrics(options.metrics)
+	}
+	return &teamsNotifier{
+		webURL:     webURL,
+		httpClient: &http.Client{Timeout: teamsRequestTimeout},
+		opts:       &options,
+		logger:     options.logger.Named("teams-notifier"),
+	}
+}
+
+func (n *teamsNotifier) RecipientType() subscriptionproto.Recipient_Type {
+	return subscriptionproto.Recipient_MicrosoftTeamsChannel
+}
+
+func (n *teamsNotifier) Notify(
+	ctx context.Context,
+	notification *senderproto.Notification,
+	recipient *subscriptionproto.Recipient,
+	language subscriptionproto.Recipient_Language,
+) error {
+	if recipient.Type != subscriptionproto.Recipient_MicrosoftTeamsChannel {
+		return nil
+	}
+	receivedCounter.WithLabelValues(typeTeams).Inc()
+	if err := n.notify(ctx, notification, recipient.MicrosoftTeamsChannelRecipient, language); err != nil {
+		n.logger.Error("Failed to notify",
+			zap.Error(err),
+		)
+		handledCounter.WithLabelValues(typeTeams, codeFail).Inc()
+		return err
+	}
+	handledCounter.WithLabelValues(typeTeams, codeSuccess).Inc()
+	return nil
+}
+
+func (n *teamsNotifier) notify(
+	ctx context.Context,
+	notification *senderproto.Notification,
+	teamsRecipient *subscriptionproto.MicrosoftTeamsChannelRecipient,
+	language subscriptionproto.Recipient_Language,
+) error {
+	localizer, err := newLocalizer(ctx, language)
+	if err != nil {
+		return err
+	}
+	c, err := newContent(n.webURL, notification, localizer)
+	if err != nil {
+		return err
+	}
+	return n.postWebhook(ctx, n.createMessage(c), teamsRecipient.WebhookUrl)
+}
+
+func (n *teamsNotifier) createMessage(c *content) *teamsMessage {
+	body := []adaptiveCardElement{
+		{
+			Type:   "TextBlock",
+			Text:   c.title,
+			Weight: "Bolder",
+			Size:   "Medium",
+			Wrap:   true,
+		},
+	}
+	if c.text != "" {
+		body = append(body, adaptiveCardElement{
+			Type: "TextBlock",
+			Text: c.text,
+			Wrap: true,
+		})
+	}
+	if len(c.facts) > 0 {
+		facts := make([]adaptiveCardFact, 0, len(c.facts))
+		for _, f := range c.facts {
+			facts = append(facts, adaptiveCardFact{Title: f.name, Value: f.value})
+		}
+		body = append(body, adaptiveCardElement{
+			Type:  "FactSet",
+			Facts: facts,
+		})
+	}
+	var actions []adaptiveCardAction
+	// A single link is rendered as a button, while a list of entities is rendered as markdown links.
+	if len(c.links) == 1 {
+		actions = append(actions, adaptiveCardAction{
+			Type:  "Action.OpenUrl",
+			Title: c.links[0].text,
+			URL:   c.links[0].url,
+		})
+	} else {
+		for _, l := range c.links {
+			body = append(body, adaptiveCardElement{
+				Type: "TextBlock",
+				Text: fmt.Sprintf("- [%s](%s)", l.text, l.url),
+				Wrap: true,
+			})
+		}
+	}
+	return &teamsMessage{
+		Type: "message",
+		Attachments: []teamsAttachment{
+			{
+				ContentType: adaptiveCardContentType,
+				Content: adaptiveCard{
+					Schema:  adaptiveCardSchema,
+					Type:    "AdaptiveCard",
+					Version: adaptiveCardVersion,
+					Body:    body,
+					Actions: actions,
+				},
+			},
+		},
+	}
+}
+
+func (n *teamsNotifier) postWebhook(ctx context.Context, msg *teamsMessage, webhookURL string) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		n.logger.Error("Failed to post a message",
+			zap.Error(err),
+			// Avoid logging a webhook URL which contains secret.
+			zap.String("teamsRecipientId", subscriptiondomain.MicrosoftTeamsChannelRecipientID(webhookURL)),
+		)
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		n.logger.Error("Unexpected status code from the Teams webhook",
+			zap.Int("statusCode", resp.StatusCode),
+			zap.String("teamsRecipientId", subscriptiondomain.MicrosoftTeamsChannelRecipientID(webhookURL)),
+		)
+		return fmt.Errorf("teamsnotifier: unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	domainproto "github.com/bucketeer-io/bucketeer/v2/proto/event/domain"
+	featureproto "github.com/bucketeer-io/bucketeer/v2/proto/feature"
+	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+	senderproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription/sender"
+)
+
+func TestTeamsNotifierNotify(t *testing.T) {
+	t.Parallel()
+
+	webURL := "https://bucketeer.io"
+	patterns := []struct {
+		desc         string
+		notification *senderproto.Notification
+		language     subscriptionproto.Recipient_Language
+		statusCode   int
+		expected     []string
+		expectedErr  bool
+	}{
+		{
+			desc: "success: domain event",
+			notification: &senderproto.Notification{
+				Type: senderproto.Notification_DomainEvent,
+				DomainEventNotification: &senderproto.DomainEventNotification{
+					EnvironmentName:    "env-name",
+					EnvironmentUrlCode: "env-url",
+					Editor:             &domainproto.Editor{Email: "editor@example.com"},
+					EntityType:         domainproto.Event_FEATURE,
+					EntityId:           "feature-id",
+					Type:               domainproto.Event_FEATURE_ENABLED,
+				},
+			},
+			language:   subscriptionproto.Recipient_ENGLISH,
+			statusCode: http.StatusOK,
+			expected: []string{
+				"Environment",
+				"env-name",
+				"feature-id",
+				"editor@example.com",
+				"Action.OpenUrl",
+				"https://bucketeer.io/env-url/features/feature-id",
+			},
+		},
+		{
+			desc: "success: feature stale in japanese",
+			notification: &senderproto.Notification{
+				Type: senderproto.Notification_FeatureStale,
+				FeatureStaleNotification: &senderproto.FeatureStaleNotification{
+					EnvironmentName:    "env-name",
+					EnvironmentUrlCode: "env-url",
+					Features: []*featureproto.Feature{
+						{Id: "fid-1", Name: "feature-1"},
+						{Id: "fid-2", Name: "feature-2"},
+					},
+				},
+			},
+			language:   subscriptionproto.Recipient_JAPANESE,
+			statusCode: http.StatusOK,
+			expected: []string{
+				"環境",
+				"[feature-1 (fid-1)](https://bucketeer.io/env-url/features/fid-1)",
+				"[feature-2 (fid-2)](https://bucketeer.io/env-url/features/fid-2)",
+			},
+		},
+		{
+			desc: "success: feature removal due",
+			notification: &senderproto.Notification{
+				Type: senderproto.Notification_FeatureRemovalDue,
+				FeatureRemovalDueNotification: &senderproto.FeatureRemovalDueNotification{
+					EnvironmentName:    "env-name",
+					EnvironmentUrlCode: "env-url",
+					Features: []*featureproto.Feature{
+						{Id: "fid-1", Name: "feature-1", Maintainer: "alice@example.com"},
+					},
+				},
+			},
+			language:   subscriptionproto.Recipient_ENGLISH,
+			statusCode: http.StatusOK,
+			expected: []string{
+				"Feature flags due for removal",
+				"env-name",
+				"feature-1 (alice@example.com)",
+				"https://bucketeer.io/env-url/features/fid-1",
+			},
+		},
+		{
+			desc: "error: unexpected status code",
+			notification: &senderproto.Notification{
+				Type: senderproto.Notification_MauCount,
+				MauCountNotification: &senderproto.MauCountNotification{
+					EnvironmentName: "env-name",
+					Month:           4,
+				},
+			},
+			language:    subscriptionproto.Recipient_ENGLISH,
+			statusCode:  http.StatusBadRequest,
+			expectedErr: true,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			var body []byte
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				msg := &teamsMessage{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(msg))
+				require.Len(t, msg.Attachments, 1)
+				assert.Equal(t, adaptiveCardContentType, msg.Attachments[0].ContentType)
+				var err error
+				body, err = json.Marshal(msg)
+				require.NoError(t, err)
+				w.WriteHeader(p.statusCode)
+			}))
+			defer srv.Close()
+
+			n := &teamsNotifier{
+				webURL:     webURL,
+				httpClient: srv.Client(),
+				logger:     zap.NewNop(),
+			}
+			err := n.Notify(
+				context.Background(),
+				p.notification,
+				&subscriptionproto.Recipient{
+					Type: subscriptionproto.Recipient_MicrosoftTeamsChannel,
+					MicrosoftTeamsChannelRecipient: &subscriptionproto.MicrosoftTeamsChannelRecipient{
+						WebhookUrl: srv.URL,
+					},
+				},
+				p.language,
+			)
+			assert.Equal(t, p.expectedErr, err != nil)
+			for _, e := range p.expected {
+				assert.Contains(t, string(body), e)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/backoff"
+	subscriptiondomain "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/webhook"
+	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+	senderproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription/sender"
+)
+
+const (
+	webhookRequestTimeout = 10 * time.Second
+	webhookMaxRetries     = 3
+	webhookBackoffBase    = time.Second
+	webhookBackoffMax     = 10 * time.Second
+)
+
+// webhookPayload is the JSON body posted to the webhook recipients.
+// The notification is encoded using protojson, so receivers can decode it using the published protos.
+type webhookPayload struct {
+	Type         string          `json:"type"`
+	Timestamp    int64           `json:"timestamp"`
+	Notification json.RawMessage `json:"notification"`
+}
+
+type webhookNotifier struct {
+	httpClient *http.Client
+	backoff    backoff.Backoff
+	maxRetries int
+	logger     *zap.Logger
+	opts       *options
+}
+
+func NewWebhookNotifier(opts ...Option) Notifier {
+	options := defaultOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.metrics != nil {
+		registerMetrics(options.metrics)
+	}
+	return &webhookNotifier{
+		httpClient: webhook.NewHTTPClient(webhookRequestTimeout),
+		backoff:    backoff.NewExponential(webhookBackoffBase, webhookBackoffMax),
+		maxRetries: webhookMaxRetries,
+		opts:       &options,
+		logger:     options.logger.Named("webhook-notifier"),
+	}
+}
+
+func (n *webhookNotifier) RecipientType() subscriptionproto.Recipient_Type {
+	return subscriptionproto.Recipient_Webhook
+}
+
+func (n *webhookNotifier) Notify(
+	ctx context.Context,
+	notification *senderproto.Notification,
+	recipient *subscriptionproto.Recipient,
+	language subscriptionproto.Recipient_Language,
+) error {
+	if recipient.Type != subscriptionproto.Recipient_Webhook {
+		return nil
+	}
+	receivedCounter.WithLabelValues(typeWebhook).Inc()
+	if err := n.notify(ctx, notification, recipient.WebhookRecipient); err != nil {
+		n.logger.Error("Failed to notify",
+			zap.Error(err),
+			// Avoid logging a webhook URL which may contain credentials.
+			zap.String("webhookRecipientId", subscriptiondomain.WebhookRecipientID(recipient.WebhookRecipient.Url)),
+		)
+		handledCounter.WithLabelValues(typeWebhook, codeFail).Inc()
+		return err
+	}
+	handledCounter.WithLabelValues(typeWebhook, codeSuccess).Inc()
+	return nil
+}
+
+func (n *webhookNotifier) notify(
+	ctx context.Context,
+	notification *senderproto.Notification,
+	webhookRecipient *subscriptionproto.WebhookRecipient,
+) error {
+	now := time.Now()
+	body, err := n.createPayload(notification, now)
+	if err != nil {
+		return err
+	}
+	var lastErr error
+	retry := backoff.NewRetry(ctx, n.maxRetries, n.backoff.Clone())
+	for retry.WaitNext() {
+		retryable, err := n.post(ctx, webhookRecipient.Url, webhookRecipient.Secret, body)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		if !retryable {
+			return err
+		}
+		n.logger.Warn("Failed to post a webhook, retrying",
+			zap.Error(err),
+			zap.Int("calls", retry.Calls()),
+		)
+	}
+	if lastErr == nil {
+		return ctx.Err()
+	}
+	return lastErr
+}
+
+func (n *webhookNotifier) createPayload(
+	notification *senderproto.Notification,
+	now time.Time,
+) ([]byte, error) {
+	data, err := protojson.Marshal(notification)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&webhookPayload{
+		Type:         notification.Type.String(),
+		Timestamp:    now.Unix(),
+		Notification: data,
+	})
+}
+
+// post returns whether the request can be retried when it fails.
+func (n *webhookNotifier) post(
+	ctx context.Context,
+	url, secret string,
+	body []byte,
+) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", webhook.UserAgent)
+	timestamp := time.Now().Unix()
+	req.Header.Set(webhook.SignatureHeader, webhook.SignaturePrefix+webhook.Sign(secret, timestamp, body))
+	req.Header.Set(webhook.TimestampHeader, strconv.FormatInt(timestamp, 10))
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		// A host which is not allowed won't become allowed on retry.
+		return !errors.Is(err, webhook.ErrDisallowedHost), err
+	}
+	defer resp.Body.Close()
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+	err = fmt.Errorf("webhooknotifier: unexpected status code: %d", resp.StatusCode)
+	retryable := resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode >= http.StatusInternalServerError
+	return retryable, err
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/backoff"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/webhook"
+	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+	senderproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription/sender"
+)
+
+func TestWebhookNotifierNotify(t *testing.T) {
+	t.Parallel()
+
+	notification := &senderproto.Notification{
+		Type: senderproto.Notification_MauCount,
+		MauCountNotification: &senderproto.MauCountNotification{
+			EnvironmentName: "env-name",
+			EventCount:      10,
+			UserCount:       5,
+			Month:           4,
+		},
+	}
+	patterns := []struct {
+		desc          string
+		statusCodes   []int
+		expectedCalls int32
+		expectedErr   bool
+	}{
+		{
+			desc:          "success",
+			statusCodes:   []int{http.StatusOK},
+			expectedCalls: 1,
+		},
+		{
+			desc:          "success: retry on server error",
+			statusCodes:   []int{http.StatusInternalServerError, http.StatusTooManyRequests, http.StatusNoContent},
+			expectedCalls: 3,
+		},
+		{
+			desc:          "error: client error is not retried",
+			statusCodes:   []int{http.StatusBadRequest},
+			expectedCalls: 1,
+			expectedErr:   true,
+		},
+		{
+			desc: "error: retries exhausted",
+			statusCodes: []int{
+				http.StatusBadGateway,
+				http.StatusBadGateway,
+				http.StatusBadGateway,
+			},
+			expectedCalls: 3,
+			expectedErr:   true,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			var calls int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				i := atomic.AddInt32(&calls, 1) - 1
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+
+				timestamp := r.Header.Get(webhook.TimestampHeader)
+				assert.NotEmpty(t, timestamp)
+				mac := hmac.New(sha256.New, []byte("secret"))
+				mac.Write([]byte(timestamp + "."))
+				mac.Write(body)
+				assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), r.Header.Get(webhook.SignatureHeader))
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+				var payload webhookPayload
+				require.NoError(t, json.Unmarshal(body, &payload))
+				assert.Equal(t, senderproto.Notification_MauCount.String(), payload.Type)
+				actual := &senderproto.Notification{}
+				require.NoError(t, protojson.Unmarshal(payload.Notification, actual))
+				assert.Equal(t, notification.MauCountNotification.EventCount, actual.MauCountNotification.EventCount)
+
+				w.WriteHeader(p.statusCodes[int(i)%len(p.statusCodes)])
+			}))
+			defer srv.Close()
+
+			n := &webhookNotifier{
+				httpClient: srv.Client(),
+				backoff:    backoff.NewConstant(0),
+				maxRetries: 3,
+				logger:     zap.NewNop(),
+			}
+			err := n.Notify(
+				context.Background(),
+				notification,
+				&subscriptionproto.Recipient{
+					Type: subscriptionproto.Recipient_Webhook,
+					WebhookRecipient: &subscriptionproto.WebhookRecipient{
+						Url:    srv.URL,
+						Secret: "secret",
+					},
+				},
+				subscriptionproto.Recipient_ENGLISH,
+			)
+			assert.Equal(t, p.expectedErr, err != nil)
+			assert.Equal(t, p.expectedCalls, atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+func TestWebhookNotifierIgnoresOtherRecipients(t *testing.T) {
+	t.Parallel()
+	n := &webhookNotifier{logger: zap.NewNop()}
+	err := n.Notify(
+		context.Background(),
+		&senderproto.Notification{},
+		&subscriptionproto.Recipient{Type: subscriptionproto.Recipient_SlackChannel},
+		subscriptionproto.Recipient_ENGLISH,
+	)
+	assert.NoError(t, err)
+}
+
+func TestWebhookNotifierDoesNotRetryDisallowedHost(t *testing.T) {
+	t.Parallel()
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	}))
+	defer srv.Close()
+	n := &webhookNotifier{
+		httpClient: webhook.NewHTTPClient(webhookRequestTimeout),
+		logger:     zap.NewNop(),
+	}
+	retryable, err := n.post(context.Background(), srv.URL, "secret", []byte("{}"))
+	assert.ErrorIs(t, err, webhook.ErrDisallowedHost)
+	assert.False(t, retryable)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+}
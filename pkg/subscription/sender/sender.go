@@ -64,7 +64,7 @@ type Sender interface {
 
 type sender struct {
 	subscriptionClient subscriptionclient.Client
-	notifiers          []notifier.Notifier
+	notifiers          map[subscriptionproto.Recipient_Type]notifier.Notifier
 	opts               *options
 	logger             *zap.Logger
 }
@@ -81,9 +81,13 @@ func NewSender(
 	if options.metrics != nil {
 		registerMetrics(options.metrics)
 	}
+	notifierMap := make(map[subscriptionproto.Recipient_Type]notifier.Notifier, len(notifiers))
+	for _, n := range notifiers {
+		notifierMap[n.RecipientType()] = n
+	}
 	return &sender{
 		subscriptionClient: subscriptionClient,
-		notifiers:          notifiers,
+		notifiers:          notifierMap,
 		opts:               &options,
 		logger:             options.logger.Named("sender"),
 	}
@@ -157,12 +161,16 @@ func (s *sender) send(
 	recipient *subscriptionproto.Recipient,
 	language subscriptionproto.Recipient_Language,
 ) error {
-	for _, notifier := range s.notifiers {
-		if err := notifier.Notify(ctx, notification, recipient, language); err != nil {
-			return err
-		}
+	notifier, ok := s.notifiers[recipient.Type]
+	if !ok {
+		// The recipient type isn't enabled in this deployment,
+		// so retrying won't help.
+		s.logger.Warn("No notifier found for the recipient type",
+			zap.String("recipientType", recipient.Type.String()),
+		)
+		return nil
 	}
-	return nil
+	return notifier.Notify(ctx, notification, recipient, language)
 }
 
 func (s *sender) listEnabledSubscriptions(
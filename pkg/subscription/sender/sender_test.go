@@ -120,6 +120,29 @@ func TestHandle(t *testing.T) {
 			},
 			expected: errors.New("test"),
 		},
+		{
+			desc: "success: skip recipient without notifier",
+			setup: func(t *testing.T, s *sender) {
+				s.subscriptionClient.(*ncmock.MockClient).EXPECT().ListEnabledSubscriptions(gomock.Any(), gomock.Any()).Return(
+					&subscriptionproto.ListEnabledSubscriptionsResponse{Subscriptions: []*subscriptionproto.Subscription{
+						{Id: "sid0", Recipient: &subscriptionproto.Recipient{
+							Type:     subscriptionproto.Recipient_Webhook,
+							Language: subscriptionproto.Recipient_ENGLISH,
+						}},
+					}}, nil)
+			},
+			input: &senderproto.NotificationEvent{
+				Id:            "id",
+				EnvironmentId: "ns0",
+				SourceType:    subscriptionproto.Subscription_DOMAIN_EVENT_ACCOUNT,
+				Notification: &senderproto.Notification{
+					Type:                    senderproto.Notification_DomainEvent,
+					DomainEventNotification: &senderproto.DomainEventNotification{},
+				},
+				IsAdminEvent: false,
+			},
+			expected: nil,
+		},
 		{
 			desc: "success: 1 subscription",
 			setup: func(t *testing.T, s *sender) {
@@ -755,6 +778,30 @@ func createSubscriptions(t *testing.T, size int) []*subscriptionproto.Subscripti
 	return subscriptions
 }
 
+func TestNewSenderSelectsNotifierByRecipientType(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	slack := nmock.NewMockNotifier(mockController)
+	slack.EXPECT().RecipientType().Return(subscriptionproto.Recipient_SlackChannel)
+	webhook := nmock.NewMockNotifier(mockController)
+	webhook.EXPECT().RecipientType().Return(subscriptionproto.Recipient_Webhook)
+	s := NewSender(
+		ncmock.NewMockClient(mockController),
+		[]notifier.Notifier{slack, webhook},
+	).(*sender)
+
+	webhook.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	err := s.send(
+		context.Background(),
+		&senderproto.Notification{},
+		&subscriptionproto.Recipient{Type: subscriptionproto.Recipient_Webhook},
+		subscriptionproto.Recipient_ENGLISH,
+	)
+	assert.NoError(t, err)
+}
+
 func createSender(t *testing.T, c *gomock.Controller) *sender {
 	ncMock := ncmock.NewMockClient(c)
 	nMock := nmock.NewMockNotifier(c)
@@ -762,7 +809,9 @@ func createSender(t *testing.T, c *gomock.Controller) *sender {
 	require.NoError(t, err)
 	return &sender{
 		subscriptionClient: ncMock,
-		notifiers:          []notifier.Notifier{nMock},
-		logger:             logger,
+		notifiers: map[subscriptionproto.Recipient_Type]notifier.Notifier{
+			subscriptionproto.Recipient_SlackChannel: nMock,
+		},
+		logger: logger,
 	}
 }
@@ -0,0 +1,278 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+
+	pb "google.golang.org/protobuf/proto"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/crypto"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+)
+
+// EncryptedSubscriptionStorage stores the recipient credentials encrypted by a SecretCipher.
+type EncryptedSubscriptionStorage interface {
+	SubscriptionStorage
+	crypto.SecretRotator
+}
+
+// EncryptedAdminSubscriptionStorage stores the recipient credentials encrypted by a SecretCipher.
+// It only rotates the secrets of the admin environment.
+type EncryptedAdminSubscriptionStorage interface {
+	AdminSubscriptionStorage
+	crypto.SecretRotator
+}
+
+type encryptedSubscriptionStorage struct {
+	SubscriptionStorage
+	cipher crypto.SecretCipher
+}
+
+type encryptedAdminSubscriptionStorage struct {
+	AdminSubscriptionStorage
+	cipher crypto.SecretCipher
+}
+
+func NewEncryptedSubscriptionStorage(
+	s SubscriptionStorage,
+	cipher crypto.SecretCipher,
+) EncryptedSubscriptionStorage {
+	return &encryptedSubscriptionStorage{SubscriptionStorage: s, cipher: cipher}
+}
+
+func NewEncryptedAdminSubscriptionStorage(
+	s AdminSubscriptionStorage,
+	cipher crypto.SecretCipher,
+) EncryptedAdminSubscriptionStorage {
+	return &encryptedAdminSubscriptionStorage{AdminSubscriptionStorage: s, cipher: cipher}
+}
+
+func (s *encryptedSubscriptionStorage) CreateSubscription(
+	ctx context.Context,
+	e *domain.Subscription,
+	environmentId string,
+) error {
+	encrypted, err := encryptSubscription(ctx, s.cipher, e, environmentId)
+	if err != nil {
+		return err
+	}
+	return s.SubscriptionStorage.CreateSubscription(ctx, encrypted, environmentId)
+}
+
+func (s *encryptedSubscriptionStorage) UpdateSubscription(
+	ctx context.Context,
+	e *domain.Subscription,
+	environmentId string,
+) error {
+	encrypted, err := encryptSubscription(ctx, s.cipher, e, environmentId)
+	if err != nil {
+		return err
+	}
+	return s.SubscriptionStorage.UpdateSubscription(ctx, encrypted, environmentId)
+}
+
+func (s *encryptedSubscriptionStorage) GetSubscription(
+	ctx context.Context,
+	id, environmentId string,
+) (*domain.Subscription, error) {
+	subscription, err := s.SubscriptionStorage.GetSubscription(ctx, id, environmentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := decryptRecipient(ctx, s.cipher, subscription.Recipient); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (s *encryptedSubscriptionStorage) ListSubscriptions(
+	ctx context.Context,
+	params ListSubscriptionsParams,
+) ([]*proto.Subscription, int, int64, error) {
+	subscriptions, nextOffset, totalCount, err := s.SubscriptionStorage.ListSubscriptions(ctx, params)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	for _, subscription := range subscriptions {
+		if err := decryptRecipient(ctx, s.cipher, subscription.Recipient); err != nil {
+			return nil, 0, 0, err
+		}
+	}
+	return subscriptions, nextOffset, totalCount, nil
+}
+
+func (s *encryptedSubscriptionStorage) RotateSecrets(ctx context.Context, environmentID string) (int, error) {
+	subscriptions, _, _, err := s.SubscriptionStorage.ListSubscriptions(ctx, ListSubscriptionsParams{
+		EnvironmentIDs: []string{environmentID},
+		PageSize:       database.QueryNoLimit,
+	})
+	if err != nil {
+		return 0, err
+	}
+	rotated := 0
+	for _, subscription := range subscriptions {
+		needsRotation, err := recipientNeedsRotation(ctx, s.cipher, environmentID, subscription.Recipient)
+		if err != nil {
+			return rotated, err
+		}
+		if !needsRotation {
+			continue
+		}
+		decrypted, err := s.GetSubscription(ctx, subscription.Id, environmentID)
+		if err != nil {
+			return rotated, err
+		}
+		if err := s.UpdateSubscription(ctx, decrypted, environmentID); err != nil {
+			return rotated, err
+		}
+		rotated++
+	}
+	return rotated, nil
+}
+
+func (s *encryptedAdminSubscriptionStorage) CreateAdminSubscription(
+	ctx context.Context,
+	e *domain.Subscription,
+) error {
+	encrypted, err := encryptSubscription(ctx, s.cipher, e, storage.AdminEnvironmentID)
+	if err != nil {
+		return err
+	}
+	return s.AdminSubscriptionStorage.CreateAdminSubscription(ctx, encrypted)
+}
+
+func (s *encryptedAdminSubscriptionStorage) UpdateAdminSubscription(
+	ctx context.Context,
+	e *domain.Subscription,
+) error {
+	encrypted, err := encryptSubscription(ctx, s.cipher, e, storage.AdminEnvironmentID)
+	if err != nil {
+		return err
+	}
+	return s.AdminSubscriptionStorage.UpdateAdminSubscription(ctx, encrypted)
+}
+
+func (s *encryptedAdminSubscriptionStorage) GetAdminSubscription(
+	ctx context.Context,
+	id string,
+) (*domain.Subscription, error) {
+	subscription, err := s.AdminSubscriptionStorage.GetAdminSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := decryptRecipient(ctx, s.cipher, subscription.Recipient); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (s *encryptedAdminSubscriptionStorage) ListAdminSubscriptions(
+	ctx context.Context,
+	params ListAdminSubscriptionsParams,
+) ([]*proto.Subscription, int, int64, error) {
+	subscriptions, nextOffset, totalCount, err := s.AdminSubscriptionStorage.ListAdminSubscriptions(ctx, params)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	for _, subscription := range subscriptions {
+		if err := decryptRecipient(ctx, s.cipher, subscription.Recipient); err != nil {
+			return nil, 0, 0, err
+		}
+	}
+	return subscriptions, nextOffset, totalCount, nil
+}
+
+func (s *encryptedAdminSubscriptionStorage) RotateSecrets(ctx context.Context, environmentID string) (int, error) {
+	if environmentID != storage.AdminEnvironmentID {
+		return 0, nil
+	}
+	subscriptions, _, _, err := s.AdminSubscriptionStorage.ListAdminSubscriptions(ctx, ListAdminSubscriptionsParams{
+		PageSize: database.QueryNoLimit,
+	})
+	if err != nil {
+		return 0, err
+	}
+	rotated := 0
+	for _, subscription := range subscriptions {
+		needsRotation, err := recipientNeedsRotation(ctx, s.cipher, environmentID, subscription.Recipient)
+		if err != nil {
+			return rotated, err
+		}
+		if !needsRotation {
+			continue
+		}
+		decrypted, err := s.GetAdminSubscription(ctx, subscription.Id)
+		if err != nil {
+			return rotated, err
+		}
+		if err := s.UpdateAdminSubscription(ctx, decrypted); err != nil {
+			return rotated, err
+		}
+		rotated++
+	}
+	return rotated, nil
+}
+
+// encryptSubscription returns a copy of the subscription with its recipient credentials encrypted,
+// so the caller's object keeps the plaintext.
+func encryptSubscription(
+	ctx context.Context,
+	cipher crypto.SecretCipher,
+	e *domain.Subscription,
+	environmentID string,
+) (*domain.Subscription, error) {
+	encrypted := &domain.Subscription{Subscription: pb.Clone(e.Subscription).(*proto.Subscription)}
+	for _, secret := range domain.RecipientSecrets(encrypted.Recipient) {
+		ciphertext, err := cipher.Encrypt(ctx, environmentID, *secret)
+		if err != nil {
+			return nil, err
+		}
+		*secret = ciphertext
+	}
+	return encrypted, nil
+}
+
+func decryptRecipient(ctx context.Context, cipher crypto.SecretCipher, recipient *proto.Recipient) error {
+	for _, secret := range domain.RecipientSecrets(recipient) {
+		plaintext, err := cipher.Decrypt(ctx, *secret)
+		if err != nil {
+			return err
+		}
+		*secret = plaintext
+	}
+	return nil
+}
+
+func recipientNeedsRotation(
+	ctx context.Context,
+	cipher crypto.SecretCipher,
+	environmentID string,
+	recipient *proto.Recipient,
+) (bool, error) {
+	for _, secret := range domain.RecipientSecrets(recipient) {
+		needsRotation, err := cipher.NeedsRotation(ctx, environmentID, *secret)
+		if err != nil {
+			return false, err
+		}
+		if needsRotation {
+			return true, nil
+		}
+	}
+	return false, nil
+}
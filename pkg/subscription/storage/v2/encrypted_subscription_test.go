@@ -0,0 +1,190 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	pb "google.golang.org/protobuf/proto"
+
+	cryptomock "github.com/bucketeer-io/bucketeer/v2/pkg/crypto/mock"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+)
+
+type fakeSubscriptionStorage struct {
+	SubscriptionStorage
+	subscriptions map[string]*proto.Subscription
+}
+
+func (s *fakeSubscriptionStorage) CreateSubscription(_ context.Context, e *domain.Subscription, _ string) error {
+	s.subscriptions[e.Id] = e.Subscription
+	return nil
+}
+
+func (s *fakeSubscriptionStorage) UpdateSubscription(_ context.Context, e *domain.Subscription, _ string) error {
+	s.subscriptions[e.Id] = e.Subscription
+	return nil
+}
+
+func (s *fakeSubscriptionStorage) GetSubscription(_ context.Context, id, _ string) (*domain.Subscription, error) {
+	return &domain.Subscription{Subscription: pb.Clone(s.subscriptions[id]).(*proto.Subscription)}, nil
+}
+
+func (s *fakeSubscriptionStorage) ListSubscriptions(
+	_ context.Context,
+	_ ListSubscriptionsParams,
+) ([]*proto.Subscription, int, int64, error) {
+	var subscriptions []*proto.Subscription
+	for _, subscription := range s.subscriptions {
+		subscriptions = append(subscriptions, pb.Clone(subscription).(*proto.Subscription))
+	}
+	return subscriptions, 0, int64(len(subscriptions)), nil
+}
+
+type fakeAdminSubscriptionStorage struct {
+	AdminSubscriptionStorage
+	subscriptions map[string]*proto.Subscription
+}
+
+func (s *fakeAdminSubscriptionStorage) UpdateAdminSubscription(_ context.Context, e *domain.Subscription) error {
+	s.subscriptions[e.Id] = e.Subscription
+	return nil
+}
+
+func (s *fakeAdminSubscriptionStorage) GetAdminSubscription(
+	_ context.Context,
+	id string,
+) (*domain.Subscription, error) {
+	return &domain.Subscription{Subscription: pb.Clone(s.subscriptions[id]).(*proto.Subscription)}, nil
+}
+
+func (s *fakeAdminSubscriptionStorage) ListAdminSubscriptions(
+	_ context.Context,
+	_ ListAdminSubscriptionsParams,
+) ([]*proto.Subscription, int, int64, error) {
+	var subscriptions []*proto.Subscription
+	for _, subscription := range s.subscriptions {
+		subscriptions = append(subscriptions, pb.Clone(subscription).(*proto.Subscription))
+	}
+	return subscriptions, 0, int64(len(subscriptions)), nil
+}
+
+func newTestSecretCipher(
+	t *testing.T,
+	mockController *gomock.Controller,
+	environmentID string,
+) *cryptomock.MockSecretCipher {
+	t.Helper()
+	cipher := cryptomock.NewMockSecretCipher(mockController)
+	cipher.EXPECT().Encrypt(gomock.Any(), environmentID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, plaintext string) (string, error) {
+			return "enc:" + plaintext, nil
+		},
+	).AnyTimes()
+	cipher.EXPECT().Decrypt(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, value string) (string, error) {
+			return strings.TrimPrefix(value, "enc:"), nil
+		},
+	).AnyTimes()
+	cipher.EXPECT().NeedsRotation(gomock.Any(), environmentID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, value string) (bool, error) {
+			return !strings.HasPrefix(value, "enc:"), nil
+		},
+	).AnyTimes()
+	return cipher
+}
+
+func newSlackSubscription(id, webhookURL string) *proto.Subscription {
+	return &proto.Subscription{
+		Id: id,
+		Recipient: &proto.Recipient{
+			Type:                  proto.Recipient_SlackChannel,
+			SlackChannelRecipient: &proto.SlackChannelRecipient{WebhookUrl: webhookURL},
+		},
+	}
+}
+
+func slackWebhookURL(s *proto.Subscription) string {
+	return s.Recipient.SlackChannelRecipient.WebhookUrl
+}
+
+func TestEncryptedSubscriptionStorageCreateAndGet(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	inner := &fakeSubscriptionStorage{subscriptions: map[string]*proto.Subscription{}}
+	s := NewEncryptedSubscriptionStorage(inner, newTestSecretCipher(t, mockController, "env-0"))
+
+	subscription := &domain.Subscription{Subscription: &proto.Subscription{
+		Id: "id-0",
+		Recipient: &proto.Recipient{
+			Type:             proto.Recipient_Webhook,
+			WebhookRecipient: &proto.WebhookRecipient{Url: "https://example.com", Secret: "secret"},
+		},
+	}}
+	require.NoError(t, s.CreateSubscription(context.Background(), subscription, "env-0"))
+	assert.Equal(t, "secret", subscription.Recipient.WebhookRecipient.Secret)
+	assert.Equal(t, "https://example.com", inner.subscriptions["id-0"].Recipient.WebhookRecipient.Url)
+	assert.Equal(t, "enc:secret", inner.subscriptions["id-0"].Recipient.WebhookRecipient.Secret)
+
+	actual, err := s.GetSubscription(context.Background(), "id-0", "env-0")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", actual.Recipient.WebhookRecipient.Secret)
+	list, _, _, err := s.ListSubscriptions(context.Background(), ListSubscriptionsParams{})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "secret", list[0].Recipient.WebhookRecipient.Secret)
+}
+
+func TestEncryptedSubscriptionStorageRotateSecrets(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	inner := &fakeSubscriptionStorage{subscriptions: map[string]*proto.Subscription{
+		"id-0": newSlackSubscription("id-0", "https://hooks.slack.com/0"),
+		"id-1": newSlackSubscription("id-1", "enc:https://hooks.slack.com/1"),
+	}}
+	s := NewEncryptedSubscriptionStorage(inner, newTestSecretCipher(t, mockController, "env-0"))
+
+	rotated, err := s.RotateSecrets(context.Background(), "env-0")
+	require.NoError(t, err)
+	assert.Equal(t, 1, rotated)
+	assert.Equal(t, "enc:https://hooks.slack.com/0", slackWebhookURL(inner.subscriptions["id-0"]))
+	assert.Equal(t, "enc:https://hooks.slack.com/1", slackWebhookURL(inner.subscriptions["id-1"]))
+}
+
+func TestEncryptedAdminSubscriptionStorageRotateSecrets(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	inner := &fakeAdminSubscriptionStorage{subscriptions: map[string]*proto.Subscription{
+		"id-0": newSlackSubscription("id-0", "https://hooks.slack.com/0"),
+	}}
+	s := NewEncryptedAdminSubscriptionStorage(inner, newTestSecretCipher(t, mockController, ""))
+
+	rotated, err := s.RotateSecrets(context.Background(), "env-0")
+	require.NoError(t, err)
+	assert.Equal(t, 0, rotated)
+	rotated, err = s.RotateSecrets(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, rotated)
+	assert.Equal(t, "enc:https://hooks.slack.com/0", slackWebhookURL(inner.subscriptions["id-0"]))
+}
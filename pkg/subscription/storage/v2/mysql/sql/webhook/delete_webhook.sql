@@ -0,0 +1,5 @@
+DELETE FROM
+    webhook
+WHERE
+    id = ? AND
+    environment_id = ?
@@ -0,0 +1,12 @@
+INSERT INTO webhook (
+    id,
+    environment_id,
+    name,
+    url,
+    secret,
+    source_types,
+    max_retries,
+    disabled,
+    created_at,
+    updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
@@ -0,0 +1,11 @@
+UPDATE webhook SET
+    name = ?,
+    url = ?,
+    secret = ?,
+    source_types = ?,
+    max_retries = ?,
+    disabled = ?,
+    updated_at = ?
+WHERE
+    id = ? AND
+    environment_id = ?
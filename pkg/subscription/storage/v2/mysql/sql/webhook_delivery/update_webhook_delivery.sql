@@ -0,0 +1,9 @@
+UPDATE webhook_delivery SET
+    status = ?,
+    status_code = ?,
+    latency_ms = ?,
+    attempts = ?,
+    error_message = ?
+WHERE
+    id = ? AND
+    environment_id = ?
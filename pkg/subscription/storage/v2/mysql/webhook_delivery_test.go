@@ -0,0 +1,305 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/mysql"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/mysql/mock"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
+	v2ns "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+)
+
+func TestNewWebhookDeliveryStorage(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	storage := NewWebhookDeliveryStorage(mock.NewMockQueryExecer(mockController))
+	assert.IsType(t, &webhookDeliveryStorage{}, storage)
+}
+
+func TestCreateWebhookDelivery(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	delivery := &domain.WebhookDelivery{WebhookDelivery: &proto.WebhookDelivery{
+		Id:            "id-0",
+		WebhookId:     "webhook-0",
+		EnvironmentId: "env-0",
+		EventId:       "event-0",
+		SourceType:    proto.Subscription_DOMAIN_EVENT_FEATURE,
+		EntityId:      "feature-0",
+		EventType:     "FEATURE_CREATED",
+		Payload:       "{}",
+		Status:        proto.WebhookDelivery_FAILED,
+		StatusCode:    500,
+		LatencyMs:     12,
+		Attempts:      2,
+		ErrorMessage:  "unexpected status code: 500",
+		CreatedAt:     1,
+	}}
+	patterns := []struct {
+		desc        string
+		setup       func(*webhookDeliveryStorage)
+		expectedErr error
+	}{
+		{
+			desc: "ErrWebhookDeliveryAlreadyExists",
+			setup: func(s *webhookDeliveryStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, mysql.ErrDuplicateEntry)
+			},
+			expectedErr: v2ns.ErrWebhookDeliveryAlreadyExists,
+		},
+		{
+			desc: "Error",
+			setup: func(s *webhookDeliveryStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errors.New("error"))
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "Success",
+			setup: func(s *webhookDeliveryStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(),
+					insertWebhookDeliverySQL,
+					"id-0", "webhook-0", "env-0", "event-0",
+					int32(proto.Subscription_DOMAIN_EVENT_FEATURE), "feature-0", "FEATURE_CREATED", "{}",
+					int32(proto.WebhookDelivery_FAILED), int32(500), int64(12), int32(2),
+					"unexpected status code: 500", "", int64(1),
+				).Return(nil, nil)
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newWebhookDeliveryStorageWithMock(t, mockController)
+			p.setup(storage)
+			err := storage.CreateWebhookDelivery(context.Background(), delivery)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestUpdateWebhookDelivery(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	delivery := &domain.WebhookDelivery{WebhookDelivery: &proto.WebhookDelivery{
+		Id:            "id-0",
+		WebhookId:     "webhook-0",
+		EnvironmentId: "env-0",
+		Status:        proto.WebhookDelivery_FAILED,
+		StatusCode:    500,
+		LatencyMs:     12,
+		Attempts:      2,
+		ErrorMessage:  "unexpected status code: 500",
+	}}
+	patterns := []struct {
+		desc        string
+		setup       func(*webhookDeliveryStorage)
+		expectedErr error
+	}{
+		{
+			desc: "ErrWebhookDeliveryUnexpectedAffectedRows",
+			setup: func(s *webhookDeliveryStorage) {
+				result := mock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(0), nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+			},
+			expectedErr: v2ns.ErrWebhookDeliveryUnexpectedAffectedRows,
+		},
+		{
+			desc: "Error",
+			setup: func(s *webhookDeliveryStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errors.New("error"))
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "Success",
+			setup: func(s *webhookDeliveryStorage) {
+				result := mock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(1), nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(),
+					updateWebhookDeliverySQL,
+					int32(proto.WebhookDelivery_FAILED), int32(500), int64(12), int32(2),
+					"unexpected status code: 500", "id-0", "env-0",
+				).Return(result, nil)
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newWebhookDeliveryStorageWithMock(t, mockController)
+			p.setup(storage)
+			err := storage.UpdateWebhookDelivery(context.Background(), delivery)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestGetWebhookDelivery(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*webhookDeliveryStorage)
+		expectedErr error
+	}{
+		{
+			desc: "ErrWebhookDeliveryNotFound",
+			setup: func(s *webhookDeliveryStorage) {
+				row := mock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(mysql.ErrNoRows)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+			},
+			expectedErr: v2ns.ErrWebhookDeliveryNotFound,
+		},
+		{
+			desc: "Error",
+			setup: func(s *webhookDeliveryStorage) {
+				row := mock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(errors.New("error"))
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "Success",
+			setup: func(s *webhookDeliveryStorage) {
+				row := mock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), selectWebhookDeliverySQL, "id-0", "env-0",
+				).Return(row)
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newWebhookDeliveryStorageWithMock(t, mockController)
+			p.setup(storage)
+			_, err := storage.GetWebhookDelivery(context.Background(), "id-0", "env-0")
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc           string
+		setup          func(*webhookDeliveryStorage)
+		params         v2ns.ListWebhookDeliveriesParams
+		expectedCount  int
+		expectedCursor int
+		expectedErr    error
+	}{
+		{
+			desc:        "ErrInvalidCursor",
+			params:      v2ns.ListWebhookDeliveriesParams{Cursor: "invalid"},
+			expectedErr: v2ns.ErrInvalidCursor,
+		},
+		{
+			desc: "Error",
+			setup: func(s *webhookDeliveryStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errors.New("error"))
+			},
+			params:      v2ns.ListWebhookDeliveriesParams{EnvironmentID: "env-0"},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "Success",
+			setup: func(s *webhookDeliveryStorage) {
+				rows := mock.NewMockRows(mockController)
+				rows.EXPECT().Close().Return(nil)
+				rows.EXPECT().Next().Return(true)
+				rows.EXPECT().Scan(gomock.Any()).Return(nil)
+				rows.EXPECT().Next().Return(false)
+				rows.EXPECT().Err().Return(nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryContext(
+					gomock.Any(), gomock.Any(), "env-0", "webhook-0", int32(proto.WebhookDelivery_FAILED),
+				).Return(rows, nil)
+				row := mock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), "env-0", "webhook-0", int32(proto.WebhookDelivery_FAILED),
+				).Return(row)
+			},
+			params: v2ns.ListWebhookDeliveriesParams{
+				EnvironmentID: "env-0",
+				WebhookID:     "webhook-0",
+				Status:        proto.WebhookDelivery_FAILED,
+				PageSize:      10,
+			},
+			expectedCount:  1,
+			expectedCursor: 1,
+			expectedErr:    nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newWebhookDeliveryStorageWithMock(t, mockController)
+			if p.setup != nil {
+				p.setup(storage)
+			}
+			deliveries, cursor, _, err := storage.ListWebhookDeliveries(context.Background(), p.params)
+			assert.Equal(t, p.expectedCount, len(deliveries))
+			assert.Equal(t, p.expectedCursor, cursor)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func newWebhookDeliveryStorageWithMock(
+	t *testing.T,
+	mockController *gomock.Controller,
+) *webhookDeliveryStorage {
+	t.Helper()
+	return &webhookDeliveryStorage{mock.NewMockQueryExecer(mockController)}
+}
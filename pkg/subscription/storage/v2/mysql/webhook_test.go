@@ -0,0 +1,353 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/mysql"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/mysql/mock"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
+	v2ns "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+)
+
+func TestNewWebhookStorage(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	storage := NewWebhookStorage(mock.NewMockQueryExecer(mockController))
+	assert.IsType(t, &webhookStorage{}, storage)
+}
+
+func TestCreateWebhook(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	sourceTypes := []proto.Subscription_SourceType{proto.Subscription_DOMAIN_EVENT_FEATURE}
+	webhook := &domain.Webhook{Webhook: &proto.Webhook{
+		Id:            "id-0",
+		EnvironmentId: "env-0",
+		Name:          "name-0",
+		Url:           "https://example.com/hook",
+		Secret:        "secret",
+		SourceTypes:   sourceTypes,
+		MaxRetries:    3,
+		CreatedAt:     1,
+		UpdatedAt:     2,
+	}}
+	patterns := []struct {
+		desc        string
+		setup       func(*webhookStorage)
+		expectedErr error
+	}{
+		{
+			desc: "ErrWebhookAlreadyExists",
+			setup: func(s *webhookStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, mysql.ErrDuplicateEntry)
+			},
+			expectedErr: v2ns.ErrWebhookAlreadyExists,
+		},
+		{
+			desc: "Error",
+			setup: func(s *webhookStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errors.New("error"))
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "Success",
+			setup: func(s *webhookStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(),
+					insertWebhookSQL,
+					"id-0", "env-0", "name-0", "https://example.com/hook", "secret",
+					mysql.JSONObject{Val: sourceTypes}, int32(3), false, int64(1), int64(2),
+				).Return(nil, nil)
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newWebhookStorageWithMock(t, mockController)
+			p.setup(storage)
+			err := storage.CreateWebhook(context.Background(), webhook)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	sourceTypes := []proto.Subscription_SourceType{proto.Subscription_DOMAIN_EVENT_FEATURE}
+	webhook := &domain.Webhook{Webhook: &proto.Webhook{
+		Id:            "id-0",
+		EnvironmentId: "env-0",
+		Name:          "name-0",
+		Url:           "https://example.com/hook",
+		Secret:        "secret",
+		SourceTypes:   sourceTypes,
+		MaxRetries:    3,
+		Disabled:      true,
+		CreatedAt:     1,
+		UpdatedAt:     2,
+	}}
+	patterns := []struct {
+		desc        string
+		setup       func(*webhookStorage)
+		expectedErr error
+	}{
+		{
+			desc: "ErrWebhookUnexpectedAffectedRows",
+			setup: func(s *webhookStorage) {
+				result := mock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(0), nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+			},
+			expectedErr: v2ns.ErrWebhookUnexpectedAffectedRows,
+		},
+		{
+			desc: "Error",
+			setup: func(s *webhookStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errors.New("error"))
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "Success",
+			setup: func(s *webhookStorage) {
+				result := mock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(1), nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(),
+					updateWebhookSQL,
+					"name-0", "https://example.com/hook", "secret",
+					mysql.JSONObject{Val: sourceTypes}, int32(3), true, int64(2), "id-0", "env-0",
+				).Return(result, nil)
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newWebhookStorageWithMock(t, mockController)
+			p.setup(storage)
+			err := storage.UpdateWebhook(context.Background(), webhook)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*webhookStorage)
+		expectedErr error
+	}{
+		{
+			desc: "ErrWebhookUnexpectedAffectedRows",
+			setup: func(s *webhookStorage) {
+				result := mock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(0), nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+			},
+			expectedErr: v2ns.ErrWebhookUnexpectedAffectedRows,
+		},
+		{
+			desc: "Error",
+			setup: func(s *webhookStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errors.New("error"))
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "Success",
+			setup: func(s *webhookStorage) {
+				result := mock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(1), nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), deleteWebhookSQL, "id-0", "env-0",
+				).Return(result, nil)
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newWebhookStorageWithMock(t, mockController)
+			p.setup(storage)
+			err := storage.DeleteWebhook(context.Background(), "id-0", "env-0")
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestGetWebhook(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*webhookStorage)
+		expectedErr error
+	}{
+		{
+			desc: "ErrWebhookNotFound",
+			setup: func(s *webhookStorage) {
+				row := mock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(mysql.ErrNoRows)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+			},
+			expectedErr: v2ns.ErrWebhookNotFound,
+		},
+		{
+			desc: "Error",
+			setup: func(s *webhookStorage) {
+				row := mock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(errors.New("error"))
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(row)
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "Success",
+			setup: func(s *webhookStorage) {
+				row := mock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), selectWebhookSQL, "id-0", "env-0",
+				).Return(row)
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newWebhookStorageWithMock(t, mockController)
+			p.setup(storage)
+			_, err := storage.GetWebhook(context.Background(), "id-0", "env-0")
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func TestListWebhooks(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	disabled := false
+	patterns := []struct {
+		desc           string
+		setup          func(*webhookStorage)
+		params         v2ns.ListWebhooksParams
+		expectedCount  int
+		expectedCursor int
+		expectedErr    error
+	}{
+		{
+			desc:        "ErrInvalidCursor",
+			params:      v2ns.ListWebhooksParams{Cursor: "invalid"},
+			expectedErr: v2ns.ErrInvalidCursor,
+		},
+		{
+			desc: "Error",
+			setup: func(s *webhookStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errors.New("error"))
+			},
+			params:      v2ns.ListWebhooksParams{EnvironmentID: "env-0"},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "Success",
+			setup: func(s *webhookStorage) {
+				rows := mock.NewMockRows(mockController)
+				rows.EXPECT().Close().Return(nil)
+				rows.EXPECT().Next().Return(true)
+				rows.EXPECT().Scan(gomock.Any()).Return(nil)
+				rows.EXPECT().Next().Return(false)
+				rows.EXPECT().Err().Return(nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryContext(
+					gomock.Any(), gomock.Any(), "env-0", false, "%hook%", "%hook%",
+				).Return(rows, nil)
+				row := mock.NewMockRow(mockController)
+				row.EXPECT().Scan(gomock.Any()).Return(nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
+					gomock.Any(), gomock.Any(), "env-0", false, "%hook%", "%hook%",
+				).Return(row)
+			},
+			params: v2ns.ListWebhooksParams{
+				EnvironmentID: "env-0",
+				Disabled:      &disabled,
+				SearchKeyword: "hook",
+				PageSize:      10,
+				Cursor:        "5",
+			},
+			expectedCount:  1,
+			expectedCursor: 6,
+			expectedErr:    nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newWebhookStorageWithMock(t, mockController)
+			if p.setup != nil {
+				p.setup(storage)
+			}
+			webhooks, cursor, _, err := storage.ListWebhooks(context.Background(), p.params)
+			assert.Equal(t, p.expectedCount, len(webhooks))
+			assert.Equal(t, p.expectedCursor, cursor)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
+func newWebhookStorageWithMock(t *testing.T, mockController *gomock.Controller) *webhookStorage {
+	t.Helper()
+	return &webhookStorage{mock.NewMockQueryExecer(mockController)}
+}
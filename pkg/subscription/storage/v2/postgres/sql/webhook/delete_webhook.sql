@@ -0,0 +1,5 @@
+DELETE FROM
+    webhook
+WHERE
+    id = $1 AND
+    environment_id = $2
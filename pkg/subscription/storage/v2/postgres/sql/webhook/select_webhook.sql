@@ -0,0 +1,16 @@
+SELECT
+    id,
+    environment_id,
+    name,
+    url,
+    secret,
+    source_types,
+    max_retries,
+    disabled,
+    created_at,
+    updated_at
+FROM
+    webhook
+WHERE
+    id = $1 AND
+    environment_id = $2
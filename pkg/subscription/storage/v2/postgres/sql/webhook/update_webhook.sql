@@ -0,0 +1,11 @@
+UPDATE webhook SET
+    name = $1,
+    url = $2,
+    secret = $3,
+    source_types = $4,
+    max_retries = $5,
+    disabled = $6,
+    updated_at = $7
+WHERE
+    id = $8 AND
+    environment_id = $9
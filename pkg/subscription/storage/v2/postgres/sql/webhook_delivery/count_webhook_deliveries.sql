@@ -0,0 +1,4 @@
+SELECT
+    COUNT(1)
+FROM
+    webhook_delivery
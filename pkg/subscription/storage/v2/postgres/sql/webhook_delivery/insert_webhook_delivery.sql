@@ -0,0 +1,17 @@
+INSERT INTO webhook_delivery (
+    id,
+    webhook_id,
+    environment_id,
+    event_id,
+    source_type,
+    entity_id,
+    event_type,
+    payload,
+    status,
+    status_code,
+    latency_ms,
+    attempts,
+    error_message,
+    replayed_delivery_id,
+    created_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
@@ -0,0 +1,18 @@
+SELECT
+    id,
+    webhook_id,
+    environment_id,
+    event_id,
+    source_type,
+    entity_id,
+    event_type,
+    payload,
+    status,
+    status_code,
+    latency_ms,
+    attempts,
+    error_message,
+    replayed_delivery_id,
+    created_at
+FROM
+    webhook_delivery
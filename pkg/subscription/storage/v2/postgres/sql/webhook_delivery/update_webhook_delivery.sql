@@ -0,0 +1,9 @@
+UPDATE webhook_delivery SET
+    status = $1,
+    status_code = $2,
+    latency_ms = $3,
+    attempts = $4,
+    error_message = $5
+WHERE
+    id = $6 AND
+    environment_id = $7
@@ -0,0 +1,246 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"strconv"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
+	v2ns "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+)
+
+var (
+	//go:embed sql/webhook/insert_webhook.sql
+	insertWebhookSQL string
+	//go:embed sql/webhook/update_webhook.sql
+	updateWebhookSQL string
+	//go:embed sql/webhook/delete_webhook.sql
+	deleteWebhookSQL string
+	//go:embed sql/webhook/select_webhook.sql
+	selectWebhookSQL string
+	//go:embed sql/webhook/select_webhooks.sql
+	selectWebhooksSQL string
+	//go:embed sql/webhook/count_webhooks.sql
+	countWebhooksSQL string
+)
+
+type webhookStorage struct {
+	qe postgres.QueryExecer
+}
+
+func NewWebhookStorage(qe postgres.QueryExecer) v2ns.WebhookStorage {
+	return &webhookStorage{qe}
+}
+
+func (s *webhookStorage) CreateWebhook(ctx context.Context, w *domain.Webhook) error {
+	_, err := s.qe.ExecContext(
+		ctx,
+		insertWebhookSQL,
+		w.Id,
+		w.EnvironmentId,
+		w.Name,
+		w.Url,
+		w.Secret,
+		postgres.JSONObject{Val: w.SourceTypes},
+		w.MaxRetries,
+		w.Disabled,
+		w.CreatedAt,
+		w.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, postgres.ErrDuplicateEntry) {
+			return v2ns.ErrWebhookAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *webhookStorage) UpdateWebhook(ctx context.Context, w *domain.Webhook) error {
+	result, err := s.qe.ExecContext(
+		ctx,
+		updateWebhookSQL,
+		w.Name,
+		w.Url,
+		w.Secret,
+		postgres.JSONObject{Val: w.SourceTypes},
+		w.MaxRetries,
+		w.Disabled,
+		w.UpdatedAt,
+		w.Id,
+		w.EnvironmentId,
+	)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return v2ns.ErrWebhookUnexpectedAffectedRows
+	}
+	return nil
+}
+
+func (s *webhookStorage) DeleteWebhook(ctx context.Context, id, environmentID string) error {
+	result, err := s.qe.ExecContext(
+		ctx,
+		deleteWebhookSQL,
+		id,
+		environmentID,
+	)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return v2ns.ErrWebhookUnexpectedAffectedRows
+	}
+	return nil
+}
+
+func (s *webhookStorage) GetWebhook(
+	ctx context.Context,
+	id, environmentID string,
+) (*domain.Webhook, error) {
+	webhook := proto.Webhook{}
+	err := s.qe.QueryRowContext(
+		ctx,
+		selectWebhookSQL,
+		id,
+		environmentID,
+	).Scan(
+		&webhook.Id,
+		&webhook.EnvironmentId,
+		&webhook.Name,
+		&webhook.Url,
+		&webhook.Secret,
+		&postgres.JSONObject{Val: &webhook.SourceTypes},
+		&webhook.MaxRetries,
+		&webhook.Disabled,
+		&webhook.CreatedAt,
+		&webhook.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, v2ns.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	return &domain.Webhook{Webhook: &webhook}, nil
+}
+
+func (s *webhookStorage) ListWebhooks(
+	ctx context.Context,
+	params v2ns.ListWebhooksParams,
+) ([]*proto.Webhook, int, int64, error) {
+	options, err := listWebhooksOptions(params)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	query, whereArgs := postgres.ConstructQueryAndWhereArgs(selectWebhooksSQL, options)
+	rows, err := s.qe.QueryContext(ctx, query, whereArgs...)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	defer rows.Close()
+	webhooks := make([]*proto.Webhook, 0, options.Limit)
+	for rows.Next() {
+		webhook := proto.Webhook{}
+		err := rows.Scan(
+			&webhook.Id,
+			&webhook.EnvironmentId,
+			&webhook.Name,
+			&webhook.Url,
+			&webhook.Secret,
+			&postgres.JSONObject{Val: &webhook.SourceTypes},
+			&webhook.MaxRetries,
+			&webhook.Disabled,
+			&webhook.CreatedAt,
+			&webhook.UpdatedAt,
+		)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		webhooks = append(webhooks, &webhook)
+	}
+	if rows.Err() != nil {
+		return nil, 0, 0, rows.Err()
+	}
+	nextOffset := options.Offset + len(webhooks)
+	var totalCount int64
+	countQuery, countWhereArgs := postgres.ConstructCountQuery(countWebhooksSQL, options)
+	err = s.qe.QueryRowContext(ctx, countQuery, countWhereArgs...).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return webhooks, nextOffset, totalCount, nil
+}
+
+func listWebhooksOptions(params v2ns.ListWebhooksParams) (*postgres.ListOptions, error) {
+	var filters []*postgres.Filter
+	if params.EnvironmentID != "" {
+		filters = append(filters, &postgres.Filter{
+			Column:   "environment_id",
+			Operator: postgres.OperatorEqual,
+			Value:    params.EnvironmentID,
+		})
+	}
+	if params.Disabled != nil {
+		filters = append(filters, &postgres.Filter{
+			Column:   "disabled",
+			Operator: postgres.OperatorEqual,
+			Value:    *params.Disabled,
+		})
+	}
+	var searchQuery *postgres.SearchQuery
+	if params.SearchKeyword != "" {
+		searchQuery = &postgres.SearchQuery{
+			Columns: []string{"name", "url"},
+			Keyword: params.SearchKeyword,
+		}
+	}
+	offset, err := cursorToOffset(params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	return &postgres.ListOptions{
+		Limit:       int(params.PageSize),
+		Offset:      offset,
+		Filters:     filters,
+		SearchQuery: searchQuery,
+		Orders:      []*postgres.Order{postgres.NewOrder("name", postgres.OrderDirectionAsc)},
+	}, nil
+}
+
+func cursorToOffset(cursor string) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	offset, err := strconv.Atoi(cursor)
+	if err != nil {
+		return 0, v2ns.ErrInvalidCursor
+	}
+	return offset, nil
+}
@@ -0,0 +1,236 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
+	v2ns "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+)
+
+var (
+	//go:embed sql/webhook_delivery/insert_webhook_delivery.sql
+	insertWebhookDeliverySQL string
+	//go:embed sql/webhook_delivery/update_webhook_delivery.sql
+	updateWebhookDeliverySQL string
+	//go:embed sql/webhook_delivery/select_webhook_delivery.sql
+	selectWebhookDeliverySQL string
+	//go:embed sql/webhook_delivery/select_webhook_deliveries.sql
+	selectWebhookDeliveriesSQL string
+	//go:embed sql/webhook_delivery/count_webhook_deliveries.sql
+	countWebhookDeliveriesSQL string
+)
+
+type webhookDeliveryStorage struct {
+	qe postgres.QueryExecer
+}
+
+func NewWebhookDeliveryStorage(qe postgres.QueryExecer) v2ns.WebhookDeliveryStorage {
+	return &webhookDeliveryStorage{qe}
+}
+
+func (s *webhookDeliveryStorage) CreateWebhookDelivery(
+	ctx context.Context,
+	d *domain.WebhookDelivery,
+) error {
+	_, err := s.qe.ExecContext(
+		ctx,
+		insertWebhookDeliverySQL,
+		d.Id,
+		d.WebhookId,
+		d.EnvironmentId,
+		d.EventId,
+		int32(d.SourceType),
+		d.EntityId,
+		d.EventType,
+		d.Payload,
+		int32(d.Status),
+		d.StatusCode,
+		d.LatencyMs,
+		d.Attempts,
+		d.ErrorMessage,
+		d.ReplayedDeliveryId,
+		d.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, postgres.ErrDuplicateEntry) {
+			return v2ns.ErrWebhookDeliveryAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *webhookDeliveryStorage) UpdateWebhookDelivery(
+	ctx context.Context,
+	d *domain.WebhookDelivery,
+) error {
+	result, err := s.qe.ExecContext(
+		ctx,
+		updateWebhookDeliverySQL,
+		int32(d.Status),
+		d.StatusCode,
+		d.LatencyMs,
+		d.Attempts,
+		d.ErrorMessage,
+		d.Id,
+		d.EnvironmentId,
+	)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return v2ns.ErrWebhookDeliveryUnexpectedAffectedRows
+	}
+	return nil
+}
+
+func (s *webhookDeliveryStorage) GetWebhookDelivery(
+	ctx context.Context,
+	id, environmentID string,
+) (*domain.WebhookDelivery, error) {
+	delivery := proto.WebhookDelivery{}
+	var sourceType, status int32
+	err := s.qe.QueryRowContext(
+		ctx,
+		selectWebhookDeliverySQL,
+		id,
+		environmentID,
+	).Scan(
+		&delivery.Id,
+		&delivery.WebhookId,
+		&delivery.EnvironmentId,
+		&delivery.EventId,
+		&sourceType,
+		&delivery.EntityId,
+		&delivery.EventType,
+		&delivery.Payload,
+		&status,
+		&delivery.StatusCode,
+		&delivery.LatencyMs,
+		&delivery.Attempts,
+		&delivery.ErrorMessage,
+		&delivery.ReplayedDeliveryId,
+		&delivery.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, v2ns.ErrWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+	delivery.SourceType = proto.Subscription_SourceType(sourceType)
+	delivery.Status = proto.WebhookDelivery_Status(status)
+	return &domain.WebhookDelivery{WebhookDelivery: &delivery}, nil
+}
+
+func (s *webhookDeliveryStorage) ListWebhookDeliveries(
+	ctx context.Context,
+	params v2ns.ListWebhookDeliveriesParams,
+) ([]*proto.WebhookDelivery, int, int64, error) {
+	options, err := listWebhookDeliveriesOptions(params)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	query, whereArgs := postgres.ConstructQueryAndWhereArgs(selectWebhookDeliveriesSQL, options)
+	rows, err := s.qe.QueryContext(ctx, query, whereArgs...)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	defer rows.Close()
+	deliveries := make([]*proto.WebhookDelivery, 0, options.Limit)
+	for rows.Next() {
+		delivery := proto.WebhookDelivery{}
+		var sourceType, status int32
+		err := rows.Scan(
+			&delivery.Id,
+			&delivery.WebhookId,
+			&delivery.EnvironmentId,
+			&delivery.EventId,
+			&sourceType,
+			&delivery.EntityId,
+			&delivery.EventType,
+			&delivery.Payload,
+			&status,
+			&delivery.StatusCode,
+			&delivery.LatencyMs,
+			&delivery.Attempts,
+			&delivery.ErrorMessage,
+			&delivery.ReplayedDeliveryId,
+			&delivery.CreatedAt,
+		)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		delivery.SourceType = proto.Subscription_SourceType(sourceType)
+		delivery.Status = proto.WebhookDelivery_Status(status)
+		deliveries = append(deliveries, &delivery)
+	}
+	if rows.Err() != nil {
+		return nil, 0, 0, rows.Err()
+	}
+	nextOffset := options.Offset + len(deliveries)
+	var totalCount int64
+	countQuery, countWhereArgs := postgres.ConstructCountQuery(countWebhookDeliveriesSQL, options)
+	err = s.qe.QueryRowContext(ctx, countQuery, countWhereArgs...).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return deliveries, nextOffset, totalCount, nil
+}
+
+func listWebhookDeliveriesOptions(params v2ns.ListWebhookDeliveriesParams) (*postgres.ListOptions, error) {
+	var filters []*postgres.Filter
+	if params.EnvironmentID != "" {
+		filters = append(filters, &postgres.Filter{
+			Column:   "environment_id",
+			Operator: postgres.OperatorEqual,
+			Value:    params.EnvironmentID,
+		})
+	}
+	if params.WebhookID != "" {
+		filters = append(filters, &postgres.Filter{
+			Column:   "webhook_id",
+			Operator: postgres.OperatorEqual,
+			Value:    params.WebhookID,
+		})
+	}
+	if params.Status != proto.WebhookDelivery_UNKNOWN {
+		filters = append(filters, &postgres.Filter{
+			Column:   "status",
+			Operator: postgres.OperatorEqual,
+			Value:    int32(params.Status),
+		})
+	}
+	offset, err := cursorToOffset(params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	return &postgres.ListOptions{
+		Limit:   int(params.PageSize),
+		Offset:  offset,
+		Filters: filters,
+		Orders:  []*postgres.Order{postgres.NewOrder("created_at", postgres.OrderDirectionDesc)},
+	}, nil
+}
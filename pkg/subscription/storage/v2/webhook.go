@@ -0,0 +1,61 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mock/$GOFILE
+package v2
+
+import (
+	"context"
+
+	err "github.com/bucketeer-io/bucketeer/v2/pkg/error"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+)
+
+var (
+	ErrWebhookAlreadyExists = err.NewErrorAlreadyExists(
+		err.SubscriptionPackageName,
+		"webhook already exists",
+	)
+	ErrWebhookNotFound = err.NewErrorNotFound(
+		err.SubscriptionPackageName,
+		"webhook not found",
+		"webhook",
+	)
+	ErrWebhookUnexpectedAffectedRows = err.NewErrorUnexpectedAffectedRows(
+		err.SubscriptionPackageName,
+		"webhook unexpected affected rows",
+	)
+)
+
+// ListWebhooksParams carries list intent without database-specific types.
+type ListWebhooksParams struct {
+	EnvironmentID string
+	Disabled      *bool
+	SearchKeyword string
+	// PageSize is the row limit; use database.QueryNoLimit for an uncapped list.
+	PageSize int64
+	Cursor   string
+}
+
+type WebhookStorage interface {
+	CreateWebhook(ctx context.Context, w *domain.Webhook) error
+	UpdateWebhook(ctx context.Context, w *domain.Webhook) error
+	DeleteWebhook(ctx context.Context, id, environmentID string) error
+	GetWebhook(ctx context.Context, id, environmentID string) (*domain.Webhook, error)
+	ListWebhooks(
+		ctx context.Context,
+		params ListWebhooksParams,
+	) ([]*proto.Webhook, int, int64, error)
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mock/$GOFILE
+package v2
+
+import (
+	"context"
+
+	err "github.com/bucketeer-io/bucketeer/v2/pkg/error"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+)
+
+var (
+	ErrWebhookDeliveryAlreadyExists = err.NewErrorAlreadyExists(
+		err.SubscriptionPackageName,
+		"webhook delivery already exists",
+	)
+	ErrWebhookDeliveryNotFound = err.NewErrorNotFound(
+		err.SubscriptionPackageName,
+		"webhook delivery not found",
+		"webhook_delivery",
+	)
+	ErrWebhookDeliveryUnexpectedAffectedRows = err.NewErrorUnexpectedAffectedRows(
+		err.SubscriptionPackageName,
+		"webhook delivery unexpected affected rows",
+	)
+)
+
+// ListWebhookDeliveriesParams carries list intent without database-specific types.
+// The deliveries are listed from the newest.
+type ListWebhookDeliveriesParams struct {
+	EnvironmentID string
+	WebhookID     string
+	Status        proto.WebhookDelivery_Status
+	PageSize      int64
+	Cursor        string
+}
+
+// WebhookDeliveryStorage stores the delivery log of the webhooks.
+// A delivery is stored as pending when it is queued and updated with its result once it finishes.
+// A replay is stored as a new delivery.
+type WebhookDeliveryStorage interface {
+	CreateWebhookDelivery(ctx context.Context, d *domain.WebhookDelivery) error
+	UpdateWebhookDelivery(ctx context.Context, d *domain.WebhookDelivery) error
+	GetWebhookDelivery(ctx context.Context, id, environmentID string) (*domain.WebhookDelivery, error)
+	ListWebhookDeliveries(
+		ctx context.Context,
+		params ListWebhookDeliveriesParams,
+	) ([]*proto.WebhookDelivery, int, int64, error)
+}
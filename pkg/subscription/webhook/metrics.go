@@ -0,0 +1,53 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/metrics"
+)
+
+var (
+	registerOnce sync.Once
+
+	handledCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "bucketeer",
+			Subsystem: "webhook",
+			Name:      "deliveries_total",
+			Help:      "Total number of webhook deliveries",
+		}, []string{"status"})
+
+	handledHistogram = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "bucketeer",
+			Subsystem: "webhook",
+			Name:      "request_duration_seconds",
+			Help:      "Histogram of webhook request duration seconds",
+			Buckets:   prometheus.DefBuckets,
+		})
+)
+
+func registerMetrics(r metrics.Registerer) {
+	registerOnce.Do(func() {
+		r.MustRegister(
+			handledCounter,
+			handledHistogram,
+		)
+	})
+}
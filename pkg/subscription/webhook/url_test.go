@@ -0,0 +1,72 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateURL(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc     string
+		url      string
+		expected error
+	}{
+		{desc: "success: https", url: "https://example.com/hook", expected: nil},
+		{desc: "success: http with port", url: "http://example.com:8080/hook", expected: nil},
+		{desc: "success: public ip", url: "https://8.8.8.8/hook", expected: nil},
+		{desc: "err: empty", url: "", expected: ErrInvalidURL},
+		{desc: "err: scheme", url: "ftp://example.com/hook", expected: ErrInvalidURL},
+		{desc: "err: no host", url: "https:///hook", expected: ErrInvalidURL},
+		{desc: "err: localhost", url: "http://localhost:8080/hook", expected: ErrDisallowedHost},
+		{desc: "err: localhost subdomain", url: "http://api.localhost./hook", expected: ErrDisallowedHost},
+		{desc: "err: loopback", url: "http://127.0.0.1/hook", expected: ErrDisallowedHost},
+		{desc: "err: ipv6 loopback", url: "http://[::1]/hook", expected: ErrDisallowedHost},
+		{desc: "err: unspecified", url: "http://0.0.0.0/hook", expected: ErrDisallowedHost},
+		{desc: "err: link-local", url: "http://169.254.169.254/latest/meta-data", expected: ErrDisallowedHost},
+		{desc: "err: private", url: "http://192.168.1.1/hook", expected: ErrDisallowedHost},
+		{desc: "err: ipv6 private", url: "http://[fd00::1]/hook", expected: ErrDisallowedHost},
+		{desc: "err: ipv4-mapped loopback", url: "http://[::ffff:127.0.0.1]/hook", expected: ErrDisallowedHost},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			assert.Equal(t, p.expected, ValidateURL(p.url))
+		})
+	}
+}
+
+func TestNewHTTPClientRejectsDisallowedAddress(t *testing.T) {
+	t.Parallel()
+	var called bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+	client := NewHTTPClient(time.Second)
+	resp, err := client.Get(srv.URL)
+	if resp != nil {
+		resp.Body.Close()
+	}
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrDisallowedHost)
+	assert.False(t, called)
+}
@@ -0,0 +1,214 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockgen -source=$GOFILE -package=mock -destination=./mock/$GOFILE
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/backoff"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/metrics"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
+	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+)
+
+// The headers sent with every webhook request.
+//
+// Receivers verify a request as follows:
+//  1. Read the unix timestamp in seconds from the X-Bucketeer-Timestamp header and reject
+//     the request when it is too far from the current time, e.g. more than 5 minutes.
+//  2. Compute the hex encoded HMAC-SHA256 of timestamp + "." + raw body with the webhook secret.
+//  3. Compare it in constant time with the X-Bucketeer-Signature header after the "sha256=" prefix.
+//
+// Because the timestamp is signed, a captured request cannot be replayed with a fresh timestamp.
+const (
+	SignatureHeader  = "X-Bucketeer-Signature"
+	TimestampHeader  = "X-Bucketeer-Timestamp"
+	EventIDHeader    = "X-Bucketeer-Event-Id"
+	DeliveryIDHeader = "X-Bucketeer-Delivery-Id"
+	SignaturePrefix  = "sha256="
+	UserAgent        = "Bucketeer-Webhook"
+)
+
+const (
+	defaultRequestTimeout = 10 * time.Second
+	defaultBackoffBase    = time.Second
+	defaultBackoffMax     = 30 * time.Second
+)
+
+var errUnexpectedStatusCode = errors.New("webhook: unexpected status code")
+
+type Deliverer interface {
+	// Deliver posts the payload of the delivery to the webhook and records the result in the delivery.
+	// maxAttempts is the number of attempts including the first one.
+	// Only network errors, 429 and 5xx responses are retried.
+	Deliver(ctx context.Context, webhook *subscriptionproto.Webhook, delivery *domain.WebhookDelivery, maxAttempts int)
+}
+
+type options struct {
+	httpClient *http.Client
+	backoff    backoff.Backoff
+	metrics    metrics.Registerer
+	logger     *zap.Logger
+}
+
+type Option func(*options)
+
+func WithHTTPClient(c *http.Client) Option {
+	return func(opts *options) {
+		opts.httpClient = c
+	}
+}
+
+func WithBackoff(b backoff.Backoff) Option {
+	return func(opts *options) {
+		opts.backoff = b
+	}
+}
+
+func WithMetrics(r metrics.Registerer) Option {
+	return func(opts *options) {
+		opts.metrics = r
+	}
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return func(opts *options) {
+		opts.logger = logger
+	}
+}
+
+type deliverer struct {
+	httpClient *http.Client
+	backoff    backoff.Backoff
+	logger     *zap.Logger
+}
+
+func NewDeliverer(opts ...Option) Deliverer {
+	dopts := &options{
+		httpClient: NewHTTPClient(defaultRequestTimeout),
+		backoff:    backoff.NewExponential(defaultBackoffBase, defaultBackoffMax),
+		logger:     zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(dopts)
+	}
+	if dopts.metrics != nil {
+		registerMetrics(dopts.metrics)
+	}
+	return &deliverer{
+		httpClient: dopts.httpClient,
+		backoff:    dopts.backoff,
+		logger:     dopts.logger.Named("webhook-deliverer"),
+	}
+}
+
+func (d *deliverer) Deliver(
+	ctx context.Context,
+	webhook *subscriptionproto.Webhook,
+	delivery *domain.WebhookDelivery,
+	maxAttempts int,
+) {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	body := []byte(delivery.Payload)
+	var statusCode, attempts int
+	var latency time.Duration
+	var lastErr error
+	retry := backoff.NewRetry(ctx, maxAttempts, d.backoff.Clone())
+	for retry.WaitNext() {
+		attempts++
+		var retryable bool
+		statusCode, latency, retryable, lastErr = d.post(ctx, webhook.Url, webhook.Secret, delivery, body)
+		if lastErr == nil || !retryable {
+			break
+		}
+		d.logger.Warn("Failed to post a webhook, retrying",
+			zap.Error(lastErr),
+			zap.String("webhookId", webhook.Id),
+			zap.String("deliveryId", delivery.Id),
+			zap.Int("attempts", attempts),
+		)
+	}
+	delivery.SetResult(statusCode, latency, attempts, lastErr)
+	handledCounter.WithLabelValues(delivery.Status.String()).Inc()
+	if lastErr != nil {
+		d.logger.Error("Failed to deliver a webhook",
+			zap.Error(lastErr),
+			zap.String("webhookId", webhook.Id),
+			zap.String("deliveryId", delivery.Id),
+			zap.String("environmentId", delivery.EnvironmentId),
+		)
+	}
+}
+
+// post returns the status code, the latency and whether the request can be retried when it fails.
+func (d *deliverer) post(
+	ctx context.Context,
+	url, secret string,
+	delivery *domain.WebhookDelivery,
+	body []byte,
+) (int, time.Duration, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, 0, false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", UserAgent)
+	timestamp := time.Now().Unix()
+	req.Header.Set(SignatureHeader, SignaturePrefix+Sign(secret, timestamp, body))
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(EventIDHeader, delivery.EventId)
+	req.Header.Set(DeliveryIDHeader, delivery.Id)
+	start := time.Now()
+	resp, err := d.httpClient.Do(req)
+	latency := time.Since(start)
+	handledHistogram.Observe(latency.Seconds())
+	if err != nil {
+		// A host which is not allowed won't become allowed on retry.
+		return 0, latency, !errors.Is(err, ErrDisallowedHost), err
+	}
+	defer resp.Body.Close()
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return resp.StatusCode, latency, false, nil
+	}
+	retryable := resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode >= http.StatusInternalServerError
+	return resp.StatusCode, latency, retryable, fmt.Errorf("%w: %d", errUnexpectedStatusCode, resp.StatusCode)
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of timestamp + "." + body using the secret.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/bucketeer-io/bucketeer/v2/pkg/backoff"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/domain"
+	subscriptionproto "github.com/bucketeer-io/bucketeer/v2/proto/subscription"
+)
+
+func TestDelivererDeliver(t *testing.T) {
+	t.Parallel()
+	patterns := []struct {
+		desc               string
+		statusCodes        []int
+		maxAttempts        int
+		expectedCalls      int32
+		expectedStatus     subscriptionproto.WebhookDelivery_Status
+		expectedStatusCode int32
+	}{
+		{
+			desc:               "success",
+			statusCodes:        []int{http.StatusOK},
+			maxAttempts:        4,
+			expectedCalls:      1,
+			expectedStatus:     subscriptionproto.WebhookDelivery_SUCCEEDED,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			desc:               "success: retry on server error",
+			statusCodes:        []int{http.StatusInternalServerError, http.StatusTooManyRequests, http.StatusNoContent},
+			maxAttempts:        4,
+			expectedCalls:      3,
+			expectedStatus:     subscriptionproto.WebhookDelivery_SUCCEEDED,
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			desc:               "failed: client error is not retried",
+			statusCodes:        []int{http.StatusNotFound},
+			maxAttempts:        4,
+			expectedCalls:      1,
+			expectedStatus:     subscriptionproto.WebhookDelivery_FAILED,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			desc:               "failed: retries exhausted",
+			statusCodes:        []int{http.StatusBadGateway},
+			maxAttempts:        3,
+			expectedCalls:      3,
+			expectedStatus:     subscriptionproto.WebhookDelivery_FAILED,
+			expectedStatusCode: http.StatusBadGateway,
+		},
+		{
+			desc:               "failed: at least one attempt",
+			statusCodes:        []int{http.StatusBadGateway},
+			maxAttempts:        0,
+			expectedCalls:      1,
+			expectedStatus:     subscriptionproto.WebhookDelivery_FAILED,
+			expectedStatusCode: http.StatusBadGateway,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			t.Parallel()
+			const payload = `{"id":"event-id"}`
+			var calls int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				i := atomic.AddInt32(&calls, 1) - 1
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Equal(t, payload, string(body))
+				timestamp, err := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+				require.NoError(t, err)
+				assert.Equal(t, SignaturePrefix+Sign("secret", timestamp, body), r.Header.Get(SignatureHeader))
+				assert.Equal(t, "event-id", r.Header.Get(EventIDHeader))
+				assert.Equal(t, "delivery-id", r.Header.Get(DeliveryIDHeader))
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				w.WriteHeader(p.statusCodes[int(i)%len(p.statusCodes)])
+			}))
+			defer srv.Close()
+
+			d := &deliverer{
+				httpClient: srv.Client(),
+				backoff:    backoff.NewConstant(0),
+				logger:     zap.NewNop(),
+			}
+			delivery := &domain.WebhookDelivery{WebhookDelivery: &subscriptionproto.WebhookDelivery{
+				Id:      "delivery-id",
+				EventId: "event-id",
+				Payload: payload,
+			}}
+			d.Deliver(
+				context.Background(),
+				&subscriptionproto.Webhook{Id: "webhook-id", Url: srv.URL, Secret: "secret"},
+				delivery,
+				p.maxAttempts,
+			)
+			assert.Equal(t, p.expectedCalls, atomic.LoadInt32(&calls))
+			assert.Equal(t, p.expectedStatus, delivery.Status)
+			assert.Equal(t, p.expectedStatusCode, delivery.StatusCode)
+			assert.Equal(t, p.expectedCalls, delivery.Attempts)
+			if p.expectedStatus == subscriptionproto.WebhookDelivery_FAILED {
+				assert.NotEmpty(t, delivery.ErrorMessage)
+			} else {
+				assert.Empty(t, delivery.ErrorMessage)
+			}
+		})
+	}
+}
+
+func TestDelivererDeliverConnectionError(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := srv.URL
+	srv.Close()
+
+	d := &deliverer{
+		httpClient: http.DefaultClient,
+		backoff:    backoff.NewConstant(0),
+		logger:     zap.NewNop(),
+	}
+	delivery := &domain.WebhookDelivery{WebhookDelivery: &subscriptionproto.WebhookDelivery{Id: "delivery-id"}}
+	d.Deliver(context.Background(), &subscriptionproto.Webhook{Url: url, Secret: "secret"}, delivery, 2)
+	assert.Equal(t, subscriptionproto.WebhookDelivery_FAILED, delivery.Status)
+	assert.Equal(t, int32(0), delivery.StatusCode)
+	assert.Equal(t, int32(2), delivery.Attempts)
+	assert.NotEmpty(t, delivery.ErrorMessage)
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+	// Generated with: printf '1700000000.body' | openssl dgst -sha256 -hmac 'secret'
+	assert.Equal(t,
+		"42ac6f0448c1d9c3e1e82b9726248f58fef84afffcbad5188246e96070e0ea46",
+		Sign("secret", 1700000000, []byte("body")),
+	)
+	// The timestamp is signed, so a replay with another timestamp does not match.
+	assert.NotEqual(t, Sign("secret", 1700000000, []byte("body")), Sign("secret", 1700000001, []byte("body")))
+}
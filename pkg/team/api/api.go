@@ -235,6 +235,125 @@ func (s *TeamService) validateCreateTeamRequest(req *proto.CreateTeamRequest) er
 	return nil
 }
 
+func (s *TeamService) UpdateTeam(
+	ctx context.Context,
+	req *proto.UpdateTeamRequest,
+) (*proto.UpdateTeamResponse, error) {
+	editor, err := s.checkOrganizationRole(
+		ctx, req.OrganizationId,
+		accountproto.AccountV2_Role_Organization_ADMIN)
+	if err != nil {
+		return nil, err
+	}
+	if len(strings.TrimSpace(req.Id)) == 0 {
+		return nil, statusTeamIDRequired.Err()
+	}
+	var previous, updated *domain.Team
+	err = s.dbClient.RunInTransactionV2(ctx, func(ctxWithTx context.Context) error {
+		team, err := s.teamStorage.GetTeam(ctxWithTx, req.Id, req.OrganizationId)
+		if err != nil {
+			return err
+		}
+		previous = team
+		updated, err = team.Update(req.Description, req.EnvironmentRoles)
+		if err != nil {
+			return err
+		}
+		if err := s.teamStorage.UpdateTeam(ctxWithTx, updated); err != nil {
+			return err
+		}
+		event, err := domainevent.NewAdminEvent(
+			editor,
+			eventproto.Event_TEAM,
+			updated.Id,
+			eventproto.Event_TEAM_UPDATED,
+			&eventproto.TeamUpdatedEvent{
+				Id:               updated.Id,
+				Name:             updated.Name,
+				Description:      updated.Description,
+				UpdatedAt:        updated.UpdatedAt,
+				OrganizationId:   updated.OrganizationId,
+				EnvironmentRoles: updated.EnvironmentRoles,
+			},
+			updated,
+			team,
+		)
+		if err != nil {
+			return err
+		}
+		return s.publisher.Publish(ctx, event)
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrTeamNotFound) {
+			return nil, statusTeamNotFound.Err()
+		}
+		return nil, s.reportInternalServerError(ctx, err, req.OrganizationId)
+	}
+	// The members are recomputed even when the mapping did not change,
+	// so updating the team again retries a sync that failed.
+	if len(previous.EnvironmentRoles) > 0 || len(updated.EnvironmentRoles) > 0 {
+		if err := s.syncMemberEnvironmentRoles(ctx, updated.Team); err != nil {
+			return nil, s.reportInternalServerError(ctx, err, req.OrganizationId)
+		}
+	}
+	return &proto.UpdateTeamResponse{
+		Team: updated.Team,
+	}, nil
+}
+
+// syncMemberEnvironmentRoles recomputes the environment roles of the team members
+// from the role mappings of all the teams they belong to, the same way the SCIM
+// service does when the membership changes.
+func (s *TeamService) syncMemberEnvironmentRoles(ctx context.Context, team *proto.Team) error {
+	// No page size lists all the teams of the organization
+	teams, _, _, err := s.teamStorage.ListTeams(ctx, storage.ListTeamsParams{
+		OrganizationID: team.OrganizationId,
+	})
+	if err != nil {
+		return err
+	}
+	for i, t := range teams {
+		if t.Id == team.Id {
+			teams[i] = team
+		}
+	}
+	resp, err := s.accountClient.ListAccountsV2(ctx, &accountproto.ListAccountsV2Request{
+		OrganizationId: team.OrganizationId,
+		Teams:          []string{team.Name},
+	})
+	if err != nil {
+		return err
+	}
+	for _, a := range resp.Accounts {
+		req := &accountproto.UpdateAccountV2Request{
+			Email:          a.Email,
+			OrganizationId: team.OrganizationId,
+		}
+		roles := domain.MergeEnvironmentRoles(teams, a.Teams, a.EnvironmentRoles)
+		switch {
+		case len(roles) == 0 && len(a.EnvironmentRoles) > 0:
+			req.ClearEnvironmentRoles = true
+		case len(roles) > 0 && !domain.EqualEnvironmentRoles(roles, a.EnvironmentRoles):
+			req.EnvironmentRoles = roles
+		default:
+			continue
+		}
+		if _, err := s.accountClient.UpdateAccountV2(ctx, req); err != nil {
+			s.logger.Error(
+				"Failed to update the environment roles of a team member",
+				log.FieldsFromIncomingContext(ctx).AddFields(
+					zap.Error(err),
+					zap.String("organizationId", team.OrganizationId),
+					zap.String("teamId", team.Id),
+					zap.String("email", a.Email),
+				)...,
+			)
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *TeamService) DeleteTeam(
 	ctx context.Context,
 	req *proto.DeleteTeamRequest,
@@ -263,7 +382,8 @@ func (s *TeamService) DeleteTeam(
 			return err
 		}
 
-		// Check if team is in use by any account
+		// Check if team is in use by any account. Since a team is only deleted
+		// once it has no members, no environment roles granted by its mapping remain.
 		accounts, err := s.listAccountsFromOrganization(ctxWithTx, req.OrganizationId)
 		if err != nil {
 			return err
@@ -282,6 +282,186 @@ func TestTeamService_ListTeams(t *testing.T) {
 	}
 }
 
+func TestTeamService_UpdateTeam(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := createContextWithToken(t)
+	ctx = metadata.NewIncomingContext(ctx, metadata.MD{
+		"accept-language": []string{"en"},
+	})
+	roles := []*accountproto.AccountV2_EnvironmentRole{
+		{EnvironmentId: "env0", Role: accountproto.AccountV2_Role_Environment_EDITOR},
+	}
+
+	patterns := []struct {
+		desc          string
+		ctx           context.Context
+		setup         func(*TeamService)
+		req           *proto.UpdateTeamRequest
+		expectedRoles []*accountproto.AccountV2_EnvironmentRole
+		expectedErr   error
+	}{
+		{
+			desc: "err: unauthenticated",
+			ctx:  context.TODO(),
+			req: &proto.UpdateTeamRequest{
+				OrganizationId: "ns0",
+				Id:             "team1",
+			},
+			expectedErr: statusUnauthenticated.Err(),
+		},
+		{
+			desc: "err: team id required",
+			ctx:  ctx,
+			req: &proto.UpdateTeamRequest{
+				OrganizationId: "ns0",
+			},
+			expectedErr: statusTeamIDRequired.Err(),
+		},
+		{
+			desc: "err: team not found",
+			ctx:  ctx,
+			setup: func(s *TeamService) {
+				s.dbClient.(*databasemock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				s.teamStorage.(*teamstoragemock.MockTeamStorage).EXPECT().GetTeam(
+					gomock.Any(), "team1", "ns0",
+				).Return(nil, storage.ErrTeamNotFound)
+			},
+			req: &proto.UpdateTeamRequest{
+				OrganizationId: "ns0",
+				Id:             "team1",
+			},
+			expectedErr: statusTeamNotFound.Err(),
+		},
+		{
+			desc: "success",
+			ctx:  ctx,
+			setup: func(s *TeamService) {
+				s.dbClient.(*databasemock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				s.teamStorage.(*teamstoragemock.MockTeamStorage).EXPECT().GetTeam(
+					gomock.Any(), "team1", "ns0",
+				).Return(&domain.Team{
+					Team: &proto.Team{
+						Id:             "team1",
+						OrganizationId: "ns0",
+						Name:           "team1",
+					},
+				}, nil)
+				s.teamStorage.(*teamstoragemock.MockTeamStorage).EXPECT().UpdateTeam(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.publisher.(*publishermock.MockPublisher).EXPECT().Publish(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.teamStorage.(*teamstoragemock.MockTeamStorage).EXPECT().ListTeams(
+					gomock.Any(), storage.ListTeamsParams{OrganizationID: "ns0"},
+				).Return([]*proto.Team{{Id: "team1", OrganizationId: "ns0", Name: "team1"}}, 0, int64(1), nil)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().ListAccountsV2(
+					gomock.Any(), &accountproto.ListAccountsV2Request{OrganizationId: "ns0", Teams: []string{"team1"}},
+				).Return(&accountproto.ListAccountsV2Response{
+					Accounts: []*accountproto.AccountV2{
+						{Email: "alice@example.com", Teams: []string{"team1"}},
+						{Email: "bob@example.com", Teams: []string{"team1"}, EnvironmentRoles: roles},
+					},
+				}, nil)
+				// bob already has the roles of the mapping
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().UpdateAccountV2(
+					gomock.Any(), &accountproto.UpdateAccountV2Request{
+						Email:          "alice@example.com",
+						OrganizationId: "ns0",
+						EnvironmentRoles: []*accountproto.AccountV2_EnvironmentRole{
+							{EnvironmentId: "env0", Role: accountproto.AccountV2_Role_Environment_EDITOR},
+						},
+					},
+				).Return(&accountproto.UpdateAccountV2Response{}, nil)
+			},
+			req: &proto.UpdateTeamRequest{
+				OrganizationId:   "ns0",
+				Id:               "team1",
+				EnvironmentRoles: roles,
+			},
+			expectedRoles: roles,
+			expectedErr:   nil,
+		},
+		{
+			desc: "success: removing the mapping revokes the roles of the members",
+			ctx:  ctx,
+			setup: func(s *TeamService) {
+				s.dbClient.(*databasemock.MockClient).EXPECT().RunInTransactionV2(
+					gomock.Any(), gomock.Any(),
+				).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				s.teamStorage.(*teamstoragemock.MockTeamStorage).EXPECT().GetTeam(
+					gomock.Any(), "team1", "ns0",
+				).Return(&domain.Team{
+					Team: &proto.Team{
+						Id:               "team1",
+						OrganizationId:   "ns0",
+						Name:             "team1",
+						EnvironmentRoles: roles,
+					},
+				}, nil)
+				s.teamStorage.(*teamstoragemock.MockTeamStorage).EXPECT().UpdateTeam(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				s.publisher.(*publishermock.MockPublisher).EXPECT().Publish(
+					gomock.Any(), gomock.Any(),
+				).Return(nil)
+				// The storage may still return the previous mapping, so the updated team is used instead.
+				s.teamStorage.(*teamstoragemock.MockTeamStorage).EXPECT().ListTeams(
+					gomock.Any(), storage.ListTeamsParams{OrganizationID: "ns0"},
+				).Return([]*proto.Team{
+					{Id: "team1", OrganizationId: "ns0", Name: "team1", EnvironmentRoles: roles},
+				}, 0, int64(1), nil)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().ListAccountsV2(
+					gomock.Any(), gomock.Any(),
+				).Return(&accountproto.ListAccountsV2Response{
+					Accounts: []*accountproto.AccountV2{
+						{Email: "alice@example.com", Teams: []string{"team1"}, EnvironmentRoles: roles},
+					},
+				}, nil)
+				s.accountClient.(*accountclientmock.MockClient).EXPECT().UpdateAccountV2(
+					gomock.Any(), &accountproto.UpdateAccountV2Request{
+						Email:                 "alice@example.com",
+						OrganizationId:        "ns0",
+						ClearEnvironmentRoles: true,
+					},
+				).Return(&accountproto.UpdateAccountV2Response{}, nil)
+			},
+			req: &proto.UpdateTeamRequest{
+				OrganizationId: "ns0",
+				Id:             "team1",
+			},
+			expectedRoles: nil,
+			expectedErr:   nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			s := createTeamService(mockController)
+			if p.setup != nil {
+				p.setup(s)
+			}
+			res, err := s.UpdateTeam(p.ctx, p.req)
+			assert.Equal(t, p.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, p.expectedRoles, res.Team.EnvironmentRoles)
+			}
+		})
+	}
+}
+
 func TestTeamService_DeleteTeam(t *testing.T) {
 	t.Parallel()
 	mockController := gomock.NewController(t)
@@ -113,3 +113,23 @@ func (mr *MockClientMockRecorder) ListTeams(ctx, in any, opts ...any) *gomock.Ca
 	varargs := append([]any{ctx, in}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeams", reflect.TypeOf((*MockClient)(nil).ListTeams), varargs...)
 }
+
+// UpdateTeam mocks base method.
+func (m *MockClient) UpdateTeam(ctx context.Context, in *team.UpdateTeamRequest, opts ...grpc.CallOption) (*team.UpdateTeamResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTeam", varargs...)
+	ret0, _ := ret[0].(*team.UpdateTeamResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeam indicates an expected call of UpdateTeam.
+func (mr *MockClientMockRecorder) UpdateTeam(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeam", reflect.TypeOf((*MockClient)(nil).UpdateTeam), varargs...)
+}
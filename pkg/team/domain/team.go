@@ -15,9 +15,15 @@
 package domain
 
 import (
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/jinzhu/copier"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
 	"github.com/bucketeer-io/bucketeer/v2/pkg/uuid"
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
 	proto "github.com/bucketeer-io/bucketeer/v2/proto/team"
 )
 
@@ -42,3 +48,84 @@ func NewTeam(name, description, organizationID string) (*Team, error) {
 		},
 	}, nil
 }
+
+// Update returns a copy of the team with the given changes applied.
+// The environment roles are replaced as a whole, so an empty slice revokes them all.
+func (t *Team) Update(
+	description *wrapperspb.StringValue,
+	environmentRoles []*accountproto.AccountV2_EnvironmentRole,
+) (*Team, error) {
+	updated := &Team{}
+	if err := copier.Copy(updated, t); err != nil {
+		return nil, err
+	}
+	if description != nil {
+		updated.Description = description.Value
+	}
+	updated.EnvironmentRoles = environmentRoles
+	updated.UpdatedAt = time.Now().Unix()
+	return updated, nil
+}
+
+// HasEnvironmentRoles reports whether any of the named teams has an environment role mapping.
+func HasEnvironmentRoles(teams []*proto.Team, names []string) bool {
+	return slices.ContainsFunc(teams, func(t *proto.Team) bool {
+		return len(t.EnvironmentRoles) > 0 && slices.Contains(names, t.Name)
+	})
+}
+
+// MergeEnvironmentRoles merges the role mappings of the named teams.
+// When several teams grant a role in the same environment, the highest role wins.
+// The custom roles assigned directly to the account are kept in the environments
+// the teams still grant a role in.
+func MergeEnvironmentRoles(
+	teams []*proto.Team,
+	names []string,
+	current []*accountproto.AccountV2_EnvironmentRole,
+) []*accountproto.AccountV2_EnvironmentRole {
+	roles := make(map[string]accountproto.AccountV2_Role_Environment)
+	for _, t := range teams {
+		if !slices.Contains(names, t.Name) {
+			continue
+		}
+		for _, r := range t.EnvironmentRoles {
+			if r.Role > roles[r.EnvironmentId] {
+				roles[r.EnvironmentId] = r.Role
+			}
+		}
+	}
+	customRoleIDs := make(map[string][]string, len(current))
+	for _, r := range current {
+		customRoleIDs[r.EnvironmentId] = r.CustomRoleIds
+	}
+	merged := make([]*accountproto.AccountV2_EnvironmentRole, 0, len(roles))
+	for envID, role := range roles {
+		merged = append(merged, &accountproto.AccountV2_EnvironmentRole{
+			EnvironmentId: envID,
+			Role:          role,
+			CustomRoleIds: slices.Clone(customRoleIDs[envID]),
+		})
+	}
+	slices.SortFunc(merged, func(a, b *accountproto.AccountV2_EnvironmentRole) int {
+		return strings.Compare(a.EnvironmentId, b.EnvironmentId)
+	})
+	return merged
+}
+
+// EqualEnvironmentRoles reports whether both lists grant the same roles, regardless of their order.
+func EqualEnvironmentRoles(a, b []*accountproto.AccountV2_EnvironmentRole) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	roles := make(map[string]*accountproto.AccountV2_EnvironmentRole, len(b))
+	for _, r := range b {
+		roles[r.EnvironmentId] = r
+	}
+	for _, r := range a {
+		role, ok := roles[r.EnvironmentId]
+		if !ok || role.Role != r.Role || !slices.Equal(role.CustomRoleIds, r.CustomRoleIds) {
+			return false
+		}
+	}
+	return true
+}
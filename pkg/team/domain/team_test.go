@@ -18,6 +18,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	accountproto "github.com/bucketeer-io/bucketeer/v2/proto/account"
+	proto "github.com/bucketeer-io/bucketeer/v2/proto/team"
 )
 
 func TestNewTeam(t *testing.T) {
@@ -31,3 +36,72 @@ func TestNewTeam(t *testing.T) {
 	assert.True(t, team.UpdatedAt > 0)
 	assert.NotEmpty(t, team.Id)
 }
+
+func TestUpdateTeam(t *testing.T) {
+	t.Parallel()
+	team, err := NewTeam("Test Team", "This is a test team", "org-123")
+	require.NoError(t, err)
+	team.UpdatedAt = 0
+	roles := []*accountproto.AccountV2_EnvironmentRole{
+		{EnvironmentId: "env-0", Role: accountproto.AccountV2_Role_Environment_EDITOR},
+	}
+
+	updated, err := team.Update(wrapperspb.String("new description"), roles)
+	require.NoError(t, err)
+	assert.Equal(t, "new description", updated.Description)
+	assert.Equal(t, roles, updated.EnvironmentRoles)
+	assert.True(t, updated.UpdatedAt > 0)
+	assert.Equal(t, "This is a test team", team.Description)
+	assert.Empty(t, team.EnvironmentRoles)
+
+	cleared, err := updated.Update(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "new description", cleared.Description)
+	assert.Empty(t, cleared.EnvironmentRoles)
+}
+
+func TestMergeEnvironmentRoles(t *testing.T) {
+	t.Parallel()
+	teams := []*proto.Team{
+		{
+			Name: "developers",
+			EnvironmentRoles: []*accountproto.AccountV2_EnvironmentRole{
+				{EnvironmentId: "env-dev", Role: accountproto.AccountV2_Role_Environment_EDITOR},
+				{EnvironmentId: "env-prd", Role: accountproto.AccountV2_Role_Environment_VIEWER},
+			},
+		},
+		{
+			Name: "operators",
+			EnvironmentRoles: []*accountproto.AccountV2_EnvironmentRole{
+				{EnvironmentId: "env-prd", Role: accountproto.AccountV2_Role_Environment_EDITOR},
+			},
+		},
+		{Name: "qa"},
+	}
+	current := []*accountproto.AccountV2_EnvironmentRole{
+		{
+			EnvironmentId: "env-prd",
+			Role:          accountproto.AccountV2_Role_Environment_VIEWER,
+			CustomRoleIds: []string{"custom-role-1"},
+		},
+		{
+			EnvironmentId: "env-stg",
+			Role:          accountproto.AccountV2_Role_Environment_VIEWER,
+			CustomRoleIds: []string{"custom-role-2"},
+		},
+	}
+	actual := MergeEnvironmentRoles(teams, []string{"developers", "operators", "qa"}, current)
+	assert.Equal(t, []*accountproto.AccountV2_EnvironmentRole{
+		{EnvironmentId: "env-dev", Role: accountproto.AccountV2_Role_Environment_EDITOR},
+		{
+			EnvironmentId: "env-prd",
+			Role:          accountproto.AccountV2_Role_Environment_EDITOR,
+			CustomRoleIds: []string{"custom-role-1"},
+		},
+	}, actual)
+	assert.Empty(t, MergeEnvironmentRoles(teams, []string{"qa"}, current))
+	assert.True(t, HasEnvironmentRoles(teams, []string{"qa", "operators"}))
+	assert.False(t, HasEnvironmentRoles(teams, []string{"qa"}))
+	assert.True(t, EqualEnvironmentRoles(actual, []*accountproto.AccountV2_EnvironmentRole{actual[1], actual[0]}))
+	assert.False(t, EqualEnvironmentRoles(actual, actual[:1]))
+}
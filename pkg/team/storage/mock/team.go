@@ -104,6 +104,20 @@ func (mr *MockTeamStorageMockRecorder) ListTeams(ctx, params any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeams", reflect.TypeOf((*MockTeamStorage)(nil).ListTeams), ctx, params)
 }
 
+// UpdateTeam mocks base method.
+func (m *MockTeamStorage) UpdateTeam(ctx context.Context, team *domain.Team) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeam", ctx, team)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTeam indicates an expected call of UpdateTeam.
+func (mr *MockTeamStorageMockRecorder) UpdateTeam(ctx, team any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeam", reflect.TypeOf((*MockTeamStorage)(nil).UpdateTeam), ctx, team)
+}
+
 // UpsertTeam mocks base method.
 func (m *MockTeamStorage) UpsertTeam(ctx context.Context, team *domain.Team) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,7 @@
+UPDATE team SET
+    description = ?,
+    environment_roles = ?,
+    updated_at = ?
+WHERE
+    id = ? AND
+    organization_id = ?
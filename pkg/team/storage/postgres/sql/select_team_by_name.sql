@@ -5,6 +5,7 @@ SELECT
     team.created_at,
     team.updated_at,
     team.organization_id,
+    team.environment_roles,
     org.name as organization_name
 FROM
     team
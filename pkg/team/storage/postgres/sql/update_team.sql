@@ -0,0 +1,7 @@
+UPDATE team SET
+    description = $1,
+    environment_roles = $2,
+    updated_at = $3
+WHERE
+    id = $4 AND
+    organization_id = $5
@@ -30,6 +30,8 @@ import (
 var (
 	//go:embed sql/insert_team.sql
 	insertTeamSQL string
+	//go:embed sql/update_team.sql
+	updateTeamSQL string
 	//go:embed sql/select_team.sql
 	selectTeamSQL string
 	//go:embed sql/select_team_by_name.sql
@@ -67,6 +69,29 @@ func (t *teamStorage) UpsertTeam(ctx context.Context, team *domain.Team) error {
 	return nil
 }
 
+func (t *teamStorage) UpdateTeam(ctx context.Context, team *domain.Team) error {
+	result, err := t.qe.ExecContext(
+		ctx,
+		updateTeamSQL,
+		team.Description,
+		pgstorage.JSONObject{Val: team.EnvironmentRoles},
+		team.UpdatedAt,
+		team.Id,
+		team.OrganizationId,
+	)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return teamstorage.ErrTeamUnexpectedAffectedRows
+	}
+	return nil
+}
+
 func (t *teamStorage) GetTeam(ctx context.Context, id, organizationID string) (*domain.Team, error) {
 	team := proto.Team{}
 	err := t.qe.QueryRowContext(
@@ -81,6 +106,7 @@ func (t *teamStorage) GetTeam(ctx context.Context, id, organizationID string) (*
 		&team.CreatedAt,
 		&team.UpdatedAt,
 		&team.OrganizationId,
+		&pgstorage.JSONObject{Val: &team.EnvironmentRoles},
 		&team.OrganizationName,
 	)
 	if err != nil {
@@ -108,6 +134,7 @@ func (t *teamStorage) GetTeamByName(ctx context.Context, name, organizationID st
 		&team.CreatedAt,
 		&team.UpdatedAt,
 		&team.OrganizationId,
+		&pgstorage.JSONObject{Val: &team.EnvironmentRoles},
 		&team.OrganizationName,
 	)
 	if err != nil {
@@ -203,6 +230,7 @@ func (t *teamStorage) ListTeams(
 			&team.CreatedAt,
 			&team.UpdatedAt,
 			&team.OrganizationId,
+			&pgstorage.JSONObject{Val: &team.EnvironmentRoles},
 			&team.OrganizationName,
 		)
 		if err != nil {
@@ -111,6 +111,83 @@ func TestUpsertTeam(t *testing.T) {
 	}
 }
 
+func TestUpdateTeam(t *testing.T) {
+	t.Parallel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	patterns := []struct {
+		desc        string
+		setup       func(*teamStorage)
+		input       *domain.Team
+		expectedErr error
+	}{
+		{
+			desc: "ErrTeamUnexpectedAffectedRows",
+			setup: func(s *teamStorage) {
+				result := mock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(0), nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(result, nil)
+			},
+			input: &domain.Team{
+				Team: &proto.Team{Id: "team-id-0", OrganizationId: "org-0"},
+			},
+			expectedErr: teamstorage.ErrTeamUnexpectedAffectedRows,
+		},
+		{
+			desc: "Error",
+			setup: func(s *teamStorage) {
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(nil, errors.New("error"))
+			},
+			input: &domain.Team{
+				Team: &proto.Team{Id: "team-id-0", OrganizationId: "org-0"},
+			},
+			expectedErr: errors.New("error"),
+		},
+		{
+			desc: "Success",
+			setup: func(s *teamStorage) {
+				result := mock.NewMockResult(mockController)
+				result.EXPECT().RowsAffected().Return(int64(1), nil)
+				s.qe.(*mock.MockQueryExecer).EXPECT().ExecContext(
+					gomock.Any(),
+					updateTeamSQL,
+					"team-description-0",
+					gomock.Any(),
+					int64(2),
+					"team-id-0",
+					"org-0",
+				).Return(result, nil)
+			},
+			input: &domain.Team{
+				Team: &proto.Team{
+					Id:             "team-id-0",
+					Name:           "team-name-0",
+					Description:    "team-description-0",
+					OrganizationId: "org-0",
+					CreatedAt:      1,
+					UpdatedAt:      2,
+				},
+			},
+			expectedErr: nil,
+		},
+	}
+	for _, p := range patterns {
+		t.Run(p.desc, func(t *testing.T) {
+			storage := newTeamStorageWithMock(t, mockController)
+			if p.setup != nil {
+				p.setup(storage)
+			}
+			err := storage.UpdateTeam(context.Background(), p.input)
+			assert.Equal(t, p.expectedErr, err)
+		})
+	}
+}
+
 func TestGetTeam(t *testing.T) {
 	t.Parallel()
 	mockController := gomock.NewController(t)
@@ -163,6 +240,7 @@ func TestGetTeam(t *testing.T) {
 					gomock.Any(), // created_at
 					gomock.Any(), // updated_at
 					gomock.Any(), // organization_id
+					gomock.Any(), // environment_roles
 					gomock.Any(), // organization_name
 				).Do(func(args ...interface{}) {
 					*args[0].(*string) = "team-id-0"
@@ -171,7 +249,7 @@ func TestGetTeam(t *testing.T) {
 					*args[3].(*int64) = int64(1)
 					*args[4].(*int64) = int64(2)
 					*args[5].(*string) = "org-0"
-					*args[6].(*string) = "test-org"
+					*args[7].(*string) = "test-org"
 				}).Return(nil)
 				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
 					gomock.Any(),
@@ -261,6 +339,7 @@ func TestListTeams(t *testing.T) {
 					gomock.Any(), // created_at
 					gomock.Any(), // updated_at
 					gomock.Any(), // organization_id
+					gomock.Any(), // environment_roles
 					gomock.Any(), // organization_name
 				).Do(func(args ...interface{}) {
 					*args[0].(*string) = "team-id-0"
@@ -269,7 +348,7 @@ func TestListTeams(t *testing.T) {
 					*args[3].(*int64) = int64(1)
 					*args[4].(*int64) = int64(2)
 					*args[5].(*string) = "org-0"
-					*args[6].(*string) = "test-org"
+					*args[7].(*string) = "test-org"
 				}).Return(nil)
 				row := mock.NewMockRow(mockController)
 				row.EXPECT().Scan(gomock.Any()).Return(nil)
@@ -369,6 +448,7 @@ func TestGetTeamByName(t *testing.T) {
 					gomock.Any(), // created_at
 					gomock.Any(), // updated_at
 					gomock.Any(), // organization_id
+					gomock.Any(), // environment_roles
 					gomock.Any(), // organization_name
 				).Do(func(args ...interface{}) {
 					*args[0].(*string) = "team-id-0"
@@ -377,7 +457,7 @@ func TestGetTeamByName(t *testing.T) {
 					*args[3].(*int64) = int64(2)
 					*args[4].(*int64) = int64(3)
 					*args[5].(*string) = "test-org"
-					*args[6].(*string) = "test-org-name"
+					*args[7].(*string) = "test-org-name"
 				}).Return(nil)
 				s.qe.(*mock.MockQueryExecer).EXPECT().QueryRowContext(
 					gomock.Any(),
@@ -36,6 +36,7 @@ var (
 
 type TeamStorage interface {
 	UpsertTeam(ctx context.Context, team *domain.Team) error
+	UpdateTeam(ctx context.Context, team *domain.Team) error
 	GetTeam(ctx context.Context, id, organizationID string) (*domain.Team, error)
 	GetTeamByName(ctx context.Context, name, organizationID string) (*domain.Team, error)
 	ListTeams(
@@ -0,0 +1,223 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const instrumentationName = "github.com/bucketeer-io/bucketeer/v2"
+
+type providerOptions struct {
+	endpoint string
+	insecure bool
+	sampler  sdktrace.Sampler
+	logger   *zap.Logger
+}
+
+type ProviderOption func(*providerOptions)
+
+// WithOTLPEndpoint sets the host:port of the OTLP/gRPC collector the spans are exported to.
+func WithOTLPEndpoint(endpoint string) ProviderOption {
+	return func(o *providerOptions) {
+		o.endpoint = endpoint
+	}
+}
+
+// WithOTLPInsecure disables the transport security of the connection to the collector.
+func WithOTLPInsecure(insecure bool) ProviderOption {
+	return func(o *providerOptions) {
+		o.insecure = insecure
+	}
+}
+
+func WithProviderSampler(s sdktrace.Sampler) ProviderOption {
+	return func(o *providerOptions) {
+		o.sampler = s
+	}
+}
+
+func WithProviderLogger(logger *zap.Logger) ProviderOption {
+	return func(o *providerOptions) {
+		o.logger = logger
+	}
+}
+
+// NewTracerProvider creates an OpenTelemetry tracer provider exporting spans over OTLP/gRPC
+// and installs it as the global provider along with the W3C trace context propagator.
+// The caller must shut the provider down to flush the pending spans.
+func NewTracerProvider(
+	ctx context.Context,
+	service, version string,
+	opts ...ProviderOption,
+) (*sdktrace.TracerProvider, error) {
+	options := &providerOptions{
+		sampler: NewOTelSampler(),
+		logger:  zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	var exporterOpts []otlptracegrpc.Option
+	if options.endpoint != "" {
+		exporterOpts = append(exporterOpts, otlptracegrpc.WithEndpoint(options.endpoint))
+	}
+	if options.insecure {
+		exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, exporterOpts...)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.New(
+		ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(
+			semconv.ServiceName(service),
+			semconv.ServiceVersion(version),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(options.sampler),
+	)
+	logger := options.logger
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		logger.Warn("Failed to upload tracing data to the OTLP collector", zap.Error(err))
+	}))
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+	return tp, nil
+}
+
+type otelSampler struct {
+	probability       float64
+	filteringSamplers map[string]sdktrace.Sampler
+}
+
+type OTelSamplerOption func(*otelSampler)
+
+func WithOTelDefaultProbability(p float64) OTelSamplerOption {
+	return func(s *otelSampler) {
+		s.probability = p
+	}
+}
+
+func WithOTelFilteringSampler(name string, fs sdktrace.Sampler) OTelSamplerOption {
+	return func(s *otelSampler) {
+		s.filteringSamplers[name] = fs
+	}
+}
+
+// NewOTelSampler is the OpenTelemetry counterpart of NewSampler.
+// Root spans are sampled by name, and child spans follow the decision of their parent
+// so that a trace propagated through pubsub is either recorded end to end or not at all.
+func NewOTelSampler(options ...OTelSamplerOption) sdktrace.Sampler {
+	s := &otelSampler{
+		probability:       0.01,
+		filteringSamplers: make(map[string]sdktrace.Sampler),
+	}
+	for _, opt := range options {
+		opt(s)
+	}
+	return sdktrace.ParentBased(s)
+}
+
+func (s *otelSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	if fs, ok := s.filteringSamplers[p.Name]; ok {
+		return fs.ShouldSample(p)
+	}
+	return sdktrace.TraceIDRatioBased(s.probability).ShouldSample(p)
+}
+
+func (s *otelSampler) Description() string {
+	return "BucketeerSampler"
+}
+
+// StartSpan starts a span using the global tracer provider.
+// It is a no-op when no provider has been installed.
+func StartSpan(
+	ctx context.Context,
+	name string,
+	opts ...oteltrace.SpanStartOption,
+) (context.Context, oteltrace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// EndSpan records err on the span, if any, and ends it.
+func EndSpan(span oteltrace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// InjectAttributes writes the trace context of ctx into the pubsub message attributes.
+func InjectAttributes(ctx context.Context, attributes map[string]string) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(attributes))
+}
+
+// ExtractAttributes returns a copy of ctx carrying the trace context found in the pubsub message attributes.
+func ExtractAttributes(ctx context.Context, attributes map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(attributes))
+}
+
+// StartProducerSpan starts a span for publishing messages to the topic of the messaging system.
+func StartProducerSpan(ctx context.Context, system, topic string) (context.Context, oteltrace.Span) {
+	return StartSpan(
+		ctx,
+		topic+" publish",
+		oteltrace.WithSpanKind(oteltrace.SpanKindProducer),
+		oteltrace.WithAttributes(
+			semconv.MessagingSystemKey.String(system),
+			semconv.MessagingDestinationName(topic),
+		),
+	)
+}
+
+// StartConsumerSpan starts a span for processing a pulled message,
+// continuing the trace propagated in the message attributes.
+func StartConsumerSpan(
+	ctx context.Context,
+	name string,
+	attributes map[string]string,
+) (context.Context, oteltrace.Span) {
+	ctx = ExtractAttributes(ctx, attributes)
+	return StartSpan(
+		ctx,
+		name,
+		oteltrace.WithSpanKind(oteltrace.SpanKindConsumer),
+		oteltrace.WithAttributes(semconv.MessagingMessageID(attributes["id"])),
+	)
+}
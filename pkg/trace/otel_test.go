@@ -0,0 +1,134 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func TestOTelSampler(t *testing.T) {
+	t.Parallel()
+	filteringSpanName := "span-name"
+	traceID := oteltrace.TraceID{0x01}
+	sampledParent := oteltrace.ContextWithSpanContext(
+		context.Background(),
+		oteltrace.NewSpanContext(oteltrace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     oteltrace.SpanID{0x01},
+			TraceFlags: oteltrace.FlagsSampled,
+			Remote:     true,
+		}),
+	)
+	testcases := []struct {
+		desc     string
+		sampler  sdktrace.Sampler
+		ctx      context.Context
+		name     string
+		expected bool
+	}{
+		{
+			desc: "false: filteringSpanName NeverSample",
+			sampler: NewOTelSampler(
+				WithOTelDefaultProbability(1.0),
+				WithOTelFilteringSampler(filteringSpanName, sdktrace.NeverSample()),
+			),
+			ctx:      context.Background(),
+			name:     filteringSpanName,
+			expected: false,
+		},
+		{
+			desc: "true: filteringSpanName Probability=1.0",
+			sampler: NewOTelSampler(
+				WithOTelDefaultProbability(0.0),
+				WithOTelFilteringSampler(filteringSpanName, sdktrace.TraceIDRatioBased(1.0)),
+			),
+			ctx:      context.Background(),
+			name:     filteringSpanName,
+			expected: true,
+		},
+		{
+			desc: "false: default Probability=0.0",
+			sampler: NewOTelSampler(
+				WithOTelDefaultProbability(0.0),
+				WithOTelFilteringSampler(filteringSpanName, sdktrace.TraceIDRatioBased(1.0)),
+			),
+			ctx:      context.Background(),
+			name:     "default",
+			expected: false,
+		},
+		{
+			desc: "true: default Probability=1.0",
+			sampler: NewOTelSampler(
+				WithOTelDefaultProbability(1.0),
+				WithOTelFilteringSampler(filteringSpanName, sdktrace.NeverSample()),
+			),
+			ctx:      context.Background(),
+			name:     "default",
+			expected: true,
+		},
+		{
+			desc: "true: sampled parent overrides Probability=0.0",
+			sampler: NewOTelSampler(
+				WithOTelDefaultProbability(0.0),
+			),
+			ctx:      sampledParent,
+			name:     "default",
+			expected: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result := tc.sampler.ShouldSample(sdktrace.SamplingParameters{
+				ParentContext: tc.ctx,
+				TraceID:       traceID,
+				Name:          tc.name,
+			})
+			assert.Equal(t, tc.expected, result.Decision == sdktrace.RecordAndSample)
+		})
+	}
+}
+
+func TestInjectExtractAttributes(t *testing.T) {
+	prev := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(prev)
+
+	sc := oteltrace.NewSpanContext(oteltrace.SpanContextConfig{
+		TraceID:    oteltrace.TraceID{0x01, 0x02},
+		SpanID:     oteltrace.SpanID{0x03},
+		TraceFlags: oteltrace.FlagsSampled,
+	})
+	attributes := map[string]string{"id": "message-id"}
+	InjectAttributes(oteltrace.ContextWithSpanContext(context.Background(), sc), attributes)
+	require.Contains(t, attributes, "traceparent")
+	assert.Equal(t, "message-id", attributes["id"])
+
+	extracted := oteltrace.SpanContextFromContext(ExtractAttributes(context.Background(), attributes))
+	assert.Equal(t, sc.TraceID(), extracted.TraceID())
+	assert.Equal(t, sc.SpanID(), extracted.SpanID())
+	assert.True(t, extracted.IsSampled())
+	assert.True(t, extracted.IsRemote())
+
+	empty := oteltrace.SpanContextFromContext(ExtractAttributes(context.Background(), map[string]string{}))
+	assert.False(t, empty.IsValid())
+}
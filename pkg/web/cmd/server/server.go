@@ -21,6 +21,7 @@ import (
 	"io"
 	"os"
 	"regexp"
+	"strings"
 	"sync"
 	"time"
 
@@ -46,6 +47,7 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/auth"
 	authapi "github.com/bucketeer-io/bucketeer/v2/pkg/auth/api"
 	authclient "github.com/bucketeer-io/bucketeer/v2/pkg/auth/client"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/auth/oidc"
 	autoopsapi "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/api"
 	autoopsclient "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/client"
 	v2aos "github.com/bucketeer-io/bucketeer/v2/pkg/autoops/storage/v2"
@@ -59,6 +61,8 @@ import (
 	coderefstorage "github.com/bucketeer-io/bucketeer/v2/pkg/coderef/storage"
 	coderefmysql "github.com/bucketeer-io/bucketeer/v2/pkg/coderef/storage/mysql"
 	coderefpostgres "github.com/bucketeer-io/bucketeer/v2/pkg/coderef/storage/postgres"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/crypto"
+	cryptostorage "github.com/bucketeer-io/bucketeer/v2/pkg/crypto/storage/v2"
 	environmentapi "github.com/bucketeer-io/bucketeer/v2/pkg/environment/api"
 	environmentclient "github.com/bucketeer-io/bucketeer/v2/pkg/environment/client"
 	v2es "github.com/bucketeer-io/bucketeer/v2/pkg/environment/storage/v2"
@@ -106,19 +110,23 @@ import (
 	"github.com/bucketeer-io/bucketeer/v2/pkg/rpc"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/rpc/client"
 	gatewayapi "github.com/bucketeer-io/bucketeer/v2/pkg/rpc/gateway"
+	scimapi "github.com/bucketeer-io/bucketeer/v2/pkg/scim/api"
 	bqquerier "github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/bigquery/querier"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/database"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/mysql"
 	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/postgres"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/storage/v2/sqlite"
 	subscriptionapi "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/api"
 	v2ns "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2"
 	subscriptionmysql "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2/mysql"
 	subscriptionpostgres "github.com/bucketeer-io/bucketeer/v2/pkg/subscription/storage/v2/postgres"
+	"github.com/bucketeer-io/bucketeer/v2/pkg/subscription/webhook"
 	tagapi "github.com/bucketeer-io/bucketeer/v2/pkg/tag/api"
 	tagstorage "github.com/bucketeer-io/bucketeer/v2/pkg/tag/storage"
 	tagmysql "github.com/bucketeer-io/bucketeer/v2/pkg/tag/storage/mysql"
 	tagpostgres "github.com/bucketeer-io/bucketeer/v2/pkg/tag/storage/postgres"
 	teamapi "github.com/bucketeer-io/bucketeer/v2/pkg/team/api"
+	teamclient "github.com/bucketeer-io/bucketeer/v2/pkg/team/client"
 	teamstorage "github.com/bucketeer-io/bucketeer/v2/pkg/team/storage"
 	teammysql "github.com/bucketeer-io/bucketeer/v2/pkg/team/storage/mysql"
 	teampostgres "github.com/bucketeer-io/bucketeer/v2/pkg/team/storage/postgres"
@@ -165,28 +173,36 @@ type GracefulStopper interface {
 
 type server struct {
 	*kingpin.CmdClause
-	port                            *int
-	project                         *string
-	isDemoSiteEnabled               *bool
-	timezone                        *string
-	certPath                        *string
-	keyPath                         *string
-	serviceTokenPath                *string
-	operationalDatabaseType         *string
-	mysqlUser                       *string
-	mysqlPass                       *string
-	mysqlHost                       *string
-	mysqlPort                       *int
-	mysqlDBName                     *string
-	postgresUser                    *string
-	postgresPass                    *string
-	postgresHost                    *string
-	postgresPort                    *int
-	postgresDBName                  *string
-	postgresSSLMode                 *string
-	postgresSSLRootCert             *string
-	postgresSSLCert                 *string
-	postgresSSLKey                  *string
+	port                    *int
+	project                 *string
+	isDemoSiteEnabled       *bool
+	timezone                *string
+	certPath                *string
+	keyPath                 *string
+	serviceTokenPath        *string
+	operationalDatabaseType *string
+	mysqlUser               *string
+	mysqlPass               *string
+	mysqlHost               *string
+	mysqlPort               *int
+	mysqlDBName             *string
+	postgresUser            *string
+	postgresPass            *string
+	postgresHost            *string
+	postgresPort            *int
+	postgresDBName          *string
+	postgresSSLMode         *string
+	postgresSSLRootCert     *string
+	postgresSSLCert         *string
+	postgresSSLKey          *string
+	// Secret encryption
+	secretEncryptionBackend      *string
+	secretEncryptionKey          *string
+	secretEncryptionAWSRegion    *string
+	secretEncryptionVaultAddress *string
+	secretEncryptionVaultToken   *string
+	// SQLite
+	sqlitePath                      *string
 	persistentRedisServerName       *string
 	persistentRedisAddr             *string
 	persistentRedisPoolMaxIdle      *int
@@ -227,6 +243,7 @@ type server struct {
 	featureService                  *string
 	autoOpsService                  *string
 	codeReferenceService            *string
+	teamService                     *string
 	accessTokenTTL                  *time.Duration
 	refreshTokenTTL                 *time.Duration
 	emailFilter                     *string
@@ -234,6 +251,7 @@ type server struct {
 	oauthPublicKeyPath              *string
 	oauthPrivateKeyPath             *string
 	webhookBaseURL                  *string
+	webURL                          *string
 	webhookKMSResourceName          *string
 	cloudService                    *string
 	webConsoleEnvJSPath             *string
@@ -244,6 +262,8 @@ type server struct {
 	pubSubRedisMinIdle              *int
 	pubSubRedisPartitionCount       *int
 	pubSubRedisMode                 *string
+	pubSubKafkaBrokers              *string
+	pubSubKafkaPartitionCount       *int
 	dataWarehouseType               *string
 	dataWarehouseConfigPath         *string
 	// AI Chat configuration
@@ -300,7 +320,7 @@ func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
 		isDemoSiteEnabled: cmd.Flag(
 			"demo-site-enabled",
 			"Is demo site enabled").Default("false").Bool(),
-		operationalDatabaseType: cmd.Flag("storage-type", "Operational database type (mysql, postgres).").
+		operationalDatabaseType: cmd.Flag("storage-type", "Operational database type (mysql, postgres, sqlite).").
 			Default("mysql").String(),
 		mysqlUser:      cmd.Flag("mysql-user", "MySQL user.").Required().String(),
 		mysqlPass:      cmd.Flag("mysql-pass", "MySQL password.").Required().String(),
@@ -328,6 +348,30 @@ func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
 			"postgres-ssl-key",
 			"Path to the PostgreSQL SSL client private key file.",
 		).String(),
+		sqlitePath: cmd.Flag(
+			"sqlite-path",
+			"Path to the SQLite database file used when storage-type=sqlite.",
+		).Default("bucketeer.db").String(),
+		secretEncryptionBackend: cmd.Flag(
+			"secret-encryption-backend",
+			"Backend wrapping the data keys of the stored secrets (none, local, gcp-kms, aws-kms, vault).",
+		).Default(string(crypto.KeyBackendNone)).String(),
+		secretEncryptionKey: cmd.Flag(
+			"secret-encryption-key",
+			"Key file path for local, key name for gcp-kms and vault, or key ID for aws-kms.",
+		).String(),
+		secretEncryptionAWSRegion: cmd.Flag(
+			"secret-encryption-aws-region",
+			"Region of the AWS KMS key.",
+		).String(),
+		secretEncryptionVaultAddress: cmd.Flag(
+			"secret-encryption-vault-address",
+			"Address of the Vault server.",
+		).String(),
+		secretEncryptionVaultToken: cmd.Flag(
+			"secret-encryption-vault-token",
+			"Token used to authenticate to Vault.",
+		).String(),
 		persistentRedisServerName: cmd.Flag(
 			"persistent-redis-server-name",
 			"Name of the persistent redis.",
@@ -480,6 +524,10 @@ func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
 			"code-reference-service",
 			"bucketeer-code-reference-service address.",
 		).Default("localhost:9001").String(),
+		teamService: cmd.Flag(
+			"team-service",
+			"bucketeer-team-service address.",
+		).Default("localhost:9001").String(),
 		dataWarehouseType: cmd.Flag(
 			"data-warehouse-type",
 			"Data warehouse type (bigquery, mysql, postgres).",
@@ -511,6 +559,7 @@ func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
 			"Path to private key for signing oauth token.",
 		).Required().String(),
 		webhookBaseURL: cmd.Flag("webhook-base-url", "the base url for incoming webhooks.").Required().String(),
+		webURL:         cmd.Flag("web-url", "Web console URL.").Required().String(),
 		webhookKMSResourceName: cmd.Flag(
 			"webhook-kms-resource-name",
 			"Cloud KMS resource name to encrypt and decrypt webhook credentials.",
@@ -519,7 +568,7 @@ func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
 		webConsoleEnvJSPath: cmd.Flag("web-console-env-js-path", "console env js path").Required().String(),
 		// PubSub configuration
 		pubSubType: cmd.Flag("pubsub-type",
-			"Type of PubSub to use (google or redis-stream).",
+			"Type of PubSub to use (google, redis-stream, kafka or memory).",
 		).Default("google").String(),
 		pubSubRedisServerName: cmd.Flag("pubsub-redis-server-name",
 			"Name of the Redis server for PubSub.",
@@ -539,6 +588,12 @@ func RegisterCommand(r cli.CommandRegistry, p cli.ParentCommand) cli.Command {
 		pubSubRedisMode: cmd.Flag("pubsub-redis-mode",
 			"PubSub Redis client mode: cluster, standalone, or auto.",
 		).Default("auto").String(),
+		pubSubKafkaBrokers: cmd.Flag("pubsub-kafka-brokers",
+			"Comma-separated addresses of the Kafka brokers for PubSub.",
+		).Default("localhost:9092").String(),
+		pubSubKafkaPartitionCount: cmd.Flag("pubsub-kafka-partition-count",
+			"Number of partitions of the Kafka topics created for PubSub.",
+		).Default("16").Int(),
 		// AI Chat configuration (optional — disabled when openai-api-key is empty)
 		openAIAPIKey: cmd.Flag(
 			"openai-api-key",
@@ -643,13 +698,19 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 	var prStorage v2aos.ProgressiveRolloutStorage
 	var experimentStorage v2exs.ExperimentStorage
 	var goalStorage v2exs.GoalStorage
+	var experimentLayerStorage v2exs.ExperimentLayerStorage
+	var experimentHoldoutStorage v2exs.ExperimentHoldoutStorage
 	var experimentResultStorage v2er.ExperimentResultStorage
 	var monthlySummaryStorage insightsstorage.MonthlySummaryStorage
 	var subscriptionStorage v2ns.SubscriptionStorage
 	var adminSubscriptionStorage v2ns.AdminSubscriptionStorage
+	var webhookStorage v2ns.WebhookStorage
+	var webhookDeliveryStorage v2ns.WebhookDeliveryStorage
 	var teamStorage teamstorage.TeamStorage
 	var notificationStorage notificationstorage.NotificationStorage
 	var scheduledFlagChangeStorage v2fs.ScheduledFlagChangeStorage
+	var changeRequestStorage v2fs.ChangeRequestStorage
+	var dataKeyStorage cryptostorage.DataKeyStorage
 	if *s.operationalDatabaseType == "postgres" {
 		if *s.postgresUser == "" || *s.postgresHost == "" || *s.postgresDBName == "" {
 			return fmt.Errorf("postgres-user, postgres-host, and postgres-db-name are required when storage-type=postgres")
@@ -678,13 +739,19 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		prStorage = autoopspostgres.NewProgressiveRolloutStorage(postgresClient)
 		experimentStorage = experimentpostgres.NewExperimentStorage(postgresClient)
 		goalStorage = experimentpostgres.NewGoalStorage(postgresClient)
+		experimentLayerStorage = experimentpostgres.NewExperimentLayerStorage(postgresClient)
+		experimentHoldoutStorage = experimentpostgres.NewExperimentHoldoutStorage(postgresClient)
 		experimentResultStorage = eventcounterpostgres.NewExperimentResultStorage(postgresClient)
 		monthlySummaryStorage = insightspostgres.NewMonthlySummaryStorage(postgresClient)
 		subscriptionStorage = subscriptionpostgres.NewSubscriptionStorage(postgresClient)
 		adminSubscriptionStorage = subscriptionpostgres.NewAdminSubscriptionStorage(postgresClient)
+		webhookStorage = subscriptionpostgres.NewWebhookStorage(postgresClient)
+		webhookDeliveryStorage = subscriptionpostgres.NewWebhookDeliveryStorage(postgresClient)
 		teamStorage = teampostgres.NewTeamStorage(postgresClient)
 		notificationStorage = notificationpostgres.NewNotificationStorage(postgresClient)
 		scheduledFlagChangeStorage = featurepostgres.NewScheduledFlagChangeStorage(postgresClient)
+		changeRequestStorage = featurepostgres.NewChangeRequestStorage(postgresClient)
+		dataKeyStorage = cryptostorage.NewPostgresDataKeyStorage(postgresClient)
 	} else {
 		dbClient = database.NewMySQLStorageClient(mysqlClient)
 		pushStorage = v2ps.NewMySQLPushStorage(mysqlClient)
@@ -707,12 +774,29 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		experimentResultStorage = eventcountermysql.NewExperimentResultStorage(mysqlClient)
 		experimentStorage = experimentmysql.NewExperimentStorage(mysqlClient)
 		goalStorage = experimentmysql.NewGoalStorage(mysqlClient)
+		experimentLayerStorage = experimentmysql.NewExperimentLayerStorage(mysqlClient)
+		experimentHoldoutStorage = experimentmysql.NewExperimentHoldoutStorage(mysqlClient)
 		monthlySummaryStorage = insightsmysql.NewMonthlySummaryStorage(mysqlClient)
 		subscriptionStorage = subscriptionmysql.NewSubscriptionStorage(mysqlClient)
 		adminSubscriptionStorage = subscriptionmysql.NewAdminSubscriptionStorage(mysqlClient)
+		webhookStorage = subscriptionmysql.NewWebhookStorage(mysqlClient)
+		webhookDeliveryStorage = subscriptionmysql.NewWebhookDeliveryStorage(mysqlClient)
 		teamStorage = teammysql.NewTeamStorage(mysqlClient)
 		notificationStorage = notificationmysql.NewNotificationStorage(mysqlClient)
 		scheduledFlagChangeStorage = featuremysql.NewScheduledFlagChangeStorage(mysqlClient)
+		changeRequestStorage = featuremysql.NewChangeRequestStorage(mysqlClient)
+		dataKeyStorage = cryptostorage.NewMySQLDataKeyStorage(mysqlClient)
+	}
+	secretCipher, err := s.createSecretCipher(ctx, dataKeyStorage, environmentStorage)
+	if err != nil {
+		logger.Error("Failed to create the secret cipher", zap.Error(err))
+		return err
+	}
+	if secretCipher != nil {
+		pushStorage = v2ps.NewEncryptedPushStorage(pushStorage, secretCipher)
+		flagTriggerStorage = v2fs.NewEncryptedFlagTriggerStorage(flagTriggerStorage, secretCipher)
+		subscriptionStorage = v2ns.NewEncryptedSubscriptionStorage(subscriptionStorage, secretCipher)
+		adminSubscriptionStorage = v2ns.NewEncryptedAdminSubscriptionStorage(adminSubscriptionStorage, secretCipher)
 	}
 
 	// persistentRedisClient
@@ -860,6 +944,17 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 	if err != nil {
 		return err
 	}
+	// teamClient
+	teamClient, err := teamclient.NewClient(*s.teamService, *s.certPath,
+		client.WithPerRPCCredentials(creds),
+		client.WithDialTimeout(clientDialTimeout),
+		client.WithBlock(),
+		client.WithMetrics(registerer),
+		client.WithLogger(logger),
+	)
+	if err != nil {
+		return err
+	}
 	// authService
 	authService, err := s.createAuthService(
 		dbClient,
@@ -929,6 +1024,7 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		featureStorage,
 		featureClient,
 		experimentClient,
+		environmentClient,
 		accountClient,
 		authClient,
 		domainTopicPublisher,
@@ -1009,6 +1105,9 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		dbClient,
 		experimentStorage,
 		goalStorage,
+		experimentLayerStorage,
+		experimentHoldoutStorage,
+		featureStorage,
 		domainTopicPublisher,
 		experimentapi.WithLogger(logger),
 	)
@@ -1031,6 +1130,8 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		flagTriggerStorage,
 		fluiStorage,
 		scheduledFlagChangeStorage,
+		changeRequestStorage,
+		auditLogStorage,
 		accountClient,
 		experimentClient,
 		autoOpsClient,
@@ -1059,6 +1160,12 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		dbClient,
 		adminSubscriptionStorage,
 		subscriptionStorage,
+		webhookStorage,
+		webhookDeliveryStorage,
+		webhook.NewDeliverer(
+			webhook.WithMetrics(registerer),
+			webhook.WithLogger(logger),
+		),
 		accountClient,
 		domainTopicPublisher,
 		subscriptionapi.WithLogger(logger),
@@ -1192,6 +1299,14 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 		rest.WithPort(*s.dashboardServicePort),
 		rest.WithService(NewDashboardService(*s.webConsoleEnvJSPath)),
 		rest.WithMetrics(registerer),
+		// SCIM 2.0 provisioning of the accounts and teams
+		rest.WithService(scimapi.NewSCIMService(
+			accountClient,
+			teamClient,
+			accountStorage,
+			scimapi.WithBaseURL(*s.webURL),
+			scimapi.WithLogger(logger),
+		)),
 	}
 	if *s.openAIAPIKey != "" {
 		if *s.aichatModel == "" {
@@ -1378,6 +1493,30 @@ func (s *server) Run(ctx context.Context, metrics metrics.Metrics, logger *zap.L
 	return nil
 }
 
+// createSecretCipher returns nil when the secrets are stored in plaintext.
+func (s *server) createSecretCipher(
+	ctx context.Context,
+	dataKeyStorage cryptostorage.DataKeyStorage,
+	environmentStorage v2es.EnvironmentStorage,
+) (crypto.SecretCipher, error) {
+	return crypto.NewSecretCipher(
+		ctx,
+		crypto.KeyBackend(*s.secretEncryptionBackend),
+		*s.secretEncryptionKey,
+		dataKeyStorage,
+		func(ctx context.Context, environmentID string) (string, error) {
+			env, err := environmentStorage.GetEnvironmentV2(ctx, environmentID)
+			if err != nil {
+				return "", err
+			}
+			return env.OrganizationId, nil
+		},
+		crypto.WithAWSRegion(*s.secretEncryptionAWSRegion),
+		crypto.WithVaultAddress(*s.secretEncryptionVaultAddress),
+		crypto.WithVaultToken(*s.secretEncryptionVaultToken),
+	)
+}
+
 func (s *server) createMySQLClient(
 	ctx context.Context,
 	registerer metrics.Registerer,
@@ -1385,6 +1524,14 @@ func (s *server) createMySQLClient(
 ) (mysql.Client, error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
+	if *s.operationalDatabaseType == "sqlite" {
+		return sqlite.NewClient(
+			ctx,
+			*s.sqlitePath,
+			sqlite.WithLogger(logger),
+			sqlite.WithMetrics(registerer),
+		)
+	}
 	return mysql.NewClient(
 		ctx,
 		*s.mysqlUser, *s.mysqlPass, *s.mysqlHost,
@@ -1468,6 +1615,9 @@ func (s *server) createPublisher(
 		}
 		factoryOpts = append(factoryOpts, factory.WithRedisClient(redisClient))
 		factoryOpts = append(factoryOpts, factory.WithPartitionCount(*s.pubSubRedisPartitionCount))
+	case factory.Kafka:
+		factoryOpts = append(factoryOpts, factory.WithKafkaBrokers(strings.Split(*s.pubSubKafkaBrokers, ",")))
+		factoryOpts = append(factoryOpts, factory.WithPartitionCount(*s.pubSubKafkaPartitionCount))
 	}
 
 	// Create the PubSub client using the factory
@@ -1521,6 +1671,13 @@ func (s *server) createAuthService(
 		authapi.WithRefreshTokenTTL(*s.refreshTokenTTL),
 		authapi.WithDemoSiteEnabled(*s.isDemoSiteEnabled),
 	}
+	if len(config.OIDC.Providers) > 0 {
+		oidcAuthenticator, err := oidc.NewAuthenticator(&config.OIDC, logger)
+		if err != nil {
+			return nil, err
+		}
+		serviceOptions = append(serviceOptions, authapi.WithOIDCAuthenticator(oidcAuthenticator))
+	}
 	if *s.emailFilter != "" {
 		filter, err := regexp.Compile(*s.emailFilter)
 		if err != nil {
@@ -1599,6 +1756,8 @@ func (s *server) createFeatureService(
 	flagTriggerStorage v2fs.FlagTriggerStorage,
 	fluiStorage v2fs.FeatureLastUsedInfoStorage,
 	scheduledFlagChangeStorage v2fs.ScheduledFlagChangeStorage,
+	changeRequestStorage v2fs.ChangeRequestStorage,
+	auditLogStorage v2als.AuditLogStorage,
 	accountClient accountclient.Client,
 	experimentClient experimentclient.Client,
 	autoOpsClient autoopsclient.Client,
@@ -1619,6 +1778,8 @@ func (s *server) createFeatureService(
 		flagTriggerStorage,
 		fluiStorage,
 		scheduledFlagChangeStorage,
+		changeRequestStorage,
+		auditLogStorage,
 		accountClient,
 		experimentClient,
 		autoOpsClient,
@@ -1798,6 +1959,9 @@ func (s *server) createDWHMySQLClient(
 	host := config.Host
 	port := config.Port
 	database := config.Database
+	if config.UseMainConnection && *s.operationalDatabaseType == "sqlite" {
+		return sqlite.NewClient(ctx, *s.sqlitePath, sqlite.WithLogger(logger))
+	}
 	if config.UseMainConnection {
 		user = *s.mysqlUser
 		password = *s.mysqlPass
@@ -0,0 +1,482 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v4.23.4
+// source: proto/account/custom_role.proto
+
+package account
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Permission_ResourceType int32
+
+const (
+	Permission_RESOURCE_TYPE_UNSPECIFIED Permission_ResourceType = 0
+	Permission_FEATURE                   Permission_ResourceType = 1
+	Permission_SEGMENT                   Permission_ResourceType = 2
+	Permission_EXPERIMENT                Permission_ResourceType = 3
+	Permission_GOAL                      Permission_ResourceType = 4
+	Permission_AUTOOPS_RULE              Permission_ResourceType = 5
+	Permission_PROGRESSIVE_ROLLOUT       Permission_ResourceType = 6
+)
+
+// Enum value maps for Permission_ResourceType.
+var (
+	Permission_ResourceType_name = map[int32]string{
+		0: "RESOURCE_TYPE_UNSPECIFIED",
+		1: "FEATURE",
+		2: "SEGMENT",
+		3: "EXPERIMENT",
+		4: "GOAL",
+		5: "AUTOOPS_RULE",
+		6: "PROGRESSIVE_ROLLOUT",
+	}
+	Permission_ResourceType_value = map[string]int32{
+		"RESOURCE_TYPE_UNSPECIFIED": 0,
+		"FEATURE":                   1,
+		"SEGMENT":                   2,
+		"EXPERIMENT":                3,
+		"GOAL":                      4,
+		"AUTOOPS_RULE":              5,
+		"PROGRESSIVE_ROLLOUT":       6,
+	}
+)
+
+func (x Permission_ResourceType) Enum() *Permission_ResourceType {
+	p := new(Permission_ResourceType)
+	*p = x
+	return p
+}
+
+func (x Permission_ResourceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Permission_ResourceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_account_custom_role_proto_enumTypes[0].Descriptor()
+}
+
+func (Permission_ResourceType) Type() protoreflect.EnumType {
+	return &file_proto_account_custom_role_proto_enumTypes[0]
+}
+
+func (x Permission_ResourceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Permission_ResourceType.Descriptor instead.
+func (Permission_ResourceType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_account_custom_role_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Permission_Action int32
+
+const (
+	Permission_ACTION_UNSPECIFIED Permission_Action = 0
+	Permission_READ               Permission_Action = 1
+	Permission_CREATE             Permission_Action = 2
+	Permission_UPDATE             Permission_Action = 3
+	Permission_DELETE             Permission_Action = 4
+	// Enable or disable a flag.
+	Permission_TOGGLE Permission_Action = 5
+	// Change the targeting of a flag, such as rules, targets and the default strategy.
+	Permission_UPDATE_TARGETING Permission_Action = 6
+)
+
+// Enum value maps for Permission_Action.
+var (
+	Permission_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "READ",
+		2: "CREATE",
+		3: "UPDATE",
+		4: "DELETE",
+		5: "TOGGLE",
+		6: "UPDATE_TARGETING",
+	}
+	Permission_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"READ":               1,
+		"CREATE":             2,
+		"UPDATE":             3,
+		"DELETE":             4,
+		"TOGGLE":             5,
+		"UPDATE_TARGETING":   6,
+	}
+)
+
+func (x Permission_Action) Enum() *Permission_Action {
+	p := new(Permission_Action)
+	*p = x
+	return p
+}
+
+func (x Permission_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Permission_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_account_custom_role_proto_enumTypes[1].Descriptor()
+}
+
+func (Permission_Action) Type() protoreflect.EnumType {
+	return &file_proto_account_custom_role_proto_enumTypes[1]
+}
+
+func (x Permission_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Permission_Action.Descriptor instead.
+func (Permission_Action) EnumDescriptor() ([]byte, []int) {
+	return file_proto_account_custom_role_proto_rawDescGZIP(), []int{0, 1}
+}
+
+// Permission grants a set of actions on a type of resource.
+// When tags or resource_ids are set, the permission applies only to the resources
+// that have one of the tags or one of the IDs. Permissions are additive.
+type Permission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceType Permission_ResourceType `protobuf:"varint,1,opt,name=resource_type,json=resourceType,proto3,enum=bucketeer.account.Permission_ResourceType" json:"resource_type"`
+	Actions      []Permission_Action     `protobuf:"varint,2,rep,packed,name=actions,proto3,enum=bucketeer.account.Permission_Action" json:"actions"`
+	Tags         []string                `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags"`
+	ResourceIds  []string                `protobuf:"bytes,4,rep,name=resource_ids,json=resourceIds,proto3" json:"resource_ids"`
+}
+
+func (x *Permission) Reset() {
+	*x = Permission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_account_custom_role_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Permission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Permission) ProtoMessage() {}
+
+func (x *Permission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_account_custom_role_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Permission.ProtoReflect.Descriptor instead.
+func (*Permission) Descriptor() ([]byte, []int) {
+	return file_proto_account_custom_role_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Permission) GetResourceType() Permission_ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return Permission_RESOURCE_TYPE_UNSPECIFIED
+}
+
+func (x *Permission) GetActions() []Permission_Action {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *Permission) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Permission) GetResourceIds() []string {
+	if x != nil {
+		return x.ResourceIds
+	}
+	return nil
+}
+
+// CustomRole is a named set of permissions of an organization.
+// It is assigned to accounts and teams per environment in addition to the environment role.
+type CustomRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	OrganizationId string        `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id"`
+	Name           string        `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	Description    string        `protobuf:"bytes,4,opt,name=description,proto3" json:"description"`
+	Permissions    []*Permission `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions"`
+	// Built-in roles mirror the VIEWER and EDITOR environment roles and cannot be changed.
+	BuiltIn   bool  `protobuf:"varint,6,opt,name=built_in,json=builtIn,proto3" json:"built_in"`
+	CreatedAt int64 `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt int64 `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+}
+
+func (x *CustomRole) Reset() {
+	*x = CustomRole{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_account_custom_role_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomRole) ProtoMessage() {}
+
+func (x *CustomRole) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_account_custom_role_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomRole.ProtoReflect.Descriptor instead.
+func (*CustomRole) Descriptor() ([]byte, []int) {
+	return file_proto_account_custom_role_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CustomRole) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CustomRole) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CustomRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomRole) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CustomRole) GetPermissions() []*Permission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *CustomRole) GetBuiltIn() bool {
+	if x != nil {
+		return x.BuiltIn
+	}
+	return false
+}
+
+func (x *CustomRole) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CustomRole) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+var File_proto_account_custom_role_proto protoreflect.FileDescriptor
+
+var file_proto_account_custom_role_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x11, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd5, 0x03, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65,
+	0x72, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x0c,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19,
+	0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x46,
+	0x45, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45, 0x47, 0x4d,
+	0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x58, 0x50, 0x45, 0x52, 0x49, 0x4d,
+	0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x4f, 0x41, 0x4c, 0x10, 0x04, 0x12,
+	0x10, 0x0a, 0x0c, 0x41, 0x55, 0x54, 0x4f, 0x4f, 0x50, 0x53, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x10,
+	0x05, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x56, 0x45,
+	0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x4f, 0x55, 0x54, 0x10, 0x06, 0x22, 0x70, 0x0a, 0x06, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x52, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x0a,
+	0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x54, 0x4f,
+	0x47, 0x47, 0x4c, 0x45, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x06, 0x22, 0x95, 0x02, 0x0a,
+	0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0b, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x62,
+	0x75, 0x69, 0x6c, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62,
+	0x75, 0x69, 0x6c, 0x74, 0x49, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_proto_account_custom_role_proto_rawDescOnce sync.Once
+	file_proto_account_custom_role_proto_rawDescData = file_proto_account_custom_role_proto_rawDesc
+)
+
+func file_proto_account_custom_role_proto_rawDescGZIP() []byte {
+	file_proto_account_custom_role_proto_rawDescOnce.Do(func() {
+		file_proto_account_custom_role_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_account_custom_role_proto_rawDescData)
+	})
+	return file_proto_account_custom_role_proto_rawDescData
+}
+
+var file_proto_account_custom_role_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_account_custom_role_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_account_custom_role_proto_goTypes = []interface{}{
+	(Permission_ResourceType)(0), // 0: bucketeer.account.Permission.ResourceType
+	(Permission_Action)(0),       // 1: bucketeer.account.Permission.Action
+	(*Permission)(nil),           // 2: bucketeer.account.Permission
+	(*CustomRole)(nil),           // 3: bucketeer.account.CustomRole
+}
+var file_proto_account_custom_role_proto_depIdxs = []int32{
+	0, // 0: bucketeer.account.Permission.resource_type:type_name -> bucketeer.account.Permission.ResourceType
+	1, // 1: bucketeer.account.Permission.actions:type_name -> bucketeer.account.Permission.Action
+	2, // 2: bucketeer.account.CustomRole.permissions:type_name -> bucketeer.account.Permission
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_account_custom_role_proto_init() }
+func file_proto_account_custom_role_proto_init() {
+	if File_proto_account_custom_role_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_account_custom_role_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Permission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_account_custom_role_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_account_custom_role_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_account_custom_role_proto_goTypes,
+		DependencyIndexes: file_proto_account_custom_role_proto_depIdxs,
+		EnumInfos:         file_proto_account_custom_role_proto_enumTypes,
+		MessageInfos:      file_proto_account_custom_role_proto_msgTypes,
+	}.Build()
+	File_proto_account_custom_role_proto = out.File
+	file_proto_account_custom_role_proto_rawDesc = nil
+	file_proto_account_custom_role_proto_goTypes = nil
+	file_proto_account_custom_role_proto_depIdxs = nil
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v4.23.4
+// source: proto/autoops/guardrail.proto
+
+package autoops
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GuardrailGoal is a goal that must not regress while an experiment or a
+// progressive rollout is running. When the posterior probability that a
+// variation performs worse than the control on this goal reaches the
+// threshold, the rollout is halted and the flag is switched to the control.
+type GuardrailGoal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GoalId string `protobuf:"bytes,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id"`
+	// Probability of being worse than the control, in (0, 1), at which the
+	// guardrail halts. Zero means the default of 0.95.
+	Threshold float64 `protobuf:"fixed64,2,opt,name=threshold,proto3" json:"threshold"`
+}
+
+func (x *GuardrailGoal) Reset() {
+	*x = GuardrailGoal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_autoops_guardrail_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuardrailGoal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardrailGoal) ProtoMessage() {}
+
+func (x *GuardrailGoal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_autoops_guardrail_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardrailGoal.ProtoReflect.Descriptor instead.
+func (*GuardrailGoal) Descriptor() ([]byte, []int) {
+	return file_proto_autoops_guardrail_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GuardrailGoal) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *GuardrailGoal) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+var File_proto_autoops_guardrail_proto protoreflect.FileDescriptor
+
+var file_proto_autoops_guardrail_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x6f, 0x70, 0x73, 0x2f,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x11, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6f,
+	0x70, 0x73, 0x22, 0x46, 0x0a, 0x0d, 0x47, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x47,
+	0x6f, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6f, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x6f, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65,
+	0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2f,
+	0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x6f, 0x70, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_autoops_guardrail_proto_rawDescOnce sync.Once
+	file_proto_autoops_guardrail_proto_rawDescData = file_proto_autoops_guardrail_proto_rawDesc
+)
+
+func file_proto_autoops_guardrail_proto_rawDescGZIP() []byte {
+	file_proto_autoops_guardrail_proto_rawDescOnce.Do(func() {
+		file_proto_autoops_guardrail_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_autoops_guardrail_proto_rawDescData)
+	})
+	return file_proto_autoops_guardrail_proto_rawDescData
+}
+
+var file_proto_autoops_guardrail_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_autoops_guardrail_proto_goTypes = []interface{}{
+	(*GuardrailGoal)(nil), // 0: bucketeer.autoops.GuardrailGoal
+}
+var file_proto_autoops_guardrail_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_autoops_guardrail_proto_init() }
+func file_proto_autoops_guardrail_proto_init() {
+	if File_proto_autoops_guardrail_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_autoops_guardrail_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuardrailGoal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_autoops_guardrail_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_autoops_guardrail_proto_goTypes,
+		DependencyIndexes: file_proto_autoops_guardrail_proto_depIdxs,
+		MessageInfos:      file_proto_autoops_guardrail_proto_msgTypes,
+	}.Build()
+	File_proto_autoops_guardrail_proto = out.File
+	file_proto_autoops_guardrail_proto_rawDesc = nil
+	file_proto_autoops_guardrail_proto_goTypes = nil
+	file_proto_autoops_guardrail_proto_depIdxs = nil
+}
@@ -465,6 +465,32 @@ func local_request_AutoOpsService_ExecuteProgressiveRollout_0(ctx context.Contex
 
 }
 
+func request_AutoOpsService_ExecuteGuardrailHalt_0(ctx context.Context, marshaler runtime.Marshaler, client AutoOpsServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ExecuteGuardrailHaltRequest
+	var metadata runtime.ServerMetadata
+
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.ExecuteGuardrailHalt(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_AutoOpsService_ExecuteGuardrailHalt_0(ctx context.Context, marshaler runtime.Marshaler, server AutoOpsServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ExecuteGuardrailHaltRequest
+	var metadata runtime.ServerMetadata
+
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := server.ExecuteGuardrailHalt(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
 // RegisterAutoOpsServiceHandlerServer registers the http handlers for service AutoOpsService to "mux".
 // UnaryRPC     :call AutoOpsServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -821,6 +847,31 @@ func RegisterAutoOpsServiceHandlerServer(ctx context.Context, mux *runtime.Serve
 
 	})
 
+	mux.Handle("POST", pattern_AutoOpsService_ExecuteGuardrailHalt_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateIncomingContext(ctx, mux, req, "/bucketeer.autoops.AutoOpsService/ExecuteGuardrailHalt", runtime.WithHTTPPathPattern("/v1/auto_ops_rule/guardrail_halt"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_AutoOpsService_ExecuteGuardrailHalt_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_AutoOpsService_ExecuteGuardrailHalt_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
@@ -1170,6 +1221,28 @@ func RegisterAutoOpsServiceHandlerClient(ctx context.Context, mux *runtime.Serve
 
 	})
 
+	mux.Handle("POST", pattern_AutoOpsService_ExecuteGuardrailHalt_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		var err error
+		var annotatedContext context.Context
+		annotatedContext, err = runtime.AnnotateContext(ctx, mux, req, "/bucketeer.autoops.AutoOpsService/ExecuteGuardrailHalt", runtime.WithHTTPPathPattern("/v1/auto_ops_rule/guardrail_halt"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_AutoOpsService_ExecuteGuardrailHalt_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_AutoOpsService_ExecuteGuardrailHalt_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
@@ -1201,6 +1274,8 @@ var (
 	pattern_AutoOpsService_ListProgressiveRollouts_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"v1", "progressive_rollouts"}, ""))
 
 	pattern_AutoOpsService_ExecuteProgressiveRollout_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "progressive_rollout", "execute"}, ""))
+
+	pattern_AutoOpsService_ExecuteGuardrailHalt_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "auto_ops_rule", "guardrail_halt"}, ""))
 )
 
 var (
@@ -1231,4 +1306,6 @@ var (
 	forward_AutoOpsService_ListProgressiveRollouts_0 = runtime.ForwardResponseMessage
 
 	forward_AutoOpsService_ExecuteProgressiveRollout_0 = runtime.ForwardResponseMessage
+
+	forward_AutoOpsService_ExecuteGuardrailHalt_0 = runtime.ForwardResponseMessage
 )
@@ -54,6 +54,13 @@ type EnvironmentV2 struct {
 	AutoArchiveEnabled       bool  `protobuf:"varint,12,opt,name=auto_archive_enabled,json=autoArchiveEnabled,proto3" json:"auto_archive_enabled"`
 	AutoArchiveUnusedDays    int32 `protobuf:"varint,13,opt,name=auto_archive_unused_days,json=autoArchiveUnusedDays,proto3" json:"auto_archive_unused_days"`
 	AutoArchiveCheckCodeRefs bool  `protobuf:"varint,14,opt,name=auto_archive_check_code_refs,json=autoArchiveCheckCodeRefs,proto3" json:"auto_archive_check_code_refs"`
+	// Change approval configuration.
+	// When enabled, flag changes require an approved change request. Flag triggers and
+	// auto operations that enable a flag, progressive rollouts and scheduled flag changes
+	// are rejected. Operations that only stop the exposure of a flag (disabling triggers
+	// and auto operations, guardrail halts) are exempt so they can be used as kill switches.
+	RequireChangeApproval      bool  `protobuf:"varint,15,opt,name=require_change_approval,json=requireChangeApproval,proto3" json:"require_change_approval"`
+	ChangeApprovalMinApprovers int32 `protobuf:"varint,16,opt,name=change_approval_min_approvers,json=changeApprovalMinApprovers,proto3" json:"change_approval_min_approvers"`
 }
 
 func (x *EnvironmentV2) Reset() {
@@ -186,13 +193,27 @@ func (x *EnvironmentV2) GetAutoArchiveCheckCodeRefs() bool {
 	return false
 }
 
+func (x *EnvironmentV2) GetRequireChangeApproval() bool {
+	if x != nil {
+		return x.RequireChangeApproval
+	}
+	return false
+}
+
+func (x *EnvironmentV2) GetChangeApprovalMinApprovers() int32 {
+	if x != nil {
+		return x.ChangeApprovalMinApprovers
+	}
+	return 0
+}
+
 var File_proto_environment_environment_proto protoreflect.FileDescriptor
 
 var file_proto_environment_environment_proto_rawDesc = []byte{
 	0x0a, 0x23, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
 	0x65, 0x6e, 0x74, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72,
-	0x2e, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x94, 0x04, 0x0a,
+	0x2e, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x8f, 0x05, 0x0a,
 	0x0d, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x32, 0x12, 0x0e,
 	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
 	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
@@ -226,11 +247,18 @@ var file_proto_environment_environment_proto_rawDesc = []byte{
 	0x69, 0x76, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x72,
 	0x65, 0x66, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x61, 0x75, 0x74, 0x6f, 0x41,
 	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x66, 0x73, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x66, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x1d, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x6d,
+	0x69, 0x6e, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x1a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x42, 0x38,
+	0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x65, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
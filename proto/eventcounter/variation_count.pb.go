@@ -47,6 +47,11 @@ type VariationCount struct {
 	VariationValue          string  `protobuf:"bytes,6,opt,name=variation_value,json=variationValue,proto3" json:"variation_value"`
 	ValueSumPerUserMean     float64 `protobuf:"fixed64,7,opt,name=value_sum_per_user_mean,json=valueSumPerUserMean,proto3" json:"value_sum_per_user_mean"`
 	ValueSumPerUserVariance float64 `protobuf:"fixed64,8,opt,name=value_sum_per_user_variance,json=valueSumPerUserVariance,proto3" json:"value_sum_per_user_variance"`
+	// Sample variance of the per-user goal event count, and the sample
+	// covariance between the per-user value sum and event count. Used by the
+	// delta method for ratio (value per event) metrics.
+	EventCountPerUserVariance    float64 `protobuf:"fixed64,9,opt,name=event_count_per_user_variance,json=eventCountPerUserVariance,proto3" json:"event_count_per_user_variance"`
+	ValueSumEventCountCovariance float64 `protobuf:"fixed64,10,opt,name=value_sum_event_count_covariance,json=valueSumEventCountCovariance,proto3" json:"value_sum_event_count_covariance"`
 }
 
 func (x *VariationCount) Reset() {
@@ -137,6 +142,20 @@ func (x *VariationCount) GetValueSumPerUserVariance() float64 {
 	return 0
 }
 
+func (x *VariationCount) GetEventCountPerUserVariance() float64 {
+	if x != nil {
+		return x.EventCountPerUserVariance
+	}
+	return 0
+}
+
+func (x *VariationCount) GetValueSumEventCountCovariance() float64 {
+	if x != nil {
+		return x.ValueSumEventCountCovariance
+	}
+	return 0
+}
+
 var File_proto_eventcounter_variation_count_proto protoreflect.FileDescriptor
 
 var file_proto_eventcounter_variation_count_proto_rawDesc = []byte{
@@ -144,7 +163,7 @@ var file_proto_eventcounter_variation_count_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
 	0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x62, 0x75, 0x63, 0x6b,
 	0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x65, 0x72, 0x22, 0xcc, 0x02, 0x0a, 0x0e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x65, 0x72, 0x22, 0xd6, 0x03, 0x0a, 0x0e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x61, 0x72,
 	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72,
@@ -165,11 +184,19 @@ var file_proto_eventcounter_variation_count_proto_rawDesc = []byte{
 	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
 	0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x53,
 	0x75, 0x6d, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63,
-	0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x12, 0x40, 0x0a, 0x1d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x19, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x75, 0x6d,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1c, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x53, 0x75, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x6f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x65, 0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72,
+	0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
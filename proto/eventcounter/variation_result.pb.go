@@ -77,6 +77,14 @@ type VariationResult struct {
 	// default stopping threshold) when there is strong evidence of a
 	// value-per-user difference. See Summary.value_safe_to_stop.
 	ValueSequentialBayesFactor float64 `protobuf:"fixed64,27,opt,name=value_sequential_bayes_factor,json=valueSequentialBayesFactor,proto3" json:"value_sequential_bayes_factor"`
+	// Relative lift of the conversion rate over the baseline, i.e. the posterior
+	// of (p_variation - p_baseline) / p_baseline. The median and the 2.5/97.5
+	// percentiles form the 95% credible interval. Empty for the baseline.
+	CvrLift *DistributionSummary `protobuf:"bytes,28,opt,name=cvr_lift,json=cvrLift,proto3" json:"cvr_lift"`
+	// Relative lift of the goal's value metric (as selected by the goal's
+	// metric type) over the baseline. Empty for the baseline or when the value
+	// analysis is skipped.
+	GoalValueLift *DistributionSummary `protobuf:"bytes,29,opt,name=goal_value_lift,json=goalValueLift,proto3" json:"goal_value_lift"`
 }
 
 func (x *VariationResult) Reset() {
@@ -300,6 +308,20 @@ func (x *VariationResult) GetValueSequentialBayesFactor() float64 {
 	return 0
 }
 
+func (x *VariationResult) GetCvrLift() *DistributionSummary {
+	if x != nil {
+		return x.CvrLift
+	}
+	return nil
+}
+
+func (x *VariationResult) GetGoalValueLift() *DistributionSummary {
+	if x != nil {
+		return x.GoalValueLift
+	}
+	return nil
+}
+
 var File_proto_eventcounter_variation_result_proto protoreflect.FileDescriptor
 
 var file_proto_eventcounter_variation_result_proto_rawDesc = []byte{
@@ -315,7 +337,7 @@ var file_proto_eventcounter_variation_result_proto_rawDesc = []byte{
 	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
 	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x9d, 0x13, 0x0a, 0x0f, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x6f, 0x22, 0xba, 0x14, 0x0a, 0x0f, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
 	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x61, 0x72,
 	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x51, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65,
@@ -469,11 +491,21 @@ var file_proto_eventcounter_variation_result_proto_rawDesc = []byte{
 	0x61, 0x6c, 0x5f, 0x62, 0x61, 0x79, 0x65, 0x73, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
 	0x1b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x65, 0x71, 0x75,
 	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x61, 0x79, 0x65, 0x73, 0x46, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x12, 0x46, 0x0a, 0x08, 0x63, 0x76, 0x72, 0x5f, 0x6c, 0x69, 0x66, 0x74, 0x18, 0x1c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x07, 0x63, 0x76, 0x72, 0x4c, 0x69, 0x66, 0x74, 0x12, 0x53, 0x0a, 0x0f, 0x67, 0x6f, 0x61,
+	0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6c, 0x69, 0x66, 0x74, 0x18, 0x1d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x0d, 0x67, 0x6f, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x69, 0x66, 0x74, 0x42, 0x39,
+	0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x65, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -517,11 +549,13 @@ var file_proto_eventcounter_variation_result_proto_depIdxs = []int32{
 	3,  // 18: bucketeer.eventcounter.VariationResult.goal_value_sum_per_user_median_timeseries:type_name -> bucketeer.eventcounter.Timeseries
 	3,  // 19: bucketeer.eventcounter.VariationResult.goal_value_sum_per_user_percentile025_timeseries:type_name -> bucketeer.eventcounter.Timeseries
 	3,  // 20: bucketeer.eventcounter.VariationResult.goal_value_sum_per_user_percentile975_timeseries:type_name -> bucketeer.eventcounter.Timeseries
-	21, // [21:21] is the sub-list for method output_type
-	21, // [21:21] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	2,  // 21: bucketeer.eventcounter.VariationResult.cvr_lift:type_name -> bucketeer.eventcounter.DistributionSummary
+	2,  // 22: bucketeer.eventcounter.VariationResult.goal_value_lift:type_name -> bucketeer.eventcounter.DistributionSummary
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_proto_eventcounter_variation_result_proto_init() }
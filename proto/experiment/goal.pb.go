@@ -84,22 +84,126 @@ func (Goal_ConnectionType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_experiment_goal_proto_rawDescGZIP(), []int{0, 0}
 }
 
+// MetricType selects how the experiment calculator analyses the goal.
+type Goal_MetricType int32
+
+const (
+	Goal_CONVERSION Goal_MetricType = 0 // Share of evaluated users who fired the goal.
+	Goal_SUM        Goal_MetricType = 1 // Value per evaluated user, non-converters count as 0.
+	Goal_MEAN       Goal_MetricType = 2 // Value per converting user.
+	Goal_RATIO      Goal_MetricType = 3 // Value per goal event (e.g. average order value).
+)
+
+// Enum value maps for Goal_MetricType.
+var (
+	Goal_MetricType_name = map[int32]string{
+		0: "CONVERSION",
+		1: "SUM",
+		2: "MEAN",
+		3: "RATIO",
+	}
+	Goal_MetricType_value = map[string]int32{
+		"CONVERSION": 0,
+		"SUM":        1,
+		"MEAN":       2,
+		"RATIO":      3,
+	}
+)
+
+func (x Goal_MetricType) Enum() *Goal_MetricType {
+	p := new(Goal_MetricType)
+	*p = x
+	return p
+}
+
+func (x Goal_MetricType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Goal_MetricType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_experiment_goal_proto_enumTypes[1].Descriptor()
+}
+
+func (Goal_MetricType) Type() protoreflect.EnumType {
+	return &file_proto_experiment_goal_proto_enumTypes[1]
+}
+
+func (x Goal_MetricType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Goal_MetricType.Descriptor instead.
+func (Goal_MetricType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_experiment_goal_proto_rawDescGZIP(), []int{0, 1}
+}
+
+type Goal_ImprovementDirection int32
+
+const (
+	Goal_HIGHER_IS_BETTER Goal_ImprovementDirection = 0
+	Goal_LOWER_IS_BETTER  Goal_ImprovementDirection = 1 // e.g. page load duration or error count.
+)
+
+// Enum value maps for Goal_ImprovementDirection.
+var (
+	Goal_ImprovementDirection_name = map[int32]string{
+		0: "HIGHER_IS_BETTER",
+		1: "LOWER_IS_BETTER",
+	}
+	Goal_ImprovementDirection_value = map[string]int32{
+		"HIGHER_IS_BETTER": 0,
+		"LOWER_IS_BETTER":  1,
+	}
+)
+
+func (x Goal_ImprovementDirection) Enum() *Goal_ImprovementDirection {
+	p := new(Goal_ImprovementDirection)
+	*p = x
+	return p
+}
+
+func (x Goal_ImprovementDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Goal_ImprovementDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_experiment_goal_proto_enumTypes[2].Descriptor()
+}
+
+func (Goal_ImprovementDirection) Type() protoreflect.EnumType {
+	return &file_proto_experiment_goal_proto_enumTypes[2]
+}
+
+func (x Goal_ImprovementDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Goal_ImprovementDirection.Descriptor instead.
+func (Goal_ImprovementDirection) EnumDescriptor() ([]byte, []int) {
+	return file_proto_experiment_goal_proto_rawDescGZIP(), []int{0, 2}
+}
+
 type Goal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             string                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Name           string                       `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	Description    string                       `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
-	Deleted        bool                         `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted"`
-	CreatedAt      int64                        `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt      int64                        `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	IsInUseStatus  bool                         `protobuf:"varint,7,opt,name=is_in_use_status,json=isInUseStatus,proto3" json:"is_in_use_status"` // This field is set only when APIs return.
-	Archived       bool                         `protobuf:"varint,8,opt,name=archived,proto3" json:"archived"`
-	ConnectionType Goal_ConnectionType          `protobuf:"varint,9,opt,name=connection_type,json=connectionType,proto3,enum=bucketeer.experiment.Goal_ConnectionType" json:"connection_type"`
-	Experiments    []*Goal_ExperimentReference  `protobuf:"bytes,10,rep,name=experiments,proto3" json:"experiments"`
-	AutoOpsRules   []*Goal_AutoOpsRuleReference `protobuf:"bytes,11,rep,name=auto_ops_rules,json=autoOpsRules,proto3" json:"auto_ops_rules"`
+	Id                   string                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name                 string                       `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Description          string                       `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
+	Deleted              bool                         `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted"`
+	CreatedAt            int64                        `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            int64                        `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	IsInUseStatus        bool                         `protobuf:"varint,7,opt,name=is_in_use_status,json=isInUseStatus,proto3" json:"is_in_use_status"` // This field is set only when APIs return.
+	Archived             bool                         `protobuf:"varint,8,opt,name=archived,proto3" json:"archived"`
+	ConnectionType       Goal_ConnectionType          `protobuf:"varint,9,opt,name=connection_type,json=connectionType,proto3,enum=bucketeer.experiment.Goal_ConnectionType" json:"connection_type"`
+	Experiments          []*Goal_ExperimentReference  `protobuf:"bytes,10,rep,name=experiments,proto3" json:"experiments"`
+	AutoOpsRules         []*Goal_AutoOpsRuleReference `protobuf:"bytes,11,rep,name=auto_ops_rules,json=autoOpsRules,proto3" json:"auto_ops_rules"`
+	MetricType           Goal_MetricType              `protobuf:"varint,12,opt,name=metric_type,json=metricType,proto3,enum=bucketeer.experiment.Goal_MetricType" json:"metric_type"`
+	ImprovementDirection Goal_ImprovementDirection    `protobuf:"varint,13,opt,name=improvement_direction,json=improvementDirection,proto3,enum=bucketeer.experiment.Goal_ImprovementDirection" json:"improvement_direction"`
+	// Percentile (1-100) at which per-user goal values are winsorized before
+	// the value analysis. 0 uses the server default (99); 100 disables capping.
+	ValueCapPercentile int32 `protobuf:"varint,14,opt,name=value_cap_percentile,json=valueCapPercentile,proto3" json:"value_cap_percentile"`
 }
 
 func (x *Goal) Reset() {
@@ -211,6 +315,27 @@ func (x *Goal) GetAutoOpsRules() []*Goal_AutoOpsRuleReference {
 	return nil
 }
 
+func (x *Goal) GetMetricType() Goal_MetricType {
+	if x != nil {
+		return x.MetricType
+	}
+	return Goal_CONVERSION
+}
+
+func (x *Goal) GetImprovementDirection() Goal_ImprovementDirection {
+	if x != nil {
+		return x.ImprovementDirection
+	}
+	return Goal_HIGHER_IS_BETTER
+}
+
+func (x *Goal) GetValueCapPercentile() int32 {
+	if x != nil {
+		return x.ValueCapPercentile
+	}
+	return 0
+}
+
 type Goal_ExperimentReference struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -371,7 +496,7 @@ var file_proto_experiment_goal_proto_rawDesc = []byte{
 	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x75,
 	0x74, 0x6f, 0x6f, 0x70, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6f, 0x70, 0x73, 0x5f, 0x72,
-	0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x98, 0x07, 0x0a, 0x04, 0x47, 0x6f,
+	0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf7, 0x09, 0x0a, 0x04, 0x47, 0x6f,
 	0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
@@ -402,7 +527,21 @@ var file_proto_experiment_goal_proto_rawDesc = []byte{
 	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x2e,
 	0x41, 0x75, 0x74, 0x6f, 0x4f, 0x70, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72,
 	0x65, 0x6e, 0x63, 0x65, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x4f, 0x70, 0x73, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x1a, 0xbc, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x65, 0x73, 0x12, 0x46, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x65, 0x65, 0x72, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47,
+	0x6f, 0x61, 0x6c, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x64, 0x0a, 0x15, 0x69, 0x6d,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x47, 0x6f, 0x61, 0x6c, 0x2e, 0x49, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x69, 0x6d, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x0a, 0x14, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x63, 0x61, 0x70, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x61, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x1a, 0xbc, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
 	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
 	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
@@ -429,11 +568,19 @@ var file_proto_experiment_goal_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
 	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x58, 0x50, 0x45, 0x52, 0x49, 0x4d,
 	0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x10, 0x02, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4f, 0x4e, 0x10, 0x02, 0x22, 0x3a, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e,
+	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4d,
+	0x45, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x10, 0x03,
+	0x22, 0x41, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x10, 0x48, 0x49, 0x47, 0x48,
+	0x45, 0x52, 0x5f, 0x49, 0x53, 0x5f, 0x42, 0x45, 0x54, 0x54, 0x45, 0x52, 0x10, 0x00, 0x12, 0x13,
+	0x0a, 0x0f, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x49, 0x53, 0x5f, 0x42, 0x45, 0x54, 0x54, 0x45,
+	0x52, 0x10, 0x01, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -448,27 +595,31 @@ func file_proto_experiment_goal_proto_rawDescGZIP() []byte {
 	return file_proto_experiment_goal_proto_rawDescData
 }
 
-var file_proto_experiment_goal_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_experiment_goal_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
 var file_proto_experiment_goal_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_proto_experiment_goal_proto_goTypes = []interface{}{
 	(Goal_ConnectionType)(0),          // 0: bucketeer.experiment.Goal.ConnectionType
-	(*Goal)(nil),                      // 1: bucketeer.experiment.Goal
-	(*Goal_ExperimentReference)(nil),  // 2: bucketeer.experiment.Goal.ExperimentReference
-	(*Goal_AutoOpsRuleReference)(nil), // 3: bucketeer.experiment.Goal.AutoOpsRuleReference
-	(Experiment_Status)(0),            // 4: bucketeer.experiment.Experiment.Status
-	(autoops.AutoOpsStatus)(0),        // 5: bucketeer.autoops.AutoOpsStatus
+	(Goal_MetricType)(0),              // 1: bucketeer.experiment.Goal.MetricType
+	(Goal_ImprovementDirection)(0),    // 2: bucketeer.experiment.Goal.ImprovementDirection
+	(*Goal)(nil),                      // 3: bucketeer.experiment.Goal
+	(*Goal_ExperimentReference)(nil),  // 4: bucketeer.experiment.Goal.ExperimentReference
+	(*Goal_AutoOpsRuleReference)(nil), // 5: bucketeer.experiment.Goal.AutoOpsRuleReference
+	(Experiment_Status)(0),            // 6: bucketeer.experiment.Experiment.Status
+	(autoops.AutoOpsStatus)(0),        // 7: bucketeer.autoops.AutoOpsStatus
 }
 var file_proto_experiment_goal_proto_depIdxs = []int32{
 	0, // 0: bucketeer.experiment.Goal.connection_type:type_name -> bucketeer.experiment.Goal.ConnectionType
-	2, // 1: bucketeer.experiment.Goal.experiments:type_name -> bucketeer.experiment.Goal.ExperimentReference
-	3, // 2: bucketeer.experiment.Goal.auto_ops_rules:type_name -> bucketeer.experiment.Goal.AutoOpsRuleReference
-	4, // 3: bucketeer.experiment.Goal.ExperimentReference.status:type_name -> bucketeer.experiment.Experiment.Status
-	5, // 4: bucketeer.experiment.Goal.AutoOpsRuleReference.auto_ops_status:type_name -> bucketeer.autoops.AutoOpsStatus
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	4, // 1: bucketeer.experiment.Goal.experiments:type_name -> bucketeer.experiment.Goal.ExperimentReference
+	5, // 2: bucketeer.experiment.Goal.auto_ops_rules:type_name -> bucketeer.experiment.Goal.AutoOpsRuleReference
+	1, // 3: bucketeer.experiment.Goal.metric_type:type_name -> bucketeer.experiment.Goal.MetricType
+	2, // 4: bucketeer.experiment.Goal.improvement_direction:type_name -> bucketeer.experiment.Goal.ImprovementDirection
+	6, // 5: bucketeer.experiment.Goal.ExperimentReference.status:type_name -> bucketeer.experiment.Experiment.Status
+	7, // 6: bucketeer.experiment.Goal.AutoOpsRuleReference.auto_ops_status:type_name -> bucketeer.autoops.AutoOpsStatus
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_proto_experiment_goal_proto_init() }
@@ -520,7 +671,7 @@ func file_proto_experiment_goal_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_experiment_goal_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      3,
 			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
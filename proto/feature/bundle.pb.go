@@ -0,0 +1,378 @@
+// Copyright 2026 The Bucketeer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v4.23.4
+// source: proto/feature/bundle.proto
+
+package feature
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Serialization format of an exported feature bundle.
+type FeatureBundleFormat int32
+
+const (
+	FeatureBundleFormat_FEATURE_BUNDLE_FORMAT_YAML FeatureBundleFormat = 0
+	FeatureBundleFormat_FEATURE_BUNDLE_FORMAT_JSON FeatureBundleFormat = 1
+)
+
+// Enum value maps for FeatureBundleFormat.
+var (
+	FeatureBundleFormat_name = map[int32]string{
+		0: "FEATURE_BUNDLE_FORMAT_YAML",
+		1: "FEATURE_BUNDLE_FORMAT_JSON",
+	}
+	FeatureBundleFormat_value = map[string]int32{
+		"FEATURE_BUNDLE_FORMAT_YAML": 0,
+		"FEATURE_BUNDLE_FORMAT_JSON": 1,
+	}
+)
+
+func (x FeatureBundleFormat) Enum() *FeatureBundleFormat {
+	p := new(FeatureBundleFormat)
+	*p = x
+	return p
+}
+
+func (x FeatureBundleFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeatureBundleFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_feature_bundle_proto_enumTypes[0].Descriptor()
+}
+
+func (FeatureBundleFormat) Type() protoreflect.EnumType {
+	return &file_proto_feature_bundle_proto_enumTypes[0]
+}
+
+func (x FeatureBundleFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeatureBundleFormat.Descriptor instead.
+func (FeatureBundleFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_feature_bundle_proto_rawDescGZIP(), []int{0}
+}
+
+type FeatureBundleChange_ResourceType int32
+
+const (
+	FeatureBundleChange_FEATURE             FeatureBundleChange_ResourceType = 0
+	FeatureBundleChange_SEGMENT             FeatureBundleChange_ResourceType = 1
+	FeatureBundleChange_TAG                 FeatureBundleChange_ResourceType = 2
+	FeatureBundleChange_AUTO_OPS_RULE       FeatureBundleChange_ResourceType = 3
+	FeatureBundleChange_PROGRESSIVE_ROLLOUT FeatureBundleChange_ResourceType = 4
+)
+
+// Enum value maps for FeatureBundleChange_ResourceType.
+var (
+	FeatureBundleChange_ResourceType_name = map[int32]string{
+		0: "FEATURE",
+		1: "SEGMENT",
+		2: "TAG",
+		3: "AUTO_OPS_RULE",
+		4: "PROGRESSIVE_ROLLOUT",
+	}
+	FeatureBundleChange_ResourceType_value = map[string]int32{
+		"FEATURE":             0,
+		"SEGMENT":             1,
+		"TAG":                 2,
+		"AUTO_OPS_RULE":       3,
+		"PROGRESSIVE_ROLLOUT": 4,
+	}
+)
+
+func (x FeatureBundleChange_ResourceType) Enum() *FeatureBundleChange_ResourceType {
+	p := new(FeatureBundleChange_ResourceType)
+	*p = x
+	return p
+}
+
+func (x FeatureBundleChange_ResourceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeatureBundleChange_ResourceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_feature_bundle_proto_enumTypes[1].Descriptor()
+}
+
+func (FeatureBundleChange_ResourceType) Type() protoreflect.EnumType {
+	return &file_proto_feature_bundle_proto_enumTypes[1]
+}
+
+func (x FeatureBundleChange_ResourceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeatureBundleChange_ResourceType.Descriptor instead.
+func (FeatureBundleChange_ResourceType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_feature_bundle_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type FeatureBundleChange_ChangeAction int32
+
+const (
+	FeatureBundleChange_CREATE  FeatureBundleChange_ChangeAction = 0
+	FeatureBundleChange_UPDATE  FeatureBundleChange_ChangeAction = 1
+	FeatureBundleChange_ARCHIVE FeatureBundleChange_ChangeAction = 2
+	FeatureBundleChange_DELETE  FeatureBundleChange_ChangeAction = 3
+)
+
+// Enum value maps for FeatureBundleChange_ChangeAction.
+var (
+	FeatureBundleChange_ChangeAction_name = map[int32]string{
+		0: "CREATE",
+		1: "UPDATE",
+		2: "ARCHIVE",
+		3: "DELETE",
+	}
+	FeatureBundleChange_ChangeAction_value = map[string]int32{
+		"CREATE":  0,
+		"UPDATE":  1,
+		"ARCHIVE": 2,
+		"DELETE":  3,
+	}
+)
+
+func (x FeatureBundleChange_ChangeAction) Enum() *FeatureBundleChange_ChangeAction {
+	p := new(FeatureBundleChange_ChangeAction)
+	*p = x
+	return p
+}
+
+func (x FeatureBundleChange_ChangeAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeatureBundleChange_ChangeAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_feature_bundle_proto_enumTypes[2].Descriptor()
+}
+
+func (FeatureBundleChange_ChangeAction) Type() protoreflect.EnumType {
+	return &file_proto_feature_bundle_proto_enumTypes[2]
+}
+
+func (x FeatureBundleChange_ChangeAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeatureBundleChange_ChangeAction.Descriptor instead.
+func (FeatureBundleChange_ChangeAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_feature_bundle_proto_rawDescGZIP(), []int{0, 1}
+}
+
+// FeatureBundleChange describes a single change an import would make
+// (or made) to the target environment.
+type FeatureBundleChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceType FeatureBundleChange_ResourceType `protobuf:"varint,1,opt,name=resource_type,json=resourceType,proto3,enum=bucketeer.feature.FeatureBundleChange_ResourceType" json:"resource_type"`
+	Action       FeatureBundleChange_ChangeAction `protobuf:"varint,2,opt,name=action,proto3,enum=bucketeer.feature.FeatureBundleChange_ChangeAction" json:"action"`
+	// Feature ID, segment name or tag name. For auto operations and
+	// progressive rollouts it is the feature ID the resource belongs to.
+	ResourceId string `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id"`
+	// Changed fields for updates (e.g. "name", "rules", "variations").
+	Fields []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields"`
+}
+
+func (x *FeatureBundleChange) Reset() {
+	*x = FeatureBundleChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_feature_bundle_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeatureBundleChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureBundleChange) ProtoMessage() {}
+
+func (x *FeatureBundleChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_feature_bundle_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureBundleChange.ProtoReflect.Descriptor instead.
+func (*FeatureBundleChange) Descriptor() ([]byte, []int) {
+	return file_proto_feature_bundle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FeatureBundleChange) GetResourceType() FeatureBundleChange_ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return FeatureBundleChange_FEATURE
+}
+
+func (x *FeatureBundleChange) GetAction() FeatureBundleChange_ChangeAction {
+	if x != nil {
+		return x.Action
+	}
+	return FeatureBundleChange_CREATE
+}
+
+func (x *FeatureBundleChange) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *FeatureBundleChange) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+var File_proto_feature_bundle_proto protoreflect.FileDescriptor
+
+var file_proto_feature_bundle_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2f,
+	0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x95, 0x03, 0x0a, 0x13, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33,
+	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x4b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x33, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x5d, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x45, 0x41, 0x54, 0x55,
+	0x52, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x10,
+	0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x41, 0x47, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x55,
+	0x54, 0x4f, 0x5f, 0x4f, 0x50, 0x53, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x17, 0x0a,
+	0x13, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x4f, 0x4c,
+	0x4c, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x22, 0x3f, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x2a, 0x55, 0x0a, 0x13, 0x46, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1e,
+	0x0a, 0x1a, 0x46, 0x45, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x59, 0x41, 0x4d, 0x4c, 0x10, 0x00, 0x12, 0x1e,
+	0x0a, 0x1a, 0x46, 0x45, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x42, 0x34,
+	0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x65, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_feature_bundle_proto_rawDescOnce sync.Once
+	file_proto_feature_bundle_proto_rawDescData = file_proto_feature_bundle_proto_rawDesc
+)
+
+func file_proto_feature_bundle_proto_rawDescGZIP() []byte {
+	file_proto_feature_bundle_proto_rawDescOnce.Do(func() {
+		file_proto_feature_bundle_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_feature_bundle_proto_rawDescData)
+	})
+	return file_proto_feature_bundle_proto_rawDescData
+}
+
+var file_proto_feature_bundle_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_feature_bundle_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_feature_bundle_proto_goTypes = []interface{}{
+	(FeatureBundleFormat)(0),              // 0: bucketeer.feature.FeatureBundleFormat
+	(FeatureBundleChange_ResourceType)(0), // 1: bucketeer.feature.FeatureBundleChange.ResourceType
+	(FeatureBundleChange_ChangeAction)(0), // 2: bucketeer.feature.FeatureBundleChange.ChangeAction
+	(*FeatureBundleChange)(nil),           // 3: bucketeer.feature.FeatureBundleChange
+}
+var file_proto_feature_bundle_proto_depIdxs = []int32{
+	1, // 0: bucketeer.feature.FeatureBundleChange.resource_type:type_name -> bucketeer.feature.FeatureBundleChange.ResourceType
+	2, // 1: bucketeer.feature.FeatureBundleChange.action:type_name -> bucketeer.feature.FeatureBundleChange.ChangeAction
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_proto_feature_bundle_proto_init() }
+func file_proto_feature_bundle_proto_init() {
+	if File_proto_feature_bundle_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_feature_bundle_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeatureBundleChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_feature_bundle_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_feature_bundle_proto_goTypes,
+		DependencyIndexes: file_proto_feature_bundle_proto_depIdxs,
+		EnumInfos:         file_proto_feature_bundle_proto_enumTypes,
+		MessageInfos:      file_proto_feature_bundle_proto_msgTypes,
+	}.Build()
+	File_proto_feature_bundle_proto = out.File
+	file_proto_feature_bundle_proto_rawDesc = nil
+	file_proto_feature_bundle_proto_goTypes = nil
+	file_proto_feature_bundle_proto_depIdxs = nil
+}
@@ -89,6 +89,119 @@ func (Feature_VariationType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_feature_feature_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type FeatureLifecycle_Kind int32
+
+const (
+	FeatureLifecycle_KIND_UNSPECIFIED FeatureLifecycle_Kind = 0
+	FeatureLifecycle_RELEASE          FeatureLifecycle_Kind = 1
+	FeatureLifecycle_EXPERIMENT       FeatureLifecycle_Kind = 2
+	FeatureLifecycle_OPERATIONAL      FeatureLifecycle_Kind = 3 // Ops toggles and kill switches
+	FeatureLifecycle_PERMISSION       FeatureLifecycle_Kind = 4
+)
+
+// Enum value maps for FeatureLifecycle_Kind.
+var (
+	FeatureLifecycle_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "RELEASE",
+		2: "EXPERIMENT",
+		3: "OPERATIONAL",
+		4: "PERMISSION",
+	}
+	FeatureLifecycle_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"RELEASE":          1,
+		"EXPERIMENT":       2,
+		"OPERATIONAL":      3,
+		"PERMISSION":       4,
+	}
+)
+
+func (x FeatureLifecycle_Kind) Enum() *FeatureLifecycle_Kind {
+	p := new(FeatureLifecycle_Kind)
+	*p = x
+	return p
+}
+
+func (x FeatureLifecycle_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeatureLifecycle_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_feature_feature_proto_enumTypes[1].Descriptor()
+}
+
+func (FeatureLifecycle_Kind) Type() protoreflect.EnumType {
+	return &file_proto_feature_feature_proto_enumTypes[1]
+}
+
+func (x FeatureLifecycle_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeatureLifecycle_Kind.Descriptor instead.
+func (FeatureLifecycle_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_feature_feature_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type FeatureLifecycle_State int32
+
+const (
+	FeatureLifecycle_STATE_UNSPECIFIED FeatureLifecycle_State = 0
+	FeatureLifecycle_ACTIVE            FeatureLifecycle_State = 1
+	// Every user gets the same variation.
+	FeatureLifecycle_ROLLED_OUT FeatureLifecycle_State = 2
+	// Rolled out and either no longer requested or past its planned removal date.
+	FeatureLifecycle_READY_TO_REMOVE FeatureLifecycle_State = 3
+	// No code references are left and the flag is no longer requested.
+	FeatureLifecycle_REMOVED_FROM_CODE FeatureLifecycle_State = 4
+)
+
+// Enum value maps for FeatureLifecycle_State.
+var (
+	FeatureLifecycle_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "ACTIVE",
+		2: "ROLLED_OUT",
+		3: "READY_TO_REMOVE",
+		4: "REMOVED_FROM_CODE",
+	}
+	FeatureLifecycle_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"ACTIVE":            1,
+		"ROLLED_OUT":        2,
+		"READY_TO_REMOVE":   3,
+		"REMOVED_FROM_CODE": 4,
+	}
+)
+
+func (x FeatureLifecycle_State) Enum() *FeatureLifecycle_State {
+	p := new(FeatureLifecycle_State)
+	*p = x
+	return p
+}
+
+func (x FeatureLifecycle_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeatureLifecycle_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_feature_feature_proto_enumTypes[2].Descriptor()
+}
+
+func (FeatureLifecycle_State) Type() protoreflect.EnumType {
+	return &file_proto_feature_feature_proto_enumTypes[2]
+}
+
+func (x FeatureLifecycle_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeatureLifecycle_State.Descriptor instead.
+func (FeatureLifecycle_State) EnumDescriptor() ([]byte, []int) {
+	return file_proto_feature_feature_proto_rawDescGZIP(), []int{1, 1}
+}
+
 type VariationValueSchema_Type int32
 
 const (
@@ -125,11 +238,11 @@ func (x VariationValueSchema_Type) String() string {
 }
 
 func (VariationValueSchema_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_feature_feature_proto_enumTypes[1].Descriptor()
+	return file_proto_feature_feature_proto_enumTypes[3].Descriptor()
 }
 
 func (VariationValueSchema_Type) Type() protoreflect.EnumType {
-	return &file_proto_feature_feature_proto_enumTypes[1]
+	return &file_proto_feature_feature_proto_enumTypes[3]
 }
 
 func (x VariationValueSchema_Type) Number() protoreflect.EnumNumber {
@@ -138,7 +251,7 @@ func (x VariationValueSchema_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VariationValueSchema_Type.Descriptor instead.
 func (VariationValueSchema_Type) EnumDescriptor() ([]byte, []int) {
-	return file_proto_feature_feature_proto_rawDescGZIP(), []int{1, 0}
+	return file_proto_feature_feature_proto_rawDescGZIP(), []int{3, 0}
 }
 
 type Feature struct {
@@ -171,6 +284,8 @@ type Feature struct {
 	SamplingSeed          string                `protobuf:"bytes,22,opt,name=sampling_seed,json=samplingSeed,proto3" json:"sampling_seed"`
 	AutoOpsSummary        *AutoOpsSummary       `protobuf:"bytes,23,opt,name=auto_ops_summary,json=autoOpsSummary,proto3" json:"auto_ops_summary"`
 	VariationValueSchema  *VariationValueSchema `protobuf:"bytes,24,opt,name=variation_value_schema,json=variationValueSchema,proto3" json:"variation_value_schema"`
+	ExperimentAllocation  *ExperimentAllocation `protobuf:"bytes,25,opt,name=experiment_allocation,json=experimentAllocation,proto3" json:"experiment_allocation"`
+	Lifecycle             *FeatureLifecycle     `protobuf:"bytes,26,opt,name=lifecycle,proto3" json:"lifecycle"`
 }
 
 func (x *Feature) Reset() {
@@ -374,6 +489,204 @@ func (x *Feature) GetVariationValueSchema() *VariationValueSchema {
 	return nil
 }
 
+func (x *Feature) GetExperimentAllocation() *ExperimentAllocation {
+	if x != nil {
+		return x.ExperimentAllocation
+	}
+	return nil
+}
+
+func (x *Feature) GetLifecycle() *FeatureLifecycle {
+	if x != nil {
+		return x.Lifecycle
+	}
+	return nil
+}
+
+// FeatureLifecycle tells what the flag is for and how far it is from being
+// removed from the code. The state is derived by the FeatureLifecycleUpdater
+// batch job from the flag usage, its code references and its targeting.
+type FeatureLifecycle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind FeatureLifecycle_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=bucketeer.feature.FeatureLifecycle_Kind" json:"kind"`
+	// Unix time in seconds. Required for the temporary kinds, release and experiment.
+	PlannedRemovalAt int64                  `protobuf:"varint,2,opt,name=planned_removal_at,json=plannedRemovalAt,proto3" json:"planned_removal_at"`
+	State            FeatureLifecycle_State `protobuf:"varint,3,opt,name=state,proto3,enum=bucketeer.feature.FeatureLifecycle_State" json:"state"`
+	StateUpdatedAt   int64                  `protobuf:"varint,4,opt,name=state_updated_at,json=stateUpdatedAt,proto3" json:"state_updated_at"`
+	// Set when the maintainers were reminded that the planned removal date passed.
+	RemovalRemindedAt int64 `protobuf:"varint,5,opt,name=removal_reminded_at,json=removalRemindedAt,proto3" json:"removal_reminded_at"`
+}
+
+func (x *FeatureLifecycle) Reset() {
+	*x = FeatureLifecycle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_feature_feature_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeatureLifecycle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureLifecycle) ProtoMessage() {}
+
+func (x *FeatureLifecycle) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_feature_feature_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureLifecycle.ProtoReflect.Descriptor instead.
+func (*FeatureLifecycle) Descriptor() ([]byte, []int) {
+	return file_proto_feature_feature_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FeatureLifecycle) GetKind() FeatureLifecycle_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return FeatureLifecycle_KIND_UNSPECIFIED
+}
+
+func (x *FeatureLifecycle) GetPlannedRemovalAt() int64 {
+	if x != nil {
+		return x.PlannedRemovalAt
+	}
+	return 0
+}
+
+func (x *FeatureLifecycle) GetState() FeatureLifecycle_State {
+	if x != nil {
+		return x.State
+	}
+	return FeatureLifecycle_STATE_UNSPECIFIED
+}
+
+func (x *FeatureLifecycle) GetStateUpdatedAt() int64 {
+	if x != nil {
+		return x.StateUpdatedAt
+	}
+	return 0
+}
+
+func (x *FeatureLifecycle) GetRemovalRemindedAt() int64 {
+	if x != nil {
+		return x.RemovalRemindedAt
+	}
+	return 0
+}
+
+// ExperimentAllocation is set while an experiment runs on the feature in a
+// layer or with a holdout group. Users outside the experiment's slice of the
+// layer and users in the holdout group get the baseline variation.
+type ExperimentAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExperimentId string `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id"`
+	LayerId      string `protobuf:"bytes,2,opt,name=layer_id,json=layerId,proto3" json:"layer_id"`
+	// The experiment's slice of the layer, [traffic_start, traffic_end) out of 100000.
+	TrafficStart        int32  `protobuf:"varint,3,opt,name=traffic_start,json=trafficStart,proto3" json:"traffic_start"`
+	TrafficEnd          int32  `protobuf:"varint,4,opt,name=traffic_end,json=trafficEnd,proto3" json:"traffic_end"`
+	BaselineVariationId string `protobuf:"bytes,5,opt,name=baseline_variation_id,json=baselineVariationId,proto3" json:"baseline_variation_id"`
+	// Share of the users in the holdout group, out of 100000.
+	HoldoutWeight int32  `protobuf:"varint,6,opt,name=holdout_weight,json=holdoutWeight,proto3" json:"holdout_weight"`
+	HoldoutId     string `protobuf:"bytes,7,opt,name=holdout_id,json=holdoutId,proto3" json:"holdout_id"`
+}
+
+func (x *ExperimentAllocation) Reset() {
+	*x = ExperimentAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_feature_feature_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExperimentAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentAllocation) ProtoMessage() {}
+
+func (x *ExperimentAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_feature_feature_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentAllocation.ProtoReflect.Descriptor instead.
+func (*ExperimentAllocation) Descriptor() ([]byte, []int) {
+	return file_proto_feature_feature_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExperimentAllocation) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (x *ExperimentAllocation) GetLayerId() string {
+	if x != nil {
+		return x.LayerId
+	}
+	return ""
+}
+
+func (x *ExperimentAllocation) GetTrafficStart() int32 {
+	if x != nil {
+		return x.TrafficStart
+	}
+	return 0
+}
+
+func (x *ExperimentAllocation) GetTrafficEnd() int32 {
+	if x != nil {
+		return x.TrafficEnd
+	}
+	return 0
+}
+
+func (x *ExperimentAllocation) GetBaselineVariationId() string {
+	if x != nil {
+		return x.BaselineVariationId
+	}
+	return ""
+}
+
+func (x *ExperimentAllocation) GetHoldoutWeight() int32 {
+	if x != nil {
+		return x.HoldoutWeight
+	}
+	return 0
+}
+
+func (x *ExperimentAllocation) GetHoldoutId() string {
+	if x != nil {
+		return x.HoldoutId
+	}
+	return ""
+}
+
 type VariationValueSchema struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -392,7 +705,7 @@ type VariationValueSchema struct {
 func (x *VariationValueSchema) Reset() {
 	*x = VariationValueSchema{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_feature_feature_proto_msgTypes[1]
+		mi := &file_proto_feature_feature_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -405,7 +718,7 @@ func (x *VariationValueSchema) String() string {
 func (*VariationValueSchema) ProtoMessage() {}
 
 func (x *VariationValueSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_feature_feature_proto_msgTypes[1]
+	mi := &file_proto_feature_feature_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,7 +731,7 @@ func (x *VariationValueSchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VariationValueSchema.ProtoReflect.Descriptor instead.
 func (*VariationValueSchema) Descriptor() ([]byte, []int) {
-	return file_proto_feature_feature_proto_rawDescGZIP(), []int{1}
+	return file_proto_feature_feature_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *VariationValueSchema) GetType() VariationValueSchema_Type {
@@ -498,7 +811,7 @@ type AutoOpsSummary struct {
 func (x *AutoOpsSummary) Reset() {
 	*x = AutoOpsSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_feature_feature_proto_msgTypes[2]
+		mi := &file_proto_feature_feature_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -511,7 +824,7 @@ func (x *AutoOpsSummary) String() string {
 func (*AutoOpsSummary) ProtoMessage() {}
 
 func (x *AutoOpsSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_feature_feature_proto_msgTypes[2]
+	mi := &file_proto_feature_feature_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,7 +837,7 @@ func (x *AutoOpsSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AutoOpsSummary.ProtoReflect.Descriptor instead.
 func (*AutoOpsSummary) Descriptor() ([]byte, []int) {
-	return file_proto_feature_feature_proto_rawDescGZIP(), []int{2}
+	return file_proto_feature_feature_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AutoOpsSummary) GetProgressiveRolloutCount() int32 {
@@ -560,7 +873,7 @@ type Features struct {
 func (x *Features) Reset() {
 	*x = Features{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_feature_feature_proto_msgTypes[3]
+		mi := &file_proto_feature_feature_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -573,7 +886,7 @@ func (x *Features) String() string {
 func (*Features) ProtoMessage() {}
 
 func (x *Features) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_feature_feature_proto_msgTypes[3]
+	mi := &file_proto_feature_feature_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +899,7 @@ func (x *Features) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Features.ProtoReflect.Descriptor instead.
 func (*Features) Descriptor() ([]byte, []int) {
-	return file_proto_feature_feature_proto_rawDescGZIP(), []int{3}
+	return file_proto_feature_feature_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Features) GetFeatures() []*Feature {
@@ -615,7 +928,7 @@ type EnvironmentFeature struct {
 func (x *EnvironmentFeature) Reset() {
 	*x = EnvironmentFeature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_feature_feature_proto_msgTypes[4]
+		mi := &file_proto_feature_feature_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -628,7 +941,7 @@ func (x *EnvironmentFeature) String() string {
 func (*EnvironmentFeature) ProtoMessage() {}
 
 func (x *EnvironmentFeature) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_feature_feature_proto_msgTypes[4]
+	mi := &file_proto_feature_feature_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,7 +954,7 @@ func (x *EnvironmentFeature) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvironmentFeature.ProtoReflect.Descriptor instead.
 func (*EnvironmentFeature) Descriptor() ([]byte, []int) {
-	return file_proto_feature_feature_proto_rawDescGZIP(), []int{4}
+	return file_proto_feature_feature_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EnvironmentFeature) GetEnvironmentId() string {
@@ -672,7 +985,7 @@ type Tag struct {
 func (x *Tag) Reset() {
 	*x = Tag{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_feature_feature_proto_msgTypes[5]
+		mi := &file_proto_feature_feature_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -685,7 +998,7 @@ func (x *Tag) String() string {
 func (*Tag) ProtoMessage() {}
 
 func (x *Tag) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_feature_feature_proto_msgTypes[5]
+	mi := &file_proto_feature_feature_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +1011,7 @@ func (x *Tag) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tag.ProtoReflect.Descriptor instead.
 func (*Tag) Descriptor() ([]byte, []int) {
-	return file_proto_feature_feature_proto_rawDescGZIP(), []int{5}
+	return file_proto_feature_feature_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Tag) GetId() string {
@@ -740,7 +1053,7 @@ type VariationValueSchema_EnumValidator struct {
 func (x *VariationValueSchema_EnumValidator) Reset() {
 	*x = VariationValueSchema_EnumValidator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_feature_feature_proto_msgTypes[6]
+		mi := &file_proto_feature_feature_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -753,7 +1066,7 @@ func (x *VariationValueSchema_EnumValidator) String() string {
 func (*VariationValueSchema_EnumValidator) ProtoMessage() {}
 
 func (x *VariationValueSchema_EnumValidator) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_feature_feature_proto_msgTypes[6]
+	mi := &file_proto_feature_feature_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -766,7 +1079,7 @@ func (x *VariationValueSchema_EnumValidator) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use VariationValueSchema_EnumValidator.ProtoReflect.Descriptor instead.
 func (*VariationValueSchema_EnumValidator) Descriptor() ([]byte, []int) {
-	return file_proto_feature_feature_proto_rawDescGZIP(), []int{1, 0}
+	return file_proto_feature_feature_proto_rawDescGZIP(), []int{3, 0}
 }
 
 func (x *VariationValueSchema_EnumValidator) GetValues() []string {
@@ -787,7 +1100,7 @@ type VariationValueSchema_RegexValidator struct {
 func (x *VariationValueSchema_RegexValidator) Reset() {
 	*x = VariationValueSchema_RegexValidator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_feature_feature_proto_msgTypes[7]
+		mi := &file_proto_feature_feature_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -800,7 +1113,7 @@ func (x *VariationValueSchema_RegexValidator) String() string {
 func (*VariationValueSchema_RegexValidator) ProtoMessage() {}
 
 func (x *VariationValueSchema_RegexValidator) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_feature_feature_proto_msgTypes[7]
+	mi := &file_proto_feature_feature_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -813,7 +1126,7 @@ func (x *VariationValueSchema_RegexValidator) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use VariationValueSchema_RegexValidator.ProtoReflect.Descriptor instead.
 func (*VariationValueSchema_RegexValidator) Descriptor() ([]byte, []int) {
-	return file_proto_feature_feature_proto_rawDescGZIP(), []int{1, 1}
+	return file_proto_feature_feature_proto_rawDescGZIP(), []int{3, 1}
 }
 
 func (x *VariationValueSchema_RegexValidator) GetPattern() string {
@@ -834,7 +1147,7 @@ type VariationValueSchema_JsonSchemaValidator struct {
 func (x *VariationValueSchema_JsonSchemaValidator) Reset() {
 	*x = VariationValueSchema_JsonSchemaValidator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_feature_feature_proto_msgTypes[8]
+		mi := &file_proto_feature_feature_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -847,7 +1160,7 @@ func (x *VariationValueSchema_JsonSchemaValidator) String() string {
 func (*VariationValueSchema_JsonSchemaValidator) ProtoMessage() {}
 
 func (x *VariationValueSchema_JsonSchemaValidator) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_feature_feature_proto_msgTypes[8]
+	mi := &file_proto_feature_feature_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -860,7 +1173,7 @@ func (x *VariationValueSchema_JsonSchemaValidator) ProtoReflect() protoreflect.M
 
 // Deprecated: Use VariationValueSchema_JsonSchemaValidator.ProtoReflect.Descriptor instead.
 func (*VariationValueSchema_JsonSchemaValidator) Descriptor() ([]byte, []int) {
-	return file_proto_feature_feature_proto_rawDescGZIP(), []int{1, 2}
+	return file_proto_feature_feature_proto_rawDescGZIP(), []int{3, 2}
 }
 
 func (x *VariationValueSchema_JsonSchemaValidator) GetSchema() string {
@@ -888,7 +1201,7 @@ var file_proto_feature_feature_proto_rawDesc = []byte{
 	0x75, 0x73, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x20, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x70,
 	0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x88, 0x09, 0x0a, 0x07, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x0e, 0x0a,
+	0x6f, 0x22, 0xa9, 0x0a, 0x0a, 0x07, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x0e, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
@@ -956,85 +1269,142 @@ var file_proto_feature_feature_proto_rawDesc = []byte{
 	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x14, 0x76, 0x61,
 	0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x22, 0x48, 0x0a, 0x0d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x42, 0x4f, 0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
-	0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e,
-	0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x59, 0x41, 0x4d, 0x4c, 0x10, 0x04, 0x22, 0x85, 0x05, 0x0a,
-	0x14, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x40, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e,
-	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x5e, 0x0a, 0x0e, 0x65, 0x6e, 0x75, 0x6d, 0x5f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x35, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x61, 0x0a, 0x0f, 0x72, 0x65, 0x67, 0x65, 0x78,
-	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x36, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x78, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x67, 0x65,
-	0x78, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x71, 0x0a, 0x15, 0x6a, 0x73,
-	0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x6d, 0x61, 0x12, 0x5c, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x41, 0x0a, 0x09, 0x6c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4c,
+	0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x09, 0x6c, 0x69, 0x66, 0x65, 0x63, 0x79,
+	0x63, 0x6c, 0x65, 0x22, 0x48, 0x0a, 0x0d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x42, 0x4f, 0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f,
+	0x4e, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x59, 0x41, 0x4d, 0x4c, 0x10, 0x04, 0x22, 0xdd, 0x03,
+	0x0a, 0x10, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63,
+	0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x28, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x66, 0x65,
+	0x63, 0x79, 0x63, 0x6c, 0x65, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x2c, 0x0a, 0x12, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x61, 0x6c, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x41, 0x74, 0x12, 0x3f,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63,
+	0x6c, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x28, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x52,
+	0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5a, 0x0a, 0x04, 0x4b, 0x69, 0x6e,
+	0x64, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4c, 0x45, 0x41,
+	0x53, 0x45, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x58, 0x50, 0x45, 0x52, 0x49, 0x4d, 0x45,
+	0x4e, 0x54, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x10, 0x04, 0x22, 0x66, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15,
+	0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10,
+	0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x4f, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10,
+	0x02, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x54, 0x4f, 0x5f, 0x52, 0x45,
+	0x4d, 0x4f, 0x56, 0x45, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45,
+	0x44, 0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x04, 0x22, 0x96, 0x02,
+	0x0a, 0x14, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x45, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x15,
+	0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x61, 0x73,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x68, 0x6f, 0x6c, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x68, 0x6f, 0x6c, 0x64, 0x6f, 0x75,
+	0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x6f,
+	0x75, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x6c,
+	0x64, 0x6f, 0x75, 0x74, 0x49, 0x64, 0x22, 0x85, 0x05, 0x0a, 0x14, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
+	0x40, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x5e, 0x0a, 0x0e, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x62, 0x75, 0x63, 0x6b,
 	0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x56, 0x61,
 	0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
-	0x27, 0x0a, 0x0d, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x2a, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x65,
-	0x78, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
-	0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74,
-	0x74, 0x65, 0x72, 0x6e, 0x1a, 0x2d, 0x0a, 0x13, 0x4a, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x22, 0x42, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x4e, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x52,
-	0x45, 0x47, 0x45, 0x58, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x53,
-	0x43, 0x48, 0x45, 0x4d, 0x41, 0x10, 0x03, 0x42, 0x0b, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x22, 0x9f, 0x01, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x6f, 0x4f, 0x70, 0x73,
-	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x70, 0x72, 0x6f, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x69, 0x76, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6b, 0x69,
-	0x6c, 0x6c, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x52, 0x0a, 0x08, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72,
-	0x2e, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x12, 0x45, 0x6e,
-	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x46, 0x65,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22,
-	0x67, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72,
-	0x2d, 0x69, 0x6f, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2f, 0x76, 0x32,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x61, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x0d, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x61, 0x0a, 0x0f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x78, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x67, 0x65, 0x78, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x71, 0x0a, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4a, 0x73, 0x6f,
+	0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x27, 0x0a, 0x0d, 0x45, 0x6e, 0x75,
+	0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x1a, 0x2a, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x65, 0x78, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x1a, 0x2d,
+	0x0a, 0x13, 0x4a, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x42, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x45,
+	0x4e, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x02,
+	0x12, 0x0f, 0x0a, 0x0b, 0x4a, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x10,
+	0x03, 0x42, 0x0b, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x9f,
+	0x01, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x6f, 0x4f, 0x70, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x76, 0x65,
+	0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x76,
+	0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x52, 0x0a, 0x08, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x08,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x12, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x36, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x03, 0x54, 0x61, 0x67,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1049,48 +1419,56 @@ func file_proto_feature_feature_proto_rawDescGZIP() []byte {
 	return file_proto_feature_feature_proto_rawDescData
 }
 
-var file_proto_feature_feature_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_feature_feature_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_feature_feature_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_feature_feature_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_proto_feature_feature_proto_goTypes = []interface{}{
 	(Feature_VariationType)(0),                       // 0: bucketeer.feature.Feature.VariationType
-	(VariationValueSchema_Type)(0),                   // 1: bucketeer.feature.VariationValueSchema.Type
-	(*Feature)(nil),                                  // 2: bucketeer.feature.Feature
-	(*VariationValueSchema)(nil),                     // 3: bucketeer.feature.VariationValueSchema
-	(*AutoOpsSummary)(nil),                           // 4: bucketeer.feature.AutoOpsSummary
-	(*Features)(nil),                                 // 5: bucketeer.feature.Features
-	(*EnvironmentFeature)(nil),                       // 6: bucketeer.feature.EnvironmentFeature
-	(*Tag)(nil),                                      // 7: bucketeer.feature.Tag
-	(*VariationValueSchema_EnumValidator)(nil),       // 8: bucketeer.feature.VariationValueSchema.EnumValidator
-	(*VariationValueSchema_RegexValidator)(nil),      // 9: bucketeer.feature.VariationValueSchema.RegexValidator
-	(*VariationValueSchema_JsonSchemaValidator)(nil), // 10: bucketeer.feature.VariationValueSchema.JsonSchemaValidator
-	(*Variation)(nil),                                // 11: bucketeer.feature.Variation
-	(*Target)(nil),                                   // 12: bucketeer.feature.Target
-	(*Rule)(nil),                                     // 13: bucketeer.feature.Rule
-	(*Strategy)(nil),                                 // 14: bucketeer.feature.Strategy
-	(*FeatureLastUsedInfo)(nil),                      // 15: bucketeer.feature.FeatureLastUsedInfo
-	(*Prerequisite)(nil),                             // 16: bucketeer.feature.Prerequisite
+	(FeatureLifecycle_Kind)(0),                       // 1: bucketeer.feature.FeatureLifecycle.Kind
+	(FeatureLifecycle_State)(0),                      // 2: bucketeer.feature.FeatureLifecycle.State
+	(VariationValueSchema_Type)(0),                   // 3: bucketeer.feature.VariationValueSchema.Type
+	(*Feature)(nil),                                  // 4: bucketeer.feature.Feature
+	(*FeatureLifecycle)(nil),                         // 5: bucketeer.feature.FeatureLifecycle
+	(*ExperimentAllocation)(nil),                     // 6: bucketeer.feature.ExperimentAllocation
+	(*VariationValueSchema)(nil),                     // 7: bucketeer.feature.VariationValueSchema
+	(*AutoOpsSummary)(nil),                           // 8: bucketeer.feature.AutoOpsSummary
+	(*Features)(nil),                                 // 9: bucketeer.feature.Features
+	(*EnvironmentFeature)(nil),                       // 10: bucketeer.feature.EnvironmentFeature
+	(*Tag)(nil),                                      // 11: bucketeer.feature.Tag
+	(*VariationValueSchema_EnumValidator)(nil),       // 12: bucketeer.feature.VariationValueSchema.EnumValidator
+	(*VariationValueSchema_RegexValidator)(nil),      // 13: bucketeer.feature.VariationValueSchema.RegexValidator
+	(*VariationValueSchema_JsonSchemaValidator)(nil), // 14: bucketeer.feature.VariationValueSchema.JsonSchemaValidator
+	(*Variation)(nil),                                // 15: bucketeer.feature.Variation
+	(*Target)(nil),                                   // 16: bucketeer.feature.Target
+	(*Rule)(nil),                                     // 17: bucketeer.feature.Rule
+	(*Strategy)(nil),                                 // 18: bucketeer.feature.Strategy
+	(*FeatureLastUsedInfo)(nil),                      // 19: bucketeer.feature.FeatureLastUsedInfo
+	(*Prerequisite)(nil),                             // 20: bucketeer.feature.Prerequisite
 }
 var file_proto_feature_feature_proto_depIdxs = []int32{
-	11, // 0: bucketeer.feature.Feature.variations:type_name -> bucketeer.feature.Variation
-	12, // 1: bucketeer.feature.Feature.targets:type_name -> bucketeer.feature.Target
-	13, // 2: bucketeer.feature.Feature.rules:type_name -> bucketeer.feature.Rule
-	14, // 3: bucketeer.feature.Feature.default_strategy:type_name -> bucketeer.feature.Strategy
-	15, // 4: bucketeer.feature.Feature.last_used_info:type_name -> bucketeer.feature.FeatureLastUsedInfo
+	15, // 0: bucketeer.feature.Feature.variations:type_name -> bucketeer.feature.Variation
+	16, // 1: bucketeer.feature.Feature.targets:type_name -> bucketeer.feature.Target
+	17, // 2: bucketeer.feature.Feature.rules:type_name -> bucketeer.feature.Rule
+	18, // 3: bucketeer.feature.Feature.default_strategy:type_name -> bucketeer.feature.Strategy
+	19, // 4: bucketeer.feature.Feature.last_used_info:type_name -> bucketeer.feature.FeatureLastUsedInfo
 	0,  // 5: bucketeer.feature.Feature.variation_type:type_name -> bucketeer.feature.Feature.VariationType
-	16, // 6: bucketeer.feature.Feature.prerequisites:type_name -> bucketeer.feature.Prerequisite
-	4,  // 7: bucketeer.feature.Feature.auto_ops_summary:type_name -> bucketeer.feature.AutoOpsSummary
-	3,  // 8: bucketeer.feature.Feature.variation_value_schema:type_name -> bucketeer.feature.VariationValueSchema
-	1,  // 9: bucketeer.feature.VariationValueSchema.type:type_name -> bucketeer.feature.VariationValueSchema.Type
-	8,  // 10: bucketeer.feature.VariationValueSchema.enum_validator:type_name -> bucketeer.feature.VariationValueSchema.EnumValidator
-	9,  // 11: bucketeer.feature.VariationValueSchema.regex_validator:type_name -> bucketeer.feature.VariationValueSchema.RegexValidator
-	10, // 12: bucketeer.feature.VariationValueSchema.json_schema_validator:type_name -> bucketeer.feature.VariationValueSchema.JsonSchemaValidator
-	2,  // 13: bucketeer.feature.Features.features:type_name -> bucketeer.feature.Feature
-	2,  // 14: bucketeer.feature.EnvironmentFeature.features:type_name -> bucketeer.feature.Feature
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	20, // 6: bucketeer.feature.Feature.prerequisites:type_name -> bucketeer.feature.Prerequisite
+	8,  // 7: bucketeer.feature.Feature.auto_ops_summary:type_name -> bucketeer.feature.AutoOpsSummary
+	7,  // 8: bucketeer.feature.Feature.variation_value_schema:type_name -> bucketeer.feature.VariationValueSchema
+	6,  // 9: bucketeer.feature.Feature.experiment_allocation:type_name -> bucketeer.feature.ExperimentAllocation
+	5,  // 10: bucketeer.feature.Feature.lifecycle:type_name -> bucketeer.feature.FeatureLifecycle
+	1,  // 11: bucketeer.feature.FeatureLifecycle.kind:type_name -> bucketeer.feature.FeatureLifecycle.Kind
+	2,  // 12: bucketeer.feature.FeatureLifecycle.state:type_name -> bucketeer.feature.FeatureLifecycle.State
+	3,  // 13: bucketeer.feature.VariationValueSchema.type:type_name -> bucketeer.feature.VariationValueSchema.Type
+	12, // 14: bucketeer.feature.VariationValueSchema.enum_validator:type_name -> bucketeer.feature.VariationValueSchema.EnumValidator
+	13, // 15: bucketeer.feature.VariationValueSchema.regex_validator:type_name -> bucketeer.feature.VariationValueSchema.RegexValidator
+	14, // 16: bucketeer.feature.VariationValueSchema.json_schema_validator:type_name -> bucketeer.feature.VariationValueSchema.JsonSchemaValidator
+	4,  // 17: bucketeer.feature.Features.features:type_name -> bucketeer.feature.Feature
+	4,  // 18: bucketeer.feature.EnvironmentFeature.features:type_name -> bucketeer.feature.Feature
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_proto_feature_feature_proto_init() }
@@ -1118,7 +1496,7 @@ func file_proto_feature_feature_proto_init() {
 			}
 		}
 		file_proto_feature_feature_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VariationValueSchema); i {
+			switch v := v.(*FeatureLifecycle); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1130,7 +1508,7 @@ func file_proto_feature_feature_proto_init() {
 			}
 		}
 		file_proto_feature_feature_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AutoOpsSummary); i {
+			switch v := v.(*ExperimentAllocation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1142,7 +1520,7 @@ func file_proto_feature_feature_proto_init() {
 			}
 		}
 		file_proto_feature_feature_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Features); i {
+			switch v := v.(*VariationValueSchema); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1154,7 +1532,7 @@ func file_proto_feature_feature_proto_init() {
 			}
 		}
 		file_proto_feature_feature_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnvironmentFeature); i {
+			switch v := v.(*AutoOpsSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1166,7 +1544,7 @@ func file_proto_feature_feature_proto_init() {
 			}
 		}
 		file_proto_feature_feature_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tag); i {
+			switch v := v.(*Features); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1178,7 +1556,7 @@ func file_proto_feature_feature_proto_init() {
 			}
 		}
 		file_proto_feature_feature_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VariationValueSchema_EnumValidator); i {
+			switch v := v.(*EnvironmentFeature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1190,7 +1568,7 @@ func file_proto_feature_feature_proto_init() {
 			}
 		}
 		file_proto_feature_feature_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VariationValueSchema_RegexValidator); i {
+			switch v := v.(*Tag); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1202,6 +1580,30 @@ func file_proto_feature_feature_proto_init() {
 			}
 		}
 		file_proto_feature_feature_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VariationValueSchema_EnumValidator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_feature_feature_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VariationValueSchema_RegexValidator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_feature_feature_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VariationValueSchema_JsonSchemaValidator); i {
 			case 0:
 				return &v.state
@@ -1214,7 +1616,7 @@ func file_proto_feature_feature_proto_init() {
 			}
 		}
 	}
-	file_proto_feature_feature_proto_msgTypes[1].OneofWrappers = []interface{}{
+	file_proto_feature_feature_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*VariationValueSchema_EnumValidator_)(nil),
 		(*VariationValueSchema_RegexValidator_)(nil),
 		(*VariationValueSchema_JsonSchemaValidator_)(nil),
@@ -1224,8 +1626,8 @@ func file_proto_feature_feature_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_feature_feature_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   9,
+			NumEnums:      4,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
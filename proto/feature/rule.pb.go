@@ -34,6 +34,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ClauseGroup_Operator int32
+
+const (
+	// Matches when every child matches.
+	ClauseGroup_AND ClauseGroup_Operator = 0
+	// Matches when at least one child matches.
+	ClauseGroup_OR ClauseGroup_Operator = 1
+	// Matches when its single child doesn't match.
+	ClauseGroup_NOT ClauseGroup_Operator = 2
+)
+
+// Enum value maps for ClauseGroup_Operator.
+var (
+	ClauseGroup_Operator_name = map[int32]string{
+		0: "AND",
+		1: "OR",
+		2: "NOT",
+	}
+	ClauseGroup_Operator_value = map[string]int32{
+		"AND": 0,
+		"OR":  1,
+		"NOT": 2,
+	}
+)
+
+func (x ClauseGroup_Operator) Enum() *ClauseGroup_Operator {
+	p := new(ClauseGroup_Operator)
+	*p = x
+	return p
+}
+
+func (x ClauseGroup_Operator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClauseGroup_Operator) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_feature_rule_proto_enumTypes[0].Descriptor()
+}
+
+func (ClauseGroup_Operator) Type() protoreflect.EnumType {
+	return &file_proto_feature_rule_proto_enumTypes[0]
+}
+
+func (x ClauseGroup_Operator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClauseGroup_Operator.Descriptor instead.
+func (ClauseGroup_Operator) EnumDescriptor() ([]byte, []int) {
+	return file_proto_feature_rule_proto_rawDescGZIP(), []int{1, 0}
+}
+
 type Rule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -41,7 +93,15 @@ type Rule struct {
 
 	Id       string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	Strategy *Strategy `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy"`
-	Clauses  []*Clause `protobuf:"bytes,3,rep,name=clauses,proto3" json:"clauses"`
+	// Clauses are ANDed together and with the clause groups.
+	Clauses []*Clause `protobuf:"bytes,3,rep,name=clauses,proto3" json:"clauses"`
+	// Optional nested groups for conditions that need OR or NOT, e.g.
+	// "country = JP AND (plan = pro OR beta_tester = true)".
+	ClauseGroups []*ClauseGroup `protobuf:"bytes,4,rep,name=clause_groups,json=clauseGroups,proto3" json:"clause_groups"`
+	// Optional human-readable name, surfaced alongside the rule ID in
+	// evaluation reasons.
+	Name        string `protobuf:"bytes,5,opt,name=name,proto3" json:"name"`
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description"`
 }
 
 func (x *Rule) Reset() {
@@ -97,6 +157,91 @@ func (x *Rule) GetClauses() []*Clause {
 	return nil
 }
 
+func (x *Rule) GetClauseGroups() []*ClauseGroup {
+	if x != nil {
+		return x.ClauseGroups
+	}
+	return nil
+}
+
+func (x *Rule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Rule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// ClauseGroup combines clauses and nested groups with a logical operator.
+type ClauseGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator ClauseGroup_Operator `protobuf:"varint,1,opt,name=operator,proto3,enum=bucketeer.feature.ClauseGroup_Operator" json:"operator"`
+	Clauses  []*Clause            `protobuf:"bytes,2,rep,name=clauses,proto3" json:"clauses"`
+	Groups   []*ClauseGroup       `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups"`
+}
+
+func (x *ClauseGroup) Reset() {
+	*x = ClauseGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_feature_rule_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClauseGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClauseGroup) ProtoMessage() {}
+
+func (x *ClauseGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_feature_rule_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClauseGroup.ProtoReflect.Descriptor instead.
+func (*ClauseGroup) Descriptor() ([]byte, []int) {
+	return file_proto_feature_rule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClauseGroup) GetOperator() ClauseGroup_Operator {
+	if x != nil {
+		return x.Operator
+	}
+	return ClauseGroup_AND
+}
+
+func (x *ClauseGroup) GetClauses() []*Clause {
+	if x != nil {
+		return x.Clauses
+	}
+	return nil
+}
+
+func (x *ClauseGroup) GetGroups() []*ClauseGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // RuleListValue is a wrapper for a repeated Rule field so that update
 // requests can distinguish "not set" (field is absent) from
 // "replace with an empty list" (field is present with no values).
@@ -111,7 +256,7 @@ type RuleListValue struct {
 func (x *RuleListValue) Reset() {
 	*x = RuleListValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_feature_rule_proto_msgTypes[1]
+		mi := &file_proto_feature_rule_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -124,7 +269,7 @@ func (x *RuleListValue) String() string {
 func (*RuleListValue) ProtoMessage() {}
 
 func (x *RuleListValue) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_feature_rule_proto_msgTypes[1]
+	mi := &file_proto_feature_rule_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -137,7 +282,7 @@ func (x *RuleListValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuleListValue.ProtoReflect.Descriptor instead.
 func (*RuleListValue) Descriptor() ([]byte, []int) {
-	return file_proto_feature_rule_proto_rawDescGZIP(), []int{1}
+	return file_proto_feature_rule_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *RuleListValue) GetValues() []*Rule {
@@ -156,7 +301,7 @@ var file_proto_feature_rule_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x63, 0x6c, 0x61,
 	0x75, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x01, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xff, 0x01, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
 	0x12, 0x37, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66,
@@ -164,15 +309,38 @@ var file_proto_feature_rule_proto_rawDesc = []byte{
 	0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x63, 0x6c, 0x61,
 	0x75, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x75, 0x63,
 	0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x43,
-	0x6c, 0x61, 0x75, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x73, 0x22, 0x40,
-	0x0a, 0x0d, 0x52, 0x75, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x2f, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x65, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66,
-	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x61, 0x75, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x73, 0x12, 0x43,
+	0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65,
+	0x72, 0x2e, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0c, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe5, 0x01, 0x0a, 0x0b, 0x43, 0x6c,
+	0x61, 0x75, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x43, 0x0a, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x33,
+	0x0a, 0x07, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x75,
+	0x73, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x24, 0x0a, 0x08, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x44, 0x10, 0x00,
+	0x12, 0x06, 0x0a, 0x02, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x4f, 0x54, 0x10,
+	0x02, 0x22, 0x40, 0x0a, 0x0d, 0x52, 0x75, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -187,22 +355,29 @@ func file_proto_feature_rule_proto_rawDescGZIP() []byte {
 	return file_proto_feature_rule_proto_rawDescData
 }
 
-var file_proto_feature_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_feature_rule_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_feature_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_proto_feature_rule_proto_goTypes = []interface{}{
-	(*Rule)(nil),          // 0: bucketeer.feature.Rule
-	(*RuleListValue)(nil), // 1: bucketeer.feature.RuleListValue
-	(*Strategy)(nil),      // 2: bucketeer.feature.Strategy
-	(*Clause)(nil),        // 3: bucketeer.feature.Clause
+	(ClauseGroup_Operator)(0), // 0: bucketeer.feature.ClauseGroup.Operator
+	(*Rule)(nil),              // 1: bucketeer.feature.Rule
+	(*ClauseGroup)(nil),       // 2: bucketeer.feature.ClauseGroup
+	(*RuleListValue)(nil),     // 3: bucketeer.feature.RuleListValue
+	(*Strategy)(nil),          // 4: bucketeer.feature.Strategy
+	(*Clause)(nil),            // 5: bucketeer.feature.Clause
 }
 var file_proto_feature_rule_proto_depIdxs = []int32{
-	2, // 0: bucketeer.feature.Rule.strategy:type_name -> bucketeer.feature.Strategy
-	3, // 1: bucketeer.feature.Rule.clauses:type_name -> bucketeer.feature.Clause
-	0, // 2: bucketeer.feature.RuleListValue.values:type_name -> bucketeer.feature.Rule
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 0: bucketeer.feature.Rule.strategy:type_name -> bucketeer.feature.Strategy
+	5, // 1: bucketeer.feature.Rule.clauses:type_name -> bucketeer.feature.Clause
+	2, // 2: bucketeer.feature.Rule.clause_groups:type_name -> bucketeer.feature.ClauseGroup
+	0, // 3: bucketeer.feature.ClauseGroup.operator:type_name -> bucketeer.feature.ClauseGroup.Operator
+	5, // 4: bucketeer.feature.ClauseGroup.clauses:type_name -> bucketeer.feature.Clause
+	2, // 5: bucketeer.feature.ClauseGroup.groups:type_name -> bucketeer.feature.ClauseGroup
+	1, // 6: bucketeer.feature.RuleListValue.values:type_name -> bucketeer.feature.Rule
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_proto_feature_rule_proto_init() }
@@ -226,6 +401,18 @@ func file_proto_feature_rule_proto_init() {
 			}
 		}
 		file_proto_feature_rule_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClauseGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_feature_rule_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RuleListValue); i {
 			case 0:
 				return &v.state
@@ -243,13 +430,14 @@ func file_proto_feature_rule_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_feature_rule_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_proto_feature_rule_proto_goTypes,
 		DependencyIndexes: file_proto_feature_rule_proto_depIdxs,
+		EnumInfos:         file_proto_feature_rule_proto_enumTypes,
 		MessageInfos:      file_proto_feature_rule_proto_msgTypes,
 	}.Build()
 	File_proto_feature_rule_proto = out.File
@@ -37,16 +37,25 @@ const (
 type Recipient_Type int32
 
 const (
-	Recipient_SlackChannel Recipient_Type = 0
+	Recipient_SlackChannel          Recipient_Type = 0
+	Recipient_MicrosoftTeamsChannel Recipient_Type = 1
+	Recipient_Webhook               Recipient_Type = 2
+	Recipient_Email                 Recipient_Type = 3
 )
 
 // Enum value maps for Recipient_Type.
 var (
 	Recipient_Type_name = map[int32]string{
 		0: "SlackChannel",
+		1: "MicrosoftTeamsChannel",
+		2: "Webhook",
+		3: "Email",
 	}
 	Recipient_Type_value = map[string]int32{
-		"SlackChannel": 0,
+		"SlackChannel":          0,
+		"MicrosoftTeamsChannel": 1,
+		"Webhook":               2,
+		"Email":                 3,
 	}
 )
 
@@ -128,9 +137,12 @@ type Recipient struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type                  Recipient_Type         `protobuf:"varint,1,opt,name=type,proto3,enum=bucketeer.subscription.Recipient_Type" json:"type"`
-	SlackChannelRecipient *SlackChannelRecipient `protobuf:"bytes,2,opt,name=slack_channel_recipient,json=slackChannelRecipient,proto3" json:"slack_channel_recipient"`
-	Language              Recipient_Language     `protobuf:"varint,3,opt,name=language,proto3,enum=bucketeer.subscription.Recipient_Language" json:"language"`
+	Type                           Recipient_Type                  `protobuf:"varint,1,opt,name=type,proto3,enum=bucketeer.subscription.Recipient_Type" json:"type"`
+	SlackChannelRecipient          *SlackChannelRecipient          `protobuf:"bytes,2,opt,name=slack_channel_recipient,json=slackChannelRecipient,proto3" json:"slack_channel_recipient"`
+	Language                       Recipient_Language              `protobuf:"varint,3,opt,name=language,proto3,enum=bucketeer.subscription.Recipient_Language" json:"language"`
+	MicrosoftTeamsChannelRecipient *MicrosoftTeamsChannelRecipient `protobuf:"bytes,4,opt,name=microsoft_teams_channel_recipient,json=microsoftTeamsChannelRecipient,proto3" json:"microsoft_teams_channel_recipient"`
+	WebhookRecipient               *WebhookRecipient               `protobuf:"bytes,5,opt,name=webhook_recipient,json=webhookRecipient,proto3" json:"webhook_recipient"`
+	EmailRecipient                 *EmailRecipient                 `protobuf:"bytes,6,opt,name=email_recipient,json=emailRecipient,proto3" json:"email_recipient"`
 }
 
 func (x *Recipient) Reset() {
@@ -186,6 +198,27 @@ func (x *Recipient) GetLanguage() Recipient_Language {
 	return Recipient_ENGLISH
 }
 
+func (x *Recipient) GetMicrosoftTeamsChannelRecipient() *MicrosoftTeamsChannelRecipient {
+	if x != nil {
+		return x.MicrosoftTeamsChannelRecipient
+	}
+	return nil
+}
+
+func (x *Recipient) GetWebhookRecipient() *WebhookRecipient {
+	if x != nil {
+		return x.WebhookRecipient
+	}
+	return nil
+}
+
+func (x *Recipient) GetEmailRecipient() *EmailRecipient {
+	if x != nil {
+		return x.EmailRecipient
+	}
+	return nil
+}
+
 type SlackChannelRecipient struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -233,13 +266,164 @@ func (x *SlackChannelRecipient) GetWebhookUrl() string {
 	return ""
 }
 
+type MicrosoftTeamsChannelRecipient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WebhookUrl string `protobuf:"bytes,1,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url"`
+}
+
+func (x *MicrosoftTeamsChannelRecipient) Reset() {
+	*x = MicrosoftTeamsChannelRecipient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_subscription_recipient_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MicrosoftTeamsChannelRecipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MicrosoftTeamsChannelRecipient) ProtoMessage() {}
+
+func (x *MicrosoftTeamsChannelRecipient) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_subscription_recipient_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MicrosoftTeamsChannelRecipient.ProtoReflect.Descriptor instead.
+func (*MicrosoftTeamsChannelRecipient) Descriptor() ([]byte, []int) {
+	return file_proto_subscription_recipient_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MicrosoftTeamsChannelRecipient) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+type WebhookRecipient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url"`
+	// Used to sign the request body with HMAC-SHA256.
+	// The signature is sent in the X-Bucketeer-Signature header.
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret"`
+}
+
+func (x *WebhookRecipient) Reset() {
+	*x = WebhookRecipient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_subscription_recipient_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookRecipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookRecipient) ProtoMessage() {}
+
+func (x *WebhookRecipient) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_subscription_recipient_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookRecipient.ProtoReflect.Descriptor instead.
+func (*WebhookRecipient) Descriptor() ([]byte, []int) {
+	return file_proto_subscription_recipient_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WebhookRecipient) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookRecipient) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type EmailRecipient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses"`
+}
+
+func (x *EmailRecipient) Reset() {
+	*x = EmailRecipient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_subscription_recipient_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmailRecipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailRecipient) ProtoMessage() {}
+
+func (x *EmailRecipient) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_subscription_recipient_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailRecipient.ProtoReflect.Descriptor instead.
+func (*EmailRecipient) Descriptor() ([]byte, []int) {
+	return file_proto_subscription_recipient_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EmailRecipient) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
 var File_proto_subscription_recipient_proto protoreflect.FileDescriptor
 
 var file_proto_subscription_recipient_proto_rawDesc = []byte{
 	0x0a, 0x22, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
 	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb7, 0x02, 0x0a,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x96, 0x05, 0x0a,
 	0x09, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x04, 0x74, 0x79,
 	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
 	0x74, 0x65, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
@@ -255,14 +439,47 @@ var file_proto_subscription_recipient_proto_rawDesc = []byte{
 	0x2a, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x62, 0x73,
 	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
 	0x6e, 0x74, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6c, 0x61, 0x6e,
-	0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x18, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a,
-	0x0c, 0x53, 0x6c, 0x61, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x10, 0x00, 0x22,
-	0x25, 0x0a, 0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x45,
-	0x4e, 0x47, 0x4c, 0x49, 0x53, 0x48, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x41, 0x50, 0x41,
-	0x4e, 0x45, 0x53, 0x45, 0x10, 0x01, 0x22, 0x38, 0x0a, 0x15, 0x53, 0x6c, 0x61, 0x63, 0x6b, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x21, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73,
+	0x6f, 0x66, 0x74, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x36, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f,
+	0x73, 0x6f, 0x66, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x1e, 0x6d, 0x69, 0x63, 0x72, 0x6f,
+	0x73, 0x6f, 0x66, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x11, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72,
+	0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x10,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x12, 0x4f, 0x0a, 0x0f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x0e, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x22, 0x4b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x6c, 0x61,
+	0x63, 0x6b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4d,
+	0x69, 0x63, 0x72, 0x6f, 0x73, 0x6f, 0x66, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x10, 0x03, 0x22, 0x25,
+	0x0a, 0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4e,
+	0x47, 0x4c, 0x49, 0x53, 0x48, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x41, 0x50, 0x41, 0x4e,
+	0x45, 0x53, 0x45, 0x10, 0x01, 0x22, 0x38, 0x0a, 0x15, 0x53, 0x6c, 0x61, 0x63, 0x6b, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x22,
+	0x41, 0x0a, 0x1e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x6f, 0x66, 0x74, 0x54, 0x65, 0x61, 0x6d,
+	0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55,
+	0x72, 0x6c, 0x22, 0x3c, 0x0a, 0x10, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x22, 0x2e, 0x0a, 0x0e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
 	0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
 	0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x62, 0x75, 0x63, 0x6b,
 	0x65, 0x74, 0x65, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73,
@@ -283,22 +500,28 @@ func file_proto_subscription_recipient_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_subscription_recipient_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_subscription_recipient_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_subscription_recipient_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_proto_subscription_recipient_proto_goTypes = []interface{}{
-	(Recipient_Type)(0),           // 0: bucketeer.subscription.Recipient.Type
-	(Recipient_Language)(0),       // 1: bucketeer.subscription.Recipient.Language
-	(*Recipient)(nil),             // 2: bucketeer.subscription.Recipient
-	(*SlackChannelRecipient)(nil), // 3: bucketeer.subscription.SlackChannelRecipient
+	(Recipient_Type)(0),                    // 0: bucketeer.subscription.Recipient.Type
+	(Recipient_Language)(0),                // 1: bucketeer.subscription.Recipient.Language
+	(*Recipient)(nil),                      // 2: bucketeer.subscription.Recipient
+	(*SlackChannelRecipient)(nil),          // 3: bucketeer.subscription.SlackChannelRecipient
+	(*MicrosoftTeamsChannelRecipient)(nil), // 4: bucketeer.subscription.MicrosoftTeamsChannelRecipient
+	(*WebhookRecipient)(nil),               // 5: bucketeer.subscription.WebhookRecipient
+	(*EmailRecipient)(nil),                 // 6: bucketeer.subscription.EmailRecipient
 }
 var file_proto_subscription_recipient_proto_depIdxs = []int32{
 	0, // 0: bucketeer.subscription.Recipient.type:type_name -> bucketeer.subscription.Recipient.Type
 	3, // 1: bucketeer.subscription.Recipient.slack_channel_recipient:type_name -> bucketeer.subscription.SlackChannelRecipient
 	1, // 2: bucketeer.subscription.Recipient.language:type_name -> bucketeer.subscription.Recipient.Language
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: bucketeer.subscription.Recipient.microsoft_teams_channel_recipient:type_name -> bucketeer.subscription.MicrosoftTeamsChannelRecipient
+	5, // 4: bucketeer.subscription.Recipient.webhook_recipient:type_name -> bucketeer.subscription.WebhookRecipient
+	6, // 5: bucketeer.subscription.Recipient.email_recipient:type_name -> bucketeer.subscription.EmailRecipient
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_proto_subscription_recipient_proto_init() }
@@ -331,6 +554,42 @@ func file_proto_subscription_recipient_proto_init() {
 				return nil
 			}
 		}
+		file_proto_subscription_recipient_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MicrosoftTeamsChannelRecipient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_subscription_recipient_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookRecipient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_subscription_recipient_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmailRecipient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -338,7 +597,7 @@ func file_proto_subscription_recipient_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_subscription_recipient_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -45,6 +45,7 @@ const (
 	Notification_ExperimentRunning        Notification_Type = 2
 	Notification_MauCount                 Notification_Type = 3
 	Notification_DemoOrganizationCreation Notification_Type = 4
+	Notification_FeatureRemovalDue        Notification_Type = 5
 )
 
 // Enum value maps for Notification_Type.
@@ -55,6 +56,7 @@ var (
 		2: "ExperimentRunning",
 		3: "MauCount",
 		4: "DemoOrganizationCreation",
+		5: "FeatureRemovalDue",
 	}
 	Notification_Type_value = map[string]int32{
 		"DomainEvent":              0,
@@ -62,6 +64,7 @@ var (
 		"ExperimentRunning":        2,
 		"MauCount":                 3,
 		"DemoOrganizationCreation": 4,
+		"FeatureRemovalDue":        5,
 	}
 )
 
@@ -103,6 +106,7 @@ type Notification struct {
 	ExperimentRunningNotification        *ExperimentRunningNotification        `protobuf:"bytes,4,opt,name=experiment_running_notification,json=experimentRunningNotification,proto3" json:"experiment_running_notification"`
 	MauCountNotification                 *MauCountNotification                 `protobuf:"bytes,5,opt,name=mau_count_notification,json=mauCountNotification,proto3" json:"mau_count_notification"`
 	DemoOrganizationCreationNotification *DemoOrganizationCreationNotification `protobuf:"bytes,6,opt,name=demo_organization_creation_notification,json=demoOrganizationCreationNotification,proto3" json:"demo_organization_creation_notification"`
+	FeatureRemovalDueNotification        *FeatureRemovalDueNotification        `protobuf:"bytes,7,opt,name=feature_removal_due_notification,json=featureRemovalDueNotification,proto3" json:"feature_removal_due_notification"`
 }
 
 func (x *Notification) Reset() {
@@ -179,6 +183,13 @@ func (x *Notification) GetDemoOrganizationCreationNotification() *DemoOrganizati
 	return nil
 }
 
+func (x *Notification) GetFeatureRemovalDueNotification() *FeatureRemovalDueNotification {
+	if x != nil {
+		return x.FeatureRemovalDueNotification
+	}
+	return nil
+}
+
 type DomainEventNotification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -337,6 +348,71 @@ func (x *FeatureStaleNotification) GetEnvironmentUrlCode() string {
 	return ""
 }
 
+// FeatureRemovalDueNotification lists the temporary flags whose planned
+// removal date passed.
+type FeatureRemovalDueNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Features           []*feature.Feature `protobuf:"bytes,1,rep,name=features,proto3" json:"features"`
+	EnvironmentName    string             `protobuf:"bytes,2,opt,name=environment_name,json=environmentName,proto3" json:"environment_name"`
+	EnvironmentUrlCode string             `protobuf:"bytes,3,opt,name=environment_url_code,json=environmentUrlCode,proto3" json:"environment_url_code"`
+}
+
+func (x *FeatureRemovalDueNotification) Reset() {
+	*x = FeatureRemovalDueNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_subscription_sender_notification_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeatureRemovalDueNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureRemovalDueNotification) ProtoMessage() {}
+
+func (x *FeatureRemovalDueNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_subscription_sender_notification_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureRemovalDueNotification.ProtoReflect.Descriptor instead.
+func (*FeatureRemovalDueNotification) Descriptor() ([]byte, []int) {
+	return file_proto_subscription_sender_notification_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FeatureRemovalDueNotification) GetFeatures() []*feature.Feature {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *FeatureRemovalDueNotification) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *FeatureRemovalDueNotification) GetEnvironmentUrlCode() string {
+	if x != nil {
+		return x.EnvironmentUrlCode
+	}
+	return ""
+}
+
 type ExperimentRunningNotification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -350,7 +426,7 @@ type ExperimentRunningNotification struct {
 func (x *ExperimentRunningNotification) Reset() {
 	*x = ExperimentRunningNotification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_subscription_sender_notification_proto_msgTypes[3]
+		mi := &file_proto_subscription_sender_notification_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -363,7 +439,7 @@ func (x *ExperimentRunningNotification) String() string {
 func (*ExperimentRunningNotification) ProtoMessage() {}
 
 func (x *ExperimentRunningNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_subscription_sender_notification_proto_msgTypes[3]
+	mi := &file_proto_subscription_sender_notification_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -376,7 +452,7 @@ func (x *ExperimentRunningNotification) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExperimentRunningNotification.ProtoReflect.Descriptor instead.
 func (*ExperimentRunningNotification) Descriptor() ([]byte, []int) {
-	return file_proto_subscription_sender_notification_proto_rawDescGZIP(), []int{3}
+	return file_proto_subscription_sender_notification_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ExperimentRunningNotification) GetExperiments() []*experiment.Experiment {
@@ -415,7 +491,7 @@ type MauCountNotification struct {
 func (x *MauCountNotification) Reset() {
 	*x = MauCountNotification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_subscription_sender_notification_proto_msgTypes[4]
+		mi := &file_proto_subscription_sender_notification_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -428,7 +504,7 @@ func (x *MauCountNotification) String() string {
 func (*MauCountNotification) ProtoMessage() {}
 
 func (x *MauCountNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_subscription_sender_notification_proto_msgTypes[4]
+	mi := &file_proto_subscription_sender_notification_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,7 +517,7 @@ func (x *MauCountNotification) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MauCountNotification.ProtoReflect.Descriptor instead.
 func (*MauCountNotification) Descriptor() ([]byte, []int) {
-	return file_proto_subscription_sender_notification_proto_rawDescGZIP(), []int{4}
+	return file_proto_subscription_sender_notification_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *MauCountNotification) GetEventCount() int64 {
@@ -492,7 +568,7 @@ type DemoOrganizationCreationNotification struct {
 func (x *DemoOrganizationCreationNotification) Reset() {
 	*x = DemoOrganizationCreationNotification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_subscription_sender_notification_proto_msgTypes[5]
+		mi := &file_proto_subscription_sender_notification_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -505,7 +581,7 @@ func (x *DemoOrganizationCreationNotification) String() string {
 func (*DemoOrganizationCreationNotification) ProtoMessage() {}
 
 func (x *DemoOrganizationCreationNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_subscription_sender_notification_proto_msgTypes[5]
+	mi := &file_proto_subscription_sender_notification_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,7 +594,7 @@ func (x *DemoOrganizationCreationNotification) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use DemoOrganizationCreationNotification.ProtoReflect.Descriptor instead.
 func (*DemoOrganizationCreationNotification) Descriptor() ([]byte, []int) {
-	return file_proto_subscription_sender_notification_proto_rawDescGZIP(), []int{5}
+	return file_proto_subscription_sender_notification_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DemoOrganizationCreationNotification) GetOwnerEmail() string {
@@ -555,7 +631,7 @@ var file_proto_subscription_sender_notification_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x66, 0x65, 0x61,
 	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x65, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x06,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdc, 0x07,
 	0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44,
 	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x62,
 	0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
@@ -600,14 +676,24 @@ var file_proto_subscription_sender_notification_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69,
 	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x24, 0x64, 0x65, 0x6d, 0x6f, 0x4f, 0x72,
 	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6c,
-	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x65, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x02,
-	0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x61, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0x03, 0x12, 0x1c,
-	0x0a, 0x18, 0x44, 0x65, 0x6d, 0x6f, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x04, 0x22, 0xfb, 0x02, 0x0a,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x85,
+	0x01, 0x0a, 0x20, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x61, 0x6c, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x75, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1d, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x75, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0f, 0x0a, 0x0b, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x10, 0x00,
+	0x12, 0x10, 0x0a, 0x0c, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x6c, 0x65,
+	0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x61, 0x75,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x65, 0x6d, 0x6f, 0x4f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x75, 0x65, 0x10, 0x05, 0x22, 0xfb, 0x02, 0x0a,
 	0x17, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69,
 	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x65, 0x64, 0x69, 0x74,
 	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65,
@@ -643,48 +729,59 @@ var file_proto_subscription_sender_notification_proto_rawDesc = []byte{
 	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x63, 0x6f,
 	0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
 	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x4a, 0x04, 0x08, 0x01,
-	0x10, 0x02, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0xcc, 0x01, 0x0a, 0x1d, 0x45, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0b, 0x65, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x65, 0x78, 0x70, 0x65,
-	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29,
-	0x0a, 0x10, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x6e, 0x76,
-	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x4a, 0x04, 0x08, 0x01, 0x10,
-	0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0xcf, 0x01, 0x0a, 0x14, 0x4d, 0x61, 0x75, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x12, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x43,
-	0x6f, 0x64, 0x65, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x9d, 0x01, 0x0a, 0x24, 0x44, 0x65,
-	0x6d, 0x6f, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x45, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72,
-	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11,
-	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65,
-	0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2f, 0x76,
-	0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x10, 0x02, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0xb4, 0x01, 0x0a, 0x1d, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x75, 0x65, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x66, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2e, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a,
+	0x14, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0xcc, 0x01, 0x0a, 0x1d, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x42, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65,
+	0x65, 0x72, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x30, 0x0a, 0x14, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x75, 0x72, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x43, 0x6f,
+	0x64, 0x65, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0xcf,
+	0x01, 0x0a, 0x14, 0x4d, 0x61, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x73,
+	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x29, 0x0a,
+	0x10, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02,
+	0x22, 0x9d, 0x01, 0x0a, 0x24, 0x44, 0x65, 0x6d, 0x6f, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x65, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -700,38 +797,41 @@ func file_proto_subscription_sender_notification_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_subscription_sender_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_subscription_sender_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_subscription_sender_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_proto_subscription_sender_notification_proto_goTypes = []interface{}{
 	(Notification_Type)(0),                       // 0: bucketeer.subscription.sender.Notification.Type
 	(*Notification)(nil),                         // 1: bucketeer.subscription.sender.Notification
 	(*DomainEventNotification)(nil),              // 2: bucketeer.subscription.sender.DomainEventNotification
 	(*FeatureStaleNotification)(nil),             // 3: bucketeer.subscription.sender.FeatureStaleNotification
-	(*ExperimentRunningNotification)(nil),        // 4: bucketeer.subscription.sender.ExperimentRunningNotification
-	(*MauCountNotification)(nil),                 // 5: bucketeer.subscription.sender.MauCountNotification
-	(*DemoOrganizationCreationNotification)(nil), // 6: bucketeer.subscription.sender.DemoOrganizationCreationNotification
-	(*domain.Editor)(nil),                        // 7: bucketeer.event.domain.Editor
-	(domain.Event_EntityType)(0),                 // 8: bucketeer.event.domain.Event.EntityType
-	(domain.Event_Type)(0),                       // 9: bucketeer.event.domain.Event.Type
-	(*feature.Feature)(nil),                      // 10: bucketeer.feature.Feature
-	(*experiment.Experiment)(nil),                // 11: bucketeer.experiment.Experiment
+	(*FeatureRemovalDueNotification)(nil),        // 4: bucketeer.subscription.sender.FeatureRemovalDueNotification
+	(*ExperimentRunningNotification)(nil),        // 5: bucketeer.subscription.sender.ExperimentRunningNotification
+	(*MauCountNotification)(nil),                 // 6: bucketeer.subscription.sender.MauCountNotification
+	(*DemoOrganizationCreationNotification)(nil), // 7: bucketeer.subscription.sender.DemoOrganizationCreationNotification
+	(*domain.Editor)(nil),                        // 8: bucketeer.event.domain.Editor
+	(domain.Event_EntityType)(0),                 // 9: bucketeer.event.domain.Event.EntityType
+	(domain.Event_Type)(0),                       // 10: bucketeer.event.domain.Event.Type
+	(*feature.Feature)(nil),                      // 11: bucketeer.feature.Feature
+	(*experiment.Experiment)(nil),                // 12: bucketeer.experiment.Experiment
 }
 var file_proto_subscription_sender_notification_proto_depIdxs = []int32{
 	0,  // 0: bucketeer.subscription.sender.Notification.type:type_name -> bucketeer.subscription.sender.Notification.Type
 	2,  // 1: bucketeer.subscription.sender.Notification.domain_event_notification:type_name -> bucketeer.subscription.sender.DomainEventNotification
 	3,  // 2: bucketeer.subscription.sender.Notification.feature_stale_notification:type_name -> bucketeer.subscription.sender.FeatureStaleNotification
-	4,  // 3: bucketeer.subscription.sender.Notification.experiment_running_notification:type_name -> bucketeer.subscription.sender.ExperimentRunningNotification
-	5,  // 4: bucketeer.subscription.sender.Notification.mau_count_notification:type_name -> bucketeer.subscription.sender.MauCountNotification
-	6,  // 5: bucketeer.subscription.sender.Notification.demo_organization_creation_notification:type_name -> bucketeer.subscription.sender.DemoOrganizationCreationNotification
-	7,  // 6: bucketeer.subscription.sender.DomainEventNotification.editor:type_name -> bucketeer.event.domain.Editor
-	8,  // 7: bucketeer.subscription.sender.DomainEventNotification.entity_type:type_name -> bucketeer.event.domain.Event.EntityType
-	9,  // 8: bucketeer.subscription.sender.DomainEventNotification.type:type_name -> bucketeer.event.domain.Event.Type
-	10, // 9: bucketeer.subscription.sender.FeatureStaleNotification.features:type_name -> bucketeer.feature.Feature
-	11, // 10: bucketeer.subscription.sender.ExperimentRunningNotification.experiments:type_name -> bucketeer.experiment.Experiment
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	5,  // 3: bucketeer.subscription.sender.Notification.experiment_running_notification:type_name -> bucketeer.subscription.sender.ExperimentRunningNotification
+	6,  // 4: bucketeer.subscription.sender.Notification.mau_count_notification:type_name -> bucketeer.subscription.sender.MauCountNotification
+	7,  // 5: bucketeer.subscription.sender.Notification.demo_organization_creation_notification:type_name -> bucketeer.subscription.sender.DemoOrganizationCreationNotification
+	4,  // 6: bucketeer.subscription.sender.Notification.feature_removal_due_notification:type_name -> bucketeer.subscription.sender.FeatureRemovalDueNotification
+	8,  // 7: bucketeer.subscription.sender.DomainEventNotification.editor:type_name -> bucketeer.event.domain.Editor
+	9,  // 8: bucketeer.subscription.sender.DomainEventNotification.entity_type:type_name -> bucketeer.event.domain.Event.EntityType
+	10, // 9: bucketeer.subscription.sender.DomainEventNotification.type:type_name -> bucketeer.event.domain.Event.Type
+	11, // 10: bucketeer.subscription.sender.FeatureStaleNotification.features:type_name -> bucketeer.feature.Feature
+	11, // 11: bucketeer.subscription.sender.FeatureRemovalDueNotification.features:type_name -> bucketeer.feature.Feature
+	12, // 12: bucketeer.subscription.sender.ExperimentRunningNotification.experiments:type_name -> bucketeer.experiment.Experiment
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_proto_subscription_sender_notification_proto_init() }
@@ -777,7 +877,7 @@ func file_proto_subscription_sender_notification_proto_init() {
 			}
 		}
 		file_proto_subscription_sender_notification_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExperimentRunningNotification); i {
+			switch v := v.(*FeatureRemovalDueNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -789,7 +889,7 @@ func file_proto_subscription_sender_notification_proto_init() {
 			}
 		}
 		file_proto_subscription_sender_notification_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MauCountNotification); i {
+			switch v := v.(*ExperimentRunningNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -801,6 +901,18 @@ func file_proto_subscription_sender_notification_proto_init() {
 			}
 		}
 		file_proto_subscription_sender_notification_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MauCountNotification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_subscription_sender_notification_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DemoOrganizationCreationNotification); i {
 			case 0:
 				return &v.state
@@ -819,7 +931,7 @@ func file_proto_subscription_sender_notification_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_subscription_sender_notification_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -59,9 +59,13 @@ const (
 	Subscription_DOMAIN_EVENT_TEAM                  Subscription_SourceType = 19
 	Subscription_DOMAIN_EVENT_DEMO_ORGANIZATION     Subscription_SourceType = 20
 	Subscription_DOMAIN_EVENT_SCHEDULED_FLAG_CHANGE Subscription_SourceType = 21
+	Subscription_DOMAIN_EVENT_CHANGE_REQUEST        Subscription_SourceType = 22
+	Subscription_DOMAIN_EVENT_SCIM_TOKEN            Subscription_SourceType = 23
+	Subscription_DOMAIN_EVENT_CUSTOM_ROLE           Subscription_SourceType = 24
 	Subscription_FEATURE_STALE                      Subscription_SourceType = 100
 	Subscription_EXPERIMENT_RUNNING                 Subscription_SourceType = 200
 	Subscription_MAU_COUNT                          Subscription_SourceType = 300
+	Subscription_FEATURE_REMOVAL_DUE                Subscription_SourceType = 400
 )
 
 // Enum value maps for Subscription_SourceType.
@@ -89,9 +93,13 @@ var (
 		19:  "DOMAIN_EVENT_TEAM",
 		20:  "DOMAIN_EVENT_DEMO_ORGANIZATION",
 		21:  "DOMAIN_EVENT_SCHEDULED_FLAG_CHANGE",
+		22:  "DOMAIN_EVENT_CHANGE_REQUEST",
+		23:  "DOMAIN_EVENT_SCIM_TOKEN",
+		24:  "DOMAIN_EVENT_CUSTOM_ROLE",
 		100: "FEATURE_STALE",
 		200: "EXPERIMENT_RUNNING",
 		300: "MAU_COUNT",
+		400: "FEATURE_REMOVAL_DUE",
 	}
 	Subscription_SourceType_value = map[string]int32{
 		"DOMAIN_EVENT_FEATURE":               0,
@@ -116,9 +124,13 @@ var (
 		"DOMAIN_EVENT_TEAM":                  19,
 		"DOMAIN_EVENT_DEMO_ORGANIZATION":     20,
 		"DOMAIN_EVENT_SCHEDULED_FLAG_CHANGE": 21,
+		"DOMAIN_EVENT_CHANGE_REQUEST":        22,
+		"DOMAIN_EVENT_SCIM_TOKEN":            23,
+		"DOMAIN_EVENT_CUSTOM_ROLE":           24,
 		"FEATURE_STALE":                      100,
 		"EXPERIMENT_RUNNING":                 200,
 		"MAU_COUNT":                          300,
+		"FEATURE_REMOVAL_DUE":                400,
 	}
 )
 
@@ -277,7 +289,7 @@ var file_proto_subscription_subscription_proto_rawDesc = []byte{
 	0x65, 0x72, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
 	0x22, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
 	0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xe8, 0x08, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x6f, 0x74, 0x6f, 0x22, 0xde, 0x09, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
 	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
 	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
@@ -303,7 +315,7 @@ var file_proto_subscription_subscription_proto_rawDesc = []byte{
 	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x65, 0x61,
 	0x74, 0x75, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0a,
 	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61,
-	0x67, 0x54, 0x61, 0x67, 0x73, 0x22, 0xc6, 0x05, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x67, 0x54, 0x61, 0x67, 0x73, 0x22, 0xbc, 0x06, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
 	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x5f, 0x45,
 	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x45, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x00, 0x12, 0x15,
 	0x0a, 0x11, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x47,
@@ -344,15 +356,22 @@ var file_proto_subscription_subscription_proto_rawDesc = []byte{
 	0x4e, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x14, 0x12, 0x26, 0x0a, 0x22, 0x44, 0x4f,
 	0x4d, 0x41, 0x49, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44,
 	0x55, 0x4c, 0x45, 0x44, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
-	0x10, 0x15, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x45, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x53, 0x54,
-	0x41, 0x4c, 0x45, 0x10, 0x64, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x45, 0x52, 0x49, 0x4d,
-	0x45, 0x4e, 0x54, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0xc8, 0x01, 0x12, 0x0e,
-	0x0a, 0x09, 0x4d, 0x41, 0x55, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0xac, 0x02, 0x42, 0x39,
-	0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x65, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x10, 0x15, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x10, 0x16, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x49, 0x4d, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x17,
+	0x12, 0x1c, 0x0a, 0x18, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x10, 0x18, 0x12, 0x11,
+	0x0a, 0x0d, 0x46, 0x45, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x45, 0x10,
+	0x64, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x45, 0x52, 0x49, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0xc8, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x4d, 0x41,
+	0x55, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0xac, 0x02, 0x12, 0x18, 0x0a, 0x13, 0x46, 0x45,
+	0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x44, 0x55,
+	0x45, 0x10, 0x90, 0x03, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2d, 0x69, 0x6f, 0x2f,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
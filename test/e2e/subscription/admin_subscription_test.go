@@ -70,8 +70,8 @@ func TestCreateGetDeleteAdminSubscription(t *testing.T) {
 	if subscription.Recipient.Type != proto.Recipient_SlackChannel {
 		t.Fatalf("Incorrect recipient type. Expected: %s actual: %s", proto.Recipient_SlackChannel, subscription.Recipient.Type)
 	}
-	if subscription.Recipient.SlackChannelRecipient.WebhookUrl != webhookURL {
-		t.Fatalf("Incorrect webhook URL. Expected: %s actual: %s", webhookURL, subscription.Recipient.SlackChannelRecipient.WebhookUrl)
+	if subscription.Recipient.SlackChannelRecipient.WebhookUrl != maskedWebhookURL {
+		t.Fatalf("Incorrect webhook URL. Expected: %s actual: %s", maskedWebhookURL, subscription.Recipient.SlackChannelRecipient.WebhookUrl)
 	}
 	if subscription.Disabled != false {
 		t.Fatalf("Incorrect deleted. Expected: %t actual: %t", false, subscription.Disabled)
@@ -138,8 +138,8 @@ func TestCreateListDeleteAdminSubscription(t *testing.T) {
 	if subscription.Recipient.Type != proto.Recipient_SlackChannel {
 		t.Fatalf("Incorrect recipient type. Expected: %s actual: %s", proto.Recipient_SlackChannel, subscription.Recipient.Type)
 	}
-	if subscription.Recipient.SlackChannelRecipient.WebhookUrl != webhookURL {
-		t.Fatalf("Incorrect webhook URL. Expected: %s actual: %s", webhookURL, subscription.Recipient.SlackChannelRecipient.WebhookUrl)
+	if subscription.Recipient.SlackChannelRecipient.WebhookUrl != maskedWebhookURL {
+		t.Fatalf("Incorrect webhook URL. Expected: %s actual: %s", maskedWebhookURL, subscription.Recipient.SlackChannelRecipient.WebhookUrl)
 	}
 	if subscription.Disabled != false {
 		t.Fatalf("Incorrect deleted. Expected: %t actual: %t", false, subscription.Disabled)
@@ -221,8 +221,8 @@ func TestUpdateAdminSubscription(t *testing.T) {
 	if subscription.Recipient.Type != proto.Recipient_SlackChannel {
 		t.Fatalf("Incorrect recipient type. Expected: %s actual: %s", proto.Recipient_SlackChannel, subscription.Recipient.Type)
 	}
-	if subscription.Recipient.SlackChannelRecipient.WebhookUrl != webhookURL {
-		t.Fatalf("Incorrect webhook URL. Expected: %s actual: %s", webhookURL, subscription.Recipient.SlackChannelRecipient.WebhookUrl)
+	if subscription.Recipient.SlackChannelRecipient.WebhookUrl != maskedWebhookURL {
+		t.Fatalf("Incorrect webhook URL. Expected: %s actual: %s", maskedWebhookURL, subscription.Recipient.SlackChannelRecipient.WebhookUrl)
 	}
 	if subscription.Disabled != false {
 		t.Fatalf("Incorrect deleted. Expected: %t actual: %t", false, subscription.Disabled)
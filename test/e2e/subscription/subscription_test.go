@@ -36,6 +36,8 @@ import (
 const (
 	prefixTestName = "e2e-test"
 	timeout        = 60 * time.Second
+	// The API masks the webhook URLs, which have no host in these tests.
+	maskedWebhookURL = "********"
 )
 
 var (
@@ -108,8 +110,8 @@ func TestCreateListSubscription(t *testing.T) {
 	if subscription.Recipient.Type != proto.Recipient_SlackChannel {
 		t.Fatalf("Incorrect recipient type. Expected: %s actual: %s", proto.Recipient_SlackChannel, subscription.Recipient.Type)
 	}
-	if subscription.Recipient.SlackChannelRecipient.WebhookUrl != webhookURL {
-		t.Fatalf("Incorrect webhook URL. Expected: %s actual: %s", webhookURL, subscription.Recipient.SlackChannelRecipient.WebhookUrl)
+	if subscription.Recipient.SlackChannelRecipient.WebhookUrl != maskedWebhookURL {
+		t.Fatalf("Incorrect webhook URL. Expected: %s actual: %s", maskedWebhookURL, subscription.Recipient.SlackChannelRecipient.WebhookUrl)
 	}
 	if subscription.Disabled != false {
 		t.Fatalf("Incorrect deleted. Expected: %t actual: %t", false, subscription.Disabled)
@@ -185,8 +187,8 @@ func TestCreateUpdateSubscription(t *testing.T) {
 	if subscription.Recipient.Type != proto.Recipient_SlackChannel {
 		t.Fatalf("Incorrect recipient type. Expected: %s actual: %s", proto.Recipient_SlackChannel, subscription.Recipient.Type)
 	}
-	if subscription.Recipient.SlackChannelRecipient.WebhookUrl != webhookURL {
-		t.Fatalf("Incorrect webhook URL. Expected: %s actual: %s", webhookURL, subscription.Recipient.SlackChannelRecipient.WebhookUrl)
+	if subscription.Recipient.SlackChannelRecipient.WebhookUrl != maskedWebhookURL {
+		t.Fatalf("Incorrect webhook URL. Expected: %s actual: %s", maskedWebhookURL, subscription.Recipient.SlackChannelRecipient.WebhookUrl)
 	}
 	if subscription.Disabled != false {
 		t.Fatalf("Incorrect deleted. Expected: %t actual: %t", false, subscription.Disabled)
@@ -252,8 +254,8 @@ func TestCreateGetDeleteSubscription(t *testing.T) {
 	if subscription.Recipient.Type != proto.Recipient_SlackChannel {
 		t.Fatalf("Incorrect recipient type. Expected: %s actual: %s", proto.Recipient_SlackChannel, subscription.Recipient.Type)
 	}
-	if subscription.Recipient.SlackChannelRecipient.WebhookUrl != webhookURL {
-		t.Fatalf("Incorrect webhook URL. Expected: %s actual: %s", webhookURL, subscription.Recipient.SlackChannelRecipient.WebhookUrl)
+	if subscription.Recipient.SlackChannelRecipient.WebhookUrl != maskedWebhookURL {
+		t.Fatalf("Incorrect webhook URL. Expected: %s actual: %s", maskedWebhookURL, subscription.Recipient.SlackChannelRecipient.WebhookUrl)
 	}
 	if subscription.Disabled != false {
 		t.Fatalf("Incorrect deleted. Expected: %t actual: %t", false, subscription.Disabled)
@@ -326,8 +328,8 @@ func TestCreateListDeleteSubscription(t *testing.T) {
 	if subscription.Recipient.Type != proto.Recipient_SlackChannel {
 		t.Fatalf("Incorrect recipient type. Expected: %s actual: %s", proto.Recipient_SlackChannel, subscription.Recipient.Type)
 	}
-	if subscription.Recipient.SlackChannelRecipient.WebhookUrl != webhookURL {
-		t.Fatalf("Incorrect webhook URL. Expected: %s actual: %s", webhookURL, subscription.Recipient.SlackChannelRecipient.WebhookUrl)
+	if subscription.Recipient.SlackChannelRecipient.WebhookUrl != maskedWebhookURL {
+		t.Fatalf("Incorrect webhook URL. Expected: %s actual: %s", maskedWebhookURL, subscription.Recipient.SlackChannelRecipient.WebhookUrl)
 	}
 	if subscription.Disabled != false {
 		t.Fatalf("Incorrect deleted. Expected: %t actual: %t", false, subscription.Disabled)
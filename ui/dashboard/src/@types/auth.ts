@@ -21,6 +21,7 @@ export interface AuthTypeMap {
   AUTH_TYPE_USER_PASSWORD: 1;
   AUTH_TYPE_GOOGLE: 2;
   AUTH_TYPE_GITHUB: 3;
+  AUTH_TYPE_OIDC: 4;
 }
 
 export interface SignInForm {
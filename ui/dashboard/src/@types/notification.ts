@@ -21,7 +21,8 @@ export type SourceType =
   | 'DOMAIN_EVENT_FLAG_TRIGGER'
   | 'FEATURE_STALE'
   | 'EXPERIMENT_RUNNING'
-  | 'MAU_COUNT';
+  | 'MAU_COUNT'
+  | 'FEATURE_REMOVAL_DUE';
 
 export interface NotificationRecipient {
   type: RecipientType;
@@ -4,7 +4,8 @@ import type {
   FeatureTarget,
   FeatureVariation,
   FeatureRuleChange,
-  FeatureChangeType
+  FeatureChangeType,
+  VariationValueSchema
 } from './feature';
 
 export const ScheduledFlagChangeStatuses = {
@@ -77,6 +78,9 @@ export interface ScheduledChangePayload {
   archived?: boolean;
   resetSamplingSeed?: boolean;
   maintainer?: string;
+  tags?: { values: string[] };
+  variationValueSchema?: VariationValueSchema;
+  clearVariationValueSchema?: boolean;
 }
 
 export interface ChangeSummary {
@@ -29,6 +29,11 @@ export const SOURCE_TYPE_ITEMS: NotificationOption[] = [
     description: translate(`source-type.feature-flag-description`),
     value: 'DOMAIN_EVENT_FEATURE'
   },
+  {
+    label: translate(`source-type.feature-flag-removal-due`),
+    description: translate(`source-type.feature-flag-removal-due-description`),
+    value: 'FEATURE_REMOVAL_DUE'
+  },
   {
     label: translate(`source-type.goal`),
     description: translate(`source-type.goal-description`),